@@ -18,7 +18,10 @@ package admin
 
 import (
 	"context"
+	"io"
+	"time"
 
+	protoTypes "github.com/gogo/protobuf/types"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -27,7 +30,11 @@ import (
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/jsonpatch"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
+	doctime "github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
 // Option configures Options.
@@ -38,10 +45,19 @@ func WithLogger(logger *zap.Logger) Option {
 	return func(o *Options) { o.Logger = logger }
 }
 
+// WithDialOptions configures the additional gRPC dial options of the client,
+// e.g. a dialer to connect to the server on an in-memory listener.
+func WithDialOptions(dialOptions ...grpc.DialOption) Option {
+	return func(o *Options) { o.DialOptions = append(o.DialOptions, dialOptions...) }
+}
+
 // Options configures how we set up the client.
 type Options struct {
 	// Logger is the Logger of the client.
 	Logger *zap.Logger
+
+	// DialOptions is the additional gRPC dial options of the client.
+	DialOptions []grpc.DialOption
 }
 
 // Client is a client for admin service.
@@ -62,6 +78,7 @@ func New(opts ...Option) (*Client, error) {
 
 	credentials := grpc.WithTransportCredentials(insecure.NewCredentials())
 	dialOptions := []grpc.DialOption{credentials}
+	dialOptions = append(dialOptions, options.DialOptions...)
 
 	logger := options.Logger
 	if logger == nil {
@@ -125,6 +142,51 @@ func (c *Client) CreateProject(ctx context.Context, name string) (*types.Project
 	return converter.FromProject(response.Project)
 }
 
+// GetProject gets the project of the given name. The snapshot policy of the
+// project is returned with the defaults of the server merged.
+func (c *Client) GetProject(ctx context.Context, name string) (*types.Project, error) {
+	response, err := c.client.GetProject(
+		ctx,
+		&api.GetProjectRequest{
+			Name: name,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromProject(response.Project)
+}
+
+// GetProjects gets the projects of the given IDs. It returns the IDs of the
+// projects not found as well.
+func (c *Client) GetProjects(
+	ctx context.Context,
+	ids []types.ID,
+) ([]*types.Project, []types.ID, error) {
+	req := &api.GetProjectsRequest{}
+	for _, id := range ids {
+		req.Ids = append(req.Ids, id.String())
+	}
+
+	response, err := c.client.GetProjects(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	projects, err := converter.FromProjects(response.Projects)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var notFoundIDs []types.ID
+	for _, id := range response.NotFoundIds {
+		notFoundIDs = append(notFoundIDs, types.ID(id))
+	}
+
+	return projects, notFoundIDs, nil
+}
+
 // ListProjects lists all projects.
 func (c *Client) ListProjects(ctx context.Context) ([]*types.Project, error) {
 	response, err := c.client.ListProjects(
@@ -143,15 +205,33 @@ func (c *Client) UpdateProject(
 	ctx context.Context,
 	id string,
 	fields *types.UpdatableProjectFields,
+) (*types.Project, error) {
+	return c.UpdateProjectWithMask(ctx, id, fields, nil)
+}
+
+// UpdateProjectWithMask updates only the fields of the given paths of the
+// project, e.g. "auth_webhook_url", and leaves the others untouched even if
+// they are given. If no path is given, all the given fields are updated.
+func (c *Client) UpdateProjectWithMask(
+	ctx context.Context,
+	id string,
+	fields *types.UpdatableProjectFields,
+	paths []string,
 ) (*types.Project, error) {
 	pbProjectField, err := converter.ToUpdatableProjectFields(fields)
 	if err != nil {
 		return nil, err
 	}
 
+	var updateMask *protoTypes.FieldMask
+	if len(paths) > 0 {
+		updateMask = &protoTypes.FieldMask{Paths: paths}
+	}
+
 	response, err := c.client.UpdateProject(ctx, &api.UpdateProjectRequest{
-		Id:     id,
-		Fields: pbProjectField,
+		Id:         id,
+		Fields:     pbProjectField,
+		UpdateMask: updateMask,
 	})
 	if err != nil {
 		return nil, err
@@ -160,19 +240,256 @@ func (c *Client) UpdateProject(
 	return converter.FromProject(response.Project)
 }
 
-// ListDocuments lists documents.
-func (c *Client) ListDocuments(ctx context.Context, projectName string) ([]*types.DocumentSummary, error) {
-	response, err := c.client.ListDocuments(
+// ListDocuments lists documents from the view at the given snapshot time. If
+// the snapshot time is zero, the view at the current time is used. It returns
+// the time of the view to be passed for the next pages, so that all the pages
+// are listed from the same view. If metadata is given, only the documents
+// which have all the entries of it are listed.
+func (c *Client) ListDocuments(
+	ctx context.Context,
+	projectName string,
+	previousID types.ID,
+	pageSize int32,
+	isForward bool,
+	snapshotAt time.Time,
+	metadata map[string]string,
+) ([]*types.DocumentSummary, time.Time, error) {
+	req := &api.ListDocumentsRequest{
+		ProjectName: projectName,
+		PreviousId:  previousID.String(),
+		PageSize:    pageSize,
+		IsForward:   isForward,
+		Metadata:    metadata,
+	}
+
+	var err error
+	if !snapshotAt.IsZero() {
+		if req.SnapshotAt, err = protoTypes.TimestampProto(snapshotAt); err != nil {
+			return nil, time.Time{}, err
+		}
+	}
+
+	response, err := c.client.ListDocuments(ctx, req)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	summaries, err := converter.FromDocumentSummaries(response.Documents)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	if snapshotAt, err = protoTypes.TimestampFromProto(response.SnapshotAt); err != nil {
+		return nil, time.Time{}, err
+	}
+
+	return summaries, snapshotAt, nil
+}
+
+// ListDocumentsByPageToken lists the documents of the page of the given token,
+// which is the next page token of the previous page. Empty token means the
+// first page listed in the given direction. It returns the next page token,
+// which is empty if there are no more pages.
+func (c *Client) ListDocumentsByPageToken(
+	ctx context.Context,
+	projectName string,
+	pageToken string,
+	pageSize int32,
+	isForward bool,
+	metadata map[string]string,
+) ([]*types.DocumentSummary, string, error) {
+	response, err := c.client.ListDocuments(ctx, &api.ListDocumentsRequest{
+		ProjectName: projectName,
+		PageSize:    pageSize,
+		IsForward:   isForward,
+		Metadata:    metadata,
+		PageToken:   pageToken,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	summaries, err := converter.FromDocumentSummaries(response.Documents)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return summaries, response.NextPageToken, nil
+}
+
+// StreamDocuments calls the given function with the summaries of all the
+// documents of the given project streamed from the server. The documents
+// created during the stream may not be included. It stops when the context is
+// done or the function returns an error.
+func (c *Client) StreamDocuments(
+	ctx context.Context,
+	projectName string,
+	fn func(summary *types.DocumentSummary) error,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.client.StreamDocuments(ctx, &api.StreamDocumentsRequest{
+		ProjectName: projectName,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		summary, err := converter.FromDocumentSummary(resp.Document)
+		if err != nil {
+			return err
+		}
+		if err := fn(summary); err != nil {
+			return err
+		}
+	}
+}
+
+// GetDocument returns the document detail of the given key.
+func (c *Client) GetDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+) (*types.DocumentDetail, error) {
+	return c.getDocument(ctx, &api.GetDocumentRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+	})
+}
+
+// GetDocumentIncludingRemoved returns the document detail of the given key. Unlike
+// GetDocument, the latest removed document of the key is returned if there is
+// no live one, and its removal time is set in the summary.
+func (c *Client) GetDocumentIncludingRemoved(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+) (*types.DocumentDetail, error) {
+	return c.getDocument(ctx, &api.GetDocumentRequest{
+		ProjectName:    projectName,
+		DocumentKey:    key.String(),
+		IncludeRemoved: true,
+	})
+}
+
+// GetDocumentSinceCheckpoint returns the document detail of the given key
+// with the number of the changes after the given server sequence of a
+// checkpoint. It helps to decide whether to pull a snapshot instead of the
+// changes.
+func (c *Client) GetDocumentSinceCheckpoint(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	serverSeq uint64,
+) (*types.DocumentDetail, error) {
+	return c.getDocument(ctx, &api.GetDocumentRequest{
+		ProjectName:         projectName,
+		DocumentKey:         key.String(),
+		CheckpointServerSeq: &protoTypes.UInt64Value{Value: serverSeq},
+	})
+}
+
+func (c *Client) getDocument(
+	ctx context.Context,
+	req *api.GetDocumentRequest,
+) (*types.DocumentDetail, error) {
+	response, err := c.client.GetDocument(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := converter.FromDocumentSummary(response.Document)
+	if err != nil {
+		return nil, err
+	}
+
+	gcInterval, err := time.ParseDuration(response.GcInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.DocumentDetail{
+		Summary:                summary,
+		ServerSeq:              response.ServerSeq,
+		SnapshotServerSeq:      response.SnapshotServerSeq,
+		AttachedClients:        int(response.AttachedClients),
+		ChangesSinceCheckpoint: response.ChangesSinceCheckpoint,
+		GCInterval:             gcInterval,
+	}, nil
+}
+
+// ExportDocument exports the latest content of the document of the given key
+// in JSON. If withMetadata is true, the metadata of each element such as the
+// creation time and the last modifier is exported together.
+func (c *Client) ExportDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	withMetadata bool,
+) (string, error) {
+	detail, err := c.GetDocument(ctx, projectName, key)
+	if err != nil {
+		return "", err
+	}
+
+	snapshotMeta, err := c.client.GetSnapshotMeta(ctx, &api.GetSnapshotMetaRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+		ServerSeq:   detail.ServerSeq,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	obj, err := converter.BytesToObject(snapshotMeta.Snapshot)
+	if err != nil {
+		return "", err
+	}
+	if !withMetadata {
+		return obj.Marshal(), nil
+	}
+
+	exported, err := converter.ToExportedElement(obj)
+	if err != nil {
+		return "", err
+	}
+	return exported.Marshal()
+}
+
+// RemoveDocumentsByPrefix removes the documents of the project whose keys
+// start with the given prefix. It returns the number of documents removed and
+// the number of documents skipped because they are attached by clients.
+func (c *Client) RemoveDocumentsByPrefix(
+	ctx context.Context,
+	projectName string,
+	prefix string,
+	dryRun bool,
+	force bool,
+) (int, int, error) {
+	response, err := c.client.RemoveDocumentsByPrefix(
 		ctx,
-		&api.ListDocumentsRequest{
+		&api.RemoveDocumentsByPrefixRequest{
 			ProjectName: projectName,
+			KeyPrefix:   prefix,
+			DryRun:      dryRun,
+			Force:       force,
 		},
 	)
 	if err != nil {
-		return nil, err
+		return 0, 0, err
 	}
 
-	return converter.FromDocumentSummaries(response.Documents)
+	return int(response.RemovedCount), int(response.SkippedCount), nil
 }
 
 // ListChangeSummaries returns the change summaries of the given document.
@@ -223,6 +540,7 @@ func (c *Client) ListChangeSummaries(
 	if err != nil {
 		return nil, err
 	}
+	newDoc.SetObjectMergePolicy(json.MergePolicy(snapshotMeta.ObjectMergePolicy))
 	var summaries []*types.ChangeSummary
 	for _, c := range changes {
 		if err := newDoc.ApplyChanges(c); err != nil {
@@ -239,3 +557,531 @@ func (c *Client) ListChangeSummaries(
 
 	return summaries, nil
 }
+
+// ListDocumentClientEvents returns the events of clients on the given document
+// which occurred in [from, to). Zero from or to means no bound.
+func (c *Client) ListDocumentClientEvents(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	from time.Time,
+	to time.Time,
+	previousID types.ID,
+	pageSize int32,
+	isForward bool,
+) ([]*types.DocumentClientEvent, error) {
+	req := &api.ListDocumentClientEventsRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+		PreviousId:  previousID.String(),
+		PageSize:    pageSize,
+		IsForward:   isForward,
+	}
+
+	var err error
+	if !from.IsZero() {
+		if req.From, err = protoTypes.TimestampProto(from); err != nil {
+			return nil, err
+		}
+	}
+	if !to.IsZero() {
+		if req.To, err = protoTypes.TimestampProto(to); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := c.client.ListDocumentClientEvents(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromDocumentClientEvents(resp.Events)
+}
+
+// ListDocumentClientEventsByPageToken lists the events of clients on the given
+// document of the page of the given token, like ListDocumentsByPageToken.
+func (c *Client) ListDocumentClientEventsByPageToken(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	pageToken string,
+	pageSize int32,
+	isForward bool,
+) ([]*types.DocumentClientEvent, string, error) {
+	resp, err := c.client.ListDocumentClientEvents(ctx, &api.ListDocumentClientEventsRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+		PageSize:    pageSize,
+		IsForward:   isForward,
+		PageToken:   pageToken,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	events, err := converter.FromDocumentClientEvents(resp.Events)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return events, resp.NextPageToken, nil
+}
+
+// ListDocumentEventLogs lists the entries of the event log of the given
+// document whose server sequences are in [fromSeq, toSeq]. Zero toSeq means
+// the last server sequence of the document.
+func (c *Client) ListDocumentEventLogs(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	fromSeq uint64,
+	toSeq uint64,
+) ([]*types.DocumentEventLog, error) {
+	resp, err := c.client.ListDocumentEventLogs(ctx, &api.ListDocumentEventLogsRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+		FromSeq:     fromSeq,
+		ToSeq:       toSeq,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromDocumentEventLogs(resp.EventLogs)
+}
+
+// GetDocumentVersionVector gets the largest Lamport timestamps of the changes
+// of each actor of the given document.
+func (c *Client) GetDocumentVersionVector(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+) (doctime.VersionVector, error) {
+	resp, err := c.client.GetDocumentVersionVector(ctx, &api.GetDocumentVersionVectorRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromVersionVector(resp.VersionVector)
+}
+
+// GetProjectStats gets the statistics of the given project such as the
+// conflict wins of the actors and the hot documents.
+func (c *Client) GetProjectStats(
+	ctx context.Context,
+	projectName string,
+) (*types.ProjectStats, error) {
+	resp, err := c.client.GetProjectStats(ctx, &api.GetProjectStatsRequest{
+		ProjectName: projectName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromProjectStats(resp.Stats)
+}
+
+// WatchRejections calls the given function with the changes of the given
+// project rejected by the server until the context is done or the function
+// returns an error. At most maxPerSecond rejections are delivered per second,
+// and zero means the default of the server.
+func (c *Client) WatchRejections(
+	ctx context.Context,
+	projectName string,
+	maxPerSecond int,
+	fn func(rejection *types.Rejection) error,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.client.WatchRejections(ctx, &api.WatchRejectionsRequest{
+		ProjectName:  projectName,
+		MaxPerSecond: int32(maxPerSecond),
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		rejection, err := converter.FromRejection(resp.Rejection)
+		if err != nil {
+			return err
+		}
+		if err := fn(rejection); err != nil {
+			return err
+		}
+	}
+}
+
+// WatchProjectStats watches the live statistics of the given project measured
+// by the server, and calls the given function with each update until the
+// context is done or the function returns an error.
+func (c *Client) WatchProjectStats(
+	ctx context.Context,
+	projectName string,
+	fn func(update *types.ProjectStatsUpdate) error,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.client.WatchProjectStats(ctx, &api.WatchProjectStatsRequest{
+		ProjectName: projectName,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		update, err := converter.FromProjectStatsUpdate(resp)
+		if err != nil {
+			return err
+		}
+		if err := fn(update); err != nil {
+			return err
+		}
+	}
+}
+
+// GetMaintenance gets the maintenance mode of the server.
+func (c *Client) GetMaintenance(ctx context.Context) (*types.Maintenance, error) {
+	resp, err := c.client.GetMaintenance(ctx, &api.GetMaintenanceRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromMaintenance(resp.Maintenance)
+}
+
+// SetMaintenance enables or disables the maintenance mode of the server. The
+// given message is returned with the writes rejected in maintenance mode.
+func (c *Client) SetMaintenance(
+	ctx context.Context,
+	enabled bool,
+	message string,
+) (*types.Maintenance, error) {
+	resp, err := c.client.SetMaintenance(ctx, &api.SetMaintenanceRequest{
+		Enabled: enabled,
+		Message: message,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromMaintenance(resp.Maintenance)
+}
+
+// ListSnapshotMetas lists the metadata of the snapshots of the given document
+// retained for rollback from the latest one.
+func (c *Client) ListSnapshotMetas(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+) ([]*types.SnapshotMeta, error) {
+	resp, err := c.client.ListSnapshotMetas(ctx, &api.ListSnapshotMetasRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromSnapshotMetas(resp.Snapshots)
+}
+
+// RollbackDocument restores the given document to the retained snapshot of
+// the given server sequence.
+func (c *Client) RollbackDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	serverSeq uint64,
+) error {
+	_, err := c.client.RollbackDocument(ctx, &api.RollbackDocumentRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+		ServerSeq:   serverSeq,
+	})
+	return err
+}
+
+// ResetDocument clears all the content of the given document while keeping
+// its key and the clients attaching it.
+func (c *Client) ResetDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+) error {
+	_, err := c.client.ResetDocument(ctx, &api.ResetDocumentRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+	})
+	return err
+}
+
+// ValidateDocument replays the change log of the given document and compares
+// the result with the latest snapshot of it.
+func (c *Client) ValidateDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+) (*types.DocumentValidation, error) {
+	resp, err := c.client.ValidateDocument(ctx, &api.ValidateDocumentRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.DocumentValidation{
+		ServerSeq:        resp.ServerSeq,
+		Valid:            resp.Valid,
+		ExpectedChecksum: resp.ExpectedChecksum,
+		ActualChecksum:   resp.ActualChecksum,
+		DivergingPath:    resp.DivergingPath,
+	}, nil
+}
+
+// DiffDocument returns the JSON Patch (RFC 6902) which turns the given
+// document of fromServerSeq into the one of toServerSeq.
+func (c *Client) DiffDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	fromServerSeq uint64,
+	toServerSeq uint64,
+) (jsonpatch.Patch, error) {
+	resp, err := c.client.DiffDocument(ctx, &api.DiffDocumentRequest{
+		ProjectName:   projectName,
+		DocumentKey:   key.String(),
+		FromServerSeq: fromServerSeq,
+		ToServerSeq:   toServerSeq,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonpatch.Parse([]byte(resp.Patch))
+}
+
+// AnalyzeDocument returns the sizes of the subtrees of the given document up
+// to the given depth, to find the parts which make the document large. Zero
+// depth reports the top-level subtrees.
+func (c *Client) AnalyzeDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	maxDepth int,
+) (*types.DocumentAnalysis, error) {
+	resp, err := c.client.AnalyzeDocument(ctx, &api.AnalyzeDocumentRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+		MaxDepth:    int32(maxDepth),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	subtrees := make([]*types.SubtreeSize, 0, len(resp.Subtrees))
+	for _, subtree := range resp.Subtrees {
+		subtrees = append(subtrees, &types.SubtreeSize{
+			Path:           subtree.Path,
+			Bytes:          subtree.Bytes,
+			TombstoneBytes: subtree.TombstoneBytes,
+		})
+	}
+
+	return &types.DocumentAnalysis{
+		ServerSeq: resp.ServerSeq,
+		Subtrees:  subtrees,
+		Truncated: resp.Truncated,
+	}, nil
+}
+
+// CreateDocumentFromTemplate creates a document of the given key from the
+// template of the project rendered with the given variables.
+func (c *Client) CreateDocumentFromTemplate(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	templateID string,
+	variables map[string]string,
+) (*types.DocumentSummary, error) {
+	resp, err := c.client.CreateDocumentFromTemplate(ctx, &api.CreateDocumentFromTemplateRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+		TemplateId:  templateID,
+		Variables:   variables,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromDocumentSummary(resp.Document)
+}
+
+// ReplayOperations stores the given changes, authored in another system, in
+// the given order as the history of the document of the given key. The
+// changes already stored are skipped, so the replay can be retried.
+func (c *Client) ReplayOperations(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	changes []*change.Change,
+) (*types.ChangeReplay, error) {
+	pbChanges, err := converter.ToChanges(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.ReplayOperations(ctx, &api.ReplayOperationsRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+		Changes:     pbChanges,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ChangeReplay{
+		ServerSeq: resp.ServerSeq,
+		Replayed:  int(resp.Replayed),
+		Skipped:   int(resp.Skipped),
+	}, nil
+}
+
+// CreateDocument creates an empty document of the given key. It returns
+// AlreadyExists if the document already exists.
+func (c *Client) CreateDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+) (*types.DocumentSummary, error) {
+	resp, err := c.client.CreateDocumentByAdmin(ctx, &api.CreateDocumentByAdminRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromDocumentSummary(resp.Document)
+}
+
+// LockDocument locks the given document so that changes can not be pushed to
+// it until it is unlocked. The reason is shown to the rejected clients.
+func (c *Client) LockDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	reason string,
+) error {
+	_, err := c.client.LockDocument(ctx, &api.LockDocumentRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+		Reason:      reason,
+	})
+	return err
+}
+
+// UnlockDocument unlocks the given document.
+func (c *Client) UnlockDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+) error {
+	_, err := c.client.UnlockDocument(ctx, &api.UnlockDocumentRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+	})
+	return err
+}
+
+// MoveDocument moves the given document to the target project. The clients
+// attached to the document must attach it again under the target project.
+func (c *Client) MoveDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	targetProjectName string,
+) error {
+	_, err := c.client.MoveDocument(ctx, &api.MoveDocumentRequest{
+		ProjectName:       projectName,
+		DocumentKey:       key.String(),
+		TargetProjectName: targetProjectName,
+	})
+	return err
+}
+
+// UnarchiveDocument unarchives the given document.
+func (c *Client) UnarchiveDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+) error {
+	_, err := c.client.UnarchiveDocument(ctx, &api.UnarchiveDocumentRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+	})
+	return err
+}
+
+// TransferDocumentOwnership transfers the ownership of the given document to
+// the given owner. It returns the IDs of the clients detached from the
+// document because they are no longer permitted to access it.
+func (c *Client) TransferDocumentOwnership(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	owner string,
+) ([]types.ID, error) {
+	resp, err := c.client.TransferDocumentOwnership(ctx, &api.TransferDocumentOwnershipRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+		Owner:       owner,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []types.ID
+	for _, id := range resp.DetachedClientIds {
+		ids = append(ids, types.ID(id))
+	}
+	return ids, nil
+}
+
+// SetDocumentMetadata replaces the metadata of the given document. Empty
+// metadata clears it.
+func (c *Client) SetDocumentMetadata(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	metadata map[string]string,
+) error {
+	_, err := c.client.SetDocumentMetadata(ctx, &api.SetDocumentMetadataRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+		Metadata:    metadata,
+	})
+	return err
+}
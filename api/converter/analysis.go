@@ -0,0 +1,154 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package converter
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+)
+
+// AnalyzeObject returns the sizes of the subtrees of the given root object up
+// to the given depth, measured in the bytes of its snapshot. The root is
+// encoded once and its elements are visited once, while the subtrees reported
+// are measured again at each depth up to the given one.
+func AnalyzeObject(obj *json.Object, maxDepth int) (*types.DocumentAnalysis, error) {
+	pbElem, err := toJSONObject(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &api.Snapshot{
+		Root:    pbElem.GetJsonObject(),
+		Version: CurrentSnapshotVersion,
+	}
+	a := &analyzer{maxDepth: maxDepth}
+	a.subtrees = append(a.subtrees, &types.SubtreeSize{
+		Path:           json.RootPath,
+		Bytes:          int64(snapshot.Size()),
+		TombstoneBytes: a.walk(pbElem, json.RootPath, 0),
+	})
+
+	sort.SliceStable(a.subtrees, func(i, j int) bool {
+		return a.subtrees[i].Bytes > a.subtrees[j].Bytes
+	})
+
+	analysis := &types.DocumentAnalysis{Subtrees: a.subtrees}
+	if len(analysis.Subtrees) > types.MaxDocumentAnalysisSubtrees {
+		analysis.Subtrees = analysis.Subtrees[:types.MaxDocumentAnalysisSubtrees]
+		analysis.Truncated = true
+	}
+	return analysis, nil
+}
+
+// analyzer measures the subtrees of an encoded root object.
+type analyzer struct {
+	maxDepth int
+	subtrees []*types.SubtreeSize
+}
+
+// walk returns the bytes of the tombstones of the given element, and records
+// the sizes of its live descendants up to the max depth.
+func (a *analyzer) walk(elem *api.JSONElement, path string, depth int) int64 {
+	var tombstoneBytes int64
+	switch body := elem.Body.(type) {
+	case *api.JSONElement_JsonObject:
+		for _, node := range body.JsonObject.Nodes {
+			tombstoneBytes += a.walkMember(node, node.Element, json.JoinPath(path, node.Key), depth+1)
+		}
+	case *api.JSONElement_JsonArray:
+		idx := 0
+		for _, node := range body.JsonArray.Nodes {
+			tombstoneBytes += a.walkMember(node, node.Element, json.JoinPath(path, strconv.Itoa(idx)), depth+1)
+			if !isRemoved(node.Element) {
+				idx++
+			}
+		}
+	case *api.JSONElement_Text_:
+		for _, node := range body.Text.Nodes {
+			if node.RemovedAt != nil {
+				tombstoneBytes += embeddedSize(node)
+			}
+		}
+	case *api.JSONElement_RichText_:
+		for _, node := range body.RichText.Nodes {
+			if node.RemovedAt != nil {
+				tombstoneBytes += embeddedSize(node)
+			}
+		}
+	case *api.JSONElement_Tree_:
+		for _, node := range body.Tree.Nodes {
+			if node.RemovedAt != nil {
+				tombstoneBytes += embeddedSize(node)
+			}
+		}
+	}
+
+	return tombstoneBytes
+}
+
+// walkMember returns the bytes of the tombstones of the given member of a
+// container, which is all of it if the member is removed, and records its
+// size if it is live and not deeper than the max depth.
+func (a *analyzer) walkMember(node proto.Sizer, elem *api.JSONElement, path string, depth int) int64 {
+	if isRemoved(elem) {
+		return embeddedSize(node)
+	}
+
+	tombstoneBytes := a.walk(elem, path, depth)
+	if depth <= a.maxDepth {
+		a.subtrees = append(a.subtrees, &types.SubtreeSize{
+			Path:           path,
+			Bytes:          embeddedSize(node),
+			TombstoneBytes: tombstoneBytes,
+		})
+	}
+	return tombstoneBytes
+}
+
+// isRemoved returns whether the given element is removed.
+func isRemoved(elem *api.JSONElement) bool {
+	switch body := elem.Body.(type) {
+	case *api.JSONElement_JsonObject:
+		return body.JsonObject.RemovedAt != nil
+	case *api.JSONElement_JsonArray:
+		return body.JsonArray.RemovedAt != nil
+	case *api.JSONElement_Primitive_:
+		return body.Primitive.RemovedAt != nil
+	case *api.JSONElement_Text_:
+		return body.Text.RemovedAt != nil
+	case *api.JSONElement_RichText_:
+		return body.RichText.RemovedAt != nil
+	case *api.JSONElement_Counter_:
+		return body.Counter.RemovedAt != nil
+	case *api.JSONElement_Tree_:
+		return body.Tree.RemovedAt != nil
+	}
+	return false
+}
+
+// embeddedSize returns the bytes of the given message embedded in its parent
+// with the tag and the length of the field.
+func embeddedSize(msg proto.Sizer) int64 {
+	size := msg.Size()
+	return int64(1 + proto.SizeVarint(uint64(size)) + size)
+}
@@ -45,4 +45,34 @@ var (
 	// ErrUnsupportedCounterType is returned when the given counter type is not
 	// supported yet.
 	ErrUnsupportedCounterType = errors.New("unsupported counter type")
+
+	// ErrInvalidTreeNodes is returned when the given tree nodes cannot be
+	// rebuilt into a tree.
+	ErrInvalidTreeNodes = errors.New("invalid tree nodes")
+
+	// ErrSnapshotVersionUnsupported is returned when the format version of the
+	// given snapshot is newer than this version understands.
+	ErrSnapshotVersionUnsupported = errors.New("snapshot version unsupported")
+
+	// ErrUnknownDatatype is returned when the given snapshot has an element
+	// of a datatype unknown to this version, e.g. written by a newer version.
+	ErrUnknownDatatype = errors.New("unknown datatype")
+
+	// ErrInvalidVersionVector is returned when the actors and the Lamport
+	// timestamps of the given version vector do not match.
+	ErrInvalidVersionVector = errors.New("invalid version vector")
+)
+
+const (
+	// SnapshotVersionV1 is the first format of snapshot. It is the encoding of
+	// the root object without the format version.
+	SnapshotVersionV1 int32 = 1
+
+	// SnapshotVersionV2 is the format of snapshot that embeds the format
+	// version and can contain Tree.
+	SnapshotVersionV2 int32 = 2
+
+	// CurrentSnapshotVersion is the format version of the snapshots encoded
+	// by this version.
+	CurrentSnapshotVersion = SnapshotVersionV2
 )
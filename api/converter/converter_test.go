@@ -18,15 +18,20 @@ package converter_test
 
 import (
 	"math"
+	"strings"
 	"testing"
 	gotime "time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
 	"github.com/yorkie-team/yorkie/pkg/document/proxy"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
@@ -111,6 +116,16 @@ func TestConverter(t *testing.T) {
 				Increase(10).
 				Increase(math.MaxInt64)
 
+			// a tree
+			root.SetNewTree("k6").
+				Edit(nil, 0, 0, proxy.TreeNode{
+					Type:     "p",
+					Children: []proxy.TreeNode{{Type: "text", Value: "hello"}},
+				}).
+				Edit(nil, 1, 1, proxy.TreeNode{Type: "p"}).
+				Edit(nil, 1, 2).
+				Style(nil, 0, 1, map[string]string{"b": "1"})
+
 			return nil
 		})
 		assert.NoError(t, err)
@@ -123,6 +138,128 @@ func TestConverter(t *testing.T) {
 		assert.Equal(t, doc.Marshal(), obj.Marshal())
 	})
 
+	t.Run("snapshot version test", func(t *testing.T) {
+		doc := document.New("d1")
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewText("k1").Edit(0, 0, "A")
+			root.SetNewCounter("k2", 0).Increase(1)
+			return nil
+		})
+		assert.NoError(t, err)
+
+		bytes, err := converter.ObjectToSnapshotBytes(doc.RootObject())
+		assert.NoError(t, err)
+		version, err := converter.SnapshotVersion(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, converter.CurrentSnapshotVersion, version)
+
+		// 00. The snapshot sent to clients is the encoding of JSONElement.
+		clientBytes, err := converter.ObjectToBytes(doc.RootObject())
+		assert.NoError(t, err)
+		pbElem := &api.JSONElement{}
+		assert.NoError(t, proto.Unmarshal(clientBytes, pbElem))
+		assert.NotNil(t, pbElem.GetJsonObject())
+
+		// 01. A v1 snapshot is the encoding of the root object without version.
+		pbSnapshot := &api.Snapshot{}
+		assert.NoError(t, proto.Unmarshal(bytes, pbSnapshot))
+		v1Bytes, err := proto.Marshal(&api.JSONElement{
+			Body: &api.JSONElement_JsonObject{JsonObject: pbSnapshot.Root},
+		})
+		assert.NoError(t, err)
+
+		version, err = converter.SnapshotVersion(v1Bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, converter.SnapshotVersionV1, version)
+
+		obj, err := converter.BytesToObject(v1Bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, doc.Marshal(), obj.Marshal())
+
+		// 02. A v1 snapshot is re-encoded in the current version.
+		upgraded, err := converter.ObjectToSnapshotBytes(obj)
+		assert.NoError(t, err)
+		version, err = converter.SnapshotVersion(upgraded)
+		assert.NoError(t, err)
+		assert.Equal(t, converter.CurrentSnapshotVersion, version)
+
+		// 03. A snapshot of a newer version should not be loaded.
+		pbSnapshot.Version = converter.CurrentSnapshotVersion + 1
+		newerBytes, err := proto.Marshal(pbSnapshot)
+		assert.NoError(t, err)
+		_, err = converter.BytesToObject(newerBytes)
+		assert.ErrorIs(t, err, converter.ErrSnapshotVersionUnsupported)
+		_, err = document.NewInternalDocumentFromSnapshot("d1", 0, 0, newerBytes)
+		assert.ErrorIs(t, err, converter.ErrSnapshotVersionUnsupported)
+	})
+
+	t.Run("snapshot compression test", func(t *testing.T) {
+		doc := document.New("d1")
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewText("k1").Edit(0, 0, strings.Repeat("A", 1000))
+			return nil
+		})
+		assert.NoError(t, err)
+
+		bytes, err := converter.ObjectToSnapshotBytes(doc.RootObject())
+		assert.NoError(t, err)
+
+		// 01. The uncompressed snapshot is kept as it is.
+		uncompressed, err := converter.CompressSnapshot(bytes, types.SnapshotCompressionNone)
+		assert.NoError(t, err)
+		assert.Equal(t, bytes, uncompressed)
+
+		// 02. The compressed snapshot is decoded like the uncompressed one.
+		compressed, err := converter.CompressSnapshot(bytes, types.SnapshotCompressionGzip)
+		assert.NoError(t, err)
+		assert.Less(t, len(compressed), len(bytes))
+
+		obj, err := converter.BytesToObject(compressed)
+		assert.NoError(t, err)
+		assert.Equal(t, doc.Marshal(), obj.Marshal())
+		version, err := converter.SnapshotVersion(compressed)
+		assert.NoError(t, err)
+		assert.Equal(t, converter.CurrentSnapshotVersion, version)
+	})
+
+	t.Run("unknown datatype test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			root.SetNewArray("k2").AddString("v2")
+			return nil
+		}))
+
+		bytes, err := converter.ObjectToBytes(doc.RootObject())
+		assert.NoError(t, err)
+		pbSnapshot := &api.Snapshot{}
+		assert.NoError(t, proto.Unmarshal(bytes, pbSnapshot))
+
+		// 01. Add an element of an unknown datatype marked with field 100,
+		// as if it is written by a newer version.
+		unknown := &api.JSONElement{XXX_unrecognized: []byte{0xa2, 0x06, 0x00}}
+		pbSnapshot.Root.Nodes = append(pbSnapshot.Root.Nodes, &api.RHTNode{
+			Key:     "k3",
+			Element: unknown,
+		})
+		pbArr := pbSnapshot.Root.Nodes[1].Element.Body.(*api.JSONElement_JsonArray).JsonArray
+		pbArr.Nodes = append(pbArr.Nodes, &api.RGANode{Element: unknown})
+		newerBytes, err := proto.Marshal(pbSnapshot)
+		assert.NoError(t, err)
+
+		// 02. The snapshot fails to be decoded by default.
+		_, err = converter.BytesToObject(newerBytes)
+		assert.ErrorIs(t, err, converter.ErrUnknownDatatype)
+		assert.ErrorContains(t, err, "field 100")
+		_, err = document.NewInternalDocumentFromSnapshot("d1", 0, 0, newerBytes)
+		assert.ErrorIs(t, err, converter.ErrUnknownDatatype)
+
+		// 03. The elements of the unknown datatype are skipped if requested.
+		obj, err := converter.BytesToObjectWithPolicy(newerBytes, converter.SkipUnknownDatatype)
+		assert.NoError(t, err)
+		assert.Equal(t, doc.Marshal(), obj.Marshal())
+	})
+
 	t.Run("change pack test", func(t *testing.T) {
 		d1 := document.New("d1")
 
@@ -171,6 +308,19 @@ func TestConverter(t *testing.T) {
 			// counter
 			root.SetNewCounter("k4", 0).Increase(5)
 
+			// tree
+			root.SetNewTree("k5").
+				Edit(nil, 0, 0, proxy.TreeNode{
+					Type:     "p",
+					Children: []proxy.TreeNode{{Type: "text", Value: "hello"}},
+				}).
+				Edit([]int{0}, 0, 1, proxy.TreeNode{Type: "text", Value: "world"}).
+				Edit(nil, 1, 1, proxy.TreeNode{
+					Type:       "h1",
+					Attributes: map[string]string{"a": "1"},
+				}).
+				Style(nil, 0, 2, map[string]string{"b": "1"})
+
 			return nil
 		})
 		assert.NoError(t, err)
@@ -189,6 +339,228 @@ func TestConverter(t *testing.T) {
 		assert.Equal(t, d1.Marshal(), d2.Marshal())
 	})
 
+	t.Run("change message test", func(t *testing.T) {
+		d1 := document.New("d1")
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("title", "hello")
+			return nil
+		}, "user renamed title"))
+
+		pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+		pack, err := converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+		assert.Equal(t, "user renamed title", pack.Changes[0].Message())
+
+		// A message larger than the maximum is rejected.
+		err = d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("title", "world")
+			return nil
+		}, strings.Repeat("a", change.MaxMessageBytes+1))
+		assert.ErrorIs(t, err, change.ErrMessageTooLarge)
+		assert.Equal(t, `{"title":"hello"}`, d1.Marshal())
+	})
+
+	t.Run("operation wall time test", func(t *testing.T) {
+		d1 := document.New("d1")
+		d1.SetRecordWallTime(true)
+
+		before := gotime.Now().UnixMilli()
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewText("k1").Edit(0, 0, "a")
+			return nil
+		}))
+		after := gotime.Now().UnixMilli()
+
+		pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+		pack, err := converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+
+		for _, op := range pack.Changes[0].Operations() {
+			assert.GreaterOrEqual(t, op.WallTime(), before)
+			assert.LessOrEqual(t, op.WallTime(), after)
+		}
+
+		// wall time is not recorded by default.
+		d2 := document.New("d2")
+		assert.NoError(t, d2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		pbPack, err = converter.ToChangePack(d2.CreateChangePack())
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), pbPack.Changes[0].Operations[0].WallTime)
+	})
+
+	t.Run("operation id test", func(t *testing.T) {
+		d1 := document.New("d1")
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		d1.CreateChangePack().Changes[0].Operations()[0].SetID("op-1")
+
+		pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+		pack, err := converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+
+		ops := pack.Changes[0].Operations()
+		assert.Equal(t, "op-1", ops[0].ID())
+		assert.Equal(t, "", ops[1].ID())
+	})
+
+	t.Run("object generation test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			root.SetString("k1", "v2")
+			return nil
+		}))
+
+		pbPack, err := converter.ToChangePack(doc.CreateChangePack())
+		assert.NoError(t, err)
+		pack, err := converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+		for i, op := range pack.Changes[0].Operations() {
+			assert.Equal(t, uint32(i), op.(*operations.Set).Generation())
+		}
+
+		bytes, err := converter.ObjectToBytes(doc.RootObject())
+		assert.NoError(t, err)
+		obj, err := converter.BytesToObject(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, doc.RootObject().NextGeneration("k1"), obj.NextGeneration("k1"))
+		assert.Equal(t, uint32(2), obj.NextGeneration("k1"))
+	})
+
+	t.Run("clear test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("k1").AddInteger(1, 2).Clear()
+			root.SetNewObject("k2").SetString("a", "b").Clear()
+			return nil
+		}))
+
+		pbPack, err := converter.ToChangePack(doc.CreateChangePack())
+		assert.NoError(t, err)
+		pack, err := converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+		ops := pack.Changes[0].Operations()
+		assert.IsType(t, &operations.Clear{}, ops[3])
+		assert.IsType(t, &operations.Clear{}, ops[6])
+
+		bytes, err := converter.ObjectToBytes(doc.RootObject())
+		assert.NoError(t, err)
+		obj, err := converter.BytesToObject(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":[],"k2":{}}`, obj.Marshal())
+		assert.Equal(t, ops[3].ExecutedAt(), obj.Get("k1").(*json.Array).ClearedAt())
+		assert.Equal(t, ops[6].ExecutedAt(), obj.Get("k2").(*json.Object).ClearedAt())
+	})
+
+	t.Run("exported element test", func(t *testing.T) {
+		actor1, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actor2, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		d1 := document.New("d1")
+		d1.SetActor(actor1)
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			root.SetNewArray("k2").AddInteger(1)
+			root.SetNewCounter("k3", 0)
+			root.SetDate("k4", gotime.Date(2022, 1, 1, 0, 0, 0, 0, gotime.UTC))
+			return nil
+		}))
+
+		d2 := document.New("d1")
+		d2.SetActor(actor2)
+		pack := change.NewPack(d1.Key(), change.InitialCheckpoint, d1.CreateChangePack().Changes, nil)
+		pack.MinSyncedTicket = time.InitialTicket
+		assert.NoError(t, d2.ApplyChangePack(pack))
+		assert.NoError(t, d2.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("k2").AddInteger(2)
+			root.GetCounter("k3").Increase(1)
+			return nil
+		}))
+
+		exported, err := converter.ToExportedElement(d2.RootObject())
+		assert.NoError(t, err)
+		assert.Equal(t, types.ExportedObject, exported.Type)
+
+		members := exported.Value.(map[string]*types.ExportedElement)
+		assert.Equal(t, types.ExportedPrimitive, members["k1"].Type)
+		assert.Equal(t, actor1.String(), members["k1"].ModifiedBy)
+		assert.Equal(t, members["k1"].CreatedAt, members["k1"].ModifiedAt)
+
+		for _, k := range []string{"k2", "k3"} {
+			assert.Equal(t, actor1.String(), members[k].CreatedAt[len(members[k].CreatedAt)-24:])
+			assert.Equal(t, actor2.String(), members[k].ModifiedBy)
+			assert.NotEqual(t, members[k].CreatedAt, members[k].ModifiedAt)
+		}
+		elements := members["k2"].Value.([]*types.ExportedElement)
+		assert.Len(t, elements, 2)
+		assert.Equal(t, actor1.String(), elements[0].ModifiedBy)
+		assert.Equal(t, actor2.String(), elements[1].ModifiedBy)
+
+		marshaled, err := exported.Marshal()
+		assert.NoError(t, err)
+		assert.Contains(t, marshaled, `"type":"counter"`)
+		assert.Contains(t, marshaled, `"value":"2022-01-01T00:00:00Z"`)
+	})
+
+	t.Run("large integer test", func(t *testing.T) {
+		// NOTE: 2^53+1 can not be represented as float64 exactly.
+		const large = int64(1<<53 + 1)
+
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetLong("k1", large)
+			root.SetNewArray("k2").AddLong(-large)
+			root.SetNewCounter("k3", large).Increase(1)
+			root.SetDouble("k4", 1.5)
+			return nil
+		}))
+		expected := `{"k1":9007199254740993,"k2":[-9007199254740993],"k3":9007199254740994,"k4":1.500000}`
+		assert.Equal(t, expected, doc.Marshal())
+
+		bytes, err := converter.ObjectToBytes(doc.RootObject())
+		assert.NoError(t, err)
+		obj, err := converter.BytesToObject(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, obj.Marshal())
+		assert.Equal(t, large, obj.Get("k1").(*json.Primitive).Value())
+		assert.Equal(t, json.LongCnt, obj.Get("k3").(*json.Counter).ValueType())
+
+		pbPack, err := converter.ToChangePack(doc.CreateChangePack())
+		assert.NoError(t, err)
+		pack, err := converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+		pack.MinSyncedTicket = time.InitialTicket
+		doc2 := document.New("d1")
+		assert.NoError(t, doc2.ApplyChangePack(pack))
+		assert.Equal(t, expected, doc2.Marshal())
+
+		exported, err := converter.ToExportedElement(doc2.RootObject())
+		assert.NoError(t, err)
+		members := exported.Value.(map[string]*types.ExportedElement)
+		assert.Equal(t, types.ExportedLong, members["k1"].ValueType)
+		assert.Equal(t, types.ExportedLong, members["k2"].Value.([]*types.ExportedElement)[0].ValueType)
+		assert.Equal(t, types.ExportedLong, members["k3"].ValueType)
+		assert.Equal(t, types.ExportedDouble, members["k4"].ValueType)
+		assert.Equal(t, types.ExportedObject, exported.Type)
+		assert.Empty(t, exported.ValueType)
+
+		marshaled, err := exported.Marshal()
+		assert.NoError(t, err)
+		assert.Contains(t, marshaled, `"value":9007199254740993`)
+		assert.Contains(t, marshaled, `"value":9007199254740994`)
+	})
+
 	t.Run("change pack error test", func(t *testing.T) {
 		_, err := converter.FromChangePack(nil)
 		assert.ErrorIs(t, err, converter.ErrPackRequired)
@@ -197,6 +569,30 @@ func TestConverter(t *testing.T) {
 		assert.ErrorIs(t, err, converter.ErrCheckpointRequired)
 	})
 
+	t.Run("version vector test", func(t *testing.T) {
+		actor1, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actor2, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		vector := time.NewVersionVector()
+		vector.Set(actor1, 3)
+		vector.Set(actor2, 5)
+		vector.Set(actor1, 2)
+
+		bytes, err := converter.VersionVectorToBytes(vector)
+		assert.NoError(t, err)
+		decoded, err := converter.BytesToVersionVector(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, vector, decoded)
+		assert.Equal(t, uint64(3), decoded.Get(actor1))
+
+		_, err = converter.FromVersionVector(&api.VersionVector{
+			ActorIds: [][]byte{actor1.Bytes()},
+		})
+		assert.ErrorIs(t, err, converter.ErrInvalidVersionVector)
+	})
+
 	t.Run("client test", func(t *testing.T) {
 		cli := types.Client{
 			ID: time.InitialActorID,
@@ -222,3 +618,63 @@ func TestConverter(t *testing.T) {
 		assert.Equal(t, cli.PresenceInfo, decodedCli.PresenceInfo)
 	})
 }
+
+func TestAnalyzeObject(t *testing.T) {
+	doc := document.New("d1")
+	assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+		root.SetNewArray("todos").AddString("a", "b", "c")
+		root.SetNewText("note").Edit(0, 0, "hello world")
+		root.SetNewObject("settings").SetNewObject("theme").SetString("color", "red")
+		return nil
+	}))
+	assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+		root.GetArray("todos").Delete(0)
+		root.GetText("note").Edit(0, 6, "")
+		return nil
+	}))
+
+	t.Run("analyze top-level subtrees test", func(t *testing.T) {
+		snapshot, err := converter.ObjectToSnapshotBytes(doc.RootObject())
+		assert.NoError(t, err)
+
+		analysis, err := converter.AnalyzeObject(doc.RootObject(), 1)
+		assert.NoError(t, err)
+		assert.False(t, analysis.Truncated)
+		assert.Len(t, analysis.Subtrees, 4)
+
+		sizes := make(map[string]*types.SubtreeSize)
+		for _, subtree := range analysis.Subtrees {
+			sizes[subtree.Path] = subtree
+		}
+
+		// the root is the whole snapshot, and the first one.
+		root := analysis.Subtrees[0]
+		assert.Equal(t, json.RootPath, root.Path)
+		assert.Equal(t, int64(len(snapshot)), root.Bytes)
+		assert.Equal(t, sizes["$.todos"].TombstoneBytes+sizes["$.note"].TombstoneBytes, root.TombstoneBytes)
+		assert.Less(t, sizes["$.todos"].Bytes+sizes["$.note"].Bytes+sizes["$.settings"].Bytes, root.Bytes)
+
+		// the removed element and text nodes are tombstones.
+		assert.Positive(t, sizes["$.todos"].TombstoneBytes)
+		assert.Positive(t, sizes["$.note"].TombstoneBytes)
+		assert.Zero(t, sizes["$.settings"].TombstoneBytes)
+		assert.Positive(t, sizes["$.todos"].LiveBytes())
+	})
+
+	t.Run("analyze subtrees up to depth test", func(t *testing.T) {
+		analysis, err := converter.AnalyzeObject(doc.RootObject(), 2)
+		assert.NoError(t, err)
+
+		var paths []string
+		for _, subtree := range analysis.Subtrees {
+			paths = append(paths, subtree.Path)
+		}
+		assert.ElementsMatch(t, []string{
+			"$", "$.todos", "$.todos.0", "$.todos.1", "$.note", "$.settings", "$.settings.theme",
+		}, paths)
+
+		analysis, err = converter.AnalyzeObject(doc.RootObject(), 0)
+		assert.NoError(t, err)
+		assert.Len(t, analysis.Subtrees, 1)
+	})
+}
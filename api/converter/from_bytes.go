@@ -17,7 +17,11 @@
 package converter
 
 import (
+	"bytes"
+	"compress/gzip"
+	"errors"
 	"fmt"
+	"io"
 
 	"github.com/gogo/protobuf/proto"
 
@@ -26,18 +30,43 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
-// BytesToObject creates an Object from the given byte array.
+// UnknownDatatypePolicy is the policy to decode the elements of snapshots whose
+// datatypes are unknown to this version, e.g. the ones written by a newer
+// version before a rollback of the cluster.
+type UnknownDatatypePolicy int
+
+const (
+	// FailOnUnknownDatatype fails decoding with ErrUnknownDatatype. It is the
+	// default, since the skipped elements would be lost once the document is
+	// snapshotted again.
+	FailOnUnknownDatatype UnknownDatatypePolicy = iota
+
+	// SkipUnknownDatatype decodes snapshots without the elements of unknown
+	// datatypes. It should only be used to inspect the snapshots.
+	SkipUnknownDatatype
+)
+
+// BytesToObject creates an Object from the given byte array. It returns
+// ErrSnapshotVersionUnsupported if the snapshot is encoded in a newer format
+// than CurrentSnapshotVersion, and ErrUnknownDatatype if the snapshot has an
+// element of an unknown datatype.
 func BytesToObject(snapshot []byte) (*json.Object, error) {
+	return BytesToObjectWithPolicy(snapshot, FailOnUnknownDatatype)
+}
+
+// BytesToObjectWithPolicy creates an Object from the given byte array with
+// the given policy for the elements of unknown datatypes.
+func BytesToObjectWithPolicy(snapshot []byte, policy UnknownDatatypePolicy) (*json.Object, error) {
 	if snapshot == nil {
 		return json.NewObject(json.NewRHTPriorityQueueMap(), time.InitialTicket), nil
 	}
 
-	pbElem := &api.JSONElement{}
-	if err := proto.Unmarshal(snapshot, pbElem); err != nil {
+	pbSnapshot, err := bytesToSnapshot(snapshot)
+	if err != nil {
 		return nil, err
 	}
 
-	obj, err := fromJSONObject(pbElem.GetJsonObject())
+	obj, err := fromJSONObject(pbSnapshot.Root, policy)
 	if err != nil {
 		return nil, err
 	}
@@ -45,12 +74,89 @@ func BytesToObject(snapshot []byte) (*json.Object, error) {
 	return obj, nil
 }
 
-func fromJSONElement(pbElem *api.JSONElement) (json.Element, error) {
+// SnapshotVersion returns the format version of the given snapshot.
+func SnapshotVersion(snapshot []byte) (int32, error) {
+	if snapshot == nil {
+		return CurrentSnapshotVersion, nil
+	}
+
+	pbSnapshot, err := bytesToSnapshot(snapshot)
+	if err != nil {
+		return 0, err
+	}
+
+	return pbSnapshot.Version, nil
+}
+
+// BytesToVersionVector creates a version vector from the given byte array.
+func BytesToVersionVector(bytes []byte) (time.VersionVector, error) {
+	pbVector := &api.VersionVector{}
+	if err := proto.Unmarshal(bytes, pbVector); err != nil {
+		return nil, err
+	}
+
+	return FromVersionVector(pbVector)
+}
+
+// bytesToSnapshot decodes the given snapshot and validates its version.
+// Snapshots of the first format have no version field, so they are regarded
+// as SnapshotVersionV1.
+func bytesToSnapshot(snapshot []byte) (*api.Snapshot, error) {
+	if isCompressedSnapshot(snapshot) {
+		decompressed, err := decompressSnapshot(snapshot)
+		if err != nil {
+			return nil, err
+		}
+		snapshot = decompressed
+	}
+
+	pbSnapshot := &api.Snapshot{}
+	if err := proto.Unmarshal(snapshot, pbSnapshot); err != nil {
+		return nil, err
+	}
+
+	if pbSnapshot.Version == 0 {
+		pbSnapshot.Version = SnapshotVersionV1
+	}
+	if pbSnapshot.Version > CurrentSnapshotVersion {
+		return nil, fmt.Errorf("%d: %w", pbSnapshot.Version, ErrSnapshotVersionUnsupported)
+	}
+
+	return pbSnapshot, nil
+}
+
+// isCompressedSnapshot returns whether the given snapshot is compressed with
+// gzip.
+//
+// NOTE: A snapshot encoded in Protobuf never starts with the magic number of
+// gzip, since its first byte would be a tag of the invalid wire type 7.
+func isCompressedSnapshot(snapshot []byte) bool {
+	return len(snapshot) >= 2 && snapshot[0] == 0x1f && snapshot[1] == 0x8b
+}
+
+// decompressSnapshot decompresses the given snapshot compressed with gzip.
+func decompressSnapshot(snapshot []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(snapshot))
+	if err != nil {
+		return nil, fmt.Errorf("decompress snapshot: %w", err)
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	decompressed, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("decompress snapshot: %w", err)
+	}
+	return decompressed, nil
+}
+
+func fromJSONElement(pbElem *api.JSONElement, policy UnknownDatatypePolicy) (json.Element, error) {
 	switch decoded := pbElem.Body.(type) {
 	case *api.JSONElement_JsonObject:
-		return fromJSONObject(decoded.JsonObject)
+		return fromJSONObject(decoded.JsonObject, policy)
 	case *api.JSONElement_JsonArray:
-		return fromJSONArray(decoded.JsonArray)
+		return fromJSONArray(decoded.JsonArray, policy)
 	case *api.JSONElement_Primitive_:
 		return fromJSONPrimitive(decoded.Primitive)
 	case *api.JSONElement_Text_:
@@ -59,19 +165,38 @@ func fromJSONElement(pbElem *api.JSONElement) (json.Element, error) {
 		return fromJSONRichText(decoded.RichText)
 	case *api.JSONElement_Counter_:
 		return fromJSONCounter(decoded.Counter)
+	case *api.JSONElement_Tree_:
+		return fromJSONTree(decoded.Tree)
+	case nil:
+		// NOTE: The body of a datatype added in a newer version is kept in the
+		// unrecognized fields of the element by the decoder.
+		if len(pbElem.XXX_unrecognized) > 0 {
+			tag, _ := proto.DecodeVarint(pbElem.XXX_unrecognized)
+			return nil, fmt.Errorf("field %d: %w", tag>>3, ErrUnknownDatatype)
+		}
+		return nil, fmt.Errorf("empty element: %w", ErrUnsupportedElement)
 	default:
 		return nil, fmt.Errorf("%s: %w", decoded, ErrUnsupportedElement)
 	}
 }
 
-func fromJSONObject(pbObj *api.JSONElement_JSONObject) (*json.Object, error) {
+// skipsElement returns whether the element which failed to be decoded with the
+// given error should be skipped under the given policy.
+func skipsElement(err error, policy UnknownDatatypePolicy) bool {
+	return policy == SkipUnknownDatatype && errors.Is(err, ErrUnknownDatatype)
+}
+
+func fromJSONObject(pbObj *api.JSONElement_JSONObject, policy UnknownDatatypePolicy) (*json.Object, error) {
 	members := json.NewRHTPriorityQueueMap()
 	for _, pbNode := range pbObj.Nodes {
-		elem, err := fromJSONElement(pbNode.Element)
+		elem, err := fromJSONElement(pbNode.Element, policy)
+		if skipsElement(err, policy) {
+			continue
+		}
 		if err != nil {
 			return nil, err
 		}
-		members.SetInternal(pbNode.Key, elem)
+		members.SetInternal(pbNode.Key, elem, pbNode.Generation)
 	}
 
 	createdAt, err := fromTimeTicket(pbObj.CreatedAt)
@@ -89,20 +214,29 @@ func fromJSONObject(pbObj *api.JSONElement_JSONObject) (*json.Object, error) {
 		return nil, err
 	}
 
+	clearedAt, err := fromTimeTicket(pbObj.ClearedAt)
+	if err != nil {
+		return nil, err
+	}
+
 	obj := json.NewObject(
 		members,
 		createdAt,
 	)
 	obj.SetMovedAt(movedAt)
 	obj.SetRemovedAt(removedAt)
+	obj.SetClearedAt(clearedAt)
 
 	return obj, nil
 }
 
-func fromJSONArray(pbArr *api.JSONElement_JSONArray) (*json.Array, error) {
+func fromJSONArray(pbArr *api.JSONElement_JSONArray, policy UnknownDatatypePolicy) (*json.Array, error) {
 	elements := json.NewRGATreeList()
 	for _, pbNode := range pbArr.Nodes {
-		elem, err := fromJSONElement(pbNode.Element)
+		elem, err := fromJSONElement(pbNode.Element, policy)
+		if skipsElement(err, policy) {
+			continue
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -121,6 +255,10 @@ func fromJSONArray(pbArr *api.JSONElement_JSONArray) (*json.Array, error) {
 	if err != nil {
 		return nil, err
 	}
+	clearedAt, err := fromTimeTicket(pbArr.ClearedAt)
+	if err != nil {
+		return nil, err
+	}
 
 	arr := json.NewArray(
 		elements,
@@ -128,6 +266,7 @@ func fromJSONArray(pbArr *api.JSONElement_JSONArray) (*json.Array, error) {
 	)
 	arr.SetMovedAt(movedAt)
 	arr.SetRemovedAt(removedAt)
+	arr.SetClearedAt(clearedAt)
 	return arr, nil
 }
 
@@ -267,6 +406,10 @@ func fromJSONCounter(pbCnt *api.JSONElement_Counter) (*json.Counter, error) {
 	if err != nil {
 		return nil, err
 	}
+	increasedAt, err := fromTimeTicket(pbCnt.IncreasedAt)
+	if err != nil {
+		return nil, err
+	}
 	counterType, err := fromCounterType(pbCnt.Type)
 	if err != nil {
 		return nil, err
@@ -278,10 +421,40 @@ func fromJSONCounter(pbCnt *api.JSONElement_Counter) (*json.Counter, error) {
 	)
 	counter.SetMovedAt(movedAt)
 	counter.SetRemovedAt(removedAt)
+	counter.SetIncreasedAt(increasedAt)
 
 	return counter, nil
 }
 
+func fromJSONTree(pbTree *api.JSONElement_Tree) (*json.Tree, error) {
+	createdAt, err := fromTimeTicket(pbTree.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	movedAt, err := fromTimeTicket(pbTree.MovedAt)
+	if err != nil {
+		return nil, err
+	}
+	removedAt, err := fromTimeTicket(pbTree.RemovedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	roots, err := fromTreeNodes(pbTree.Nodes)
+	if err != nil {
+		return nil, err
+	}
+	if len(roots) != 1 {
+		return nil, fmt.Errorf("%d roots: %w", len(roots), ErrInvalidTreeNodes)
+	}
+
+	tree := json.NewTree(roots[0], createdAt)
+	tree.SetMovedAt(movedAt)
+	tree.SetRemovedAt(removedAt)
+
+	return tree, nil
+}
+
 func fromTextNode(pbTextNode *api.TextNode) (*json.RGATreeSplitNode[*json.TextValue], error) {
 	id, err := fromTextNodeID(pbTextNode.Id)
 	if err != nil {
@@ -18,6 +18,7 @@ package converter
 
 import (
 	"fmt"
+	gotime "time"
 
 	protoTypes "github.com/gogo/protobuf/types"
 
@@ -55,14 +56,31 @@ func FromProject(pbProject *api.Project) (*types.Project, error) {
 		return nil, err
 	}
 	return &types.Project{
-		ID:                 types.ID(pbProject.Id),
-		Name:               pbProject.Name,
-		AuthWebhookURL:     pbProject.AuthWebhookUrl,
-		AuthWebhookMethods: pbProject.AuthWebhookMethods,
-		PublicKey:          pbProject.PublicKey,
-		SecretKey:          pbProject.SecretKey,
-		CreatedAt:          createdAt,
-		UpdatedAt:          updatedAt,
+		ID:                       types.ID(pbProject.Id),
+		Name:                     pbProject.Name,
+		AuthWebhookURL:           pbProject.AuthWebhookUrl,
+		AuthWebhookMethods:       pbProject.AuthWebhookMethods,
+		PublicKey:                pbProject.PublicKey,
+		SecretKey:                pbProject.SecretKey,
+		DocumentKeyPolicy:        fromDocumentKeyPolicy(pbProject.DocumentKeyPolicy),
+		CollectApplyLag:          pbProject.CollectApplyLag,
+		InitialContent:           pbProject.InitialContent,
+		ObjectMergePolicy:        pbProject.ObjectMergePolicy,
+		EventWebhookURL:          pbProject.EventWebhookUrl,
+		EphemeralKeyPrefix:       pbProject.EphemeralKeyPrefix,
+		ChangefeedURL:            pbProject.ChangefeedUrl,
+		Features:                 pbProject.Features,
+		DocumentTemplates:        pbProject.DocumentTemplates,
+		ExplicitDocumentCreation: pbProject.ExplicitDocumentCreation,
+		ActorIDPolicy:            pbProject.ActorIdPolicy,
+		AllowedOperations:        pbProject.AllowedOperations,
+		SnapshotPolicy:           fromSnapshotPolicy(pbProject.SnapshotPolicy),
+		MaxKeysPerObject:         int(pbProject.MaxKeysPerObject),
+		RemovedDocumentPolicy:    pbProject.RemovedDocumentPolicy,
+		ArchiveAfter:             pbProject.ArchiveAfter,
+		DocumentCount:            int(pbProject.DocumentCount),
+		CreatedAt:                createdAt,
+		UpdatedAt:                updatedAt,
 	}, nil
 }
 
@@ -93,13 +111,231 @@ func FromDocumentSummary(pbSummary *api.DocumentSummary) (*types.DocumentSummary
 	if err != nil {
 		return nil, err
 	}
-	return &types.DocumentSummary{
-		ID:         types.ID(pbSummary.Id),
-		Key:        key.Key(pbSummary.Key),
-		CreatedAt:  createdAt,
-		AccessedAt: accessedAt,
-		UpdatedAt:  updatedAt,
-		Snapshot:   pbSummary.Snapshot,
+	summary := &types.DocumentSummary{
+		ID:              types.ID(pbSummary.Id),
+		Key:             key.Key(pbSummary.Key),
+		CreatedAt:       createdAt,
+		AccessedAt:      accessedAt,
+		UpdatedAt:       updatedAt,
+		Snapshot:        pbSummary.Snapshot,
+		Metadata:        pbSummary.Metadata,
+		OperationCounts: pbSummary.OperationCounts,
+	}
+	if pbSummary.ArchivedAt != nil {
+		archivedAt, err := protoTypes.TimestampFromProto(pbSummary.ArchivedAt)
+		if err != nil {
+			return nil, err
+		}
+		summary.ArchivedAt = archivedAt
+	}
+	if pbSummary.RemovedAt != nil {
+		removedAt, err := protoTypes.TimestampFromProto(pbSummary.RemovedAt)
+		if err != nil {
+			return nil, err
+		}
+		summary.RemovedAt = removedAt
+	}
+
+	return summary, nil
+}
+
+// FromDocumentClientEvents converts the given Protobuf formats to model format.
+func FromDocumentClientEvents(pbEvents []*api.DocumentClientEvent) ([]*types.DocumentClientEvent, error) {
+	var events []*types.DocumentClientEvent
+	for _, pbEvent := range pbEvents {
+		event, err := FromDocumentClientEvent(pbEvent)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// FromDocumentClientEvent converts the given Protobuf formats to model format.
+func FromDocumentClientEvent(pbEvent *api.DocumentClientEvent) (*types.DocumentClientEvent, error) {
+	createdAt, err := protoTypes.TimestampFromProto(pbEvent.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	var eventType types.DocumentClientEventType
+	switch pbEvent.Type {
+	case api.DocumentClientEventType_DOCUMENT_ATTACHED:
+		eventType = types.DocumentAttachedByClientEvent
+	case api.DocumentClientEventType_DOCUMENT_DETACHED:
+		eventType = types.DocumentDetachedByClientEvent
+	case api.DocumentClientEventType_DOCUMENT_OWNERSHIP_TRANSFERRED:
+		eventType = types.DocumentOwnershipTransferredByServerEvent
+	case api.DocumentClientEventType_DOCUMENT_DETACHED_BY_SERVER:
+		eventType = types.DocumentDetachedByServerEvent
+	default:
+		return nil, fmt.Errorf("%v: %w", pbEvent.Type, ErrUnsupportedEventType)
+	}
+
+	return &types.DocumentClientEvent{
+		ID:        types.ID(pbEvent.Id),
+		ClientID:  types.ID(pbEvent.ClientId),
+		Type:      eventType,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+// FromRejection converts the given Protobuf format to model format.
+func FromRejection(pbRejection *api.Rejection) (*types.Rejection, error) {
+	rejectedAt, err := protoTypes.TimestampFromProto(pbRejection.RejectedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.Rejection{
+		DocumentKey: key.Key(pbRejection.DocumentKey),
+		ClientID:    types.ID(pbRejection.ClientId),
+		Reason:      types.RejectionReason(pbRejection.Reason),
+		Message:     pbRejection.Message,
+		Changes:     int(pbRejection.Changes),
+		RejectedAt:  rejectedAt,
+		Dropped:     int(pbRejection.Dropped),
+	}, nil
+}
+
+// FromProjectStatsUpdate converts the given Protobuf formats to model format.
+func FromProjectStatsUpdate(pbResp *api.WatchProjectStatsResponse) (*types.ProjectStatsUpdate, error) {
+	update := &types.ProjectStatsUpdate{}
+	if pbResp.Event != nil {
+		update.Event = &types.ProjectStatsEvent{
+			Type:        types.ProjectStatsEventType(pbResp.Event.Type),
+			DocumentKey: key.Key(pbResp.Event.DocumentKey),
+		}
+	}
+
+	if pbStats := pbResp.Stats; pbStats != nil {
+		measuredAt, err := protoTypes.TimestampFromProto(pbStats.MeasuredAt)
+		if err != nil {
+			return nil, err
+		}
+
+		var conflictWins []*types.ActorConflictWins
+		for _, pbWins := range pbStats.ConflictWins {
+			conflictWins = append(conflictWins, &types.ActorConflictWins{
+				Actor: pbWins.Actor,
+				Wins:  int(pbWins.Wins),
+			})
+		}
+
+		update.Stats = types.LiveProjectStats{
+			ActiveDocuments: int(pbStats.ActiveDocuments),
+			AttachedClients: int(pbStats.AttachedClients),
+			OpsPerSecond:    pbStats.OpsPerSecond,
+			HotDocuments:    int(pbStats.HotDocuments),
+			ConflictWins:    conflictWins,
+			MeasuredAt:      measuredAt,
+		}
+	}
+
+	return update, nil
+}
+
+// FromDocumentEventLogs converts the given Protobuf formats to model format.
+func FromDocumentEventLogs(pbLogs []*api.DocumentEventLog) ([]*types.DocumentEventLog, error) {
+	var logs []*types.DocumentEventLog
+	for _, pbLog := range pbLogs {
+		vector, err := FromVersionVector(pbLog.VersionVector)
+		if err != nil {
+			return nil, err
+		}
+
+		createdAt, err := protoTypes.TimestampFromProto(pbLog.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		logs = append(logs, &types.DocumentEventLog{
+			ServerSeq:      pbLog.ServerSeq,
+			ActorID:        types.ID(pbLog.ActorId),
+			ClientSeq:      pbLog.ClientSeq,
+			Lamport:        pbLog.Lamport,
+			OperationCount: int(pbLog.OperationCount),
+			VersionVector:  vector,
+			Fingerprint:    pbLog.Fingerprint,
+			CreatedAt:      createdAt,
+		})
+	}
+	return logs, nil
+}
+
+// FromSnapshotMetas converts the given Protobuf formats to model format.
+func FromSnapshotMetas(pbMetas []*api.SnapshotMeta) ([]*types.SnapshotMeta, error) {
+	var metas []*types.SnapshotMeta
+	for _, pbMeta := range pbMetas {
+		createdAt, err := protoTypes.TimestampFromProto(pbMeta.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		metas = append(metas, &types.SnapshotMeta{
+			ServerSeq:  pbMeta.ServerSeq,
+			Lamport:    pbMeta.Lamport,
+			GarbageLen: int(pbMeta.GarbageLen),
+			CreatedAt:  createdAt,
+		})
+	}
+	return metas, nil
+}
+
+// FromProjectStats converts the given Protobuf formats to model format.
+func FromProjectStats(pbStats *api.ProjectStats) (*types.ProjectStats, error) {
+	var wins []*types.ActorConflictWins
+	for _, pbWins := range pbStats.ConflictWins {
+		wins = append(wins, &types.ActorConflictWins{
+			Actor: pbWins.Actor,
+			Wins:  int(pbWins.Wins),
+		})
+	}
+
+	var hotDocs []*types.HotDocument
+	for _, pbDoc := range pbStats.HotDocuments {
+		detectedAt, err := protoTypes.TimestampFromProto(pbDoc.DetectedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		hotDocs = append(hotDocs, &types.HotDocument{
+			DocumentKey:          key.Key(pbDoc.DocumentKey),
+			OpsPerSecond:         pbDoc.OpsPerSecond,
+			TopActorID:           types.ID(pbDoc.TopActorId),
+			TopActorOpsPerSecond: pbDoc.TopActorOpsPerSecond,
+			DetectedAt:           detectedAt,
+		})
+	}
+
+	var queues []*types.BackgroundQueue
+	for _, pbQueue := range pbStats.BackgroundQueues {
+		queues = append(queues, &types.BackgroundQueue{
+			Type:      pbQueue.Type,
+			Depth:     int(pbQueue.Depth),
+			OldestAge: gotime.Duration(pbQueue.OldestAgeSeconds * float64(gotime.Second)),
+		})
+	}
+
+	return &types.ProjectStats{
+		ConflictWins:     wins,
+		HotDocuments:     hotDocs,
+		BackgroundQueues: queues,
+	}, nil
+}
+
+// FromMaintenance converts the given Protobuf formats to model format.
+func FromMaintenance(pbMaintenance *api.Maintenance) (*types.Maintenance, error) {
+	updatedAt, err := protoTypes.TimestampFromProto(pbMaintenance.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.Maintenance{
+		Enabled:   pbMaintenance.Enabled,
+		Message:   pbMaintenance.Message,
+		UpdatedAt: updatedAt,
 	}, nil
 }
 
@@ -149,6 +385,7 @@ func FromChangePack(pbPack *api.ChangePack) (*change.Pack, error) {
 		Changes:         changes,
 		Snapshot:        pbPack.Snapshot,
 		MinSyncedTicket: minSyncedTicket,
+		DeferredChanges: pbPack.DeferredChanges,
 	}, nil
 }
 
@@ -159,6 +396,29 @@ func fromCheckpoint(pbCheckpoint *api.Checkpoint) change.Checkpoint {
 	)
 }
 
+// FromVersionVector converts the given Protobuf format to model format.
+func FromVersionVector(pbVector *api.VersionVector) (time.VersionVector, error) {
+	if len(pbVector.ActorIds) != len(pbVector.Lamports) {
+		return nil, fmt.Errorf(
+			"%d actors, %d lamports: %w",
+			len(pbVector.ActorIds),
+			len(pbVector.Lamports),
+			ErrInvalidVersionVector,
+		)
+	}
+
+	vector := time.NewVersionVector()
+	for i, bytes := range pbVector.ActorIds {
+		actorID, err := time.ActorIDFromBytes(bytes)
+		if err != nil {
+			return nil, err
+		}
+		vector.Set(actorID, pbVector.Lamports[i])
+	}
+
+	return vector, nil
+}
+
 // FromChanges converts the given Protobuf formats to model format.
 func FromChanges(pbChanges []*api.Change) ([]*change.Change, error) {
 	var changes []*change.Change
@@ -214,6 +474,8 @@ func FromEventType(pbDocEventType api.DocEventType) (types.DocEventType, error)
 		return types.DocumentsUnwatchedEvent, nil
 	case api.DocEventType_PRESENCE_CHANGED:
 		return types.PresenceChangedEvent, nil
+	case api.DocEventType_SUBTREE_REMOVED:
+		return types.SubtreeRemovedEvent, nil
 	}
 	return "", fmt.Errorf("%v: %w", pbDocEventType, ErrUnsupportedEventType)
 }
@@ -234,6 +496,9 @@ func FromDocEvent(docEvent *api.DocEvent) (*sync.DocEvent, error) {
 		Type:         eventType,
 		Publisher:    *client,
 		DocumentKeys: FromDocumentKeys(docEvent.DocumentKeys),
+		ChangedPaths: docEvent.ChangedPaths,
+		RemovedPaths: docEvent.RemovedPaths,
+		ServerSeq:    docEvent.ServerSeq,
 	}, nil
 }
 
@@ -276,12 +541,20 @@ func FromOperations(pbOps []*api.Operation) ([]operations.Operation, error) {
 			op, err = fromStyle(decoded.Style)
 		case *api.Operation_Increase_:
 			op, err = fromIncrease(decoded.Increase)
+		case *api.Operation_TreeEdit_:
+			op, err = fromTreeEdit(decoded.TreeEdit)
+		case *api.Operation_TreeStyle_:
+			op, err = fromTreeStyle(decoded.TreeStyle)
+		case *api.Operation_Clear_:
+			op, err = fromClear(decoded.Clear)
 		default:
 			return nil, ErrUnsupportedOperation
 		}
 		if err != nil {
 			return nil, err
 		}
+		op.SetWallTime(pbOp.WallTime)
+		op.SetID(pbOp.Id)
 		ops = append(ops, op)
 	}
 
@@ -306,6 +579,7 @@ func fromSet(pbSet *api.Operation_Set) (*operations.Set, error) {
 		parentCreatedAt,
 		pbSet.Key,
 		elem,
+		pbSet.Generation,
 		executedAt,
 	), nil
 }
@@ -380,6 +654,21 @@ func fromRemove(pbRemove *api.Operation_Remove) (*operations.Remove, error) {
 	), nil
 }
 
+func fromClear(pbClear *api.Operation_Clear) (*operations.Clear, error) {
+	parentCreatedAt, err := fromTimeTicket(pbClear.ParentCreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	executedAt, err := fromTimeTicket(pbClear.ExecutedAt)
+	if err != nil {
+		return nil, err
+	}
+	return operations.NewClear(
+		parentCreatedAt,
+		executedAt,
+	), nil
+}
+
 func fromEdit(pbEdit *api.Operation_Edit) (*operations.Edit, error) {
 	parentCreatedAt, err := fromTimeTicket(pbEdit.ParentCreatedAt)
 	if err != nil {
@@ -518,6 +807,76 @@ func fromIncrease(pbInc *api.Operation_Increase) (*operations.Increase, error) {
 	), nil
 }
 
+func fromTreeEdit(pbTreeEdit *api.Operation_TreeEdit) (*operations.TreeEdit, error) {
+	parentCreatedAt, err := fromTimeTicket(pbTreeEdit.ParentCreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	from, err := fromTreePos(pbTreeEdit.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := fromTreePos(pbTreeEdit.To)
+	if err != nil {
+		return nil, err
+	}
+	createdAtMapByActor, err := fromCreatedAtMapByActor(
+		pbTreeEdit.CreatedAtMapByActor,
+	)
+	if err != nil {
+		return nil, err
+	}
+	contents, err := fromTreeNodes(pbTreeEdit.Contents)
+	if err != nil {
+		return nil, err
+	}
+	executedAt, err := fromTimeTicket(pbTreeEdit.ExecutedAt)
+	if err != nil {
+		return nil, err
+	}
+	return operations.NewTreeEdit(
+		parentCreatedAt,
+		from,
+		to,
+		createdAtMapByActor,
+		contents,
+		executedAt,
+	), nil
+}
+
+func fromTreeStyle(pbStyle *api.Operation_TreeStyle) (*operations.TreeStyle, error) {
+	parentCreatedAt, err := fromTimeTicket(pbStyle.ParentCreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	from, err := fromTreePos(pbStyle.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := fromTreePos(pbStyle.To)
+	if err != nil {
+		return nil, err
+	}
+	createdAtMapByActor, err := fromCreatedAtMapByActor(
+		pbStyle.CreatedAtMapByActor,
+	)
+	if err != nil {
+		return nil, err
+	}
+	executedAt, err := fromTimeTicket(pbStyle.ExecutedAt)
+	if err != nil {
+		return nil, err
+	}
+	return operations.NewTreeStyle(
+		parentCreatedAt,
+		from,
+		to,
+		createdAtMapByActor,
+		pbStyle.Attributes,
+		executedAt,
+	), nil
+}
+
 func fromCreatedAtMapByActor(
 	pbCreatedAtMapByActor map[string]*api.TimeTicket,
 ) (map[string]*time.Ticket, error) {
@@ -545,6 +904,68 @@ func fromTextNodePos(
 	), nil
 }
 
+func fromTreePos(pbPos *api.TreePos) (*json.TreePos, error) {
+	parentCreatedAt, err := fromTimeTicket(pbPos.ParentCreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	leftSiblingCreatedAt, err := fromTimeTicket(pbPos.LeftSiblingCreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return json.NewTreePos(parentCreatedAt, leftSiblingCreatedAt), nil
+}
+
+// fromTreeNodes rebuilds the subtrees from the given list of nodes in
+// pre-order with the depth.
+func fromTreeNodes(pbNodes []*api.TreeNode) ([]*json.TreeNode, error) {
+	var roots []*json.TreeNode
+	var stack []*json.TreeNode
+	for _, pbNode := range pbNodes {
+		node, err := fromTreeNode(pbNode)
+		if err != nil {
+			return nil, err
+		}
+
+		depth := int(pbNode.Depth)
+		if depth > len(stack) {
+			return nil, fmt.Errorf("depth %d: %w", depth, ErrInvalidTreeNodes)
+		}
+
+		stack = stack[:depth]
+		if depth == 0 {
+			roots = append(roots, node)
+		} else {
+			stack[depth-1].Append(node)
+		}
+		stack = append(stack, node)
+	}
+
+	return roots, nil
+}
+
+func fromTreeNode(pbNode *api.TreeNode) (*json.TreeNode, error) {
+	id, err := fromTimeTicket(pbNode.Id)
+	if err != nil {
+		return nil, err
+	}
+	removedAt, err := fromTimeTicket(pbNode.RemovedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	node := json.NewTreeNode(id, pbNode.Type, pbNode.Value)
+	for _, pbAttr := range pbNode.Attributes {
+		updatedAt, err := fromTimeTicket(pbAttr.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		node.Attrs().Set(pbAttr.Key, pbAttr.Value, updatedAt)
+	}
+	node.SetRemovedAt(removedAt)
+	return node, nil
+}
+
 func fromTimeTicket(pbTicket *api.TimeTicket) (*time.Ticket, error) {
 	if pbTicket == nil {
 		return nil, nil
@@ -626,6 +1047,12 @@ func fromElement(pbElement *api.JSONElementSimple) (json.Element, error) {
 			json.NewRGATreeSplit(json.InitialRichTextNode()),
 			createdAt,
 		), nil
+	case api.ValueType_TREE:
+		createdAt, err := fromTimeTicket(pbElement.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		return json.NewInitialTree(createdAt), nil
 	case api.ValueType_INTEGER_CNT:
 		fallthrough
 	case api.ValueType_LONG_CNT:
@@ -696,6 +1123,81 @@ func FromUpdatableProjectFields(pbProjectFields *api.UpdatableProjectFields) (*t
 	if pbProjectFields.AuthWebhookMethods != nil {
 		updatableProjectFields.AuthWebhookMethods = &pbProjectFields.AuthWebhookMethods.Methods
 	}
+	if pbProjectFields.DocumentKeyPolicy != nil {
+		policy := fromDocumentKeyPolicy(pbProjectFields.DocumentKeyPolicy)
+		updatableProjectFields.DocumentKeyPolicy = &policy
+	}
+	if pbProjectFields.CollectApplyLag != nil {
+		updatableProjectFields.CollectApplyLag = &pbProjectFields.CollectApplyLag.Value
+	}
+	if pbProjectFields.InitialContent != nil {
+		updatableProjectFields.InitialContent = &pbProjectFields.InitialContent.Value
+	}
+	if pbProjectFields.ObjectMergePolicy != nil {
+		updatableProjectFields.ObjectMergePolicy = &pbProjectFields.ObjectMergePolicy.Value
+	}
+	if pbProjectFields.EventWebhookUrl != nil {
+		updatableProjectFields.EventWebhookURL = &pbProjectFields.EventWebhookUrl.Value
+	}
+	if pbProjectFields.EphemeralKeyPrefix != nil {
+		updatableProjectFields.EphemeralKeyPrefix = &pbProjectFields.EphemeralKeyPrefix.Value
+	}
+	if pbProjectFields.ChangefeedUrl != nil {
+		updatableProjectFields.ChangefeedURL = &pbProjectFields.ChangefeedUrl.Value
+	}
+	if pbProjectFields.Features != nil {
+		updatableProjectFields.Features = &pbProjectFields.Features.Features
+	}
+	if pbProjectFields.ArchiveAfter != nil {
+		updatableProjectFields.ArchiveAfter = &pbProjectFields.ArchiveAfter.Value
+	}
+	if pbProjectFields.DocumentTemplates != nil {
+		updatableProjectFields.DocumentTemplates = &pbProjectFields.DocumentTemplates.Templates
+	}
+	if pbProjectFields.ExplicitDocumentCreation != nil {
+		updatableProjectFields.ExplicitDocumentCreation = &pbProjectFields.ExplicitDocumentCreation.Value
+	}
+	if pbProjectFields.ActorIdPolicy != nil {
+		updatableProjectFields.ActorIDPolicy = &pbProjectFields.ActorIdPolicy.Value
+	}
+	if pbProjectFields.AllowedOperations != nil {
+		updatableProjectFields.AllowedOperations = &pbProjectFields.AllowedOperations.Operations
+	}
+	if pbProjectFields.SnapshotPolicy != nil {
+		policy := fromSnapshotPolicy(pbProjectFields.SnapshotPolicy)
+		updatableProjectFields.SnapshotPolicy = &policy
+	}
+	if pbProjectFields.MaxKeysPerObject != nil {
+		maxKeys := int(pbProjectFields.MaxKeysPerObject.Value)
+		updatableProjectFields.MaxKeysPerObject = &maxKeys
+	}
+	if pbProjectFields.RemovedDocumentPolicy != nil {
+		updatableProjectFields.RemovedDocumentPolicy = &pbProjectFields.RemovedDocumentPolicy.Value
+	}
 
 	return updatableProjectFields, nil
 }
+
+func fromDocumentKeyPolicy(pbPolicy *api.DocumentKeyPolicy) types.DocumentKeyPolicy {
+	if pbPolicy == nil {
+		return types.DocumentKeyPolicy{}
+	}
+
+	return types.DocumentKeyPolicy{
+		AllowedCharset:     pbPolicy.AllowedCharset,
+		MaxLength:          int(pbPolicy.MaxLength),
+		DisallowedPrefixes: pbPolicy.DisallowedPrefixes,
+	}
+}
+
+func fromSnapshotPolicy(pbPolicy *api.SnapshotPolicy) types.SnapshotPolicy {
+	if pbPolicy == nil {
+		return types.SnapshotPolicy{}
+	}
+
+	return types.SnapshotPolicy{
+		Threshold:      pbPolicy.Threshold,
+		Compression:    pbPolicy.Compression,
+		RetentionCount: pbPolicy.RetentionCount,
+	}
+}
@@ -17,16 +17,21 @@
 package converter
 
 import (
+	"bytes"
+	"compress/gzip"
 	"fmt"
 	"reflect"
 
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
-// ObjectToBytes converts the given object to byte array.
+// ObjectToBytes converts the given object to byte array. The bytes are the
+// encoding of JSONElement, which is the snapshot sent to clients.
 func ObjectToBytes(obj *json.Object) ([]byte, error) {
 	pbElem, err := toJSONElement(obj)
 	if err != nil {
@@ -40,6 +45,58 @@ func ObjectToBytes(obj *json.Object) ([]byte, error) {
 	return bytes, nil
 }
 
+// ObjectToSnapshotBytes converts the given object to the byte array of the
+// snapshot stored in the database. Unlike ObjectToBytes, the snapshot is
+// encoded in CurrentSnapshotVersion with the format version embedded.
+func ObjectToSnapshotBytes(obj *json.Object) ([]byte, error) {
+	pbElem, err := toJSONObject(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	bytes, err := proto.Marshal(&api.Snapshot{
+		Root:    pbElem.GetJsonObject(),
+		Version: CurrentSnapshotVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bytes, nil
+}
+
+// CompressSnapshot compresses the given snapshot with the given compression.
+// The compressed snapshot is decompressed by the decoders of snapshots, so it
+// can be stored in place of the uncompressed one.
+func CompressSnapshot(snapshot []byte, compression types.SnapshotCompression) ([]byte, error) {
+	if compression != types.SnapshotCompressionGzip || snapshot == nil {
+		return snapshot, nil
+	}
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(snapshot); err != nil {
+		return nil, fmt.Errorf("compress snapshot: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("compress snapshot: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// VersionVectorToBytes converts the given version vector to byte array.
+func VersionVectorToBytes(vector time.VersionVector) ([]byte, error) {
+	pbVector, err := ToVersionVector(vector)
+	if err != nil {
+		return nil, err
+	}
+
+	bytes, err := proto.Marshal(pbVector)
+	if err != nil {
+		return nil, err
+	}
+	return bytes, nil
+}
+
 func toJSONElement(elem json.Element) (*api.JSONElement, error) {
 	switch elem := elem.(type) {
 	case *json.Object:
@@ -54,6 +111,8 @@ func toJSONElement(elem json.Element) (*api.JSONElement, error) {
 		return toRichText(elem), nil
 	case *json.Counter:
 		return toCounter(elem)
+	case *json.Tree:
+		return toTree(elem), nil
 	default:
 		return nil, fmt.Errorf("%v: %w", reflect.TypeOf(elem), ErrUnsupportedElement)
 	}
@@ -71,6 +130,7 @@ func toJSONObject(obj *json.Object) (*api.JSONElement, error) {
 			CreatedAt: ToTimeTicket(obj.CreatedAt()),
 			MovedAt:   ToTimeTicket(obj.MovedAt()),
 			RemovedAt: ToTimeTicket(obj.RemovedAt()),
+			ClearedAt: ToTimeTicket(obj.ClearedAt()),
 		}},
 	}
 	return pbElem, nil
@@ -88,6 +148,7 @@ func toJSONArray(arr *json.Array) (*api.JSONElement, error) {
 			CreatedAt: ToTimeTicket(arr.CreatedAt()),
 			MovedAt:   ToTimeTicket(arr.MovedAt()),
 			RemovedAt: ToTimeTicket(arr.RemovedAt()),
+			ClearedAt: ToTimeTicket(arr.ClearedAt()),
 		}},
 	}
 	return pbElem, nil
@@ -140,15 +201,27 @@ func toCounter(counter *json.Counter) (*api.JSONElement, error) {
 
 	return &api.JSONElement{
 		Body: &api.JSONElement_Counter_{Counter: &api.JSONElement_Counter{
-			Type:      pbCounterType,
-			Value:     counter.Bytes(),
-			CreatedAt: ToTimeTicket(counter.CreatedAt()),
-			MovedAt:   ToTimeTicket(counter.MovedAt()),
-			RemovedAt: ToTimeTicket(counter.RemovedAt()),
+			Type:        pbCounterType,
+			Value:       counter.Bytes(),
+			CreatedAt:   ToTimeTicket(counter.CreatedAt()),
+			MovedAt:     ToTimeTicket(counter.MovedAt()),
+			RemovedAt:   ToTimeTicket(counter.RemovedAt()),
+			IncreasedAt: ToTimeTicket(counter.IncreasedAt()),
 		}},
 	}, nil
 }
 
+func toTree(tree *json.Tree) *api.JSONElement {
+	return &api.JSONElement{
+		Body: &api.JSONElement_Tree_{Tree: &api.JSONElement_Tree{
+			Nodes:     toTreeNodes([]*json.TreeNode{tree.Root()}),
+			CreatedAt: ToTimeTicket(tree.CreatedAt()),
+			MovedAt:   ToTimeTicket(tree.MovedAt()),
+			RemovedAt: ToTimeTicket(tree.RemovedAt()),
+		}},
+	}
+}
+
 func toRHTNodes(rhtNodes []*json.RHTPQMapNode) ([]*api.RHTNode, error) {
 	var pbRHTNodes []*api.RHTNode
 	for _, rhtNode := range rhtNodes {
@@ -158,8 +231,9 @@ func toRHTNodes(rhtNodes []*json.RHTPQMapNode) ([]*api.RHTNode, error) {
 		}
 
 		pbRHTNodes = append(pbRHTNodes, &api.RHTNode{
-			Key:     rhtNode.Key(),
-			Element: pbElem,
+			Key:        rhtNode.Key(),
+			Element:    pbElem,
+			Generation: rhtNode.Generation(),
 		})
 	}
 	return pbRHTNodes, nil
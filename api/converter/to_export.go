@@ -0,0 +1,133 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package converter
+
+import (
+	gojson "encoding/json"
+	"fmt"
+	"reflect"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+)
+
+// ToExportedElement converts the given element to ExportedElement which
+// contains the metadata of the element and its descendants.
+func ToExportedElement(elem json.Element) (*types.ExportedElement, error) {
+	modifiedAt := json.ModifiedAt(elem)
+	exported := &types.ExportedElement{
+		CreatedAt:  elem.CreatedAt().Key(),
+		ModifiedAt: modifiedAt.Key(),
+		ModifiedBy: modifiedAt.ActorIDHex(),
+	}
+
+	switch elem := elem.(type) {
+	case *json.Object:
+		members := make(map[string]*types.ExportedElement)
+		for key, member := range elem.Members() {
+			exportedMember, err := ToExportedElement(member)
+			if err != nil {
+				return nil, err
+			}
+			members[key] = exportedMember
+		}
+		exported.Type = types.ExportedObject
+		exported.Value = members
+	case *json.Array:
+		elements := make([]*types.ExportedElement, 0, elem.Len())
+		for _, element := range elem.Elements() {
+			exportedElement, err := ToExportedElement(element)
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, exportedElement)
+		}
+		exported.Type = types.ExportedArray
+		exported.Value = elements
+	case *json.Primitive:
+		valueType, err := toExportedValueType(elem.ValueType())
+		if err != nil {
+			return nil, err
+		}
+		exported.Type = types.ExportedPrimitive
+		exported.ValueType = valueType
+		exported.Value = gojson.RawMessage(elem.Marshal())
+		// NOTE: Marshal of Date is not a valid JSON, so it is exported as a
+		// string of RFC3339.
+		if elem.ValueType() == json.Date {
+			exported.Value = elem.Value().(gotime.Time).Format(gotime.RFC3339)
+		}
+	case *json.Counter:
+		valueType, err := toExportedCounterType(elem.ValueType())
+		if err != nil {
+			return nil, err
+		}
+		exported.Type = types.ExportedCounter
+		exported.ValueType = valueType
+		exported.Value = gojson.RawMessage(elem.Marshal())
+	case *json.Text:
+		exported.Type = types.ExportedText
+		exported.Value = gojson.RawMessage(elem.Marshal())
+	case *json.RichText:
+		exported.Type = types.ExportedRichText
+		exported.Value = gojson.RawMessage(elem.Marshal())
+	case *json.Tree:
+		exported.Type = types.ExportedTree
+		exported.Value = gojson.RawMessage(elem.Marshal())
+	default:
+		return nil, fmt.Errorf("%v: %w", reflect.TypeOf(elem), ErrUnsupportedElement)
+	}
+
+	return exported, nil
+}
+
+func toExportedValueType(valueType json.ValueType) (types.ExportedValueType, error) {
+	switch valueType {
+	case json.Null:
+		return types.ExportedNull, nil
+	case json.Boolean:
+		return types.ExportedBoolean, nil
+	case json.Integer:
+		return types.ExportedInteger, nil
+	case json.Long:
+		return types.ExportedLong, nil
+	case json.Double:
+		return types.ExportedDouble, nil
+	case json.String:
+		return types.ExportedString, nil
+	case json.Bytes:
+		return types.ExportedBytes, nil
+	case json.Date:
+		return types.ExportedDate, nil
+	}
+
+	return "", fmt.Errorf("%d, %w", valueType, ErrUnsupportedValueType)
+}
+
+func toExportedCounterType(valueType json.CounterType) (types.ExportedValueType, error) {
+	switch valueType {
+	case json.IntegerCnt:
+		return types.ExportedInteger, nil
+	case json.LongCnt:
+		return types.ExportedLong, nil
+	case json.DoubleCnt:
+		return types.ExportedDouble, nil
+	}
+
+	return "", fmt.Errorf("%d, %w", valueType, ErrUnsupportedCounterType)
+}
@@ -19,6 +19,7 @@ package converter
 import (
 	"fmt"
 	"reflect"
+	"sort"
 
 	protoTypes "github.com/gogo/protobuf/types"
 
@@ -58,14 +59,31 @@ func ToProject(project *types.Project) (*api.Project, error) {
 	}
 
 	return &api.Project{
-		Id:                 project.ID.String(),
-		Name:               project.Name,
-		AuthWebhookUrl:     project.AuthWebhookURL,
-		AuthWebhookMethods: project.AuthWebhookMethods,
-		PublicKey:          project.PublicKey,
-		SecretKey:          project.SecretKey,
-		CreatedAt:          pbCreatedAt,
-		UpdatedAt:          pbUpdatedAt,
+		Id:                       project.ID.String(),
+		Name:                     project.Name,
+		AuthWebhookUrl:           project.AuthWebhookURL,
+		AuthWebhookMethods:       project.AuthWebhookMethods,
+		PublicKey:                project.PublicKey,
+		SecretKey:                project.SecretKey,
+		DocumentKeyPolicy:        toDocumentKeyPolicy(&project.DocumentKeyPolicy),
+		CollectApplyLag:          project.CollectApplyLag,
+		InitialContent:           project.InitialContent,
+		ObjectMergePolicy:        project.ObjectMergePolicy,
+		EventWebhookUrl:          project.EventWebhookURL,
+		EphemeralKeyPrefix:       project.EphemeralKeyPrefix,
+		ChangefeedUrl:            project.ChangefeedURL,
+		Features:                 project.Features,
+		DocumentTemplates:        project.DocumentTemplates,
+		ExplicitDocumentCreation: project.ExplicitDocumentCreation,
+		ActorIdPolicy:            project.ActorIDPolicy,
+		AllowedOperations:        project.AllowedOperations,
+		SnapshotPolicy:           toSnapshotPolicy(&project.SnapshotPolicy),
+		MaxKeysPerObject:         int32(project.MaxKeysPerObject),
+		RemovedDocumentPolicy:    project.RemovedDocumentPolicy,
+		ArchiveAfter:             project.ArchiveAfter,
+		DocumentCount:            int32(project.DocumentCount),
+		CreatedAt:                pbCreatedAt,
+		UpdatedAt:                pbUpdatedAt,
 	}, nil
 }
 
@@ -97,13 +115,230 @@ func ToDocumentSummary(summary *types.DocumentSummary) (*api.DocumentSummary, er
 		return nil, err
 	}
 
-	return &api.DocumentSummary{
-		Id:         summary.ID.String(),
-		Key:        summary.Key.String(),
-		CreatedAt:  pbCreatedAt,
-		AccessedAt: pbAccessedAt,
-		UpdatedAt:  pbUpdatedAt,
-		Snapshot:   summary.Snapshot,
+	pbSummary := &api.DocumentSummary{
+		Id:              summary.ID.String(),
+		Key:             summary.Key.String(),
+		CreatedAt:       pbCreatedAt,
+		AccessedAt:      pbAccessedAt,
+		UpdatedAt:       pbUpdatedAt,
+		Snapshot:        summary.Snapshot,
+		Metadata:        summary.Metadata,
+		OperationCounts: summary.OperationCounts,
+	}
+	if !summary.ArchivedAt.IsZero() {
+		pbArchivedAt, err := protoTypes.TimestampProto(summary.ArchivedAt)
+		if err != nil {
+			return nil, err
+		}
+		pbSummary.ArchivedAt = pbArchivedAt
+	}
+	if !summary.RemovedAt.IsZero() {
+		pbRemovedAt, err := protoTypes.TimestampProto(summary.RemovedAt)
+		if err != nil {
+			return nil, err
+		}
+		pbSummary.RemovedAt = pbRemovedAt
+	}
+
+	return pbSummary, nil
+}
+
+// ToDocumentClientEvents converts the given model to Protobuf format.
+func ToDocumentClientEvents(events []*types.DocumentClientEvent) ([]*api.DocumentClientEvent, error) {
+	var pbEvents []*api.DocumentClientEvent
+	for _, event := range events {
+		pbEvent, err := ToDocumentClientEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		pbEvents = append(pbEvents, pbEvent)
+	}
+	return pbEvents, nil
+}
+
+// ToRejection converts the given model to Protobuf format.
+func ToRejection(rejection *types.Rejection) (*api.Rejection, error) {
+	pbRejectedAt, err := protoTypes.TimestampProto(rejection.RejectedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Rejection{
+		DocumentKey: rejection.DocumentKey.String(),
+		ClientId:    rejection.ClientID.String(),
+		Reason:      string(rejection.Reason),
+		Message:     rejection.Message,
+		Changes:     int32(rejection.Changes),
+		RejectedAt:  pbRejectedAt,
+		Dropped:     int32(rejection.Dropped),
+	}, nil
+}
+
+// ToProjectStatsUpdate converts the given model to Protobuf format.
+func ToProjectStatsUpdate(update *types.ProjectStatsUpdate) (*api.WatchProjectStatsResponse, error) {
+	pbMeasuredAt, err := protoTypes.TimestampProto(update.Stats.MeasuredAt)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbConflictWins []*api.ActorConflictWins
+	for _, wins := range update.Stats.ConflictWins {
+		pbConflictWins = append(pbConflictWins, &api.ActorConflictWins{
+			Actor: wins.Actor,
+			Wins:  int64(wins.Wins),
+		})
+	}
+
+	var pbEvent *api.ProjectStatsEvent
+	if update.Event != nil {
+		pbEvent = &api.ProjectStatsEvent{
+			Type:        string(update.Event.Type),
+			DocumentKey: update.Event.DocumentKey.String(),
+		}
+	}
+
+	return &api.WatchProjectStatsResponse{
+		Event: pbEvent,
+		Stats: &api.LiveProjectStats{
+			ActiveDocuments: int32(update.Stats.ActiveDocuments),
+			AttachedClients: int32(update.Stats.AttachedClients),
+			OpsPerSecond:    update.Stats.OpsPerSecond,
+			HotDocuments:    int32(update.Stats.HotDocuments),
+			ConflictWins:    pbConflictWins,
+			MeasuredAt:      pbMeasuredAt,
+		},
+	}, nil
+}
+
+// ToDocumentClientEvent converts the given model to Protobuf format.
+func ToDocumentClientEvent(event *types.DocumentClientEvent) (*api.DocumentClientEvent, error) {
+	pbCreatedAt, err := protoTypes.TimestampProto(event.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbType api.DocumentClientEventType
+	switch event.Type {
+	case types.DocumentAttachedByClientEvent:
+		pbType = api.DocumentClientEventType_DOCUMENT_ATTACHED
+	case types.DocumentDetachedByClientEvent:
+		pbType = api.DocumentClientEventType_DOCUMENT_DETACHED
+	case types.DocumentOwnershipTransferredByServerEvent:
+		pbType = api.DocumentClientEventType_DOCUMENT_OWNERSHIP_TRANSFERRED
+	case types.DocumentDetachedByServerEvent:
+		pbType = api.DocumentClientEventType_DOCUMENT_DETACHED_BY_SERVER
+	default:
+		return nil, fmt.Errorf("%s: %w", event.Type, ErrUnsupportedEventType)
+	}
+
+	return &api.DocumentClientEvent{
+		Id:        event.ID.String(),
+		ClientId:  event.ClientID.String(),
+		Type:      pbType,
+		CreatedAt: pbCreatedAt,
+	}, nil
+}
+
+// ToDocumentEventLogs converts the given model to Protobuf format.
+func ToDocumentEventLogs(logs []*types.DocumentEventLog) ([]*api.DocumentEventLog, error) {
+	var pbLogs []*api.DocumentEventLog
+	for _, log := range logs {
+		pbVector, err := ToVersionVector(log.VersionVector)
+		if err != nil {
+			return nil, err
+		}
+
+		pbCreatedAt, err := protoTypes.TimestampProto(log.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		pbLogs = append(pbLogs, &api.DocumentEventLog{
+			ServerSeq:      log.ServerSeq,
+			ActorId:        log.ActorID.String(),
+			ClientSeq:      log.ClientSeq,
+			Lamport:        log.Lamport,
+			OperationCount: int32(log.OperationCount),
+			VersionVector:  pbVector,
+			Fingerprint:    log.Fingerprint,
+			CreatedAt:      pbCreatedAt,
+		})
+	}
+	return pbLogs, nil
+}
+
+// ToSnapshotMetas converts the given model to Protobuf format.
+func ToSnapshotMetas(metas []*types.SnapshotMeta) ([]*api.SnapshotMeta, error) {
+	var pbMetas []*api.SnapshotMeta
+	for _, meta := range metas {
+		pbCreatedAt, err := protoTypes.TimestampProto(meta.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		pbMetas = append(pbMetas, &api.SnapshotMeta{
+			ServerSeq:  meta.ServerSeq,
+			Lamport:    meta.Lamport,
+			GarbageLen: int32(meta.GarbageLen),
+			CreatedAt:  pbCreatedAt,
+		})
+	}
+	return pbMetas, nil
+}
+
+// ToProjectStats converts the given model to Protobuf format.
+func ToProjectStats(stats *types.ProjectStats) (*api.ProjectStats, error) {
+	var pbWins []*api.ActorConflictWins
+	for _, wins := range stats.ConflictWins {
+		pbWins = append(pbWins, &api.ActorConflictWins{
+			Actor: wins.Actor,
+			Wins:  int64(wins.Wins),
+		})
+	}
+
+	var pbHotDocs []*api.HotDocument
+	for _, doc := range stats.HotDocuments {
+		pbDetectedAt, err := protoTypes.TimestampProto(doc.DetectedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		pbHotDocs = append(pbHotDocs, &api.HotDocument{
+			DocumentKey:          doc.DocumentKey.String(),
+			OpsPerSecond:         doc.OpsPerSecond,
+			TopActorId:           doc.TopActorID.String(),
+			TopActorOpsPerSecond: doc.TopActorOpsPerSecond,
+			DetectedAt:           pbDetectedAt,
+		})
+	}
+
+	var pbQueues []*api.BackgroundQueue
+	for _, queue := range stats.BackgroundQueues {
+		pbQueues = append(pbQueues, &api.BackgroundQueue{
+			Type:             queue.Type,
+			Depth:            int32(queue.Depth),
+			OldestAgeSeconds: queue.OldestAge.Seconds(),
+		})
+	}
+
+	return &api.ProjectStats{
+		ConflictWins:     pbWins,
+		HotDocuments:     pbHotDocs,
+		BackgroundQueues: pbQueues,
+	}, nil
+}
+
+// ToMaintenance converts the given model to Protobuf format.
+func ToMaintenance(maintenance *types.Maintenance) (*api.Maintenance, error) {
+	pbUpdatedAt, err := protoTypes.TimestampProto(maintenance.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Maintenance{
+		Enabled:   maintenance.Enabled,
+		Message:   maintenance.Message,
+		UpdatedAt: pbUpdatedAt,
 	}, nil
 }
 
@@ -136,6 +371,7 @@ func ToChangePack(pack *change.Pack) (*api.ChangePack, error) {
 		Changes:         pbChanges,
 		Snapshot:        pack.Snapshot,
 		MinSyncedTicket: ToTimeTicket(pack.MinSyncedTicket),
+		DeferredChanges: pack.DeferredChanges,
 	}, nil
 }
 
@@ -147,6 +383,31 @@ func ToCheckpoint(cp change.Checkpoint) *api.Checkpoint {
 	}
 }
 
+// ToVersionVector converts the given model format to Protobuf format. The
+// entries are sorted by the actor IDs.
+func ToVersionVector(vector time.VersionVector) (*api.VersionVector, error) {
+	keys := make([]string, 0, len(vector))
+	for key := range vector {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pbVector := &api.VersionVector{
+		ActorIds: make([][]byte, 0, len(keys)),
+		Lamports: make([]uint64, 0, len(keys)),
+	}
+	for _, key := range keys {
+		actorID, err := time.ActorIDFromHex(key)
+		if err != nil {
+			return nil, err
+		}
+		pbVector.ActorIds = append(pbVector.ActorIds, actorID.Bytes())
+		pbVector.Lamports = append(pbVector.Lamports, vector[key])
+	}
+
+	return pbVector, nil
+}
+
 // ToChangeID converts the given model format to Protobuf format.
 func ToChangeID(id change.ID) *api.ChangeID {
 	return &api.ChangeID{
@@ -195,6 +456,8 @@ func ToDocEventType(eventType types.DocEventType) (api.DocEventType, error) {
 		return api.DocEventType_DOCUMENTS_UNWATCHED, nil
 	case types.PresenceChangedEvent:
 		return api.DocEventType_PRESENCE_CHANGED, nil
+	case types.SubtreeRemovedEvent:
+		return api.DocEventType_SUBTREE_REMOVED, nil
 	default:
 		return 0, fmt.Errorf("%s: %w", eventType, ErrUnsupportedEventType)
 	}
@@ -211,6 +474,9 @@ func ToDocEvent(docEvent sync.DocEvent) (*api.DocEvent, error) {
 		Type:         eventType,
 		Publisher:    ToClient(docEvent.Publisher),
 		DocumentKeys: ToDocumentKeys(docEvent.DocumentKeys),
+		ChangedPaths: docEvent.ChangedPaths,
+		RemovedPaths: docEvent.RemovedPaths,
+		ServerSeq:    docEvent.ServerSeq,
 	}, nil
 }
 
@@ -240,12 +506,20 @@ func ToOperations(ops []operations.Operation) ([]*api.Operation, error) {
 			pbOperation.Body, err = toStyle(op)
 		case *operations.Increase:
 			pbOperation.Body, err = toIncrease(op)
+		case *operations.TreeEdit:
+			pbOperation.Body, err = toTreeEdit(op)
+		case *operations.TreeStyle:
+			pbOperation.Body, err = toTreeStyle(op)
+		case *operations.Clear:
+			pbOperation.Body, err = toClear(op)
 		default:
 			return nil, ErrUnsupportedOperation
 		}
 		if err != nil {
 			return nil, err
 		}
+		pbOperation.WallTime = o.WallTime()
+		pbOperation.Id = o.ID()
 		pbOperations = append(pbOperations, pbOperation)
 	}
 
@@ -297,6 +571,7 @@ func toSet(set *operations.Set) (*api.Operation_Set_, error) {
 			Key:             set.Key(),
 			Value:           pbElem,
 			ExecutedAt:      ToTimeTicket(set.ExecutedAt()),
+			Generation:      set.Generation(),
 		},
 	}, nil
 }
@@ -403,6 +678,41 @@ func toIncrease(increase *operations.Increase) (*api.Operation_Increase_, error)
 	}, nil
 }
 
+func toTreeEdit(treeEdit *operations.TreeEdit) (*api.Operation_TreeEdit_, error) {
+	return &api.Operation_TreeEdit_{
+		TreeEdit: &api.Operation_TreeEdit{
+			ParentCreatedAt:     ToTimeTicket(treeEdit.ParentCreatedAt()),
+			From:                toTreePos(treeEdit.From()),
+			To:                  toTreePos(treeEdit.To()),
+			CreatedAtMapByActor: toCreatedAtMapByActor(treeEdit.CreatedAtMapByActor()),
+			Contents:            toTreeNodes(treeEdit.Contents()),
+			ExecutedAt:          ToTimeTicket(treeEdit.ExecutedAt()),
+		},
+	}, nil
+}
+
+func toTreeStyle(style *operations.TreeStyle) (*api.Operation_TreeStyle_, error) {
+	return &api.Operation_TreeStyle_{
+		TreeStyle: &api.Operation_TreeStyle{
+			ParentCreatedAt:     ToTimeTicket(style.ParentCreatedAt()),
+			From:                toTreePos(style.From()),
+			To:                  toTreePos(style.To()),
+			CreatedAtMapByActor: toCreatedAtMapByActor(style.CreatedAtMapByActor()),
+			Attributes:          style.Attributes(),
+			ExecutedAt:          ToTimeTicket(style.ExecutedAt()),
+		},
+	}, nil
+}
+
+func toClear(clear *operations.Clear) (*api.Operation_Clear_, error) {
+	return &api.Operation_Clear_{
+		Clear: &api.Operation_Clear{
+			ParentCreatedAt: ToTimeTicket(clear.ParentCreatedAt()),
+			ExecutedAt:      ToTimeTicket(clear.ExecutedAt()),
+		},
+	}, nil
+}
+
 func toJSONElementSimple(elem json.Element) (*api.JSONElementSimple, error) {
 	switch elem := elem.(type) {
 	case *json.Object:
@@ -436,6 +746,11 @@ func toJSONElementSimple(elem json.Element) (*api.JSONElementSimple, error) {
 			Type:      api.ValueType_RICH_TEXT,
 			CreatedAt: ToTimeTicket(elem.CreatedAt()),
 		}, nil
+	case *json.Tree:
+		return &api.JSONElementSimple{
+			Type:      api.ValueType_TREE,
+			CreatedAt: ToTimeTicket(elem.CreatedAt()),
+		}, nil
 	case *json.Counter:
 		pbCounterType, err := toCounterType(elem.ValueType())
 		if err != nil {
@@ -460,6 +775,52 @@ func toTextNodePos(pos *json.RGATreeSplitNodePos) *api.TextNodePos {
 	}
 }
 
+func toTreePos(pos *json.TreePos) *api.TreePos {
+	return &api.TreePos{
+		ParentCreatedAt:      ToTimeTicket(pos.ParentCreatedAt()),
+		LeftSiblingCreatedAt: ToTimeTicket(pos.LeftSiblingCreatedAt()),
+	}
+}
+
+// toTreeNodes converts the given subtrees to the list of nodes in pre-order
+// with the depth.
+func toTreeNodes(roots []*json.TreeNode) []*api.TreeNode {
+	var pbNodes []*api.TreeNode
+
+	var traverse func(node *json.TreeNode, depth int)
+	traverse = func(node *json.TreeNode, depth int) {
+		pbNodes = append(pbNodes, toTreeNode(node, depth))
+		for _, child := range node.Children() {
+			traverse(child, depth+1)
+		}
+	}
+	for _, root := range roots {
+		traverse(root, 0)
+	}
+
+	return pbNodes
+}
+
+func toTreeNode(node *json.TreeNode, depth int) *api.TreeNode {
+	attrs := make(map[string]*api.RichTextNodeAttr)
+	for _, attr := range node.Attrs().Nodes() {
+		attrs[attr.Key()] = &api.RichTextNodeAttr{
+			Key:       attr.Key(),
+			Value:     attr.Value(),
+			UpdatedAt: ToTimeTicket(attr.UpdatedAt()),
+		}
+	}
+
+	return &api.TreeNode{
+		Id:         ToTimeTicket(node.ID()),
+		Type:       node.Type(),
+		Value:      node.Value(),
+		RemovedAt:  ToTimeTicket(node.RemovedAt()),
+		Depth:      int32(depth),
+		Attributes: attrs,
+	}
+}
+
 func toCreatedAtMapByActor(
 	createdAtMapByActor map[string]*time.Ticket,
 ) map[string]*api.TimeTicket {
@@ -522,5 +883,75 @@ func ToUpdatableProjectFields(fields *types.UpdatableProjectFields) (*api.Updata
 	} else {
 		pbUpdatableProjectFields.AuthWebhookMethods = nil
 	}
+	if fields.DocumentKeyPolicy != nil {
+		pbUpdatableProjectFields.DocumentKeyPolicy = toDocumentKeyPolicy(fields.DocumentKeyPolicy)
+	}
+	if fields.CollectApplyLag != nil {
+		pbUpdatableProjectFields.CollectApplyLag = &protoTypes.BoolValue{Value: *fields.CollectApplyLag}
+	}
+	if fields.InitialContent != nil {
+		pbUpdatableProjectFields.InitialContent = &protoTypes.StringValue{Value: *fields.InitialContent}
+	}
+	if fields.ObjectMergePolicy != nil {
+		pbUpdatableProjectFields.ObjectMergePolicy = &protoTypes.StringValue{Value: *fields.ObjectMergePolicy}
+	}
+	if fields.EventWebhookURL != nil {
+		pbUpdatableProjectFields.EventWebhookUrl = &protoTypes.StringValue{Value: *fields.EventWebhookURL}
+	}
+	if fields.EphemeralKeyPrefix != nil {
+		pbUpdatableProjectFields.EphemeralKeyPrefix = &protoTypes.StringValue{Value: *fields.EphemeralKeyPrefix}
+	}
+	if fields.ChangefeedURL != nil {
+		pbUpdatableProjectFields.ChangefeedUrl = &protoTypes.StringValue{Value: *fields.ChangefeedURL}
+	}
+	if fields.Features != nil {
+		pbUpdatableProjectFields.Features = &api.UpdatableProjectFields_Features{
+			Features: *fields.Features,
+		}
+	}
+	if fields.ArchiveAfter != nil {
+		pbUpdatableProjectFields.ArchiveAfter = &protoTypes.StringValue{Value: *fields.ArchiveAfter}
+	}
+	if fields.DocumentTemplates != nil {
+		pbUpdatableProjectFields.DocumentTemplates = &api.UpdatableProjectFields_DocumentTemplates{
+			Templates: *fields.DocumentTemplates,
+		}
+	}
+	if fields.ExplicitDocumentCreation != nil {
+		pbUpdatableProjectFields.ExplicitDocumentCreation = &protoTypes.BoolValue{Value: *fields.ExplicitDocumentCreation}
+	}
+	if fields.ActorIDPolicy != nil {
+		pbUpdatableProjectFields.ActorIdPolicy = &protoTypes.StringValue{Value: *fields.ActorIDPolicy}
+	}
+	if fields.AllowedOperations != nil {
+		pbUpdatableProjectFields.AllowedOperations = &api.UpdatableProjectFields_AllowedOperations{
+			Operations: *fields.AllowedOperations,
+		}
+	}
+	if fields.SnapshotPolicy != nil {
+		pbUpdatableProjectFields.SnapshotPolicy = toSnapshotPolicy(fields.SnapshotPolicy)
+	}
+	if fields.MaxKeysPerObject != nil {
+		pbUpdatableProjectFields.MaxKeysPerObject = &protoTypes.Int32Value{Value: int32(*fields.MaxKeysPerObject)}
+	}
+	if fields.RemovedDocumentPolicy != nil {
+		pbUpdatableProjectFields.RemovedDocumentPolicy = &protoTypes.StringValue{Value: *fields.RemovedDocumentPolicy}
+	}
 	return pbUpdatableProjectFields, nil
 }
+
+func toDocumentKeyPolicy(policy *types.DocumentKeyPolicy) *api.DocumentKeyPolicy {
+	return &api.DocumentKeyPolicy{
+		AllowedCharset:     policy.AllowedCharset,
+		MaxLength:          int32(policy.MaxLength),
+		DisallowedPrefixes: policy.DisallowedPrefixes,
+	}
+}
+
+func toSnapshotPolicy(policy *types.SnapshotPolicy) *api.SnapshotPolicy {
+	return &api.SnapshotPolicy{
+		Threshold:      policy.Threshold,
+		Compression:    policy.Compression,
+		RetentionCount: policy.RetentionCount,
+	}
+}
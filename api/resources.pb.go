@@ -4,6 +4,7 @@
 package api
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	types "github.com/gogo/protobuf/types"
 	proto "github.com/golang/protobuf/proto"
@@ -41,6 +42,7 @@ const (
 	ValueType_INTEGER_CNT ValueType = 12
 	ValueType_LONG_CNT    ValueType = 13
 	ValueType_DOUBLE_CNT  ValueType = 14
+	ValueType_TREE        ValueType = 15
 )
 
 var ValueType_name = map[int32]string{
@@ -59,6 +61,7 @@ var ValueType_name = map[int32]string{
 	12: "INTEGER_CNT",
 	13: "LONG_CNT",
 	14: "DOUBLE_CNT",
+	15: "TREE",
 }
 
 var ValueType_value = map[string]int32{
@@ -77,6 +80,7 @@ var ValueType_value = map[string]int32{
 	"INTEGER_CNT": 12,
 	"LONG_CNT":    13,
 	"DOUBLE_CNT":  14,
+	"TREE":        15,
 }
 
 func (x ValueType) String() string {
@@ -94,6 +98,7 @@ const (
 	DocEventType_DOCUMENTS_WATCHED   DocEventType = 1
 	DocEventType_DOCUMENTS_UNWATCHED DocEventType = 2
 	DocEventType_PRESENCE_CHANGED    DocEventType = 3
+	DocEventType_SUBTREE_REMOVED     DocEventType = 4
 )
 
 var DocEventType_name = map[int32]string{
@@ -101,6 +106,7 @@ var DocEventType_name = map[int32]string{
 	1: "DOCUMENTS_WATCHED",
 	2: "DOCUMENTS_UNWATCHED",
 	3: "PRESENCE_CHANGED",
+	4: "SUBTREE_REMOVED",
 }
 
 var DocEventType_value = map[string]int32{
@@ -108,6 +114,7 @@ var DocEventType_value = map[string]int32{
 	"DOCUMENTS_WATCHED":   1,
 	"DOCUMENTS_UNWATCHED": 2,
 	"PRESENCE_CHANGED":    3,
+	"SUBTREE_REMOVED":     4,
 }
 
 func (x DocEventType) String() string {
@@ -118,12 +125,44 @@ func (DocEventType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_cf1b13971fe4c19d, []int{1}
 }
 
+type DocumentClientEventType int32
+
+const (
+	DocumentClientEventType_DOCUMENT_ATTACHED              DocumentClientEventType = 0
+	DocumentClientEventType_DOCUMENT_DETACHED              DocumentClientEventType = 1
+	DocumentClientEventType_DOCUMENT_OWNERSHIP_TRANSFERRED DocumentClientEventType = 2
+	DocumentClientEventType_DOCUMENT_DETACHED_BY_SERVER    DocumentClientEventType = 3
+)
+
+var DocumentClientEventType_name = map[int32]string{
+	0: "DOCUMENT_ATTACHED",
+	1: "DOCUMENT_DETACHED",
+	2: "DOCUMENT_OWNERSHIP_TRANSFERRED",
+	3: "DOCUMENT_DETACHED_BY_SERVER",
+}
+
+var DocumentClientEventType_value = map[string]int32{
+	"DOCUMENT_ATTACHED":              0,
+	"DOCUMENT_DETACHED":              1,
+	"DOCUMENT_OWNERSHIP_TRANSFERRED": 2,
+	"DOCUMENT_DETACHED_BY_SERVER":    3,
+}
+
+func (x DocumentClientEventType) String() string {
+	return proto.EnumName(DocumentClientEventType_name, int32(x))
+}
+
+func (DocumentClientEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{2}
+}
+
 type ChangePack struct {
 	DocumentKey          string      `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
 	Checkpoint           *Checkpoint `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
 	Snapshot             []byte      `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
 	Changes              []*Change   `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
 	MinSyncedTicket      *TimeTicket `protobuf:"bytes,5,opt,name=min_synced_ticket,json=minSyncedTicket,proto3" json:"min_synced_ticket,omitempty"`
+	DeferredChanges      uint32      `protobuf:"varint,6,opt,name=deferred_changes,json=deferredChanges,proto3" json:"deferred_changes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -197,6 +236,13 @@ func (m *ChangePack) GetMinSyncedTicket() *TimeTicket {
 	return nil
 }
 
+func (m *ChangePack) GetDeferredChanges() uint32 {
+	if m != nil {
+		return m.DeferredChanges
+	}
+	return 0
+}
+
 type Change struct {
 	Id                   *ChangeID    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Message              string       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
@@ -342,7 +388,12 @@ type Operation struct {
 	//	*Operation_RichEdit_
 	//	*Operation_Style_
 	//	*Operation_Increase_
+	//	*Operation_TreeEdit_
+	//	*Operation_TreeStyle_
+	//	*Operation_Clear_
 	Body                 isOperation_Body `protobuf_oneof:"body"`
+	WallTime             int64            `protobuf:"varint,12,opt,name=wall_time,json=wallTime,proto3" json:"wall_time,omitempty"`
+	Id                   string           `protobuf:"bytes,14,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -414,16 +465,28 @@ type Operation_Style_ struct {
 type Operation_Increase_ struct {
 	Increase *Operation_Increase `protobuf:"bytes,9,opt,name=increase,proto3,oneof" json:"increase,omitempty"`
 }
+type Operation_TreeEdit_ struct {
+	TreeEdit *Operation_TreeEdit `protobuf:"bytes,10,opt,name=tree_edit,json=treeEdit,proto3,oneof" json:"tree_edit,omitempty"`
+}
+type Operation_TreeStyle_ struct {
+	TreeStyle *Operation_TreeStyle `protobuf:"bytes,11,opt,name=tree_style,json=treeStyle,proto3,oneof" json:"tree_style,omitempty"`
+}
+type Operation_Clear_ struct {
+	Clear *Operation_Clear `protobuf:"bytes,13,opt,name=clear,proto3,oneof" json:"clear,omitempty"`
+}
 
-func (*Operation_Set_) isOperation_Body()      {}
-func (*Operation_Add_) isOperation_Body()      {}
-func (*Operation_Move_) isOperation_Body()     {}
-func (*Operation_Remove_) isOperation_Body()   {}
-func (*Operation_Edit_) isOperation_Body()     {}
-func (*Operation_Select_) isOperation_Body()   {}
-func (*Operation_RichEdit_) isOperation_Body() {}
-func (*Operation_Style_) isOperation_Body()    {}
-func (*Operation_Increase_) isOperation_Body() {}
+func (*Operation_Set_) isOperation_Body()       {}
+func (*Operation_Add_) isOperation_Body()       {}
+func (*Operation_Move_) isOperation_Body()      {}
+func (*Operation_Remove_) isOperation_Body()    {}
+func (*Operation_Edit_) isOperation_Body()      {}
+func (*Operation_Select_) isOperation_Body()    {}
+func (*Operation_RichEdit_) isOperation_Body()  {}
+func (*Operation_Style_) isOperation_Body()     {}
+func (*Operation_Increase_) isOperation_Body()  {}
+func (*Operation_TreeEdit_) isOperation_Body()  {}
+func (*Operation_TreeStyle_) isOperation_Body() {}
+func (*Operation_Clear_) isOperation_Body()     {}
 
 func (m *Operation) GetBody() isOperation_Body {
 	if m != nil {
@@ -495,6 +558,41 @@ func (m *Operation) GetIncrease() *Operation_Increase {
 	return nil
 }
 
+func (m *Operation) GetTreeEdit() *Operation_TreeEdit {
+	if x, ok := m.GetBody().(*Operation_TreeEdit_); ok {
+		return x.TreeEdit
+	}
+	return nil
+}
+
+func (m *Operation) GetTreeStyle() *Operation_TreeStyle {
+	if x, ok := m.GetBody().(*Operation_TreeStyle_); ok {
+		return x.TreeStyle
+	}
+	return nil
+}
+
+func (m *Operation) GetClear() *Operation_Clear {
+	if x, ok := m.GetBody().(*Operation_Clear_); ok {
+		return x.Clear
+	}
+	return nil
+}
+
+func (m *Operation) GetWallTime() int64 {
+	if m != nil {
+		return m.WallTime
+	}
+	return 0
+}
+
+func (m *Operation) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Operation) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -507,6 +605,9 @@ func (*Operation) XXX_OneofWrappers() []interface{} {
 		(*Operation_RichEdit_)(nil),
 		(*Operation_Style_)(nil),
 		(*Operation_Increase_)(nil),
+		(*Operation_TreeEdit_)(nil),
+		(*Operation_TreeStyle_)(nil),
+		(*Operation_Clear_)(nil),
 	}
 }
 
@@ -515,6 +616,7 @@ type Operation_Set struct {
 	Key                  string             `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 	Value                *JSONElementSimple `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 	ExecutedAt           *TimeTicket        `protobuf:"bytes,4,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	Generation           uint32             `protobuf:"varint,5,opt,name=generation,proto3" json:"generation,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -581,6 +683,13 @@ func (m *Operation_Set) GetExecutedAt() *TimeTicket {
 	return nil
 }
 
+func (m *Operation_Set) GetGeneration() uint32 {
+	if m != nil {
+		return m.Generation
+	}
+	return 0
+}
+
 type Operation_Add struct {
 	ParentCreatedAt      *TimeTicket        `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
 	PrevCreatedAt        *TimeTicket        `protobuf:"bytes,2,opt,name=prev_created_at,json=prevCreatedAt,proto3" json:"prev_created_at,omitempty"`
@@ -1181,6 +1290,235 @@ func (m *Operation_Increase) GetExecutedAt() *TimeTicket {
 	return nil
 }
 
+type Operation_TreeEdit struct {
+	ParentCreatedAt      *TimeTicket            `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
+	From                 *TreePos               `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *TreePos               `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	CreatedAtMapByActor  map[string]*TimeTicket `protobuf:"bytes,4,rep,name=created_at_map_by_actor,json=createdAtMapByActor,proto3" json:"created_at_map_by_actor,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Contents             []*TreeNode            `protobuf:"bytes,5,rep,name=contents,proto3" json:"contents,omitempty"`
+	ExecutedAt           *TimeTicket            `protobuf:"bytes,6,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *Operation_TreeEdit) Reset()         { *m = Operation_TreeEdit{} }
+func (m *Operation_TreeEdit) String() string { return proto.CompactTextString(m) }
+func (*Operation_TreeEdit) ProtoMessage()    {}
+func (*Operation_TreeEdit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{3, 9}
+}
+func (m *Operation_TreeEdit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Operation_TreeEdit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Operation_TreeEdit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Operation_TreeEdit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation_TreeEdit.Merge(m, src)
+}
+func (m *Operation_TreeEdit) XXX_Size() int {
+	return m.Size()
+}
+func (m *Operation_TreeEdit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation_TreeEdit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Operation_TreeEdit proto.InternalMessageInfo
+
+func (m *Operation_TreeEdit) GetParentCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.ParentCreatedAt
+	}
+	return nil
+}
+
+func (m *Operation_TreeEdit) GetFrom() *TreePos {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *Operation_TreeEdit) GetTo() *TreePos {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *Operation_TreeEdit) GetCreatedAtMapByActor() map[string]*TimeTicket {
+	if m != nil {
+		return m.CreatedAtMapByActor
+	}
+	return nil
+}
+
+func (m *Operation_TreeEdit) GetContents() []*TreeNode {
+	if m != nil {
+		return m.Contents
+	}
+	return nil
+}
+
+func (m *Operation_TreeEdit) GetExecutedAt() *TimeTicket {
+	if m != nil {
+		return m.ExecutedAt
+	}
+	return nil
+}
+
+type Operation_TreeStyle struct {
+	ParentCreatedAt      *TimeTicket            `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
+	From                 *TreePos               `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *TreePos               `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	CreatedAtMapByActor  map[string]*TimeTicket `protobuf:"bytes,4,rep,name=created_at_map_by_actor,json=createdAtMapByActor,proto3" json:"created_at_map_by_actor,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Attributes           map[string]string      `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ExecutedAt           *TimeTicket            `protobuf:"bytes,6,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *Operation_TreeStyle) Reset()         { *m = Operation_TreeStyle{} }
+func (m *Operation_TreeStyle) String() string { return proto.CompactTextString(m) }
+func (*Operation_TreeStyle) ProtoMessage()    {}
+func (*Operation_TreeStyle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{3, 10}
+}
+func (m *Operation_TreeStyle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Operation_TreeStyle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Operation_TreeStyle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Operation_TreeStyle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation_TreeStyle.Merge(m, src)
+}
+func (m *Operation_TreeStyle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Operation_TreeStyle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation_TreeStyle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Operation_TreeStyle proto.InternalMessageInfo
+
+func (m *Operation_TreeStyle) GetParentCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.ParentCreatedAt
+	}
+	return nil
+}
+
+func (m *Operation_TreeStyle) GetFrom() *TreePos {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *Operation_TreeStyle) GetTo() *TreePos {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *Operation_TreeStyle) GetCreatedAtMapByActor() map[string]*TimeTicket {
+	if m != nil {
+		return m.CreatedAtMapByActor
+	}
+	return nil
+}
+
+func (m *Operation_TreeStyle) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *Operation_TreeStyle) GetExecutedAt() *TimeTicket {
+	if m != nil {
+		return m.ExecutedAt
+	}
+	return nil
+}
+
+type Operation_Clear struct {
+	ParentCreatedAt      *TimeTicket `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
+	ExecutedAt           *TimeTicket `protobuf:"bytes,2,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Operation_Clear) Reset()         { *m = Operation_Clear{} }
+func (m *Operation_Clear) String() string { return proto.CompactTextString(m) }
+func (*Operation_Clear) ProtoMessage()    {}
+func (*Operation_Clear) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{3, 11}
+}
+func (m *Operation_Clear) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Operation_Clear) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Operation_Clear.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Operation_Clear) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation_Clear.Merge(m, src)
+}
+func (m *Operation_Clear) XXX_Size() int {
+	return m.Size()
+}
+func (m *Operation_Clear) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation_Clear.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Operation_Clear proto.InternalMessageInfo
+
+func (m *Operation_Clear) GetParentCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.ParentCreatedAt
+	}
+	return nil
+}
+
+func (m *Operation_Clear) GetExecutedAt() *TimeTicket {
+	if m != nil {
+		return m.ExecutedAt
+	}
+	return nil
+}
+
 type JSONElementSimple struct {
 	CreatedAt            *TimeTicket `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	MovedAt              *TimeTicket `protobuf:"bytes,2,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
@@ -1260,6 +1598,61 @@ func (m *JSONElementSimple) GetValue() []byte {
 	return nil
 }
 
+type Snapshot struct {
+	Root                 *JSONElement_JSONObject `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Version              int32                   `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *Snapshot) Reset()         { *m = Snapshot{} }
+func (m *Snapshot) String() string { return proto.CompactTextString(m) }
+func (*Snapshot) ProtoMessage()    {}
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{5}
+}
+func (m *Snapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Snapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Snapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Snapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Snapshot.Merge(m, src)
+}
+func (m *Snapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *Snapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_Snapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Snapshot proto.InternalMessageInfo
+
+func (m *Snapshot) GetRoot() *JSONElement_JSONObject {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *Snapshot) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type JSONElement struct {
 	// Types that are valid to be assigned to Body:
 	//	*JSONElement_JsonObject
@@ -1268,6 +1661,7 @@ type JSONElement struct {
 	//	*JSONElement_Text_
 	//	*JSONElement_RichText_
 	//	*JSONElement_Counter_
+	//	*JSONElement_Tree_
 	Body                 isJSONElement_Body `protobuf_oneof:"Body"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
@@ -1278,7 +1672,7 @@ func (m *JSONElement) Reset()         { *m = JSONElement{} }
 func (m *JSONElement) String() string { return proto.CompactTextString(m) }
 func (*JSONElement) ProtoMessage()    {}
 func (*JSONElement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{5}
+	return fileDescriptor_cf1b13971fe4c19d, []int{6}
 }
 func (m *JSONElement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1331,6 +1725,9 @@ type JSONElement_RichText_ struct {
 type JSONElement_Counter_ struct {
 	Counter *JSONElement_Counter `protobuf:"bytes,6,opt,name=counter,proto3,oneof" json:"counter,omitempty"`
 }
+type JSONElement_Tree_ struct {
+	Tree *JSONElement_Tree `protobuf:"bytes,7,opt,name=tree,proto3,oneof" json:"tree,omitempty"`
+}
 
 func (*JSONElement_JsonObject) isJSONElement_Body() {}
 func (*JSONElement_JsonArray) isJSONElement_Body()  {}
@@ -1338,6 +1735,7 @@ func (*JSONElement_Primitive_) isJSONElement_Body() {}
 func (*JSONElement_Text_) isJSONElement_Body()      {}
 func (*JSONElement_RichText_) isJSONElement_Body()  {}
 func (*JSONElement_Counter_) isJSONElement_Body()   {}
+func (*JSONElement_Tree_) isJSONElement_Body()      {}
 
 func (m *JSONElement) GetBody() isJSONElement_Body {
 	if m != nil {
@@ -1388,6 +1786,13 @@ func (m *JSONElement) GetCounter() *JSONElement_Counter {
 	return nil
 }
 
+func (m *JSONElement) GetTree() *JSONElement_Tree {
+	if x, ok := m.GetBody().(*JSONElement_Tree_); ok {
+		return x.Tree
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*JSONElement) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1397,6 +1802,7 @@ func (*JSONElement) XXX_OneofWrappers() []interface{} {
 		(*JSONElement_Text_)(nil),
 		(*JSONElement_RichText_)(nil),
 		(*JSONElement_Counter_)(nil),
+		(*JSONElement_Tree_)(nil),
 	}
 }
 
@@ -1405,6 +1811,7 @@ type JSONElement_JSONObject struct {
 	CreatedAt            *TimeTicket `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	MovedAt              *TimeTicket `protobuf:"bytes,3,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
 	RemovedAt            *TimeTicket `protobuf:"bytes,4,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	ClearedAt            *TimeTicket `protobuf:"bytes,5,opt,name=cleared_at,json=clearedAt,proto3" json:"cleared_at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -1414,7 +1821,7 @@ func (m *JSONElement_JSONObject) Reset()         { *m = JSONElement_JSONObject{}
 func (m *JSONElement_JSONObject) String() string { return proto.CompactTextString(m) }
 func (*JSONElement_JSONObject) ProtoMessage()    {}
 func (*JSONElement_JSONObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{5, 0}
+	return fileDescriptor_cf1b13971fe4c19d, []int{6, 0}
 }
 func (m *JSONElement_JSONObject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1471,11 +1878,19 @@ func (m *JSONElement_JSONObject) GetRemovedAt() *TimeTicket {
 	return nil
 }
 
+func (m *JSONElement_JSONObject) GetClearedAt() *TimeTicket {
+	if m != nil {
+		return m.ClearedAt
+	}
+	return nil
+}
+
 type JSONElement_JSONArray struct {
 	Nodes                []*RGANode  `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
 	CreatedAt            *TimeTicket `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	MovedAt              *TimeTicket `protobuf:"bytes,3,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
 	RemovedAt            *TimeTicket `protobuf:"bytes,4,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	ClearedAt            *TimeTicket `protobuf:"bytes,5,opt,name=cleared_at,json=clearedAt,proto3" json:"cleared_at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -1485,7 +1900,7 @@ func (m *JSONElement_JSONArray) Reset()         { *m = JSONElement_JSONArray{} }
 func (m *JSONElement_JSONArray) String() string { return proto.CompactTextString(m) }
 func (*JSONElement_JSONArray) ProtoMessage()    {}
 func (*JSONElement_JSONArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{5, 1}
+	return fileDescriptor_cf1b13971fe4c19d, []int{6, 1}
 }
 func (m *JSONElement_JSONArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1542,7 +1957,14 @@ func (m *JSONElement_JSONArray) GetRemovedAt() *TimeTicket {
 	return nil
 }
 
-type JSONElement_Primitive struct {
+func (m *JSONElement_JSONArray) GetClearedAt() *TimeTicket {
+	if m != nil {
+		return m.ClearedAt
+	}
+	return nil
+}
+
+type JSONElement_Primitive struct {
 	Type                 ValueType   `protobuf:"varint,1,opt,name=type,proto3,enum=api.ValueType" json:"type,omitempty"`
 	Value                []byte      `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	CreatedAt            *TimeTicket `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
@@ -1557,7 +1979,7 @@ func (m *JSONElement_Primitive) Reset()         { *m = JSONElement_Primitive{} }
 func (m *JSONElement_Primitive) String() string { return proto.CompactTextString(m) }
 func (*JSONElement_Primitive) ProtoMessage()    {}
 func (*JSONElement_Primitive) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{5, 2}
+	return fileDescriptor_cf1b13971fe4c19d, []int{6, 2}
 }
 func (m *JSONElement_Primitive) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +2057,7 @@ func (m *JSONElement_Text) Reset()         { *m = JSONElement_Text{} }
 func (m *JSONElement_Text) String() string { return proto.CompactTextString(m) }
 func (*JSONElement_Text) ProtoMessage()    {}
 func (*JSONElement_Text) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{5, 3}
+	return fileDescriptor_cf1b13971fe4c19d, []int{6, 3}
 }
 func (m *JSONElement_Text) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1706,7 +2128,7 @@ func (m *JSONElement_RichText) Reset()         { *m = JSONElement_RichText{} }
 func (m *JSONElement_RichText) String() string { return proto.CompactTextString(m) }
 func (*JSONElement_RichText) ProtoMessage()    {}
 func (*JSONElement_RichText) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{5, 4}
+	return fileDescriptor_cf1b13971fe4c19d, []int{6, 4}
 }
 func (m *JSONElement_RichText) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1769,6 +2191,7 @@ type JSONElement_Counter struct {
 	CreatedAt            *TimeTicket `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	MovedAt              *TimeTicket `protobuf:"bytes,4,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
 	RemovedAt            *TimeTicket `protobuf:"bytes,5,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	IncreasedAt          *TimeTicket `protobuf:"bytes,6,opt,name=increased_at,json=increasedAt,proto3" json:"increased_at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -1778,7 +2201,7 @@ func (m *JSONElement_Counter) Reset()         { *m = JSONElement_Counter{} }
 func (m *JSONElement_Counter) String() string { return proto.CompactTextString(m) }
 func (*JSONElement_Counter) ProtoMessage()    {}
 func (*JSONElement_Counter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{5, 5}
+	return fileDescriptor_cf1b13971fe4c19d, []int{6, 5}
 }
 func (m *JSONElement_Counter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1842,9 +2265,88 @@ func (m *JSONElement_Counter) GetRemovedAt() *TimeTicket {
 	return nil
 }
 
+func (m *JSONElement_Counter) GetIncreasedAt() *TimeTicket {
+	if m != nil {
+		return m.IncreasedAt
+	}
+	return nil
+}
+
+type JSONElement_Tree struct {
+	Nodes                []*TreeNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	CreatedAt            *TimeTicket `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MovedAt              *TimeTicket `protobuf:"bytes,3,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
+	RemovedAt            *TimeTicket `protobuf:"bytes,4,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *JSONElement_Tree) Reset()         { *m = JSONElement_Tree{} }
+func (m *JSONElement_Tree) String() string { return proto.CompactTextString(m) }
+func (*JSONElement_Tree) ProtoMessage()    {}
+func (*JSONElement_Tree) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{6, 6}
+}
+func (m *JSONElement_Tree) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JSONElement_Tree) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JSONElement_Tree.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JSONElement_Tree) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONElement_Tree.Merge(m, src)
+}
+func (m *JSONElement_Tree) XXX_Size() int {
+	return m.Size()
+}
+func (m *JSONElement_Tree) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONElement_Tree.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JSONElement_Tree proto.InternalMessageInfo
+
+func (m *JSONElement_Tree) GetNodes() []*TreeNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *JSONElement_Tree) GetCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *JSONElement_Tree) GetMovedAt() *TimeTicket {
+	if m != nil {
+		return m.MovedAt
+	}
+	return nil
+}
+
+func (m *JSONElement_Tree) GetRemovedAt() *TimeTicket {
+	if m != nil {
+		return m.RemovedAt
+	}
+	return nil
+}
+
 type RHTNode struct {
 	Key                  string       `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Element              *JSONElement `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+	Generation           uint32       `protobuf:"varint,3,opt,name=generation,proto3" json:"generation,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -1854,7 +2356,7 @@ func (m *RHTNode) Reset()         { *m = RHTNode{} }
 func (m *RHTNode) String() string { return proto.CompactTextString(m) }
 func (*RHTNode) ProtoMessage()    {}
 func (*RHTNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{6}
+	return fileDescriptor_cf1b13971fe4c19d, []int{7}
 }
 func (m *RHTNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1897,6 +2399,13 @@ func (m *RHTNode) GetElement() *JSONElement {
 	return nil
 }
 
+func (m *RHTNode) GetGeneration() uint32 {
+	if m != nil {
+		return m.Generation
+	}
+	return 0
+}
+
 type RGANode struct {
 	Next                 *RGANode     `protobuf:"bytes,1,opt,name=next,proto3" json:"next,omitempty"`
 	Element              *JSONElement `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
@@ -1909,7 +2418,7 @@ func (m *RGANode) Reset()         { *m = RGANode{} }
 func (m *RGANode) String() string { return proto.CompactTextString(m) }
 func (*RGANode) ProtoMessage()    {}
 func (*RGANode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{7}
+	return fileDescriptor_cf1b13971fe4c19d, []int{8}
 }
 func (m *RGANode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1966,7 +2475,7 @@ func (m *TextNode) Reset()         { *m = TextNode{} }
 func (m *TextNode) String() string { return proto.CompactTextString(m) }
 func (*TextNode) ProtoMessage()    {}
 func (*TextNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{8}
+	return fileDescriptor_cf1b13971fe4c19d, []int{9}
 }
 func (m *TextNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2036,7 +2545,7 @@ func (m *RichTextNodeAttr) Reset()         { *m = RichTextNodeAttr{} }
 func (m *RichTextNodeAttr) String() string { return proto.CompactTextString(m) }
 func (*RichTextNodeAttr) ProtoMessage()    {}
 func (*RichTextNodeAttr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{9}
+	return fileDescriptor_cf1b13971fe4c19d, []int{10}
 }
 func (m *RichTextNodeAttr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2101,7 +2610,7 @@ func (m *RichTextNode) Reset()         { *m = RichTextNode{} }
 func (m *RichTextNode) String() string { return proto.CompactTextString(m) }
 func (*RichTextNode) ProtoMessage()    {}
 func (*RichTextNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{10}
+	return fileDescriptor_cf1b13971fe4c19d, []int{11}
 }
 func (m *RichTextNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2177,7 +2686,7 @@ func (m *TextNodeID) Reset()         { *m = TextNodeID{} }
 func (m *TextNodeID) String() string { return proto.CompactTextString(m) }
 func (*TextNodeID) ProtoMessage()    {}
 func (*TextNodeID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{11}
+	return fileDescriptor_cf1b13971fe4c19d, []int{12}
 }
 func (m *TextNodeID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2220,25 +2729,184 @@ func (m *TextNodeID) GetOffset() int32 {
 	return 0
 }
 
+type TreeNode struct {
+	Id                   *TimeTicket                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type                 string                       `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Value                string                       `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	RemovedAt            *TimeTicket                  `protobuf:"bytes,4,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	Depth                int32                        `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"`
+	Attributes           map[string]*RichTextNodeAttr `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *TreeNode) Reset()         { *m = TreeNode{} }
+func (m *TreeNode) String() string { return proto.CompactTextString(m) }
+func (*TreeNode) ProtoMessage()    {}
+func (*TreeNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{13}
+}
+func (m *TreeNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreeNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreeNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TreeNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreeNode.Merge(m, src)
+}
+func (m *TreeNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreeNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreeNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreeNode proto.InternalMessageInfo
+
+func (m *TreeNode) GetId() *TimeTicket {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *TreeNode) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *TreeNode) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *TreeNode) GetRemovedAt() *TimeTicket {
+	if m != nil {
+		return m.RemovedAt
+	}
+	return nil
+}
+
+func (m *TreeNode) GetDepth() int32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *TreeNode) GetAttributes() map[string]*RichTextNodeAttr {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+type TreePos struct {
+	ParentCreatedAt      *TimeTicket `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
+	LeftSiblingCreatedAt *TimeTicket `protobuf:"bytes,2,opt,name=left_sibling_created_at,json=leftSiblingCreatedAt,proto3" json:"left_sibling_created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *TreePos) Reset()         { *m = TreePos{} }
+func (m *TreePos) String() string { return proto.CompactTextString(m) }
+func (*TreePos) ProtoMessage()    {}
+func (*TreePos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{14}
+}
+func (m *TreePos) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreePos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreePos.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TreePos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreePos.Merge(m, src)
+}
+func (m *TreePos) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreePos) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreePos.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreePos proto.InternalMessageInfo
+
+func (m *TreePos) GetParentCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.ParentCreatedAt
+	}
+	return nil
+}
+
+func (m *TreePos) GetLeftSiblingCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.LeftSiblingCreatedAt
+	}
+	return nil
+}
+
 type Project struct {
-	Id                   string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	PublicKey            string           `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	SecretKey            string           `protobuf:"bytes,4,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
-	AuthWebhookUrl       string           `protobuf:"bytes,5,opt,name=auth_webhook_url,json=authWebhookUrl,proto3" json:"auth_webhook_url,omitempty"`
-	AuthWebhookMethods   []string         `protobuf:"bytes,6,rep,name=auth_webhook_methods,json=authWebhookMethods,proto3" json:"auth_webhook_methods,omitempty"`
-	CreatedAt            *types.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt            *types.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Id                       string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                     string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PublicKey                string             `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	SecretKey                string             `protobuf:"bytes,4,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	AuthWebhookUrl           string             `protobuf:"bytes,5,opt,name=auth_webhook_url,json=authWebhookUrl,proto3" json:"auth_webhook_url,omitempty"`
+	AuthWebhookMethods       []string           `protobuf:"bytes,6,rep,name=auth_webhook_methods,json=authWebhookMethods,proto3" json:"auth_webhook_methods,omitempty"`
+	CreatedAt                *types.Timestamp   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt                *types.Timestamp   `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DocumentKeyPolicy        *DocumentKeyPolicy `protobuf:"bytes,9,opt,name=document_key_policy,json=documentKeyPolicy,proto3" json:"document_key_policy,omitempty"`
+	CollectApplyLag          bool               `protobuf:"varint,10,opt,name=collect_apply_lag,json=collectApplyLag,proto3" json:"collect_apply_lag,omitempty"`
+	InitialContent           string             `protobuf:"bytes,11,opt,name=initial_content,json=initialContent,proto3" json:"initial_content,omitempty"`
+	ObjectMergePolicy        string             `protobuf:"bytes,12,opt,name=object_merge_policy,json=objectMergePolicy,proto3" json:"object_merge_policy,omitempty"`
+	EventWebhookUrl          string             `protobuf:"bytes,13,opt,name=event_webhook_url,json=eventWebhookUrl,proto3" json:"event_webhook_url,omitempty"`
+	DocumentCount            int32              `protobuf:"varint,14,opt,name=document_count,json=documentCount,proto3" json:"document_count,omitempty"`
+	EphemeralKeyPrefix       string             `protobuf:"bytes,15,opt,name=ephemeral_key_prefix,json=ephemeralKeyPrefix,proto3" json:"ephemeral_key_prefix,omitempty"`
+	ChangefeedUrl            string             `protobuf:"bytes,16,opt,name=changefeed_url,json=changefeedUrl,proto3" json:"changefeed_url,omitempty"`
+	Features                 map[string]bool    `protobuf:"bytes,17,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ArchiveAfter             string             `protobuf:"bytes,18,opt,name=archive_after,json=archiveAfter,proto3" json:"archive_after,omitempty"`
+	DocumentTemplates        map[string]string  `protobuf:"bytes,19,rep,name=document_templates,json=documentTemplates,proto3" json:"document_templates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ExplicitDocumentCreation bool               `protobuf:"varint,20,opt,name=explicit_document_creation,json=explicitDocumentCreation,proto3" json:"explicit_document_creation,omitempty"`
+	ActorIdPolicy            string             `protobuf:"bytes,21,opt,name=actor_id_policy,json=actorIdPolicy,proto3" json:"actor_id_policy,omitempty"`
+	AllowedOperations        []string           `protobuf:"bytes,22,rep,name=allowed_operations,json=allowedOperations,proto3" json:"allowed_operations,omitempty"`
+	SnapshotPolicy           *SnapshotPolicy    `protobuf:"bytes,23,opt,name=snapshot_policy,json=snapshotPolicy,proto3" json:"snapshot_policy,omitempty"`
+	MaxKeysPerObject         int32              `protobuf:"varint,24,opt,name=max_keys_per_object,json=maxKeysPerObject,proto3" json:"max_keys_per_object,omitempty"`
+	RemovedDocumentPolicy    string             `protobuf:"bytes,25,opt,name=removed_document_policy,json=removedDocumentPolicy,proto3" json:"removed_document_policy,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}           `json:"-"`
+	XXX_unrecognized         []byte             `json:"-"`
+	XXX_sizecache            int32              `json:"-"`
 }
 
 func (m *Project) Reset()         { *m = Project{} }
 func (m *Project) String() string { return proto.CompactTextString(m) }
 func (*Project) ProtoMessage()    {}
 func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{12}
+	return fileDescriptor_cf1b13971fe4c19d, []int{15}
 }
 func (m *Project) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2323,144 +2991,146 @@ func (m *Project) GetUpdatedAt() *types.Timestamp {
 	return nil
 }
 
-type UpdatableProjectFields struct {
-	Name                 *types.StringValue                         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	AuthWebhookUrl       *types.StringValue                         `protobuf:"bytes,2,opt,name=auth_webhook_url,json=authWebhookUrl,proto3" json:"auth_webhook_url,omitempty"`
-	AuthWebhookMethods   *UpdatableProjectFields_AuthWebhookMethods `protobuf:"bytes,3,opt,name=auth_webhook_methods,json=authWebhookMethods,proto3" json:"auth_webhook_methods,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
-	XXX_unrecognized     []byte                                     `json:"-"`
-	XXX_sizecache        int32                                      `json:"-"`
+func (m *Project) GetDocumentKeyPolicy() *DocumentKeyPolicy {
+	if m != nil {
+		return m.DocumentKeyPolicy
+	}
+	return nil
 }
 
-func (m *UpdatableProjectFields) Reset()         { *m = UpdatableProjectFields{} }
-func (m *UpdatableProjectFields) String() string { return proto.CompactTextString(m) }
-func (*UpdatableProjectFields) ProtoMessage()    {}
-func (*UpdatableProjectFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{13}
+func (m *Project) GetCollectApplyLag() bool {
+	if m != nil {
+		return m.CollectApplyLag
+	}
+	return false
 }
-func (m *UpdatableProjectFields) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *Project) GetInitialContent() string {
+	if m != nil {
+		return m.InitialContent
+	}
+	return ""
 }
-func (m *UpdatableProjectFields) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_UpdatableProjectFields.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Project) GetObjectMergePolicy() string {
+	if m != nil {
+		return m.ObjectMergePolicy
 	}
+	return ""
 }
-func (m *UpdatableProjectFields) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdatableProjectFields.Merge(m, src)
+
+func (m *Project) GetEventWebhookUrl() string {
+	if m != nil {
+		return m.EventWebhookUrl
+	}
+	return ""
 }
-func (m *UpdatableProjectFields) XXX_Size() int {
-	return m.Size()
+
+func (m *Project) GetDocumentCount() int32 {
+	if m != nil {
+		return m.DocumentCount
+	}
+	return 0
 }
-func (m *UpdatableProjectFields) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdatableProjectFields.DiscardUnknown(m)
+
+func (m *Project) GetEphemeralKeyPrefix() string {
+	if m != nil {
+		return m.EphemeralKeyPrefix
+	}
+	return ""
 }
 
-var xxx_messageInfo_UpdatableProjectFields proto.InternalMessageInfo
+func (m *Project) GetChangefeedUrl() string {
+	if m != nil {
+		return m.ChangefeedUrl
+	}
+	return ""
+}
 
-func (m *UpdatableProjectFields) GetName() *types.StringValue {
+func (m *Project) GetFeatures() map[string]bool {
 	if m != nil {
-		return m.Name
+		return m.Features
 	}
 	return nil
 }
 
-func (m *UpdatableProjectFields) GetAuthWebhookUrl() *types.StringValue {
+func (m *Project) GetArchiveAfter() string {
 	if m != nil {
-		return m.AuthWebhookUrl
+		return m.ArchiveAfter
 	}
-	return nil
+	return ""
 }
 
-func (m *UpdatableProjectFields) GetAuthWebhookMethods() *UpdatableProjectFields_AuthWebhookMethods {
+func (m *Project) GetDocumentTemplates() map[string]string {
 	if m != nil {
-		return m.AuthWebhookMethods
+		return m.DocumentTemplates
 	}
 	return nil
 }
 
-type UpdatableProjectFields_AuthWebhookMethods struct {
-	Methods              []string `protobuf:"bytes,1,rep,name=methods,proto3" json:"methods,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Project) GetExplicitDocumentCreation() bool {
+	if m != nil {
+		return m.ExplicitDocumentCreation
+	}
+	return false
 }
 
-func (m *UpdatableProjectFields_AuthWebhookMethods) Reset() {
-	*m = UpdatableProjectFields_AuthWebhookMethods{}
+func (m *Project) GetActorIdPolicy() string {
+	if m != nil {
+		return m.ActorIdPolicy
+	}
+	return ""
 }
-func (m *UpdatableProjectFields_AuthWebhookMethods) String() string {
-	return proto.CompactTextString(m)
-}
-func (*UpdatableProjectFields_AuthWebhookMethods) ProtoMessage() {}
-func (*UpdatableProjectFields_AuthWebhookMethods) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{13, 0}
-}
-func (m *UpdatableProjectFields_AuthWebhookMethods) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *UpdatableProjectFields_AuthWebhookMethods) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_UpdatableProjectFields_AuthWebhookMethods.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Project) GetAllowedOperations() []string {
+	if m != nil {
+		return m.AllowedOperations
 	}
+	return nil
 }
-func (m *UpdatableProjectFields_AuthWebhookMethods) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdatableProjectFields_AuthWebhookMethods.Merge(m, src)
-}
-func (m *UpdatableProjectFields_AuthWebhookMethods) XXX_Size() int {
-	return m.Size()
-}
-func (m *UpdatableProjectFields_AuthWebhookMethods) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdatableProjectFields_AuthWebhookMethods.DiscardUnknown(m)
+
+func (m *Project) GetSnapshotPolicy() *SnapshotPolicy {
+	if m != nil {
+		return m.SnapshotPolicy
+	}
+	return nil
 }
 
-var xxx_messageInfo_UpdatableProjectFields_AuthWebhookMethods proto.InternalMessageInfo
+func (m *Project) GetMaxKeysPerObject() int32 {
+	if m != nil {
+		return m.MaxKeysPerObject
+	}
+	return 0
+}
 
-func (m *UpdatableProjectFields_AuthWebhookMethods) GetMethods() []string {
+func (m *Project) GetRemovedDocumentPolicy() string {
 	if m != nil {
-		return m.Methods
+		return m.RemovedDocumentPolicy
 	}
-	return nil
+	return ""
 }
 
-type DocumentSummary struct {
-	Id                   string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Key                  string           `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Snapshot             string           `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
-	CreatedAt            *types.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	AccessedAt           *types.Timestamp `protobuf:"bytes,5,opt,name=accessed_at,json=accessedAt,proto3" json:"accessed_at,omitempty"`
-	UpdatedAt            *types.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type DocumentKeyPolicy struct {
+	AllowedCharset       string   `protobuf:"bytes,1,opt,name=allowed_charset,json=allowedCharset,proto3" json:"allowed_charset,omitempty"`
+	MaxLength            int32    `protobuf:"varint,2,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+	DisallowedPrefixes   []string `protobuf:"bytes,3,rep,name=disallowed_prefixes,json=disallowedPrefixes,proto3" json:"disallowed_prefixes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DocumentSummary) Reset()         { *m = DocumentSummary{} }
-func (m *DocumentSummary) String() string { return proto.CompactTextString(m) }
-func (*DocumentSummary) ProtoMessage()    {}
-func (*DocumentSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{14}
+func (m *DocumentKeyPolicy) Reset()         { *m = DocumentKeyPolicy{} }
+func (m *DocumentKeyPolicy) String() string { return proto.CompactTextString(m) }
+func (*DocumentKeyPolicy) ProtoMessage()    {}
+func (*DocumentKeyPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{16}
 }
-func (m *DocumentSummary) XXX_Unmarshal(b []byte) error {
+func (m *DocumentKeyPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DocumentSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DocumentKeyPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DocumentSummary.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DocumentKeyPolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2470,80 +3140,60 @@ func (m *DocumentSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *DocumentSummary) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DocumentSummary.Merge(m, src)
+func (m *DocumentKeyPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentKeyPolicy.Merge(m, src)
 }
-func (m *DocumentSummary) XXX_Size() int {
+func (m *DocumentKeyPolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *DocumentSummary) XXX_DiscardUnknown() {
-	xxx_messageInfo_DocumentSummary.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DocumentSummary proto.InternalMessageInfo
-
-func (m *DocumentSummary) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
+func (m *DocumentKeyPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentKeyPolicy.DiscardUnknown(m)
 }
 
-func (m *DocumentSummary) GetKey() string {
-	if m != nil {
-		return m.Key
-	}
-	return ""
-}
+var xxx_messageInfo_DocumentKeyPolicy proto.InternalMessageInfo
 
-func (m *DocumentSummary) GetSnapshot() string {
+func (m *DocumentKeyPolicy) GetAllowedCharset() string {
 	if m != nil {
-		return m.Snapshot
+		return m.AllowedCharset
 	}
 	return ""
 }
 
-func (m *DocumentSummary) GetCreatedAt() *types.Timestamp {
-	if m != nil {
-		return m.CreatedAt
-	}
-	return nil
-}
-
-func (m *DocumentSummary) GetAccessedAt() *types.Timestamp {
+func (m *DocumentKeyPolicy) GetMaxLength() int32 {
 	if m != nil {
-		return m.AccessedAt
+		return m.MaxLength
 	}
-	return nil
+	return 0
 }
 
-func (m *DocumentSummary) GetUpdatedAt() *types.Timestamp {
+func (m *DocumentKeyPolicy) GetDisallowedPrefixes() []string {
 	if m != nil {
-		return m.UpdatedAt
+		return m.DisallowedPrefixes
 	}
 	return nil
 }
 
-type Presence struct {
-	Clock                int32             `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
-	Data                 map[string]string `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type SnapshotPolicy struct {
+	Threshold            uint64   `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Compression          string   `protobuf:"bytes,2,opt,name=compression,proto3" json:"compression,omitempty"`
+	RetentionCount       uint64   `protobuf:"varint,3,opt,name=retention_count,json=retentionCount,proto3" json:"retention_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Presence) Reset()         { *m = Presence{} }
-func (m *Presence) String() string { return proto.CompactTextString(m) }
-func (*Presence) ProtoMessage()    {}
-func (*Presence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{15}
+func (m *SnapshotPolicy) Reset()         { *m = SnapshotPolicy{} }
+func (m *SnapshotPolicy) String() string { return proto.CompactTextString(m) }
+func (*SnapshotPolicy) ProtoMessage()    {}
+func (*SnapshotPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{17}
 }
-func (m *Presence) XXX_Unmarshal(b []byte) error {
+func (m *SnapshotPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Presence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SnapshotPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Presence.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SnapshotPolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2553,52 +3203,76 @@ func (m *Presence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Presence) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Presence.Merge(m, src)
+func (m *SnapshotPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotPolicy.Merge(m, src)
 }
-func (m *Presence) XXX_Size() int {
+func (m *SnapshotPolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *Presence) XXX_DiscardUnknown() {
-	xxx_messageInfo_Presence.DiscardUnknown(m)
+func (m *SnapshotPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Presence proto.InternalMessageInfo
+var xxx_messageInfo_SnapshotPolicy proto.InternalMessageInfo
 
-func (m *Presence) GetClock() int32 {
+func (m *SnapshotPolicy) GetThreshold() uint64 {
 	if m != nil {
-		return m.Clock
+		return m.Threshold
 	}
 	return 0
 }
 
-func (m *Presence) GetData() map[string]string {
+func (m *SnapshotPolicy) GetCompression() string {
 	if m != nil {
-		return m.Data
+		return m.Compression
 	}
-	return nil
+	return ""
 }
 
-type Client struct {
-	Id                   []byte    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Presence             *Presence `protobuf:"bytes,2,opt,name=presence,proto3" json:"presence,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+func (m *SnapshotPolicy) GetRetentionCount() uint64 {
+	if m != nil {
+		return m.RetentionCount
+	}
+	return 0
 }
 
-func (m *Client) Reset()         { *m = Client{} }
-func (m *Client) String() string { return proto.CompactTextString(m) }
-func (*Client) ProtoMessage()    {}
-func (*Client) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{16}
+type UpdatableProjectFields struct {
+	Name                     *types.StringValue                         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AuthWebhookUrl           *types.StringValue                         `protobuf:"bytes,2,opt,name=auth_webhook_url,json=authWebhookUrl,proto3" json:"auth_webhook_url,omitempty"`
+	AuthWebhookMethods       *UpdatableProjectFields_AuthWebhookMethods `protobuf:"bytes,3,opt,name=auth_webhook_methods,json=authWebhookMethods,proto3" json:"auth_webhook_methods,omitempty"`
+	DocumentKeyPolicy        *DocumentKeyPolicy                         `protobuf:"bytes,4,opt,name=document_key_policy,json=documentKeyPolicy,proto3" json:"document_key_policy,omitempty"`
+	CollectApplyLag          *types.BoolValue                           `protobuf:"bytes,5,opt,name=collect_apply_lag,json=collectApplyLag,proto3" json:"collect_apply_lag,omitempty"`
+	InitialContent           *types.StringValue                         `protobuf:"bytes,6,opt,name=initial_content,json=initialContent,proto3" json:"initial_content,omitempty"`
+	ObjectMergePolicy        *types.StringValue                         `protobuf:"bytes,7,opt,name=object_merge_policy,json=objectMergePolicy,proto3" json:"object_merge_policy,omitempty"`
+	EventWebhookUrl          *types.StringValue                         `protobuf:"bytes,8,opt,name=event_webhook_url,json=eventWebhookUrl,proto3" json:"event_webhook_url,omitempty"`
+	EphemeralKeyPrefix       *types.StringValue                         `protobuf:"bytes,9,opt,name=ephemeral_key_prefix,json=ephemeralKeyPrefix,proto3" json:"ephemeral_key_prefix,omitempty"`
+	ChangefeedUrl            *types.StringValue                         `protobuf:"bytes,10,opt,name=changefeed_url,json=changefeedUrl,proto3" json:"changefeed_url,omitempty"`
+	Features                 *UpdatableProjectFields_Features           `protobuf:"bytes,11,opt,name=features,proto3" json:"features,omitempty"`
+	ArchiveAfter             *types.StringValue                         `protobuf:"bytes,12,opt,name=archive_after,json=archiveAfter,proto3" json:"archive_after,omitempty"`
+	DocumentTemplates        *UpdatableProjectFields_DocumentTemplates  `protobuf:"bytes,13,opt,name=document_templates,json=documentTemplates,proto3" json:"document_templates,omitempty"`
+	ExplicitDocumentCreation *types.BoolValue                           `protobuf:"bytes,14,opt,name=explicit_document_creation,json=explicitDocumentCreation,proto3" json:"explicit_document_creation,omitempty"`
+	ActorIdPolicy            *types.StringValue                         `protobuf:"bytes,15,opt,name=actor_id_policy,json=actorIdPolicy,proto3" json:"actor_id_policy,omitempty"`
+	AllowedOperations        *UpdatableProjectFields_AllowedOperations  `protobuf:"bytes,16,opt,name=allowed_operations,json=allowedOperations,proto3" json:"allowed_operations,omitempty"`
+	SnapshotPolicy           *SnapshotPolicy                            `protobuf:"bytes,17,opt,name=snapshot_policy,json=snapshotPolicy,proto3" json:"snapshot_policy,omitempty"`
+	MaxKeysPerObject         *types.Int32Value                          `protobuf:"bytes,18,opt,name=max_keys_per_object,json=maxKeysPerObject,proto3" json:"max_keys_per_object,omitempty"`
+	RemovedDocumentPolicy    *types.StringValue                         `protobuf:"bytes,19,opt,name=removed_document_policy,json=removedDocumentPolicy,proto3" json:"removed_document_policy,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}                                   `json:"-"`
+	XXX_unrecognized         []byte                                     `json:"-"`
+	XXX_sizecache            int32                                      `json:"-"`
 }
-func (m *Client) XXX_Unmarshal(b []byte) error {
+
+func (m *UpdatableProjectFields) Reset()         { *m = UpdatableProjectFields{} }
+func (m *UpdatableProjectFields) String() string { return proto.CompactTextString(m) }
+func (*UpdatableProjectFields) ProtoMessage()    {}
+func (*UpdatableProjectFields) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{18}
+}
+func (m *UpdatableProjectFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Client) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdatableProjectFields) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Client.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdatableProjectFields.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2608,99 +3282,174 @@ func (m *Client) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Client) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Client.Merge(m, src)
+func (m *UpdatableProjectFields) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatableProjectFields.Merge(m, src)
 }
-func (m *Client) XXX_Size() int {
+func (m *UpdatableProjectFields) XXX_Size() int {
 	return m.Size()
 }
-func (m *Client) XXX_DiscardUnknown() {
-	xxx_messageInfo_Client.DiscardUnknown(m)
+func (m *UpdatableProjectFields) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatableProjectFields.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Client proto.InternalMessageInfo
+var xxx_messageInfo_UpdatableProjectFields proto.InternalMessageInfo
 
-func (m *Client) GetId() []byte {
+func (m *UpdatableProjectFields) GetName() *types.StringValue {
 	if m != nil {
-		return m.Id
+		return m.Name
 	}
 	return nil
 }
 
-func (m *Client) GetPresence() *Presence {
+func (m *UpdatableProjectFields) GetAuthWebhookUrl() *types.StringValue {
 	if m != nil {
-		return m.Presence
+		return m.AuthWebhookUrl
 	}
 	return nil
 }
 
-type Clients struct {
-	Clients              []*Client `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+func (m *UpdatableProjectFields) GetAuthWebhookMethods() *UpdatableProjectFields_AuthWebhookMethods {
+	if m != nil {
+		return m.AuthWebhookMethods
+	}
+	return nil
 }
 
-func (m *Clients) Reset()         { *m = Clients{} }
-func (m *Clients) String() string { return proto.CompactTextString(m) }
-func (*Clients) ProtoMessage()    {}
-func (*Clients) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{17}
-}
-func (m *Clients) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *UpdatableProjectFields) GetDocumentKeyPolicy() *DocumentKeyPolicy {
+	if m != nil {
+		return m.DocumentKeyPolicy
+	}
+	return nil
 }
-func (m *Clients) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Clients.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *UpdatableProjectFields) GetCollectApplyLag() *types.BoolValue {
+	if m != nil {
+		return m.CollectApplyLag
 	}
+	return nil
 }
-func (m *Clients) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Clients.Merge(m, src)
+
+func (m *UpdatableProjectFields) GetInitialContent() *types.StringValue {
+	if m != nil {
+		return m.InitialContent
+	}
+	return nil
 }
-func (m *Clients) XXX_Size() int {
-	return m.Size()
+
+func (m *UpdatableProjectFields) GetObjectMergePolicy() *types.StringValue {
+	if m != nil {
+		return m.ObjectMergePolicy
+	}
+	return nil
 }
-func (m *Clients) XXX_DiscardUnknown() {
-	xxx_messageInfo_Clients.DiscardUnknown(m)
+
+func (m *UpdatableProjectFields) GetEventWebhookUrl() *types.StringValue {
+	if m != nil {
+		return m.EventWebhookUrl
+	}
+	return nil
 }
 
-var xxx_messageInfo_Clients proto.InternalMessageInfo
+func (m *UpdatableProjectFields) GetEphemeralKeyPrefix() *types.StringValue {
+	if m != nil {
+		return m.EphemeralKeyPrefix
+	}
+	return nil
+}
 
-func (m *Clients) GetClients() []*Client {
+func (m *UpdatableProjectFields) GetChangefeedUrl() *types.StringValue {
 	if m != nil {
-		return m.Clients
+		return m.ChangefeedUrl
 	}
 	return nil
 }
 
-type Checkpoint struct {
-	ServerSeq            uint64   `protobuf:"varint,1,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
-	ClientSeq            uint32   `protobuf:"varint,2,opt,name=client_seq,json=clientSeq,proto3" json:"client_seq,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *UpdatableProjectFields) GetFeatures() *UpdatableProjectFields_Features {
+	if m != nil {
+		return m.Features
+	}
+	return nil
 }
 
-func (m *Checkpoint) Reset()         { *m = Checkpoint{} }
-func (m *Checkpoint) String() string { return proto.CompactTextString(m) }
-func (*Checkpoint) ProtoMessage()    {}
-func (*Checkpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{18}
+func (m *UpdatableProjectFields) GetArchiveAfter() *types.StringValue {
+	if m != nil {
+		return m.ArchiveAfter
+	}
+	return nil
 }
-func (m *Checkpoint) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *UpdatableProjectFields) GetDocumentTemplates() *UpdatableProjectFields_DocumentTemplates {
+	if m != nil {
+		return m.DocumentTemplates
+	}
+	return nil
 }
-func (m *Checkpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Checkpoint.Marshal(b, m, deterministic)
+
+func (m *UpdatableProjectFields) GetExplicitDocumentCreation() *types.BoolValue {
+	if m != nil {
+		return m.ExplicitDocumentCreation
+	}
+	return nil
+}
+
+func (m *UpdatableProjectFields) GetActorIdPolicy() *types.StringValue {
+	if m != nil {
+		return m.ActorIdPolicy
+	}
+	return nil
+}
+
+func (m *UpdatableProjectFields) GetAllowedOperations() *UpdatableProjectFields_AllowedOperations {
+	if m != nil {
+		return m.AllowedOperations
+	}
+	return nil
+}
+
+func (m *UpdatableProjectFields) GetSnapshotPolicy() *SnapshotPolicy {
+	if m != nil {
+		return m.SnapshotPolicy
+	}
+	return nil
+}
+
+func (m *UpdatableProjectFields) GetMaxKeysPerObject() *types.Int32Value {
+	if m != nil {
+		return m.MaxKeysPerObject
+	}
+	return nil
+}
+
+func (m *UpdatableProjectFields) GetRemovedDocumentPolicy() *types.StringValue {
+	if m != nil {
+		return m.RemovedDocumentPolicy
+	}
+	return nil
+}
+
+type UpdatableProjectFields_AuthWebhookMethods struct {
+	Methods              []string `protobuf:"bytes,1,rep,name=methods,proto3" json:"methods,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdatableProjectFields_AuthWebhookMethods) Reset() {
+	*m = UpdatableProjectFields_AuthWebhookMethods{}
+}
+func (m *UpdatableProjectFields_AuthWebhookMethods) String() string {
+	return proto.CompactTextString(m)
+}
+func (*UpdatableProjectFields_AuthWebhookMethods) ProtoMessage() {}
+func (*UpdatableProjectFields_AuthWebhookMethods) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{18, 0}
+}
+func (m *UpdatableProjectFields_AuthWebhookMethods) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdatableProjectFields_AuthWebhookMethods) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdatableProjectFields_AuthWebhookMethods.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2710,53 +3459,44 @@ func (m *Checkpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Checkpoint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Checkpoint.Merge(m, src)
+func (m *UpdatableProjectFields_AuthWebhookMethods) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatableProjectFields_AuthWebhookMethods.Merge(m, src)
 }
-func (m *Checkpoint) XXX_Size() int {
+func (m *UpdatableProjectFields_AuthWebhookMethods) XXX_Size() int {
 	return m.Size()
 }
-func (m *Checkpoint) XXX_DiscardUnknown() {
-	xxx_messageInfo_Checkpoint.DiscardUnknown(m)
+func (m *UpdatableProjectFields_AuthWebhookMethods) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatableProjectFields_AuthWebhookMethods.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Checkpoint proto.InternalMessageInfo
-
-func (m *Checkpoint) GetServerSeq() uint64 {
-	if m != nil {
-		return m.ServerSeq
-	}
-	return 0
-}
+var xxx_messageInfo_UpdatableProjectFields_AuthWebhookMethods proto.InternalMessageInfo
 
-func (m *Checkpoint) GetClientSeq() uint32 {
+func (m *UpdatableProjectFields_AuthWebhookMethods) GetMethods() []string {
 	if m != nil {
-		return m.ClientSeq
+		return m.Methods
 	}
-	return 0
+	return nil
 }
 
-type TextNodePos struct {
-	CreatedAt            *TimeTicket `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Offset               int32       `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	RelativeOffset       int32       `protobuf:"varint,3,opt,name=relative_offset,json=relativeOffset,proto3" json:"relative_offset,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type UpdatableProjectFields_Features struct {
+	Features             map[string]bool `protobuf:"bytes,1,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *TextNodePos) Reset()         { *m = TextNodePos{} }
-func (m *TextNodePos) String() string { return proto.CompactTextString(m) }
-func (*TextNodePos) ProtoMessage()    {}
-func (*TextNodePos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{19}
+func (m *UpdatableProjectFields_Features) Reset()         { *m = UpdatableProjectFields_Features{} }
+func (m *UpdatableProjectFields_Features) String() string { return proto.CompactTextString(m) }
+func (*UpdatableProjectFields_Features) ProtoMessage()    {}
+func (*UpdatableProjectFields_Features) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{18, 1}
 }
-func (m *TextNodePos) XXX_Unmarshal(b []byte) error {
+func (m *UpdatableProjectFields_Features) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TextNodePos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdatableProjectFields_Features) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TextNodePos.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdatableProjectFields_Features.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2766,60 +3506,95 @@ func (m *TextNodePos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *TextNodePos) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TextNodePos.Merge(m, src)
+func (m *UpdatableProjectFields_Features) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatableProjectFields_Features.Merge(m, src)
 }
-func (m *TextNodePos) XXX_Size() int {
+func (m *UpdatableProjectFields_Features) XXX_Size() int {
 	return m.Size()
 }
-func (m *TextNodePos) XXX_DiscardUnknown() {
-	xxx_messageInfo_TextNodePos.DiscardUnknown(m)
+func (m *UpdatableProjectFields_Features) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatableProjectFields_Features.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TextNodePos proto.InternalMessageInfo
+var xxx_messageInfo_UpdatableProjectFields_Features proto.InternalMessageInfo
 
-func (m *TextNodePos) GetCreatedAt() *TimeTicket {
+func (m *UpdatableProjectFields_Features) GetFeatures() map[string]bool {
 	if m != nil {
-		return m.CreatedAt
+		return m.Features
 	}
 	return nil
 }
 
-func (m *TextNodePos) GetOffset() int32 {
-	if m != nil {
-		return m.Offset
+type UpdatableProjectFields_DocumentTemplates struct {
+	Templates            map[string]string `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UpdatableProjectFields_DocumentTemplates) Reset() {
+	*m = UpdatableProjectFields_DocumentTemplates{}
+}
+func (m *UpdatableProjectFields_DocumentTemplates) String() string { return proto.CompactTextString(m) }
+func (*UpdatableProjectFields_DocumentTemplates) ProtoMessage()    {}
+func (*UpdatableProjectFields_DocumentTemplates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{18, 2}
+}
+func (m *UpdatableProjectFields_DocumentTemplates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdatableProjectFields_DocumentTemplates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdatableProjectFields_DocumentTemplates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *UpdatableProjectFields_DocumentTemplates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatableProjectFields_DocumentTemplates.Merge(m, src)
+}
+func (m *UpdatableProjectFields_DocumentTemplates) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdatableProjectFields_DocumentTemplates) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatableProjectFields_DocumentTemplates.DiscardUnknown(m)
 }
 
-func (m *TextNodePos) GetRelativeOffset() int32 {
+var xxx_messageInfo_UpdatableProjectFields_DocumentTemplates proto.InternalMessageInfo
+
+func (m *UpdatableProjectFields_DocumentTemplates) GetTemplates() map[string]string {
 	if m != nil {
-		return m.RelativeOffset
+		return m.Templates
 	}
-	return 0
+	return nil
 }
 
-type TimeTicket struct {
-	Lamport              uint64   `protobuf:"varint,1,opt,name=lamport,proto3" json:"lamport,omitempty"`
-	Delimiter            uint32   `protobuf:"varint,2,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
-	ActorId              []byte   `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+type UpdatableProjectFields_AllowedOperations struct {
+	Operations           []string `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TimeTicket) Reset()         { *m = TimeTicket{} }
-func (m *TimeTicket) String() string { return proto.CompactTextString(m) }
-func (*TimeTicket) ProtoMessage()    {}
-func (*TimeTicket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{20}
+func (m *UpdatableProjectFields_AllowedOperations) Reset() {
+	*m = UpdatableProjectFields_AllowedOperations{}
 }
-func (m *TimeTicket) XXX_Unmarshal(b []byte) error {
+func (m *UpdatableProjectFields_AllowedOperations) String() string { return proto.CompactTextString(m) }
+func (*UpdatableProjectFields_AllowedOperations) ProtoMessage()    {}
+func (*UpdatableProjectFields_AllowedOperations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{18, 3}
+}
+func (m *UpdatableProjectFields_AllowedOperations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TimeTicket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdatableProjectFields_AllowedOperations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TimeTicket.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdatableProjectFields_AllowedOperations.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2829,60 +3604,53 @@ func (m *TimeTicket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TimeTicket) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TimeTicket.Merge(m, src)
+func (m *UpdatableProjectFields_AllowedOperations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatableProjectFields_AllowedOperations.Merge(m, src)
 }
-func (m *TimeTicket) XXX_Size() int {
+func (m *UpdatableProjectFields_AllowedOperations) XXX_Size() int {
 	return m.Size()
 }
-func (m *TimeTicket) XXX_DiscardUnknown() {
-	xxx_messageInfo_TimeTicket.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_TimeTicket proto.InternalMessageInfo
-
-func (m *TimeTicket) GetLamport() uint64 {
-	if m != nil {
-		return m.Lamport
-	}
-	return 0
+func (m *UpdatableProjectFields_AllowedOperations) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatableProjectFields_AllowedOperations.DiscardUnknown(m)
 }
 
-func (m *TimeTicket) GetDelimiter() uint32 {
-	if m != nil {
-		return m.Delimiter
-	}
-	return 0
-}
+var xxx_messageInfo_UpdatableProjectFields_AllowedOperations proto.InternalMessageInfo
 
-func (m *TimeTicket) GetActorId() []byte {
+func (m *UpdatableProjectFields_AllowedOperations) GetOperations() []string {
 	if m != nil {
-		return m.ActorId
+		return m.Operations
 	}
 	return nil
 }
 
-type DocEvent struct {
-	Type                 DocEventType `protobuf:"varint,1,opt,name=type,proto3,enum=api.DocEventType" json:"type,omitempty"`
-	Publisher            *Client      `protobuf:"bytes,2,opt,name=publisher,proto3" json:"publisher,omitempty"`
-	DocumentKeys         []string     `protobuf:"bytes,3,rep,name=document_keys,json=documentKeys,proto3" json:"document_keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type DocumentSummary struct {
+	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key                  string            `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Snapshot             string            `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	CreatedAt            *types.Timestamp  `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AccessedAt           *types.Timestamp  `protobuf:"bytes,5,opt,name=accessed_at,json=accessedAt,proto3" json:"accessed_at,omitempty"`
+	UpdatedAt            *types.Timestamp  `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ArchivedAt           *types.Timestamp  `protobuf:"bytes,8,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	OperationCounts      map[string]int64  `protobuf:"bytes,9,rep,name=operation_counts,json=operationCounts,proto3" json:"operation_counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	RemovedAt            *types.Timestamp  `protobuf:"bytes,10,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *DocEvent) Reset()         { *m = DocEvent{} }
-func (m *DocEvent) String() string { return proto.CompactTextString(m) }
-func (*DocEvent) ProtoMessage()    {}
-func (*DocEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf1b13971fe4c19d, []int{21}
+func (m *DocumentSummary) Reset()         { *m = DocumentSummary{} }
+func (m *DocumentSummary) String() string { return proto.CompactTextString(m) }
+func (*DocumentSummary) ProtoMessage()    {}
+func (*DocumentSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{19}
 }
-func (m *DocEvent) XXX_Unmarshal(b []byte) error {
+func (m *DocumentSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DocEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DocumentSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DocEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DocumentSummary.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2892,1632 +3660,1727 @@ func (m *DocEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *DocEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DocEvent.Merge(m, src)
+func (m *DocumentSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentSummary.Merge(m, src)
 }
-func (m *DocEvent) XXX_Size() int {
+func (m *DocumentSummary) XXX_Size() int {
 	return m.Size()
 }
-func (m *DocEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_DocEvent.DiscardUnknown(m)
+func (m *DocumentSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentSummary.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DocEvent proto.InternalMessageInfo
+var xxx_messageInfo_DocumentSummary proto.InternalMessageInfo
 
-func (m *DocEvent) GetType() DocEventType {
+func (m *DocumentSummary) GetId() string {
 	if m != nil {
-		return m.Type
+		return m.Id
 	}
-	return DocEventType_DOCUMENTS_CHANGED
+	return ""
 }
 
-func (m *DocEvent) GetPublisher() *Client {
+func (m *DocumentSummary) GetKey() string {
 	if m != nil {
-		return m.Publisher
+		return m.Key
+	}
+	return ""
+}
+
+func (m *DocumentSummary) GetSnapshot() string {
+	if m != nil {
+		return m.Snapshot
+	}
+	return ""
+}
+
+func (m *DocumentSummary) GetCreatedAt() *types.Timestamp {
+	if m != nil {
+		return m.CreatedAt
 	}
 	return nil
 }
 
-func (m *DocEvent) GetDocumentKeys() []string {
+func (m *DocumentSummary) GetAccessedAt() *types.Timestamp {
 	if m != nil {
-		return m.DocumentKeys
+		return m.AccessedAt
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("api.ValueType", ValueType_name, ValueType_value)
-	proto.RegisterEnum("api.DocEventType", DocEventType_name, DocEventType_value)
-	proto.RegisterType((*ChangePack)(nil), "api.ChangePack")
-	proto.RegisterType((*Change)(nil), "api.Change")
-	proto.RegisterType((*ChangeID)(nil), "api.ChangeID")
-	proto.RegisterType((*Operation)(nil), "api.Operation")
-	proto.RegisterType((*Operation_Set)(nil), "api.Operation.Set")
-	proto.RegisterType((*Operation_Add)(nil), "api.Operation.Add")
-	proto.RegisterType((*Operation_Move)(nil), "api.Operation.Move")
-	proto.RegisterType((*Operation_Remove)(nil), "api.Operation.Remove")
-	proto.RegisterType((*Operation_Edit)(nil), "api.Operation.Edit")
-	proto.RegisterMapType((map[string]*TimeTicket)(nil), "api.Operation.Edit.CreatedAtMapByActorEntry")
-	proto.RegisterType((*Operation_Select)(nil), "api.Operation.Select")
-	proto.RegisterType((*Operation_RichEdit)(nil), "api.Operation.RichEdit")
-	proto.RegisterMapType((map[string]string)(nil), "api.Operation.RichEdit.AttributesEntry")
-	proto.RegisterMapType((map[string]*TimeTicket)(nil), "api.Operation.RichEdit.CreatedAtMapByActorEntry")
-	proto.RegisterType((*Operation_Style)(nil), "api.Operation.Style")
-	proto.RegisterMapType((map[string]string)(nil), "api.Operation.Style.AttributesEntry")
-	proto.RegisterType((*Operation_Increase)(nil), "api.Operation.Increase")
-	proto.RegisterType((*JSONElementSimple)(nil), "api.JSONElementSimple")
-	proto.RegisterType((*JSONElement)(nil), "api.JSONElement")
-	proto.RegisterType((*JSONElement_JSONObject)(nil), "api.JSONElement.JSONObject")
-	proto.RegisterType((*JSONElement_JSONArray)(nil), "api.JSONElement.JSONArray")
-	proto.RegisterType((*JSONElement_Primitive)(nil), "api.JSONElement.Primitive")
-	proto.RegisterType((*JSONElement_Text)(nil), "api.JSONElement.Text")
-	proto.RegisterType((*JSONElement_RichText)(nil), "api.JSONElement.RichText")
-	proto.RegisterType((*JSONElement_Counter)(nil), "api.JSONElement.Counter")
-	proto.RegisterType((*RHTNode)(nil), "api.RHTNode")
-	proto.RegisterType((*RGANode)(nil), "api.RGANode")
-	proto.RegisterType((*TextNode)(nil), "api.TextNode")
-	proto.RegisterType((*RichTextNodeAttr)(nil), "api.RichTextNodeAttr")
-	proto.RegisterType((*RichTextNode)(nil), "api.RichTextNode")
-	proto.RegisterMapType((map[string]*RichTextNodeAttr)(nil), "api.RichTextNode.AttributesEntry")
-	proto.RegisterType((*TextNodeID)(nil), "api.TextNodeID")
-	proto.RegisterType((*Project)(nil), "api.Project")
-	proto.RegisterType((*UpdatableProjectFields)(nil), "api.UpdatableProjectFields")
-	proto.RegisterType((*UpdatableProjectFields_AuthWebhookMethods)(nil), "api.UpdatableProjectFields.AuthWebhookMethods")
-	proto.RegisterType((*DocumentSummary)(nil), "api.DocumentSummary")
-	proto.RegisterType((*Presence)(nil), "api.Presence")
-	proto.RegisterMapType((map[string]string)(nil), "api.Presence.DataEntry")
-	proto.RegisterType((*Client)(nil), "api.Client")
-	proto.RegisterType((*Clients)(nil), "api.Clients")
-	proto.RegisterType((*Checkpoint)(nil), "api.Checkpoint")
-	proto.RegisterType((*TextNodePos)(nil), "api.TextNodePos")
-	proto.RegisterType((*TimeTicket)(nil), "api.TimeTicket")
-	proto.RegisterType((*DocEvent)(nil), "api.DocEvent")
+func (m *DocumentSummary) GetUpdatedAt() *types.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("resources.proto", fileDescriptor_cf1b13971fe4c19d) }
-
-var fileDescriptor_cf1b13971fe4c19d = []byte{
-	// 2183 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcd, 0x6f, 0xdb, 0xd8,
-	0x11, 0x17, 0x29, 0xea, 0x83, 0x23, 0xd9, 0x66, 0x5e, 0x9c, 0x44, 0xab, 0x26, 0x5e, 0x47, 0xbb,
-	0x69, 0x9c, 0x6c, 0x20, 0x1b, 0xe9, 0xc7, 0x7e, 0x04, 0x2d, 0x20, 0x4b, 0x5a, 0xcb, 0xbb, 0x8e,
-	0x64, 0x50, 0x72, 0xd3, 0x3d, 0xb1, 0x14, 0xf9, 0x6c, 0x31, 0x96, 0x48, 0x86, 0xa4, 0xbc, 0xd1,
-	0xa5, 0x40, 0x0b, 0xb4, 0x87, 0x9e, 0x7b, 0xe8, 0xb9, 0x58, 0x60, 0xff, 0x81, 0x02, 0x3d, 0xb4,
-	0x45, 0x0e, 0xbd, 0xec, 0x6d, 0xdb, 0x63, 0x51, 0xa0, 0x58, 0xa4, 0xff, 0x48, 0xf1, 0x3e, 0x28,
-	0x91, 0x92, 0x1c, 0x45, 0xf0, 0x16, 0x31, 0xf6, 0xc6, 0xf7, 0xe6, 0x37, 0x6f, 0xe6, 0xcd, 0xcc,
-	0x7b, 0x33, 0x8f, 0x03, 0x6b, 0x1e, 0xf6, 0x9d, 0xa1, 0x67, 0x60, 0xbf, 0xec, 0x7a, 0x4e, 0xe0,
-	0xa0, 0xa4, 0xee, 0x5a, 0xc5, 0xb7, 0x4f, 0x1c, 0xe7, 0xa4, 0x8f, 0xb7, 0xe9, 0x54, 0x77, 0x78,
-	0xbc, 0x1d, 0x58, 0x03, 0xec, 0x07, 0xfa, 0xc0, 0x65, 0xa8, 0xe2, 0xc6, 0x34, 0xe0, 0x73, 0x4f,
-	0x77, 0x5d, 0xec, 0xf1, 0x55, 0x4a, 0xdf, 0x08, 0x00, 0xd5, 0x9e, 0x6e, 0x9f, 0xe0, 0x43, 0xdd,
-	0x38, 0x45, 0xb7, 0x21, 0x6f, 0x3a, 0xc6, 0x70, 0x80, 0xed, 0x40, 0x3b, 0xc5, 0xa3, 0x82, 0xb0,
-	0x29, 0x6c, 0xc9, 0x6a, 0x2e, 0x9c, 0xfb, 0x14, 0x8f, 0xd0, 0x36, 0x80, 0xd1, 0xc3, 0xc6, 0xa9,
-	0xeb, 0x58, 0x76, 0x50, 0x10, 0x37, 0x85, 0xad, 0xdc, 0xc3, 0xb5, 0xb2, 0xee, 0x5a, 0xe5, 0xea,
-	0x78, 0x5a, 0x8d, 0x40, 0x50, 0x11, 0xb2, 0xbe, 0xad, 0xbb, 0x7e, 0xcf, 0x09, 0x0a, 0xc9, 0x4d,
-	0x61, 0x2b, 0xaf, 0x8e, 0xc7, 0xe8, 0x0e, 0x64, 0x0c, 0x2a, 0xdd, 0x2f, 0x48, 0x9b, 0xc9, 0xad,
-	0xdc, 0xc3, 0x1c, 0x5f, 0x89, 0xcc, 0xa9, 0x21, 0x0d, 0x3d, 0x82, 0x2b, 0x03, 0xcb, 0xd6, 0xfc,
-	0x91, 0x6d, 0x60, 0x53, 0x0b, 0x2c, 0xe3, 0x14, 0x07, 0x85, 0x54, 0x44, 0x74, 0xc7, 0x1a, 0xe0,
-	0x0e, 0x9d, 0x56, 0xd7, 0x06, 0x96, 0xdd, 0xa6, 0x40, 0x36, 0x51, 0x7a, 0x06, 0x69, 0xb6, 0x1e,
-	0xba, 0x05, 0xa2, 0x65, 0xd2, 0x3d, 0xe5, 0x1e, 0xae, 0x44, 0x04, 0xed, 0xd7, 0x54, 0xd1, 0x32,
-	0x51, 0x01, 0x32, 0x03, 0xec, 0xfb, 0xfa, 0x09, 0xa6, 0xdb, 0x92, 0xd5, 0x70, 0x88, 0xca, 0x00,
-	0x8e, 0x8b, 0x3d, 0x3d, 0xb0, 0x1c, 0xdb, 0x2f, 0x24, 0xa9, 0xa6, 0xab, 0x74, 0x81, 0x56, 0x38,
-	0xad, 0x46, 0x10, 0xa5, 0xdf, 0x08, 0x90, 0x0d, 0x97, 0x46, 0xb7, 0x00, 0x8c, 0xbe, 0x45, 0x2c,
-	0xea, 0xe3, 0x67, 0x54, 0xfa, 0x8a, 0x2a, 0xb3, 0x99, 0x36, 0x7e, 0x86, 0x6e, 0x03, 0xf8, 0xd8,
-	0x3b, 0xc3, 0x1e, 0x25, 0x13, 0xc1, 0xd2, 0xae, 0xb8, 0x23, 0xa8, 0x32, 0x9b, 0x25, 0x90, 0x9b,
-	0x90, 0xe9, 0xeb, 0x03, 0xd7, 0xf1, 0x98, 0x01, 0x19, 0x3d, 0x9c, 0x42, 0x6f, 0x41, 0x56, 0x37,
-	0x02, 0xc7, 0xd3, 0x2c, 0xb3, 0x20, 0x51, 0xfb, 0x66, 0xe8, 0x78, 0xdf, 0x2c, 0x7d, 0x75, 0x1d,
-	0xe4, 0xb1, 0x86, 0xe8, 0xfb, 0x90, 0xf4, 0x71, 0xc0, 0xf7, 0x8f, 0xe2, 0xea, 0x97, 0xdb, 0x38,
-	0x68, 0x24, 0x54, 0x02, 0x20, 0x38, 0xdd, 0x34, 0xb9, 0x6b, 0xa7, 0x71, 0x15, 0xd3, 0x24, 0x38,
-	0xdd, 0x34, 0xd1, 0x3d, 0x90, 0x06, 0xce, 0x19, 0xa6, 0x3a, 0xe5, 0x1e, 0x5e, 0x9d, 0x02, 0x3e,
-	0x76, 0xce, 0x70, 0x23, 0xa1, 0x52, 0x08, 0xda, 0x86, 0xb4, 0x87, 0x29, 0x58, 0xa2, 0xe0, 0x6b,
-	0x53, 0x60, 0x95, 0x12, 0x1b, 0x09, 0x95, 0xc3, 0xc8, 0xda, 0xd8, 0xb4, 0x42, 0x27, 0x4f, 0xaf,
-	0x5d, 0x37, 0x2d, 0xa2, 0x2d, 0x85, 0x90, 0xb5, 0x7d, 0xdc, 0xc7, 0x46, 0x50, 0x48, 0xcf, 0x5d,
-	0xbb, 0x4d, 0x89, 0x64, 0x6d, 0x06, 0x43, 0x3f, 0x06, 0xd9, 0xb3, 0x8c, 0x9e, 0x46, 0x05, 0x64,
-	0x28, 0xcf, 0x8d, 0x69, 0x7d, 0x2c, 0xa3, 0xc7, 0x85, 0x64, 0x3d, 0xfe, 0x8d, 0x1e, 0x40, 0xca,
-	0x0f, 0x46, 0x7d, 0x5c, 0xc8, 0x52, 0x9e, 0xf5, 0x69, 0x39, 0x84, 0xd6, 0x48, 0xa8, 0x0c, 0x84,
-	0x7e, 0x04, 0x59, 0xcb, 0x36, 0x3c, 0xac, 0xfb, 0xb8, 0x20, 0xcf, 0x15, 0xb2, 0xcf, 0xc9, 0x44,
-	0x48, 0x08, 0x2d, 0xfe, 0x49, 0x80, 0x64, 0x1b, 0x07, 0x24, 0xe4, 0x5d, 0xdd, 0x23, 0x51, 0x43,
-	0x08, 0x01, 0x36, 0x35, 0x3d, 0x74, 0xdd, 0x6c, 0xc8, 0x33, 0x64, 0x95, 0x01, 0x2b, 0x01, 0x52,
-	0x20, 0x49, 0x4e, 0x2f, 0x8b, 0x62, 0xf2, 0x49, 0x74, 0x3f, 0xd3, 0xfb, 0xc3, 0xd0, 0x59, 0xd7,
-	0xe9, 0x12, 0x9f, 0xb4, 0x5b, 0xcd, 0x7a, 0x1f, 0x93, 0x93, 0xdd, 0xb6, 0x06, 0x6e, 0x1f, 0xab,
-	0x0c, 0x84, 0x76, 0x20, 0x87, 0x9f, 0x63, 0x63, 0xc8, 0xc5, 0x4a, 0xf3, 0xc5, 0x42, 0x88, 0xa9,
-	0x04, 0xc5, 0x7f, 0x0b, 0x90, 0xac, 0x98, 0xe6, 0xc5, 0xd4, 0x7e, 0x1f, 0xd6, 0x5c, 0x0f, 0x9f,
-	0x45, 0x59, 0xc5, 0xf9, 0xac, 0x2b, 0x04, 0x37, 0x61, 0xfc, 0x7f, 0xef, 0xee, 0x3f, 0x02, 0x48,
-	0x24, 0x9e, 0xdf, 0xd0, 0xf6, 0xca, 0x00, 0x11, 0x9e, 0xe4, 0x7c, 0x1e, 0xd9, 0x18, 0xe3, 0x97,
-	0xdf, 0xe0, 0x97, 0x02, 0xa4, 0xd9, 0x19, 0xbc, 0xd8, 0x16, 0xe3, 0x9a, 0x8a, 0xcb, 0x6a, 0x9a,
-	0x5c, 0xac, 0xe9, 0xef, 0x93, 0x20, 0xd1, 0xd3, 0x78, 0x21, 0x3d, 0xdf, 0x05, 0xe9, 0xd8, 0x73,
-	0x06, 0x5c, 0x43, 0x85, 0xe1, 0xf1, 0xf3, 0xa0, 0xe9, 0x98, 0xf8, 0xd0, 0xf1, 0x55, 0x4a, 0x45,
-	0x9b, 0x20, 0x06, 0x0e, 0x57, 0x6a, 0x16, 0x23, 0x06, 0x0e, 0xea, 0xc2, 0x8d, 0x89, 0x74, 0x6d,
-	0xa0, 0xbb, 0x5a, 0x77, 0xa4, 0xd1, 0xdb, 0x97, 0xe7, 0xb3, 0x07, 0x73, 0x6e, 0xae, 0xf2, 0x58,
-	0x8f, 0xc7, 0xba, 0xbb, 0x3b, 0xaa, 0x10, 0x78, 0xdd, 0x0e, 0xbc, 0x91, 0x7a, 0xd5, 0x98, 0xa5,
-	0x90, 0xb4, 0x64, 0x38, 0x76, 0x80, 0x6d, 0x76, 0x1b, 0xca, 0x6a, 0x38, 0x9c, 0xb6, 0x5e, 0x7a,
-	0xb1, 0xf5, 0x9e, 0x40, 0xe1, 0x3c, 0xe1, 0xe1, 0xa5, 0x21, 0x4c, 0x2e, 0x8d, 0x3b, 0xe1, 0xb1,
-	0x3a, 0xc7, 0x91, 0x8c, 0xfa, 0x91, 0xf8, 0x81, 0x50, 0x7c, 0x21, 0x40, 0x9a, 0x5d, 0xb4, 0x97,
-	0xc3, 0x31, 0xcb, 0x1f, 0x81, 0x2f, 0x24, 0xc8, 0x86, 0xd7, 0xfe, 0xe5, 0xd8, 0xc3, 0xf1, 0xa2,
-	0xe0, 0xda, 0x39, 0x27, 0x6b, 0x7d, 0x6b, 0x01, 0xb6, 0x07, 0xa0, 0x07, 0x81, 0x67, 0x75, 0x87,
-	0x01, 0xf6, 0x0b, 0x69, 0x2a, 0xf4, 0xee, 0x79, 0x42, 0x2b, 0x63, 0x24, 0x93, 0x15, 0x61, 0x9d,
-	0x76, 0x47, 0xe6, 0x0d, 0x46, 0xea, 0x4f, 0x60, 0x6d, 0x4a, 0xd3, 0x39, 0xeb, 0xad, 0x47, 0xd7,
-	0x93, 0xa3, 0xec, 0x7f, 0x17, 0x21, 0x45, 0x33, 0xfd, 0xe5, 0x88, 0x91, 0x5a, 0xcc, 0x43, 0x2c,
-	0x2c, 0xde, 0x9d, 0x57, 0x98, 0x2c, 0xe3, 0x9e, 0xd4, 0x62, 0xf7, 0x5c, 0xd0, 0x8a, 0x5f, 0x0a,
-	0x90, 0x0d, 0xcb, 0x9f, 0x8b, 0x19, 0xf2, 0x41, 0xdc, 0xf3, 0xcb, 0xa5, 0xfe, 0xc5, 0xf9, 0x66,
-	0x37, 0x0d, 0x52, 0xd7, 0x31, 0x47, 0xa5, 0x7f, 0x09, 0x70, 0x65, 0x66, 0xd9, 0xa9, 0x7c, 0x27,
-	0x2c, 0xcc, 0x77, 0xf7, 0x21, 0x4b, 0x92, 0xec, 0xab, 0xb2, 0x63, 0x86, 0x02, 0x58, 0x2e, 0x65,
-	0xc5, 0xf0, 0x2b, 0xb3, 0x3e, 0x87, 0x54, 0x02, 0x54, 0x02, 0x29, 0x18, 0xb9, 0xac, 0xc2, 0x5e,
-	0xe5, 0xcf, 0x93, 0x9f, 0x91, 0x5d, 0x77, 0x46, 0x2e, 0x56, 0x29, 0x6d, 0xe2, 0x91, 0x14, 0x7d,
-	0x28, 0xb0, 0x41, 0xe9, 0x77, 0x79, 0xc8, 0x45, 0xf6, 0x86, 0x7e, 0x0a, 0xb9, 0xa7, 0xbe, 0x63,
-	0x6b, 0x4e, 0xf7, 0x29, 0x29, 0xab, 0xd9, 0xb6, 0xbe, 0x37, 0x6d, 0x59, 0xfa, 0xdd, 0xa2, 0x90,
-	0x46, 0x42, 0x05, 0xc2, 0xc1, 0x46, 0xe8, 0x11, 0xd0, 0x91, 0xa6, 0x7b, 0x9e, 0x3e, 0xe2, 0xfb,
-	0x2c, 0xce, 0x65, 0xaf, 0x10, 0x44, 0x23, 0xa1, 0xca, 0x04, 0x4f, 0x07, 0xe8, 0x23, 0x90, 0x5d,
-	0xcf, 0x1a, 0x58, 0x81, 0x35, 0x7e, 0x5a, 0xcc, 0xf2, 0x1e, 0x86, 0x08, 0xc2, 0x3b, 0x86, 0xa3,
-	0xf7, 0x40, 0x0a, 0xf0, 0xf3, 0x20, 0xf6, 0xc8, 0x88, 0xb2, 0x91, 0xd3, 0x43, 0xde, 0x0d, 0x04,
-	0x84, 0x3e, 0xe0, 0xcf, 0x00, 0xca, 0xc1, 0x42, 0xfe, 0xad, 0x19, 0x0e, 0x72, 0xbb, 0x71, 0x2e,
-	0xfa, 0x10, 0x20, 0xdf, 0xe8, 0x87, 0xe4, 0xc2, 0x1c, 0xda, 0x01, 0xf6, 0x78, 0xce, 0x2d, 0xcc,
-	0xf0, 0x55, 0x19, 0xbd, 0x91, 0x50, 0x43, 0x68, 0xf1, 0xaf, 0x02, 0xc0, 0xc4, 0x64, 0xa8, 0x04,
-	0x29, 0xdb, 0x31, 0xb1, 0x5f, 0x10, 0xe8, 0xa1, 0xcd, 0xd3, 0x25, 0xd4, 0x46, 0x87, 0x9c, 0x6e,
-	0x95, 0x91, 0x96, 0x2e, 0xa7, 0xa2, 0xe1, 0x95, 0x5c, 0x2a, 0xbc, 0xa4, 0x45, 0xe1, 0x55, 0xfc,
-	0x8b, 0x00, 0xf2, 0xd8, 0x65, 0xe7, 0x68, 0xbf, 0x57, 0xb9, 0xac, 0xda, 0xff, 0x53, 0x00, 0x79,
-	0x1c, 0x34, 0xe3, 0xa3, 0x22, 0xbc, 0xce, 0x51, 0x11, 0x23, 0x47, 0x65, 0xe9, 0x52, 0x3c, 0xba,
-	0x27, 0x69, 0xa9, 0x3d, 0xa5, 0x16, 0xee, 0xe9, 0xcf, 0x02, 0x48, 0x34, 0x1e, 0xdf, 0x89, 0x3b,
-	0x63, 0x25, 0x96, 0x29, 0x2e, 0xa3, 0x37, 0x5e, 0x08, 0xac, 0xd6, 0xa2, 0xda, 0xdf, 0x8d, 0x6b,
-	0x7f, 0x85, 0x85, 0x12, 0xa7, 0x5e, 0xd6, 0x1d, 0x7c, 0x2d, 0x40, 0x86, 0x9f, 0xf1, 0xef, 0x46,
-	0x34, 0x91, 0x44, 0xb7, 0x4b, 0x12, 0xdd, 0x1e, 0x64, 0xf8, 0x2d, 0x34, 0x27, 0xa3, 0xdf, 0x87,
-	0x0c, 0x66, 0x37, 0x5c, 0xac, 0x72, 0x89, 0xdc, 0x7c, 0x6a, 0x08, 0x28, 0x3d, 0x81, 0x0c, 0xbf,
-	0x10, 0xd0, 0x26, 0x48, 0x36, 0xb9, 0x65, 0x59, 0x26, 0x89, 0x5f, 0x16, 0x94, 0xb2, 0xd4, 0xc2,
-	0x7f, 0x14, 0x20, 0x1b, 0xc6, 0x06, 0x7a, 0x3b, 0xf2, 0x4f, 0x6f, 0x2d, 0x16, 0xf8, 0xfc, 0xaf,
-	0xde, 0xdc, 0x22, 0x64, 0xe9, 0xe4, 0xba, 0x0d, 0x39, 0xcb, 0xf6, 0x35, 0xfa, 0x7e, 0xe7, 0xff,
-	0xd9, 0xe6, 0xc8, 0x93, 0x2d, 0xdb, 0x3f, 0xf4, 0xf0, 0xd9, 0xbe, 0x59, 0x7a, 0x0a, 0x4a, 0x34,
-	0x86, 0x49, 0xb1, 0xf4, 0xba, 0x15, 0x12, 0x51, 0x6e, 0xe8, 0x9a, 0x8b, 0xc2, 0x82, 0x43, 0x2a,
-	0x41, 0xe9, 0x85, 0x08, 0xf9, 0xa8, 0xb0, 0xc5, 0x46, 0xa9, 0xc4, 0xca, 0x46, 0x91, 0x1e, 0xbc,
-	0xdb, 0x33, 0x07, 0xef, 0x95, 0x35, 0xe3, 0x7a, 0xf4, 0x9f, 0xcb, 0x39, 0x76, 0x95, 0x96, 0xb5,
-	0x6b, 0x6a, 0x91, 0x5d, 0x8b, 0x9d, 0xd7, 0x29, 0x3c, 0xdf, 0x8b, 0x17, 0x85, 0xd7, 0x66, 0x76,
-	0x46, 0x96, 0x88, 0xd4, 0xa3, 0xa5, 0x0e, 0xc0, 0x44, 0xdc, 0xd2, 0x55, 0xdd, 0x75, 0x48, 0x3b,
-	0xc7, 0xc7, 0x3e, 0x66, 0xb1, 0x9b, 0x52, 0xf9, 0xa8, 0xf4, 0x37, 0x11, 0x32, 0x87, 0x9e, 0x43,
-	0xd3, 0xfd, 0xea, 0xd8, 0x25, 0x32, 0xf5, 0x00, 0x02, 0xc9, 0xd6, 0x07, 0xa1, 0xe3, 0xe9, 0x37,
-	0xba, 0x05, 0xe0, 0x0e, 0xbb, 0x7d, 0xcb, 0xa0, 0xff, 0xde, 0x99, 0x5d, 0x65, 0x36, 0xf3, 0x29,
-	0x1e, 0x11, 0xb2, 0x8f, 0x0d, 0x0f, 0xb3, 0x5f, 0xf3, 0x12, 0x23, 0xb3, 0x19, 0x42, 0xde, 0x02,
-	0x45, 0x1f, 0x06, 0x3d, 0xed, 0x73, 0xdc, 0xed, 0x39, 0xce, 0xa9, 0x36, 0xf4, 0xfa, 0xfc, 0x3d,
-	0xb7, 0x4a, 0xe6, 0x9f, 0xb0, 0xe9, 0x23, 0xaf, 0x8f, 0x76, 0x60, 0x3d, 0x86, 0x1c, 0xe0, 0xa0,
-	0xe7, 0x98, 0xec, 0x81, 0x27, 0xab, 0x28, 0x82, 0x7e, 0xcc, 0x28, 0xe8, 0xc3, 0x98, 0x45, 0x32,
-	0xbc, 0x2a, 0x63, 0xbd, 0x85, 0x72, 0xd8, 0x5b, 0xa0, 0xd6, 0xa1, 0xcd, 0x87, 0xa8, 0x71, 0x3e,
-	0x8c, 0x05, 0x73, 0x76, 0x31, 0xeb, 0x24, 0xae, 0xbf, 0x10, 0xe1, 0xfa, 0x11, 0x19, 0xe9, 0xdd,
-	0x3e, 0xe6, 0x86, 0xfc, 0xd8, 0xc2, 0x7d, 0x93, 0xbc, 0x58, 0x98, 0xf9, 0x98, 0x73, 0x6e, 0xce,
-	0xac, 0xd7, 0x0e, 0x3c, 0xcb, 0x3e, 0xa1, 0xb7, 0x30, 0x37, 0xee, 0xc7, 0x73, 0xcc, 0x23, 0xbe,
-	0x06, 0xf7, 0xb4, 0xf1, 0x7e, 0x71, 0x8e, 0xf1, 0xd8, 0x31, 0x2d, 0xd3, 0x30, 0x99, 0xaf, 0x74,
-	0xb9, 0x32, 0x63, 0xd8, 0x79, 0xc6, 0x2e, 0x96, 0x01, 0xcd, 0x22, 0x59, 0x77, 0x82, 0x89, 0x12,
-	0xa8, 0x9f, 0xc2, 0x61, 0xe9, 0xd7, 0x22, 0xac, 0xd5, 0x78, 0x87, 0xa6, 0x3d, 0x1c, 0x0c, 0x74,
-	0x6f, 0x34, 0x13, 0x6e, 0xb3, 0x7f, 0x84, 0xa7, 0xdb, 0x32, 0x72, 0xa4, 0x2d, 0x13, 0x77, 0xb7,
-	0xb4, 0x8c, 0xbb, 0x1f, 0x41, 0x4e, 0x37, 0x0c, 0xec, 0xfb, 0xd1, 0xbc, 0xf3, 0x2a, 0x5e, 0x08,
-	0xe1, 0x33, 0xb1, 0x92, 0x5e, 0x26, 0x56, 0x7e, 0x2b, 0x40, 0xf6, 0xd0, 0xc3, 0x3e, 0xb6, 0x0d,
-	0x9a, 0x6d, 0x8d, 0xbe, 0x63, 0x9c, 0x52, 0x03, 0xa4, 0x54, 0x36, 0x20, 0xaf, 0x03, 0xe2, 0x16,
-	0x7e, 0xdd, 0xb1, 0xbf, 0xf1, 0x21, 0x4b, 0xb9, 0xa6, 0x07, 0x3a, 0xbb, 0xe4, 0x28, 0xa8, 0xf8,
-	0x3e, 0xc8, 0xe3, 0xa9, 0x65, 0x9e, 0xb6, 0xa5, 0x2a, 0xa4, 0xab, 0xb4, 0xb9, 0x13, 0xf1, 0x41,
-	0x9e, 0xfa, 0xe0, 0x1e, 0x64, 0x5d, 0x2e, 0x8e, 0x47, 0xde, 0x4a, 0x4c, 0x07, 0x75, 0x4c, 0x2e,
-	0xed, 0x40, 0x86, 0x2d, 0xe2, 0xd3, 0x16, 0x19, 0xfb, 0xe4, 0x05, 0x12, 0x6f, 0x91, 0xd1, 0x39,
-	0x35, 0xa4, 0x95, 0x9a, 0x00, 0x93, 0xfe, 0xdb, 0x54, 0x53, 0x49, 0x98, 0xd7, 0x54, 0x8a, 0xb7,
-	0xa5, 0xc4, 0xa9, 0xb6, 0x54, 0xe9, 0x97, 0x90, 0x8b, 0xfc, 0x6b, 0xf8, 0xb6, 0xae, 0x44, 0x74,
-	0x17, 0xd6, 0x3c, 0xdc, 0xd7, 0x49, 0x15, 0xae, 0x71, 0x40, 0x92, 0x02, 0x56, 0xc3, 0xe9, 0x16,
-	0xbb, 0x3b, 0x0d, 0x80, 0xc9, 0xca, 0xd1, 0x0e, 0x98, 0x30, 0xdb, 0x01, 0xbb, 0x09, 0xb2, 0x89,
-	0xfb, 0xa4, 0xb8, 0xc7, 0x5e, 0xb8, 0x93, 0xf1, 0x44, 0xac, 0x3f, 0x96, 0x8c, 0xf7, 0xc7, 0x7e,
-	0x25, 0x40, 0xb6, 0xe6, 0x18, 0xf5, 0x33, 0xe2, 0xae, 0x3b, 0xb1, 0x32, 0x8e, 0x95, 0xa1, 0x21,
-	0x31, 0x52, 0xc9, 0xdd, 0x03, 0x76, 0x25, 0xfb, 0x3d, 0x2e, 0x6c, 0xca, 0x23, 0x13, 0x2a, 0x7a,
-	0x07, 0x56, 0xa2, 0xdd, 0x54, 0xd6, 0x39, 0x94, 0xd5, 0x7c, 0xa4, 0x9d, 0xea, 0xdf, 0xff, 0x5a,
-	0x00, 0x79, 0x5c, 0x2d, 0xa2, 0x2c, 0x48, 0xcd, 0xa3, 0x83, 0x03, 0x25, 0x81, 0x72, 0x90, 0xd9,
-	0x6d, 0xb5, 0x0e, 0xea, 0x95, 0xa6, 0x22, 0x90, 0xc1, 0x7e, 0xb3, 0x53, 0xdf, 0xab, 0xab, 0x8a,
-	0x48, 0x30, 0x07, 0xad, 0xe6, 0x9e, 0x92, 0x44, 0x00, 0xe9, 0x5a, 0xeb, 0x68, 0xf7, 0xa0, 0xae,
-	0x48, 0xe4, 0xbb, 0xdd, 0x51, 0xf7, 0x9b, 0x7b, 0x4a, 0x0a, 0xc9, 0x90, 0xda, 0xfd, 0xac, 0x53,
-	0x6f, 0x2b, 0x69, 0x02, 0xae, 0x55, 0x3a, 0x75, 0x25, 0x83, 0xd6, 0xd8, 0x23, 0x5f, 0x6b, 0xed,
-	0x7e, 0x52, 0xaf, 0x76, 0x94, 0x2c, 0x5a, 0x65, 0xef, 0x51, 0xad, 0xa2, 0xaa, 0x95, 0xcf, 0x14,
-	0x99, 0x40, 0x3b, 0xf5, 0x9f, 0x77, 0x14, 0x40, 0x2b, 0x20, 0xab, 0xfb, 0xd5, 0x86, 0x46, 0x87,
-	0x39, 0xc2, 0xc9, 0xa5, 0x6b, 0xd5, 0x66, 0x47, 0xc9, 0xa3, 0x3c, 0x64, 0x89, 0x06, 0x74, 0xb4,
-	0x42, 0xd6, 0x61, 0x5a, 0xd0, 0xf1, 0xea, 0xfd, 0x53, 0xc8, 0x47, 0xed, 0x86, 0xae, 0xc1, 0x95,
-	0x5a, 0xab, 0x7a, 0xf4, 0xb8, 0xde, 0xec, 0xb4, 0xb5, 0x6a, 0xa3, 0xd2, 0xdc, 0xab, 0xd7, 0x94,
-	0x44, 0x7c, 0xfa, 0x49, 0xa5, 0x53, 0x6d, 0xd4, 0x6b, 0x8a, 0x80, 0x6e, 0xc0, 0xd5, 0xc9, 0xf4,
-	0x51, 0x33, 0x24, 0x88, 0x68, 0x1d, 0x94, 0x43, 0xb5, 0xde, 0xae, 0x37, 0xab, 0xf5, 0xf1, 0x2a,
-	0xc9, 0x5d, 0xe5, 0xab, 0x97, 0x1b, 0xc2, 0x3f, 0x5e, 0x6e, 0x08, 0xdf, 0xbc, 0xdc, 0x10, 0xfe,
-	0xf0, 0xdf, 0x8d, 0x44, 0x37, 0x4d, 0x2f, 0x8a, 0x1f, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x93,
-	0x53, 0x8a, 0x54, 0x32, 0x1f, 0x00, 0x00,
+func (m *DocumentSummary) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
 }
 
-func (m *ChangePack) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DocumentSummary) GetArchivedAt() *types.Timestamp {
+	if m != nil {
+		return m.ArchivedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ChangePack) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DocumentSummary) GetOperationCounts() map[string]int64 {
+	if m != nil {
+		return m.OperationCounts
+	}
+	return nil
 }
 
-func (m *ChangePack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.MinSyncedTicket != nil {
-		{
-			size, err := m.MinSyncedTicket.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+func (m *DocumentSummary) GetRemovedAt() *types.Timestamp {
+	if m != nil {
+		return m.RemovedAt
 	}
-	if len(m.Changes) > 0 {
-		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintResources(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	return nil
+}
+
+type DocumentClientEvent struct {
+	Id                   string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClientId             string                  `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Type                 DocumentClientEventType `protobuf:"varint,3,opt,name=type,proto3,enum=api.DocumentClientEventType" json:"type,omitempty"`
+	CreatedAt            *types.Timestamp        `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *DocumentClientEvent) Reset()         { *m = DocumentClientEvent{} }
+func (m *DocumentClientEvent) String() string { return proto.CompactTextString(m) }
+func (*DocumentClientEvent) ProtoMessage()    {}
+func (*DocumentClientEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{20}
+}
+func (m *DocumentClientEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DocumentClientEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DocumentClientEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Snapshot) > 0 {
-		i -= len(m.Snapshot)
-		copy(dAtA[i:], m.Snapshot)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Snapshot)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *DocumentClientEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentClientEvent.Merge(m, src)
+}
+func (m *DocumentClientEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *DocumentClientEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentClientEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentClientEvent proto.InternalMessageInfo
+
+func (m *DocumentClientEvent) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if m.Checkpoint != nil {
-		{
-			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *DocumentClientEvent) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	if len(m.DocumentKey) > 0 {
-		i -= len(m.DocumentKey)
-		copy(dAtA[i:], m.DocumentKey)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.DocumentKey)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *DocumentClientEvent) GetType() DocumentClientEventType {
+	if m != nil {
+		return m.Type
 	}
-	return len(dAtA) - i, nil
+	return DocumentClientEventType_DOCUMENT_ATTACHED
 }
 
-func (m *Change) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DocumentClientEvent) GetCreatedAt() *types.Timestamp {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Change) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Rejection struct {
+	DocumentKey          string           `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	ClientId             string           `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Reason               string           `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message              string           `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Changes              int32            `protobuf:"varint,5,opt,name=changes,proto3" json:"changes,omitempty"`
+	RejectedAt           *types.Timestamp `protobuf:"bytes,6,opt,name=rejected_at,json=rejectedAt,proto3" json:"rejected_at,omitempty"`
+	Dropped              int32            `protobuf:"varint,7,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *Change) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Operations) > 0 {
-		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintResources(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *Rejection) Reset()         { *m = Rejection{} }
+func (m *Rejection) String() string { return proto.CompactTextString(m) }
+func (*Rejection) ProtoMessage()    {}
+func (*Rejection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{21}
+}
+func (m *Rejection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Rejection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Rejection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *Rejection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rejection.Merge(m, src)
+}
+func (m *Rejection) XXX_Size() int {
+	return m.Size()
+}
+func (m *Rejection) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rejection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rejection proto.InternalMessageInfo
+
+func (m *Rejection) GetDocumentKey() string {
+	if m != nil {
+		return m.DocumentKey
 	}
-	if m.Id != nil {
-		{
-			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *Rejection) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ChangeID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Rejection) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ChangeID) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Rejection) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
 
-func (m *ChangeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Rejection) GetChanges() int32 {
+	if m != nil {
+		return m.Changes
 	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Lamport != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Lamport))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ServerSeq != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.ServerSeq))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ClientSeq != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.ClientSeq))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Operation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Rejection) GetRejectedAt() *types.Timestamp {
+	if m != nil {
+		return m.RejectedAt
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
 
-func (m *Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Body != nil {
-		{
-			size := m.Body.Size()
-			i -= size
-			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+func (m *Rejection) GetDropped() int32 {
+	if m != nil {
+		return m.Dropped
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Operation_Set_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DocumentEventLog struct {
+	ServerSeq            uint64           `protobuf:"varint,1,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	ActorId              string           `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ClientSeq            uint32           `protobuf:"varint,3,opt,name=client_seq,json=clientSeq,proto3" json:"client_seq,omitempty"`
+	Lamport              uint64           `protobuf:"varint,4,opt,name=lamport,proto3" json:"lamport,omitempty"`
+	OperationCount       int32            `protobuf:"varint,5,opt,name=operation_count,json=operationCount,proto3" json:"operation_count,omitempty"`
+	VersionVector        *VersionVector   `protobuf:"bytes,6,opt,name=version_vector,json=versionVector,proto3" json:"version_vector,omitempty"`
+	Fingerprint          string           `protobuf:"bytes,7,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	CreatedAt            *types.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *Operation_Set_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Set != nil {
-		{
-			size, err := m.Set.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *DocumentEventLog) Reset()         { *m = DocumentEventLog{} }
+func (m *DocumentEventLog) String() string { return proto.CompactTextString(m) }
+func (*DocumentEventLog) ProtoMessage()    {}
+func (*DocumentEventLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{22}
 }
-func (m *Operation_Add_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DocumentEventLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Operation_Add_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Add != nil {
-		{
-			size, err := m.Add.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+func (m *DocumentEventLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DocumentEventLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *Operation_Move_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DocumentEventLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentEventLog.Merge(m, src)
 }
-
-func (m *Operation_Move_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Move != nil {
-		{
-			size, err := m.Move.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	return len(dAtA) - i, nil
+func (m *DocumentEventLog) XXX_Size() int {
+	return m.Size()
 }
-func (m *Operation_Remove_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DocumentEventLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentEventLog.DiscardUnknown(m)
 }
 
-func (m *Operation_Remove_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Remove != nil {
-		{
-			size, err := m.Remove.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+var xxx_messageInfo_DocumentEventLog proto.InternalMessageInfo
+
+func (m *DocumentEventLog) GetServerSeq() uint64 {
+	if m != nil {
+		return m.ServerSeq
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Operation_Edit_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return 0
 }
 
-func (m *Operation_Edit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Edit != nil {
-		{
-			size, err := m.Edit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+func (m *DocumentEventLog) GetActorId() string {
+	if m != nil {
+		return m.ActorId
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Operation_Select_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return ""
 }
 
-func (m *Operation_Select_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Select != nil {
-		{
-			size, err := m.Select.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+func (m *DocumentEventLog) GetClientSeq() uint32 {
+	if m != nil {
+		return m.ClientSeq
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Operation_RichEdit_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return 0
 }
 
-func (m *Operation_RichEdit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RichEdit != nil {
-		{
-			size, err := m.RichEdit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+func (m *DocumentEventLog) GetLamport() uint64 {
+	if m != nil {
+		return m.Lamport
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Operation_Style_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return 0
 }
 
-func (m *Operation_Style_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Style != nil {
-		{
-			size, err := m.Style.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+func (m *DocumentEventLog) GetOperationCount() int32 {
+	if m != nil {
+		return m.OperationCount
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Operation_Increase_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return 0
 }
 
-func (m *Operation_Increase_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Increase != nil {
-		{
-			size, err := m.Increase.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+func (m *DocumentEventLog) GetVersionVector() *VersionVector {
+	if m != nil {
+		return m.VersionVector
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *Operation_Set) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *DocumentEventLog) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Operation_Set) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DocumentEventLog) GetCreatedAt() *types.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
 }
 
-func (m *Operation_Set) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+type Presence struct {
+	Clock                int32             `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	Data                 map[string]string `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Presence) Reset()         { *m = Presence{} }
+func (m *Presence) String() string { return proto.CompactTextString(m) }
+func (*Presence) ProtoMessage()    {}
+func (*Presence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{23}
+}
+func (m *Presence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Presence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Presence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *Presence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Presence.Merge(m, src)
+}
+func (m *Presence) XXX_Size() int {
+	return m.Size()
+}
+func (m *Presence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Presence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Presence proto.InternalMessageInfo
+
+func (m *Presence) GetClock() int32 {
+	if m != nil {
+		return m.Clock
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Operation_Add) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Presence) GetData() map[string]string {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Operation_Add) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Client struct {
+	Id                   []byte    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Presence             *Presence `protobuf:"bytes,2,opt,name=presence,proto3" json:"presence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *Operation_Add) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.PrevCreatedAt != nil {
-		{
-			size, err := m.PrevCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+func (m *Client) Reset()         { *m = Client{} }
+func (m *Client) String() string { return proto.CompactTextString(m) }
+func (*Client) ProtoMessage()    {}
+func (*Client) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{24}
+}
+func (m *Client) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Client) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Client.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *Client) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Client.Merge(m, src)
+}
+func (m *Client) XXX_Size() int {
+	return m.Size()
+}
+func (m *Client) XXX_DiscardUnknown() {
+	xxx_messageInfo_Client.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Client proto.InternalMessageInfo
+
+func (m *Client) GetId() []byte {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Operation_Move) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Client) GetPresence() *Presence {
+	if m != nil {
+		return m.Presence
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Operation_Move) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Clients struct {
+	Clients              []*Client `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *Operation_Move) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Clients) Reset()         { *m = Clients{} }
+func (m *Clients) String() string { return proto.CompactTextString(m) }
+func (*Clients) ProtoMessage()    {}
+func (*Clients) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{25}
+}
+func (m *Clients) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Clients) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Clients.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+}
+func (m *Clients) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clients.Merge(m, src)
+}
+func (m *Clients) XXX_Size() int {
+	return m.Size()
+}
+func (m *Clients) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clients.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Clients proto.InternalMessageInfo
+
+func (m *Clients) GetClients() []*Client {
+	if m != nil {
+		return m.Clients
 	}
-	if m.CreatedAt != nil {
-		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+	return nil
+}
+
+type Checkpoint struct {
+	ServerSeq            uint64   `protobuf:"varint,1,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	ClientSeq            uint32   `protobuf:"varint,2,opt,name=client_seq,json=clientSeq,proto3" json:"client_seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Checkpoint) Reset()         { *m = Checkpoint{} }
+func (m *Checkpoint) String() string { return proto.CompactTextString(m) }
+func (*Checkpoint) ProtoMessage()    {}
+func (*Checkpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{26}
+}
+func (m *Checkpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Checkpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Checkpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.PrevCreatedAt != nil {
-		{
-			size, err := m.PrevCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+}
+func (m *Checkpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Checkpoint.Merge(m, src)
+}
+func (m *Checkpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *Checkpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Checkpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Checkpoint proto.InternalMessageInfo
+
+func (m *Checkpoint) GetServerSeq() uint64 {
+	if m != nil {
+		return m.ServerSeq
 	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *Checkpoint) GetClientSeq() uint32 {
+	if m != nil {
+		return m.ClientSeq
+	}
+	return 0
+}
+
+type SnapshotMeta struct {
+	ServerSeq            uint64           `protobuf:"varint,1,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	Lamport              uint64           `protobuf:"varint,2,opt,name=lamport,proto3" json:"lamport,omitempty"`
+	CreatedAt            *types.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	GarbageLen           int32            `protobuf:"varint,4,opt,name=garbage_len,json=garbageLen,proto3" json:"garbage_len,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SnapshotMeta) Reset()         { *m = SnapshotMeta{} }
+func (m *SnapshotMeta) String() string { return proto.CompactTextString(m) }
+func (*SnapshotMeta) ProtoMessage()    {}
+func (*SnapshotMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{27}
+}
+func (m *SnapshotMeta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotMeta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SnapshotMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotMeta.Merge(m, src)
+}
+func (m *SnapshotMeta) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotMeta.DiscardUnknown(m)
 }
 
-func (m *Operation_Remove) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SnapshotMeta proto.InternalMessageInfo
+
+func (m *SnapshotMeta) GetServerSeq() uint64 {
+	if m != nil {
+		return m.ServerSeq
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Operation_Remove) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SnapshotMeta) GetLamport() uint64 {
+	if m != nil {
+		return m.Lamport
+	}
+	return 0
 }
 
-func (m *Operation_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *SnapshotMeta) GetCreatedAt() *types.Timestamp {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *SnapshotMeta) GetGarbageLen() int32 {
+	if m != nil {
+		return m.GarbageLen
 	}
-	if m.CreatedAt != nil {
-		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+	return 0
+}
+
+type ProjectStats struct {
+	ConflictWins         []*ActorConflictWins `protobuf:"bytes,1,rep,name=conflict_wins,json=conflictWins,proto3" json:"conflict_wins,omitempty"`
+	HotDocuments         []*HotDocument       `protobuf:"bytes,2,rep,name=hot_documents,json=hotDocuments,proto3" json:"hot_documents,omitempty"`
+	BackgroundQueues     []*BackgroundQueue   `protobuf:"bytes,3,rep,name=background_queues,json=backgroundQueues,proto3" json:"background_queues,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ProjectStats) Reset()         { *m = ProjectStats{} }
+func (m *ProjectStats) String() string { return proto.CompactTextString(m) }
+func (*ProjectStats) ProtoMessage()    {}
+func (*ProjectStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{28}
+}
+func (m *ProjectStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ProjectStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectStats.Merge(m, src)
+}
+func (m *ProjectStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectStats proto.InternalMessageInfo
+
+func (m *ProjectStats) GetConflictWins() []*ActorConflictWins {
+	if m != nil {
+		return m.ConflictWins
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Operation_Edit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProjectStats) GetHotDocuments() []*HotDocument {
+	if m != nil {
+		return m.HotDocuments
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Operation_Edit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProjectStats) GetBackgroundQueues() []*BackgroundQueue {
+	if m != nil {
+		return m.BackgroundQueues
+	}
+	return nil
 }
 
-func (m *Operation_Edit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type ActorConflictWins struct {
+	Actor                string   `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	Wins                 int64    `protobuf:"varint,2,opt,name=wins,proto3" json:"wins,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActorConflictWins) Reset()         { *m = ActorConflictWins{} }
+func (m *ActorConflictWins) String() string { return proto.CompactTextString(m) }
+func (*ActorConflictWins) ProtoMessage()    {}
+func (*ActorConflictWins) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{29}
+}
+func (m *ActorConflictWins) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActorConflictWins) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActorConflictWins.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Content) > 0 {
-		i -= len(m.Content)
-		copy(dAtA[i:], m.Content)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Content)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.CreatedAtMapByActor) > 0 {
-		for k := range m.CreatedAtMapByActor {
-			v := m.CreatedAtMapByActor[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintResources(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintResources(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
+}
+func (m *ActorConflictWins) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActorConflictWins.Merge(m, src)
+}
+func (m *ActorConflictWins) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActorConflictWins) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActorConflictWins.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActorConflictWins proto.InternalMessageInfo
+
+func (m *ActorConflictWins) GetActor() string {
+	if m != nil {
+		return m.Actor
 	}
-	if m.To != nil {
-		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *ActorConflictWins) GetWins() int64 {
+	if m != nil {
+		return m.Wins
 	}
-	if m.From != nil {
-		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+	return 0
+}
+
+type LiveProjectStats struct {
+	ActiveDocuments      int32                `protobuf:"varint,1,opt,name=active_documents,json=activeDocuments,proto3" json:"active_documents,omitempty"`
+	AttachedClients      int32                `protobuf:"varint,2,opt,name=attached_clients,json=attachedClients,proto3" json:"attached_clients,omitempty"`
+	OpsPerSecond         float64              `protobuf:"fixed64,3,opt,name=ops_per_second,json=opsPerSecond,proto3" json:"ops_per_second,omitempty"`
+	HotDocuments         int32                `protobuf:"varint,4,opt,name=hot_documents,json=hotDocuments,proto3" json:"hot_documents,omitempty"`
+	MeasuredAt           *types.Timestamp     `protobuf:"bytes,5,opt,name=measured_at,json=measuredAt,proto3" json:"measured_at,omitempty"`
+	ConflictWins         []*ActorConflictWins `protobuf:"bytes,6,rep,name=conflict_wins,json=conflictWins,proto3" json:"conflict_wins,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *LiveProjectStats) Reset()         { *m = LiveProjectStats{} }
+func (m *LiveProjectStats) String() string { return proto.CompactTextString(m) }
+func (*LiveProjectStats) ProtoMessage()    {}
+func (*LiveProjectStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{30}
+}
+func (m *LiveProjectStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiveProjectStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiveProjectStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *LiveProjectStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiveProjectStats.Merge(m, src)
+}
+func (m *LiveProjectStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiveProjectStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiveProjectStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiveProjectStats proto.InternalMessageInfo
+
+func (m *LiveProjectStats) GetActiveDocuments() int32 {
+	if m != nil {
+		return m.ActiveDocuments
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Operation_Select) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LiveProjectStats) GetAttachedClients() int32 {
+	if m != nil {
+		return m.AttachedClients
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Operation_Select) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *LiveProjectStats) GetOpsPerSecond() float64 {
+	if m != nil {
+		return m.OpsPerSecond
+	}
+	return 0
 }
 
-func (m *Operation_Select) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *LiveProjectStats) GetHotDocuments() int32 {
+	if m != nil {
+		return m.HotDocuments
 	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *LiveProjectStats) GetMeasuredAt() *types.Timestamp {
+	if m != nil {
+		return m.MeasuredAt
 	}
-	if m.To != nil {
-		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *LiveProjectStats) GetConflictWins() []*ActorConflictWins {
+	if m != nil {
+		return m.ConflictWins
 	}
-	if m.From != nil {
-		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+	return nil
+}
+
+type ProjectStatsEvent struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	DocumentKey          string   `protobuf:"bytes,2,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProjectStatsEvent) Reset()         { *m = ProjectStatsEvent{} }
+func (m *ProjectStatsEvent) String() string { return proto.CompactTextString(m) }
+func (*ProjectStatsEvent) ProtoMessage()    {}
+func (*ProjectStatsEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{31}
+}
+func (m *ProjectStatsEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectStatsEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectStatsEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ProjectStatsEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectStatsEvent.Merge(m, src)
+}
+func (m *ProjectStatsEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectStatsEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectStatsEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectStatsEvent proto.InternalMessageInfo
+
+func (m *ProjectStatsEvent) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Operation_RichEdit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProjectStatsEvent) GetDocumentKey() string {
+	if m != nil {
+		return m.DocumentKey
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Operation_RichEdit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BackgroundQueue struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Depth                int32    `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	OldestAgeSeconds     float64  `protobuf:"fixed64,3,opt,name=oldest_age_seconds,json=oldestAgeSeconds,proto3" json:"oldest_age_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Operation_RichEdit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *BackgroundQueue) Reset()         { *m = BackgroundQueue{} }
+func (m *BackgroundQueue) String() string { return proto.CompactTextString(m) }
+func (*BackgroundQueue) ProtoMessage()    {}
+func (*BackgroundQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{32}
+}
+func (m *BackgroundQueue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackgroundQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackgroundQueue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Attributes) > 0 {
-		for k := range m.Attributes {
-			v := m.Attributes[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintResources(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintResources(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.Content) > 0 {
-		i -= len(m.Content)
-		copy(dAtA[i:], m.Content)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Content)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.CreatedAtMapByActor) > 0 {
-		for k := range m.CreatedAtMapByActor {
-			v := m.CreatedAtMapByActor[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintResources(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintResources(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.To != nil {
-		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.From != nil {
-		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+}
+func (m *BackgroundQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackgroundQueue.Merge(m, src)
+}
+func (m *BackgroundQueue) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackgroundQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackgroundQueue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackgroundQueue proto.InternalMessageInfo
+
+func (m *BackgroundQueue) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *BackgroundQueue) GetDepth() int32 {
+	if m != nil {
+		return m.Depth
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Operation_Style) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BackgroundQueue) GetOldestAgeSeconds() float64 {
+	if m != nil {
+		return m.OldestAgeSeconds
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Operation_Style) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type HotDocument struct {
+	DocumentKey          string           `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	OpsPerSecond         float64          `protobuf:"fixed64,2,opt,name=ops_per_second,json=opsPerSecond,proto3" json:"ops_per_second,omitempty"`
+	TopActorId           string           `protobuf:"bytes,3,opt,name=top_actor_id,json=topActorId,proto3" json:"top_actor_id,omitempty"`
+	TopActorOpsPerSecond float64          `protobuf:"fixed64,4,opt,name=top_actor_ops_per_second,json=topActorOpsPerSecond,proto3" json:"top_actor_ops_per_second,omitempty"`
+	DetectedAt           *types.Timestamp `protobuf:"bytes,5,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *Operation_Style) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+func (m *HotDocument) Reset()         { *m = HotDocument{} }
+func (m *HotDocument) String() string { return proto.CompactTextString(m) }
+func (*HotDocument) ProtoMessage()    {}
+func (*HotDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{33}
+}
+func (m *HotDocument) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HotDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HotDocument.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if len(m.Attributes) > 0 {
-		for k := range m.Attributes {
-			v := m.Attributes[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintResources(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintResources(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
+}
+func (m *HotDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HotDocument.Merge(m, src)
+}
+func (m *HotDocument) XXX_Size() int {
+	return m.Size()
+}
+func (m *HotDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_HotDocument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HotDocument proto.InternalMessageInfo
+
+func (m *HotDocument) GetDocumentKey() string {
+	if m != nil {
+		return m.DocumentKey
 	}
-	if m.To != nil {
-		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *HotDocument) GetOpsPerSecond() float64 {
+	if m != nil {
+		return m.OpsPerSecond
 	}
-	if m.From != nil {
-		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *HotDocument) GetTopActorId() string {
+	if m != nil {
+		return m.TopActorId
 	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *HotDocument) GetTopActorOpsPerSecond() float64 {
+	if m != nil {
+		return m.TopActorOpsPerSecond
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Operation_Increase) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *HotDocument) GetDetectedAt() *types.Timestamp {
+	if m != nil {
+		return m.DetectedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Operation_Increase) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Maintenance struct {
+	Enabled              bool             `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Message              string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	UpdatedAt            *types.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *Operation_Increase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+func (m *Maintenance) Reset()         { *m = Maintenance{} }
+func (m *Maintenance) String() string { return proto.CompactTextString(m) }
+func (*Maintenance) ProtoMessage()    {}
+func (*Maintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{34}
+}
+func (m *Maintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Maintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Maintenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+}
+func (m *Maintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Maintenance.Merge(m, src)
+}
+func (m *Maintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *Maintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Maintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Maintenance proto.InternalMessageInfo
+
+func (m *Maintenance) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *Maintenance) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *JSONElementSimple) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Maintenance) GetUpdatedAt() *types.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JSONElementSimple) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type VersionVector struct {
+	ActorIds             [][]byte `protobuf:"bytes,1,rep,name=actor_ids,json=actorIds,proto3" json:"actor_ids,omitempty"`
+	Lamports             []uint64 `protobuf:"varint,2,rep,packed,name=lamports,proto3" json:"lamports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *JSONElementSimple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Type != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.RemovedAt != nil {
-		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.MovedAt != nil {
-		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.CreatedAt != nil {
-		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+func (m *VersionVector) Reset()         { *m = VersionVector{} }
+func (m *VersionVector) String() string { return proto.CompactTextString(m) }
+func (*VersionVector) ProtoMessage()    {}
+func (*VersionVector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{35}
+}
+func (m *VersionVector) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionVector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersionVector.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *JSONElement) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *VersionVector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionVector.Merge(m, src)
 }
-
-func (m *JSONElement) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VersionVector) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionVector) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionVector.DiscardUnknown(m)
 }
 
-func (m *JSONElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_VersionVector proto.InternalMessageInfo
+
+func (m *VersionVector) GetActorIds() [][]byte {
+	if m != nil {
+		return m.ActorIds
 	}
-	if m.Body != nil {
-		{
-			size := m.Body.Size()
-			i -= size
-			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	return nil
+}
+
+func (m *VersionVector) GetLamports() []uint64 {
+	if m != nil {
+		return m.Lamports
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *JSONElement_JsonObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type TextNodePos struct {
+	CreatedAt            *TimeTicket `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Offset               int32       `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	RelativeOffset       int32       `protobuf:"varint,3,opt,name=relative_offset,json=relativeOffset,proto3" json:"relative_offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *JSONElement_JsonObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JsonObject != nil {
-		{
-			size, err := m.JsonObject.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+func (m *TextNodePos) Reset()         { *m = TextNodePos{} }
+func (m *TextNodePos) String() string { return proto.CompactTextString(m) }
+func (*TextNodePos) ProtoMessage()    {}
+func (*TextNodePos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{36}
+}
+func (m *TextNodePos) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TextNodePos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TextNodePos.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *JSONElement_JsonArray) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TextNodePos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TextNodePos.Merge(m, src)
+}
+func (m *TextNodePos) XXX_Size() int {
+	return m.Size()
+}
+func (m *TextNodePos) XXX_DiscardUnknown() {
+	xxx_messageInfo_TextNodePos.DiscardUnknown(m)
 }
 
-func (m *JSONElement_JsonArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JsonArray != nil {
-		{
-			size, err := m.JsonArray.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_TextNodePos proto.InternalMessageInfo
+
+func (m *TextNodePos) GetCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *JSONElement_Primitive_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *TextNodePos) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
 }
 
-func (m *JSONElement_Primitive_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Primitive != nil {
-		{
-			size, err := m.Primitive.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (m *TextNodePos) GetRelativeOffset() int32 {
+	if m != nil {
+		return m.RelativeOffset
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
-func (m *JSONElement_Text_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type TimeTicket struct {
+	Lamport              uint64   `protobuf:"varint,1,opt,name=lamport,proto3" json:"lamport,omitempty"`
+	Delimiter            uint32   `protobuf:"varint,2,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	ActorId              []byte   `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *JSONElement_Text_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Text != nil {
-		{
-			size, err := m.Text.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+func (m *TimeTicket) Reset()         { *m = TimeTicket{} }
+func (m *TimeTicket) String() string { return proto.CompactTextString(m) }
+func (*TimeTicket) ProtoMessage()    {}
+func (*TimeTicket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{37}
+}
+func (m *TimeTicket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeTicket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeTicket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *JSONElement_RichText_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TimeTicket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeTicket.Merge(m, src)
+}
+func (m *TimeTicket) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeTicket) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeTicket.DiscardUnknown(m)
 }
 
-func (m *JSONElement_RichText_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RichText != nil {
-		{
-			size, err := m.RichText.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+var xxx_messageInfo_TimeTicket proto.InternalMessageInfo
+
+func (m *TimeTicket) GetLamport() uint64 {
+	if m != nil {
+		return m.Lamport
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
-func (m *JSONElement_Counter_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *TimeTicket) GetDelimiter() uint32 {
+	if m != nil {
+		return m.Delimiter
+	}
+	return 0
 }
 
-func (m *JSONElement_Counter_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Counter != nil {
-		{
-			size, err := m.Counter.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+func (m *TimeTicket) GetActorId() []byte {
+	if m != nil {
+		return m.ActorId
+	}
+	return nil
+}
+
+type DocEvent struct {
+	Type                 DocEventType `protobuf:"varint,1,opt,name=type,proto3,enum=api.DocEventType" json:"type,omitempty"`
+	Publisher            *Client      `protobuf:"bytes,2,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	DocumentKeys         []string     `protobuf:"bytes,3,rep,name=document_keys,json=documentKeys,proto3" json:"document_keys,omitempty"`
+	ChangedPaths         []string     `protobuf:"bytes,4,rep,name=changed_paths,json=changedPaths,proto3" json:"changed_paths,omitempty"`
+	RemovedPaths         []string     `protobuf:"bytes,5,rep,name=removed_paths,json=removedPaths,proto3" json:"removed_paths,omitempty"`
+	ServerSeq            uint64       `protobuf:"varint,6,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *DocEvent) Reset()         { *m = DocEvent{} }
+func (m *DocEvent) String() string { return proto.CompactTextString(m) }
+func (*DocEvent) ProtoMessage()    {}
+func (*DocEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf1b13971fe4c19d, []int{38}
+}
+func (m *DocEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DocEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DocEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *JSONElement_JSONObject) Marshal() (dAtA []byte, err error) {
+func (m *DocEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocEvent.Merge(m, src)
+}
+func (m *DocEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *DocEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocEvent proto.InternalMessageInfo
+
+func (m *DocEvent) GetType() DocEventType {
+	if m != nil {
+		return m.Type
+	}
+	return DocEventType_DOCUMENTS_CHANGED
+}
+
+func (m *DocEvent) GetPublisher() *Client {
+	if m != nil {
+		return m.Publisher
+	}
+	return nil
+}
+
+func (m *DocEvent) GetDocumentKeys() []string {
+	if m != nil {
+		return m.DocumentKeys
+	}
+	return nil
+}
+
+func (m *DocEvent) GetChangedPaths() []string {
+	if m != nil {
+		return m.ChangedPaths
+	}
+	return nil
+}
+
+func (m *DocEvent) GetRemovedPaths() []string {
+	if m != nil {
+		return m.RemovedPaths
+	}
+	return nil
+}
+
+func (m *DocEvent) GetServerSeq() uint64 {
+	if m != nil {
+		return m.ServerSeq
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("api.ValueType", ValueType_name, ValueType_value)
+	proto.RegisterEnum("api.DocEventType", DocEventType_name, DocEventType_value)
+	proto.RegisterEnum("api.DocumentClientEventType", DocumentClientEventType_name, DocumentClientEventType_value)
+	proto.RegisterType((*ChangePack)(nil), "api.ChangePack")
+	proto.RegisterType((*Change)(nil), "api.Change")
+	proto.RegisterType((*ChangeID)(nil), "api.ChangeID")
+	proto.RegisterType((*Operation)(nil), "api.Operation")
+	proto.RegisterType((*Operation_Set)(nil), "api.Operation.Set")
+	proto.RegisterType((*Operation_Add)(nil), "api.Operation.Add")
+	proto.RegisterType((*Operation_Move)(nil), "api.Operation.Move")
+	proto.RegisterType((*Operation_Remove)(nil), "api.Operation.Remove")
+	proto.RegisterType((*Operation_Edit)(nil), "api.Operation.Edit")
+	proto.RegisterMapType((map[string]*TimeTicket)(nil), "api.Operation.Edit.CreatedAtMapByActorEntry")
+	proto.RegisterType((*Operation_Select)(nil), "api.Operation.Select")
+	proto.RegisterType((*Operation_RichEdit)(nil), "api.Operation.RichEdit")
+	proto.RegisterMapType((map[string]string)(nil), "api.Operation.RichEdit.AttributesEntry")
+	proto.RegisterMapType((map[string]*TimeTicket)(nil), "api.Operation.RichEdit.CreatedAtMapByActorEntry")
+	proto.RegisterType((*Operation_Style)(nil), "api.Operation.Style")
+	proto.RegisterMapType((map[string]string)(nil), "api.Operation.Style.AttributesEntry")
+	proto.RegisterType((*Operation_Increase)(nil), "api.Operation.Increase")
+	proto.RegisterType((*Operation_TreeEdit)(nil), "api.Operation.TreeEdit")
+	proto.RegisterMapType((map[string]*TimeTicket)(nil), "api.Operation.TreeEdit.CreatedAtMapByActorEntry")
+	proto.RegisterType((*Operation_TreeStyle)(nil), "api.Operation.TreeStyle")
+	proto.RegisterMapType((map[string]string)(nil), "api.Operation.TreeStyle.AttributesEntry")
+	proto.RegisterMapType((map[string]*TimeTicket)(nil), "api.Operation.TreeStyle.CreatedAtMapByActorEntry")
+	proto.RegisterType((*Operation_Clear)(nil), "api.Operation.Clear")
+	proto.RegisterType((*JSONElementSimple)(nil), "api.JSONElementSimple")
+	proto.RegisterType((*Snapshot)(nil), "api.Snapshot")
+	proto.RegisterType((*JSONElement)(nil), "api.JSONElement")
+	proto.RegisterType((*JSONElement_JSONObject)(nil), "api.JSONElement.JSONObject")
+	proto.RegisterType((*JSONElement_JSONArray)(nil), "api.JSONElement.JSONArray")
+	proto.RegisterType((*JSONElement_Primitive)(nil), "api.JSONElement.Primitive")
+	proto.RegisterType((*JSONElement_Text)(nil), "api.JSONElement.Text")
+	proto.RegisterType((*JSONElement_RichText)(nil), "api.JSONElement.RichText")
+	proto.RegisterType((*JSONElement_Counter)(nil), "api.JSONElement.Counter")
+	proto.RegisterType((*JSONElement_Tree)(nil), "api.JSONElement.Tree")
+	proto.RegisterType((*RHTNode)(nil), "api.RHTNode")
+	proto.RegisterType((*RGANode)(nil), "api.RGANode")
+	proto.RegisterType((*TextNode)(nil), "api.TextNode")
+	proto.RegisterType((*RichTextNodeAttr)(nil), "api.RichTextNodeAttr")
+	proto.RegisterType((*RichTextNode)(nil), "api.RichTextNode")
+	proto.RegisterMapType((map[string]*RichTextNodeAttr)(nil), "api.RichTextNode.AttributesEntry")
+	proto.RegisterType((*TextNodeID)(nil), "api.TextNodeID")
+	proto.RegisterType((*TreeNode)(nil), "api.TreeNode")
+	proto.RegisterMapType((map[string]*RichTextNodeAttr)(nil), "api.TreeNode.AttributesEntry")
+	proto.RegisterType((*TreePos)(nil), "api.TreePos")
+	proto.RegisterType((*Project)(nil), "api.Project")
+	proto.RegisterMapType((map[string]string)(nil), "api.Project.DocumentTemplatesEntry")
+	proto.RegisterMapType((map[string]bool)(nil), "api.Project.FeaturesEntry")
+	proto.RegisterType((*DocumentKeyPolicy)(nil), "api.DocumentKeyPolicy")
+	proto.RegisterType((*SnapshotPolicy)(nil), "api.SnapshotPolicy")
+	proto.RegisterType((*UpdatableProjectFields)(nil), "api.UpdatableProjectFields")
+	proto.RegisterType((*UpdatableProjectFields_AuthWebhookMethods)(nil), "api.UpdatableProjectFields.AuthWebhookMethods")
+	proto.RegisterType((*UpdatableProjectFields_Features)(nil), "api.UpdatableProjectFields.Features")
+	proto.RegisterMapType((map[string]bool)(nil), "api.UpdatableProjectFields.Features.FeaturesEntry")
+	proto.RegisterType((*UpdatableProjectFields_DocumentTemplates)(nil), "api.UpdatableProjectFields.DocumentTemplates")
+	proto.RegisterMapType((map[string]string)(nil), "api.UpdatableProjectFields.DocumentTemplates.TemplatesEntry")
+	proto.RegisterType((*UpdatableProjectFields_AllowedOperations)(nil), "api.UpdatableProjectFields.AllowedOperations")
+	proto.RegisterType((*DocumentSummary)(nil), "api.DocumentSummary")
+	proto.RegisterMapType((map[string]string)(nil), "api.DocumentSummary.MetadataEntry")
+	proto.RegisterMapType((map[string]int64)(nil), "api.DocumentSummary.OperationCountsEntry")
+	proto.RegisterType((*DocumentClientEvent)(nil), "api.DocumentClientEvent")
+	proto.RegisterType((*Rejection)(nil), "api.Rejection")
+	proto.RegisterType((*DocumentEventLog)(nil), "api.DocumentEventLog")
+	proto.RegisterType((*Presence)(nil), "api.Presence")
+	proto.RegisterMapType((map[string]string)(nil), "api.Presence.DataEntry")
+	proto.RegisterType((*Client)(nil), "api.Client")
+	proto.RegisterType((*Clients)(nil), "api.Clients")
+	proto.RegisterType((*Checkpoint)(nil), "api.Checkpoint")
+	proto.RegisterType((*SnapshotMeta)(nil), "api.SnapshotMeta")
+	proto.RegisterType((*ProjectStats)(nil), "api.ProjectStats")
+	proto.RegisterType((*ActorConflictWins)(nil), "api.ActorConflictWins")
+	proto.RegisterType((*LiveProjectStats)(nil), "api.LiveProjectStats")
+	proto.RegisterType((*ProjectStatsEvent)(nil), "api.ProjectStatsEvent")
+	proto.RegisterType((*BackgroundQueue)(nil), "api.BackgroundQueue")
+	proto.RegisterType((*HotDocument)(nil), "api.HotDocument")
+	proto.RegisterType((*Maintenance)(nil), "api.Maintenance")
+	proto.RegisterType((*VersionVector)(nil), "api.VersionVector")
+	proto.RegisterType((*TextNodePos)(nil), "api.TextNodePos")
+	proto.RegisterType((*TimeTicket)(nil), "api.TimeTicket")
+	proto.RegisterType((*DocEvent)(nil), "api.DocEvent")
+}
+
+func init() { proto.RegisterFile("resources.proto", fileDescriptor_cf1b13971fe4c19d) }
+
+var fileDescriptor_cf1b13971fe4c19d = []byte{
+	// 4205 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3b, 0x5d, 0x8f, 0xdc, 0x46,
+	0x72, 0xe2, 0x7c, 0xb3, 0x66, 0x66, 0x67, 0xb6, 0x77, 0x25, 0x8d, 0xc7, 0xb2, 0xbc, 0xa6, 0xed,
+	0xb3, 0xa4, 0xb3, 0x57, 0x8a, 0x9c, 0xf3, 0x9d, 0x4e, 0xf6, 0x21, 0xb3, 0xb3, 0x23, 0xed, 0xfa,
+	0x56, 0xbb, 0x1b, 0xce, 0x48, 0x3a, 0x07, 0x07, 0x30, 0x5c, 0xb2, 0x77, 0x86, 0x16, 0x87, 0xa4,
+	0x49, 0xee, 0x4a, 0x0b, 0x04, 0x41, 0x80, 0xc0, 0x79, 0xc9, 0x21, 0x40, 0x80, 0x00, 0xc9, 0x73,
+	0x70, 0xc1, 0x3d, 0x04, 0x87, 0xe4, 0x2d, 0x8f, 0xf7, 0x10, 0x24, 0xc8, 0x63, 0x02, 0xe4, 0xe5,
+	0x10, 0xe0, 0x10, 0x38, 0x2f, 0x41, 0xbe, 0x7e, 0x43, 0xd0, 0x5f, 0x1c, 0x92, 0xc3, 0xd9, 0x99,
+	0xf1, 0xde, 0xc1, 0x3a, 0xbf, 0xb1, 0xab, 0xaa, 0xbb, 0xab, 0xab, 0xaa, 0xab, 0xaa, 0x8b, 0xdd,
+	0xd0, 0xf0, 0x71, 0xe0, 0x9e, 0xf8, 0x06, 0x0e, 0x36, 0x3d, 0xdf, 0x0d, 0x5d, 0x94, 0xd7, 0x3d,
+	0xab, 0xfd, 0xfa, 0xd0, 0x75, 0x87, 0x36, 0xbe, 0x4d, 0x41, 0x47, 0x27, 0xc7, 0xb7, 0x43, 0x6b,
+	0x8c, 0x83, 0x50, 0x1f, 0x7b, 0x8c, 0xaa, 0x7d, 0x3d, 0x4d, 0xf0, 0xdc, 0xd7, 0x3d, 0x0f, 0xfb,
+	0x7c, 0x14, 0xe5, 0x8f, 0x73, 0x00, 0xdd, 0x91, 0xee, 0x0c, 0xf1, 0xa1, 0x6e, 0x3c, 0x43, 0x6f,
+	0x40, 0xcd, 0x74, 0x8d, 0x93, 0x31, 0x76, 0x42, 0xed, 0x19, 0x3e, 0x6b, 0x49, 0x1b, 0xd2, 0x0d,
+	0x59, 0xad, 0x0a, 0xd8, 0xf7, 0xf1, 0x19, 0xba, 0x0d, 0x60, 0x8c, 0xb0, 0xf1, 0xcc, 0x73, 0x2d,
+	0x27, 0x6c, 0xe5, 0x36, 0xa4, 0x1b, 0xd5, 0xbb, 0x8d, 0x4d, 0xdd, 0xb3, 0x36, 0xbb, 0x11, 0x58,
+	0x8d, 0x91, 0xa0, 0x36, 0x54, 0x02, 0x47, 0xf7, 0x82, 0x91, 0x1b, 0xb6, 0xf2, 0x1b, 0xd2, 0x8d,
+	0x9a, 0x1a, 0xb5, 0xd1, 0xdb, 0x50, 0x36, 0xe8, 0xec, 0x41, 0xab, 0xb0, 0x91, 0xbf, 0x51, 0xbd,
+	0x5b, 0xe5, 0x23, 0x11, 0x98, 0x2a, 0x70, 0xe8, 0x3e, 0xac, 0x8e, 0x2d, 0x47, 0x0b, 0xce, 0x1c,
+	0x03, 0x9b, 0x5a, 0x68, 0x19, 0xcf, 0x70, 0xd8, 0x2a, 0xc6, 0xa6, 0x1e, 0x58, 0x63, 0x3c, 0xa0,
+	0x60, 0xb5, 0x31, 0xb6, 0x9c, 0x3e, 0x25, 0x64, 0x00, 0x74, 0x13, 0x9a, 0x26, 0x3e, 0xc6, 0xbe,
+	0x8f, 0x4d, 0x4d, 0x4c, 0x56, 0xda, 0x90, 0x6e, 0xd4, 0xd5, 0x86, 0x80, 0xb3, 0xf9, 0x02, 0xe5,
+	0x33, 0x28, 0xb1, 0x4f, 0xf4, 0x1a, 0xe4, 0x2c, 0x93, 0x2e, 0xbf, 0x7a, 0xb7, 0x1e, 0xe3, 0x69,
+	0x77, 0x5b, 0xcd, 0x59, 0x26, 0x6a, 0x41, 0x79, 0x8c, 0x83, 0x40, 0x1f, 0x62, 0x2a, 0x01, 0x59,
+	0x15, 0x4d, 0xb4, 0x09, 0xe0, 0x7a, 0xd8, 0xd7, 0x43, 0xcb, 0x75, 0x82, 0x56, 0x9e, 0x2e, 0x6a,
+	0x85, 0x0e, 0x70, 0x20, 0xc0, 0x6a, 0x8c, 0x42, 0xf9, 0x5c, 0x82, 0x8a, 0x18, 0x1a, 0xbd, 0x06,
+	0x60, 0xd8, 0x16, 0x11, 0x7e, 0x80, 0x3f, 0xa3, 0xb3, 0xd7, 0x55, 0x99, 0x41, 0xfa, 0xf8, 0x33,
+	0xf4, 0x06, 0x40, 0x80, 0xfd, 0x53, 0xec, 0x53, 0x34, 0x99, 0xb8, 0xb0, 0x95, 0xbb, 0x23, 0xa9,
+	0x32, 0x83, 0x12, 0x92, 0x6b, 0x50, 0xb6, 0xf5, 0xb1, 0xe7, 0xfa, 0x4c, 0xd6, 0x0c, 0x2f, 0x40,
+	0xe8, 0x15, 0xa8, 0xe8, 0x46, 0xe8, 0xfa, 0x9a, 0x65, 0xb6, 0x0a, 0x54, 0x15, 0x65, 0xda, 0xde,
+	0x35, 0x95, 0x5f, 0x6c, 0x80, 0x1c, 0x71, 0x88, 0xbe, 0x01, 0xf9, 0x00, 0x87, 0x7c, 0xfd, 0x28,
+	0xc9, 0xfe, 0x66, 0x1f, 0x87, 0x3b, 0x97, 0x54, 0x42, 0x40, 0xe8, 0x74, 0xd3, 0x6c, 0xe5, 0x32,
+	0xe9, 0x3a, 0xa6, 0x49, 0xe8, 0x74, 0xd3, 0x44, 0x37, 0xa1, 0x30, 0x76, 0x4f, 0x31, 0xe5, 0xa9,
+	0x7a, 0x77, 0x2d, 0x45, 0xf8, 0xc8, 0x3d, 0xc5, 0x3b, 0x97, 0x54, 0x4a, 0x82, 0x6e, 0x43, 0xc9,
+	0xc7, 0x94, 0xb8, 0x40, 0x89, 0x2f, 0xa7, 0x88, 0x55, 0x8a, 0xdc, 0xb9, 0xa4, 0x72, 0x32, 0x32,
+	0x36, 0x36, 0x2d, 0x61, 0x0f, 0xe9, 0xb1, 0x7b, 0xa6, 0x45, 0xb8, 0xa5, 0x24, 0x64, 0xec, 0x00,
+	0xdb, 0xd8, 0x08, 0x5b, 0xa5, 0xcc, 0xb1, 0xfb, 0x14, 0x49, 0xc6, 0x66, 0x64, 0xe8, 0x03, 0x90,
+	0x7d, 0xcb, 0x18, 0x69, 0x74, 0x82, 0x32, 0xed, 0x73, 0x35, 0xcd, 0x8f, 0x65, 0x8c, 0xf8, 0x24,
+	0x15, 0x9f, 0x7f, 0xa3, 0x77, 0xa1, 0x18, 0x84, 0x67, 0x36, 0x6e, 0x55, 0x68, 0x9f, 0xf5, 0xf4,
+	0x3c, 0x04, 0xb7, 0x73, 0x49, 0x65, 0x44, 0xe8, 0x5b, 0x50, 0xb1, 0x1c, 0xc3, 0xc7, 0x7a, 0x80,
+	0x5b, 0x72, 0xe6, 0x24, 0xbb, 0x1c, 0x4d, 0x26, 0x11, 0xa4, 0x84, 0xb9, 0xd0, 0xc7, 0x98, 0x31,
+	0x07, 0x99, 0xfd, 0x06, 0x3e, 0xc6, 0x82, 0xb9, 0x90, 0x7f, 0xa3, 0x7b, 0x00, 0xb4, 0x1f, 0xe3,
+	0xb0, 0x4a, 0x3b, 0xb6, 0x32, 0x3a, 0x0a, 0x2e, 0xe5, 0x50, 0x34, 0xc8, 0xba, 0x0c, 0x1b, 0xeb,
+	0x7e, 0xab, 0x9e, 0xb9, 0xae, 0x2e, 0xc1, 0x91, 0x75, 0x51, 0x22, 0xf4, 0x2a, 0xc8, 0xcf, 0x75,
+	0xdb, 0xd6, 0x88, 0x53, 0x6a, 0xd5, 0x36, 0xa4, 0x1b, 0x79, 0xb5, 0x42, 0x00, 0x64, 0xb7, 0xa2,
+	0x15, 0xba, 0xc3, 0x56, 0xe8, 0xee, 0xc9, 0x59, 0x66, 0xfb, 0x5f, 0x25, 0xc8, 0xf7, 0x71, 0x48,
+	0xf6, 0xba, 0xa7, 0xfb, 0x64, 0x0f, 0x90, 0x65, 0x86, 0xd8, 0xd4, 0x74, 0x61, 0x88, 0xd3, 0x7b,
+	0x9d, 0x51, 0x76, 0x19, 0x61, 0x27, 0x44, 0x4d, 0xc8, 0x13, 0xb7, 0xc5, 0xf6, 0x24, 0xf9, 0x24,
+	0x1c, 0x9f, 0xea, 0xf6, 0x89, 0x30, 0xbd, 0x2b, 0x74, 0x88, 0x8f, 0xfb, 0x07, 0xfb, 0x3d, 0x1b,
+	0x13, 0x97, 0xd6, 0xb7, 0xc6, 0x9e, 0x8d, 0x55, 0x46, 0x84, 0xee, 0x40, 0x15, 0xbf, 0xc0, 0xc6,
+	0x09, 0x9f, 0xb6, 0x90, 0x3d, 0x2d, 0x08, 0x9a, 0x4e, 0x88, 0xae, 0x03, 0x0c, 0xb1, 0xc3, 0x05,
+	0x40, 0x6d, 0xb0, 0xae, 0xc6, 0x20, 0xed, 0x7f, 0x93, 0x20, 0xdf, 0x31, 0xcd, 0x8b, 0x2d, 0xeb,
+	0xdb, 0xd0, 0xf0, 0x7c, 0x7c, 0x1a, 0xef, 0x9a, 0xcb, 0xee, 0x5a, 0x27, 0x74, 0x93, 0x8e, 0xbf,
+	0xe2, 0xd5, 0xb7, 0x7f, 0x21, 0x41, 0x81, 0xec, 0xde, 0xaf, 0x68, 0x79, 0x9b, 0x00, 0xb1, 0x3e,
+	0xf9, 0xec, 0x3e, 0xb2, 0x11, 0xd1, 0x2f, 0xbf, 0xc0, 0x9f, 0x48, 0x50, 0x62, 0x1e, 0xe7, 0x62,
+	0x4b, 0x4c, 0x72, 0x9a, 0x5b, 0x96, 0xd3, 0xfc, 0x7c, 0x4e, 0xff, 0x2c, 0x0f, 0x05, 0xba, 0xbd,
+	0x2f, 0xc4, 0xe7, 0x5b, 0x50, 0x38, 0xf6, 0xdd, 0x31, 0xe7, 0xb0, 0xc9, 0xe8, 0xf1, 0x8b, 0x70,
+	0xdf, 0x35, 0xf1, 0xa1, 0x1b, 0xa8, 0x14, 0x8b, 0x36, 0x20, 0x17, 0xba, 0xad, 0xfc, 0x0c, 0x9a,
+	0x5c, 0xe8, 0xa2, 0x23, 0xb8, 0x3a, 0x99, 0x5d, 0x1b, 0xeb, 0x9e, 0x76, 0x74, 0xa6, 0xd1, 0x58,
+	0xc3, 0x03, 0xfd, 0xbb, 0x19, 0x7e, 0x7a, 0x33, 0xe2, 0xe3, 0x91, 0xee, 0x6d, 0x9d, 0x75, 0x08,
+	0x79, 0xcf, 0x09, 0xfd, 0x33, 0x75, 0xcd, 0x98, 0xc6, 0x90, 0x20, 0x6c, 0xb8, 0x4e, 0x88, 0x1d,
+	0xe6, 0xfb, 0x65, 0x55, 0x34, 0xd3, 0xd2, 0x2b, 0xcd, 0x97, 0xde, 0x53, 0x68, 0xcd, 0x9a, 0x5c,
+	0x38, 0x15, 0x69, 0xe2, 0x54, 0xde, 0x16, 0xdb, 0x6a, 0x86, 0x22, 0x19, 0xf6, 0xbb, 0xb9, 0xef,
+	0x48, 0xed, 0x9f, 0x49, 0x50, 0x62, 0x61, 0xe5, 0xe5, 0x50, 0xcc, 0xf2, 0x5b, 0xe0, 0xc7, 0x05,
+	0xa8, 0x88, 0x20, 0xf7, 0x72, 0xac, 0xe1, 0x78, 0x9e, 0x71, 0xdd, 0x99, 0x11, 0xa3, 0x7f, 0x69,
+	0x06, 0xf6, 0x10, 0x40, 0x0f, 0x43, 0xdf, 0x3a, 0x3a, 0x09, 0x69, 0x36, 0x49, 0x26, 0x7d, 0x67,
+	0xd6, 0xa4, 0x9d, 0x88, 0x92, 0xcd, 0x15, 0xeb, 0x9a, 0x56, 0x47, 0xf9, 0x2b, 0xb4, 0xd4, 0x8f,
+	0xa0, 0x91, 0xe2, 0x34, 0x63, 0xbc, 0xf5, 0xf8, 0x78, 0x72, 0xbc, 0xfb, 0xdf, 0xe7, 0xa0, 0xc8,
+	0x92, 0x84, 0x97, 0xc2, 0x46, 0xb6, 0x13, 0x1a, 0x62, 0x66, 0xf1, 0x56, 0x56, 0x1a, 0xb6, 0x8c,
+	0x7a, 0x8a, 0xf3, 0xd5, 0x73, 0x41, 0x29, 0xfe, 0x44, 0x82, 0x8a, 0x48, 0xf6, 0x2e, 0x26, 0xc8,
+	0x77, 0x93, 0x9a, 0x5f, 0x2e, 0xf4, 0x2f, 0x10, 0x6f, 0xfe, 0x2a, 0x0f, 0x15, 0x91, 0x5e, 0x5e,
+	0x8c, 0xd3, 0x8d, 0x84, 0xca, 0x6b, 0x8c, 0xde, 0xc7, 0x31, 0x75, 0x5f, 0x8b, 0xa9, 0x3b, 0x89,
+	0xff, 0x52, 0xee, 0x40, 0xb0, 0xbd, 0xa4, 0x3b, 0xb8, 0x09, 0x15, 0xbe, 0xff, 0x83, 0x56, 0x71,
+	0x23, 0x1f, 0x9d, 0x0c, 0xc9, 0x70, 0xc4, 0xf4, 0xd4, 0x08, 0xfd, 0x32, 0x05, 0xa0, 0xcf, 0x0b,
+	0x20, 0x47, 0xd9, 0xfc, 0x57, 0xab, 0xa8, 0xe1, 0x3c, 0x45, 0xfd, 0xc6, 0xac, 0x53, 0xc8, 0x92,
+	0x9a, 0xda, 0x49, 0x6c, 0x7e, 0xa6, 0xab, 0x1b, 0x33, 0xc7, 0x5e, 0xc2, 0x01, 0x94, 0x7e, 0x7d,
+	0xfd, 0xf3, 0x29, 0x14, 0xe9, 0xf1, 0xec, 0x62, 0x26, 0x90, 0x92, 0x47, 0x6e, 0xae, 0x3c, 0xb6,
+	0x4a, 0x50, 0x38, 0x72, 0xcd, 0x33, 0xe5, 0xe7, 0x12, 0xac, 0x4e, 0xb9, 0x9f, 0x54, 0x5e, 0x2c,
+	0xcd, 0xcd, 0x8b, 0x6f, 0x41, 0x85, 0x24, 0xe3, 0xe7, 0x4d, 0x5e, 0xa6, 0x04, 0x2c, 0xe7, 0xf6,
+	0x71, 0x44, 0x3d, 0xeb, 0x74, 0xc0, 0x49, 0x3a, 0x21, 0x52, 0xa0, 0x10, 0x9e, 0x79, 0xac, 0xee,
+	0xb0, 0xc2, 0x8b, 0x36, 0x4f, 0x88, 0xfc, 0x06, 0x67, 0x1e, 0x56, 0x29, 0x6e, 0x22, 0xdf, 0x22,
+	0x2d, 0x9f, 0xb0, 0x86, 0xf2, 0x18, 0x2a, 0x7d, 0x51, 0xd2, 0xba, 0x0d, 0x05, 0xdf, 0x75, 0xc5,
+	0x5a, 0x5e, 0x4d, 0xbb, 0x5d, 0xfa, 0x7d, 0x70, 0xf4, 0x29, 0x36, 0x42, 0x95, 0x12, 0x92, 0x2c,
+	0xe3, 0x14, 0xfb, 0x01, 0x39, 0x3e, 0x92, 0x15, 0x15, 0x55, 0xd1, 0x54, 0x3e, 0x6f, 0x40, 0x35,
+	0xd6, 0x15, 0x7d, 0x0f, 0xaa, 0x9f, 0x06, 0xae, 0xa3, 0xb9, 0xb4, 0xfb, 0x02, 0x33, 0xec, 0x5c,
+	0x52, 0x81, 0xf4, 0x60, 0x2d, 0x74, 0x1f, 0x68, 0x4b, 0xd3, 0x7d, 0x5f, 0x3f, 0xe3, 0xe2, 0x6b,
+	0x67, 0x76, 0xef, 0x10, 0x0a, 0x72, 0xf4, 0x27, 0xf4, 0xb4, 0x81, 0xbe, 0x0b, 0xb2, 0xe7, 0x5b,
+	0x63, 0x2b, 0xb4, 0xa2, 0x3a, 0xce, 0x74, 0xdf, 0x43, 0x41, 0x41, 0xfa, 0x46, 0xe4, 0xe8, 0x9b,
+	0x50, 0x08, 0xf1, 0x8b, 0x30, 0x51, 0xd1, 0x89, 0x77, 0x23, 0xc1, 0x9b, 0x14, 0x69, 0x08, 0x11,
+	0xfa, 0x0e, 0xaf, 0xb9, 0xd0, 0x1e, 0x2c, 0xe2, 0xbe, 0x32, 0xd5, 0x83, 0x24, 0x57, 0xbc, 0x57,
+	0xc5, 0xe7, 0xdf, 0xe8, 0x37, 0x49, 0xbe, 0x76, 0xe2, 0x84, 0xd8, 0x6f, 0x95, 0x62, 0x55, 0x8d,
+	0x78, 0xbf, 0x2e, 0xc3, 0xef, 0x5c, 0x52, 0x05, 0x29, 0x65, 0xce, 0xc7, 0xb8, 0x55, 0x9e, 0xc5,
+	0x9c, 0x8f, 0x69, 0x75, 0x8a, 0x10, 0xb5, 0xff, 0x47, 0x02, 0x98, 0xc8, 0x17, 0x29, 0x50, 0x74,
+	0x5c, 0x13, 0x07, 0x2d, 0x69, 0x23, 0x1f, 0xb9, 0x3c, 0x75, 0x67, 0x40, 0xc3, 0x01, 0x43, 0x2d,
+	0x7d, 0xf4, 0x8b, 0x9b, 0x78, 0x7e, 0x29, 0x13, 0x2f, 0xcc, 0x35, 0x71, 0xc2, 0x0b, 0x71, 0x02,
+	0xe7, 0xa6, 0x33, 0x32, 0x27, 0xe9, 0x84, 0xed, 0xff, 0x96, 0x40, 0x8e, 0xec, 0x61, 0xc6, 0x6a,
+	0x1f, 0x76, 0xbe, 0x2e, 0xab, 0xfd, 0x17, 0x09, 0xe4, 0xc8, 0x82, 0x23, 0x77, 0x20, 0x2d, 0xe2,
+	0x0e, 0x72, 0x31, 0x77, 0xb0, 0x74, 0x59, 0x22, 0x2e, 0x83, 0xc2, 0x52, 0x32, 0x28, 0xce, 0x93,
+	0x41, 0xfb, 0xef, 0x24, 0x28, 0xd0, 0xcd, 0xf1, 0x66, 0x52, 0x79, 0xf5, 0x44, 0xd6, 0xfc, 0x12,
+	0x6a, 0x8f, 0x9c, 0x9c, 0x2b, 0x62, 0x9b, 0xa3, 0x77, 0x92, 0xdc, 0xaf, 0x32, 0xd3, 0xe3, 0xd8,
+	0x97, 0x75, 0x05, 0x7f, 0x98, 0x83, 0x32, 0x77, 0x38, 0x5f, 0x0f, 0x6b, 0x42, 0x77, 0xa1, 0x26,
+	0xca, 0xcf, 0xe7, 0xe5, 0x43, 0xd5, 0x88, 0x48, 0x58, 0xa0, 0x8f, 0xf1, 0x0c, 0x0b, 0x14, 0xc9,
+	0xf3, 0xcb, 0xa7, 0x3f, 0x92, 0xba, 0x6c, 0x91, 0xd4, 0x65, 0x08, 0x65, 0xee, 0xd3, 0x33, 0x32,
+	0xae, 0x5b, 0x50, 0xc6, 0x2c, 0x52, 0x24, 0xce, 0xac, 0xb1, 0x08, 0xa2, 0x0a, 0x82, 0x54, 0xb1,
+	0x38, 0x9f, 0x2e, 0x16, 0x2b, 0x4f, 0xa1, 0xcc, 0xdd, 0x29, 0xc9, 0xb5, 0x1d, 0x12, 0x00, 0xa5,
+	0x58, 0x2e, 0xcd, 0x71, 0x2a, 0xc5, 0x2c, 0x33, 0xb1, 0xf2, 0x97, 0x12, 0x54, 0xc4, 0x4e, 0x41,
+	0xaf, 0xc7, 0xfe, 0x6d, 0x35, 0x12, 0x6e, 0x80, 0xff, 0xdd, 0xca, 0x4c, 0x22, 0x97, 0x4e, 0xa7,
+	0x6e, 0x43, 0xd5, 0x72, 0x02, 0x8d, 0x56, 0x76, 0xf9, 0xff, 0xa6, 0x8c, 0xf9, 0x64, 0xcb, 0x09,
+	0x0e, 0x7d, 0x7c, 0xba, 0x6b, 0x2a, 0x9f, 0x42, 0x33, 0xbe, 0xa3, 0x49, 0xb2, 0xbb, 0x68, 0x86,
+	0x4b, 0x98, 0x3b, 0xf1, 0xcc, 0x79, 0x9b, 0x84, 0x93, 0x74, 0x42, 0xe5, 0x67, 0x39, 0xa8, 0xc5,
+	0x27, 0x9b, 0x2f, 0x94, 0x4e, 0xe2, 0x4c, 0x91, 0xa3, 0x26, 0xfc, 0xc6, 0x94, 0x1b, 0x3a, 0xf7,
+	0x30, 0xb1, 0x1e, 0xaf, 0xc6, 0xcf, 0x90, 0x6b, 0x61, 0x59, 0xb9, 0x16, 0xe7, 0xc9, 0xb5, 0x3d,
+	0x58, 0xe4, 0xe0, 0xf0, 0xcd, 0xe4, 0x41, 0xe4, 0xf2, 0xd4, 0xca, 0xc8, 0x10, 0xb1, 0xf3, 0x84,
+	0x32, 0x00, 0x98, 0x4c, 0xb7, 0x74, 0x1e, 0x7f, 0x05, 0x4a, 0xee, 0xf1, 0x31, 0xf9, 0xc7, 0xc8,
+	0x72, 0x5e, 0xde, 0x52, 0xfe, 0x36, 0xc7, 0xaa, 0x0a, 0xb3, 0x74, 0x32, 0x19, 0x8c, 0xe8, 0x04,
+	0x71, 0xa7, 0xca, 0x4c, 0x21, 0xe5, 0x44, 0x2f, 0x24, 0xe4, 0x75, 0x28, 0x9a, 0xd8, 0x0b, 0x47,
+	0x54, 0xbc, 0x45, 0x95, 0x35, 0xd0, 0x47, 0x19, 0x65, 0xbf, 0xd7, 0x12, 0x6e, 0xec, 0x3c, 0xfd,
+	0xff, 0x8a, 0x14, 0xf1, 0x27, 0x12, 0x94, 0xf9, 0x29, 0xfb, 0x62, 0x67, 0xbb, 0x07, 0x70, 0xd5,
+	0xc6, 0xc7, 0xa1, 0x16, 0x58, 0x47, 0xb6, 0xe5, 0x0c, 0x17, 0xf8, 0x1d, 0xb3, 0x4e, 0xe8, 0xfb,
+	0x8c, 0x3c, 0x1a, 0x47, 0xf9, 0x31, 0x40, 0xf9, 0xd0, 0x77, 0x69, 0x82, 0xbc, 0x12, 0xa9, 0x50,
+	0x16, 0x1a, 0x73, 0xf4, 0x71, 0xa4, 0x31, 0xf2, 0x4d, 0xfe, 0x7a, 0x7b, 0x27, 0x47, 0xb6, 0x65,
+	0xd0, 0x2b, 0x07, 0x4c, 0x6d, 0x32, 0x83, 0x90, 0x0b, 0x07, 0xaf, 0x91, 0xbf, 0xde, 0x86, 0x8f,
+	0xd9, 0x8d, 0x84, 0x02, 0x43, 0x33, 0x08, 0x41, 0xdf, 0x80, 0xa6, 0x7e, 0x12, 0x8e, 0xb4, 0xe7,
+	0xf8, 0x68, 0xe4, 0xba, 0xcf, 0xb4, 0x13, 0xdf, 0xe6, 0xd5, 0xda, 0x15, 0x02, 0x7f, 0xca, 0xc0,
+	0x8f, 0x7d, 0x1b, 0xdd, 0x81, 0xf5, 0x04, 0xe5, 0x18, 0x87, 0x23, 0xd7, 0x64, 0x7a, 0x94, 0x55,
+	0x14, 0xa3, 0x7e, 0xc4, 0x30, 0xe4, 0x4f, 0x69, 0x4c, 0x08, 0x65, 0x7e, 0xe8, 0x61, 0x57, 0x2a,
+	0x36, 0xc5, 0x95, 0x8a, 0xcd, 0x81, 0xb8, 0x73, 0x11, 0x37, 0xf0, 0x7b, 0x09, 0x87, 0x54, 0x99,
+	0xdf, 0x35, 0xf2, 0x4d, 0xe8, 0x01, 0xac, 0xc5, 0x2f, 0x61, 0x68, 0x9e, 0x6b, 0x5b, 0xc6, 0x59,
+	0x4b, 0x8e, 0xd5, 0xf1, 0xb6, 0x27, 0x17, 0x32, 0x0e, 0x29, 0x56, 0x5d, 0x35, 0xd3, 0x20, 0x74,
+	0x0b, 0x56, 0x0d, 0xd7, 0xb6, 0xb1, 0x11, 0x6a, 0xba, 0xe7, 0xd9, 0x67, 0x9a, 0xad, 0x0f, 0xe9,
+	0x7f, 0xe2, 0x8a, 0xda, 0xe0, 0x88, 0x0e, 0x81, 0xef, 0xe9, 0x43, 0xf4, 0x0e, 0x34, 0x2c, 0xc7,
+	0x0a, 0x2d, 0xdd, 0xd6, 0x44, 0xc9, 0xbb, 0xca, 0x84, 0xc8, 0xc1, 0x5d, 0x06, 0x45, 0x9b, 0xb0,
+	0xc6, 0x8e, 0x9f, 0xda, 0x18, 0xfb, 0x43, 0x2c, 0x98, 0xab, 0x51, 0xe2, 0x55, 0x86, 0x7a, 0x44,
+	0x30, 0x13, 0x26, 0xf0, 0x29, 0x59, 0x49, 0x5c, 0x3f, 0x75, 0x4a, 0xdd, 0xa0, 0x88, 0x98, 0x82,
+	0xde, 0x86, 0x95, 0x68, 0xe1, 0xf4, 0x74, 0x46, 0x7f, 0x0f, 0x17, 0xd5, 0xba, 0x80, 0xd2, 0x64,
+	0x8a, 0xe8, 0x11, 0x7b, 0x23, 0x3c, 0xc6, 0xbe, 0x6e, 0x33, 0x01, 0xf9, 0xf8, 0xd8, 0x7a, 0xd1,
+	0x6a, 0xd0, 0x51, 0x51, 0x84, 0x23, 0x92, 0xa0, 0x18, 0x32, 0x30, 0xbb, 0xf9, 0x71, 0x8c, 0xb1,
+	0x49, 0x39, 0x68, 0x52, 0xda, 0xfa, 0x04, 0x4a, 0xe6, 0xff, 0x00, 0x2a, 0xc7, 0x58, 0x0f, 0x4f,
+	0x7c, 0x1c, 0xb4, 0x56, 0x37, 0xf2, 0xd1, 0x09, 0x97, 0x1b, 0xf3, 0xe6, 0x03, 0x8e, 0x64, 0x3b,
+	0x3b, 0xa2, 0x45, 0x6f, 0x42, 0x5d, 0xf7, 0x8d, 0x91, 0x75, 0x8a, 0x35, 0xfd, 0x98, 0x9c, 0x3e,
+	0x11, 0x1d, 0xbd, 0xc6, 0x81, 0x1d, 0x02, 0x43, 0x2a, 0xa0, 0x68, 0x71, 0x21, 0x1e, 0x7b, 0xb6,
+	0x4e, 0x7c, 0xc8, 0x1a, 0x9d, 0xe6, 0xcd, 0xc4, 0x34, 0x42, 0xb9, 0x03, 0x41, 0xc5, 0xe6, 0x5b,
+	0x35, 0xd3, 0x70, 0xf4, 0x21, 0xb4, 0xf1, 0x0b, 0xcf, 0xb6, 0x0c, 0x2b, 0xd4, 0x26, 0x92, 0xf3,
+	0x31, 0xcb, 0x2f, 0xd6, 0xa9, 0xaa, 0x5b, 0x82, 0x42, 0x0c, 0xdb, 0xe5, 0x78, 0xf4, 0x0d, 0x68,
+	0x88, 0xdb, 0x20, 0x42, 0x8d, 0x97, 0x99, 0x58, 0xf8, 0xa5, 0x10, 0xae, 0xc2, 0xf7, 0x00, 0xe9,
+	0xb6, 0xed, 0x3e, 0xc7, 0xa6, 0x16, 0xbb, 0xda, 0x72, 0x85, 0xee, 0x9a, 0x55, 0x8e, 0x89, 0xea,
+	0x6a, 0x84, 0xa9, 0x86, 0xb8, 0xdf, 0x23, 0x86, 0xbd, 0x1a, 0xbb, 0x9a, 0x21, 0x0a, 0x25, 0xdc,
+	0x6e, 0x57, 0x82, 0x44, 0x1b, 0xbd, 0x07, 0x6b, 0x63, 0xfd, 0x05, 0x51, 0x6b, 0xa0, 0x79, 0xd8,
+	0x17, 0xb5, 0x8e, 0x16, 0x35, 0x84, 0xe6, 0x58, 0x7f, 0xf1, 0x7d, 0x7c, 0x16, 0x1c, 0x62, 0x9f,
+	0x1f, 0xc0, 0x3f, 0x80, 0xab, 0xc2, 0xaf, 0x47, 0x02, 0xe0, 0x93, 0xbe, 0x42, 0xd7, 0x72, 0x99,
+	0xa3, 0xc5, 0xea, 0xd9, 0x34, 0xed, 0xfb, 0x50, 0x4f, 0x68, 0x73, 0x5e, 0xa2, 0x51, 0x89, 0x97,
+	0xd2, 0xb6, 0xe1, 0x4a, 0xb6, 0x8e, 0x96, 0x29, 0xc8, 0x29, 0x3f, 0x92, 0x60, 0x75, 0x6a, 0x1f,
+	0x93, 0x8d, 0x28, 0x84, 0x6d, 0x8c, 0x74, 0x5f, 0xdc, 0xc2, 0x21, 0xde, 0x8c, 0x81, 0xbb, 0x0c,
+	0x4a, 0xdc, 0x22, 0x11, 0x94, 0x8d, 0x9d, 0x61, 0x38, 0xe2, 0x51, 0x54, 0x1e, 0xeb, 0x2f, 0xf6,
+	0x28, 0x00, 0xdd, 0x86, 0x35, 0xd3, 0x0a, 0xc4, 0x50, 0x6c, 0x87, 0x60, 0x76, 0x21, 0x49, 0x56,
+	0xd1, 0x04, 0x75, 0xc8, 0x31, 0xca, 0x19, 0xac, 0x24, 0x55, 0x83, 0xae, 0x81, 0x1c, 0x8e, 0x7c,
+	0x1c, 0x8c, 0x5c, 0x9b, 0xb9, 0xf0, 0x82, 0x3a, 0x01, 0xa0, 0x0d, 0xa8, 0x1a, 0xee, 0xd8, 0xf3,
+	0x71, 0x10, 0x95, 0xae, 0x64, 0x35, 0x0e, 0x22, 0x4b, 0xf1, 0x71, 0x88, 0x1d, 0x62, 0x16, 0x7c,
+	0x3f, 0xd3, 0x3b, 0x49, 0xea, 0x4a, 0x04, 0xa6, 0x1b, 0x5a, 0xf9, 0xcf, 0x15, 0xb8, 0xf2, 0x98,
+	0xb8, 0x3f, 0xfd, 0xc8, 0xc6, 0x7c, 0x17, 0x3c, 0xb0, 0xb0, 0x6d, 0x92, 0xfa, 0x2b, 0x8b, 0x17,
+	0x2c, 0x86, 0x5d, 0x9b, 0x72, 0xa0, 0xfd, 0xd0, 0xb7, 0x9c, 0x21, 0x3d, 0x48, 0xf1, 0x68, 0xf2,
+	0x20, 0x23, 0x1e, 0xe4, 0x16, 0xe8, 0x9d, 0x8e, 0x16, 0xbf, 0x3b, 0x23, 0x5a, 0xb0, 0xdc, 0x72,
+	0x93, 0xda, 0x72, 0x36, 0xd3, 0x9b, 0x9d, 0xa9, 0x48, 0x92, 0x19, 0x5d, 0x66, 0xf8, 0xf9, 0xc2,
+	0xb2, 0x7e, 0xfe, 0x41, 0x96, 0x9f, 0x2f, 0xce, 0x88, 0x38, 0x5b, 0xae, 0x6b, 0xb3, 0x05, 0x4f,
+	0xc5, 0x80, 0xde, 0x74, 0x0c, 0x28, 0x2d, 0x22, 0xb8, 0x54, 0x84, 0xd8, 0xcb, 0x8e, 0x10, 0xe5,
+	0x05, 0x86, 0xca, 0x88, 0x1f, 0x3b, 0x59, 0xf1, 0xa3, 0xb2, 0xc0, 0x58, 0x53, 0xd1, 0x65, 0x7f,
+	0x46, 0xd8, 0x90, 0x17, 0x18, 0x2c, 0x2b, 0xa8, 0x74, 0xa7, 0x82, 0x0a, 0x2c, 0x30, 0x52, 0x2a,
+	0xe4, 0xfc, 0x56, 0x2c, 0xe4, 0xb0, 0x9b, 0x58, 0x6f, 0x9d, 0x67, 0x59, 0xc2, 0x67, 0xc5, 0x82,
+	0x4f, 0x27, 0x1d, 0x7c, 0x6a, 0x0b, 0x70, 0x91, 0x0c, 0x4d, 0x3f, 0xcc, 0x0c, 0x4d, 0xec, 0x8a,
+	0xd7, 0x7b, 0xe7, 0xb1, 0x33, 0xe5, 0x05, 0xb3, 0x82, 0xd4, 0x0f, 0xce, 0x0d, 0x52, 0x2b, 0x73,
+	0xed, 0x74, 0x76, 0x00, 0xdb, 0x9e, 0x0e, 0x60, 0x8d, 0x45, 0x54, 0x90, 0x0c, 0x6f, 0x3f, 0xcc,
+	0x0c, 0x6f, 0xcd, 0xf9, 0xab, 0xef, 0xa4, 0x43, 0xdf, 0x82, 0xd1, 0x70, 0x75, 0xf1, 0x68, 0xf8,
+	0x71, 0x76, 0x34, 0x44, 0xbc, 0xf2, 0x9f, 0x5e, 0xe5, 0xae, 0x13, 0xbe, 0x7f, 0x97, 0x2d, 0x72,
+	0x3a, 0x54, 0x0e, 0x66, 0x87, 0xca, 0xb5, 0x05, 0xa4, 0x36, 0x23, 0x90, 0x6e, 0x02, 0x9a, 0x76,
+	0x77, 0xec, 0x7e, 0x2c, 0xfd, 0xa4, 0xc5, 0x1e, 0x59, 0x15, 0xcd, 0xf6, 0x9f, 0x4b, 0x50, 0x11,
+	0x56, 0x8c, 0xf6, 0x63, 0xd6, 0xcf, 0x8a, 0x42, 0x77, 0x17, 0xb1, 0xfe, 0x59, 0x89, 0xd8, 0xc5,
+	0xa2, 0xfa, 0x4f, 0x63, 0xf1, 0x78, 0x62, 0xbd, 0xbf, 0x03, 0xf2, 0x64, 0x4b, 0x30, 0x1e, 0x3f,
+	0x5c, 0x6a, 0x4b, 0x6c, 0xa6, 0xd2, 0xb8, 0xc9, 0x70, 0xed, 0x0f, 0x61, 0xe5, 0xcb, 0xe7, 0x0f,
+	0xed, 0xf7, 0x61, 0x75, 0xca, 0x02, 0x49, 0x85, 0x29, 0x66, 0xc4, 0x4c, 0xf6, 0x31, 0x88, 0xf2,
+	0xa7, 0x45, 0x68, 0x08, 0x16, 0xfb, 0x27, 0xe3, 0xb1, 0xee, 0x9f, 0x4d, 0x9d, 0xd1, 0xa6, 0x2f,
+	0x51, 0xa6, 0xaf, 0x70, 0xcb, 0xb1, 0x2b, 0xdc, 0xc9, 0x33, 0x52, 0x61, 0x99, 0x33, 0xd2, 0x7d,
+	0xa8, 0xea, 0x86, 0x81, 0x83, 0x20, 0x5e, 0x7e, 0x3c, 0xaf, 0x2f, 0x08, 0xf2, 0xa9, 0x03, 0x56,
+	0x69, 0x99, 0x03, 0xd6, 0xf7, 0xa0, 0x32, 0xc6, 0xa1, 0x4e, 0xf4, 0xd7, 0x2a, 0x53, 0x95, 0x2a,
+	0x89, 0x68, 0xcb, 0x05, 0xb3, 0xf9, 0x88, 0x13, 0x71, 0x33, 0x13, 0x7d, 0x28, 0xdf, 0xcc, 0x7f,
+	0x2e, 0x78, 0xb8, 0x03, 0x41, 0xde, 0x21, 0xdb, 0xb0, 0x19, 0xe9, 0x83, 0x65, 0x45, 0x41, 0x4b,
+	0xa6, 0x4c, 0xdc, 0xcc, 0x64, 0x22, 0x52, 0x2e, 0xcd, 0x95, 0xb8, 0x11, 0x35, 0xdc, 0x24, 0x94,
+	0x48, 0x23, 0x56, 0xdf, 0x80, 0xf9, 0xd2, 0x98, 0x54, 0xa9, 0xef, 0x43, 0x3d, 0xb1, 0xd0, 0xa5,
+	0x8c, 0x70, 0x0b, 0xd6, 0xb3, 0x18, 0x9c, 0x37, 0x46, 0x3e, 0x9e, 0x08, 0xff, 0x8d, 0x04, 0x6b,
+	0x91, 0x6f, 0xa7, 0x97, 0xdd, 0x7b, 0x24, 0x74, 0x4f, 0xd9, 0xe5, 0xab, 0xc0, 0xef, 0xc2, 0x93,
+	0xb2, 0x17, 0xe3, 0xa4, 0xc2, 0x00, 0xbb, 0x26, 0x49, 0x14, 0x69, 0x29, 0x28, 0x4f, 0xeb, 0xeb,
+	0xd7, 0x12, 0xa2, 0x8c, 0x0d, 0x1a, 0xab, 0xb6, 0x7f, 0x79, 0xc3, 0x55, 0xfe, 0x4f, 0x02, 0x59,
+	0xc5, 0x64, 0xd7, 0x93, 0x30, 0xb4, 0xc0, 0xa3, 0x89, 0x73, 0x59, 0xbf, 0x42, 0x6e, 0xbc, 0xeb,
+	0x01, 0xaf, 0x08, 0xcb, 0x2a, 0x6f, 0xc5, 0x1f, 0x19, 0x14, 0x92, 0x8f, 0x0c, 0x5a, 0x93, 0x67,
+	0x13, 0xac, 0x3e, 0x15, 0x7b, 0x29, 0x51, 0xf5, 0x29, 0x63, 0x8b, 0x6e, 0x0b, 0x10, 0xe4, 0x1d,
+	0xfa, 0x27, 0xda, 0xf4, 0x5d, 0xcf, 0xc3, 0x26, 0xcd, 0xd6, 0x8a, 0xaa, 0x68, 0x2a, 0xff, 0x98,
+	0x83, 0xa6, 0x90, 0x26, 0x95, 0xe3, 0x9e, 0x3b, 0x64, 0x85, 0x99, 0xe8, 0x39, 0x02, 0x3f, 0x20,
+	0x4c, 0x9e, 0x22, 0xc4, 0x1f, 0x1b, 0xf0, 0x47, 0x12, 0x3c, 0xf0, 0xa6, 0xde, 0x39, 0xe4, 0xd3,
+	0xef, 0x1c, 0x5a, 0x93, 0x47, 0x0c, 0x05, 0x3a, 0xaa, 0x68, 0x92, 0x23, 0x45, 0x6a, 0xf3, 0x70,
+	0x01, 0xac, 0x24, 0x37, 0x04, 0xba, 0x07, 0x2b, 0xfc, 0x2f, 0xba, 0x76, 0x8a, 0xc9, 0xac, 0xad,
+	0x52, 0xec, 0x8d, 0xc2, 0x13, 0x86, 0x7a, 0x42, 0x31, 0x6a, 0xfd, 0x34, 0xde, 0x24, 0x07, 0x9b,
+	0x63, 0xcb, 0x19, 0x62, 0xdf, 0xf3, 0xc9, 0x0b, 0x97, 0x32, 0xd3, 0x66, 0x0c, 0x94, 0xb2, 0x9c,
+	0xca, 0x32, 0x96, 0xf3, 0x47, 0x12, 0x54, 0x0e, 0x7d, 0x1c, 0x60, 0xc7, 0xa0, 0xa5, 0x4a, 0xc3,
+	0x76, 0x8d, 0x67, 0x54, 0x76, 0x45, 0x95, 0x35, 0xc8, 0xff, 0x68, 0xea, 0x99, 0x58, 0x89, 0xf9,
+	0x2a, 0x2f, 0x0d, 0xb0, 0x2e, 0x9b, 0xdb, 0x91, 0x3b, 0xa2, 0x44, 0xed, 0x6f, 0x83, 0xbc, 0xfd,
+	0x65, 0x36, 0xae, 0xd2, 0x85, 0x12, 0xdb, 0x16, 0xb1, 0x6d, 0x56, 0xa3, 0xdb, 0xec, 0x26, 0x54,
+	0x3c, 0x3e, 0x1d, 0x3f, 0x38, 0xd5, 0x13, 0x3c, 0xa8, 0x11, 0x5a, 0xb9, 0x03, 0x65, 0x36, 0x48,
+	0x40, 0x5f, 0xf2, 0xb0, 0xcf, 0x96, 0x14, 0x7f, 0xc9, 0x43, 0x61, 0xaa, 0xc0, 0x29, 0xfb, 0xe4,
+	0xb9, 0x51, 0xf4, 0x34, 0xe8, 0x8d, 0x69, 0x0b, 0x4a, 0x3f, 0x68, 0x49, 0x9a, 0x4a, 0x2e, 0x65,
+	0x2a, 0xca, 0x5f, 0x4b, 0x50, 0x13, 0x39, 0x14, 0xf1, 0x62, 0x8b, 0x0c, 0x19, 0x7b, 0x23, 0x93,
+	0x9b, 0x7e, 0x23, 0x73, 0x2f, 0xe3, 0x77, 0xdb, 0x82, 0xf1, 0xec, 0x75, 0xa8, 0x0e, 0x75, 0xff,
+	0x48, 0x1f, 0x62, 0x72, 0x2c, 0xa7, 0xb6, 0x5b, 0x54, 0x81, 0x83, 0xf6, 0xb0, 0xa3, 0xfc, 0x83,
+	0x04, 0x35, 0x9e, 0x2e, 0xf4, 0x43, 0x3d, 0x24, 0xdb, 0xb5, 0x6e, 0xb8, 0xce, 0xb1, 0x6d, 0x19,
+	0xa1, 0xf6, 0xdc, 0x72, 0x84, 0xec, 0xd8, 0xe1, 0x8f, 0x5e, 0x1c, 0xea, 0x72, 0xf4, 0x53, 0xcb,
+	0x09, 0xd4, 0x9a, 0x11, 0x6b, 0xa1, 0x6f, 0x41, 0x9d, 0x64, 0x95, 0xc2, 0xcf, 0x88, 0x9f, 0x12,
+	0xec, 0x37, 0xd0, 0x8e, 0x1b, 0xe5, 0xcb, 0x6a, 0x6d, 0x34, 0x69, 0x90, 0x03, 0xc3, 0xea, 0x91,
+	0x6e, 0x3c, 0x1b, 0xfa, 0xee, 0x89, 0x63, 0x6a, 0x9f, 0x9d, 0xe0, 0x13, 0x2c, 0x1e, 0x2a, 0xb1,
+	0xf7, 0x1c, 0x5b, 0x11, 0xf6, 0xb7, 0x09, 0x52, 0x6d, 0x1e, 0x25, 0x01, 0x81, 0xf2, 0x11, 0xac,
+	0x4e, 0x31, 0x47, 0x6c, 0x8d, 0xdd, 0xe5, 0x62, 0xf6, 0xc7, 0x1a, 0xa4, 0xe0, 0x4b, 0x17, 0xc6,
+	0xbc, 0x3e, 0xfd, 0x56, 0x7e, 0x9a, 0x83, 0xe6, 0x9e, 0x75, 0x8a, 0x13, 0xa2, 0xb8, 0x09, 0x4d,
+	0xdd, 0x20, 0x3f, 0xde, 0x63, 0x0b, 0x62, 0xfb, 0xa2, 0xc1, 0xe0, 0x93, 0x15, 0x10, 0xd2, 0x30,
+	0xd4, 0x8d, 0x11, 0x29, 0x92, 0x70, 0xa3, 0xcb, 0x71, 0x52, 0x0e, 0x17, 0x66, 0xf9, 0x16, 0xac,
+	0xb8, 0x1e, 0xcb, 0x9d, 0x03, 0x6c, 0xb8, 0x8e, 0x49, 0x35, 0x2a, 0xa9, 0x35, 0xd7, 0x23, 0xa9,
+	0x71, 0x9f, 0xc2, 0xd0, 0x9b, 0x69, 0x49, 0x32, 0xd5, 0x25, 0xe5, 0x76, 0x1f, 0xaa, 0x63, 0xac,
+	0x07, 0x27, 0xfe, 0xc2, 0xd9, 0x8a, 0x20, 0xef, 0x84, 0xd3, 0x8a, 0x2e, 0x2d, 0xae, 0x68, 0xe5,
+	0x63, 0x58, 0x8d, 0x8b, 0x8a, 0x45, 0x47, 0x14, 0xfb, 0xa1, 0x2c, 0xfe, 0x7d, 0xa4, 0x23, 0x51,
+	0x6e, 0x2a, 0x12, 0x29, 0x16, 0x34, 0x52, 0xfa, 0xcd, 0x1c, 0x29, 0xfa, 0xff, 0x91, 0x8b, 0xff,
+	0xff, 0x78, 0x17, 0x90, 0x6b, 0x9b, 0x38, 0x08, 0x35, 0x62, 0xe3, 0x4c, 0xa0, 0x01, 0x97, 0x68,
+	0x93, 0x61, 0x3a, 0x43, 0xcc, 0x84, 0x1a, 0x28, 0xff, 0x2b, 0x41, 0x35, 0x66, 0x86, 0x8b, 0xc4,
+	0xc9, 0x69, 0x75, 0xe5, 0x32, 0xd4, 0xb5, 0x01, 0xb5, 0xd0, 0xf5, 0xb4, 0x28, 0xba, 0xb0, 0xb0,
+	0x09, 0xa1, 0xeb, 0x75, 0x78, 0x80, 0xf9, 0x00, 0x5a, 0x13, 0x8a, 0xd4, 0x88, 0x05, 0x3a, 0xe2,
+	0xba, 0xa0, 0x3e, 0x88, 0x8f, 0x7c, 0x1f, 0xaa, 0x26, 0x0e, 0xa3, 0xf0, 0xb9, 0x80, 0x8e, 0x05,
+	0x79, 0x27, 0x54, 0x7e, 0x0f, 0xaa, 0x8f, 0x74, 0xcb, 0x09, 0xb1, 0xa3, 0x13, 0xef, 0xde, 0x82,
+	0x32, 0x76, 0xc8, 0x01, 0x81, 0x39, 0xd7, 0x8a, 0x2a, 0x9a, 0xe7, 0xbc, 0x1e, 0xbc, 0x97, 0xf1,
+	0x1b, 0x73, 0xb1, 0xa4, 0x56, 0xd9, 0x83, 0x7a, 0x22, 0xac, 0x91, 0x9c, 0x43, 0x48, 0x88, 0xf9,
+	0x95, 0x9a, 0x5a, 0xe1, 0x01, 0x98, 0x9c, 0x13, 0x2a, 0xdc, 0xe1, 0x31, 0xb7, 0xc1, 0x9c, 0x60,
+	0x04, 0x53, 0x7e, 0x1f, 0xaa, 0xb1, 0x1b, 0xd5, 0xbf, 0xac, 0xdf, 0x7b, 0xac, 0x24, 0x68, 0xeb,
+	0x74, 0x9b, 0x73, 0x82, 0x3c, 0x8b, 0xdf, 0x02, 0x7c, 0x40, 0xa1, 0x8a, 0x01, 0x30, 0x19, 0x39,
+	0xee, 0xb1, 0xa5, 0x69, 0x8f, 0x7d, 0x0d, 0x64, 0x13, 0xdb, 0xe4, 0xda, 0x0e, 0xf6, 0x45, 0x84,
+	0x88, 0x00, 0x89, 0x34, 0x24, 0x9f, 0x7c, 0xf3, 0xf8, 0x5f, 0x12, 0x54, 0xb6, 0x5d, 0x83, 0xed,
+	0xa7, 0xb7, 0x13, 0x17, 0x34, 0x56, 0x45, 0x02, 0x99, 0xce, 0x1a, 0x6f, 0x02, 0xfb, 0x35, 0x15,
+	0x8c, 0xf8, 0x64, 0xa9, 0x48, 0x37, 0xc1, 0x12, 0xaf, 0x12, 0xb7, 0x77, 0x51, 0x7c, 0xad, 0xc5,
+	0x0c, 0x9e, 0xfe, 0x3b, 0x60, 0xb9, 0x9b, 0xa9, 0x79, 0x7a, 0x38, 0x62, 0x57, 0xd5, 0x65, 0xb5,
+	0xc6, 0x81, 0x87, 0x04, 0x46, 0x88, 0x44, 0x76, 0xcf, 0x88, 0x8a, 0x8c, 0x88, 0x03, 0x19, 0x51,
+	0x32, 0x1d, 0x2b, 0xa5, 0xd2, 0xb1, 0x5b, 0x3f, 0x97, 0x40, 0x8e, 0x2e, 0x9c, 0xa0, 0x0a, 0x14,
+	0xf6, 0x1f, 0xef, 0xed, 0x35, 0x2f, 0xa1, 0x2a, 0x94, 0xb7, 0x0e, 0x0e, 0xf6, 0x7a, 0x9d, 0xfd,
+	0xa6, 0x44, 0x1a, 0xbb, 0xfb, 0x83, 0xde, 0xc3, 0x9e, 0xda, 0xcc, 0x11, 0x9a, 0xbd, 0x83, 0xfd,
+	0x87, 0xcd, 0x3c, 0x02, 0x28, 0x6d, 0x1f, 0x3c, 0xde, 0xda, 0xeb, 0x35, 0x0b, 0xe4, 0xbb, 0x3f,
+	0x50, 0x77, 0xf7, 0x1f, 0x36, 0x8b, 0x48, 0x86, 0xe2, 0xd6, 0x27, 0x83, 0x5e, 0xbf, 0x59, 0x22,
+	0xc4, 0xdb, 0x9d, 0x41, 0xaf, 0x59, 0x46, 0xfc, 0xd2, 0xa2, 0x76, 0xb0, 0xf5, 0x71, 0xaf, 0x3b,
+	0x68, 0x56, 0xd0, 0x0a, 0xbb, 0x32, 0xa7, 0x75, 0x54, 0xb5, 0xf3, 0x49, 0x53, 0x26, 0xa4, 0x83,
+	0xde, 0x0f, 0x06, 0x4d, 0x40, 0x75, 0x90, 0xd5, 0xdd, 0xee, 0x8e, 0x46, 0x9b, 0x55, 0xd2, 0x93,
+	0xcf, 0xae, 0x75, 0xf7, 0x07, 0xcd, 0x1a, 0xaa, 0x41, 0x85, 0x70, 0x40, 0x5b, 0x75, 0x32, 0x0e,
+	0xe3, 0x82, 0xb6, 0x57, 0xe8, 0x38, 0x6a, 0xaf, 0xd7, 0x6c, 0xdc, 0xfa, 0x03, 0x09, 0x6a, 0x71,
+	0x5d, 0xa1, 0xcb, 0xb0, 0xba, 0x7d, 0xd0, 0x7d, 0xfc, 0xa8, 0xb7, 0x3f, 0xe8, 0x6b, 0xdd, 0x9d,
+	0xce, 0xfe, 0xc3, 0xde, 0x76, 0xf3, 0x52, 0x12, 0xfc, 0xb4, 0x33, 0xe8, 0xee, 0xf4, 0xb6, 0x9b,
+	0x12, 0xba, 0x0a, 0x6b, 0x13, 0xf0, 0xe3, 0x7d, 0x81, 0xc8, 0xa1, 0x75, 0x68, 0x1e, 0xaa, 0xbd,
+	0x7e, 0x6f, 0xbf, 0xdb, 0x8b, 0x46, 0xc9, 0xa3, 0x35, 0x68, 0xf4, 0x1f, 0x6f, 0x91, 0xa9, 0x35,
+	0xb5, 0xf7, 0xe8, 0xe0, 0x49, 0x6f, 0xbb, 0x59, 0xb8, 0xf5, 0x23, 0x09, 0xae, 0xce, 0x38, 0x6f,
+	0xc4, 0xa7, 0xd5, 0x3a, 0x83, 0x41, 0xa7, 0xbb, 0x93, 0xe6, 0x46, 0xdb, 0xee, 0x71, 0xb0, 0x84,
+	0x14, 0xb8, 0x1e, 0x81, 0x0f, 0x9e, 0xee, 0xf7, 0xd4, 0xfe, 0xce, 0xee, 0xa1, 0x36, 0x50, 0x3b,
+	0xfb, 0xfd, 0x07, 0x3d, 0x55, 0xa5, 0x8c, 0xbd, 0x0e, 0xaf, 0x4e, 0x75, 0xd5, 0xb6, 0x3e, 0xd1,
+	0xfa, 0x3d, 0xf5, 0x49, 0x4f, 0x6d, 0xe6, 0xb7, 0x9a, 0xff, 0xf4, 0xc5, 0x75, 0xe9, 0x9f, 0xbf,
+	0xb8, 0x2e, 0xfd, 0xfb, 0x17, 0xd7, 0xa5, 0xbf, 0xf8, 0x8f, 0xeb, 0x97, 0x8e, 0x4a, 0xd4, 0x7d,
+	0xbc, 0xff, 0xff, 0x03, 0x00, 0x43, 0x46, 0x7d, 0x9e, 0x49, 0x3e, 0x00, 0x00,
+}
+
+func (m *ChangePack) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4527,12 +5390,12 @@ func (m *JSONElement_JSONObject) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JSONElement_JSONObject) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChangePack) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement_JSONObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChangePack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4541,9 +5404,14 @@ func (m *JSONElement_JSONObject) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RemovedAt != nil {
+	if m.DeferredChanges != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.DeferredChanges))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MinSyncedTicket != nil {
 		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MinSyncedTicket.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4551,23 +5419,32 @@ func (m *JSONElement_JSONObject) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x2a
 	}
-	if m.MovedAt != nil {
-		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x22
 		}
+	}
+	if len(m.Snapshot) > 0 {
+		i -= len(m.Snapshot)
+		copy(dAtA[i:], m.Snapshot)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Snapshot)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.CreatedAt != nil {
+	if m.Checkpoint != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4577,24 +5454,17 @@ func (m *JSONElement_JSONObject) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintResources(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_JSONArray) Marshal() (dAtA []byte, err error) {
+func (m *Change) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4604,12 +5474,12 @@ func (m *JSONElement_JSONArray) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JSONElement_JSONArray) MarshalTo(dAtA []byte) (int, error) {
+func (m *Change) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement_JSONArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Change) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4618,33 +5488,30 @@ func (m *JSONElement_JSONArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RemovedAt != nil {
-		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	if m.MovedAt != nil {
-		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Message)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if m.CreatedAt != nil {
+	if m.Id != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4652,26 +5519,12 @@ func (m *JSONElement_JSONArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintResources(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_Primitive) Marshal() (dAtA []byte, err error) {
+func (m *ChangeID) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4681,12 +5534,12 @@ func (m *JSONElement_Primitive) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JSONElement_Primitive) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChangeID) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement_Primitive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChangeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4695,58 +5548,32 @@ func (m *JSONElement_Primitive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RemovedAt != nil {
-		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.MovedAt != nil {
-		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.ActorId)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.CreatedAt != nil {
-		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
+	if m.Lamport != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Lamport))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
+	if m.ServerSeq != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.ServerSeq))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.Type != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Type))
+	if m.ClientSeq != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.ClientSeq))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_Text) Marshal() (dAtA []byte, err error) {
+func (m *Operation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4756,12 +5583,12 @@ func (m *JSONElement_Text) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JSONElement_Text) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4770,33 +5597,40 @@ func (m *JSONElement_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RemovedAt != nil {
-		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
-		}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Id)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x72
 	}
-	if m.MovedAt != nil {
+	if m.Body != nil {
 		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+			size := m.Body.Size()
+			i -= size
+			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
+	}
+	if m.WallTime != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.WallTime))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x60
 	}
-	if m.CreatedAt != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *Operation_Set_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Set_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Set != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Set.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4804,52 +5638,20 @@ func (m *JSONElement_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintResources(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *JSONElement_RichText) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *JSONElement_RichText) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Add_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement_RichText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Add_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.RemovedAt != nil {
+	if m.Add != nil {
 		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Add.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4857,11 +5659,20 @@ func (m *JSONElement_RichText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x12
 	}
-	if m.MovedAt != nil {
-		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Move_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Move_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Move != nil {
+		{
+			size, err := m.Move.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4871,9 +5682,18 @@ func (m *JSONElement_RichText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.CreatedAt != nil {
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Remove_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Remove_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Remove != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Remove.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4881,52 +5701,20 @@ func (m *JSONElement_RichText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintResources(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0x22
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *JSONElement_Counter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *JSONElement_Counter) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Edit_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement_Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Edit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.RemovedAt != nil {
+	if m.Edit != nil {
 		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Edit.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4936,9 +5724,18 @@ func (m *JSONElement_Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	if m.MovedAt != nil {
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Select_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Select_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Select != nil {
 		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Select.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4946,11 +5743,20 @@ func (m *JSONElement_Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x32
 	}
-	if m.CreatedAt != nil {
+	return len(dAtA) - i, nil
+}
+func (m *Operation_RichEdit_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_RichEdit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RichEdit != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RichEdit.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4958,50 +5764,41 @@ func (m *JSONElement_Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Type != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x3a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *RHTNode) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Style_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RHTNode) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Style_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Style != nil {
+		{
+			size, err := m.Style.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Increase_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RHTNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Increase_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Element != nil {
+	if m.Increase != nil {
 		{
-			size, err := m.Element.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Increase.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5009,45 +5806,41 @@ func (m *RHTNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x4a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *RGANode) Marshal() (dAtA []byte, err error) {
+func (m *Operation_TreeEdit_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RGANode) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_TreeEdit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TreeEdit != nil {
+		{
+			size, err := m.TreeEdit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Operation_TreeStyle_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RGANode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_TreeStyle_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Element != nil {
+	if m.TreeStyle != nil {
 		{
-			size, err := m.Element.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.TreeStyle.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5055,11 +5848,20 @@ func (m *RGANode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x5a
 	}
-	if m.Next != nil {
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Clear_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Clear_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Clear != nil {
 		{
-			size, err := m.Next.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Clear.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5067,12 +5869,11 @@ func (m *RGANode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x6a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *TextNode) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Set) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5082,12 +5883,12 @@ func (m *TextNode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TextNode) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Set) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Set) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5096,9 +5897,14 @@ func (m *TextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.InsPrevId != nil {
+	if m.Generation != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Generation))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExecutedAt != nil {
 		{
-			size, err := m.InsPrevId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5108,9 +5914,9 @@ func (m *TextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.RemovedAt != nil {
+	if m.Value != nil {
 		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5120,16 +5926,16 @@ func (m *TextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Key)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Id != nil {
+	if m.ParentCreatedAt != nil {
 		{
-			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5142,7 +5948,7 @@ func (m *TextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RichTextNodeAttr) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Add) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5152,12 +5958,12 @@ func (m *RichTextNodeAttr) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RichTextNodeAttr) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Add) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RichTextNodeAttr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Add) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5166,9 +5972,21 @@ func (m *RichTextNodeAttr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UpdatedAt != nil {
+	if m.ExecutedAt != nil {
 		{
-			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5178,24 +5996,34 @@ func (m *RichTextNodeAttr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
+	if m.PrevCreatedAt != nil {
+		{
+			size, err := m.PrevCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Key)))
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RichTextNode) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Move) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5205,12 +6033,12 @@ func (m *RichTextNode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RichTextNode) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Move) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RichTextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Move) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5219,9 +6047,9 @@ func (m *RichTextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.InsPrevId != nil {
+	if m.ExecutedAt != nil {
 		{
-			size, err := m.InsPrevId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5229,11 +6057,11 @@ func (m *RichTextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x22
 	}
-	if m.RemovedAt != nil {
+	if m.CreatedAt != nil {
 		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5241,44 +6069,23 @@ func (m *RichTextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
-		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Attributes) > 0 {
-		for k := range m.Attributes {
-			v := m.Attributes[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintResources(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
+	if m.PrevCreatedAt != nil {
+		{
+			size, err := m.PrevCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintResources(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Id != nil {
+	if m.ParentCreatedAt != nil {
 		{
-			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5291,7 +6098,7 @@ func (m *RichTextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TextNodeID) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Remove) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5301,12 +6108,12 @@ func (m *TextNodeID) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TextNodeID) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Remove) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TextNodeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5315,10 +6122,17 @@ func (m *TextNodeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Offset != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Offset))
+	if m.ExecutedAt != nil {
+		{
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
 	if m.CreatedAt != nil {
 		{
@@ -5330,12 +6144,24 @@ func (m *TextNodeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x12
+	}
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Project) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Edit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5345,12 +6171,12 @@ func (m *Project) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Project) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Edit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Edit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5359,9 +6185,9 @@ func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UpdatedAt != nil {
+	if m.ExecutedAt != nil {
 		{
-			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5369,11 +6195,44 @@ func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x32
 	}
-	if m.CreatedAt != nil {
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k := range m.CreatedAtMapByActor {
+			v := m.CreatedAtMapByActor[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintResources(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.To != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5381,56 +6240,36 @@ func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.AuthWebhookMethods) > 0 {
-		for iNdEx := len(m.AuthWebhookMethods) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AuthWebhookMethods[iNdEx])
-			copy(dAtA[i:], m.AuthWebhookMethods[iNdEx])
-			i = encodeVarintResources(dAtA, i, uint64(len(m.AuthWebhookMethods[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.AuthWebhookUrl) > 0 {
-		i -= len(m.AuthWebhookUrl)
-		copy(dAtA[i:], m.AuthWebhookUrl)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.AuthWebhookUrl)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SecretKey) > 0 {
-		i -= len(m.SecretKey)
-		copy(dAtA[i:], m.SecretKey)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.SecretKey)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.PublicKey)))
-		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Name)))
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Id)))
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdatableProjectFields) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Select) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5440,12 +6279,12 @@ func (m *UpdatableProjectFields) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdatableProjectFields) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Select) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdatableProjectFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Select) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5454,9 +6293,21 @@ func (m *UpdatableProjectFields) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.AuthWebhookMethods != nil {
+	if m.ExecutedAt != nil {
 		{
-			size, err := m.AuthWebhookMethods.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5466,9 +6317,9 @@ func (m *UpdatableProjectFields) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.AuthWebhookUrl != nil {
+	if m.From != nil {
 		{
-			size, err := m.AuthWebhookUrl.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5478,9 +6329,9 @@ func (m *UpdatableProjectFields) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Name != nil {
+	if m.ParentCreatedAt != nil {
 		{
-			size, err := m.Name.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5493,7 +6344,7 @@ func (m *UpdatableProjectFields) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdatableProjectFields_AuthWebhookMethods) Marshal() (dAtA []byte, err error) {
+func (m *Operation_RichEdit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5503,12 +6354,12 @@ func (m *UpdatableProjectFields_AuthWebhookMethods) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *UpdatableProjectFields_AuthWebhookMethods) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_RichEdit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdatableProjectFields_AuthWebhookMethods) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_RichEdit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5517,45 +6368,73 @@ func (m *UpdatableProjectFields_AuthWebhookMethods) MarshalToSizedBuffer(dAtA []
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Methods) > 0 {
-		for iNdEx := len(m.Methods) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Methods[iNdEx])
-			copy(dAtA[i:], m.Methods[iNdEx])
-			i = encodeVarintResources(dAtA, i, uint64(len(m.Methods[iNdEx])))
+	if m.ExecutedAt != nil {
+		{
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintResources(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
 			i--
 			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DocumentSummary) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DocumentSummary) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DocumentSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k := range m.CreatedAtMapByActor {
+			v := m.CreatedAtMapByActor[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintResources(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.UpdatedAt != nil {
+	if m.To != nil {
 		{
-			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5563,11 +6442,11 @@ func (m *DocumentSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x1a
 	}
-	if m.AccessedAt != nil {
+	if m.From != nil {
 		{
-			size, err := m.AccessedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5575,11 +6454,11 @@ func (m *DocumentSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x12
 	}
-	if m.CreatedAt != nil {
+	if m.ParentCreatedAt != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5587,33 +6466,12 @@ func (m *DocumentSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Snapshot) > 0 {
-		i -= len(m.Snapshot)
-		copy(dAtA[i:], m.Snapshot)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Snapshot)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Id)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Presence) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Style) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5623,12 +6481,12 @@ func (m *Presence) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Presence) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Style) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Presence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Style) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5637,9 +6495,21 @@ func (m *Presence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Data) > 0 {
-		for k := range m.Data {
-			v := m.Data[k]
+	if m.ExecutedAt != nil {
+		{
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
 			baseI := i
 			i -= len(v)
 			copy(dAtA[i:], v)
@@ -5653,18 +6523,49 @@ func (m *Presence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xa
 			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
 		}
 	}
-	if m.Clock != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Clock))
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1a
+	}
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Client) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Increase) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5674,12 +6575,12 @@ func (m *Client) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Client) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Increase) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Client) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Increase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5688,9 +6589,21 @@ func (m *Client) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Presence != nil {
+	if m.ExecutedAt != nil {
 		{
-			size, err := m.Presence.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5700,17 +6613,22 @@ func (m *Client) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.Id)))
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Clients) Marshal() (dAtA []byte, err error) {
+func (m *Operation_TreeEdit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5720,12 +6638,12 @@ func (m *Clients) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Clients) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_TreeEdit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Clients) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_TreeEdit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5734,10 +6652,22 @@ func (m *Clients) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Clients) > 0 {
-		for iNdEx := len(m.Clients) - 1; iNdEx >= 0; iNdEx-- {
+	if m.ExecutedAt != nil {
+		{
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Contents) > 0 {
+		for iNdEx := len(m.Contents) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Clients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Contents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5745,50 +6675,75 @@ func (m *Clients) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintResources(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x2a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Checkpoint) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k := range m.CreatedAtMapByActor {
+			v := m.CreatedAtMapByActor[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintResources(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.ClientSeq != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.ClientSeq))
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
-	if m.ServerSeq != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.ServerSeq))
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TextNodePos) Marshal() (dAtA []byte, err error) {
+func (m *Operation_TreeStyle) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5798,12 +6753,12 @@ func (m *TextNodePos) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TextNodePos) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_TreeStyle) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TextNodePos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_TreeStyle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5812,19 +6767,90 @@ func (m *TextNodePos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RelativeOffset != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.RelativeOffset))
+	if m.ExecutedAt != nil {
+		{
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x32
 	}
-	if m.Offset != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Offset))
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintResources(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k := range m.CreatedAtMapByActor {
+			v := m.CreatedAtMapByActor[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintResources(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
-	if m.CreatedAt != nil {
+	if m.From != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5837,7 +6863,7 @@ func (m *TextNodePos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TimeTicket) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Clear) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5847,12 +6873,12 @@ func (m *TimeTicket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TimeTicket) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Clear) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TimeTicket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Clear) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5861,27 +6887,34 @@ func (m *TimeTicket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintResources(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Delimiter != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Delimiter))
+	if m.ExecutedAt != nil {
+		{
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.Lamport != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Lamport))
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DocEvent) Marshal() (dAtA []byte, err error) {
+func (m *JSONElementSimple) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5891,12 +6924,12 @@ func (m *DocEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DocEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *JSONElementSimple) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DocEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JSONElementSimple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5905,18 +6938,33 @@ func (m *DocEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DocumentKeys) > 0 {
-		for iNdEx := len(m.DocumentKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DocumentKeys[iNdEx])
-			copy(dAtA[i:], m.DocumentKeys[iNdEx])
-			i = encodeVarintResources(dAtA, i, uint64(len(m.DocumentKeys[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Type != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Publisher != nil {
+	if m.MovedAt != nil {
 		{
-			size, err := m.Publisher.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5926,1258 +6974,11432 @@ func (m *DocEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Type != 0 {
-		i = encodeVarintResources(dAtA, i, uint64(m.Type))
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintResources(dAtA []byte, offset int, v uint64) int {
-	offset -= sovResources(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Snapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ChangePack) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DocumentKey)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.Checkpoint != nil {
-		l = m.Checkpoint.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	l = len(m.Snapshot)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if len(m.Changes) > 0 {
-		for _, e := range m.Changes {
-			l = e.Size()
-			n += 1 + l + sovResources(uint64(l))
-		}
-	}
-	if m.MinSyncedTicket != nil {
-		l = m.MinSyncedTicket.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *Snapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Change) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Snapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if m.Version != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Operations) > 0 {
-		for _, e := range m.Operations {
-			l = e.Size()
-			n += 1 + l + sovResources(uint64(l))
+	if m.Root != nil {
+		{
+			size, err := m.Root.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ChangeID) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ClientSeq != 0 {
-		n += 1 + sovResources(uint64(m.ClientSeq))
-	}
-	if m.ServerSeq != 0 {
-		n += 1 + sovResources(uint64(m.ServerSeq))
-	}
-	if m.Lamport != 0 {
-		n += 1 + sovResources(uint64(m.Lamport))
-	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *JSONElement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Operation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *JSONElement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Body != nil {
-		n += m.Body.Size()
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Body != nil {
+		{
+			size := m.Body.Size()
+			i -= size
+			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Set_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Set != nil {
-		l = m.Set.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	return n
+func (m *JSONElement_JsonObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Operation_Add_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Add != nil {
-		l = m.Add.Size()
-		n += 1 + l + sovResources(uint64(l))
+
+func (m *JSONElement_JsonObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JsonObject != nil {
+		{
+			size, err := m.JsonObject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Operation_Move_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Move != nil {
-		l = m.Move.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	return n
+func (m *JSONElement_JsonArray) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Operation_Remove_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Remove != nil {
-		l = m.Remove.Size()
-		n += 1 + l + sovResources(uint64(l))
+
+func (m *JSONElement_JsonArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JsonArray != nil {
+		{
+			size, err := m.JsonArray.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Operation_Edit_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Edit != nil {
-		l = m.Edit.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	return n
+func (m *JSONElement_Primitive_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Operation_Select_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Select != nil {
-		l = m.Select.Size()
-		n += 1 + l + sovResources(uint64(l))
+
+func (m *JSONElement_Primitive_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Primitive != nil {
+		{
+			size, err := m.Primitive.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Operation_RichEdit_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RichEdit != nil {
-		l = m.RichEdit.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	return n
+func (m *JSONElement_Text_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Operation_Style_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Style != nil {
-		l = m.Style.Size()
-		n += 1 + l + sovResources(uint64(l))
+
+func (m *JSONElement_Text_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Text != nil {
+		{
+			size, err := m.Text.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Operation_Increase_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Increase != nil {
-		l = m.Increase.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	return n
+func (m *JSONElement_RichText_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Operation_Set) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func (m *JSONElement_RichText_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RichText != nil {
+		{
+			size, err := m.RichText.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *JSONElement_Counter_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_Add) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.PrevCreatedAt != nil {
-		l = m.PrevCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *JSONElement_Counter_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Counter != nil {
+		{
+			size, err := m.Counter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *JSONElement_Tree_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_Move) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.PrevCreatedAt != nil {
-		l = m.PrevCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+func (m *JSONElement_Tree_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Tree != nil {
+		{
+			size, err := m.Tree.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return len(dAtA) - i, nil
+}
+func (m *JSONElement_JSONObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Operation_Remove) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *JSONElement_JSONObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_Edit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *JSONElement_JSONObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.ClearedAt != nil {
+		{
+			size, err := m.ClearedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.CreatedAtMapByActor) > 0 {
-		for k, v := range m.CreatedAtMapByActor {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovResources(uint64(l))
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Content)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if m.MovedAt != nil {
+		{
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Select) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JSONElement_JSONArray) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JSONElement_JSONArray) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_JSONArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.ClearedAt != nil {
+		{
+			size, err := m.ClearedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.MovedAt != nil {
+		{
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Operation_RichEdit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JSONElement_Primitive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JSONElement_Primitive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_Primitive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.CreatedAtMapByActor) > 0 {
-		for k, v := range m.CreatedAtMapByActor {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovResources(uint64(l))
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Content)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if m.MovedAt != nil {
+		{
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Attributes) > 0 {
-		for k, v := range m.Attributes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
-			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Type != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Style) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovResources(uint64(l))
+func (m *JSONElement_Text) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if len(m.Attributes) > 0 {
-		for k, v := range m.Attributes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
-			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
-		}
-	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Operation_Increase) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *JSONElement_Text) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElementSimple) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *JSONElement_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.MovedAt != nil {
-		l = m.MovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Type != 0 {
-		n += 1 + sovResources(uint64(m.Type))
+	if m.MovedAt != nil {
+		{
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Body != nil {
-		n += m.Body.Size()
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *JSONElement_RichText) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *JSONElement_JsonObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.JsonObject != nil {
-		l = m.JsonObject.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	return n
+func (m *JSONElement_RichText) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *JSONElement_JsonArray) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *JSONElement_RichText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.JsonArray != nil {
-		l = m.JsonArray.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-func (m *JSONElement_Primitive_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	if m.Primitive != nil {
-		l = m.Primitive.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.MovedAt != nil {
+		{
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-func (m *JSONElement_Text_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.Text != nil {
-		l = m.Text.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *JSONElement_RichText_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *JSONElement_Counter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JSONElement_Counter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RichText != nil {
-		l = m.RichText.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	return n
-}
-func (m *JSONElement_Counter_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	var l int
-	_ = l
-	if m.Counter != nil {
-		l = m.Counter.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.IncreasedAt != nil {
+		{
+			size, err := m.IncreasedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
-}
-func (m *JSONElement_JSONObject) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.Size()
-			n += 1 + l + sovResources(uint64(l))
+	if m.MovedAt != nil {
+		{
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.MovedAt != nil {
-		l = m.MovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Type != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_JSONArray) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JSONElement_Tree) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JSONElement_Tree) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_Tree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.Size()
-			n += 1 + l + sovResources(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.MovedAt != nil {
-		l = m.MovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+		{
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_Primitive) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RHTNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RHTNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RHTNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovResources(uint64(m.Type))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MovedAt != nil {
-		l = m.MovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.Generation != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Generation))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.Element != nil {
+		{
+			size, err := m.Element.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_Text) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RGANode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RGANode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RGANode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.Size()
-			n += 1 + l + sovResources(uint64(l))
-		}
-	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.MovedAt != nil {
-		l = m.MovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.Element != nil {
+		{
+			size, err := m.Element.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Next != nil {
+		{
+			size, err := m.Next.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_RichText) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TextNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TextNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.Size()
-			n += 1 + l + sovResources(uint64(l))
-		}
-	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MovedAt != nil {
-		l = m.MovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.InsPrevId != nil {
+		{
+			size, err := m.InsPrevId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_Counter) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RichTextNodeAttr) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RichTextNodeAttr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RichTextNodeAttr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovResources(uint64(m.Type))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MovedAt != nil {
-		l = m.MovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.UpdatedAt != nil {
+		{
+			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RHTNode) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.Element != nil {
-		l = m.Element.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RichTextNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RGANode) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Next != nil {
-		l = m.Next.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.Element != nil {
-		l = m.Element.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *RichTextNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TextNode) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RichTextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.InsPrevId != nil {
-		l = m.InsPrevId.Size()
-		n += 1 + l + sovResources(uint64(l))
+		{
+			size, err := m.InsPrevId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintResources(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RichTextNodeAttr) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TextNodeID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TextNodeID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TextNodeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UpdatedAt != nil {
-		l = m.UpdatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.Offset != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RichTextNode) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TreeNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TreeNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreeNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Attributes) > 0 {
-		for k, v := range m.Attributes {
-			_ = k
-			_ = v
-			l = 0
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
 			if v != nil {
-				l = v.Size()
-				l += 1 + sovResources(uint64(l))
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintResources(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
 			}
-			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if m.Depth != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.InsPrevId != nil {
-		l = m.InsPrevId.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TextNodeID) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.Offset != 0 {
-		n += 1 + sovResources(uint64(m.Offset))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *TreePos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Project) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TreePos) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreePos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
-	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
-	}
-	l = len(m.SecretKey)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
-	}
-	l = len(m.AuthWebhookUrl)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if len(m.AuthWebhookMethods) > 0 {
-		for _, s := range m.AuthWebhookMethods {
-			l = len(s)
-			n += 1 + l + sovResources(uint64(l))
+	if m.LeftSiblingCreatedAt != nil {
+		{
+			size, err := m.LeftSiblingCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.UpdatedAt != nil {
-		l = m.UpdatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdatableProjectFields) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = m.Name.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.AuthWebhookUrl != nil {
-		l = m.AuthWebhookUrl.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.AuthWebhookMethods != nil {
-		l = m.AuthWebhookMethods.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Project) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UpdatableProjectFields_AuthWebhookMethods) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Methods) > 0 {
-		for _, s := range m.Methods {
-			l = len(s)
-			n += 1 + l + sovResources(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *Project) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DocumentSummary) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.RemovedDocumentPolicy) > 0 {
+		i -= len(m.RemovedDocumentPolicy)
+		copy(dAtA[i:], m.RemovedDocumentPolicy)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.RemovedDocumentPolicy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
 	}
-	l = len(m.Snapshot)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if m.MaxKeysPerObject != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.MaxKeysPerObject))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
 	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.SnapshotPolicy != nil {
+		{
+			size, err := m.SnapshotPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	if m.AccessedAt != nil {
-		l = m.AccessedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.AllowedOperations) > 0 {
+		for iNdEx := len(m.AllowedOperations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedOperations[iNdEx])
+			copy(dAtA[i:], m.AllowedOperations[iNdEx])
+			i = encodeVarintResources(dAtA, i, uint64(len(m.AllowedOperations[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
 	}
-	if m.UpdatedAt != nil {
-		l = m.UpdatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.ActorIdPolicy) > 0 {
+		i -= len(m.ActorIdPolicy)
+		copy(dAtA[i:], m.ActorIdPolicy)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.ActorIdPolicy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ExplicitDocumentCreation {
+		i--
+		if m.ExplicitDocumentCreation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	return n
-}
-
-func (m *Presence) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.DocumentTemplates) > 0 {
+		for k := range m.DocumentTemplates {
+			v := m.DocumentTemplates[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintResources(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
 	}
-	var l int
-	_ = l
-	if m.Clock != 0 {
-		n += 1 + sovResources(uint64(m.Clock))
+	if len(m.ArchiveAfter) > 0 {
+		i -= len(m.ArchiveAfter)
+		copy(dAtA[i:], m.ArchiveAfter)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.ArchiveAfter)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	if len(m.Data) > 0 {
-		for k, v := range m.Data {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
-			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+	if len(m.Features) > 0 {
+		for k := range m.Features {
+			v := m.Features[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ChangefeedUrl) > 0 {
+		i -= len(m.ChangefeedUrl)
+		copy(dAtA[i:], m.ChangefeedUrl)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.ChangefeedUrl)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	return n
-}
-
-func (m *Client) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.EphemeralKeyPrefix) > 0 {
+		i -= len(m.EphemeralKeyPrefix)
+		copy(dAtA[i:], m.EphemeralKeyPrefix)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.EphemeralKeyPrefix)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
+	if m.DocumentCount != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.DocumentCount))
+		i--
+		dAtA[i] = 0x70
 	}
-	if m.Presence != nil {
-		l = m.Presence.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if len(m.EventWebhookUrl) > 0 {
+		i -= len(m.EventWebhookUrl)
+		copy(dAtA[i:], m.EventWebhookUrl)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.EventWebhookUrl)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ObjectMergePolicy) > 0 {
+		i -= len(m.ObjectMergePolicy)
+		copy(dAtA[i:], m.ObjectMergePolicy)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.ObjectMergePolicy)))
+		i--
+		dAtA[i] = 0x62
 	}
-	return n
-}
-
-func (m *Clients) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.InitialContent) > 0 {
+		i -= len(m.InitialContent)
+		copy(dAtA[i:], m.InitialContent)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.InitialContent)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	var l int
-	_ = l
-	if len(m.Clients) > 0 {
-		for _, e := range m.Clients {
-			l = e.Size()
-			n += 1 + l + sovResources(uint64(l))
+	if m.CollectApplyLag {
+		i--
+		if m.CollectApplyLag {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *Checkpoint) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.DocumentKeyPolicy != nil {
+		{
+			size, err := m.DocumentKeyPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	var l int
-	_ = l
-	if m.ServerSeq != 0 {
-		n += 1 + sovResources(uint64(m.ServerSeq))
+	if m.UpdatedAt != nil {
+		{
+			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.ClientSeq != 0 {
-		n += 1 + sovResources(uint64(m.ClientSeq))
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.AuthWebhookMethods) > 0 {
+		for iNdEx := len(m.AuthWebhookMethods) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AuthWebhookMethods[iNdEx])
+			copy(dAtA[i:], m.AuthWebhookMethods[iNdEx])
+			i = encodeVarintResources(dAtA, i, uint64(len(m.AuthWebhookMethods[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AuthWebhookUrl) > 0 {
+		i -= len(m.AuthWebhookUrl)
+		copy(dAtA[i:], m.AuthWebhookUrl)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.AuthWebhookUrl)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SecretKey) > 0 {
+		i -= len(m.SecretKey)
+		copy(dAtA[i:], m.SecretKey)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.SecretKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DocumentKeyPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DocumentKeyPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocumentKeyPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.DisallowedPrefixes) > 0 {
+		for iNdEx := len(m.DisallowedPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisallowedPrefixes[iNdEx])
+			copy(dAtA[i:], m.DisallowedPrefixes[iNdEx])
+			i = encodeVarintResources(dAtA, i, uint64(len(m.DisallowedPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxLength != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.MaxLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AllowedCharset) > 0 {
+		i -= len(m.AllowedCharset)
+		copy(dAtA[i:], m.AllowedCharset)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.AllowedCharset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TextNodePos) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SnapshotPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovResources(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Offset != 0 {
-		n += 1 + sovResources(uint64(m.Offset))
+	if m.RetentionCount != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.RetentionCount))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.RelativeOffset != 0 {
-		n += 1 + sovResources(uint64(m.RelativeOffset))
+	if len(m.Compression) > 0 {
+		i -= len(m.Compression)
+		copy(dAtA[i:], m.Compression)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Compression)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdatableProjectFields) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatableProjectFields) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatableProjectFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RemovedDocumentPolicy != nil {
+		{
+			size, err := m.RemovedDocumentPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.MaxKeysPerObject != nil {
+		{
+			size, err := m.MaxKeysPerObject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.SnapshotPolicy != nil {
+		{
+			size, err := m.SnapshotPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.AllowedOperations != nil {
+		{
+			size, err := m.AllowedOperations.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.ActorIdPolicy != nil {
+		{
+			size, err := m.ActorIdPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.ExplicitDocumentCreation != nil {
+		{
+			size, err := m.ExplicitDocumentCreation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.DocumentTemplates != nil {
+		{
+			size, err := m.DocumentTemplates.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.ArchiveAfter != nil {
+		{
+			size, err := m.ArchiveAfter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Features != nil {
+		{
+			size, err := m.Features.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ChangefeedUrl != nil {
+		{
+			size, err := m.ChangefeedUrl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.EphemeralKeyPrefix != nil {
+		{
+			size, err := m.EphemeralKeyPrefix.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.EventWebhookUrl != nil {
+		{
+			size, err := m.EventWebhookUrl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ObjectMergePolicy != nil {
+		{
+			size, err := m.ObjectMergePolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.InitialContent != nil {
+		{
+			size, err := m.InitialContent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CollectApplyLag != nil {
+		{
+			size, err := m.CollectApplyLag.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DocumentKeyPolicy != nil {
+		{
+			size, err := m.DocumentKeyPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AuthWebhookMethods != nil {
+		{
+			size, err := m.AuthWebhookMethods.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AuthWebhookUrl != nil {
+		{
+			size, err := m.AuthWebhookUrl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Name != nil {
+		{
+			size, err := m.Name.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdatableProjectFields_AuthWebhookMethods) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatableProjectFields_AuthWebhookMethods) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatableProjectFields_AuthWebhookMethods) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Methods) > 0 {
+		for iNdEx := len(m.Methods) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Methods[iNdEx])
+			copy(dAtA[i:], m.Methods[iNdEx])
+			i = encodeVarintResources(dAtA, i, uint64(len(m.Methods[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdatableProjectFields_Features) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatableProjectFields_Features) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatableProjectFields_Features) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Features) > 0 {
+		for k := range m.Features {
+			v := m.Features[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdatableProjectFields_DocumentTemplates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatableProjectFields_DocumentTemplates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatableProjectFields_DocumentTemplates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Templates) > 0 {
+		for k := range m.Templates {
+			v := m.Templates[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintResources(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdatableProjectFields_AllowedOperations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatableProjectFields_AllowedOperations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatableProjectFields_AllowedOperations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Operations[iNdEx])
+			copy(dAtA[i:], m.Operations[iNdEx])
+			i = encodeVarintResources(dAtA, i, uint64(len(m.Operations[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DocumentSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DocumentSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocumentSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.OperationCounts) > 0 {
+		for k := range m.OperationCounts {
+			v := m.OperationCounts[k]
+			baseI := i
+			i = encodeVarintResources(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.ArchivedAt != nil {
+		{
+			size, err := m.ArchivedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintResources(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.UpdatedAt != nil {
+		{
+			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AccessedAt != nil {
+		{
+			size, err := m.AccessedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Snapshot) > 0 {
+		i -= len(m.Snapshot)
+		copy(dAtA[i:], m.Snapshot)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Snapshot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DocumentClientEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DocumentClientEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocumentClientEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Rejection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Rejection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Rejection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Dropped != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Dropped))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RejectedAt != nil {
+		{
+			size, err := m.RejectedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Changes != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Changes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DocumentEventLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DocumentEventLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocumentEventLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Fingerprint) > 0 {
+		i -= len(m.Fingerprint)
+		copy(dAtA[i:], m.Fingerprint)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Fingerprint)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.VersionVector != nil {
+		{
+			size, err := m.VersionVector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.OperationCount != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.OperationCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Lamport != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Lamport))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ClientSeq != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.ClientSeq))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ServerSeq != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Presence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Presence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Presence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		for k := range m.Data {
+			v := m.Data[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintResources(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintResources(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintResources(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Clock != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Clock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Client) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Client) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Client) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Presence != nil {
+		{
+			size, err := m.Presence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clients) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clients) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clients) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Clients) > 0 {
+		for iNdEx := len(m.Clients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Checkpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ClientSeq != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.ClientSeq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ServerSeq != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotMeta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.GarbageLen != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.GarbageLen))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Lamport != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Lamport))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ServerSeq != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BackgroundQueues) > 0 {
+		for iNdEx := len(m.BackgroundQueues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BackgroundQueues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.HotDocuments) > 0 {
+		for iNdEx := len(m.HotDocuments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HotDocuments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConflictWins) > 0 {
+		for iNdEx := len(m.ConflictWins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConflictWins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActorConflictWins) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActorConflictWins) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActorConflictWins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Wins != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Wins))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiveProjectStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiveProjectStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiveProjectStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ConflictWins) > 0 {
+		for iNdEx := len(m.ConflictWins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConflictWins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResources(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MeasuredAt != nil {
+		{
+			size, err := m.MeasuredAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.HotDocuments != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.HotDocuments))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OpsPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.OpsPerSecond))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.AttachedClients != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.AttachedClients))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ActiveDocuments != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.ActiveDocuments))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectStatsEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectStatsEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectStatsEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackgroundQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackgroundQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackgroundQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.OldestAgeSeconds != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.OldestAgeSeconds))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Depth != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HotDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HotDocument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HotDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DetectedAt != nil {
+		{
+			size, err := m.DetectedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TopActorOpsPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TopActorOpsPerSecond))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if len(m.TopActorId) > 0 {
+		i -= len(m.TopActorId)
+		copy(dAtA[i:], m.TopActorId)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.TopActorId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OpsPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.OpsPerSecond))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Maintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Maintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Maintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UpdatedAt != nil {
+		{
+			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VersionVector) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionVector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionVector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Lamports) > 0 {
+		dAtA153 := make([]byte, len(m.Lamports)*10)
+		var j152 int
+		for _, num := range m.Lamports {
+			for num >= 1<<7 {
+				dAtA153[j152] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j152++
+			}
+			dAtA153[j152] = uint8(num)
+			j152++
+		}
+		i -= j152
+		copy(dAtA[i:], dAtA153[:j152])
+		i = encodeVarintResources(dAtA, i, uint64(j152))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ActorIds) > 0 {
+		for iNdEx := len(m.ActorIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActorIds[iNdEx])
+			copy(dAtA[i:], m.ActorIds[iNdEx])
+			i = encodeVarintResources(dAtA, i, uint64(len(m.ActorIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TextNodePos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TextNodePos) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TextNodePos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RelativeOffset != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.RelativeOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Offset != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimeTicket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeTicket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeTicket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintResources(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Delimiter != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Delimiter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Lamport != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Lamport))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DocEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DocEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ServerSeq != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RemovedPaths) > 0 {
+		for iNdEx := len(m.RemovedPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedPaths[iNdEx])
+			copy(dAtA[i:], m.RemovedPaths[iNdEx])
+			i = encodeVarintResources(dAtA, i, uint64(len(m.RemovedPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ChangedPaths) > 0 {
+		for iNdEx := len(m.ChangedPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChangedPaths[iNdEx])
+			copy(dAtA[i:], m.ChangedPaths[iNdEx])
+			i = encodeVarintResources(dAtA, i, uint64(len(m.ChangedPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DocumentKeys) > 0 {
+		for iNdEx := len(m.DocumentKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DocumentKeys[iNdEx])
+			copy(dAtA[i:], m.DocumentKeys[iNdEx])
+			i = encodeVarintResources(dAtA, i, uint64(len(m.DocumentKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Publisher != nil {
+		{
+			size, err := m.Publisher.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResources(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintResources(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintResources(dAtA []byte, offset int, v uint64) int {
+	offset -= sovResources(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChangePack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Snapshot)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.MinSyncedTicket != nil {
+		l = m.MinSyncedTicket.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.DeferredChanges != 0 {
+		n += 1 + sovResources(uint64(m.DeferredChanges))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Change) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangeID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientSeq != 0 {
+		n += 1 + sovResources(uint64(m.ClientSeq))
+	}
+	if m.ServerSeq != 0 {
+		n += 1 + sovResources(uint64(m.ServerSeq))
+	}
+	if m.Lamport != 0 {
+		n += 1 + sovResources(uint64(m.Lamport))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Body != nil {
+		n += m.Body.Size()
+	}
+	if m.WallTime != 0 {
+		n += 1 + sovResources(uint64(m.WallTime))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Set_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Set != nil {
+		l = m.Set.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *Operation_Add_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Add != nil {
+		l = m.Add.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *Operation_Move_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Move != nil {
+		l = m.Move.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *Operation_Remove_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Remove != nil {
+		l = m.Remove.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *Operation_Edit_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Edit != nil {
+		l = m.Edit.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *Operation_Select_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Select != nil {
+		l = m.Select.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *Operation_RichEdit_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RichEdit != nil {
+		l = m.RichEdit.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *Operation_Style_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Style != nil {
+		l = m.Style.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *Operation_Increase_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Increase != nil {
+		l = m.Increase.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *Operation_TreeEdit_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TreeEdit != nil {
+		l = m.TreeEdit.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *Operation_TreeStyle_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TreeStyle != nil {
+		l = m.TreeStyle.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *Operation_Clear_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Clear != nil {
+		l = m.Clear.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *Operation_Set) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Generation != 0 {
+		n += 1 + sovResources(uint64(m.Generation))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Add) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.PrevCreatedAt != nil {
+		l = m.PrevCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Move) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.PrevCreatedAt != nil {
+		l = m.PrevCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Remove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Edit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k, v := range m.CreatedAtMapByActor {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovResources(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Select) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_RichEdit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k, v := range m.CreatedAtMapByActor {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovResources(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Style) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Increase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_TreeEdit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k, v := range m.CreatedAtMapByActor {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovResources(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Contents) > 0 {
+		for _, e := range m.Contents {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_TreeStyle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k, v := range m.CreatedAtMapByActor {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovResources(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Clear) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElementSimple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovResources(uint64(m.Type))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Snapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Root != nil {
+		l = m.Root.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovResources(uint64(m.Version))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Body != nil {
+		n += m.Body.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement_JsonObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JsonObject != nil {
+		l = m.JsonObject.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *JSONElement_JsonArray) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JsonArray != nil {
+		l = m.JsonArray.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *JSONElement_Primitive_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Primitive != nil {
+		l = m.Primitive.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *JSONElement_Text_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Text != nil {
+		l = m.Text.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *JSONElement_RichText_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RichText != nil {
+		l = m.RichText.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *JSONElement_Counter_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Counter != nil {
+		l = m.Counter.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *JSONElement_Tree_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tree != nil {
+		l = m.Tree.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	return n
+}
+func (m *JSONElement_JSONObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ClearedAt != nil {
+		l = m.ClearedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement_JSONArray) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ClearedAt != nil {
+		l = m.ClearedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement_Primitive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovResources(uint64(m.Type))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement_Text) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement_RichText) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement_Counter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovResources(uint64(m.Type))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.IncreasedAt != nil {
+		l = m.IncreasedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement_Tree) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RHTNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Element != nil {
+		l = m.Element.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Generation != 0 {
+		n += 1 + sovResources(uint64(m.Generation))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RGANode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Next != nil {
+		l = m.Next.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Element != nil {
+		l = m.Element.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TextNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.InsPrevId != nil {
+		l = m.InsPrevId.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RichTextNodeAttr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = m.UpdatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RichTextNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovResources(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.InsPrevId != nil {
+		l = m.InsPrevId.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TextNodeID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovResources(uint64(m.Offset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TreeNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovResources(uint64(m.Depth))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovResources(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TreePos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.LeftSiblingCreatedAt != nil {
+		l = m.LeftSiblingCreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Project) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.SecretKey)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.AuthWebhookUrl)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.AuthWebhookMethods) > 0 {
+		for _, s := range m.AuthWebhookMethods {
+			l = len(s)
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = m.UpdatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.DocumentKeyPolicy != nil {
+		l = m.DocumentKeyPolicy.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.CollectApplyLag {
+		n += 2
+	}
+	l = len(m.InitialContent)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.ObjectMergePolicy)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.EventWebhookUrl)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.DocumentCount != 0 {
+		n += 1 + sovResources(uint64(m.DocumentCount))
+	}
+	l = len(m.EphemeralKeyPrefix)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.ChangefeedUrl)
+	if l > 0 {
+		n += 2 + l + sovResources(uint64(l))
+	}
+	if len(m.Features) > 0 {
+		for k, v := range m.Features {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 2 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.ArchiveAfter)
+	if l > 0 {
+		n += 2 + l + sovResources(uint64(l))
+	}
+	if len(m.DocumentTemplates) > 0 {
+		for k, v := range m.DocumentTemplates {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
+			n += mapEntrySize + 2 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if m.ExplicitDocumentCreation {
+		n += 3
+	}
+	l = len(m.ActorIdPolicy)
+	if l > 0 {
+		n += 2 + l + sovResources(uint64(l))
+	}
+	if len(m.AllowedOperations) > 0 {
+		for _, s := range m.AllowedOperations {
+			l = len(s)
+			n += 2 + l + sovResources(uint64(l))
+		}
+	}
+	if m.SnapshotPolicy != nil {
+		l = m.SnapshotPolicy.Size()
+		n += 2 + l + sovResources(uint64(l))
+	}
+	if m.MaxKeysPerObject != 0 {
+		n += 2 + sovResources(uint64(m.MaxKeysPerObject))
+	}
+	l = len(m.RemovedDocumentPolicy)
+	if l > 0 {
+		n += 2 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DocumentKeyPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AllowedCharset)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.MaxLength != 0 {
+		n += 1 + sovResources(uint64(m.MaxLength))
+	}
+	if len(m.DisallowedPrefixes) > 0 {
+		for _, s := range m.DisallowedPrefixes {
+			l = len(s)
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SnapshotPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovResources(uint64(m.Threshold))
+	}
+	l = len(m.Compression)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.RetentionCount != 0 {
+		n += 1 + sovResources(uint64(m.RetentionCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdatableProjectFields) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = m.Name.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.AuthWebhookUrl != nil {
+		l = m.AuthWebhookUrl.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.AuthWebhookMethods != nil {
+		l = m.AuthWebhookMethods.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.DocumentKeyPolicy != nil {
+		l = m.DocumentKeyPolicy.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.CollectApplyLag != nil {
+		l = m.CollectApplyLag.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.InitialContent != nil {
+		l = m.InitialContent.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ObjectMergePolicy != nil {
+		l = m.ObjectMergePolicy.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.EventWebhookUrl != nil {
+		l = m.EventWebhookUrl.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.EphemeralKeyPrefix != nil {
+		l = m.EphemeralKeyPrefix.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ChangefeedUrl != nil {
+		l = m.ChangefeedUrl.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Features != nil {
+		l = m.Features.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ArchiveAfter != nil {
+		l = m.ArchiveAfter.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.DocumentTemplates != nil {
+		l = m.DocumentTemplates.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ExplicitDocumentCreation != nil {
+		l = m.ExplicitDocumentCreation.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ActorIdPolicy != nil {
+		l = m.ActorIdPolicy.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.AllowedOperations != nil {
+		l = m.AllowedOperations.Size()
+		n += 2 + l + sovResources(uint64(l))
+	}
+	if m.SnapshotPolicy != nil {
+		l = m.SnapshotPolicy.Size()
+		n += 2 + l + sovResources(uint64(l))
+	}
+	if m.MaxKeysPerObject != nil {
+		l = m.MaxKeysPerObject.Size()
+		n += 2 + l + sovResources(uint64(l))
+	}
+	if m.RemovedDocumentPolicy != nil {
+		l = m.RemovedDocumentPolicy.Size()
+		n += 2 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdatableProjectFields_AuthWebhookMethods) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Methods) > 0 {
+		for _, s := range m.Methods {
+			l = len(s)
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdatableProjectFields_Features) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Features) > 0 {
+		for k, v := range m.Features {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdatableProjectFields_DocumentTemplates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for k, v := range m.Templates {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdatableProjectFields_AllowedOperations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for _, s := range m.Operations {
+			l = len(s)
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DocumentSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Snapshot)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.AccessedAt != nil {
+		l = m.AccessedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = m.UpdatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if m.ArchivedAt != nil {
+		l = m.ArchivedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.OperationCounts) > 0 {
+		for k, v := range m.OperationCounts {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + sovResources(uint64(v))
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DocumentClientEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovResources(uint64(m.Type))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Rejection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Changes != 0 {
+		n += 1 + sovResources(uint64(m.Changes))
+	}
+	if m.RejectedAt != nil {
+		l = m.RejectedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Dropped != 0 {
+		n += 1 + sovResources(uint64(m.Dropped))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DocumentEventLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServerSeq != 0 {
+		n += 1 + sovResources(uint64(m.ServerSeq))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.ClientSeq != 0 {
+		n += 1 + sovResources(uint64(m.ClientSeq))
+	}
+	if m.Lamport != 0 {
+		n += 1 + sovResources(uint64(m.Lamport))
+	}
+	if m.OperationCount != 0 {
+		n += 1 + sovResources(uint64(m.OperationCount))
+	}
+	if m.VersionVector != nil {
+		l = m.VersionVector.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Presence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Clock != 0 {
+		n += 1 + sovResources(uint64(m.Clock))
+	}
+	if len(m.Data) > 0 {
+		for k, v := range m.Data {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovResources(uint64(len(k))) + 1 + len(v) + sovResources(uint64(len(v)))
+			n += mapEntrySize + 1 + sovResources(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Client) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Presence != nil {
+		l = m.Presence.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clients) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Clients) > 0 {
+		for _, e := range m.Clients {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Checkpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServerSeq != 0 {
+		n += 1 + sovResources(uint64(m.ServerSeq))
+	}
+	if m.ClientSeq != 0 {
+		n += 1 + sovResources(uint64(m.ClientSeq))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SnapshotMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServerSeq != 0 {
+		n += 1 + sovResources(uint64(m.ServerSeq))
+	}
+	if m.Lamport != 0 {
+		n += 1 + sovResources(uint64(m.Lamport))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.GarbageLen != 0 {
+		n += 1 + sovResources(uint64(m.GarbageLen))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProjectStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConflictWins) > 0 {
+		for _, e := range m.ConflictWins {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if len(m.HotDocuments) > 0 {
+		for _, e := range m.HotDocuments {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if len(m.BackgroundQueues) > 0 {
+		for _, e := range m.BackgroundQueues {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActorConflictWins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Wins != 0 {
+		n += 1 + sovResources(uint64(m.Wins))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LiveProjectStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActiveDocuments != 0 {
+		n += 1 + sovResources(uint64(m.ActiveDocuments))
+	}
+	if m.AttachedClients != 0 {
+		n += 1 + sovResources(uint64(m.AttachedClients))
+	}
+	if m.OpsPerSecond != 0 {
+		n += 9
+	}
+	if m.HotDocuments != 0 {
+		n += 1 + sovResources(uint64(m.HotDocuments))
+	}
+	if m.MeasuredAt != nil {
+		l = m.MeasuredAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.ConflictWins) > 0 {
+		for _, e := range m.ConflictWins {
+			l = e.Size()
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProjectStatsEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackgroundQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovResources(uint64(m.Depth))
+	}
+	if m.OldestAgeSeconds != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HotDocument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.OpsPerSecond != 0 {
+		n += 9
+	}
+	l = len(m.TopActorId)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.TopActorOpsPerSecond != 0 {
+		n += 9
+	}
+	if m.DetectedAt != nil {
+		l = m.DetectedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Maintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = m.UpdatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VersionVector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActorIds) > 0 {
+		for _, b := range m.ActorIds {
+			l = len(b)
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if len(m.Lamports) > 0 {
+		l = 0
+		for _, e := range m.Lamports {
+			l += sovResources(uint64(e))
+		}
+		n += 1 + sovResources(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TextNodePos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovResources(uint64(m.Offset))
+	}
+	if m.RelativeOffset != 0 {
+		n += 1 + sovResources(uint64(m.RelativeOffset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TimeTicket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lamport != 0 {
+		n += 1 + sovResources(uint64(m.Lamport))
+	}
+	if m.Delimiter != 0 {
+		n += 1 + sovResources(uint64(m.Delimiter))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DocEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovResources(uint64(m.Type))
+	}
+	if m.Publisher != nil {
+		l = m.Publisher.Size()
+		n += 1 + l + sovResources(uint64(l))
+	}
+	if len(m.DocumentKeys) > 0 {
+		for _, s := range m.DocumentKeys {
+			l = len(s)
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if len(m.ChangedPaths) > 0 {
+		for _, s := range m.ChangedPaths {
+			l = len(s)
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if len(m.RemovedPaths) > 0 {
+		for _, s := range m.RemovedPaths {
+			l = len(s)
+			n += 1 + l + sovResources(uint64(l))
+		}
+	}
+	if m.ServerSeq != 0 {
+		n += 1 + sovResources(uint64(m.ServerSeq))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovResources(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozResources(x uint64) (n int) {
+	return sovResources(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChangePack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangePack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangePack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Checkpoint == nil {
+				m.Checkpoint = &Checkpoint{}
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshot = append(m.Snapshot[:0], dAtA[iNdEx:postIndex]...)
+			if m.Snapshot == nil {
+				m.Snapshot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &Change{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSyncedTicket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinSyncedTicket == nil {
+				m.MinSyncedTicket = &TimeTicket{}
+			}
+			if err := m.MinSyncedTicket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferredChanges", wireType)
+			}
+			m.DeferredChanges = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeferredChanges |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Change) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Change: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Change: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &ChangeID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operations = append(m.Operations, &Operation{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSeq", wireType)
+			}
+			m.ClientSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientSeq |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
+			}
+			m.ServerSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lamport", wireType)
+			}
+			m.Lamport = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lamport |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId = append(m.ActorId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ActorId == nil {
+				m.ActorId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_Set{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_Set_{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_Add{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_Add_{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Move", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_Move{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_Move_{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_Remove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_Remove_{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_Edit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_Edit_{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Select", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_Select{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_Select_{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RichEdit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_RichEdit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_RichEdit_{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Style", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_Style{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_Style_{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Increase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_Increase{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_Increase_{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreeEdit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_TreeEdit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_TreeEdit_{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreeStyle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_TreeStyle{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_TreeStyle_{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WallTime", wireType)
+			}
+			m.WallTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WallTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clear", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Operation_Clear{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &Operation_Clear_{v}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_Set) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Set: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Set: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &JSONElementSimple{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+			}
+			m.Generation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Generation |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_Add) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Add: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Add: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrevCreatedAt == nil {
+				m.PrevCreatedAt = &TimeTicket{}
+			}
+			if err := m.PrevCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &JSONElementSimple{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_Move) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Move: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Move: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrevCreatedAt == nil {
+				m.PrevCreatedAt = &TimeTicket{}
+			}
+			if err := m.PrevCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_Remove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Remove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Remove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_Edit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Edit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Edit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &TextNodePos{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &TextNodePos{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtMapByActor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAtMapByActor == nil {
+				m.CreatedAtMapByActor = make(map[string]*TimeTicket)
+			}
+			var mapkey string
+			var mapvalue *TimeTicket
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthResources
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &TimeTicket{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CreatedAtMapByActor[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_Select) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Select: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Select: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &TextNodePos{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &TextNodePos{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_RichEdit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RichEdit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RichEdit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &TextNodePos{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &TextNodePos{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtMapByActor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAtMapByActor == nil {
+				m.CreatedAtMapByActor = make(map[string]*TimeTicket)
+			}
+			var mapkey string
+			var mapvalue *TimeTicket
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthResources
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &TimeTicket{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CreatedAtMapByActor[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Attributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_Style) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Style: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Style: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &TextNodePos{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &TextNodePos{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Attributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_Increase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Increase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Increase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &JSONElementSimple{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_TreeEdit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreeEdit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreeEdit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &TreePos{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &TreePos{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtMapByActor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAtMapByActor == nil {
+				m.CreatedAtMapByActor = make(map[string]*TimeTicket)
+			}
+			var mapkey string
+			var mapvalue *TimeTicket
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthResources
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &TimeTicket{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CreatedAtMapByActor[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contents = append(m.Contents, &TreeNode{})
+			if err := m.Contents[len(m.Contents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_TreeStyle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreeStyle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreeStyle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &TreePos{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &TreePos{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtMapByActor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAtMapByActor == nil {
+				m.CreatedAtMapByActor = make(map[string]*TimeTicket)
+			}
+			var mapkey string
+			var mapvalue *TimeTicket
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthResources
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &TimeTicket{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CreatedAtMapByActor[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Attributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation_Clear) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Clear: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Clear: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
+			}
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedAt == nil {
+				m.ExecutedAt = &TimeTicket{}
+			}
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JSONElementSimple: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JSONElementSimple: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MovedAt == nil {
+				m.MovedAt = &TimeTicket{}
+			}
+			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
+			}
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ValueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Snapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Snapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Snapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Root == nil {
+				m.Root = &JSONElement_JSONObject{}
+			}
+			if err := m.Root.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JSONElement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JSONElement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JSONElement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonObject", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JSONElement_JSONObject{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &JSONElement_JsonObject{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonArray", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JSONElement_JSONArray{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &JSONElement_JsonArray{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Primitive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JSONElement_Primitive{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &JSONElement_Primitive_{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JSONElement_Text{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &JSONElement_Text_{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RichText", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JSONElement_RichText{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &JSONElement_RichText_{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JSONElement_Counter{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &JSONElement_Counter_{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JSONElement_Tree{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &JSONElement_Tree_{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JSONObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JSONObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &RHTNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MovedAt == nil {
+				m.MovedAt = &TimeTicket{}
+			}
+			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
+			}
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClearedAt == nil {
+				m.ClearedAt = &TimeTicket{}
+			}
+			if err := m.ClearedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JSONElement_JSONArray) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JSONArray: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JSONArray: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &RGANode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MovedAt == nil {
+				m.MovedAt = &TimeTicket{}
+			}
+			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
+			}
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClearedAt == nil {
+				m.ClearedAt = &TimeTicket{}
+			}
+			if err := m.ClearedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Primitive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Primitive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ValueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MovedAt == nil {
+				m.MovedAt = &TimeTicket{}
+			}
+			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
+			}
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JSONElement_Text) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Text: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Text: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &TextNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MovedAt == nil {
+				m.MovedAt = &TimeTicket{}
+			}
+			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
+			}
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *TimeTicket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Lamport != 0 {
-		n += 1 + sovResources(uint64(m.Lamport))
-	}
-	if m.Delimiter != 0 {
-		n += 1 + sovResources(uint64(m.Delimiter))
-	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *DocEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovResources(uint64(m.Type))
-	}
-	if m.Publisher != nil {
-		l = m.Publisher.Size()
-		n += 1 + l + sovResources(uint64(l))
-	}
-	if len(m.DocumentKeys) > 0 {
-		for _, s := range m.DocumentKeys {
-			l = len(s)
-			n += 1 + l + sovResources(uint64(l))
+func (m *JSONElement_RichText) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RichText: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RichText: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &RichTextNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MovedAt == nil {
+				m.MovedAt = &TimeTicket{}
+			}
+			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
+			}
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func sovResources(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozResources(x uint64) (n int) {
-	return sovResources(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ChangePack) Unmarshal(dAtA []byte) error {
+func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7200,17 +18422,36 @@ func (m *ChangePack) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangePack: wiretype end group for non-group")
+			return fmt.Errorf("proto: Counter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangePack: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Counter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ValueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -7220,27 +18461,29 @@ func (m *ChangePack) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7267,18 +18510,18 @@ func (m *ChangePack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Checkpoint == nil {
-				m.Checkpoint = &Checkpoint{}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
 			}
-			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -7288,29 +18531,31 @@ func (m *ChangePack) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Snapshot = append(m.Snapshot[:0], dAtA[iNdEx:postIndex]...)
-			if m.Snapshot == nil {
-				m.Snapshot = []byte{}
+			if m.MovedAt == nil {
+				m.MovedAt = &TimeTicket{}
+			}
+			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7337,14 +18582,16 @@ func (m *ChangePack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Changes = append(m.Changes, &Change{})
-			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
+			}
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSyncedTicket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncreasedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7371,10 +18618,10 @@ func (m *ChangePack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MinSyncedTicket == nil {
-				m.MinSyncedTicket = &TimeTicket{}
+			if m.IncreasedAt == nil {
+				m.IncreasedAt = &TimeTicket{}
 			}
-			if err := m.MinSyncedTicket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IncreasedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7400,7 +18647,7 @@ func (m *ChangePack) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Change) Unmarshal(dAtA []byte) error {
+func (m *JSONElement_Tree) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7423,15 +18670,15 @@ func (m *Change) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Change: wiretype end group for non-group")
+			return fmt.Errorf("proto: Tree: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Change: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Tree: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7458,18 +18705,16 @@ func (m *Change) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &ChangeID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Nodes = append(m.Nodes, &TreeNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -7479,27 +18724,31 @@ func (m *Change) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7526,124 +18775,18 @@ func (m *Change) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operations = append(m.Operations, &Operation{})
-			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.MovedAt == nil {
+				m.MovedAt = &TimeTicket{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthResources
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChangeID) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeID: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeID: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientSeq", wireType)
-			}
-			m.ClientSeq = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClientSeq |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
-			}
-			m.ServerSeq = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ServerSeq |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lamport", wireType)
-			}
-			m.Lamport = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Lamport |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -7653,24 +18796,26 @@ func (m *ChangeID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActorId = append(m.ActorId[:0], dAtA[iNdEx:postIndex]...)
-			if m.ActorId == nil {
-				m.ActorId = []byte{}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
+			}
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -7695,7 +18840,7 @@ func (m *ChangeID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation) Unmarshal(dAtA []byte) error {
+func (m *RHTNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7718,17 +18863,17 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
+			return fmt.Errorf("proto: RHTNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RHTNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -7738,30 +18883,27 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Operation_Set{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Body = &Operation_Set_{v}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Element", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7788,17 +18930,18 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Operation_Add{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Element == nil {
+				m.Element = &JSONElement{}
+			}
+			if err := m.Element.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &Operation_Add_{v}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Move", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
 			}
-			var msglen int
+			m.Generation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -7808,30 +18951,65 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Generation |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthResources
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Operation_Move{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RGANode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
 			}
-			m.Body = &Operation_Move_{v}
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RGANode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RGANode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7858,15 +19036,16 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Operation_Remove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Next == nil {
+				m.Next = &RGANode{}
+			}
+			if err := m.Next.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &Operation_Remove_{v}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Edit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Element", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7893,15 +19072,67 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Operation_Edit{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Element == nil {
+				m.Element = &JSONElement{}
+			}
+			if err := m.Element.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &Operation_Edit_{v}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TextNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TextNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TextNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Select", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7928,17 +19159,18 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Operation_Select{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Id == nil {
+				m.Id = &TextNodeID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &Operation_Select_{v}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RichEdit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -7948,30 +19180,27 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Operation_RichEdit{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Body = &Operation_RichEdit_{v}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Style", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7998,15 +19227,16 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Operation_Style{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
+			}
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &Operation_Style_{v}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Increase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InsPrevId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8033,11 +19263,12 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Operation_Increase{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.InsPrevId == nil {
+				m.InsPrevId = &TextNodeID{}
+			}
+			if err := m.InsPrevId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &Operation_Increase_{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8061,7 +19292,7 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation_Set) Unmarshal(dAtA []byte) error {
+func (m *RichTextNodeAttr) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8084,49 +19315,13 @@ func (m *Operation_Set) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Set: wiretype end group for non-group")
+			return fmt.Errorf("proto: RichTextNodeAttr: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Set: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RichTextNodeAttr: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ParentCreatedAt == nil {
-				m.ParentCreatedAt = &TimeTicket{}
-			}
-			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
@@ -8158,11 +19353,11 @@ func (m *Operation_Set) Unmarshal(dAtA []byte) error {
 			}
 			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -8172,31 +19367,27 @@ func (m *Operation_Set) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Value == nil {
-				m.Value = &JSONElementSimple{}
-			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8223,10 +19414,10 @@ func (m *Operation_Set) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutedAt == nil {
-				m.ExecutedAt = &TimeTicket{}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = &TimeTicket{}
 			}
-			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8252,7 +19443,7 @@ func (m *Operation_Set) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation_Add) Unmarshal(dAtA []byte) error {
+func (m *RichTextNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8275,15 +19466,15 @@ func (m *Operation_Add) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Add: wiretype end group for non-group")
+			return fmt.Errorf("proto: RichTextNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Add: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RichTextNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8310,16 +19501,16 @@ func (m *Operation_Add) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCreatedAt == nil {
-				m.ParentCreatedAt = &TimeTicket{}
+			if m.Id == nil {
+				m.Id = &TextNodeID{}
 			}
-			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevCreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8346,17 +19537,142 @@ func (m *Operation_Add) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrevCreatedAt == nil {
-				m.PrevCreatedAt = &TimeTicket{}
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]*RichTextNodeAttr)
 			}
-			if err := m.PrevCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue *RichTextNodeAttr
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthResources
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &RichTextNodeAttr{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Attributes[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -8382,16 +19698,103 @@ func (m *Operation_Add) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Value == nil {
-				m.Value = &JSONElementSimple{}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsPrevId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InsPrevId == nil {
+				m.InsPrevId = &TextNodeID{}
+			}
+			if err := m.InsPrevId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TextNodeID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TextNodeID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TextNodeID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8418,13 +19821,32 @@ func (m *Operation_Add) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutedAt == nil {
-				m.ExecutedAt = &TimeTicket{}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &TimeTicket{}
 			}
-			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -8447,7 +19869,7 @@ func (m *Operation_Add) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation_Move) Unmarshal(dAtA []byte) error {
+func (m *TreeNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8470,15 +19892,15 @@ func (m *Operation_Move) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Move: wiretype end group for non-group")
+			return fmt.Errorf("proto: TreeNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Move: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TreeNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8505,18 +19927,18 @@ func (m *Operation_Move) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCreatedAt == nil {
-				m.ParentCreatedAt = &TimeTicket{}
+			if m.Id == nil {
+				m.Id = &TimeTicket{}
 			}
-			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevCreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -8526,31 +19948,59 @@ func (m *Operation_Move) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrevCreatedAt == nil {
-				m.PrevCreatedAt = &TimeTicket{}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			if err := m.PrevCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8577,16 +20027,35 @@ func (m *Operation_Move) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8613,12 +20082,105 @@ func (m *Operation_Move) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutedAt == nil {
-				m.ExecutedAt = &TimeTicket{}
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]*RichTextNodeAttr)
 			}
-			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue *RichTextNodeAttr
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthResources
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &RichTextNodeAttr{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Attributes[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8642,7 +20204,7 @@ func (m *Operation_Move) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation_Remove) Unmarshal(dAtA []byte) error {
+func (m *TreePos) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8665,51 +20227,15 @@ func (m *Operation_Remove) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Remove: wiretype end group for non-group")
+			return fmt.Errorf("proto: TreePos: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Remove: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ParentCreatedAt == nil {
-				m.ParentCreatedAt = &TimeTicket{}
-			}
-			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+			return fmt.Errorf("proto: TreePos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8736,16 +20262,16 @@ func (m *Operation_Remove) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
+			if m.ParentCreatedAt == nil {
+				m.ParentCreatedAt = &TimeTicket{}
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeftSiblingCreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8772,10 +20298,10 @@ func (m *Operation_Remove) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutedAt == nil {
-				m.ExecutedAt = &TimeTicket{}
+			if m.LeftSiblingCreatedAt == nil {
+				m.LeftSiblingCreatedAt = &TimeTicket{}
 			}
-			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LeftSiblingCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8801,7 +20327,7 @@ func (m *Operation_Remove) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation_Edit) Unmarshal(dAtA []byte) error {
+func (m *Project) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8824,17 +20350,17 @@ func (m *Operation_Edit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Edit: wiretype end group for non-group")
+			return fmt.Errorf("proto: Project: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Edit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -8844,33 +20370,29 @@ func (m *Operation_Edit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCreatedAt == nil {
-				m.ParentCreatedAt = &TimeTicket{}
-			}
-			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -8880,33 +20402,29 @@ func (m *Operation_Edit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &TextNodePos{}
-			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -8916,33 +20434,29 @@ func (m *Operation_Edit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.To == nil {
-				m.To = &TextNodePos{}
-			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtMapByActor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -8952,124 +20466,27 @@ func (m *Operation_Edit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAtMapByActor == nil {
-				m.CreatedAtMapByActor = make(map[string]*TimeTicket)
-			}
-			var mapkey string
-			var mapvalue *TimeTicket
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowResources
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthResources
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &TimeTicket{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipResources(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthResources
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.CreatedAtMapByActor[mapkey] = mapvalue
+			m.SecretKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthWebhookUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9097,98 +20514,43 @@ func (m *Operation_Edit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Content = string(dAtA[iNdEx:postIndex])
+			m.AuthWebhookUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthWebhookMethods", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ExecutedAt == nil {
-				m.ExecutedAt = &TimeTicket{}
-			}
-			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthResources
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Operation_Select) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Select: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Select: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthWebhookMethods = append(m.AuthWebhookMethods, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9215,16 +20577,16 @@ func (m *Operation_Select) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCreatedAt == nil {
-				m.ParentCreatedAt = &TimeTicket{}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &types.Timestamp{}
 			}
-			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9251,16 +20613,16 @@ func (m *Operation_Select) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &TextNodePos{}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = &types.Timestamp{}
 			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKeyPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9287,18 +20649,38 @@ func (m *Operation_Select) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.To == nil {
-				m.To = &TextNodePos{}
+			if m.DocumentKeyPolicy == nil {
+				m.DocumentKeyPolicy = &DocumentKeyPolicy{}
 			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DocumentKeyPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectApplyLag", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CollectApplyLag = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialContent", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -9308,84 +20690,61 @@ func (m *Operation_Select) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutedAt == nil {
-				m.ExecutedAt = &TimeTicket{}
-			}
-			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InitialContent = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMergePolicy", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthResources
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Operation_RichEdit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RichEdit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RichEdit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ObjectMergePolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventWebhookUrl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -9395,33 +20754,48 @@ func (m *Operation_RichEdit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCreatedAt == nil {
-				m.ParentCreatedAt = &TimeTicket{}
+			m.EventWebhookUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentCount", wireType)
 			}
-			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DocumentCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DocumentCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EphemeralKeyPrefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -9431,33 +20805,29 @@ func (m *Operation_RichEdit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &TextNodePos{}
-			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EphemeralKeyPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangefeedUrl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -9467,31 +20837,27 @@ func (m *Operation_RichEdit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.To == nil {
-				m.To = &TextNodePos{}
-			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChangefeedUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtMapByActor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9518,11 +20884,11 @@ func (m *Operation_RichEdit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAtMapByActor == nil {
-				m.CreatedAtMapByActor = make(map[string]*TimeTicket)
+			if m.Features == nil {
+				m.Features = make(map[string]bool)
 			}
 			var mapkey string
-			var mapvalue *TimeTicket
+			var mapvalue bool
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -9571,36 +20937,22 @@ func (m *Operation_RichEdit) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
+					var mapvaluetemp int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowResources
 						}
 						if iNdEx >= l {
 							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthResources
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &TimeTicket{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
 					}
-					iNdEx = postmsgIndex
+					mapvalue = bool(mapvaluetemp != 0)
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipResources(dAtA[iNdEx:])
@@ -9616,11 +20968,11 @@ func (m *Operation_RichEdit) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.CreatedAtMapByActor[mapkey] = mapvalue
+			m.Features[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveAfter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9648,11 +21000,11 @@ func (m *Operation_RichEdit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Content = string(dAtA[iNdEx:postIndex])
+			m.ArchiveAfter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentTemplates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9679,8 +21031,8 @@ func (m *Operation_RichEdit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Attributes == nil {
-				m.Attributes = make(map[string]string)
+			if m.DocumentTemplates == nil {
+				m.DocumentTemplates = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -9775,11 +21127,95 @@ func (m *Operation_RichEdit) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Attributes[mapkey] = mapvalue
+			m.DocumentTemplates[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 7:
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitDocumentCreation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExplicitDocumentCreation = bool(v != 0)
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorIdPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorIdPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedOperations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedOperations = append(m.AllowedOperations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9806,13 +21242,64 @@ func (m *Operation_RichEdit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutedAt == nil {
-				m.ExecutedAt = &TimeTicket{}
+			if m.SnapshotPolicy == nil {
+				m.SnapshotPolicy = &SnapshotPolicy{}
 			}
-			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SnapshotPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxKeysPerObject", wireType)
+			}
+			m.MaxKeysPerObject = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxKeysPerObject |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedDocumentPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedDocumentPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -9835,7 +21322,7 @@ func (m *Operation_RichEdit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation_Style) Unmarshal(dAtA []byte) error {
+func (m *DocumentKeyPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9858,17 +21345,17 @@ func (m *Operation_Style) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Style: wiretype end group for non-group")
+			return fmt.Errorf("proto: DocumentKeyPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Style: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DocumentKeyPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCharset", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -9878,33 +21365,29 @@ func (m *Operation_Style) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCreatedAt == nil {
-				m.ParentCreatedAt = &TimeTicket{}
-			}
-			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AllowedCharset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLength", wireType)
 			}
-			var msglen int
+			m.MaxLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -9914,33 +21397,16 @@ func (m *Operation_Style) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxLength |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.From == nil {
-				m.From = &TextNodePos{}
-			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisallowedPrefixes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -9950,33 +21416,99 @@ func (m *Operation_Style) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.To == nil {
-				m.To = &TextNodePos{}
-			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DisallowedPrefixes = append(m.DisallowedPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -9986,124 +21518,29 @@ func (m *Operation_Style) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Attributes == nil {
-				m.Attributes = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowResources
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthResources
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipResources(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthResources
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Attributes[mapkey] = mapvalue
+			m.Compression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionCount", wireType)
 			}
-			var msglen int
+			m.RetentionCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -10113,28 +21550,11 @@ func (m *Operation_Style) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RetentionCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ExecutedAt == nil {
-				m.ExecutedAt = &TimeTicket{}
-			}
-			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -10157,7 +21577,7 @@ func (m *Operation_Style) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation_Increase) Unmarshal(dAtA []byte) error {
+func (m *UpdatableProjectFields) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10180,15 +21600,15 @@ func (m *Operation_Increase) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Increase: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdatableProjectFields: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Increase: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdatableProjectFields: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10215,16 +21635,16 @@ func (m *Operation_Increase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCreatedAt == nil {
-				m.ParentCreatedAt = &TimeTicket{}
+			if m.Name == nil {
+				m.Name = &types.StringValue{}
 			}
-			if err := m.ParentCreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Name.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthWebhookUrl", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10251,16 +21671,16 @@ func (m *Operation_Increase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Value == nil {
-				m.Value = &JSONElementSimple{}
+			if m.AuthWebhookUrl == nil {
+				m.AuthWebhookUrl = &types.StringValue{}
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AuthWebhookUrl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthWebhookMethods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10287,67 +21707,88 @@ func (m *Operation_Increase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutedAt == nil {
-				m.ExecutedAt = &TimeTicket{}
+			if m.AuthWebhookMethods == nil {
+				m.AuthWebhookMethods = &UpdatableProjectFields_AuthWebhookMethods{}
 			}
-			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AuthWebhookMethods.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKeyPolicy", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthResources
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
+			if m.DocumentKeyPolicy == nil {
+				m.DocumentKeyPolicy = &DocumentKeyPolicy{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.DocumentKeyPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectApplyLag", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JSONElementSimple: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JSONElementSimple: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CollectApplyLag == nil {
+				m.CollectApplyLag = &types.BoolValue{}
+			}
+			if err := m.CollectApplyLag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialContent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10374,16 +21815,16 @@ func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
+			if m.InitialContent == nil {
+				m.InitialContent = &types.StringValue{}
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitialContent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMergePolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10410,16 +21851,16 @@ func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MovedAt == nil {
-				m.MovedAt = &TimeTicket{}
+			if m.ObjectMergePolicy == nil {
+				m.ObjectMergePolicy = &types.StringValue{}
 			}
-			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMergePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventWebhookUrl", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10446,18 +21887,18 @@ func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
+			if m.EventWebhookUrl == nil {
+				m.EventWebhookUrl = &types.StringValue{}
 			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EventWebhookUrl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EphemeralKeyPrefix", wireType)
 			}
-			m.Type = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -10467,16 +21908,33 @@ func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= ValueType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EphemeralKeyPrefix == nil {
+				m.EphemeralKeyPrefix = &types.StringValue{}
+			}
+			if err := m.EphemeralKeyPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangefeedUrl", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -10486,80 +21944,31 @@ func (m *JSONElementSimple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			if m.ChangefeedUrl == nil {
+				m.ChangefeedUrl = &types.StringValue{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ChangefeedUrl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthResources
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JSONElement) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JSONElement: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JSONElement: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JsonObject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10586,15 +21995,16 @@ func (m *JSONElement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JSONElement_JSONObject{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Features == nil {
+				m.Features = &UpdatableProjectFields_Features{}
+			}
+			if err := m.Features.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &JSONElement_JsonObject{v}
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JsonArray", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveAfter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10621,15 +22031,16 @@ func (m *JSONElement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JSONElement_JSONArray{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ArchiveAfter == nil {
+				m.ArchiveAfter = &types.StringValue{}
+			}
+			if err := m.ArchiveAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &JSONElement_JsonArray{v}
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Primitive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentTemplates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10656,15 +22067,16 @@ func (m *JSONElement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JSONElement_Primitive{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DocumentTemplates == nil {
+				m.DocumentTemplates = &UpdatableProjectFields_DocumentTemplates{}
+			}
+			if err := m.DocumentTemplates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &JSONElement_Primitive_{v}
 			iNdEx = postIndex
-		case 4:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitDocumentCreation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10691,15 +22103,16 @@ func (m *JSONElement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JSONElement_Text{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ExplicitDocumentCreation == nil {
+				m.ExplicitDocumentCreation = &types.BoolValue{}
+			}
+			if err := m.ExplicitDocumentCreation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &JSONElement_Text_{v}
 			iNdEx = postIndex
-		case 5:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RichText", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorIdPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10726,15 +22139,16 @@ func (m *JSONElement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JSONElement_RichText{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ActorIdPolicy == nil {
+				m.ActorIdPolicy = &types.StringValue{}
+			}
+			if err := m.ActorIdPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &JSONElement_RichText_{v}
 			iNdEx = postIndex
-		case 6:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedOperations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10761,66 +22175,16 @@ func (m *JSONElement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JSONElement_Counter{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.AllowedOperations == nil {
+				m.AllowedOperations = &UpdatableProjectFields_AllowedOperations{}
 			}
-			m.Body = &JSONElement_Counter_{v}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.AllowedOperations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthResources
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JSONObject: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JSONObject: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10847,14 +22211,16 @@ func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, &RHTNode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SnapshotPolicy == nil {
+				m.SnapshotPolicy = &SnapshotPolicy{}
+			}
+			if err := m.SnapshotPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxKeysPerObject", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10881,16 +22247,16 @@ func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
+			if m.MaxKeysPerObject == nil {
+				m.MaxKeysPerObject = &types.Int32Value{}
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxKeysPerObject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedDocumentPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10917,18 +22283,69 @@ func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MovedAt == nil {
-				m.MovedAt = &TimeTicket{}
+			if m.RemovedDocumentPolicy == nil {
+				m.RemovedDocumentPolicy = &types.StringValue{}
 			}
-			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemovedDocumentPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdatableProjectFields_AuthWebhookMethods) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthWebhookMethods: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthWebhookMethods: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -10938,27 +22355,23 @@ func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
-			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Methods = append(m.Methods, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10982,7 +22395,7 @@ func (m *JSONElement_JSONObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JSONElement_JSONArray) Unmarshal(dAtA []byte) error {
+func (m *UpdatableProjectFields_Features) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11005,15 +22418,15 @@ func (m *JSONElement_JSONArray) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JSONArray: wiretype end group for non-group")
+			return fmt.Errorf("proto: Features: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JSONArray: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Features: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11040,50 +22453,146 @@ func (m *JSONElement_JSONArray) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, &RGANode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			if m.Features == nil {
+				m.Features = make(map[string]bool)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
+			m.Features[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthResources
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdatableProjectFields_DocumentTemplates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DocumentTemplates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DocumentTemplates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11110,48 +22619,103 @@ func (m *JSONElement_JSONArray) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MovedAt == nil {
-				m.MovedAt = &TimeTicket{}
-			}
-			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+			if m.Templates == nil {
+				m.Templates = make(map[string]string)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
-			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Templates[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11175,7 +22739,7 @@ func (m *JSONElement_JSONArray) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
+func (m *UpdatableProjectFields_AllowedOperations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11198,36 +22762,17 @@ func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Primitive: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllowedOperations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Primitive: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllowedOperations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= ValueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -11237,67 +22782,80 @@ func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.Operations = append(m.Operations, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthResources
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DocumentSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DocumentSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DocumentSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -11307,33 +22865,29 @@ func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MovedAt == nil {
-				m.MovedAt = &TimeTicket{}
-			}
-			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -11343,84 +22897,29 @@ func (m *JSONElement_Primitive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
-			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthResources
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JSONElement_Text) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Text: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Text: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -11430,27 +22929,25 @@ func (m *JSONElement_Text) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, &TextNode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Snapshot = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
@@ -11480,15 +22977,15 @@ func (m *JSONElement_Text) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
+				m.CreatedAt = &types.Timestamp{}
 			}
 			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11515,16 +23012,16 @@ func (m *JSONElement_Text) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MovedAt == nil {
-				m.MovedAt = &TimeTicket{}
+			if m.AccessedAt == nil {
+				m.AccessedAt = &types.Timestamp{}
 			}
-			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccessedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11551,67 +23048,16 @@ func (m *JSONElement_Text) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = &types.Timestamp{}
 			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthResources
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JSONElement_RichText) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RichText: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RichText: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11638,14 +23084,107 @@ func (m *JSONElement_RichText) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, &RichTextNode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11672,16 +23211,16 @@ func (m *JSONElement_RichText) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
+			if m.ArchivedAt == nil {
+				m.ArchivedAt = &types.Timestamp{}
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ArchivedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationCounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11698,24 +23237,101 @@ func (m *JSONElement_RichText) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MovedAt == nil {
-				m.MovedAt = &TimeTicket{}
-			}
-			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OperationCounts == nil {
+				m.OperationCounts = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthResources
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipResources(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthResources
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.OperationCounts[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
 			}
@@ -11745,7 +23361,7 @@ func (m *JSONElement_RichText) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
+				m.RemovedAt = &types.Timestamp{}
 			}
 			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -11773,7 +23389,7 @@ func (m *JSONElement_RichText) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
+func (m *DocumentClientEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11796,36 +23412,17 @@ func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Counter: wiretype end group for non-group")
+			return fmt.Errorf("proto: DocumentClientEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Counter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DocumentClientEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= ValueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -11835,31 +23432,29 @@ func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -11869,33 +23464,29 @@ func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MovedAt", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -11905,31 +23496,14 @@ func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Type |= DocumentClientEventType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MovedAt == nil {
-				m.MovedAt = &TimeTicket{}
-			}
-			if err := m.MovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11956,10 +23530,10 @@ func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &types.Timestamp{}
 			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11985,7 +23559,7 @@ func (m *JSONElement_Counter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RHTNode) Unmarshal(dAtA []byte) error {
+func (m *Rejection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12008,15 +23582,15 @@ func (m *RHTNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RHTNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: Rejection: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RHTNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Rejection: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12044,13 +23618,13 @@ func (m *RHTNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Element", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -12060,82 +23634,110 @@ func (m *RHTNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Element == nil {
-				m.Element = &JSONElement{}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			if err := m.Element.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RGANode) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			m.Changes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Changes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RGANode: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RGANode: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12162,18 +23764,18 @@ func (m *RGANode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Next == nil {
-				m.Next = &RGANode{}
+			if m.RejectedAt == nil {
+				m.RejectedAt = &types.Timestamp{}
 			}
-			if err := m.Next.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RejectedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Element", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dropped", wireType)
 			}
-			var msglen int
+			m.Dropped = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -12183,28 +23785,11 @@ func (m *RGANode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Dropped |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Element == nil {
-				m.Element = &JSONElement{}
-			}
-			if err := m.Element.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -12227,7 +23812,7 @@ func (m *RGANode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TextNode) Unmarshal(dAtA []byte) error {
+func (m *DocumentEventLog) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12250,17 +23835,17 @@ func (m *TextNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TextNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: DocumentEventLog: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TextNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DocumentEventLog: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
 			}
-			var msglen int
+			m.ServerSeq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -12270,31 +23855,14 @@ func (m *TextNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ServerSeq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Id == nil {
-				m.Id = &TextNodeID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12322,13 +23890,13 @@ func (m *TextNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSeq", wireType)
 			}
-			var msglen int
+			m.ClientSeq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -12338,33 +23906,16 @@ func (m *TextNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ClientSeq |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
-			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsPrevId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lamport", wireType)
 			}
-			var msglen int
+			m.Lamport = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -12374,84 +23925,35 @@ func (m *TextNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Lamport |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.InsPrevId == nil {
-				m.InsPrevId = &TextNodeID{}
-			}
-			if err := m.InsPrevId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipResources(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthResources
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RichTextNodeAttr) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowResources
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationCount", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.OperationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperationCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RichTextNodeAttr: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RichTextNodeAttr: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionVector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -12461,27 +23963,31 @@ func (m *RichTextNodeAttr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if m.VersionVector == nil {
+				m.VersionVector = &VersionVector{}
+			}
+			if err := m.VersionVector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12509,11 +24015,11 @@ func (m *RichTextNodeAttr) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12540,10 +24046,10 @@ func (m *RichTextNodeAttr) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdatedAt == nil {
-				m.UpdatedAt = &TimeTicket{}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &types.Timestamp{}
 			}
-			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12569,7 +24075,7 @@ func (m *RichTextNodeAttr) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RichTextNode) Unmarshal(dAtA []byte) error {
+func (m *Presence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12592,17 +24098,17 @@ func (m *RichTextNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RichTextNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: Presence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RichTextNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Presence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
 			}
-			var msglen int
+			m.Clock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -12612,31 +24118,14 @@ func (m *RichTextNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Clock |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Id == nil {
-				m.Id = &TextNodeID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12663,11 +24152,11 @@ func (m *RichTextNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Attributes == nil {
-				m.Attributes = make(map[string]*RichTextNodeAttr)
+			if m.Data == nil {
+				m.Data = make(map[string]string)
 			}
 			var mapkey string
-			var mapvalue *RichTextNodeAttr
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -12716,7 +24205,7 @@ func (m *RichTextNode) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowResources
@@ -12726,26 +24215,24 @@ func (m *RichTextNode) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
 						return ErrInvalidLengthResources
 					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
 						return ErrInvalidLengthResources
 					}
-					if postmsgIndex > l {
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &RichTextNodeAttr{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipResources(dAtA[iNdEx:])
@@ -12761,45 +24248,64 @@ func (m *RichTextNode) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Attributes[mapkey] = mapvalue
+			m.Data[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Client) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Client: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Client: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -12809,31 +24315,29 @@ func (m *RichTextNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemovedAt == nil {
-				m.RemovedAt = &TimeTicket{}
-			}
-			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsPrevId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Presence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12860,10 +24364,10 @@ func (m *RichTextNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InsPrevId == nil {
-				m.InsPrevId = &TextNodeID{}
+			if m.Presence == nil {
+				m.Presence = &Presence{}
 			}
-			if err := m.InsPrevId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Presence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12889,7 +24393,7 @@ func (m *RichTextNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TextNodeID) Unmarshal(dAtA []byte) error {
+func (m *Clients) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12912,15 +24416,15 @@ func (m *TextNodeID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TextNodeID: wiretype end group for non-group")
+			return fmt.Errorf("proto: Clients: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TextNodeID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Clients: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12947,32 +24451,11 @@ func (m *TextNodeID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &TimeTicket{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Clients = append(m.Clients, &Client{})
+			if err := m.Clients[len(m.Clients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
-			}
-			m.Offset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Offset |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -12995,7 +24478,7 @@ func (m *TextNodeID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Project) Unmarshal(dAtA []byte) error {
+func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13018,81 +24501,17 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Project: wiretype end group for non-group")
+			return fmt.Errorf("proto: Checkpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Checkpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
 			}
-			var stringLen uint64
+			m.ServerSeq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13102,29 +24521,16 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ServerSeq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSeq", wireType)
 			}
-			var stringLen uint64
+			m.ClientSeq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13134,29 +24540,67 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ClientSeq |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthResources
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthResources
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecretKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthWebhookUrl", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
+			}
+			m.ServerSeq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13166,29 +24610,16 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ServerSeq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AuthWebhookUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthWebhookMethods", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lamport", wireType)
 			}
-			var stringLen uint64
+			m.Lamport = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13198,25 +24629,12 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Lamport |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AuthWebhookMethods = append(m.AuthWebhookMethods, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
@@ -13252,11 +24670,11 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GarbageLen", wireType)
 			}
-			var msglen int
+			m.GarbageLen = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13266,28 +24684,11 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GarbageLen |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.UpdatedAt == nil {
-				m.UpdatedAt = &types.Timestamp{}
-			}
-			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -13310,7 +24711,7 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdatableProjectFields) Unmarshal(dAtA []byte) error {
+func (m *ProjectStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13333,15 +24734,15 @@ func (m *UpdatableProjectFields) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdatableProjectFields: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdatableProjectFields: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictWins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13368,16 +24769,14 @@ func (m *UpdatableProjectFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Name == nil {
-				m.Name = &types.StringValue{}
-			}
-			if err := m.Name.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ConflictWins = append(m.ConflictWins, &ActorConflictWins{})
+			if err := m.ConflictWins[len(m.ConflictWins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthWebhookUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HotDocuments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13404,16 +24803,14 @@ func (m *UpdatableProjectFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthWebhookUrl == nil {
-				m.AuthWebhookUrl = &types.StringValue{}
-			}
-			if err := m.AuthWebhookUrl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HotDocuments = append(m.HotDocuments, &HotDocument{})
+			if err := m.HotDocuments[len(m.HotDocuments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthWebhookMethods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BackgroundQueues", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13440,10 +24837,8 @@ func (m *UpdatableProjectFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthWebhookMethods == nil {
-				m.AuthWebhookMethods = &UpdatableProjectFields_AuthWebhookMethods{}
-			}
-			if err := m.AuthWebhookMethods.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BackgroundQueues = append(m.BackgroundQueues, &BackgroundQueue{})
+			if err := m.BackgroundQueues[len(m.BackgroundQueues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13469,7 +24864,7 @@ func (m *UpdatableProjectFields) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdatableProjectFields_AuthWebhookMethods) Unmarshal(dAtA []byte) error {
+func (m *ActorConflictWins) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13492,15 +24887,15 @@ func (m *UpdatableProjectFields_AuthWebhookMethods) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthWebhookMethods: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActorConflictWins: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthWebhookMethods: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActorConflictWins: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13528,8 +24923,27 @@ func (m *UpdatableProjectFields_AuthWebhookMethods) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Methods = append(m.Methods, string(dAtA[iNdEx:postIndex]))
+			m.Actor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wins", wireType)
+			}
+			m.Wins = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Wins |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -13552,7 +24966,7 @@ func (m *UpdatableProjectFields_AuthWebhookMethods) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *DocumentSummary) Unmarshal(dAtA []byte) error {
+func (m *LiveProjectStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13575,17 +24989,17 @@ func (m *DocumentSummary) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DocumentSummary: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiveProjectStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DocumentSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiveProjectStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveDocuments", wireType)
 			}
-			var stringLen uint64
+			m.ActiveDocuments = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13595,29 +25009,16 @@ func (m *DocumentSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ActiveDocuments |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttachedClients", wireType)
 			}
-			var stringLen uint64
+			m.AttachedClients = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13627,29 +25028,46 @@ func (m *DocumentSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AttachedClients |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpsPerSecond", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.OpsPerSecond = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotDocuments", wireType)
+			}
+			m.HotDocuments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HotDocuments |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MeasuredAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13659,27 +25077,31 @@ func (m *DocumentSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Snapshot = string(dAtA[iNdEx:postIndex])
+			if m.MeasuredAt == nil {
+				m.MeasuredAt = &types.Timestamp{}
+			}
+			if err := m.MeasuredAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictWins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13706,18 +25128,67 @@ func (m *DocumentSummary) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &types.Timestamp{}
+			m.ConflictWins = append(m.ConflictWins, &ActorConflictWins{})
+			if err := m.ConflictWins[len(m.ConflictWins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResources(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResources
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectStatsEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResources
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectStatsEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectStatsEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13727,33 +25198,29 @@ func (m *DocumentSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessedAt == nil {
-				m.AccessedAt = &types.Timestamp{}
-			}
-			if err := m.AccessedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13763,27 +25230,23 @@ func (m *DocumentSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdatedAt == nil {
-				m.UpdatedAt = &types.Timestamp{}
-			}
-			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13807,7 +25270,7 @@ func (m *DocumentSummary) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Presence) Unmarshal(dAtA []byte) error {
+func (m *BackgroundQueue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13830,17 +25293,17 @@ func (m *Presence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Presence: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackgroundQueue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Presence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackgroundQueue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.Clock = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13850,16 +25313,29 @@ func (m *Presence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Clock |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
 			}
-			var msglen int
+			m.Depth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -13869,119 +25345,22 @@ func (m *Presence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Depth |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthResources
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthResources
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestAgeSeconds", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowResources
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowResources
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthResources
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthResources
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipResources(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthResources
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Data[mapkey] = mapvalue
-			iNdEx = postIndex
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.OldestAgeSeconds = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -14004,7 +25383,7 @@ func (m *Presence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Client) Unmarshal(dAtA []byte) error {
+func (m *HotDocument) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14023,21 +25402,64 @@ func (m *Client) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Client: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Client: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HotDocument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HotDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpsPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.OpsPerSecond = float64(math.Float64frombits(v))
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopActorId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -14047,29 +25469,38 @@ func (m *Client) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthResources
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthResources
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
-			if m.Id == nil {
-				m.Id = []byte{}
-			}
+			m.TopActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopActorOpsPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.TopActorOpsPerSecond = float64(math.Float64frombits(v))
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Presence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DetectedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14096,10 +25527,10 @@ func (m *Client) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Presence == nil {
-				m.Presence = &Presence{}
+			if m.DetectedAt == nil {
+				m.DetectedAt = &types.Timestamp{}
 			}
-			if err := m.Presence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DetectedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14125,7 +25556,7 @@ func (m *Client) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Clients) Unmarshal(dAtA []byte) error {
+func (m *Maintenance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14148,15 +25579,67 @@ func (m *Clients) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Clients: wiretype end group for non-group")
+			return fmt.Errorf("proto: Maintenance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Clients: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Maintenance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14183,8 +25666,10 @@ func (m *Clients) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Clients = append(m.Clients, &Client{})
-			if err := m.Clients[len(m.Clients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = &types.Timestamp{}
+			}
+			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14210,7 +25695,7 @@ func (m *Clients) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Checkpoint) Unmarshal(dAtA []byte) error {
+func (m *VersionVector) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14233,17 +25718,17 @@ func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Checkpoint: wiretype end group for non-group")
+			return fmt.Errorf("proto: VersionVector: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Checkpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VersionVector: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorIds", wireType)
 			}
-			m.ServerSeq = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResources
@@ -14253,29 +25738,99 @@ func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServerSeq |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientSeq", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthResources
 			}
-			m.ClientSeq = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowResources
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorIds = append(m.ActorIds, make([]byte, postIndex-iNdEx))
+			copy(m.ActorIds[len(m.ActorIds)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Lamports = append(m.Lamports, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowResources
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthResources
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthResources
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClientSeq |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Lamports) == 0 {
+					m.Lamports = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowResources
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Lamports = append(m.Lamports, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lamports", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -14663,6 +26218,89 @@ func (m *DocEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.DocumentKeys = append(m.DocumentKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedPaths = append(m.ChangedPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResources
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResources
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedPaths = append(m.RemovedPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
+			}
+			m.ServerSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResources
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResources(dAtA[iNdEx:])
@@ -0,0 +1,103 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+var (
+	// ErrInvalidActorIDFormat is returned when the given actor ID format is
+	// invalid.
+	ErrInvalidActorIDFormat = errors.New("invalid actor id format")
+
+	// ErrActorIDFormatMismatch is returned when an actor ID does not match the
+	// actor ID format of the server.
+	ErrActorIDFormatMismatch = errors.New("actor id format mismatch")
+)
+
+// ActorIDFormat is the format of the actor IDs accepted by the server. It lets
+// deployments embed tenant info in the actor IDs with Prefix, or use shorter
+// actor IDs to shrink the version vectors.
+type ActorIDFormat struct {
+	// Size is the length of the actor IDs in bytes.
+	Size int
+
+	// Prefix is the bytes that the actor IDs start with. Empty means any.
+	Prefix []byte
+}
+
+// NewActorIDFormat creates a new instance of ActorIDFormat with the given size
+// and the prefix in hexadecimal.
+func NewActorIDFormat(size int, prefix string) (*ActorIDFormat, error) {
+	if size < time.MinActorIDSize || size > time.MaxActorIDSize {
+		return nil, fmt.Errorf(
+			"size %d is not in [%d, %d]: %w",
+			size,
+			time.MinActorIDSize,
+			time.MaxActorIDSize,
+			ErrInvalidActorIDFormat,
+		)
+	}
+
+	decoded, err := hex.DecodeString(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("prefix %s: %w", prefix, ErrInvalidActorIDFormat)
+	}
+	if len(decoded) >= size {
+		return nil, fmt.Errorf(
+			"prefix %s is not shorter than %d bytes: %w",
+			prefix,
+			size,
+			ErrInvalidActorIDFormat,
+		)
+	}
+
+	return &ActorIDFormat{
+		Size:   size,
+		Prefix: decoded,
+	}, nil
+}
+
+// Validate returns an error if the given actor ID does not match the format.
+func (f *ActorIDFormat) Validate(actorID *time.ActorID) error {
+	if actorID.Size() != f.Size {
+		return fmt.Errorf(
+			"%s has %d bytes, not %d: %w",
+			actorID,
+			actorID.Size(),
+			f.Size,
+			ErrActorIDFormatMismatch,
+		)
+	}
+
+	if !bytes.HasPrefix(actorID.Bytes(), f.Prefix) {
+		return fmt.Errorf(
+			"%s does not start with %s: %w",
+			actorID,
+			hex.EncodeToString(f.Prefix),
+			ErrActorIDFormatMismatch,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+func TestActorIDFormat(t *testing.T) {
+	t.Run("new actor id format test", func(t *testing.T) {
+		format, err := types.NewActorIDFormat(time.DefaultActorIDSize, "")
+		assert.NoError(t, err)
+		assert.Equal(t, time.DefaultActorIDSize, format.Size)
+		assert.Empty(t, format.Prefix)
+
+		format, err = types.NewActorIDFormat(8, "0a0b")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{0x0a, 0x0b}, format.Prefix)
+
+		for _, tc := range []struct {
+			size   int
+			prefix string
+		}{
+			{time.MinActorIDSize - 1, ""},
+			{time.MaxActorIDSize + 1, ""},
+			{12, "xyz"},
+			{8, "0102030405060708"},
+		} {
+			_, err := types.NewActorIDFormat(tc.size, tc.prefix)
+			assert.ErrorIs(t, err, types.ErrInvalidActorIDFormat)
+		}
+	})
+
+	t.Run("validate test", func(t *testing.T) {
+		format, err := types.NewActorIDFormat(8, "0a0b")
+		assert.NoError(t, err)
+
+		valid, err := time.ActorIDFromHex("0a0b000000000001")
+		assert.NoError(t, err)
+		assert.NoError(t, format.Validate(valid))
+
+		otherPrefix, err := time.ActorIDFromHex("0c0d000000000001")
+		assert.NoError(t, err)
+		assert.ErrorIs(t, format.Validate(otherPrefix), types.ErrActorIDFormatMismatch)
+
+		otherSize, err := time.ActorIDFromHex("0a0b00000000000000000001")
+		assert.NoError(t, err)
+		assert.ErrorIs(t, format.Validate(otherSize), types.ErrActorIDFormatMismatch)
+	})
+}
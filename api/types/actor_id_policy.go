@@ -0,0 +1,36 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package types
+
+// The policies to assign actor IDs to the clients of a project. The actor ID
+// of a client is its client ID, and every actor that has edited a document is
+// recorded in the version vectors of the document until it is pruned.
+const (
+	// ActorIDPerSession assigns actor IDs by the keys of the clients, which
+	// the SDKs generate for each session. It is the default. The sessions do
+	// not share an actor ID, but the version vectors of the documents grow
+	// with every session of the recurring users until the deactivated actors
+	// are pruned.
+	ActorIDPerSession = "per-session"
+
+	// ActorIDStable reuses the actor ID of the previous sessions of the same
+	// external identity given by the client. It curbs the growth of the
+	// version vectors for the recurring users. However, the concurrent
+	// sessions of the same identity share an actor ID, so an identity must not
+	// edit a document from more than one session at a time, and the identity
+	// should be verified by the authorization webhook.
+	ActorIDStable = "stable"
+)
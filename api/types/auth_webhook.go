@@ -35,6 +35,20 @@ const (
 	ReadWrite VerbType = "rw"
 )
 
+// OperationKind represents a category of operations taken on the document.
+type OperationKind string
+
+const (
+	// ReadOperation represents reading the given document.
+	ReadOperation OperationKind = "read"
+
+	// WriteOperation represents writing changes to the given document.
+	WriteOperation OperationKind = "write"
+
+	// PresenceOperation represents updating the presence of the given document.
+	PresenceOperation OperationKind = "presence"
+)
+
 var (
 	// ErrInvalidWebhookRequest is returned when the given webhook request is not valid.
 	ErrInvalidWebhookRequest = errors.New("invalid authorization webhook request")
@@ -50,10 +64,18 @@ type Method string
 const (
 	ActivateClient   Method = "ActivateClient"
 	DeactivateClient Method = "DeactivateClient"
+	CreateDocument   Method = "CreateDocument"
 	AttachDocument   Method = "AttachDocument"
 	DetachDocument   Method = "DetachDocument"
 	PushPull         Method = "PushPull"
 	WatchDocuments   Method = "WatchDocuments"
+	UpdatePresence   Method = "UpdatePresence"
+
+	// ReauthorizeDocument is not an RPC but the method of the requests sent
+	// by the server to re-evaluate the access of the clients attaching a
+	// document, e.g. after its ownership is transferred. The requests have
+	// no token, and the client is identified by its ID and key.
+	ReauthorizeDocument Method = "ReauthorizeDocument"
 )
 
 // IsAuthMethod returns whether the given method can be used for authorization.
@@ -71,17 +93,32 @@ func AuthMethods() []Method {
 	return []Method{
 		ActivateClient,
 		DeactivateClient,
+		CreateDocument,
 		AttachDocument,
 		DetachDocument,
 		PushPull,
 		WatchDocuments,
+		UpdatePresence,
+		ReauthorizeDocument,
 	}
 }
 
 // AccessAttribute represents an access attribute.
 type AccessAttribute struct {
-	Key  string   `json:"key"`
-	Verb VerbType `json:"verb"`
+	Key        string          `json:"key"`
+	Verb       VerbType        `json:"verb"`
+	Operations []OperationKind `json:"operations"`
+}
+
+// HasOperation returns whether the given operation kind is requested by this
+// attribute.
+func (a AccessAttribute) HasOperation(kind OperationKind) bool {
+	for _, op := range a.Operations {
+		if op == kind {
+			return true
+		}
+	}
+	return false
 }
 
 // AccessInfo represents an access information.
@@ -90,11 +127,27 @@ type AccessInfo struct {
 	Attributes []AccessAttribute
 }
 
+// HasOperation returns whether the given operation kind is requested by any
+// attribute of this access.
+func (i *AccessInfo) HasOperation(kind OperationKind) bool {
+	for _, attr := range i.Attributes {
+		if attr.HasOperation(kind) {
+			return true
+		}
+	}
+	return false
+}
+
 // AuthWebhookRequest represents the request of authentication webhook.
 type AuthWebhookRequest struct {
 	Token      string            `json:"token"`
 	Method     Method            `json:"method"`
 	Attributes []AccessAttribute `json:"attributes"`
+
+	// ClientID and ClientKey identify the client for ReauthorizeDocument,
+	// which is sent without the token of the client.
+	ClientID  string `json:"client_id,omitempty"`
+	ClientKey string `json:"client_key,omitempty"`
 }
 
 // NewAuthWebhookRequest creates a new instance of AuthWebhookRequest.
@@ -0,0 +1,31 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+// ChangeReplay is the result of replaying the changes of another system as
+// the history of a document.
+type ChangeReplay struct {
+	// ServerSeq is the server sequence of the document after the replay.
+	ServerSeq uint64 `json:"server_seq"`
+
+	// Replayed is the number of the changes stored by the replay.
+	Replayed int `json:"replayed"`
+
+	// Skipped is the number of the changes skipped since they had already
+	// been stored, e.g. by a previous attempt of the replay.
+	Skipped int `json:"skipped"`
+}
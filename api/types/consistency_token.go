@@ -0,0 +1,88 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ConsistencyTokenVersion is the version of the scheme of consistency tokens.
+const ConsistencyTokenVersion = 1
+
+// ErrInvalidConsistencyToken is returned when the given consistency token is
+// malformed or issued for another document.
+var ErrInvalidConsistencyToken = errors.New("invalid consistency token")
+
+// ConsistencyToken is the version of a document observed by a client after
+// its write. It is passed to clients as an opaque string, and clients pass it
+// back to read their own writes from any node of the cluster.
+type ConsistencyToken struct {
+	// Version is the version of the scheme of the token.
+	Version int `json:"v"`
+
+	// DocumentID is the ID of the document written.
+	DocumentID ID `json:"d"`
+
+	// ServerSeq is the server sequence of the document after the write.
+	ServerSeq uint64 `json:"s"`
+}
+
+// NewConsistencyToken creates a new instance of ConsistencyToken of the
+// current version.
+func NewConsistencyToken(docID ID, serverSeq uint64) *ConsistencyToken {
+	return &ConsistencyToken{
+		Version:    ConsistencyTokenVersion,
+		DocumentID: docID,
+		ServerSeq:  serverSeq,
+	}
+}
+
+// Encode returns the opaque string of this token.
+func (t *ConsistencyToken) Encode() (string, error) {
+	content, err := json.Marshal(t)
+	if err != nil {
+		return "", fmt.Errorf("encode consistency token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(content), nil
+}
+
+// DecodeConsistencyToken decodes the given opaque string of a token of the
+// given document. It returns ErrInvalidConsistencyToken if the token is
+// malformed or issued for another document.
+func DecodeConsistencyToken(token string, docID ID) (*ConsistencyToken, error) {
+	content, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("decode %q: %w", token, ErrInvalidConsistencyToken)
+	}
+
+	consistencyToken := &ConsistencyToken{}
+	if err := json.Unmarshal(content, consistencyToken); err != nil {
+		return nil, fmt.Errorf("decode %q: %w", token, ErrInvalidConsistencyToken)
+	}
+	if consistencyToken.Version != ConsistencyTokenVersion {
+		return nil, fmt.Errorf("version %d: %w", consistencyToken.Version, ErrInvalidConsistencyToken)
+	}
+	if consistencyToken.DocumentID != docID {
+		return nil, fmt.Errorf("document %s: %w", consistencyToken.DocumentID, ErrInvalidConsistencyToken)
+	}
+
+	return consistencyToken, nil
+}
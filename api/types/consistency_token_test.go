@@ -0,0 +1,58 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+)
+
+func TestConsistencyToken(t *testing.T) {
+	docID := types.ID("6400a1b2c3d4e5f6a7b8c9d0")
+
+	t.Run("encode and decode test", func(t *testing.T) {
+		encoded, err := types.NewConsistencyToken(docID, 10).Encode()
+		assert.NoError(t, err)
+
+		decoded, err := types.DecodeConsistencyToken(encoded, docID)
+		assert.NoError(t, err)
+		assert.Equal(t, types.ConsistencyTokenVersion, decoded.Version)
+		assert.Equal(t, uint64(10), decoded.ServerSeq)
+	})
+
+	t.Run("malformed token test", func(t *testing.T) {
+		for _, encoded := range []string{
+			"not a token",
+			base64.RawURLEncoding.EncodeToString([]byte("not json")),
+			base64.RawURLEncoding.EncodeToString([]byte(`{"v":2,"d":"6400a1b2c3d4e5f6a7b8c9d0","s":1}`)),
+		} {
+			_, err := types.DecodeConsistencyToken(encoded, docID)
+			assert.ErrorIs(t, err, types.ErrInvalidConsistencyToken, encoded)
+		}
+	})
+
+	t.Run("token of another document test", func(t *testing.T) {
+		encoded, err := types.NewConsistencyToken("6400a1b2c3d4e5f6a7b8c9d1", 10).Encode()
+		assert.NoError(t, err)
+		_, err = types.DecodeConsistencyToken(encoded, docID)
+		assert.ErrorIs(t, err, types.ErrInvalidConsistencyToken)
+	})
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+const (
+	// DefaultDocumentAnalysisDepth is the depth of the subtrees reported by
+	// the analysis of a document if the depth is not given, which reports
+	// the top-level subtrees.
+	DefaultDocumentAnalysisDepth = 1
+
+	// MaxDocumentAnalysisDepth is the max depth of the subtrees reported by
+	// the analysis of a document. Deeper depths are capped to it.
+	MaxDocumentAnalysisDepth = 8
+
+	// MaxDocumentAnalysisSubtrees is the max number of the subtrees reported
+	// by the analysis of a document. The largest ones are reported.
+	MaxDocumentAnalysisSubtrees = 1000
+)
+
+// DocumentAnalysis is the breakdown of the size of a document by its
+// subtrees, measured in the bytes of its snapshot.
+type DocumentAnalysis struct {
+	// ServerSeq is the server sequence of the version of the document
+	// analyzed.
+	ServerSeq uint64 `json:"server_seq"`
+
+	// Subtrees is the sizes of the subtrees of the live elements up to the
+	// depth, in descending order of bytes. The first one is the root.
+	Subtrees []*SubtreeSize `json:"subtrees"`
+
+	// Truncated is whether the smaller subtrees are omitted since there are
+	// more than MaxDocumentAnalysisSubtrees.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// SubtreeSize is the size of a subtree of a document.
+type SubtreeSize struct {
+	// Path is the JSON path of the subtree, e.g. "$.todos.0".
+	Path string `json:"path"`
+
+	// Bytes is the bytes of the subtree in the snapshot, including the key
+	// of the subtree in its parent and its tombstones.
+	Bytes int64 `json:"bytes"`
+
+	// TombstoneBytes is the bytes of the removed elements and text nodes in
+	// the subtree, which are kept until they are garbage collected.
+	TombstoneBytes int64 `json:"tombstone_bytes"`
+}
+
+// LiveBytes returns the bytes of the subtree except its tombstones.
+func (s *SubtreeSize) LiveBytes() int64 {
+	return s.Bytes - s.TombstoneBytes
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package types
+
+import "time"
+
+// DocumentClientEventType represents the type of the event of a client on a
+// document.
+type DocumentClientEventType string
+
+const (
+	// DocumentAttachedByClientEvent is an event indicating that a client has
+	// attached the document.
+	DocumentAttachedByClientEvent DocumentClientEventType = "attached"
+
+	// DocumentDetachedByClientEvent is an event indicating that a client has
+	// detached the document.
+	DocumentDetachedByClientEvent DocumentClientEventType = "detached"
+
+	// DocumentOwnershipTransferredByServerEvent is an event indicating that
+	// the ownership of the document has been transferred while the client
+	// attaches it, and the client is re-authorized.
+	DocumentOwnershipTransferredByServerEvent DocumentClientEventType = "ownership-transferred"
+
+	// DocumentDetachedByServerEvent is an event indicating that the server
+	// has detached the document from the client, because the client is no
+	// longer permitted to access it.
+	DocumentDetachedByServerEvent DocumentClientEventType = "detached-by-server"
+)
+
+// DocumentClientEvent is an event of a client on a document, which is kept for
+// the retention period of the server to trace the history of clients.
+type DocumentClientEvent struct {
+	// ID is the unique ID of the event.
+	ID ID `json:"id"`
+
+	// ClientID is the ID of the client which caused the event.
+	ClientID ID `json:"client_id"`
+
+	// Type is the type of the event.
+	Type DocumentClientEventType `json:"type"`
+
+	// CreatedAt is the time when the event occurred.
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// DocumentEventLog is an entry of the event log of a document, which records
+// a change applied to the document with the state of the document after it.
+// Comparing the fingerprints of the entries with the ones computed by a
+// replica pinpoints the change where the replica diverged.
+type DocumentEventLog struct {
+	// ServerSeq is the server sequence of the change.
+	ServerSeq uint64 `json:"server_seq"`
+
+	// ActorID is the ID of the actor of the change.
+	ActorID ID `json:"actor_id"`
+
+	// ClientSeq is the client sequence of the change.
+	ClientSeq uint32 `json:"client_seq"`
+
+	// Lamport is the Lamport timestamp of the change.
+	Lamport uint64 `json:"lamport"`
+
+	// OperationCount is the number of the operations of the change.
+	OperationCount int `json:"operation_count"`
+
+	// VersionVector is the version vector of the document after the change.
+	VersionVector time.VersionVector `json:"version_vector"`
+
+	// Fingerprint is the fingerprint of the document after the change.
+	Fingerprint string `json:"fingerprint"`
+
+	// CreatedAt is the time when the change is applied.
+	CreatedAt gotime.Time `json:"created_at"`
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package types
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+var (
+	// ErrInvalidDocumentKey is returned when the given document key violates
+	// the document key policy of the project.
+	ErrInvalidDocumentKey = errors.New("invalid document key")
+
+	// ErrInvalidDocumentKeyPolicy is returned when the given document key
+	// policy is malformed.
+	ErrInvalidDocumentKeyPolicy = errors.New("invalid document key policy")
+)
+
+// DocumentKeyPolicy is a policy that document keys of a project must conform
+// to. The zero value allows every key, which is the same as the behavior
+// before the policy was introduced.
+type DocumentKeyPolicy struct {
+	// AllowedCharset is the set of characters allowed in document keys. It is
+	// written as the body of a regular expression character class, e.g.
+	// `a-z0-9\-._~` or `\p{L}\p{N}`. Empty means all characters are allowed.
+	AllowedCharset string `json:"allowed_charset" bson:"allowed_charset"`
+
+	// MaxLength is the maximum number of characters(runes) of document keys.
+	// Zero means unlimited.
+	MaxLength int `json:"max_length" bson:"max_length"`
+
+	// DisallowedPrefixes is the prefixes that document keys must not start
+	// with.
+	DisallowedPrefixes []string `json:"disallowed_prefixes" bson:"disallowed_prefixes"`
+}
+
+// IsEmpty returns whether the policy has no rules.
+func (p *DocumentKeyPolicy) IsEmpty() bool {
+	return p.AllowedCharset == "" && p.MaxLength == 0 && len(p.DisallowedPrefixes) == 0
+}
+
+// Verify checks that the policy itself is well-formed.
+func (p *DocumentKeyPolicy) Verify() error {
+	if p.MaxLength < 0 {
+		return fmt.Errorf("max length %d is negative: %w", p.MaxLength, ErrInvalidDocumentKeyPolicy)
+	}
+
+	if _, err := p.charsetRegex(); err != nil {
+		return fmt.Errorf("allowed charset %q: %w", p.AllowedCharset, ErrInvalidDocumentKeyPolicy)
+	}
+
+	for _, prefix := range p.DisallowedPrefixes {
+		if prefix == "" {
+			return fmt.Errorf("empty disallowed prefix: %w", ErrInvalidDocumentKeyPolicy)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks that the given key conforms to the policy. The returned
+// error describes the violated rule.
+func (p *DocumentKeyPolicy) Validate(k key.Key) error {
+	if p == nil || p.IsEmpty() {
+		return nil
+	}
+
+	str := k.String()
+	if !utf8.ValidString(str) {
+		return fmt.Errorf("key %q is not valid UTF-8: %w", str, ErrInvalidDocumentKey)
+	}
+
+	if p.MaxLength > 0 {
+		if length := utf8.RuneCountInString(str); length > p.MaxLength {
+			return fmt.Errorf(
+				"key length %d exceeds max length %d: %w",
+				length,
+				p.MaxLength,
+				ErrInvalidDocumentKey,
+			)
+		}
+	}
+
+	if p.AllowedCharset != "" {
+		regex, err := p.charsetRegex()
+		if err != nil {
+			return fmt.Errorf("allowed charset %q: %w", p.AllowedCharset, ErrInvalidDocumentKeyPolicy)
+		}
+
+		for _, r := range str {
+			if !regex.MatchString(string(r)) {
+				return fmt.Errorf(
+					"key contains %q that is not in allowed charset %q: %w",
+					r,
+					p.AllowedCharset,
+					ErrInvalidDocumentKey,
+				)
+			}
+		}
+	}
+
+	for _, prefix := range p.DisallowedPrefixes {
+		if strings.HasPrefix(str, prefix) {
+			return fmt.Errorf("key has disallowed prefix %q: %w", prefix, ErrInvalidDocumentKey)
+		}
+	}
+
+	return nil
+}
+
+// DeepCopy returns a deep copy of the policy.
+func (p *DocumentKeyPolicy) DeepCopy() DocumentKeyPolicy {
+	var prefixes []string
+	if p.DisallowedPrefixes != nil {
+		prefixes = make([]string, len(p.DisallowedPrefixes))
+		copy(prefixes, p.DisallowedPrefixes)
+	}
+
+	return DocumentKeyPolicy{
+		AllowedCharset:     p.AllowedCharset,
+		MaxLength:          p.MaxLength,
+		DisallowedPrefixes: prefixes,
+	}
+}
+
+// charsetRegex returns the regular expression that matches a single character
+// of the allowed charset.
+func (p *DocumentKeyPolicy) charsetRegex() (*regexp.Regexp, error) {
+	if p.AllowedCharset == "" {
+		return nil, nil
+	}
+
+	return regexp.Compile("^(?:[" + p.AllowedCharset + "])$")
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+func TestDocumentKeyPolicy(t *testing.T) {
+	t.Run("empty policy test", func(t *testing.T) {
+		policy := &types.DocumentKeyPolicy{}
+		assert.True(t, policy.IsEmpty())
+		assert.NoError(t, policy.Verify())
+
+		// NOTE: the empty policy allows every key to keep the existing behavior.
+		for _, k := range []string{"", " ", "a/b?c#d", "한글", "\xff", strings.Repeat("a", 1000)} {
+			assert.NoError(t, policy.Validate(key.Key(k)))
+		}
+
+		var nilPolicy *types.DocumentKeyPolicy
+		assert.NoError(t, nilPolicy.Validate("any key"))
+	})
+
+	t.Run("max length test", func(t *testing.T) {
+		policy := &types.DocumentKeyPolicy{MaxLength: 3}
+		assert.NoError(t, policy.Validate("abc"))
+		assert.ErrorIs(t, policy.Validate("abcd"), types.ErrInvalidDocumentKey)
+
+		// the length is counted in characters, not in bytes.
+		assert.NoError(t, policy.Validate("한글키"))
+		assert.ErrorIs(t, policy.Validate("한글문서"), types.ErrInvalidDocumentKey)
+		assert.NoError(t, policy.Validate("é"))
+	})
+
+	t.Run("allowed charset test", func(t *testing.T) {
+		policy := &types.DocumentKeyPolicy{AllowedCharset: `a-z0-9\-._~`}
+		assert.NoError(t, policy.Validate("doc-1.v2_~"))
+
+		err := policy.Validate("Doc")
+		assert.ErrorIs(t, err, types.ErrInvalidDocumentKey)
+		assert.Contains(t, err.Error(), `'D'`)
+
+		for _, k := range []string{"a b", " ab", "ab ", "a\tb", "a\nb", "a b", "a/b", "문서"} {
+			assert.ErrorIs(t, policy.Validate(key.Key(k)), types.ErrInvalidDocumentKey, k)
+		}
+
+		unicodePolicy := &types.DocumentKeyPolicy{AllowedCharset: `\p{L}\p{N}\-`}
+		assert.NoError(t, unicodePolicy.Validate("문서-1"))
+		assert.NoError(t, unicodePolicy.Validate("ドキュメント"))
+		assert.ErrorIs(t, unicodePolicy.Validate("문서 1"), types.ErrInvalidDocumentKey)
+		assert.ErrorIs(t, unicodePolicy.Validate("문서　"), types.ErrInvalidDocumentKey)
+	})
+
+	t.Run("invalid UTF-8 test", func(t *testing.T) {
+		policy := &types.DocumentKeyPolicy{MaxLength: 10}
+		assert.ErrorIs(t, policy.Validate("a\xffb"), types.ErrInvalidDocumentKey)
+	})
+
+	t.Run("disallowed prefixes test", func(t *testing.T) {
+		policy := &types.DocumentKeyPolicy{DisallowedPrefixes: []string{"$", "_internal"}}
+		assert.NoError(t, policy.Validate("doc$"))
+		assert.NoError(t, policy.Validate("internal"))
+
+		err := policy.Validate("_internal-doc")
+		assert.ErrorIs(t, err, types.ErrInvalidDocumentKey)
+		assert.Contains(t, err.Error(), `"_internal"`)
+		assert.ErrorIs(t, policy.Validate("$doc"), types.ErrInvalidDocumentKey)
+	})
+
+	t.Run("verify test", func(t *testing.T) {
+		assert.ErrorIs(t, (&types.DocumentKeyPolicy{MaxLength: -1}).Verify(), types.ErrInvalidDocumentKeyPolicy)
+		assert.ErrorIs(t, (&types.DocumentKeyPolicy{AllowedCharset: `z-a`}).Verify(), types.ErrInvalidDocumentKeyPolicy)
+		assert.ErrorIs(t, (&types.DocumentKeyPolicy{DisallowedPrefixes: []string{""}}).Verify(), types.ErrInvalidDocumentKeyPolicy)
+		assert.NoError(t, (&types.DocumentKeyPolicy{AllowedCharset: `a-z`, MaxLength: 10}).Verify())
+	})
+}
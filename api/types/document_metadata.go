@@ -0,0 +1,127 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package types
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+const (
+	// DocumentOwnerMetadataKey is the metadata key of the owner of a document.
+	DocumentOwnerMetadataKey = "owner"
+
+	// DocumentGCIntervalMetadataKey is the metadata key of the interval of
+	// the garbage collection of a document, which overrides the interval of
+	// housekeeping for the document.
+	DocumentGCIntervalMetadataKey = "gc_interval"
+
+	// MinDocumentGCInterval is the minimum GC interval of a document.
+	MinDocumentGCInterval = 10 * time.Second
+
+	// MaxDocumentGCInterval is the maximum GC interval of a document.
+	MaxDocumentGCInterval = 24 * time.Hour
+
+	// MaxDocumentMetadataEntries is the maximum number of metadata entries of
+	// a document.
+	MaxDocumentMetadataEntries = 32
+
+	// MaxDocumentMetadataKeyLen is the maximum length of a metadata key.
+	MaxDocumentMetadataKeyLen = 64
+
+	// MaxDocumentMetadataValueLen is the maximum length of a metadata value.
+	MaxDocumentMetadataValueLen = 256
+)
+
+// ErrInvalidDocumentMetadata is returned when the metadata of a document
+// exceeds the limits or has an invalid key.
+var ErrInvalidDocumentMetadata = errors.New("invalid document metadata")
+
+// metadataKeyRegex is the pattern of metadata keys. Keys are used as field
+// names when filtering documents, so only a safe set of characters is allowed.
+var metadataKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// IsValidDocumentMetadataKey returns whether the given key can be used as a
+// metadata key of a document.
+func IsValidDocumentMetadataKey(k string) bool {
+	return len(k) <= MaxDocumentMetadataKeyLen && metadataKeyRegex.MatchString(k)
+}
+
+// ValidateDocumentMetadata validates the given metadata of a document.
+func ValidateDocumentMetadata(metadata map[string]string) error {
+	if len(metadata) > MaxDocumentMetadataEntries {
+		return fmt.Errorf(
+			"%d entries exceed %d: %w",
+			len(metadata),
+			MaxDocumentMetadataEntries,
+			ErrInvalidDocumentMetadata,
+		)
+	}
+
+	for k, v := range metadata {
+		if !IsValidDocumentMetadataKey(k) {
+			return fmt.Errorf("key %q: %w", k, ErrInvalidDocumentMetadata)
+		}
+		if len(v) > MaxDocumentMetadataValueLen {
+			return fmt.Errorf(
+				"value of %q exceeds %d bytes: %w",
+				k,
+				MaxDocumentMetadataValueLen,
+				ErrInvalidDocumentMetadata,
+			)
+		}
+	}
+
+	if _, err := ParseDocumentGCInterval(metadata); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ParseDocumentGCInterval returns the GC interval of a document set in the
+// given metadata. It returns 0 if the interval is not set.
+func ParseDocumentGCInterval(metadata map[string]string) (time.Duration, error) {
+	v, ok := metadata[DocumentGCIntervalMetadataKey]
+	if !ok {
+		return 0, nil
+	}
+
+	interval, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"%s %q: %w",
+			DocumentGCIntervalMetadataKey,
+			v,
+			ErrInvalidDocumentMetadata,
+		)
+	}
+	if interval < MinDocumentGCInterval || interval > MaxDocumentGCInterval {
+		return 0, fmt.Errorf(
+			"%s %s out of [%s, %s]: %w",
+			DocumentGCIntervalMetadataKey,
+			interval,
+			MinDocumentGCInterval,
+			MaxDocumentGCInterval,
+			ErrInvalidDocumentMetadata,
+		)
+	}
+
+	return interval, nil
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package types_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+)
+
+func TestValidateDocumentMetadata(t *testing.T) {
+	t.Run("valid metadata test", func(t *testing.T) {
+		assert.NoError(t, types.ValidateDocumentMetadata(nil))
+		assert.NoError(t, types.ValidateDocumentMetadata(map[string]string{
+			"owner":    "alice",
+			"category": "",
+			"tag_1-a":  strings.Repeat("v", types.MaxDocumentMetadataValueLen),
+		}))
+	})
+
+	t.Run("invalid key test", func(t *testing.T) {
+		for _, k := range []string{"", "a.b", "$owner", strings.Repeat("k", types.MaxDocumentMetadataKeyLen+1)} {
+			err := types.ValidateDocumentMetadata(map[string]string{k: "v"})
+			assert.ErrorIs(t, err, types.ErrInvalidDocumentMetadata, k)
+		}
+	})
+
+	t.Run("exceeding limits test", func(t *testing.T) {
+		err := types.ValidateDocumentMetadata(map[string]string{
+			"owner": strings.Repeat("v", types.MaxDocumentMetadataValueLen+1),
+		})
+		assert.ErrorIs(t, err, types.ErrInvalidDocumentMetadata)
+
+		metadata := make(map[string]string)
+		for i := 0; i <= types.MaxDocumentMetadataEntries; i++ {
+			metadata[fmt.Sprintf("k%d", i)] = "v"
+		}
+		assert.ErrorIs(t, types.ValidateDocumentMetadata(metadata), types.ErrInvalidDocumentMetadata)
+	})
+
+	t.Run("gc interval test", func(t *testing.T) {
+		interval, err := types.ParseDocumentGCInterval(map[string]string{"owner": "alice"})
+		assert.NoError(t, err)
+		assert.Equal(t, time.Duration(0), interval)
+
+		interval, err = types.ParseDocumentGCInterval(map[string]string{
+			types.DocumentGCIntervalMetadataKey: "1m",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, time.Minute, interval)
+
+		for _, v := range []string{"", "fast", "1s", "48h"} {
+			err := types.ValidateDocumentMetadata(map[string]string{
+				types.DocumentGCIntervalMetadataKey: v,
+			})
+			assert.ErrorIs(t, err, types.ErrInvalidDocumentMetadata, v)
+		}
+	})
+}
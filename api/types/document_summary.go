@@ -25,4 +25,47 @@ type DocumentSummary struct {
 
 	// Snapshot is the string representation of the document.
 	Snapshot string
+
+	// Metadata is the metadata of the document set by the admin.
+	Metadata map[string]string
+
+	// ArchivedAt is the time when the document is archived. It is zero if the
+	// document is not archived.
+	ArchivedAt time.Time
+
+	// OperationCounts is the number of the operations applied to the document
+	// over its lifetime, keyed by the kind of the operation, e.g. "set".
+	OperationCounts map[string]int64
+
+	// RemovedAt is the time when the document is removed. It is zero if the
+	// document is not removed.
+	RemovedAt time.Time
+}
+
+// DocumentDetail represents a summary of document with its status on the
+// server.
+type DocumentDetail struct {
+	// Summary is the summary of the document.
+	Summary *DocumentSummary
+
+	// ServerSeq is the server sequence of the last change of the document.
+	// It is the current checkpoint of the document on the server.
+	ServerSeq uint64
+
+	// SnapshotServerSeq is the server sequence of the latest snapshot of the
+	// document. It is 0 if there is no snapshot.
+	SnapshotServerSeq uint64
+
+	// AttachedClients is the number of the clients attaching the document.
+	AttachedClients int
+
+	// ChangesSinceCheckpoint is the number of the changes after the server
+	// sequence of the checkpoint given by the client. It is 0 if no checkpoint
+	// is given.
+	ChangesSinceCheckpoint uint64
+
+	// GCInterval is the effective GC interval of the document. It is the GC
+	// interval in the metadata of the document if it is set, or the interval
+	// of housekeeping otherwise.
+	GCInterval time.Duration
 }
@@ -0,0 +1,108 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	// ErrMissingTemplateVariables is returned when the variables of the
+	// placeholders of a document template are not given.
+	ErrMissingTemplateVariables = errors.New("missing template variables")
+
+	// ErrInvalidDocumentTemplate is returned when the given document template
+	// is not a JSON object.
+	ErrInvalidDocumentTemplate = errors.New("invalid document template")
+)
+
+// placeholderRegex matches the placeholders of document templates such as
+// "{{title}}".
+var placeholderRegex = regexp.MustCompile(`{{\s*([A-Za-z_][A-Za-z0-9_]*)\s*}}`)
+
+// RenderDocumentTemplate substitutes the placeholders in the given document
+// template with the given variables, and returns the JSON object of the
+// document. Placeholders can be used in the keys and the string values of
+// the template, and the variables are substituted as strings. All the
+// placeholders must be given.
+func RenderDocumentTemplate(template string, variables map[string]string) (string, error) {
+	decoder := json.NewDecoder(strings.NewReader(template))
+	decoder.UseNumber()
+
+	var root map[string]interface{}
+	if err := decoder.Decode(&root); err != nil || root == nil {
+		return "", fmt.Errorf("template must be a JSON object: %w", ErrInvalidDocumentTemplate)
+	}
+
+	missing := make(map[string]bool)
+	substitute := func(str string) string {
+		return placeholderRegex.ReplaceAllStringFunc(str, func(placeholder string) string {
+			name := placeholderRegex.FindStringSubmatch(placeholder)[1]
+			value, ok := variables[name]
+			if !ok {
+				missing[name] = true
+			}
+			return value
+		})
+	}
+
+	rendered := renderValue(root, substitute)
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return "", fmt.Errorf("%s: %w", strings.Join(names, ", "), ErrMissingTemplateVariables)
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(rendered); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// renderValue substitutes the strings in the given decoded JSON value.
+func renderValue(value interface{}, substitute func(string) string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		rendered := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			rendered[substitute(key)] = renderValue(elem, substitute)
+		}
+		return rendered
+	case []interface{}:
+		rendered := make([]interface{}, len(v))
+		for i, elem := range v {
+			rendered[i] = renderValue(elem, substitute)
+		}
+		return rendered
+	case string:
+		return substitute(v)
+	default:
+		return value
+	}
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+)
+
+func TestRenderDocumentTemplate(t *testing.T) {
+	t.Run("render test", func(t *testing.T) {
+		content, err := types.RenderDocumentTemplate(
+			`{"title":"{{title}}","{{ section }}":["by {{author}}",1.50,true,null]}`,
+			map[string]string{
+				"title":   "<Hello \"World\">",
+				"section": "notes",
+				"author":  "alice",
+				"unused":  "ignored",
+			},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"notes":["by alice",1.50,true,null],"title":"<Hello \"World\">"}`, content)
+
+		content, err = types.RenderDocumentTemplate(`{"todos":[]}`, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"todos":[]}`, content)
+	})
+
+	t.Run("missing variables test", func(t *testing.T) {
+		_, err := types.RenderDocumentTemplate(
+			`{"title":"{{title}}","owner":"{{owner}} and {{title}}"}`,
+			map[string]string{"section": "notes"},
+		)
+		assert.ErrorIs(t, err, types.ErrMissingTemplateVariables)
+		assert.Contains(t, err.Error(), "owner, title")
+	})
+
+	t.Run("invalid template test", func(t *testing.T) {
+		for _, template := range []string{`[1,2,3]`, `null`, `{"title":`, `"{{title}}"`} {
+			_, err := types.RenderDocumentTemplate(template, map[string]string{"title": "t"})
+			assert.ErrorIs(t, err, types.ErrInvalidDocumentTemplate, template)
+		}
+	})
+}
@@ -0,0 +1,38 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package types
+
+// DocumentValidation is the result of replaying the change log of a document
+// against its latest snapshot.
+type DocumentValidation struct {
+	// ServerSeq is the server sequence of the snapshot validated.
+	ServerSeq uint64 `json:"server_seq"`
+
+	// Valid is whether the replayed document matches the snapshot.
+	Valid bool `json:"valid"`
+
+	// ExpectedChecksum is the checksum of the snapshot.
+	ExpectedChecksum string `json:"expected_checksum"`
+
+	// ActualChecksum is the checksum of the document replayed from the
+	// change log.
+	ActualChecksum string `json:"actual_checksum"`
+
+	// DivergingPath is the JSON path of the first element where the replayed
+	// document diverges from the snapshot. It is empty if they match.
+	DivergingPath string `json:"diverging_path,omitempty"`
+}
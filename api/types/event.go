@@ -18,4 +18,8 @@ const (
 
 	// PresenceChangedEvent is an event indicating that presence is changed.
 	PresenceChangedEvent DocEventType = "presence-changed"
+
+	// SubtreeRemovedEvent is an event indicating that the watched subtrees of
+	// documents are removed.
+	SubtreeRemovedEvent DocEventType = "subtree-removed"
 )
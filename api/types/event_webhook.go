@@ -0,0 +1,81 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// EventWebhookSignatureHeader is the header of the event webhook request that
+// contains the signature of the payload.
+const EventWebhookSignatureHeader = "X-Yorkie-Signature"
+
+// EventWebhookType represents the type of the event sent to the event webhook.
+type EventWebhookType string
+
+// Belows are the types of events sent to the event webhook.
+const (
+	// DocumentCreatedEvent is sent when a document is created.
+	DocumentCreatedEvent EventWebhookType = "DocumentCreated"
+
+	// DocumentRemovedEvent is sent when a document is removed.
+	DocumentRemovedEvent EventWebhookType = "DocumentRemoved"
+
+	// DocumentFirstAttachedEvent is sent when a document is attached by a
+	// client while no other clients attach it.
+	DocumentFirstAttachedEvent EventWebhookType = "DocumentFirstAttached"
+
+	// DocumentArchivedEvent is sent when a document is archived after the
+	// period of inactivity of the project.
+	DocumentArchivedEvent EventWebhookType = "DocumentArchived"
+
+	// DocumentUnarchivedEvent is sent when an archived document is
+	// unarchived.
+	DocumentUnarchivedEvent EventWebhookType = "DocumentUnarchived"
+
+	// DocumentOwnershipTransferredEvent is sent when the ownership of a
+	// document is transferred.
+	DocumentOwnershipTransferredEvent EventWebhookType = "DocumentOwnershipTransferred"
+)
+
+// EventWebhookRequest represents the payload of the event webhook.
+type EventWebhookRequest struct {
+	Type        EventWebhookType `json:"type"`
+	ProjectName string           `json:"project_name"`
+	DocumentKey string           `json:"document_key"`
+	IssuedAt    time.Time        `json:"issued_at"`
+}
+
+// SignEventWebhookPayload returns the signature of the given payload, which is
+// the hex-encoded HMAC-SHA256 of the payload with the secret key of the
+// project.
+func SignEventWebhookPayload(secretKey string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secretKey))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyEventWebhookSignature returns whether the given signature is valid for
+// the given payload. Receivers of the event webhook can use it to verify that
+// the request is sent by Yorkie.
+func VerifyEventWebhookSignature(secretKey string, payload []byte, signature string) bool {
+	expected := SignEventWebhookPayload(secretKey, payload)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
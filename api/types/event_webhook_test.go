@@ -0,0 +1,40 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+)
+
+func TestEventWebhook(t *testing.T) {
+	t.Run("signature test", func(t *testing.T) {
+		payload := []byte(`{"type":"DocumentCreated"}`)
+		signature := types.SignEventWebhookPayload("secret", payload)
+
+		assert.True(t, types.VerifyEventWebhookSignature("secret", payload, signature))
+		assert.False(t, types.VerifyEventWebhookSignature("other", payload, signature))
+		assert.False(t, types.VerifyEventWebhookSignature(
+			"secret",
+			[]byte(`{"type":"DocumentRemoved"}`),
+			signature,
+		))
+	})
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import "encoding/json"
+
+// ExportedElementType represents the type of the exported element.
+type ExportedElementType string
+
+// The values below are the types of the exported element.
+const (
+	ExportedObject    ExportedElementType = "object"
+	ExportedArray     ExportedElementType = "array"
+	ExportedPrimitive ExportedElementType = "primitive"
+	ExportedCounter   ExportedElementType = "counter"
+	ExportedText      ExportedElementType = "text"
+	ExportedRichText  ExportedElementType = "richText"
+	ExportedTree      ExportedElementType = "tree"
+)
+
+// ExportedValueType represents the type of the value of an exported primitive
+// or counter. Numbers keep their type so that integers and doubles can be
+// told apart when the exported element is read back.
+type ExportedValueType string
+
+// The values below are the value types of the exported element.
+const (
+	ExportedNull    ExportedValueType = "null"
+	ExportedBoolean ExportedValueType = "boolean"
+	ExportedInteger ExportedValueType = "integer"
+	ExportedLong    ExportedValueType = "long"
+	ExportedDouble  ExportedValueType = "double"
+	ExportedString  ExportedValueType = "string"
+	ExportedBytes   ExportedValueType = "bytes"
+	ExportedDate    ExportedValueType = "date"
+)
+
+// ExportedElement is an element of a document exported with its metadata. It
+// is encoded in JSON as below, and the structure is kept stable across
+// versions:
+//
+//	{
+//	  "type": "object",
+//	  "createdAt": "1:1:000000000000000000000001",
+//	  "modifiedAt": "3:2:000000000000000000000002",
+//	  "modifiedBy": "000000000000000000000002",
+//	  "value": {"k1": {"type": "primitive", "valueType": "string", ..., "value": "v1"}}
+//	}
+//
+// The value of an object is a map of its exported members, and the value of an
+// array is a list of its exported elements. The value of the other types is
+// the JSON encoding of the element. Numbers are encoded as they are, so long
+// values beyond 2^53 are kept without precision loss. Tickets are encoded as
+// "lamport:delimiter:actorID".
+type ExportedElement struct {
+	// Type is the type of the element.
+	Type ExportedElementType `json:"type"`
+
+	// ValueType is the type of the value. It is only set for primitives and
+	// counters.
+	ValueType ExportedValueType `json:"valueType,omitempty"`
+
+	// CreatedAt is the ticket when the element was created.
+	CreatedAt string `json:"createdAt"`
+
+	// ModifiedAt is the ticket when the element was modified last.
+	ModifiedAt string `json:"modifiedAt"`
+
+	// ModifiedBy is the ID of the actor who modified the element last.
+	ModifiedBy string `json:"modifiedBy"`
+
+	// Value is the value of the element.
+	Value any `json:"value"`
+}
+
+// Marshal returns the JSON encoding of this exported element.
+func (e *ExportedElement) Marshal() (string, error) {
+	bytes, err := json.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes), nil
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package types
+
+// Feature is a feature of documents that can be enabled or disabled for each
+// project, such as the CRDT types being rolled out.
+type Feature string
+
+const (
+	// FeatureTree is the feature of Tree and its operations.
+	FeatureTree Feature = "tree"
+
+	// FeatureRichText is the feature of RichText and its operations.
+	FeatureRichText Feature = "rich-text"
+)
+
+// defaultFeatures is the features and whether they are enabled for the
+// projects which do not set them. The features already in use are enabled so
+// that the existing projects keep working.
+var defaultFeatures = map[Feature]bool{
+	FeatureTree:     true,
+	FeatureRichText: true,
+}
+
+// IsFeature returns whether the given name is a known feature.
+func IsFeature(name string) bool {
+	_, ok := defaultFeatures[Feature(name)]
+	return ok
+}
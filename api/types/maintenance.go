@@ -0,0 +1,33 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import "time"
+
+// Maintenance is the maintenance mode of the server. While it is enabled,
+// the server rejects writes such as pushing changes and creating documents,
+// but keeps serving reads and watches.
+type Maintenance struct {
+	// Enabled is whether the maintenance mode is enabled.
+	Enabled bool `json:"enabled"`
+
+	// Message is the message returned with the rejected writes.
+	Message string `json:"message"`
+
+	// UpdatedAt is the time when the maintenance mode is updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
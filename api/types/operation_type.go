@@ -0,0 +1,80 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package types
+
+// OperationType is a type of operations that clients submit in changes. It
+// is used to restrict the operations which clients of a project may submit.
+type OperationType string
+
+const (
+	// OperationSet is the type of Set operations.
+	OperationSet OperationType = "set"
+
+	// OperationAdd is the type of Add operations.
+	OperationAdd OperationType = "add"
+
+	// OperationMove is the type of Move operations.
+	OperationMove OperationType = "move"
+
+	// OperationRemove is the type of Remove operations.
+	OperationRemove OperationType = "remove"
+
+	// OperationEdit is the type of Edit operations.
+	OperationEdit OperationType = "edit"
+
+	// OperationSelect is the type of Select operations.
+	OperationSelect OperationType = "select"
+
+	// OperationRichEdit is the type of RichEdit operations.
+	OperationRichEdit OperationType = "rich-edit"
+
+	// OperationStyle is the type of Style operations.
+	OperationStyle OperationType = "style"
+
+	// OperationIncrease is the type of Increase operations.
+	OperationIncrease OperationType = "increase"
+
+	// OperationTreeEdit is the type of TreeEdit operations.
+	OperationTreeEdit OperationType = "tree-edit"
+
+	// OperationTreeStyle is the type of TreeStyle operations.
+	OperationTreeStyle OperationType = "tree-style"
+
+	// OperationClear is the type of Clear operations.
+	OperationClear OperationType = "clear"
+)
+
+// operationTypes is the set of the known operation types.
+var operationTypes = map[OperationType]bool{
+	OperationSet:       true,
+	OperationAdd:       true,
+	OperationMove:      true,
+	OperationRemove:    true,
+	OperationEdit:      true,
+	OperationSelect:    true,
+	OperationRichEdit:  true,
+	OperationStyle:     true,
+	OperationIncrease:  true,
+	OperationTreeEdit:  true,
+	OperationTreeStyle: true,
+	OperationClear:     true,
+}
+
+// IsOperationType returns whether the given name is a known operation type.
+func IsOperationType(name string) bool {
+	return operationTypes[OperationType(name)]
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// PageTokenVersion is the version of the scheme of page tokens. Tokens
+	// of other versions are rejected, so the scheme can be changed without
+	// misreading the tokens issued before.
+	PageTokenVersion = 1
+
+	// PageTokenTTL is the period for which a page token is valid.
+	PageTokenTTL = 24 * time.Hour
+)
+
+// The sort fields of page tokens.
+const (
+	SortByID        = "id"
+	SortByServerSeq = "server_seq"
+)
+
+var (
+	// ErrInvalidPageToken is returned when the given page token is malformed
+	// or issued for another list.
+	ErrInvalidPageToken = errors.New("invalid page token")
+
+	// ErrPageTokenExpired is returned when the given page token is older than
+	// PageTokenTTL.
+	ErrPageTokenExpired = errors.New("page token expired")
+)
+
+// PageToken is the cursor of the next page of a list. It is passed to clients
+// as an opaque string, and clients pass it back verbatim to get the page.
+type PageToken struct {
+	// Version is the version of the scheme of the token.
+	Version int `json:"v"`
+
+	// SortField is the field by which the list is sorted.
+	SortField string `json:"f"`
+
+	// LastValue is the value of the sort field of the last item of the
+	// previous page.
+	LastValue string `json:"l"`
+
+	// IsForward is whether the list is paged in ascending order.
+	IsForward bool `json:"fw,omitempty"`
+
+	// SnapshotAt is the point in time of the view of the list, if any.
+	SnapshotAt time.Time `json:"s"`
+
+	// IssuedAt is the time when the token is issued.
+	IssuedAt time.Time `json:"t"`
+}
+
+// NewPageToken creates a new instance of PageToken of the current version.
+func NewPageToken(sortField, lastValue string, isForward bool) *PageToken {
+	return &PageToken{
+		Version:   PageTokenVersion,
+		SortField: sortField,
+		LastValue: lastValue,
+		IsForward: isForward,
+		IssuedAt:  time.Now(),
+	}
+}
+
+// Encode returns the opaque string of this token.
+func (t *PageToken) Encode() (string, error) {
+	content, err := json.Marshal(t)
+	if err != nil {
+		return "", fmt.Errorf("encode page token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(content), nil
+}
+
+// DecodePageToken decodes the given opaque string of a token of a list
+// sorted by the given field. It returns ErrInvalidPageToken if the token is
+// malformed, and ErrPageTokenExpired if it is older than PageTokenTTL.
+func DecodePageToken(token string, sortField string) (*PageToken, error) {
+	content, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("decode %q: %w", token, ErrInvalidPageToken)
+	}
+
+	pageToken := &PageToken{}
+	if err := json.Unmarshal(content, pageToken); err != nil {
+		return nil, fmt.Errorf("decode %q: %w", token, ErrInvalidPageToken)
+	}
+	if pageToken.Version != PageTokenVersion {
+		return nil, fmt.Errorf("version %d: %w", pageToken.Version, ErrInvalidPageToken)
+	}
+	if pageToken.SortField != sortField || pageToken.LastValue == "" {
+		return nil, fmt.Errorf("sorted by %q: %w", pageToken.SortField, ErrInvalidPageToken)
+	}
+	if time.Since(pageToken.IssuedAt) > PageTokenTTL {
+		return nil, fmt.Errorf("issued at %s: %w", pageToken.IssuedAt, ErrPageTokenExpired)
+	}
+
+	return pageToken, nil
+}
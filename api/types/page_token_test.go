@@ -0,0 +1,69 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types_test
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+)
+
+func TestPageToken(t *testing.T) {
+	t.Run("encode and decode test", func(t *testing.T) {
+		token := types.NewPageToken(types.SortByID, "6400a1b2c3d4e5f6a7b8c9d0", true)
+		token.SnapshotAt = time.Now().Add(-time.Minute)
+		encoded, err := token.Encode()
+		assert.NoError(t, err)
+
+		decoded, err := types.DecodePageToken(encoded, types.SortByID)
+		assert.NoError(t, err)
+		assert.Equal(t, types.PageTokenVersion, decoded.Version)
+		assert.Equal(t, token.LastValue, decoded.LastValue)
+		assert.True(t, decoded.IsForward)
+		assert.True(t, token.SnapshotAt.Equal(decoded.SnapshotAt))
+	})
+
+	t.Run("malformed token test", func(t *testing.T) {
+		for _, encoded := range []string{
+			"not a token",
+			base64.RawURLEncoding.EncodeToString([]byte("not json")),
+			base64.RawURLEncoding.EncodeToString([]byte(`{"v":2,"f":"id","l":"a"}`)),
+		} {
+			_, err := types.DecodePageToken(encoded, types.SortByID)
+			assert.ErrorIs(t, err, types.ErrInvalidPageToken, encoded)
+		}
+
+		encoded, err := types.NewPageToken(types.SortByServerSeq, "10", true).Encode()
+		assert.NoError(t, err)
+		_, err = types.DecodePageToken(encoded, types.SortByID)
+		assert.ErrorIs(t, err, types.ErrInvalidPageToken)
+	})
+
+	t.Run("expired token test", func(t *testing.T) {
+		token := types.NewPageToken(types.SortByID, "6400a1b2c3d4e5f6a7b8c9d0", false)
+		token.IssuedAt = time.Now().Add(-types.PageTokenTTL - time.Minute)
+		encoded, err := token.Encode()
+		assert.NoError(t, err)
+
+		_, err = types.DecodePageToken(encoded, types.SortByID)
+		assert.ErrorIs(t, err, types.ErrPageTokenExpired)
+	})
+}
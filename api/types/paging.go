@@ -17,9 +17,21 @@
 
 package types
 
+import "time"
+
 // Paging is the paging information for the document.
 type Paging[T any] struct {
 	Offset    T
 	PageSize  int
 	IsForward bool
+
+	// SnapshotAt is the point in time of the view to page through. Pages
+	// with the same SnapshotAt only contain the documents which existed at
+	// that time, so a full pass does not skip documents removed or contain
+	// documents created during the pass. Zero means the latest view.
+	SnapshotAt time.Time
+
+	// Metadata limits the documents to the ones which have all the given
+	// metadata entries. Empty means no limit.
+	Metadata map[string]string
 }
@@ -17,7 +17,12 @@
 
 package types
 
-import "time"
+import (
+	"strings"
+	"time"
+
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
 
 // Project is a project that consists of multiple documents and clients.
 // It allows developers to work on the same cluster.
@@ -34,12 +39,89 @@ type Project struct {
 	// AuthWebhookMethods is the methods that run the authorization webhook.
 	AuthWebhookMethods []string `json:"auth_webhook_methods"`
 
+	// DocumentKeyPolicy is the policy that document keys of this project must
+	// conform to.
+	DocumentKeyPolicy DocumentKeyPolicy `json:"document_key_policy"`
+
+	// CollectApplyLag is whether to collect the lag between the creation of
+	// operations on clients and the reception on the server.
+	CollectApplyLag bool `json:"collect_apply_lag"`
+
+	// InitialContent is the JSON object that new documents of this project
+	// start from.
+	InitialContent string `json:"initial_content"`
+
+	// ObjectMergePolicy is the policy to resolve concurrent writes to the same
+	// key of Objects in documents of this project. Empty means "lww".
+	ObjectMergePolicy string `json:"object_merge_policy"`
+
+	// EventWebhookURL is the url of the webhook that receives the lifecycle
+	// events of documents of this project. Empty means disabled.
+	EventWebhookURL string `json:"event_webhook_url"`
+
+	// EphemeralKeyPrefix is the key prefix of the ephemeral documents of this
+	// project. The state of ephemeral documents is kept only in the memory of
+	// the server and discarded when the last client detaches. Empty means
+	// disabled.
+	EphemeralKeyPrefix string `json:"ephemeral_key_prefix"`
+
+	// ChangefeedURL is the url of the message sink that receives the changes
+	// committed to documents of this project, e.g. "nats://host:4222/subject".
+	// Empty means disabled.
+	ChangefeedURL string `json:"changefeed_url"`
+
+	// Features is whether the features of documents are enabled for this
+	// project. The features not in it follow their defaults.
+	Features map[string]bool `json:"features"`
+
+	// ArchiveAfter is the period of inactivity after which documents of this
+	// project are archived by housekeeping, e.g. "720h". Empty means
+	// disabled.
+	ArchiveAfter string `json:"archive_after"`
+
+	// DocumentTemplates is the templates of documents of this project by
+	// their IDs. A template is a JSON object which may contain placeholders
+	// such as "{{title}}" in its keys and string values.
+	DocumentTemplates map[string]string `json:"document_templates"`
+
+	// ExplicitDocumentCreation is whether documents of this project must be
+	// created explicitly before they are attached. If it is set, attaching a
+	// document that does not exist fails with NotFound.
+	ExplicitDocumentCreation bool `json:"explicit_document_creation"`
+
+	// ActorIDPolicy is the policy to assign actor IDs to the clients of this
+	// project. One of "per-session" and "stable". See ActorIDPerSession and
+	// ActorIDStable for the tradeoffs.
+	ActorIDPolicy string `json:"actor_id_policy"`
+
+	// AllowedOperations is the types of operations that clients of this
+	// project may submit. Empty means that all operations are allowed.
+	AllowedOperations []string `json:"allowed_operations"`
+
+	// SnapshotPolicy is the policy to store the snapshots of documents of this
+	// project. The settings not set follow the defaults of the server.
+	SnapshotPolicy SnapshotPolicy `json:"snapshot_policy"`
+
+	// MaxKeysPerObject is the maximum number of live keys of an Object in
+	// documents of this project. Zero follows the limit of the server.
+	MaxKeysPerObject int `json:"max_keys_per_object"`
+
+	// RemovedDocumentPolicy is the policy of attaching to the key of a removed
+	// document, e.g. "recreate", "reject" and "restore". Empty means
+	// "recreate".
+	RemovedDocumentPolicy string `json:"removed_document_policy"`
+
 	// PublicKey is the API key of this project.
 	PublicKey string `json:"public_key"`
 
 	// SecretKey is the secret key of this project.
 	SecretKey string `json:"secret_key"`
 
+	// DocumentCount is the number of documents of this project, excluding the
+	// removed ones. It is only filled in the list of projects, and it may be
+	// stale by up to the TTL of the document count cache of the server.
+	DocumentCount int `json:"document_count"`
+
 	// CreatedAt is the time when the project was created.
 	CreatedAt time.Time `json:"created_at"`
 
@@ -65,3 +147,62 @@ func (p *Project) RequireAuth(method Method) bool {
 
 	return false
 }
+
+// IsEphemeralDocument returns whether the document of the given key is
+// ephemeral in this project.
+func (p *Project) IsEphemeralDocument(k key.Key) bool {
+	return p.EphemeralKeyPrefix != "" && strings.HasPrefix(k.String(), p.EphemeralKeyPrefix)
+}
+
+// IsFeatureEnabled returns whether the given feature is enabled in this
+// project.
+func (p *Project) IsFeatureEnabled(feature Feature) bool {
+	if enabled, ok := p.Features[string(feature)]; ok {
+		return enabled
+	}
+	return defaultFeatures[feature]
+}
+
+// HasStableActorID returns whether the clients of this project reuse their
+// actor IDs across sessions.
+func (p *Project) HasStableActorID() bool {
+	return p.ActorIDPolicy == ActorIDStable
+}
+
+// RemovedDocumentPolicyOrDefault returns the policy of attaching to the key of
+// a removed document of this project.
+func (p *Project) RemovedDocumentPolicyOrDefault() string {
+	if p.RemovedDocumentPolicy == "" {
+		return RemovedDocumentRecreate
+	}
+	return p.RemovedDocumentPolicy
+}
+
+// IsOperationAllowed returns whether clients of this project may submit
+// operations of the given type.
+func (p *Project) IsOperationAllowed(opType OperationType) bool {
+	if len(p.AllowedOperations) == 0 {
+		return true
+	}
+
+	for _, allowed := range p.AllowedOperations {
+		if OperationType(allowed) == opType {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseArchiveAfter returns the period of inactivity after which documents of
+// this project are archived. Zero means that documents are never archived.
+func (p *Project) ParseArchiveAfter() time.Duration {
+	if p.ArchiveAfter == "" {
+		return 0
+	}
+
+	period, err := time.ParseDuration(p.ArchiveAfter)
+	if err != nil || period < 0 {
+		return 0
+	}
+	return period
+}
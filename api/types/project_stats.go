@@ -0,0 +1,150 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"time"
+
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+// ProjectStats is the statistics of a project measured by the server.
+type ProjectStats struct {
+	// ConflictWins is the number of the concurrent writes won by each actor
+	// in descending order of the wins. They are counted when the server
+	// stores the pushed changes.
+	ConflictWins []*ActorConflictWins `json:"conflict_wins"`
+
+	// HotDocuments is the documents of the project whose change rate exceeds
+	// the threshold of the server, in descending order of the change rate.
+	HotDocuments []*HotDocument `json:"hot_documents"`
+
+	// BackgroundQueues is the queues of the pending snapshot and GC jobs in
+	// the background of the server. They are shared by all the projects.
+	BackgroundQueues []*BackgroundQueue `json:"background_queues"`
+}
+
+// BackgroundQueue is the queue of the pending jobs of a type in the
+// background of the server.
+type BackgroundQueue struct {
+	// Type is the type of the jobs, e.g. "snapshot" and "gc".
+	Type string `json:"type"`
+
+	// Depth is the number of the pending jobs.
+	Depth int `json:"depth"`
+
+	// OldestAge is the time since the oldest pending job is enqueued.
+	OldestAge time.Duration `json:"oldest_age"`
+}
+
+// ActorConflictWins is the number of the concurrent writes to the same key of
+// Objects won by an actor.
+type ActorConflictWins struct {
+	// Actor is the ID of the actor, or "other" for the actors beyond the
+	// limit of the server.
+	Actor string `json:"actor"`
+
+	// Wins is the number of the concurrent writes won by the actor.
+	Wins int `json:"wins"`
+}
+
+// HotDocument is a document whose change rate exceeds the threshold of the
+// server.
+type HotDocument struct {
+	// DocumentKey is the key of the document.
+	DocumentKey key.Key `json:"document_key"`
+
+	// OpsPerSecond is the number of operations pushed to the document per
+	// second in the sliding window.
+	OpsPerSecond float64 `json:"ops_per_second"`
+
+	// TopActorID is the ID of the client which pushed the most operations in
+	// the sliding window. Only the operations pushed after the document became
+	// hot are counted.
+	TopActorID ID `json:"top_actor_id"`
+
+	// TopActorOpsPerSecond is the number of operations pushed by the top
+	// actor per second in the sliding window.
+	TopActorOpsPerSecond float64 `json:"top_actor_ops_per_second"`
+
+	// DetectedAt is the time when the document became hot.
+	DetectedAt time.Time `json:"detected_at"`
+}
+
+// LiveProjectStats is the aggregate statistics of a project measured by a
+// server at a moment. Each server measures them from the clients connected to
+// it and the changes pushed to it.
+type LiveProjectStats struct {
+	// ActiveDocuments is the number of the documents watched by at least one
+	// client.
+	ActiveDocuments int `json:"active_documents"`
+
+	// AttachedClients is the number of the clients watching the documents.
+	AttachedClients int `json:"attached_clients"`
+
+	// OpsPerSecond is the number of operations pushed to the documents per
+	// second in the sliding window of hot documents.
+	OpsPerSecond float64 `json:"ops_per_second"`
+
+	// HotDocuments is the number of the hot documents.
+	HotDocuments int `json:"hot_documents"`
+
+	// ConflictWins is the number of the concurrent writes won by each actor
+	// in descending order of the wins.
+	ConflictWins []*ActorConflictWins `json:"conflict_wins"`
+
+	// MeasuredAt is the time when the statistics are measured.
+	MeasuredAt time.Time `json:"measured_at"`
+}
+
+// ProjectStatsEventType represents the type of the events which change the
+// live statistics of a project significantly.
+type ProjectStatsEventType string
+
+const (
+	// DocumentActivatedEvent means that a client starts watching a document
+	// which no client watched.
+	DocumentActivatedEvent ProjectStatsEventType = "document-activated"
+
+	// DocumentDeactivatedEvent means that the last client watching a document
+	// stops watching it.
+	DocumentDeactivatedEvent ProjectStatsEventType = "document-deactivated"
+
+	// DocumentHotEvent means that a document is detected as hot.
+	DocumentHotEvent ProjectStatsEventType = "document-hot"
+)
+
+// ProjectStatsEvent is an event which changes the live statistics of a
+// project significantly.
+type ProjectStatsEvent struct {
+	// Type is the type of the event.
+	Type ProjectStatsEventType `json:"type"`
+
+	// DocumentKey is the key of the document of the event.
+	DocumentKey key.Key `json:"document_key"`
+}
+
+// ProjectStatsUpdate is an update of the live statistics of a project
+// delivered to the watchers. It is sent periodically and on each event.
+type ProjectStatsUpdate struct {
+	// Event is the event which triggers this update. It is nil for the
+	// periodic updates.
+	Event *ProjectStatsEvent `json:"event,omitempty"`
+
+	// Stats is the live statistics of the project after the event.
+	Stats LiveProjectStats `json:"stats"`
+}
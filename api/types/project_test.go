@@ -18,6 +18,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -49,4 +50,30 @@ func TestProjectInfo(t *testing.T) {
 		}
 		assert.False(t, info3.RequireAuth(types.ActivateClient))
 	})
+	t.Run("ephemeral document test", func(t *testing.T) {
+		info := &types.Project{}
+		assert.False(t, info.IsEphemeralDocument("tmp-board"))
+
+		info.EphemeralKeyPrefix = "tmp-"
+		assert.True(t, info.IsEphemeralDocument("tmp-board"))
+		assert.False(t, info.IsEphemeralDocument("board"))
+	})
+
+	t.Run("feature test", func(t *testing.T) {
+		info := &types.Project{}
+		assert.True(t, info.IsFeatureEnabled(types.FeatureTree))
+		assert.False(t, info.IsFeatureEnabled("unknown"))
+
+		info.Features = map[string]bool{"tree": false}
+		assert.False(t, info.IsFeatureEnabled(types.FeatureTree))
+		assert.True(t, info.IsFeatureEnabled(types.FeatureRichText))
+	})
+
+	t.Run("archive after test", func(t *testing.T) {
+		info := &types.Project{}
+		assert.Equal(t, time.Duration(0), info.ParseArchiveAfter())
+
+		info.ArchiveAfter = "720h"
+		assert.Equal(t, 720*time.Hour, info.ParseArchiveAfter())
+	})
 }
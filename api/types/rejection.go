@@ -0,0 +1,66 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"time"
+
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+// RejectionReason represents the reason why the server rejects the changes
+// pushed by a client.
+type RejectionReason string
+
+const (
+	// RejectedByQuota means that the changes exceed a limit of the server or
+	// the project, e.g. the size of values.
+	RejectedByQuota RejectionReason = "quota"
+
+	// RejectedAsInvalid means that the changes are invalid, e.g. an operation
+	// on an element of another type.
+	RejectedAsInvalid RejectionReason = "invalid"
+
+	// RejectedByAuthz means that the client is not allowed to push the
+	// changes, e.g. the document is locked or the webhook denies it.
+	RejectedByAuthz RejectionReason = "authz"
+)
+
+// Rejection is a change pack of a client rejected by the server.
+type Rejection struct {
+	// DocumentKey is the key of the document which the changes are pushed to.
+	DocumentKey key.Key `json:"document_key"`
+
+	// ClientID is the ID of the client which pushed the changes.
+	ClientID ID `json:"client_id"`
+
+	// Reason is the reason why the changes are rejected.
+	Reason RejectionReason `json:"reason"`
+
+	// Message is the message of the error returned to the client.
+	Message string `json:"message"`
+
+	// Changes is the number of the changes in the rejected pack.
+	Changes int `json:"changes"`
+
+	// RejectedAt is the time when the changes are rejected.
+	RejectedAt time.Time `json:"rejected_at"`
+
+	// Dropped is the number of the rejections dropped right before this one
+	// by the rate limit of the subscriber.
+	Dropped int `json:"dropped"`
+}
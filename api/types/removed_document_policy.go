@@ -0,0 +1,48 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+// The policies of attaching to the key of a removed document, when no
+// document of the key exists.
+const (
+	// RemovedDocumentRecreate creates a fresh document of the key as if the
+	// key is not used, if the attachment may create a document. It is the
+	// default.
+	RemovedDocumentRecreate = "recreate"
+
+	// RemovedDocumentReject rejects the attachment as if the document is not
+	// found, so that a removed document is not recreated by the clients which
+	// have not noticed the removal.
+	RemovedDocumentReject = "reject"
+
+	// RemovedDocumentRestore restores the latest removed document of the key
+	// with its contents, if it is removed within the retention of the server.
+	// Otherwise, it follows RemovedDocumentRecreate.
+	RemovedDocumentRestore = "restore"
+)
+
+// RemovedDocumentAction is what the server does to attach to the key of a
+// removed document.
+type RemovedDocumentAction string
+
+const (
+	// RemovedDocumentRecreated means that a fresh document is created.
+	RemovedDocumentRecreated RemovedDocumentAction = "recreated"
+
+	// RemovedDocumentRestored means that the removed document is restored.
+	RemovedDocumentRestored RemovedDocumentAction = "restored"
+)
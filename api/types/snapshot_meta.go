@@ -0,0 +1,37 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package types
+
+import "time"
+
+// SnapshotMeta is the metadata of a snapshot of a document retained for
+// rollback.
+type SnapshotMeta struct {
+	// ServerSeq is the server sequence of the document when the snapshot is
+	// created.
+	ServerSeq uint64 `json:"server_seq"`
+
+	// Lamport is the Lamport timestamp of the snapshot.
+	Lamport uint64 `json:"lamport"`
+
+	// GarbageLen is the number of the tombstones in the snapshot which are
+	// not collected yet, e.g. as a lagging client has not synced them.
+	GarbageLen int `json:"garbage_len"`
+
+	// CreatedAt is the time when the snapshot is created.
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidSnapshotPolicy is returned when the given snapshot policy is
+// malformed.
+var ErrInvalidSnapshotPolicy = errors.New("invalid snapshot policy")
+
+// SnapshotCompression is the algorithm to compress the snapshots stored in the
+// database.
+type SnapshotCompression string
+
+const (
+	// SnapshotCompressionNone stores snapshots without compression.
+	SnapshotCompressionNone SnapshotCompression = "none"
+
+	// SnapshotCompressionGzip stores snapshots compressed with gzip. It trades
+	// the CPU time to build documents for the storage of large snapshots.
+	SnapshotCompressionGzip SnapshotCompression = "gzip"
+)
+
+// IsSnapshotCompression returns whether the given string is a supported
+// snapshot compression.
+func IsSnapshotCompression(compression string) bool {
+	switch SnapshotCompression(compression) {
+	case SnapshotCompressionNone, SnapshotCompressionGzip:
+		return true
+	}
+	return false
+}
+
+// SnapshotPolicy is the policy to store the snapshots of documents of a
+// project. The zero value follows the defaults of the server.
+type SnapshotPolicy struct {
+	// Threshold is the number of changes to pull above which a snapshot is
+	// pulled instead of the changes.
+	Threshold uint64 `json:"threshold" bson:"threshold"`
+
+	// Compression is the algorithm to compress the snapshots stored. One of
+	// "none" and "gzip".
+	Compression string `json:"compression" bson:"compression"`
+
+	// RetentionCount is the number of the latest snapshots of each document
+	// to retain for rollback.
+	RetentionCount uint64 `json:"retention_count" bson:"retention_count"`
+}
+
+// IsEmpty returns whether the policy follows the defaults of the server.
+func (p *SnapshotPolicy) IsEmpty() bool {
+	return p.Threshold == 0 && p.Compression == "" && p.RetentionCount == 0
+}
+
+// Verify checks that the policy itself is well-formed. The settings of a
+// policy depend on each other, so a policy which is not empty must have all
+// of them.
+func (p *SnapshotPolicy) Verify() error {
+	if p.IsEmpty() {
+		return nil
+	}
+
+	if p.Threshold == 0 {
+		return fmt.Errorf("threshold must be positive: %w", ErrInvalidSnapshotPolicy)
+	}
+	if p.RetentionCount == 0 {
+		return fmt.Errorf("retention count must be at least 1: %w", ErrInvalidSnapshotPolicy)
+	}
+	if !IsSnapshotCompression(p.Compression) {
+		return fmt.Errorf("compression %q: %w", p.Compression, ErrInvalidSnapshotPolicy)
+	}
+
+	return nil
+}
+
+// Merge returns the policy whose settings not set in this policy are filled
+// with the given defaults.
+func (p *SnapshotPolicy) Merge(defaults SnapshotPolicy) SnapshotPolicy {
+	merged := *p
+	if merged.Threshold == 0 {
+		merged.Threshold = defaults.Threshold
+	}
+	if merged.Compression == "" {
+		merged.Compression = defaults.Compression
+	}
+	if merged.RetentionCount == 0 {
+		merged.RetentionCount = defaults.RetentionCount
+	}
+	return merged
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+)
+
+func TestSnapshotPolicy(t *testing.T) {
+	t.Run("verify test", func(t *testing.T) {
+		empty := &types.SnapshotPolicy{}
+		assert.True(t, empty.IsEmpty())
+		assert.NoError(t, empty.Verify())
+
+		valid := &types.SnapshotPolicy{Threshold: 100, Compression: "gzip", RetentionCount: 3}
+		assert.NoError(t, valid.Verify())
+
+		for _, policy := range []*types.SnapshotPolicy{
+			{Threshold: 0, Compression: "gzip", RetentionCount: 3},
+			{Threshold: 100, Compression: "gzip", RetentionCount: 0},
+			{Threshold: 100, Compression: "zstd", RetentionCount: 3},
+			{Threshold: 100},
+		} {
+			assert.ErrorIs(t, policy.Verify(), types.ErrInvalidSnapshotPolicy)
+		}
+	})
+
+	t.Run("merge test", func(t *testing.T) {
+		defaults := types.SnapshotPolicy{Threshold: 500, Compression: "none", RetentionCount: 0}
+
+		empty := &types.SnapshotPolicy{}
+		assert.Equal(t, defaults, empty.Merge(defaults))
+
+		policy := &types.SnapshotPolicy{Threshold: 100, Compression: "gzip", RetentionCount: 3}
+		assert.Equal(t, *policy, policy.Merge(defaults))
+	})
+}
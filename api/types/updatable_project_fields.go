@@ -18,14 +18,32 @@
 package types
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net/url"
 	"regexp"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 )
 
-// ErrEmptyProjectFields is returned when all the fields are empty.
-var ErrEmptyProjectFields = errors.New("updatable project fields are empty")
+var (
+	// ErrEmptyProjectFields is returned when all the fields are empty.
+	ErrEmptyProjectFields = errors.New("updatable project fields are empty")
+
+	// ErrInvalidFieldMask is returned when the field mask has a path which is
+	// not one of the updatable project fields.
+	ErrInvalidFieldMask = errors.New("invalid field mask")
+)
+
+// MaxInitialContentBytes is the maximum size in bytes of the initial content
+// of documents in a project.
+const MaxInitialContentBytes = 64 * 1024
+
+// MaxDocumentTemplates is the maximum number of document templates in a
+// project. Each template is limited to MaxInitialContentBytes.
+const MaxDocumentTemplates = 100
 
 var (
 	// reservedNames is a map of reserved names. It is used to check if the
@@ -71,16 +89,138 @@ type UpdatableProjectFields struct {
 
 	// AuthWebhookMethods is the methods that run the authorization webhook.
 	AuthWebhookMethods *[]string `bson:"auth_webhook_methods,omitempty" validate:"omitempty,invalidmethod"`
+
+	// DocumentKeyPolicy is the policy that document keys must conform to.
+	DocumentKeyPolicy *DocumentKeyPolicy `bson:"document_key_policy,omitempty"`
+
+	// CollectApplyLag is whether to collect the lag between the creation of
+	// operations on clients and the reception on the server.
+	CollectApplyLag *bool `bson:"collect_apply_lag,omitempty"`
+
+	// InitialContent is the JSON object that new documents start from. An
+	// empty string removes it.
+	InitialContent *string `bson:"initial_content,omitempty" validate:"omitempty,initialcontent"`
+
+	// ObjectMergePolicy is the policy to resolve concurrent writes to the same
+	// key of Objects. One of "lww", "fww" and "reject".
+	ObjectMergePolicy *string `bson:"object_merge_policy,omitempty" validate:"omitempty,oneof=lww fww reject"`
+
+	// EventWebhookURL is the url of the webhook that receives the lifecycle
+	// events of documents. An empty string disables it.
+	EventWebhookURL *string `bson:"event_webhook_url,omitempty"`
+
+	// EphemeralKeyPrefix is the key prefix of the ephemeral documents. An
+	// empty string disables it. Changing it does not move the state of the
+	// existing documents.
+	EphemeralKeyPrefix *string `bson:"ephemeral_key_prefix,omitempty"`
+
+	// ChangefeedURL is the url of the message sink that receives the changes
+	// committed to documents. An empty string disables it.
+	ChangefeedURL *string `bson:"changefeed_url,omitempty" validate:"omitempty,changefeedurl"`
+
+	// Features replaces whether the features of documents are enabled. The
+	// features not in it follow their defaults.
+	Features *map[string]bool `bson:"features,omitempty" validate:"omitempty,features"`
+
+	// ArchiveAfter is the period of inactivity after which documents are
+	// archived, e.g. "720h". An empty string disables it.
+	ArchiveAfter *string `bson:"archive_after,omitempty" validate:"omitempty,archiveafter"`
+
+	// DocumentTemplates replaces the templates of documents by their IDs.
+	DocumentTemplates *map[string]string `bson:"document_templates,omitempty" validate:"omitempty,documenttemplates"`
+
+	// ExplicitDocumentCreation is whether documents must be created explicitly
+	// before they are attached.
+	ExplicitDocumentCreation *bool `bson:"explicit_document_creation,omitempty"`
+
+	// ActorIDPolicy is the policy to assign actor IDs to the clients. One of
+	// "per-session" and "stable".
+	ActorIDPolicy *string `bson:"actor_id_policy,omitempty" validate:"omitempty,oneof=per-session stable"`
+
+	// AllowedOperations replaces the types of operations that clients may
+	// submit. An empty list allows all operations.
+	AllowedOperations *[]string `bson:"allowed_operations,omitempty" validate:"omitempty,operationtypes"`
+
+	// SnapshotPolicy is the policy to store the snapshots of documents. An
+	// empty policy follows the defaults of the server.
+	SnapshotPolicy *SnapshotPolicy `bson:"snapshot_policy,omitempty"`
+
+	// MaxKeysPerObject is the maximum number of live keys of an Object. Zero
+	// follows the limit of the server.
+	MaxKeysPerObject *int `bson:"max_keys_per_object,omitempty" validate:"omitempty,min=0"`
+
+	// RemovedDocumentPolicy is the policy of attaching to the key of a removed
+	// document. One of "recreate", "reject" and "restore".
+	RemovedDocumentPolicy *string `bson:"removed_document_policy,omitempty" validate:"omitempty,oneof=recreate reject restore"`
+}
+
+// Mask returns the fields only with the fields of the given paths, so that
+// the other fields are left untouched even if they are given. The paths are
+// the names of the fields in snake case, e.g. "auth_webhook_url".
+func (i *UpdatableProjectFields) Mask(paths []string) (*UpdatableProjectFields, error) {
+	masked := &UpdatableProjectFields{}
+	for _, path := range paths {
+		switch path {
+		case "name":
+			masked.Name = i.Name
+		case "auth_webhook_url":
+			masked.AuthWebhookURL = i.AuthWebhookURL
+		case "auth_webhook_methods":
+			masked.AuthWebhookMethods = i.AuthWebhookMethods
+		case "document_key_policy":
+			masked.DocumentKeyPolicy = i.DocumentKeyPolicy
+		case "collect_apply_lag":
+			masked.CollectApplyLag = i.CollectApplyLag
+		case "initial_content":
+			masked.InitialContent = i.InitialContent
+		case "object_merge_policy":
+			masked.ObjectMergePolicy = i.ObjectMergePolicy
+		case "event_webhook_url":
+			masked.EventWebhookURL = i.EventWebhookURL
+		case "ephemeral_key_prefix":
+			masked.EphemeralKeyPrefix = i.EphemeralKeyPrefix
+		case "changefeed_url":
+			masked.ChangefeedURL = i.ChangefeedURL
+		case "features":
+			masked.Features = i.Features
+		case "archive_after":
+			masked.ArchiveAfter = i.ArchiveAfter
+		case "document_templates":
+			masked.DocumentTemplates = i.DocumentTemplates
+		case "explicit_document_creation":
+			masked.ExplicitDocumentCreation = i.ExplicitDocumentCreation
+		case "actor_id_policy":
+			masked.ActorIDPolicy = i.ActorIDPolicy
+		case "allowed_operations":
+			masked.AllowedOperations = i.AllowedOperations
+		case "snapshot_policy":
+			masked.SnapshotPolicy = i.SnapshotPolicy
+		case "max_keys_per_object":
+			masked.MaxKeysPerObject = i.MaxKeysPerObject
+		case "removed_document_policy":
+			masked.RemovedDocumentPolicy = i.RemovedDocumentPolicy
+		default:
+			return nil, fmt.Errorf("%s: %w", path, ErrInvalidFieldMask)
+		}
+	}
+
+	return masked, nil
 }
 
 // Validate validates the UpdatableProjectFields.
 func (i *UpdatableProjectFields) Validate() error {
-	if i.Name == nil && i.AuthWebhookURL == nil && i.AuthWebhookMethods == nil {
+	if i.Name == nil && i.AuthWebhookURL == nil && i.AuthWebhookMethods == nil &&
+		i.DocumentKeyPolicy == nil && i.CollectApplyLag == nil && i.InitialContent == nil &&
+		i.ObjectMergePolicy == nil && i.EventWebhookURL == nil && i.EphemeralKeyPrefix == nil &&
+		i.ChangefeedURL == nil && i.Features == nil && i.ArchiveAfter == nil &&
+		i.DocumentTemplates == nil && i.ExplicitDocumentCreation == nil && i.ActorIDPolicy == nil &&
+		i.AllowedOperations == nil && i.SnapshotPolicy == nil && i.MaxKeysPerObject == nil &&
+		i.RemovedDocumentPolicy == nil {
 		return ErrEmptyProjectFields
 	}
 
+	invalidFieldsError := &InvalidFieldsError{}
 	if err := defaultValidator.Struct(i); err != nil {
-		invalidFieldsError := &InvalidFieldsError{}
 		for _, err := range err.(validator.ValidationErrors) {
 			v := &FieldViolation{
 				Field:       err.StructField(),
@@ -88,6 +228,28 @@ func (i *UpdatableProjectFields) Validate() error {
 			}
 			invalidFieldsError.Violations = append(invalidFieldsError.Violations, v)
 		}
+	}
+
+	// NOTE: validator does not run custom validations on struct fields, so
+	// the policy is verified separately.
+	if i.DocumentKeyPolicy != nil {
+		if err := i.DocumentKeyPolicy.Verify(); err != nil {
+			invalidFieldsError.Violations = append(invalidFieldsError.Violations, &FieldViolation{
+				Field:       "DocumentKeyPolicy",
+				Description: err.Error(),
+			})
+		}
+	}
+	if i.SnapshotPolicy != nil {
+		if err := i.SnapshotPolicy.Verify(); err != nil {
+			invalidFieldsError.Violations = append(invalidFieldsError.Violations, &FieldViolation{
+				Field:       "SnapshotPolicy",
+				Description: err.Error(),
+			})
+		}
+	}
+
+	if len(invalidFieldsError.Violations) > 0 {
 		return invalidFieldsError
 	}
 
@@ -117,4 +279,91 @@ func init() {
 		return true
 	})
 	registerTranslation("invalidmethod", "given {0} is invalid method")
+
+	registerValidation("initialcontent", func(level validator.FieldLevel) bool {
+		content := level.Field().String()
+		if content == "" {
+			return true
+		}
+		if len(content) > MaxInitialContentBytes {
+			return false
+		}
+
+		var values map[string]interface{}
+		return json.Unmarshal([]byte(content), &values) == nil && values != nil
+	})
+	registerTranslation(
+		"initialcontent",
+		fmt.Sprintf("{0} must be a JSON object within %d bytes", MaxInitialContentBytes),
+	)
+
+	registerValidation("changefeedurl", func(level validator.FieldLevel) bool {
+		rawURL := level.Field().String()
+		if rawURL == "" {
+			return true
+		}
+
+		u, err := url.Parse(rawURL)
+		return err == nil && u.Scheme == "nats" && u.Host != ""
+	})
+	registerTranslation("changefeedurl", "{0} must be a url of the form nats://host:port/subject")
+
+	registerValidation("features", func(level validator.FieldLevel) bool {
+		features := level.Field().Interface().(map[string]bool)
+		for name := range features {
+			if !IsFeature(name) {
+				return false
+			}
+		}
+		return true
+	})
+	registerTranslation("features", "given {0} has unknown feature")
+
+	registerValidation("operationtypes", func(level validator.FieldLevel) bool {
+		operations := level.Field().Interface().([]string)
+		for _, operation := range operations {
+			if !IsOperationType(operation) {
+				return false
+			}
+		}
+		return true
+	})
+	registerTranslation("operationtypes", "given {0} has unknown operation type")
+
+	registerValidation("documenttemplates", func(level validator.FieldLevel) bool {
+		templates := level.Field().Interface().(map[string]string)
+		if len(templates) > MaxDocumentTemplates {
+			return false
+		}
+		for id, template := range templates {
+			if !nameRegex.MatchString(id) || len(template) > MaxInitialContentBytes {
+				return false
+			}
+
+			var values map[string]interface{}
+			if json.Unmarshal([]byte(template), &values) != nil || values == nil {
+				return false
+			}
+		}
+		return true
+	})
+	registerTranslation(
+		"documenttemplates",
+		fmt.Sprintf(
+			"{0} must be at most %d JSON objects within %d bytes with slug IDs",
+			MaxDocumentTemplates,
+			MaxInitialContentBytes,
+		),
+	)
+
+	registerValidation("archiveafter", func(level validator.FieldLevel) bool {
+		archiveAfter := level.Field().String()
+		if archiveAfter == "" {
+			return true
+		}
+
+		period, err := time.ParseDuration(archiveAfter)
+		return err == nil && period > 0
+	})
+	registerTranslation("archiveafter", "{0} must be a positive duration such as 720h")
 }
@@ -6,6 +6,7 @@ package api
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/gogo/protobuf/types"
 	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -28,6 +29,7 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type ActivateClientRequest struct {
 	ClientKey            string   `protobuf:"bytes,1,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`
+	Identity             string   `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -73,9 +75,17 @@ func (m *ActivateClientRequest) GetClientKey() string {
 	return ""
 }
 
+func (m *ActivateClientRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
 type ActivateClientResponse struct {
 	ClientKey            string   `protobuf:"bytes,1,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`
 	ClientId             []byte   `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	RecordWallTime       bool     `protobuf:"varint,3,opt,name=record_wall_time,json=recordWallTime,proto3" json:"record_wall_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -128,6 +138,13 @@ func (m *ActivateClientResponse) GetClientId() []byte {
 	return nil
 }
 
+func (m *ActivateClientResponse) GetRecordWallTime() bool {
+	if m != nil {
+		return m.RecordWallTime
+	}
+	return false
+}
+
 type DeactivateClientRequest struct {
 	ClientId             []byte   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -223,11 +240,15 @@ func (m *DeactivateClientResponse) GetClientId() []byte {
 }
 
 type AttachDocumentRequest struct {
-	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	ClientId             []byte           `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ChangePack           *ChangePack      `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
+	ReadOnly             bool             `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	TargetServerSeq      uint64           `protobuf:"varint,4,opt,name=target_server_seq,json=targetServerSeq,proto3" json:"target_server_seq,omitempty"`
+	CreateIfMissing      *types.BoolValue `protobuf:"bytes,5,opt,name=create_if_missing,json=createIfMissing,proto3" json:"create_if_missing,omitempty"`
+	ConsistencyToken     string           `protobuf:"bytes,6,opt,name=consistency_token,json=consistencyToken,proto3" json:"consistency_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *AttachDocumentRequest) Reset()         { *m = AttachDocumentRequest{} }
@@ -277,12 +298,44 @@ func (m *AttachDocumentRequest) GetChangePack() *ChangePack {
 	return nil
 }
 
+func (m *AttachDocumentRequest) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
+func (m *AttachDocumentRequest) GetTargetServerSeq() uint64 {
+	if m != nil {
+		return m.TargetServerSeq
+	}
+	return 0
+}
+
+func (m *AttachDocumentRequest) GetCreateIfMissing() *types.BoolValue {
+	if m != nil {
+		return m.CreateIfMissing
+	}
+	return nil
+}
+
+func (m *AttachDocumentRequest) GetConsistencyToken() string {
+	if m != nil {
+		return m.ConsistencyToken
+	}
+	return ""
+}
+
 type AttachDocumentResponse struct {
-	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	ClientId              []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ChangePack            *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
+	Reactivated           bool        `protobuf:"varint,3,opt,name=reactivated,proto3" json:"reactivated,omitempty"`
+	ObjectMergePolicy     string      `protobuf:"bytes,4,opt,name=object_merge_policy,json=objectMergePolicy,proto3" json:"object_merge_policy,omitempty"`
+	ConsistencyToken      string      `protobuf:"bytes,5,opt,name=consistency_token,json=consistencyToken,proto3" json:"consistency_token,omitempty"`
+	RemovedDocumentAction string      `protobuf:"bytes,6,opt,name=removed_document_action,json=removedDocumentAction,proto3" json:"removed_document_action,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}    `json:"-"`
+	XXX_unrecognized      []byte      `json:"-"`
+	XXX_sizecache         int32       `json:"-"`
 }
 
 func (m *AttachDocumentResponse) Reset()         { *m = AttachDocumentResponse{} }
@@ -332,6 +385,136 @@ func (m *AttachDocumentResponse) GetChangePack() *ChangePack {
 	return nil
 }
 
+func (m *AttachDocumentResponse) GetReactivated() bool {
+	if m != nil {
+		return m.Reactivated
+	}
+	return false
+}
+
+func (m *AttachDocumentResponse) GetObjectMergePolicy() string {
+	if m != nil {
+		return m.ObjectMergePolicy
+	}
+	return ""
+}
+
+func (m *AttachDocumentResponse) GetConsistencyToken() string {
+	if m != nil {
+		return m.ConsistencyToken
+	}
+	return ""
+}
+
+func (m *AttachDocumentResponse) GetRemovedDocumentAction() string {
+	if m != nil {
+		return m.RemovedDocumentAction
+	}
+	return ""
+}
+
+type CreateDocumentRequest struct {
+	ClientId             []byte   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	DocumentKey          string   `protobuf:"bytes,2,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateDocumentRequest) Reset()         { *m = CreateDocumentRequest{} }
+func (m *CreateDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateDocumentRequest) ProtoMessage()    {}
+func (*CreateDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{6}
+}
+func (m *CreateDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateDocumentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDocumentRequest.Merge(m, src)
+}
+func (m *CreateDocumentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDocumentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDocumentRequest proto.InternalMessageInfo
+
+func (m *CreateDocumentRequest) GetClientId() []byte {
+	if m != nil {
+		return m.ClientId
+	}
+	return nil
+}
+
+func (m *CreateDocumentRequest) GetDocumentKey() string {
+	if m != nil {
+		return m.DocumentKey
+	}
+	return ""
+}
+
+type CreateDocumentResponse struct {
+	DocumentId           string   `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateDocumentResponse) Reset()         { *m = CreateDocumentResponse{} }
+func (m *CreateDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateDocumentResponse) ProtoMessage()    {}
+func (*CreateDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{7}
+}
+func (m *CreateDocumentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateDocumentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDocumentResponse.Merge(m, src)
+}
+func (m *CreateDocumentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDocumentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDocumentResponse proto.InternalMessageInfo
+
+func (m *CreateDocumentResponse) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
+	}
+	return ""
+}
+
 type DetachDocumentRequest struct {
 	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
@@ -344,7 +527,7 @@ func (m *DetachDocumentRequest) Reset()         { *m = DetachDocumentRequest{} }
 func (m *DetachDocumentRequest) String() string { return proto.CompactTextString(m) }
 func (*DetachDocumentRequest) ProtoMessage()    {}
 func (*DetachDocumentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b60f170c5b305914, []int{6}
+	return fileDescriptor_b60f170c5b305914, []int{8}
 }
 func (m *DetachDocumentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -399,7 +582,7 @@ func (m *DetachDocumentResponse) Reset()         { *m = DetachDocumentResponse{}
 func (m *DetachDocumentResponse) String() string { return proto.CompactTextString(m) }
 func (*DetachDocumentResponse) ProtoMessage()    {}
 func (*DetachDocumentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b60f170c5b305914, []int{7}
+	return fileDescriptor_b60f170c5b305914, []int{9}
 }
 func (m *DetachDocumentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,26 +625,28 @@ func (m *DetachDocumentResponse) GetChangePack() *ChangePack {
 	return nil
 }
 
-type WatchDocumentsRequest struct {
-	Client               *Client  `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
-	DocumentKeys         []string `protobuf:"bytes,2,rep,name=document_keys,json=documentKeys,proto3" json:"document_keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type AttachDocumentsRequest struct {
+	ClientId             []byte           `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ChangePacks          []*ChangePack    `protobuf:"bytes,2,rep,name=change_packs,json=changePacks,proto3" json:"change_packs,omitempty"`
+	ReadOnly             bool             `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	CreateIfMissing      *types.BoolValue `protobuf:"bytes,4,opt,name=create_if_missing,json=createIfMissing,proto3" json:"create_if_missing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *WatchDocumentsRequest) Reset()         { *m = WatchDocumentsRequest{} }
-func (m *WatchDocumentsRequest) String() string { return proto.CompactTextString(m) }
-func (*WatchDocumentsRequest) ProtoMessage()    {}
-func (*WatchDocumentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b60f170c5b305914, []int{8}
+func (m *AttachDocumentsRequest) Reset()         { *m = AttachDocumentsRequest{} }
+func (m *AttachDocumentsRequest) String() string { return proto.CompactTextString(m) }
+func (*AttachDocumentsRequest) ProtoMessage()    {}
+func (*AttachDocumentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{10}
 }
-func (m *WatchDocumentsRequest) XXX_Unmarshal(b []byte) error {
+func (m *AttachDocumentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *WatchDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AttachDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_WatchDocumentsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AttachDocumentsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -471,54 +656,65 @@ func (m *WatchDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *WatchDocumentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WatchDocumentsRequest.Merge(m, src)
+func (m *AttachDocumentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttachDocumentsRequest.Merge(m, src)
 }
-func (m *WatchDocumentsRequest) XXX_Size() int {
+func (m *AttachDocumentsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *WatchDocumentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WatchDocumentsRequest.DiscardUnknown(m)
+func (m *AttachDocumentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttachDocumentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WatchDocumentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_AttachDocumentsRequest proto.InternalMessageInfo
 
-func (m *WatchDocumentsRequest) GetClient() *Client {
+func (m *AttachDocumentsRequest) GetClientId() []byte {
 	if m != nil {
-		return m.Client
+		return m.ClientId
 	}
 	return nil
 }
 
-func (m *WatchDocumentsRequest) GetDocumentKeys() []string {
+func (m *AttachDocumentsRequest) GetChangePacks() []*ChangePack {
 	if m != nil {
-		return m.DocumentKeys
+		return m.ChangePacks
 	}
 	return nil
 }
 
-type WatchDocumentsResponse struct {
-	// Types that are valid to be assigned to Body:
-	//	*WatchDocumentsResponse_Initialization_
-	//	*WatchDocumentsResponse_Event
-	Body                 isWatchDocumentsResponse_Body `protobuf_oneof:"body"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+func (m *AttachDocumentsRequest) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
 }
 
-func (m *WatchDocumentsResponse) Reset()         { *m = WatchDocumentsResponse{} }
-func (m *WatchDocumentsResponse) String() string { return proto.CompactTextString(m) }
-func (*WatchDocumentsResponse) ProtoMessage()    {}
-func (*WatchDocumentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b60f170c5b305914, []int{9}
+func (m *AttachDocumentsRequest) GetCreateIfMissing() *types.BoolValue {
+	if m != nil {
+		return m.CreateIfMissing
+	}
+	return nil
 }
-func (m *WatchDocumentsResponse) XXX_Unmarshal(b []byte) error {
+
+type AttachDocumentsResponse struct {
+	Results              []*AttachDocumentsResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *AttachDocumentsResponse) Reset()         { *m = AttachDocumentsResponse{} }
+func (m *AttachDocumentsResponse) String() string { return proto.CompactTextString(m) }
+func (*AttachDocumentsResponse) ProtoMessage()    {}
+func (*AttachDocumentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{11}
+}
+func (m *AttachDocumentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *WatchDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AttachDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_WatchDocumentsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AttachDocumentsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -528,82 +724,116 @@ func (m *WatchDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *WatchDocumentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WatchDocumentsResponse.Merge(m, src)
+func (m *AttachDocumentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttachDocumentsResponse.Merge(m, src)
 }
-func (m *WatchDocumentsResponse) XXX_Size() int {
+func (m *AttachDocumentsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *WatchDocumentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_WatchDocumentsResponse.DiscardUnknown(m)
+func (m *AttachDocumentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttachDocumentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WatchDocumentsResponse proto.InternalMessageInfo
+var xxx_messageInfo_AttachDocumentsResponse proto.InternalMessageInfo
 
-type isWatchDocumentsResponse_Body interface {
-	isWatchDocumentsResponse_Body()
-	MarshalTo([]byte) (int, error)
-	Size() int
+func (m *AttachDocumentsResponse) GetResults() []*AttachDocumentsResponse_Result {
+	if m != nil {
+		return m.Results
+	}
+	return nil
 }
 
-type WatchDocumentsResponse_Initialization_ struct {
-	Initialization *WatchDocumentsResponse_Initialization `protobuf:"bytes,1,opt,name=initialization,proto3,oneof" json:"initialization,omitempty"`
+type AttachDocumentsResponse_Result struct {
+	DocumentKey          string                  `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	Response             *AttachDocumentResponse `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	ErrorCode            int32                   `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorMessage         string                  `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
-type WatchDocumentsResponse_Event struct {
-	Event *DocEvent `protobuf:"bytes,2,opt,name=event,proto3,oneof" json:"event,omitempty"`
+
+func (m *AttachDocumentsResponse_Result) Reset()         { *m = AttachDocumentsResponse_Result{} }
+func (m *AttachDocumentsResponse_Result) String() string { return proto.CompactTextString(m) }
+func (*AttachDocumentsResponse_Result) ProtoMessage()    {}
+func (*AttachDocumentsResponse_Result) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{11, 0}
+}
+func (m *AttachDocumentsResponse_Result) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttachDocumentsResponse_Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttachDocumentsResponse_Result.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttachDocumentsResponse_Result) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttachDocumentsResponse_Result.Merge(m, src)
+}
+func (m *AttachDocumentsResponse_Result) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttachDocumentsResponse_Result) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttachDocumentsResponse_Result.DiscardUnknown(m)
 }
 
-func (*WatchDocumentsResponse_Initialization_) isWatchDocumentsResponse_Body() {}
-func (*WatchDocumentsResponse_Event) isWatchDocumentsResponse_Body()           {}
+var xxx_messageInfo_AttachDocumentsResponse_Result proto.InternalMessageInfo
 
-func (m *WatchDocumentsResponse) GetBody() isWatchDocumentsResponse_Body {
+func (m *AttachDocumentsResponse_Result) GetDocumentKey() string {
 	if m != nil {
-		return m.Body
+		return m.DocumentKey
 	}
-	return nil
+	return ""
 }
 
-func (m *WatchDocumentsResponse) GetInitialization() *WatchDocumentsResponse_Initialization {
-	if x, ok := m.GetBody().(*WatchDocumentsResponse_Initialization_); ok {
-		return x.Initialization
+func (m *AttachDocumentsResponse_Result) GetResponse() *AttachDocumentResponse {
+	if m != nil {
+		return m.Response
 	}
 	return nil
 }
 
-func (m *WatchDocumentsResponse) GetEvent() *DocEvent {
-	if x, ok := m.GetBody().(*WatchDocumentsResponse_Event); ok {
-		return x.Event
+func (m *AttachDocumentsResponse_Result) GetErrorCode() int32 {
+	if m != nil {
+		return m.ErrorCode
 	}
-	return nil
+	return 0
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*WatchDocumentsResponse) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*WatchDocumentsResponse_Initialization_)(nil),
-		(*WatchDocumentsResponse_Event)(nil),
+func (m *AttachDocumentsResponse_Result) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
 	}
+	return ""
 }
 
-type WatchDocumentsResponse_Initialization struct {
-	PeersMapByDoc        map[string]*Clients `protobuf:"bytes,1,rep,name=peers_map_by_doc,json=peersMapByDoc,proto3" json:"peers_map_by_doc,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type DetachDocumentsRequest struct {
+	ClientId             []byte        `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ChangePacks          []*ChangePack `protobuf:"bytes,2,rep,name=change_packs,json=changePacks,proto3" json:"change_packs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *WatchDocumentsResponse_Initialization) Reset()         { *m = WatchDocumentsResponse_Initialization{} }
-func (m *WatchDocumentsResponse_Initialization) String() string { return proto.CompactTextString(m) }
-func (*WatchDocumentsResponse_Initialization) ProtoMessage()    {}
-func (*WatchDocumentsResponse_Initialization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b60f170c5b305914, []int{9, 0}
+func (m *DetachDocumentsRequest) Reset()         { *m = DetachDocumentsRequest{} }
+func (m *DetachDocumentsRequest) String() string { return proto.CompactTextString(m) }
+func (*DetachDocumentsRequest) ProtoMessage()    {}
+func (*DetachDocumentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{12}
 }
-func (m *WatchDocumentsResponse_Initialization) XXX_Unmarshal(b []byte) error {
+func (m *DetachDocumentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *WatchDocumentsResponse_Initialization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DetachDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_WatchDocumentsResponse_Initialization.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DetachDocumentsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -613,45 +843,51 @@ func (m *WatchDocumentsResponse_Initialization) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *WatchDocumentsResponse_Initialization) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WatchDocumentsResponse_Initialization.Merge(m, src)
+func (m *DetachDocumentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetachDocumentsRequest.Merge(m, src)
 }
-func (m *WatchDocumentsResponse_Initialization) XXX_Size() int {
+func (m *DetachDocumentsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *WatchDocumentsResponse_Initialization) XXX_DiscardUnknown() {
-	xxx_messageInfo_WatchDocumentsResponse_Initialization.DiscardUnknown(m)
+func (m *DetachDocumentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetachDocumentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WatchDocumentsResponse_Initialization proto.InternalMessageInfo
+var xxx_messageInfo_DetachDocumentsRequest proto.InternalMessageInfo
 
-func (m *WatchDocumentsResponse_Initialization) GetPeersMapByDoc() map[string]*Clients {
+func (m *DetachDocumentsRequest) GetClientId() []byte {
 	if m != nil {
-		return m.PeersMapByDoc
+		return m.ClientId
 	}
 	return nil
 }
 
-type PushPullRequest struct {
-	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *DetachDocumentsRequest) GetChangePacks() []*ChangePack {
+	if m != nil {
+		return m.ChangePacks
+	}
+	return nil
 }
 
-func (m *PushPullRequest) Reset()         { *m = PushPullRequest{} }
-func (m *PushPullRequest) String() string { return proto.CompactTextString(m) }
-func (*PushPullRequest) ProtoMessage()    {}
-func (*PushPullRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b60f170c5b305914, []int{10}
+type DetachDocumentsResponse struct {
+	Results              []*DetachDocumentsResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
-func (m *PushPullRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *DetachDocumentsResponse) Reset()         { *m = DetachDocumentsResponse{} }
+func (m *DetachDocumentsResponse) String() string { return proto.CompactTextString(m) }
+func (*DetachDocumentsResponse) ProtoMessage()    {}
+func (*DetachDocumentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{13}
+}
+func (m *DetachDocumentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PushPullRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DetachDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PushPullRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DetachDocumentsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -661,52 +897,47 @@ func (m *PushPullRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *PushPullRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PushPullRequest.Merge(m, src)
+func (m *DetachDocumentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetachDocumentsResponse.Merge(m, src)
 }
-func (m *PushPullRequest) XXX_Size() int {
+func (m *DetachDocumentsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *PushPullRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PushPullRequest.DiscardUnknown(m)
+func (m *DetachDocumentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetachDocumentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PushPullRequest proto.InternalMessageInfo
-
-func (m *PushPullRequest) GetClientId() []byte {
-	if m != nil {
-		return m.ClientId
-	}
-	return nil
-}
+var xxx_messageInfo_DetachDocumentsResponse proto.InternalMessageInfo
 
-func (m *PushPullRequest) GetChangePack() *ChangePack {
+func (m *DetachDocumentsResponse) GetResults() []*DetachDocumentsResponse_Result {
 	if m != nil {
-		return m.ChangePack
+		return m.Results
 	}
 	return nil
 }
 
-type PushPullResponse struct {
-	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type DetachDocumentsResponse_Result struct {
+	DocumentKey          string                  `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	Response             *DetachDocumentResponse `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	ErrorCode            int32                   `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorMessage         string                  `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func (m *PushPullResponse) Reset()         { *m = PushPullResponse{} }
-func (m *PushPullResponse) String() string { return proto.CompactTextString(m) }
-func (*PushPullResponse) ProtoMessage()    {}
-func (*PushPullResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b60f170c5b305914, []int{11}
+func (m *DetachDocumentsResponse_Result) Reset()         { *m = DetachDocumentsResponse_Result{} }
+func (m *DetachDocumentsResponse_Result) String() string { return proto.CompactTextString(m) }
+func (*DetachDocumentsResponse_Result) ProtoMessage()    {}
+func (*DetachDocumentsResponse_Result) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{13, 0}
 }
-func (m *PushPullResponse) XXX_Unmarshal(b []byte) error {
+func (m *DetachDocumentsResponse_Result) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PushPullResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DetachDocumentsResponse_Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PushPullResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DetachDocumentsResponse_Result.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -716,52 +947,67 @@ func (m *PushPullResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *PushPullResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PushPullResponse.Merge(m, src)
+func (m *DetachDocumentsResponse_Result) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetachDocumentsResponse_Result.Merge(m, src)
 }
-func (m *PushPullResponse) XXX_Size() int {
+func (m *DetachDocumentsResponse_Result) XXX_Size() int {
 	return m.Size()
 }
-func (m *PushPullResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PushPullResponse.DiscardUnknown(m)
+func (m *DetachDocumentsResponse_Result) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetachDocumentsResponse_Result.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PushPullResponse proto.InternalMessageInfo
+var xxx_messageInfo_DetachDocumentsResponse_Result proto.InternalMessageInfo
 
-func (m *PushPullResponse) GetClientId() []byte {
+func (m *DetachDocumentsResponse_Result) GetDocumentKey() string {
 	if m != nil {
-		return m.ClientId
+		return m.DocumentKey
 	}
-	return nil
+	return ""
 }
 
-func (m *PushPullResponse) GetChangePack() *ChangePack {
+func (m *DetachDocumentsResponse_Result) GetResponse() *DetachDocumentResponse {
 	if m != nil {
-		return m.ChangePack
+		return m.Response
 	}
 	return nil
 }
 
-type UpdatePresenceRequest struct {
+func (m *DetachDocumentsResponse_Result) GetErrorCode() int32 {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return 0
+}
+
+func (m *DetachDocumentsResponse_Result) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+type WatchDocumentsRequest struct {
 	Client               *Client  `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
 	DocumentKeys         []string `protobuf:"bytes,2,rep,name=document_keys,json=documentKeys,proto3" json:"document_keys,omitempty"`
+	Paths                []string `protobuf:"bytes,3,rep,name=paths,proto3" json:"paths,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdatePresenceRequest) Reset()         { *m = UpdatePresenceRequest{} }
-func (m *UpdatePresenceRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdatePresenceRequest) ProtoMessage()    {}
-func (*UpdatePresenceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b60f170c5b305914, []int{12}
+func (m *WatchDocumentsRequest) Reset()         { *m = WatchDocumentsRequest{} }
+func (m *WatchDocumentsRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchDocumentsRequest) ProtoMessage()    {}
+func (*WatchDocumentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{14}
 }
-func (m *UpdatePresenceRequest) XXX_Unmarshal(b []byte) error {
+func (m *WatchDocumentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdatePresenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WatchDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdatePresenceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WatchDocumentsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -771,50 +1017,61 @@ func (m *UpdatePresenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *UpdatePresenceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdatePresenceRequest.Merge(m, src)
+func (m *WatchDocumentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchDocumentsRequest.Merge(m, src)
 }
-func (m *UpdatePresenceRequest) XXX_Size() int {
+func (m *WatchDocumentsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *UpdatePresenceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdatePresenceRequest.DiscardUnknown(m)
+func (m *WatchDocumentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchDocumentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdatePresenceRequest proto.InternalMessageInfo
+var xxx_messageInfo_WatchDocumentsRequest proto.InternalMessageInfo
 
-func (m *UpdatePresenceRequest) GetClient() *Client {
+func (m *WatchDocumentsRequest) GetClient() *Client {
 	if m != nil {
 		return m.Client
 	}
 	return nil
 }
 
-func (m *UpdatePresenceRequest) GetDocumentKeys() []string {
+func (m *WatchDocumentsRequest) GetDocumentKeys() []string {
 	if m != nil {
 		return m.DocumentKeys
 	}
 	return nil
 }
 
-type UpdatePresenceResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *WatchDocumentsRequest) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
 }
 
-func (m *UpdatePresenceResponse) Reset()         { *m = UpdatePresenceResponse{} }
-func (m *UpdatePresenceResponse) String() string { return proto.CompactTextString(m) }
-func (*UpdatePresenceResponse) ProtoMessage()    {}
-func (*UpdatePresenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b60f170c5b305914, []int{13}
+type WatchDocumentsResponse struct {
+	// Types that are valid to be assigned to Body:
+	//	*WatchDocumentsResponse_Initialization_
+	//	*WatchDocumentsResponse_Event
+	Body                 isWatchDocumentsResponse_Body `protobuf_oneof:"body"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
-func (m *UpdatePresenceResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *WatchDocumentsResponse) Reset()         { *m = WatchDocumentsResponse{} }
+func (m *WatchDocumentsResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchDocumentsResponse) ProtoMessage()    {}
+func (*WatchDocumentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{15}
+}
+func (m *WatchDocumentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdatePresenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WatchDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdatePresenceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WatchDocumentsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -824,1406 +1081,5801 @@ func (m *UpdatePresenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *UpdatePresenceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdatePresenceResponse.Merge(m, src)
+func (m *WatchDocumentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchDocumentsResponse.Merge(m, src)
 }
-func (m *UpdatePresenceResponse) XXX_Size() int {
+func (m *WatchDocumentsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *UpdatePresenceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdatePresenceResponse.DiscardUnknown(m)
+func (m *WatchDocumentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchDocumentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdatePresenceResponse proto.InternalMessageInfo
+var xxx_messageInfo_WatchDocumentsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*ActivateClientRequest)(nil), "api.ActivateClientRequest")
-	proto.RegisterType((*ActivateClientResponse)(nil), "api.ActivateClientResponse")
-	proto.RegisterType((*DeactivateClientRequest)(nil), "api.DeactivateClientRequest")
-	proto.RegisterType((*DeactivateClientResponse)(nil), "api.DeactivateClientResponse")
-	proto.RegisterType((*AttachDocumentRequest)(nil), "api.AttachDocumentRequest")
-	proto.RegisterType((*AttachDocumentResponse)(nil), "api.AttachDocumentResponse")
-	proto.RegisterType((*DetachDocumentRequest)(nil), "api.DetachDocumentRequest")
-	proto.RegisterType((*DetachDocumentResponse)(nil), "api.DetachDocumentResponse")
-	proto.RegisterType((*WatchDocumentsRequest)(nil), "api.WatchDocumentsRequest")
-	proto.RegisterType((*WatchDocumentsResponse)(nil), "api.WatchDocumentsResponse")
-	proto.RegisterType((*WatchDocumentsResponse_Initialization)(nil), "api.WatchDocumentsResponse.Initialization")
-	proto.RegisterMapType((map[string]*Clients)(nil), "api.WatchDocumentsResponse.Initialization.PeersMapByDocEntry")
-	proto.RegisterType((*PushPullRequest)(nil), "api.PushPullRequest")
-	proto.RegisterType((*PushPullResponse)(nil), "api.PushPullResponse")
-	proto.RegisterType((*UpdatePresenceRequest)(nil), "api.UpdatePresenceRequest")
-	proto.RegisterType((*UpdatePresenceResponse)(nil), "api.UpdatePresenceResponse")
+type isWatchDocumentsResponse_Body interface {
+	isWatchDocumentsResponse_Body()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func init() { proto.RegisterFile("yorkie.proto", fileDescriptor_b60f170c5b305914) }
-
-var fileDescriptor_b60f170c5b305914 = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xdd, 0x6e, 0xd3, 0x30,
-	0x18, 0x6d, 0xda, 0xad, 0xda, 0xbe, 0x76, 0x5d, 0x65, 0xd1, 0x52, 0x65, 0xac, 0xaa, 0x32, 0x21,
-	0x55, 0x5c, 0x54, 0x53, 0x91, 0xc6, 0x8f, 0xc4, 0xc5, 0xb6, 0x22, 0x6d, 0xaa, 0x86, 0x4a, 0x34,
-	0x84, 0xb8, 0x0a, 0x9e, 0xe3, 0x6d, 0x56, 0xbb, 0x24, 0xc4, 0xce, 0xa4, 0xf0, 0x24, 0xf0, 0x46,
-	0xbb, 0xe4, 0x11, 0xd0, 0xb8, 0xe1, 0x31, 0x50, 0x62, 0x67, 0x5b, 0x32, 0x03, 0x05, 0x31, 0xee,
-	0xd2, 0xe3, 0x9c, 0x73, 0xbe, 0x53, 0xdb, 0x27, 0x50, 0x8f, 0xfd, 0x70, 0xca, 0xe8, 0x20, 0x08,
-	0x7d, 0xe1, 0xa3, 0x0a, 0x0e, 0x98, 0xb9, 0x1a, 0x52, 0xee, 0x47, 0x21, 0xa1, 0x5c, 0xa2, 0xd6,
-	0x16, 0xb4, 0xb6, 0x89, 0x60, 0xe7, 0x58, 0xd0, 0xdd, 0x19, 0xa3, 0x9e, 0xb0, 0xe9, 0x87, 0x88,
-	0x72, 0x81, 0xd6, 0x01, 0x48, 0x0a, 0x38, 0x53, 0x1a, 0x77, 0x8c, 0x9e, 0xd1, 0x5f, 0xb6, 0x97,
-	0x25, 0x32, 0xa6, 0xb1, 0x75, 0x08, 0xed, 0x22, 0x8f, 0x07, 0xbe, 0xc7, 0xe9, 0x6f, 0x88, 0x68,
-	0x0d, 0xd4, 0x0f, 0x87, 0xb9, 0x9d, 0x72, 0xcf, 0xe8, 0xd7, 0xed, 0x25, 0x09, 0xec, 0xbb, 0xd6,
-	0x16, 0xdc, 0x1f, 0x51, 0xac, 0x9d, 0x27, 0xc7, 0x33, 0x0a, 0xbc, 0x27, 0xd0, 0xb9, 0xcd, 0x53,
-	0xf3, 0xfc, 0x92, 0x78, 0x0c, 0xad, 0x6d, 0x21, 0x30, 0x39, 0x1d, 0xf9, 0x24, 0x3a, 0x9b, 0xd3,
-	0x0e, 0x6d, 0x42, 0x8d, 0x9c, 0x62, 0xef, 0x84, 0x3a, 0x01, 0x26, 0xd3, 0x34, 0x45, 0x6d, 0xb8,
-	0x3a, 0xc0, 0x01, 0x1b, 0xec, 0xa6, 0xf8, 0x04, 0x93, 0xa9, 0x0d, 0xe4, 0xea, 0xd9, 0x3a, 0x81,
-	0x76, 0xd1, 0x67, 0x8e, 0xf1, 0xfe, 0xc2, 0xe8, 0x18, 0x5a, 0x23, 0xfa, 0x1f, 0x02, 0x31, 0x68,
-	0x17, 0x7d, 0xe6, 0xdb, 0xff, 0x3f, 0xb7, 0xc2, 0xd0, 0x7a, 0x8b, 0xc5, 0xb5, 0x13, 0xcf, 0x22,
-	0x6d, 0x40, 0x55, 0xea, 0xa6, 0x2e, 0xb5, 0x61, 0x4d, 0xaa, 0xc8, 0xed, 0x57, 0x4b, 0x68, 0x03,
-	0x56, 0x5c, 0x45, 0x4c, 0x06, 0xe2, 0x9d, 0x72, 0xaf, 0xd2, 0x5f, 0xb6, 0xeb, 0x19, 0x38, 0xa6,
-	0x31, 0xb7, 0xbe, 0x97, 0xa1, 0x5d, 0xf4, 0x50, 0x71, 0x0e, 0xa1, 0xc1, 0x3c, 0x26, 0x18, 0x9e,
-	0xb1, 0x8f, 0x58, 0x30, 0xdf, 0x53, 0x66, 0x8f, 0x52, 0x33, 0x3d, 0x69, 0xb0, 0x9f, 0x63, 0xec,
-	0x95, 0xec, 0x82, 0x06, 0x7a, 0x08, 0x8b, 0xf4, 0x3c, 0x99, 0x5c, 0xe6, 0x5f, 0x49, 0xc5, 0x46,
-	0x3e, 0x79, 0x99, 0x80, 0x7b, 0x25, 0x5b, 0xae, 0x9a, 0x17, 0x06, 0x34, 0xf2, 0x5a, 0xe8, 0x18,
-	0x9a, 0x01, 0xa5, 0x21, 0x77, 0xce, 0x70, 0xe0, 0x1c, 0xc5, 0x8e, 0xeb, 0x93, 0x8e, 0xd1, 0xab,
-	0xf4, 0x6b, 0xc3, 0x17, 0xf3, 0x4f, 0x34, 0x98, 0x24, 0x12, 0x07, 0x38, 0xd8, 0x89, 0x13, 0x53,
-	0x4f, 0x84, 0xb1, 0xbd, 0x12, 0xdc, 0xc4, 0xcc, 0x57, 0x80, 0x6e, 0xbf, 0x84, 0x9a, 0x50, 0xb9,
-	0xde, 0xd5, 0xe4, 0x11, 0x59, 0xb0, 0x78, 0x8e, 0x67, 0x11, 0x55, 0x49, 0xea, 0x37, 0xf6, 0x80,
-	0xdb, 0x72, 0xe9, 0x79, 0xf9, 0xa9, 0xb1, 0x53, 0x85, 0x85, 0x23, 0xdf, 0x8d, 0xad, 0xf7, 0xb0,
-	0x3a, 0x89, 0xf8, 0xe9, 0x24, 0x9a, 0xcd, 0xee, 0xe8, 0x68, 0x62, 0x68, 0x5e, 0x3b, 0xdc, 0xcd,
-	0x2d, 0xc3, 0xd0, 0x7a, 0x13, 0xb8, 0x58, 0xd0, 0x49, 0x48, 0x39, 0xf5, 0x08, 0xfd, 0xf7, 0x47,
-	0xb2, 0x03, 0xed, 0xa2, 0x85, 0xcc, 0x32, 0xfc, 0xbc, 0x00, 0xd5, 0x77, 0x69, 0xb3, 0xa3, 0x31,
-	0x34, 0xf2, 0x2d, 0x8c, 0xcc, 0xd4, 0x50, 0x5b, 0xe9, 0xe6, 0x9a, 0x76, 0x4d, 0xaa, 0x5a, 0x25,
-	0xf4, 0x1a, 0x9a, 0xc5, 0x12, 0x45, 0x0f, 0xe4, 0xc1, 0xd4, 0x77, 0xb2, 0xb9, 0xfe, 0x93, 0xd5,
-	0x2b, 0xc9, 0x31, 0x34, 0xf2, 0x21, 0xd4, 0x7c, 0xda, 0x3f, 0x4f, 0xcd, 0xa7, 0x4f, 0x2d, 0xc5,
-	0xf2, 0x1d, 0x9a, 0x85, 0xd5, 0x15, 0x78, 0x16, 0x56, 0x5b, 0xba, 0x52, 0x2c, 0xdf, 0x5f, 0x4a,
-	0x4c, 0x5b, 0x9e, 0x4a, 0x4c, 0x5f, 0x78, 0x56, 0x09, 0x1d, 0x40, 0x23, 0x7f, 0xed, 0x94, 0x98,
-	0xb6, 0xb6, 0x94, 0x98, 0xfe, 0x9e, 0x5a, 0xa5, 0x4d, 0x03, 0x3d, 0x83, 0xa5, 0xec, 0x00, 0xa3,
-	0x7b, 0xe9, 0xcb, 0x85, 0x1b, 0x63, 0xb6, 0x0a, 0x68, 0x46, 0xde, 0x69, 0x5e, 0x5c, 0x76, 0x8d,
-	0x2f, 0x97, 0x5d, 0xe3, 0xeb, 0x65, 0xd7, 0xf8, 0xf4, 0xad, 0x5b, 0x3a, 0xaa, 0xa6, 0xdf, 0xf9,
-	0xc7, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x4e, 0xf7, 0xc4, 0xde, 0x0d, 0x08, 0x00, 0x00,
+type WatchDocumentsResponse_Initialization_ struct {
+	Initialization *WatchDocumentsResponse_Initialization `protobuf:"bytes,1,opt,name=initialization,proto3,oneof" json:"initialization,omitempty"`
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// YorkieClient is the client API for Yorkie service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type YorkieClient interface {
-	ActivateClient(ctx context.Context, in *ActivateClientRequest, opts ...grpc.CallOption) (*ActivateClientResponse, error)
-	DeactivateClient(ctx context.Context, in *DeactivateClientRequest, opts ...grpc.CallOption) (*DeactivateClientResponse, error)
-	UpdatePresence(ctx context.Context, in *UpdatePresenceRequest, opts ...grpc.CallOption) (*UpdatePresenceResponse, error)
-	AttachDocument(ctx context.Context, in *AttachDocumentRequest, opts ...grpc.CallOption) (*AttachDocumentResponse, error)
-	DetachDocument(ctx context.Context, in *DetachDocumentRequest, opts ...grpc.CallOption) (*DetachDocumentResponse, error)
-	WatchDocuments(ctx context.Context, in *WatchDocumentsRequest, opts ...grpc.CallOption) (Yorkie_WatchDocumentsClient, error)
-	PushPull(ctx context.Context, in *PushPullRequest, opts ...grpc.CallOption) (*PushPullResponse, error)
+type WatchDocumentsResponse_Event struct {
+	Event *DocEvent `protobuf:"bytes,2,opt,name=event,proto3,oneof" json:"event,omitempty"`
 }
 
-type yorkieClient struct {
-	cc *grpc.ClientConn
-}
+func (*WatchDocumentsResponse_Initialization_) isWatchDocumentsResponse_Body() {}
+func (*WatchDocumentsResponse_Event) isWatchDocumentsResponse_Body()           {}
 
-func NewYorkieClient(cc *grpc.ClientConn) YorkieClient {
-	return &yorkieClient{cc}
+func (m *WatchDocumentsResponse) GetBody() isWatchDocumentsResponse_Body {
+	if m != nil {
+		return m.Body
+	}
+	return nil
 }
 
-func (c *yorkieClient) ActivateClient(ctx context.Context, in *ActivateClientRequest, opts ...grpc.CallOption) (*ActivateClientResponse, error) {
-	out := new(ActivateClientResponse)
-	err := c.cc.Invoke(ctx, "/api.Yorkie/ActivateClient", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *WatchDocumentsResponse) GetInitialization() *WatchDocumentsResponse_Initialization {
+	if x, ok := m.GetBody().(*WatchDocumentsResponse_Initialization_); ok {
+		return x.Initialization
 	}
-	return out, nil
+	return nil
 }
 
-func (c *yorkieClient) DeactivateClient(ctx context.Context, in *DeactivateClientRequest, opts ...grpc.CallOption) (*DeactivateClientResponse, error) {
-	out := new(DeactivateClientResponse)
-	err := c.cc.Invoke(ctx, "/api.Yorkie/DeactivateClient", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *WatchDocumentsResponse) GetEvent() *DocEvent {
+	if x, ok := m.GetBody().(*WatchDocumentsResponse_Event); ok {
+		return x.Event
 	}
-	return out, nil
+	return nil
 }
 
-func (c *yorkieClient) UpdatePresence(ctx context.Context, in *UpdatePresenceRequest, opts ...grpc.CallOption) (*UpdatePresenceResponse, error) {
-	out := new(UpdatePresenceResponse)
-	err := c.cc.Invoke(ctx, "/api.Yorkie/UpdatePresence", in, out, opts...)
-	if err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*WatchDocumentsResponse) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*WatchDocumentsResponse_Initialization_)(nil),
+		(*WatchDocumentsResponse_Event)(nil),
 	}
-	return out, nil
 }
 
-func (c *yorkieClient) AttachDocument(ctx context.Context, in *AttachDocumentRequest, opts ...grpc.CallOption) (*AttachDocumentResponse, error) {
-	out := new(AttachDocumentResponse)
-	err := c.cc.Invoke(ctx, "/api.Yorkie/AttachDocument", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type WatchDocumentsResponse_Initialization struct {
+	PeersMapByDoc        map[string]*Clients `protobuf:"bytes,1,rep,name=peers_map_by_doc,json=peersMapByDoc,proto3" json:"peers_map_by_doc,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (c *yorkieClient) DetachDocument(ctx context.Context, in *DetachDocumentRequest, opts ...grpc.CallOption) (*DetachDocumentResponse, error) {
-	out := new(DetachDocumentResponse)
-	err := c.cc.Invoke(ctx, "/api.Yorkie/DetachDocument", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *WatchDocumentsResponse_Initialization) Reset()         { *m = WatchDocumentsResponse_Initialization{} }
+func (m *WatchDocumentsResponse_Initialization) String() string { return proto.CompactTextString(m) }
+func (*WatchDocumentsResponse_Initialization) ProtoMessage()    {}
+func (*WatchDocumentsResponse_Initialization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{15, 0}
+}
+func (m *WatchDocumentsResponse_Initialization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchDocumentsResponse_Initialization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchDocumentsResponse_Initialization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *WatchDocumentsResponse_Initialization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchDocumentsResponse_Initialization.Merge(m, src)
+}
+func (m *WatchDocumentsResponse_Initialization) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchDocumentsResponse_Initialization) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchDocumentsResponse_Initialization.DiscardUnknown(m)
 }
 
-func (c *yorkieClient) WatchDocuments(ctx context.Context, in *WatchDocumentsRequest, opts ...grpc.CallOption) (Yorkie_WatchDocumentsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Yorkie_serviceDesc.Streams[0], "/api.Yorkie/WatchDocuments", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &yorkieWatchDocumentsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_WatchDocumentsResponse_Initialization proto.InternalMessageInfo
+
+func (m *WatchDocumentsResponse_Initialization) GetPeersMapByDoc() map[string]*Clients {
+	if m != nil {
+		return m.PeersMapByDoc
 	}
-	return x, nil
+	return nil
 }
 
-type Yorkie_WatchDocumentsClient interface {
-	Recv() (*WatchDocumentsResponse, error)
-	grpc.ClientStream
+type PushPullRequest struct {
+	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
+	ConsistencyToken     string      `protobuf:"bytes,3,opt,name=consistency_token,json=consistencyToken,proto3" json:"consistency_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-type yorkieWatchDocumentsClient struct {
-	grpc.ClientStream
+func (m *PushPullRequest) Reset()         { *m = PushPullRequest{} }
+func (m *PushPullRequest) String() string { return proto.CompactTextString(m) }
+func (*PushPullRequest) ProtoMessage()    {}
+func (*PushPullRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{16}
 }
-
-func (x *yorkieWatchDocumentsClient) Recv() (*WatchDocumentsResponse, error) {
-	m := new(WatchDocumentsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *PushPullRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PushPullRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PushPullRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *PushPullRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushPullRequest.Merge(m, src)
+}
+func (m *PushPullRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PushPullRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushPullRequest.DiscardUnknown(m)
 }
 
-func (c *yorkieClient) PushPull(ctx context.Context, in *PushPullRequest, opts ...grpc.CallOption) (*PushPullResponse, error) {
-	out := new(PushPullResponse)
-	err := c.cc.Invoke(ctx, "/api.Yorkie/PushPull", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PushPullRequest proto.InternalMessageInfo
+
+func (m *PushPullRequest) GetClientId() []byte {
+	if m != nil {
+		return m.ClientId
 	}
-	return out, nil
+	return nil
 }
 
-// YorkieServer is the server API for Yorkie service.
-type YorkieServer interface {
-	ActivateClient(context.Context, *ActivateClientRequest) (*ActivateClientResponse, error)
-	DeactivateClient(context.Context, *DeactivateClientRequest) (*DeactivateClientResponse, error)
-	UpdatePresence(context.Context, *UpdatePresenceRequest) (*UpdatePresenceResponse, error)
-	AttachDocument(context.Context, *AttachDocumentRequest) (*AttachDocumentResponse, error)
-	DetachDocument(context.Context, *DetachDocumentRequest) (*DetachDocumentResponse, error)
-	WatchDocuments(*WatchDocumentsRequest, Yorkie_WatchDocumentsServer) error
-	PushPull(context.Context, *PushPullRequest) (*PushPullResponse, error)
+func (m *PushPullRequest) GetChangePack() *ChangePack {
+	if m != nil {
+		return m.ChangePack
+	}
+	return nil
 }
 
-// UnimplementedYorkieServer can be embedded to have forward compatible implementations.
-type UnimplementedYorkieServer struct {
+func (m *PushPullRequest) GetConsistencyToken() string {
+	if m != nil {
+		return m.ConsistencyToken
+	}
+	return ""
 }
 
-func (*UnimplementedYorkieServer) ActivateClient(ctx context.Context, req *ActivateClientRequest) (*ActivateClientResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActivateClient not implemented")
+type PushPullResponse struct {
+	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
+	ConsistencyToken     string      `protobuf:"bytes,3,opt,name=consistency_token,json=consistencyToken,proto3" json:"consistency_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
-func (*UnimplementedYorkieServer) DeactivateClient(ctx context.Context, req *DeactivateClientRequest) (*DeactivateClientResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeactivateClient not implemented")
+
+func (m *PushPullResponse) Reset()         { *m = PushPullResponse{} }
+func (m *PushPullResponse) String() string { return proto.CompactTextString(m) }
+func (*PushPullResponse) ProtoMessage()    {}
+func (*PushPullResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{17}
 }
-func (*UnimplementedYorkieServer) UpdatePresence(ctx context.Context, req *UpdatePresenceRequest) (*UpdatePresenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePresence not implemented")
+func (m *PushPullResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedYorkieServer) AttachDocument(ctx context.Context, req *AttachDocumentRequest) (*AttachDocumentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AttachDocument not implemented")
+func (m *PushPullResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PushPullResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedYorkieServer) DetachDocument(ctx context.Context, req *DetachDocumentRequest) (*DetachDocumentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DetachDocument not implemented")
+func (m *PushPullResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushPullResponse.Merge(m, src)
 }
-func (*UnimplementedYorkieServer) WatchDocuments(req *WatchDocumentsRequest, srv Yorkie_WatchDocumentsServer) error {
-	return status.Errorf(codes.Unimplemented, "method WatchDocuments not implemented")
+func (m *PushPullResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedYorkieServer) PushPull(ctx context.Context, req *PushPullRequest) (*PushPullResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PushPull not implemented")
+func (m *PushPullResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushPullResponse.DiscardUnknown(m)
 }
 
-func RegisterYorkieServer(s *grpc.Server, srv YorkieServer) {
-	s.RegisterService(&_Yorkie_serviceDesc, srv)
-}
+var xxx_messageInfo_PushPullResponse proto.InternalMessageInfo
 
-func _Yorkie_ActivateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ActivateClientRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(YorkieServer).ActivateClient(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Yorkie/ActivateClient",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YorkieServer).ActivateClient(ctx, req.(*ActivateClientRequest))
+func (m *PushPullResponse) GetClientId() []byte {
+	if m != nil {
+		return m.ClientId
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Yorkie_DeactivateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeactivateClientRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(YorkieServer).DeactivateClient(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Yorkie/DeactivateClient",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YorkieServer).DeactivateClient(ctx, req.(*DeactivateClientRequest))
+func (m *PushPullResponse) GetChangePack() *ChangePack {
+	if m != nil {
+		return m.ChangePack
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Yorkie_UpdatePresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePresenceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(YorkieServer).UpdatePresence(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Yorkie/UpdatePresence",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YorkieServer).UpdatePresence(ctx, req.(*UpdatePresenceRequest))
+func (m *PushPullResponse) GetConsistencyToken() string {
+	if m != nil {
+		return m.ConsistencyToken
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Yorkie_AttachDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AttachDocumentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(YorkieServer).AttachDocument(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Yorkie/AttachDocument",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YorkieServer).AttachDocument(ctx, req.(*AttachDocumentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type TransactDocumentsRequest struct {
+	ClientId             []byte        `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ChangePacks          []*ChangePack `protobuf:"bytes,2,rep,name=change_packs,json=changePacks,proto3" json:"change_packs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func _Yorkie_DetachDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DetachDocumentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(YorkieServer).DetachDocument(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Yorkie/DetachDocument",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YorkieServer).DetachDocument(ctx, req.(*DetachDocumentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *TransactDocumentsRequest) Reset()         { *m = TransactDocumentsRequest{} }
+func (m *TransactDocumentsRequest) String() string { return proto.CompactTextString(m) }
+func (*TransactDocumentsRequest) ProtoMessage()    {}
+func (*TransactDocumentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{18}
 }
-
-func _Yorkie_WatchDocuments_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(WatchDocumentsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *TransactDocumentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransactDocumentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return srv.(YorkieServer).WatchDocuments(m, &yorkieWatchDocumentsServer{stream})
 }
-
-type Yorkie_WatchDocumentsServer interface {
-	Send(*WatchDocumentsResponse) error
-	grpc.ServerStream
+func (m *TransactDocumentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactDocumentsRequest.Merge(m, src)
 }
-
-type yorkieWatchDocumentsServer struct {
-	grpc.ServerStream
+func (m *TransactDocumentsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *yorkieWatchDocumentsServer) Send(m *WatchDocumentsResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *TransactDocumentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactDocumentsRequest.DiscardUnknown(m)
 }
 
-func _Yorkie_PushPull_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PushPullRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(YorkieServer).PushPull(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Yorkie/PushPull",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YorkieServer).PushPull(ctx, req.(*PushPullRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_TransactDocumentsRequest proto.InternalMessageInfo
 
-var _Yorkie_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "api.Yorkie",
-	HandlerType: (*YorkieServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ActivateClient",
-			Handler:    _Yorkie_ActivateClient_Handler,
-		},
-		{
-			MethodName: "DeactivateClient",
-			Handler:    _Yorkie_DeactivateClient_Handler,
-		},
-		{
-			MethodName: "UpdatePresence",
-			Handler:    _Yorkie_UpdatePresence_Handler,
-		},
-		{
-			MethodName: "AttachDocument",
-			Handler:    _Yorkie_AttachDocument_Handler,
-		},
-		{
-			MethodName: "DetachDocument",
-			Handler:    _Yorkie_DetachDocument_Handler,
-		},
-		{
-			MethodName: "PushPull",
-			Handler:    _Yorkie_PushPull_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "WatchDocuments",
-			Handler:       _Yorkie_WatchDocuments_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "yorkie.proto",
+func (m *TransactDocumentsRequest) GetClientId() []byte {
+	if m != nil {
+		return m.ClientId
+	}
+	return nil
 }
 
-func (m *ActivateClientRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TransactDocumentsRequest) GetChangePacks() []*ChangePack {
+	if m != nil {
+		return m.ChangePacks
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ActivateClientRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type TransactDocumentsResponse struct {
+	Results              []*TransactDocumentsResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
 }
 
-func (m *ActivateClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ClientKey) > 0 {
-		i -= len(m.ClientKey)
-		copy(dAtA[i:], m.ClientKey)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientKey)))
-		i--
-		dAtA[i] = 0xa
+func (m *TransactDocumentsResponse) Reset()         { *m = TransactDocumentsResponse{} }
+func (m *TransactDocumentsResponse) String() string { return proto.CompactTextString(m) }
+func (*TransactDocumentsResponse) ProtoMessage()    {}
+func (*TransactDocumentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{19}
+}
+func (m *TransactDocumentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransactDocumentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TransactDocumentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactDocumentsResponse.Merge(m, src)
+}
+func (m *TransactDocumentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactDocumentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactDocumentsResponse.DiscardUnknown(m)
 }
 
-func (m *ActivateClientResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TransactDocumentsResponse proto.InternalMessageInfo
+
+func (m *TransactDocumentsResponse) GetResults() []*TransactDocumentsResponse_Result {
+	if m != nil {
+		return m.Results
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ActivateClientResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type TransactDocumentsResponse_Result struct {
+	DocumentKey          string      `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
+	ConsistencyToken     string      `protobuf:"bytes,3,opt,name=consistency_token,json=consistencyToken,proto3" json:"consistency_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *ActivateClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *TransactDocumentsResponse_Result) Reset()         { *m = TransactDocumentsResponse_Result{} }
+func (m *TransactDocumentsResponse_Result) String() string { return proto.CompactTextString(m) }
+func (*TransactDocumentsResponse_Result) ProtoMessage()    {}
+func (*TransactDocumentsResponse_Result) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{19, 0}
+}
+func (m *TransactDocumentsResponse_Result) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactDocumentsResponse_Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransactDocumentsResponse_Result.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *TransactDocumentsResponse_Result) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactDocumentsResponse_Result.Merge(m, src)
+}
+func (m *TransactDocumentsResponse_Result) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactDocumentsResponse_Result) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactDocumentsResponse_Result.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactDocumentsResponse_Result proto.InternalMessageInfo
+
+func (m *TransactDocumentsResponse_Result) GetDocumentKey() string {
+	if m != nil {
+		return m.DocumentKey
 	}
-	if len(m.ClientKey) > 0 {
-		i -= len(m.ClientKey)
-		copy(dAtA[i:], m.ClientKey)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientKey)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *TransactDocumentsResponse_Result) GetChangePack() *ChangePack {
+	if m != nil {
+		return m.ChangePack
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *DeactivateClientRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TransactDocumentsResponse_Result) GetConsistencyToken() string {
+	if m != nil {
+		return m.ConsistencyToken
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DeactivateClientRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ValidateChangeRequest struct {
+	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *DeactivateClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ValidateChangeRequest) Reset()         { *m = ValidateChangeRequest{} }
+func (m *ValidateChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateChangeRequest) ProtoMessage()    {}
+func (*ValidateChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{20}
+}
+func (m *ValidateChangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidateChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateChangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ValidateChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateChangeRequest.Merge(m, src)
+}
+func (m *ValidateChangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidateChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateChangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateChangeRequest proto.InternalMessageInfo
+
+func (m *ValidateChangeRequest) GetClientId() []byte {
+	if m != nil {
+		return m.ClientId
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *DeactivateClientResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidateChangeRequest) GetChangePack() *ChangePack {
+	if m != nil {
+		return m.ChangePack
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DeactivateClientResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ValidateChangeResponse struct {
+	Accepted             bool                                `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	ErrorCode            int32                               `protobuf:"varint,2,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorMessage         string                              `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Violations           []*ValidateChangeResponse_Violation `protobuf:"bytes,4,rep,name=violations,proto3" json:"violations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
 }
 
-func (m *DeactivateClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+func (m *ValidateChangeResponse) Reset()         { *m = ValidateChangeResponse{} }
+func (m *ValidateChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateChangeResponse) ProtoMessage()    {}
+func (*ValidateChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{21}
+}
+func (m *ValidateChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidateChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ValidateChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateChangeResponse.Merge(m, src)
+}
+func (m *ValidateChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidateChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateChangeResponse.DiscardUnknown(m)
 }
 
-func (m *AttachDocumentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidateChangeResponse proto.InternalMessageInfo
+
+func (m *ValidateChangeResponse) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *AttachDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidateChangeResponse) GetErrorCode() int32 {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return 0
 }
 
-func (m *AttachDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ChangePack != nil {
-		{
-			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+func (m *ValidateChangeResponse) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AttachDocumentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidateChangeResponse) GetViolations() []*ValidateChangeResponse_Violation {
+	if m != nil {
+		return m.Violations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AttachDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ValidateChangeResponse_Violation struct {
+	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AttachDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ChangePack != nil {
-		{
-			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+func (m *ValidateChangeResponse_Violation) Reset()         { *m = ValidateChangeResponse_Violation{} }
+func (m *ValidateChangeResponse_Violation) String() string { return proto.CompactTextString(m) }
+func (*ValidateChangeResponse_Violation) ProtoMessage()    {}
+func (*ValidateChangeResponse_Violation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{21, 0}
+}
+func (m *ValidateChangeResponse_Violation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidateChangeResponse_Violation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateChangeResponse_Violation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ValidateChangeResponse_Violation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateChangeResponse_Violation.Merge(m, src)
+}
+func (m *ValidateChangeResponse_Violation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidateChangeResponse_Violation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateChangeResponse_Violation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateChangeResponse_Violation proto.InternalMessageInfo
+
+func (m *ValidateChangeResponse_Violation) GetField() string {
+	if m != nil {
+		return m.Field
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DetachDocumentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidateChangeResponse_Violation) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DetachDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PushChangesStreamRequest struct {
+	ClientId             []byte   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Seq                  uint32   `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	TotalSize            uint64   `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	Chunk                []byte   `protobuf:"bytes,4,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DetachDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ChangePack != nil {
-		{
-			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+func (m *PushChangesStreamRequest) Reset()         { *m = PushChangesStreamRequest{} }
+func (m *PushChangesStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*PushChangesStreamRequest) ProtoMessage()    {}
+func (*PushChangesStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{22}
+}
+func (m *PushChangesStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PushChangesStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PushChangesStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PushChangesStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushChangesStreamRequest.Merge(m, src)
+}
+func (m *PushChangesStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PushChangesStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushChangesStreamRequest.DiscardUnknown(m)
 }
 
-func (m *DetachDocumentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PushChangesStreamRequest proto.InternalMessageInfo
+
+func (m *PushChangesStreamRequest) GetClientId() []byte {
+	if m != nil {
+		return m.ClientId
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DetachDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PushChangesStreamRequest) GetSeq() uint32 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
 }
 
-func (m *DetachDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ChangePack != nil {
-		{
-			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClientKey) > 0 {
-		i -= len(m.ClientKey)
-		copy(dAtA[i:], m.ClientKey)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientKey)))
-		i--
-		dAtA[i] = 0xa
+func (m *PushChangesStreamRequest) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *WatchDocumentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PushChangesStreamRequest) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *WatchDocumentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type UpdatePresenceRequest struct {
+	Client               *Client  `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	DocumentKeys         []string `protobuf:"bytes,2,rep,name=document_keys,json=documentKeys,proto3" json:"document_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WatchDocumentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DocumentKeys) > 0 {
-		for iNdEx := len(m.DocumentKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DocumentKeys[iNdEx])
-			copy(dAtA[i:], m.DocumentKeys[iNdEx])
-			i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentKeys[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *UpdatePresenceRequest) Reset()         { *m = UpdatePresenceRequest{} }
+func (m *UpdatePresenceRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdatePresenceRequest) ProtoMessage()    {}
+func (*UpdatePresenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{23}
+}
+func (m *UpdatePresenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdatePresenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdatePresenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Client != nil {
-		{
-			size, err := m.Client.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *UpdatePresenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePresenceRequest.Merge(m, src)
+}
+func (m *UpdatePresenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdatePresenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePresenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatePresenceRequest proto.InternalMessageInfo
+
+func (m *UpdatePresenceRequest) GetClient() *Client {
+	if m != nil {
+		return m.Client
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *WatchDocumentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UpdatePresenceRequest) GetDocumentKeys() []string {
+	if m != nil {
+		return m.DocumentKeys
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *WatchDocumentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type UpdatePresenceResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WatchDocumentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Body != nil {
-		{
-			size := m.Body.Size()
-			i -= size
-			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *UpdatePresenceResponse) Reset()         { *m = UpdatePresenceResponse{} }
+func (m *UpdatePresenceResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdatePresenceResponse) ProtoMessage()    {}
+func (*UpdatePresenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60f170c5b305914, []int{24}
+}
+func (m *UpdatePresenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdatePresenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdatePresenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UpdatePresenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePresenceResponse.Merge(m, src)
+}
+func (m *UpdatePresenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdatePresenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePresenceResponse.DiscardUnknown(m)
 }
 
-func (m *WatchDocumentsResponse_Initialization_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_UpdatePresenceResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ActivateClientRequest)(nil), "api.ActivateClientRequest")
+	proto.RegisterType((*ActivateClientResponse)(nil), "api.ActivateClientResponse")
+	proto.RegisterType((*DeactivateClientRequest)(nil), "api.DeactivateClientRequest")
+	proto.RegisterType((*DeactivateClientResponse)(nil), "api.DeactivateClientResponse")
+	proto.RegisterType((*AttachDocumentRequest)(nil), "api.AttachDocumentRequest")
+	proto.RegisterType((*AttachDocumentResponse)(nil), "api.AttachDocumentResponse")
+	proto.RegisterType((*CreateDocumentRequest)(nil), "api.CreateDocumentRequest")
+	proto.RegisterType((*CreateDocumentResponse)(nil), "api.CreateDocumentResponse")
+	proto.RegisterType((*DetachDocumentRequest)(nil), "api.DetachDocumentRequest")
+	proto.RegisterType((*DetachDocumentResponse)(nil), "api.DetachDocumentResponse")
+	proto.RegisterType((*AttachDocumentsRequest)(nil), "api.AttachDocumentsRequest")
+	proto.RegisterType((*AttachDocumentsResponse)(nil), "api.AttachDocumentsResponse")
+	proto.RegisterType((*AttachDocumentsResponse_Result)(nil), "api.AttachDocumentsResponse.Result")
+	proto.RegisterType((*DetachDocumentsRequest)(nil), "api.DetachDocumentsRequest")
+	proto.RegisterType((*DetachDocumentsResponse)(nil), "api.DetachDocumentsResponse")
+	proto.RegisterType((*DetachDocumentsResponse_Result)(nil), "api.DetachDocumentsResponse.Result")
+	proto.RegisterType((*WatchDocumentsRequest)(nil), "api.WatchDocumentsRequest")
+	proto.RegisterType((*WatchDocumentsResponse)(nil), "api.WatchDocumentsResponse")
+	proto.RegisterType((*WatchDocumentsResponse_Initialization)(nil), "api.WatchDocumentsResponse.Initialization")
+	proto.RegisterMapType((map[string]*Clients)(nil), "api.WatchDocumentsResponse.Initialization.PeersMapByDocEntry")
+	proto.RegisterType((*PushPullRequest)(nil), "api.PushPullRequest")
+	proto.RegisterType((*PushPullResponse)(nil), "api.PushPullResponse")
+	proto.RegisterType((*TransactDocumentsRequest)(nil), "api.TransactDocumentsRequest")
+	proto.RegisterType((*TransactDocumentsResponse)(nil), "api.TransactDocumentsResponse")
+	proto.RegisterType((*TransactDocumentsResponse_Result)(nil), "api.TransactDocumentsResponse.Result")
+	proto.RegisterType((*ValidateChangeRequest)(nil), "api.ValidateChangeRequest")
+	proto.RegisterType((*ValidateChangeResponse)(nil), "api.ValidateChangeResponse")
+	proto.RegisterType((*ValidateChangeResponse_Violation)(nil), "api.ValidateChangeResponse.Violation")
+	proto.RegisterType((*PushChangesStreamRequest)(nil), "api.PushChangesStreamRequest")
+	proto.RegisterType((*UpdatePresenceRequest)(nil), "api.UpdatePresenceRequest")
+	proto.RegisterType((*UpdatePresenceResponse)(nil), "api.UpdatePresenceResponse")
 }
 
-func (m *WatchDocumentsResponse_Initialization_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Initialization != nil {
-		{
-			size, err := m.Initialization.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("yorkie.proto", fileDescriptor_b60f170c5b305914) }
+
+var fileDescriptor_b60f170c5b305914 = []byte{
+	// 1376 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x41, 0x6f, 0xdb, 0xc6,
+	0x12, 0x36, 0x25, 0xdb, 0x91, 0xc6, 0xb2, 0x2d, 0xef, 0x8b, 0x14, 0x3e, 0x2a, 0xf1, 0xf3, 0x63,
+	0x10, 0xc0, 0x48, 0x01, 0x25, 0x70, 0x81, 0xa4, 0x29, 0x10, 0x14, 0xb1, 0x95, 0x22, 0x86, 0xe1,
+	0xd4, 0xa5, 0xdd, 0x04, 0x3d, 0x11, 0x6b, 0x72, 0x64, 0xb3, 0xa2, 0x48, 0x66, 0x97, 0x72, 0xa0,
+	0x1c, 0x72, 0x69, 0x4f, 0x05, 0xda, 0x73, 0x4f, 0x3d, 0xf5, 0xd0, 0x9f, 0x92, 0x53, 0xd1, 0x9f,
+	0x50, 0xa4, 0x97, 0xf6, 0x1f, 0xf4, 0x58, 0x70, 0x97, 0x94, 0x25, 0x6a, 0xa5, 0xd8, 0x45, 0x95,
+	0x1b, 0x39, 0xb3, 0xfb, 0x7d, 0x33, 0xdf, 0xee, 0xce, 0xce, 0x42, 0xa5, 0x1f, 0xb2, 0x8e, 0x87,
+	0xcd, 0x88, 0x85, 0x71, 0x48, 0x8a, 0x34, 0xf2, 0x8c, 0x55, 0x86, 0x3c, 0xec, 0x31, 0x07, 0xb9,
+	0xb4, 0x1a, 0xeb, 0x27, 0x61, 0x78, 0xe2, 0xe3, 0x1d, 0xf1, 0x77, 0xdc, 0x6b, 0xdf, 0x79, 0xc9,
+	0x68, 0x14, 0x21, 0x4b, 0xfd, 0xa6, 0x05, 0xb5, 0x47, 0x4e, 0xec, 0x9d, 0xd1, 0x18, 0x77, 0x7c,
+	0x0f, 0x83, 0xd8, 0xc2, 0x17, 0x3d, 0xe4, 0x31, 0xb9, 0x01, 0xe0, 0x08, 0x83, 0xdd, 0xc1, 0xbe,
+	0xae, 0x6d, 0x68, 0x9b, 0x65, 0xab, 0x2c, 0x2d, 0x7b, 0xd8, 0x27, 0x06, 0x94, 0x3c, 0x17, 0x83,
+	0xd8, 0x8b, 0xfb, 0x7a, 0x41, 0x38, 0x07, 0xff, 0xe6, 0x6b, 0xa8, 0xe7, 0x31, 0x79, 0x14, 0x06,
+	0x1c, 0xdf, 0x05, 0xda, 0x80, 0xf4, 0xc7, 0xf6, 0x5c, 0x81, 0x5a, 0xb1, 0x4a, 0xd2, 0xb0, 0xeb,
+	0x92, 0x4d, 0xa8, 0x32, 0x74, 0x42, 0xe6, 0xda, 0x2f, 0xa9, 0xef, 0xdb, 0xb1, 0xd7, 0x45, 0xbd,
+	0xb8, 0xa1, 0x6d, 0x96, 0xac, 0x15, 0x69, 0x7f, 0x4e, 0x7d, 0xff, 0xc8, 0xeb, 0xa2, 0x79, 0x0f,
+	0xae, 0xb5, 0x90, 0x2a, 0xb3, 0x1a, 0x61, 0xd0, 0x46, 0x19, 0xcc, 0xfb, 0xa0, 0x8f, 0xcf, 0x4b,
+	0x23, 0x9f, 0x3a, 0xf1, 0xa7, 0x02, 0xd4, 0x1e, 0xc5, 0x31, 0x75, 0x4e, 0x5b, 0xa1, 0xd3, 0xeb,
+	0x5e, 0x90, 0x8f, 0xdc, 0x85, 0x25, 0xe7, 0x94, 0x06, 0x27, 0x68, 0x47, 0xd4, 0xe9, 0x88, 0x84,
+	0x97, 0xb6, 0x56, 0x9b, 0x34, 0xf2, 0x9a, 0x3b, 0xc2, 0x7e, 0x40, 0x9d, 0x8e, 0x05, 0xce, 0xe0,
+	0x3b, 0x81, 0x63, 0x48, 0x5d, 0x3b, 0x0c, 0xfc, 0x7e, 0x9a, 0x7c, 0x29, 0x31, 0x7c, 0x16, 0xf8,
+	0x7d, 0x72, 0x1b, 0xd6, 0x62, 0xca, 0x4e, 0x30, 0xb6, 0x39, 0xb2, 0x33, 0x64, 0x36, 0xc7, 0x17,
+	0xfa, 0xfc, 0x86, 0xb6, 0x39, 0x6f, 0xad, 0x4a, 0xc7, 0xa1, 0xb0, 0x1f, 0xe2, 0x0b, 0xf2, 0x29,
+	0xac, 0x39, 0x0c, 0x69, 0x8c, 0xb6, 0xd7, 0xb6, 0xbb, 0x1e, 0xe7, 0x5e, 0x70, 0xa2, 0x2f, 0x88,
+	0x00, 0x8c, 0xa6, 0xdc, 0x32, 0xcd, 0x6c, 0xcb, 0x34, 0xb7, 0xc3, 0xd0, 0x7f, 0x46, 0xfd, 0x1e,
+	0x5a, 0xab, 0x72, 0xd2, 0x6e, 0x7b, 0x5f, 0x4e, 0x21, 0x1f, 0xc0, 0x9a, 0x13, 0x06, 0xdc, 0xe3,
+	0x31, 0x06, 0x4e, 0xdf, 0x8e, 0xc3, 0x0e, 0x06, 0xfa, 0xa2, 0x58, 0xd7, 0xea, 0x90, 0xe3, 0x28,
+	0xb1, 0x9b, 0x3f, 0x16, 0xa0, 0x9e, 0x97, 0xe9, 0x02, 0xf2, 0xfe, 0x03, 0x9d, 0x36, 0x60, 0x89,
+	0x0d, 0x56, 0xd2, 0x4d, 0x95, 0x1a, 0x36, 0x91, 0x26, 0xfc, 0x27, 0x3c, 0xfe, 0x0a, 0x9d, 0xd8,
+	0xee, 0x22, 0x4b, 0x90, 0x43, 0xdf, 0x73, 0xfa, 0x42, 0xae, 0xb2, 0xb5, 0x26, 0x5d, 0xfb, 0x89,
+	0xe7, 0x40, 0x38, 0xd4, 0x89, 0x2e, 0xa8, 0x13, 0x25, 0xf7, 0xe0, 0x1a, 0xc3, 0x6e, 0x78, 0x86,
+	0xae, 0xed, 0xa6, 0x99, 0xda, 0x09, 0x75, 0x98, 0x69, 0x53, 0x4b, 0xdd, 0x99, 0x0e, 0x8f, 0x84,
+	0xd3, 0x7c, 0x0e, 0xb5, 0x1d, 0x21, 0xf0, 0xa5, 0xb6, 0xd1, 0xff, 0xa1, 0x32, 0x60, 0x49, 0x8e,
+	0x95, 0x3c, 0x8e, 0x4b, 0x99, 0x6d, 0x0f, 0xfb, 0xe6, 0x03, 0xa8, 0xe7, 0x81, 0x53, 0xe1, 0xff,
+	0x07, 0x83, 0x81, 0x19, 0x76, 0xd9, 0x82, 0xcc, 0xb4, 0xeb, 0x9a, 0x6d, 0xa8, 0xb5, 0x70, 0xf6,
+	0x5b, 0xdb, 0xf4, 0xa0, 0x9e, 0xe7, 0xb9, 0x58, 0xd1, 0xb8, 0x3c, 0xd5, 0x2f, 0x5a, 0x7e, 0x1f,
+	0xf2, 0x0b, 0x25, 0xb5, 0x05, 0x95, 0x21, 0x26, 0xae, 0x17, 0x36, 0x8a, 0x2a, 0xaa, 0xa5, 0x73,
+	0x2a, 0x3e, 0xfd, 0xc4, 0x2a, 0x4f, 0xe1, 0xfc, 0xa5, 0x4f, 0xa1, 0xf9, 0x75, 0x01, 0xae, 0x8d,
+	0x25, 0x94, 0xaa, 0xf7, 0x10, 0xae, 0x30, 0xe4, 0x3d, 0x3f, 0xe6, 0xba, 0x26, 0xe2, 0xbd, 0x29,
+	0xe2, 0x9d, 0x30, 0xbc, 0x69, 0x89, 0xb1, 0x56, 0x36, 0xc7, 0xf8, 0x59, 0x83, 0x45, 0x69, 0x1b,
+	0xdb, 0x67, 0xda, 0xd8, 0x3e, 0x23, 0xf7, 0xa1, 0xc4, 0x52, 0xa4, 0x74, 0x21, 0x1a, 0x0a, 0xb6,
+	0x8c, 0xcc, 0x2a, 0xb1, 0xa1, 0x35, 0x46, 0xc6, 0x42, 0x66, 0x3b, 0xa1, 0x2b, 0xcb, 0xfa, 0x82,
+	0x55, 0x16, 0x96, 0x9d, 0xd0, 0x45, 0x72, 0x13, 0x96, 0xa5, 0xbb, 0x8b, 0x9c, 0xd3, 0x13, 0x4c,
+	0xcf, 0x69, 0x45, 0x18, 0xf7, 0xa5, 0x6d, 0x7c, 0x07, 0xcd, 0x6c, 0x55, 0x85, 0xe0, 0x63, 0x5c,
+	0xd3, 0x05, 0x6f, 0xe1, 0xfb, 0x14, 0xbc, 0x85, 0xef, 0x41, 0xf0, 0x97, 0x50, 0x7b, 0x4e, 0x63,
+	0x85, 0xde, 0x37, 0x61, 0x51, 0xca, 0x2b, 0x42, 0x5e, 0xda, 0x5a, 0x92, 0x62, 0x0a, 0x93, 0x95,
+	0xba, 0x12, 0x8a, 0xe1, 0xec, 0xa4, 0xf0, 0x65, 0xab, 0x32, 0x94, 0x1e, 0x27, 0x57, 0x61, 0x21,
+	0xa2, 0xf1, 0x29, 0xd7, 0x8b, 0xc2, 0x29, 0x7f, 0xcc, 0x3f, 0x0a, 0x50, 0xcf, 0x33, 0xa7, 0x79,
+	0x1d, 0xc1, 0x8a, 0x17, 0x78, 0xb1, 0x47, 0x7d, 0xef, 0x15, 0x15, 0x15, 0x57, 0x86, 0x70, 0x5b,
+	0x84, 0xa0, 0x9e, 0xd4, 0xdc, 0x1d, 0x99, 0xf1, 0x64, 0xce, 0xca, 0x61, 0x90, 0x5b, 0xb0, 0x80,
+	0x67, 0x49, 0x3e, 0x52, 0xe3, 0x65, 0xa9, 0x71, 0xe8, 0x3c, 0x4e, 0x8c, 0x4f, 0xe6, 0x2c, 0xe9,
+	0x35, 0xde, 0x68, 0xb0, 0x32, 0x8a, 0x45, 0xda, 0x50, 0x8d, 0x10, 0x19, 0xb7, 0xbb, 0x34, 0xb2,
+	0x8f, 0xfb, 0xc9, 0x7d, 0x90, 0x6e, 0x8b, 0x87, 0x17, 0x8f, 0xa8, 0x79, 0x90, 0x40, 0xec, 0xd3,
+	0x68, 0xbb, 0x9f, 0x90, 0x06, 0x31, 0xeb, 0x5b, 0xcb, 0xd1, 0xb0, 0xcd, 0x78, 0x0a, 0x64, 0x7c,
+	0x10, 0xa9, 0x42, 0xf1, 0x7c, 0xe3, 0x24, 0x9f, 0xc4, 0x84, 0x85, 0xb3, 0xa4, 0x88, 0xa4, 0x99,
+	0x54, 0x86, 0x56, 0x86, 0x5b, 0xd2, 0xf5, 0x71, 0xe1, 0x23, 0x6d, 0x7b, 0x11, 0xe6, 0x8f, 0x43,
+	0xb7, 0x6f, 0x7e, 0xa7, 0xc1, 0xea, 0x41, 0x8f, 0x9f, 0x1e, 0xf4, 0x7c, 0x7f, 0x46, 0x4d, 0x8d,
+	0xf2, 0x6a, 0x2d, 0x4e, 0xe8, 0x21, 0xbe, 0xd7, 0xa0, 0x7a, 0x1e, 0xcf, 0x6c, 0xba, 0x87, 0x4b,
+	0x05, 0xd4, 0x01, 0xfd, 0x88, 0xd1, 0x80, 0x53, 0x27, 0x9e, 0x7d, 0xdd, 0xf9, 0x53, 0x83, 0xff,
+	0x2a, 0xd8, 0x52, 0x19, 0x3e, 0xc9, 0x57, 0x9e, 0x5b, 0x02, 0x6c, 0xe2, 0x84, 0xb1, 0xda, 0xf3,
+	0xed, 0xa5, 0x6a, 0xcf, 0x8c, 0x85, 0x6d, 0x43, 0xed, 0x19, 0xf5, 0x3d, 0x37, 0xe9, 0xc5, 0x05,
+	0xc4, 0x8c, 0x1a, 0x8f, 0x6f, 0x0a, 0x50, 0xcf, 0x13, 0xa5, 0x82, 0x1a, 0x50, 0xa2, 0x8e, 0x83,
+	0x51, 0x8c, 0x92, 0xa8, 0x64, 0x0d, 0xfe, 0x73, 0x05, 0xb4, 0xf0, 0xce, 0x02, 0x5a, 0x1c, 0x2f,
+	0xa0, 0xe4, 0x31, 0xc0, 0x99, 0x17, 0xfa, 0xe2, 0x8c, 0x73, 0x7d, 0x7e, 0x68, 0xcd, 0xd4, 0x01,
+	0x35, 0x9f, 0x65, 0xa3, 0xad, 0xa1, 0x89, 0xc6, 0x0e, 0x94, 0x07, 0x8e, 0xa4, 0x62, 0xb6, 0x3d,
+	0xf4, 0xb3, 0x56, 0x4e, 0xfe, 0x24, 0x0d, 0xb1, 0x8b, 0xdc, 0x61, 0x5e, 0x24, 0x6a, 0x62, 0xd6,
+	0x22, 0x9e, 0x9b, 0xcc, 0xd7, 0xa0, 0x27, 0xe7, 0x4a, 0x12, 0xf2, 0xc3, 0x98, 0x21, 0xed, 0x5e,
+	0x48, 0xf1, 0x2a, 0x14, 0x93, 0x87, 0x46, 0x02, 0xb9, 0x6c, 0x25, 0x9f, 0x89, 0x34, 0x71, 0x18,
+	0x53, 0xdf, 0xe6, 0xde, 0x2b, 0x99, 0xf8, 0xbc, 0x55, 0x16, 0x96, 0x43, 0xef, 0x15, 0x26, 0x11,
+	0x3a, 0xa7, 0xbd, 0xa0, 0x23, 0xee, 0x94, 0x8a, 0x25, 0x7f, 0x4c, 0x0a, 0xb5, 0x2f, 0xa2, 0x24,
+	0xe5, 0x03, 0x86, 0x1c, 0x03, 0x07, 0xff, 0xf5, 0xcb, 0xc4, 0xd4, 0xa1, 0x9e, 0xa7, 0x90, 0xba,
+	0x6e, 0xfd, 0x75, 0x05, 0x16, 0xbf, 0x14, 0x8f, 0x69, 0xb2, 0x07, 0x2b, 0xa3, 0x8f, 0x57, 0x62,
+	0xc8, 0x16, 0x46, 0xf5, 0x9e, 0x34, 0x1a, 0x4a, 0x9f, 0x44, 0x35, 0xe7, 0xc8, 0xe7, 0x50, 0xcd,
+	0xbf, 0x28, 0xc9, 0xf5, 0xf4, 0x82, 0x56, 0x3e, 0x50, 0x8d, 0x1b, 0x13, 0xbc, 0x03, 0xc8, 0x3d,
+	0x58, 0x19, 0x4d, 0x22, 0x8d, 0x4f, 0x29, 0x9e, 0xd1, 0x50, 0xfa, 0x86, 0xc1, 0x46, 0xdf, 0x05,
+	0x29, 0x98, 0xf2, 0x15, 0x62, 0x34, 0x94, 0xbe, 0x61, 0xb0, 0xd1, 0x3e, 0x2f, 0x53, 0x4e, 0xf5,
+	0x32, 0x36, 0xa6, 0x35, 0x86, 0x12, 0xac, 0x85, 0x0a, 0xb0, 0x16, 0x4e, 0x06, 0x53, 0x37, 0x3d,
+	0xe6, 0x1c, 0x79, 0x0a, 0xab, 0xa3, 0x44, 0x9c, 0x34, 0xd4, 0x5d, 0xb0, 0x84, 0xbb, 0x3e, 0xad,
+	0x45, 0x96, 0x78, 0x2d, 0x54, 0xe1, 0xb5, 0x70, 0x0a, 0xde, 0x84, 0x0e, 0xd0, 0x9c, 0x23, 0xfb,
+	0xb0, 0x32, 0xda, 0x07, 0xa4, 0xc9, 0x2a, 0xbb, 0x2b, 0xa3, 0xa1, 0xf4, 0x65, 0x60, 0x77, 0x35,
+	0xf2, 0x00, 0x4a, 0xd9, 0x15, 0x49, 0xae, 0x8a, 0xc1, 0xb9, 0x1b, 0xdc, 0xa8, 0xe5, 0xac, 0x43,
+	0x91, 0xac, 0x8d, 0x55, 0x01, 0x72, 0x63, 0x30, 0x5a, 0x55, 0x1d, 0x26, 0x82, 0x6d, 0x6a, 0xe4,
+	0x08, 0xd6, 0xc6, 0x6e, 0x9f, 0x14, 0x6e, 0xd2, 0xa5, 0x69, 0xac, 0x4f, 0xbf, 0xb4, 0xe4, 0xde,
+	0x18, 0xad, 0x8f, 0xa9, 0x5c, 0xca, 0xeb, 0xc2, 0x68, 0x28, 0x7d, 0x19, 0xd8, 0x76, 0xf5, 0xcd,
+	0xdb, 0x75, 0xed, 0xd7, 0xb7, 0xeb, 0xda, 0x6f, 0x6f, 0xd7, 0xb5, 0x1f, 0x7e, 0x5f, 0x9f, 0x3b,
+	0x5e, 0x14, 0x4f, 0xae, 0x0f, 0xff, 0x1e, 0x00, 0x71, 0x58, 0xab, 0x9c, 0x5f, 0x13, 0x00, 0x00,
 }
-func (m *WatchDocumentsResponse_Event) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// YorkieClient is the client API for Yorkie service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type YorkieClient interface {
+	ActivateClient(ctx context.Context, in *ActivateClientRequest, opts ...grpc.CallOption) (*ActivateClientResponse, error)
+	DeactivateClient(ctx context.Context, in *DeactivateClientRequest, opts ...grpc.CallOption) (*DeactivateClientResponse, error)
+	UpdatePresence(ctx context.Context, in *UpdatePresenceRequest, opts ...grpc.CallOption) (*UpdatePresenceResponse, error)
+	CreateDocument(ctx context.Context, in *CreateDocumentRequest, opts ...grpc.CallOption) (*CreateDocumentResponse, error)
+	AttachDocument(ctx context.Context, in *AttachDocumentRequest, opts ...grpc.CallOption) (*AttachDocumentResponse, error)
+	DetachDocument(ctx context.Context, in *DetachDocumentRequest, opts ...grpc.CallOption) (*DetachDocumentResponse, error)
+	AttachDocuments(ctx context.Context, in *AttachDocumentsRequest, opts ...grpc.CallOption) (*AttachDocumentsResponse, error)
+	DetachDocuments(ctx context.Context, in *DetachDocumentsRequest, opts ...grpc.CallOption) (*DetachDocumentsResponse, error)
+	WatchDocuments(ctx context.Context, in *WatchDocumentsRequest, opts ...grpc.CallOption) (Yorkie_WatchDocumentsClient, error)
+	PushPull(ctx context.Context, in *PushPullRequest, opts ...grpc.CallOption) (*PushPullResponse, error)
+	PushChangesStream(ctx context.Context, opts ...grpc.CallOption) (Yorkie_PushChangesStreamClient, error)
+	TransactDocuments(ctx context.Context, in *TransactDocumentsRequest, opts ...grpc.CallOption) (*TransactDocumentsResponse, error)
+	ValidateChange(ctx context.Context, in *ValidateChangeRequest, opts ...grpc.CallOption) (*ValidateChangeResponse, error)
 }
 
-func (m *WatchDocumentsResponse_Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Event != nil {
-		{
-			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+type yorkieClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewYorkieClient(cc *grpc.ClientConn) YorkieClient {
+	return &yorkieClient{cc}
+}
+
+func (c *yorkieClient) ActivateClient(ctx context.Context, in *ActivateClientRequest, opts ...grpc.CallOption) (*ActivateClientResponse, error) {
+	out := new(ActivateClientResponse)
+	err := c.cc.Invoke(ctx, "/api.Yorkie/ActivateClient", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
-func (m *WatchDocumentsResponse_Initialization) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+
+func (c *yorkieClient) DeactivateClient(ctx context.Context, in *DeactivateClientRequest, opts ...grpc.CallOption) (*DeactivateClientResponse, error) {
+	out := new(DeactivateClientResponse)
+	err := c.cc.Invoke(ctx, "/api.Yorkie/DeactivateClient", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *WatchDocumentsResponse_Initialization) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *yorkieClient) UpdatePresence(ctx context.Context, in *UpdatePresenceRequest, opts ...grpc.CallOption) (*UpdatePresenceResponse, error) {
+	out := new(UpdatePresenceResponse)
+	err := c.cc.Invoke(ctx, "/api.Yorkie/UpdatePresence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *WatchDocumentsResponse_Initialization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *yorkieClient) CreateDocument(ctx context.Context, in *CreateDocumentRequest, opts ...grpc.CallOption) (*CreateDocumentResponse, error) {
+	out := new(CreateDocumentResponse)
+	err := c.cc.Invoke(ctx, "/api.Yorkie/CreateDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PeersMapByDoc) > 0 {
-		for k := range m.PeersMapByDoc {
-			v := m.PeersMapByDoc[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintYorkie(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PushPullRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *yorkieClient) AttachDocument(ctx context.Context, in *AttachDocumentRequest, opts ...grpc.CallOption) (*AttachDocumentResponse, error) {
+	out := new(AttachDocumentResponse)
+	err := c.cc.Invoke(ctx, "/api.Yorkie/AttachDocument", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PushPullRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *PushPullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ChangePack != nil {
-		{
-			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+func (c *yorkieClient) DetachDocument(ctx context.Context, in *DetachDocumentRequest, opts ...grpc.CallOption) (*DetachDocumentResponse, error) {
+	out := new(DetachDocumentResponse)
+	err := c.cc.Invoke(ctx, "/api.Yorkie/DetachDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PushPullResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *yorkieClient) AttachDocuments(ctx context.Context, in *AttachDocumentsRequest, opts ...grpc.CallOption) (*AttachDocumentsResponse, error) {
+	out := new(AttachDocumentsResponse)
+	err := c.cc.Invoke(ctx, "/api.Yorkie/AttachDocuments", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PushPullResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *yorkieClient) DetachDocuments(ctx context.Context, in *DetachDocumentsRequest, opts ...grpc.CallOption) (*DetachDocumentsResponse, error) {
+	out := new(DetachDocumentsResponse)
+	err := c.cc.Invoke(ctx, "/api.Yorkie/DetachDocuments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *PushPullResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *yorkieClient) WatchDocuments(ctx context.Context, in *WatchDocumentsRequest, opts ...grpc.CallOption) (Yorkie_WatchDocumentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Yorkie_serviceDesc.Streams[0], "/api.Yorkie/WatchDocuments", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ChangePack != nil {
-		{
-			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	x := &yorkieWatchDocumentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return x, nil
 }
 
-func (m *UpdatePresenceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type Yorkie_WatchDocumentsClient interface {
+	Recv() (*WatchDocumentsResponse, error)
+	grpc.ClientStream
 }
 
-func (m *UpdatePresenceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type yorkieWatchDocumentsClient struct {
+	grpc.ClientStream
 }
 
-func (m *UpdatePresenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DocumentKeys) > 0 {
-		for iNdEx := len(m.DocumentKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DocumentKeys[iNdEx])
-			copy(dAtA[i:], m.DocumentKeys[iNdEx])
-			i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentKeys[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+func (x *yorkieWatchDocumentsClient) Recv() (*WatchDocumentsResponse, error) {
+	m := new(WatchDocumentsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.Client != nil {
-		{
-			size, err := m.Client.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return m, nil
+}
+
+func (c *yorkieClient) PushPull(ctx context.Context, in *PushPullRequest, opts ...grpc.CallOption) (*PushPullResponse, error) {
+	out := new(PushPullResponse)
+	err := c.cc.Invoke(ctx, "/api.Yorkie/PushPull", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UpdatePresenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *yorkieClient) PushChangesStream(ctx context.Context, opts ...grpc.CallOption) (Yorkie_PushChangesStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Yorkie_serviceDesc.Streams[1], "/api.Yorkie/PushChangesStream", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &yorkiePushChangesStreamClient{stream}
+	return x, nil
 }
 
-func (m *UpdatePresenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Yorkie_PushChangesStreamClient interface {
+	Send(*PushChangesStreamRequest) error
+	CloseAndRecv() (*PushPullResponse, error)
+	grpc.ClientStream
 }
 
-func (m *UpdatePresenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return len(dAtA) - i, nil
+type yorkiePushChangesStreamClient struct {
+	grpc.ClientStream
 }
 
-func encodeVarintYorkie(dAtA []byte, offset int, v uint64) int {
-	offset -= sovYorkie(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (x *yorkiePushChangesStreamClient) Send(m *PushChangesStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
-func (m *ActivateClientRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClientKey)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+
+func (x *yorkiePushChangesStreamClient) CloseAndRecv() (*PushPullResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	m := new(PushPullResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return n
+	return m, nil
 }
 
-func (m *ActivateClientResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClientKey)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (c *yorkieClient) TransactDocuments(ctx context.Context, in *TransactDocumentsRequest, opts ...grpc.CallOption) (*TransactDocumentsResponse, error) {
+	out := new(TransactDocumentsResponse)
+	err := c.cc.Invoke(ctx, "/api.Yorkie/TransactDocuments", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *DeactivateClientRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (c *yorkieClient) ValidateChange(ctx context.Context, in *ValidateChangeRequest, opts ...grpc.CallOption) (*ValidateChangeResponse, error) {
+	out := new(ValidateChangeResponse)
+	err := c.cc.Invoke(ctx, "/api.Yorkie/ValidateChange", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *DeactivateClientResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// YorkieServer is the server API for Yorkie service.
+type YorkieServer interface {
+	ActivateClient(context.Context, *ActivateClientRequest) (*ActivateClientResponse, error)
+	DeactivateClient(context.Context, *DeactivateClientRequest) (*DeactivateClientResponse, error)
+	UpdatePresence(context.Context, *UpdatePresenceRequest) (*UpdatePresenceResponse, error)
+	CreateDocument(context.Context, *CreateDocumentRequest) (*CreateDocumentResponse, error)
+	AttachDocument(context.Context, *AttachDocumentRequest) (*AttachDocumentResponse, error)
+	DetachDocument(context.Context, *DetachDocumentRequest) (*DetachDocumentResponse, error)
+	AttachDocuments(context.Context, *AttachDocumentsRequest) (*AttachDocumentsResponse, error)
+	DetachDocuments(context.Context, *DetachDocumentsRequest) (*DetachDocumentsResponse, error)
+	WatchDocuments(*WatchDocumentsRequest, Yorkie_WatchDocumentsServer) error
+	PushPull(context.Context, *PushPullRequest) (*PushPullResponse, error)
+	PushChangesStream(Yorkie_PushChangesStreamServer) error
+	TransactDocuments(context.Context, *TransactDocumentsRequest) (*TransactDocumentsResponse, error)
+	ValidateChange(context.Context, *ValidateChangeRequest) (*ValidateChangeResponse, error)
+}
+
+// UnimplementedYorkieServer can be embedded to have forward compatible implementations.
+type UnimplementedYorkieServer struct {
+}
+
+func (*UnimplementedYorkieServer) ActivateClient(ctx context.Context, req *ActivateClientRequest) (*ActivateClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateClient not implemented")
+}
+func (*UnimplementedYorkieServer) DeactivateClient(ctx context.Context, req *DeactivateClientRequest) (*DeactivateClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateClient not implemented")
+}
+func (*UnimplementedYorkieServer) UpdatePresence(ctx context.Context, req *UpdatePresenceRequest) (*UpdatePresenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePresence not implemented")
+}
+func (*UnimplementedYorkieServer) CreateDocument(ctx context.Context, req *CreateDocumentRequest) (*CreateDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDocument not implemented")
+}
+func (*UnimplementedYorkieServer) AttachDocument(ctx context.Context, req *AttachDocumentRequest) (*AttachDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachDocument not implemented")
+}
+func (*UnimplementedYorkieServer) DetachDocument(ctx context.Context, req *DetachDocumentRequest) (*DetachDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachDocument not implemented")
+}
+func (*UnimplementedYorkieServer) AttachDocuments(ctx context.Context, req *AttachDocumentsRequest) (*AttachDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachDocuments not implemented")
+}
+func (*UnimplementedYorkieServer) DetachDocuments(ctx context.Context, req *DetachDocumentsRequest) (*DetachDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachDocuments not implemented")
+}
+func (*UnimplementedYorkieServer) WatchDocuments(req *WatchDocumentsRequest, srv Yorkie_WatchDocumentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDocuments not implemented")
+}
+func (*UnimplementedYorkieServer) PushPull(ctx context.Context, req *PushPullRequest) (*PushPullResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushPull not implemented")
+}
+func (*UnimplementedYorkieServer) PushChangesStream(srv Yorkie_PushChangesStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method PushChangesStream not implemented")
+}
+func (*UnimplementedYorkieServer) TransactDocuments(ctx context.Context, req *TransactDocumentsRequest) (*TransactDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransactDocuments not implemented")
+}
+func (*UnimplementedYorkieServer) ValidateChange(ctx context.Context, req *ValidateChangeRequest) (*ValidateChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateChange not implemented")
+}
+
+func RegisterYorkieServer(s *grpc.Server, srv YorkieServer) {
+	s.RegisterService(&_Yorkie_serviceDesc, srv)
+}
+
+func _Yorkie_ActivateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(YorkieServer).ActivateClient(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Yorkie/ActivateClient",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServer).ActivateClient(ctx, req.(*ActivateClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AttachDocumentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Yorkie_DeactivateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(YorkieServer).DeactivateClient(ctx, in)
 	}
-	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Yorkie/DeactivateClient",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServer).DeactivateClient(ctx, req.(*DeactivateClientRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AttachDocumentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Yorkie_UpdatePresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePresenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(YorkieServer).UpdatePresence(ctx, in)
 	}
-	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Yorkie/UpdatePresence",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServer).UpdatePresence(ctx, req.(*UpdatePresenceRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DetachDocumentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Yorkie_CreateDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(YorkieServer).CreateDocument(ctx, in)
 	}
-	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Yorkie/CreateDocument",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServer).CreateDocument(ctx, req.(*CreateDocumentRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DetachDocumentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Yorkie_AttachDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ClientKey)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(YorkieServer).AttachDocument(ctx, in)
 	}
-	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Yorkie/AttachDocument",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServer).AttachDocument(ctx, req.(*AttachDocumentRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WatchDocumentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Yorkie_DetachDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Client != nil {
-		l = m.Client.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(YorkieServer).DetachDocument(ctx, in)
 	}
-	if len(m.DocumentKeys) > 0 {
-		for _, s := range m.DocumentKeys {
-			l = len(s)
-			n += 1 + l + sovYorkie(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Yorkie/DetachDocument",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServer).DetachDocument(ctx, req.(*DetachDocumentRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WatchDocumentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Yorkie_AttachDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Body != nil {
-		n += m.Body.Size()
+	if interceptor == nil {
+		return srv.(YorkieServer).AttachDocuments(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Yorkie/AttachDocuments",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServer).AttachDocuments(ctx, req.(*AttachDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WatchDocumentsResponse_Initialization_) Size() (n int) {
-	if m == nil {
-		return 0
+func _Yorkie_DetachDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Initialization != nil {
-		l = m.Initialization.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(YorkieServer).DetachDocuments(ctx, in)
 	}
-	return n
-}
-func (m *WatchDocumentsResponse_Event) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Yorkie/DetachDocuments",
 	}
-	var l int
-	_ = l
-	if m.Event != nil {
-		l = m.Event.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServer).DetachDocuments(ctx, req.(*DetachDocumentsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
-func (m *WatchDocumentsResponse_Initialization) Size() (n int) {
-	if m == nil {
-		return 0
+
+func _Yorkie_WatchDocuments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDocumentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	var l int
-	_ = l
-	if len(m.PeersMapByDoc) > 0 {
-		for k, v := range m.PeersMapByDoc {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovYorkie(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
-		}
+	return srv.(YorkieServer).WatchDocuments(m, &yorkieWatchDocumentsServer{stream})
+}
+
+type Yorkie_WatchDocumentsServer interface {
+	Send(*WatchDocumentsResponse) error
+	grpc.ServerStream
+}
+
+type yorkieWatchDocumentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *yorkieWatchDocumentsServer) Send(m *WatchDocumentsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Yorkie_PushPull_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushPullRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(YorkieServer).PushPull(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Yorkie/PushPull",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServer).PushPull(ctx, req.(*PushPullRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PushPullRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Yorkie_PushChangesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(YorkieServer).PushChangesStream(&yorkiePushChangesStreamServer{stream})
+}
+
+type Yorkie_PushChangesStreamServer interface {
+	SendAndClose(*PushPullResponse) error
+	Recv() (*PushChangesStreamRequest, error)
+	grpc.ServerStream
+}
+
+type yorkiePushChangesStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *yorkiePushChangesStreamServer) SendAndClose(m *PushPullResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *yorkiePushChangesStreamServer) Recv() (*PushChangesStreamRequest, error) {
+	m := new(PushChangesStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	return m, nil
+}
+
+func _Yorkie_TransactDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(YorkieServer).TransactDocuments(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Yorkie/TransactDocuments",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServer).TransactDocuments(ctx, req.(*TransactDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PushPullResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Yorkie_ValidateChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YorkieServer).ValidateChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Yorkie/ValidateChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServer).ValidateChange(ctx, req.(*ValidateChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Yorkie_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Yorkie",
+	HandlerType: (*YorkieServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ActivateClient",
+			Handler:    _Yorkie_ActivateClient_Handler,
+		},
+		{
+			MethodName: "DeactivateClient",
+			Handler:    _Yorkie_DeactivateClient_Handler,
+		},
+		{
+			MethodName: "UpdatePresence",
+			Handler:    _Yorkie_UpdatePresence_Handler,
+		},
+		{
+			MethodName: "CreateDocument",
+			Handler:    _Yorkie_CreateDocument_Handler,
+		},
+		{
+			MethodName: "AttachDocument",
+			Handler:    _Yorkie_AttachDocument_Handler,
+		},
+		{
+			MethodName: "DetachDocument",
+			Handler:    _Yorkie_DetachDocument_Handler,
+		},
+		{
+			MethodName: "AttachDocuments",
+			Handler:    _Yorkie_AttachDocuments_Handler,
+		},
+		{
+			MethodName: "DetachDocuments",
+			Handler:    _Yorkie_DetachDocuments_Handler,
+		},
+		{
+			MethodName: "PushPull",
+			Handler:    _Yorkie_PushPull_Handler,
+		},
+		{
+			MethodName: "TransactDocuments",
+			Handler:    _Yorkie_TransactDocuments_Handler,
+		},
+		{
+			MethodName: "ValidateChange",
+			Handler:    _Yorkie_ValidateChange_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchDocuments",
+			Handler:       _Yorkie_WatchDocuments_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PushChangesStream",
+			Handler:       _Yorkie_PushChangesStream_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "yorkie.proto",
+}
+
+func (m *ActivateClientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivateClientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivateClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ClientKey) > 0 {
+		i -= len(m.ClientKey)
+		copy(dAtA[i:], m.ClientKey)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdatePresenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ActivateClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivateClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivateClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Client != nil {
-		l = m.Client.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DocumentKeys) > 0 {
-		for _, s := range m.DocumentKeys {
-			l = len(s)
-			n += 1 + l + sovYorkie(uint64(l))
+	if m.RecordWallTime {
+		i--
+		if m.RecordWallTime {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientKey) > 0 {
+		i -= len(m.ClientKey)
+		copy(dAtA[i:], m.ClientKey)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeactivateClientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeactivateClientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeactivateClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeactivateClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeactivateClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeactivateClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttachDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttachDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttachDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ConsistencyToken) > 0 {
+		i -= len(m.ConsistencyToken)
+		copy(dAtA[i:], m.ConsistencyToken)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ConsistencyToken)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CreateIfMissing != nil {
+		{
+			size, err := m.CreateIfMissing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TargetServerSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.TargetServerSeq))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadOnly {
+		i--
+		if m.ReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttachDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttachDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttachDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RemovedDocumentAction) > 0 {
+		i -= len(m.RemovedDocumentAction)
+		copy(dAtA[i:], m.RemovedDocumentAction)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.RemovedDocumentAction)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ConsistencyToken) > 0 {
+		i -= len(m.ConsistencyToken)
+		copy(dAtA[i:], m.ConsistencyToken)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ConsistencyToken)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ObjectMergePolicy) > 0 {
+		i -= len(m.ObjectMergePolicy)
+		copy(dAtA[i:], m.ObjectMergePolicy)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ObjectMergePolicy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Reactivated {
+		i--
+		if m.Reactivated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DocumentId) > 0 {
+		i -= len(m.DocumentId)
+		copy(dAtA[i:], m.DocumentId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DetachDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DetachDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DetachDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DetachDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DetachDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DetachDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientKey) > 0 {
+		i -= len(m.ClientKey)
+		copy(dAtA[i:], m.ClientKey)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttachDocumentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttachDocumentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttachDocumentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CreateIfMissing != nil {
+		{
+			size, err := m.CreateIfMissing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ReadOnly {
+		i--
+		if m.ReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChangePacks) > 0 {
+		for iNdEx := len(m.ChangePacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChangePacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttachDocumentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttachDocumentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttachDocumentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttachDocumentsResponse_Result) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttachDocumentsResponse_Result) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttachDocumentsResponse_Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ErrorCode != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ErrorCode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DetachDocumentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DetachDocumentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DetachDocumentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ChangePacks) > 0 {
+		for iNdEx := len(m.ChangePacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChangePacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DetachDocumentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DetachDocumentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DetachDocumentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DetachDocumentsResponse_Result) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DetachDocumentsResponse_Result) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DetachDocumentsResponse_Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ErrorCode != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ErrorCode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchDocumentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchDocumentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchDocumentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Paths) > 0 {
+		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Paths[iNdEx])
+			copy(dAtA[i:], m.Paths[iNdEx])
+			i = encodeVarintYorkie(dAtA, i, uint64(len(m.Paths[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DocumentKeys) > 0 {
+		for iNdEx := len(m.DocumentKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DocumentKeys[iNdEx])
+			copy(dAtA[i:], m.DocumentKeys[iNdEx])
+			i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Client != nil {
+		{
+			size, err := m.Client.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchDocumentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchDocumentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchDocumentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Body != nil {
+		{
+			size := m.Body.Size()
+			i -= size
+			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchDocumentsResponse_Initialization_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchDocumentsResponse_Initialization_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Initialization != nil {
+		{
+			size, err := m.Initialization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *WatchDocumentsResponse_Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchDocumentsResponse_Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Event != nil {
+		{
+			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *WatchDocumentsResponse_Initialization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchDocumentsResponse_Initialization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchDocumentsResponse_Initialization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PeersMapByDoc) > 0 {
+		for k := range m.PeersMapByDoc {
+			v := m.PeersMapByDoc[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintYorkie(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushPullRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushPullRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushPullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ConsistencyToken) > 0 {
+		i -= len(m.ConsistencyToken)
+		copy(dAtA[i:], m.ConsistencyToken)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ConsistencyToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushPullResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushPullResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushPullResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ConsistencyToken) > 0 {
+		i -= len(m.ConsistencyToken)
+		copy(dAtA[i:], m.ConsistencyToken)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ConsistencyToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactDocumentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactDocumentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactDocumentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ChangePacks) > 0 {
+		for iNdEx := len(m.ChangePacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChangePacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactDocumentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactDocumentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactDocumentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactDocumentsResponse_Result) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactDocumentsResponse_Result) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactDocumentsResponse_Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ConsistencyToken) > 0 {
+		i -= len(m.ConsistencyToken)
+		copy(dAtA[i:], m.ConsistencyToken)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ConsistencyToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DocumentKey) > 0 {
+		i -= len(m.DocumentKey)
+		copy(dAtA[i:], m.DocumentKey)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidateChangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateChangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateChangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidateChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Violations) > 0 {
+		for iNdEx := len(m.Violations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Violations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ErrorCode != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ErrorCode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidateChangeResponse_Violation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateChangeResponse_Violation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateChangeResponse_Violation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushChangesStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushChangesStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushChangesStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Chunk)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Seq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdatePresenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePresenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatePresenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DocumentKeys) > 0 {
+		for iNdEx := len(m.DocumentKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DocumentKeys[iNdEx])
+			copy(dAtA[i:], m.DocumentKeys[iNdEx])
+			i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Client != nil {
+		{
+			size, err := m.Client.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdatePresenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePresenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatePresenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintYorkie(dAtA []byte, offset int, v uint64) int {
+	offset -= sovYorkie(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ActivateClientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientKey)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivateClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientKey)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.RecordWallTime {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeactivateClientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeactivateClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttachDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ReadOnly {
+		n += 2
+	}
+	if m.TargetServerSeq != 0 {
+		n += 1 + sovYorkie(uint64(m.TargetServerSeq))
+	}
+	if m.CreateIfMissing != nil {
+		l = m.CreateIfMissing.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.ConsistencyToken)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttachDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.Reactivated {
+		n += 2
+	}
+	l = len(m.ObjectMergePolicy)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.ConsistencyToken)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.RemovedDocumentAction)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DocumentId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DetachDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DetachDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientKey)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttachDocumentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.ChangePacks) > 0 {
+		for _, e := range m.ChangePacks {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.ReadOnly {
+		n += 2
+	}
+	if m.CreateIfMissing != nil {
+		l = m.CreateIfMissing.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttachDocumentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttachDocumentsResponse_Result) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ErrorCode != 0 {
+		n += 1 + sovYorkie(uint64(m.ErrorCode))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DetachDocumentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.ChangePacks) > 0 {
+		for _, e := range m.ChangePacks {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DetachDocumentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DetachDocumentsResponse_Result) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ErrorCode != 0 {
+		n += 1 + sovYorkie(uint64(m.ErrorCode))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchDocumentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Client != nil {
+		l = m.Client.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.DocumentKeys) > 0 {
+		for _, s := range m.DocumentKeys {
+			l = len(s)
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			l = len(s)
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchDocumentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Body != nil {
+		n += m.Body.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchDocumentsResponse_Initialization_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Initialization != nil {
+		l = m.Initialization.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *WatchDocumentsResponse_Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *WatchDocumentsResponse_Initialization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PeersMapByDoc) > 0 {
+		for k, v := range m.PeersMapByDoc {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovYorkie(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushPullRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.ConsistencyToken)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushPullResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.ConsistencyToken)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TransactDocumentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.ChangePacks) > 0 {
+		for _, e := range m.ChangePacks {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TransactDocumentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TransactDocumentsResponse_Result) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DocumentKey)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.ConsistencyToken)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidateChangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidateChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Accepted {
+		n += 2
+	}
+	if m.ErrorCode != 0 {
+		n += 1 + sovYorkie(uint64(m.ErrorCode))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.Violations) > 0 {
+		for _, e := range m.Violations {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidateChangeResponse_Violation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushChangesStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovYorkie(uint64(m.Seq))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovYorkie(uint64(m.TotalSize))
+	}
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdatePresenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Client != nil {
+		l = m.Client.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.DocumentKeys) > 0 {
+		for _, s := range m.DocumentKeys {
+			l = len(s)
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdatePresenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovYorkie(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozYorkie(x uint64) (n int) {
+	return sovYorkie(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ActivateClientRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivateClientRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivateClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivateClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordWallTime", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RecordWallTime = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeactivateClientRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeactivateClientRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeactivateClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeactivateClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeactivateClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeactivateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttachDocumentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttachDocumentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttachDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
+			}
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReadOnly = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetServerSeq", wireType)
+			}
+			m.TargetServerSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetServerSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateIfMissing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateIfMissing == nil {
+				m.CreateIfMissing = &types.BoolValue{}
+			}
+			if err := m.CreateIfMissing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsistencyToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsistencyToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttachDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttachDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttachDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
+			}
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reactivated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reactivated = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMergePolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectMergePolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsistencyToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsistencyToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedDocumentAction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedDocumentAction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateDocumentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateDocumentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DetachDocumentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DetachDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
+			}
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DetachDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DetachDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DetachDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
+			}
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttachDocumentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttachDocumentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttachDocumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangePacks = append(m.ChangePacks, &ChangePack{})
+			if err := m.ChangePacks[len(m.ChangePacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReadOnly = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateIfMissing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateIfMissing == nil {
+				m.CreateIfMissing = &types.BoolValue{}
+			}
+			if err := m.CreateIfMissing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttachDocumentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttachDocumentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttachDocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &AttachDocumentsResponse_Result{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttachDocumentsResponse_Result) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &AttachDocumentResponse{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+			}
+			m.ErrorCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DetachDocumentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DetachDocumentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DetachDocumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangePacks = append(m.ChangePacks, &ChangePack{})
+			if err := m.ChangePacks[len(m.ChangePacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DetachDocumentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DetachDocumentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DetachDocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &DetachDocumentsResponse_Result{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DetachDocumentsResponse_Result) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &DetachDocumentResponse{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+			}
+			m.ErrorCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *UpdatePresenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovYorkie(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozYorkie(x uint64) (n int) {
-	return sovYorkie(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ActivateClientRequest) Unmarshal(dAtA []byte) error {
+func (m *WatchDocumentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2246,15 +6898,51 @@ func (m *ActivateClientRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActivateClientRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchDocumentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivateClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchDocumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Client == nil {
+				m.Client = &Client{}
+			}
+			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2282,7 +6970,39 @@ func (m *ActivateClientRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientKey = string(dAtA[iNdEx:postIndex])
+			m.DocumentKeys = append(m.DocumentKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2306,7 +7026,7 @@ func (m *ActivateClientRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
+func (m *WatchDocumentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2329,17 +7049,17 @@ func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActivateClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchDocumentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchDocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Initialization", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -2349,29 +7069,32 @@ func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientKey = string(dAtA[iNdEx:postIndex])
+			v := &WatchDocumentsResponse_Initialization{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &WatchDocumentsResponse_Initialization_{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -2381,25 +7104,26 @@ func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
-			if m.ClientId == nil {
-				m.ClientId = []byte{}
+			v := &DocEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			m.Body = &WatchDocumentsResponse_Event{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2423,7 +7147,7 @@ func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeactivateClientRequest) Unmarshal(dAtA []byte) error {
+func (m *WatchDocumentsResponse_Initialization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2446,17 +7170,17 @@ func (m *DeactivateClientRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeactivateClientRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Initialization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeactivateClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Initialization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeersMapByDoc", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -2466,25 +7190,120 @@ func (m *DeactivateClientRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
-			if m.ClientId == nil {
-				m.ClientId = []byte{}
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PeersMapByDoc == nil {
+				m.PeersMapByDoc = make(map[string]*Clients)
+			}
+			var mapkey string
+			var mapvalue *Clients
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYorkie
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Clients{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipYorkie(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PeersMapByDoc[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2508,7 +7327,7 @@ func (m *DeactivateClientRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeactivateClientResponse) Unmarshal(dAtA []byte) error {
+func (m *PushPullRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2531,10 +7350,10 @@ func (m *DeactivateClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeactivateClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PushPullRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeactivateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PushPullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2571,62 +7390,11 @@ func (m *DeactivateClientResponse) Unmarshal(dAtA []byte) error {
 				m.ClientId = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipYorkie(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AttachDocumentRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowYorkie
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AttachDocumentRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttachDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -2636,31 +7404,33 @@ func (m *AttachDocumentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
-			if m.ClientId == nil {
-				m.ClientId = []byte{}
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
+			}
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsistencyToken", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -2670,27 +7440,23 @@ func (m *AttachDocumentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePack == nil {
-				m.ChangePack = &ChangePack{}
-			}
-			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsistencyToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2714,7 +7480,7 @@ func (m *AttachDocumentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttachDocumentResponse) Unmarshal(dAtA []byte) error {
+func (m *PushPullResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2737,10 +7503,10 @@ func (m *AttachDocumentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttachDocumentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PushPullResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttachDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PushPullResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2813,6 +7579,38 @@ func (m *AttachDocumentResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsistencyToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsistencyToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -2835,7 +7633,7 @@ func (m *AttachDocumentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
+func (m *TransactDocumentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2858,10 +7656,10 @@ func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DetachDocumentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransactDocumentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DetachDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransactDocumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2900,7 +7698,7 @@ func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePacks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2927,10 +7725,8 @@ func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePack == nil {
-				m.ChangePack = &ChangePack{}
-			}
-			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChangePacks = append(m.ChangePacks, &ChangePack{})
+			if err := m.ChangePacks[len(m.ChangePacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2956,7 +7752,7 @@ func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DetachDocumentResponse) Unmarshal(dAtA []byte) error {
+func (m *TransactDocumentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2979,47 +7775,15 @@ func (m *DetachDocumentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DetachDocumentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransactDocumentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DetachDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransactDocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYorkie
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3046,10 +7810,8 @@ func (m *DetachDocumentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePack == nil {
-				m.ChangePack = &ChangePack{}
-			}
-			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, &TransactDocumentsResponse_Result{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3075,7 +7837,7 @@ func (m *DetachDocumentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchDocumentsRequest) Unmarshal(dAtA []byte) error {
+func (m *TransactDocumentsResponse_Result) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3098,15 +7860,47 @@ func (m *WatchDocumentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchDocumentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchDocumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3133,16 +7927,16 @@ func (m *WatchDocumentsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Client == nil {
-				m.Client = &Client{}
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
 			}
-			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsistencyToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3170,7 +7964,7 @@ func (m *WatchDocumentsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DocumentKeys = append(m.DocumentKeys, string(dAtA[iNdEx:postIndex]))
+			m.ConsistencyToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3194,7 +7988,7 @@ func (m *WatchDocumentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchDocumentsResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidateChangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3217,17 +8011,17 @@ func (m *WatchDocumentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchDocumentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidateChangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchDocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidateChangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Initialization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -3237,30 +8031,29 @@ func (m *WatchDocumentsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WatchDocumentsResponse_Initialization{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
 			}
-			m.Body = &WatchDocumentsResponse_Initialization_{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3287,11 +8080,12 @@ func (m *WatchDocumentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DocEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
+			}
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &WatchDocumentsResponse_Event{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3315,7 +8109,7 @@ func (m *WatchDocumentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchDocumentsResponse_Initialization) Unmarshal(dAtA []byte) error {
+func (m *ValidateChangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3338,15 +8132,86 @@ func (m *WatchDocumentsResponse_Initialization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Initialization: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidateChangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Initialization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidateChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+			}
+			m.ErrorCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeersMapByDoc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Violations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3373,105 +8238,10 @@ func (m *WatchDocumentsResponse_Initialization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PeersMapByDoc == nil {
-				m.PeersMapByDoc = make(map[string]*Clients)
-			}
-			var mapkey string
-			var mapvalue *Clients
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowYorkie
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowYorkie
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthYorkie
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthYorkie
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowYorkie
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthYorkie
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthYorkie
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Clients{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipYorkie(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthYorkie
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Violations = append(m.Violations, &ValidateChangeResponse_Violation{})
+			if err := m.Violations[len(m.Violations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.PeersMapByDoc[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3495,7 +8265,7 @@ func (m *WatchDocumentsResponse_Initialization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PushPullRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidateChangeResponse_Violation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3518,17 +8288,17 @@ func (m *PushPullRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PushPullRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Violation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PushPullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Violation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -3538,31 +8308,29 @@ func (m *PushPullRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
-			if m.ClientId == nil {
-				m.ClientId = []byte{}
-			}
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -3572,27 +8340,23 @@ func (m *PushPullRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePack == nil {
-				m.ChangePack = &ChangePack{}
-			}
-			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3616,7 +8380,7 @@ func (m *PushPullRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PushPullResponse) Unmarshal(dAtA []byte) error {
+func (m *PushChangesStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3639,10 +8403,10 @@ func (m *PushPullResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PushPullResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PushChangesStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PushPullResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PushChangesStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3680,10 +8444,48 @@ func (m *PushPullResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -3693,26 +8495,24 @@ func (m *PushPullResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePack == nil {
-				m.ChangePack = &ChangePack{}
-			}
-			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -20,17 +20,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 
+	protoTypes "github.com/gogo/protobuf/types"
 	"github.com/rs/xid"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	grpcstatus "google.golang.org/grpc/status"
 
 	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
@@ -42,6 +48,10 @@ const (
 	activated
 )
 
+// changePackChunkBytes is the size of chunks of a change pack sent with
+// PushChangesStream.
+const changePackChunkBytes = 1024 * 1024
+
 var (
 	// ErrClientNotActivated occurs when an inactive client executes a function
 	// that can only be executed when activated.
@@ -51,15 +61,37 @@ var (
 	// this client.
 	ErrDocumentNotAttached = errors.New("document is not attached")
 
+	// ErrBatchTargetServerSeq occurs when the documents are attached in a
+	// batch at a past version.
+	ErrBatchTargetServerSeq = errors.New("batch attachment at a past version is not supported")
+
 	// ErrUnsupportedWatchResponseType occurs when the given WatchResponseType
 	// is not supported.
 	ErrUnsupportedWatchResponseType = errors.New("unsupported watch response type")
+
+	// ErrDocumentAttachedAtPastVersion occurs when the document attached at a
+	// past version is synchronized or watched.
+	ErrDocumentAttachedAtPastVersion = errors.New("document is attached at a past version")
 )
 
 // Attachment represents the document attached and peers.
 type Attachment struct {
-	doc   *document.Document
-	peers map[string]types.PresenceInfo
+	doc      *document.Document
+	peers    map[string]types.PresenceInfo
+	readOnly bool
+
+	// serverSeq is the server sequence of the past version of the document
+	// attached. Zero means the document follows the latest version.
+	serverSeq uint64
+
+	// consistencyToken is the token of the version of the document returned
+	// by the last request, which is passed to the next one so that this
+	// client reads its own writes from any server of the cluster.
+	consistencyToken string
+
+	// removedDocumentAction is the action taken by the server on attaching,
+	// because the document of the key was removed.
+	removedDocumentAction types.RemovedDocumentAction
 }
 
 // Client is a normal client that can communicate with the server.
@@ -71,8 +103,20 @@ type Client struct {
 	dialOptions []grpc.DialOption
 	logger      *zap.Logger
 
+	// packCallOptions is the call options of RPCs that carry change packs.
+	packCallOptions []grpc.CallOption
+
+	// maxChangePackBytes is the maximum size of a change pack sent with
+	// PushPull.
+	maxChangePackBytes int
+
+	// recordWallTime is whether to record the wall-clock time of operations.
+	// It is enabled by the project of the client.
+	recordWallTime bool
+
 	id           *time.ActorID
 	key          string
+	identity     string
 	presenceInfo types.PresenceInfo
 	status       status
 	attachments  map[string]*Attachment
@@ -85,16 +129,35 @@ type WatchResponseType string
 const (
 	DocumentsChanged WatchResponseType = "documents-changed"
 	PeersChanged     WatchResponseType = "peers-changed"
+	SubtreeRemoved   WatchResponseType = "subtree-removed"
 )
 
 // WatchResponse is a structure representing response of Watch.
 type WatchResponse struct {
 	Type          WatchResponseType
 	Keys          []key.Key
+	Paths         []string
 	PeersMapByDoc map[string]map[string]types.Presence
 	Err           error
 }
 
+// ChangeValidation is the result of validating the local changes of a
+// document without pushing them.
+type ChangeValidation struct {
+	// Accepted is whether the server would accept the changes.
+	Accepted bool
+
+	// Code is the status code that the server would return when the changes
+	// are pushed. It is OK if the changes are accepted.
+	Code codes.Code
+
+	// Message is the message of the error that the server would return.
+	Message string
+
+	// Violations is the problems of the changes found by the server.
+	Violations []*types.FieldViolation
+}
+
 // New creates an instance of Client.
 func New(opts ...Option) (*Client, error) {
 	var options Options
@@ -132,6 +195,16 @@ func New(opts ...Option) (*Client, error) {
 		dialOptions = append(dialOptions, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(options.MaxCallRecvMsgSize)))
 	}
 
+	var packCallOptions []grpc.CallOption
+	if options.CompressChangePack {
+		packCallOptions = append(packCallOptions, grpc.UseCompressor(gzip.Name))
+	}
+
+	maxChangePackBytes := options.MaxChangePackBytes
+	if maxChangePackBytes == 0 {
+		maxChangePackBytes = DefaultMaxChangePackBytes
+	}
+
 	logger := options.Logger
 	if logger == nil {
 		l, err := zap.NewProduction()
@@ -142,10 +215,13 @@ func New(opts ...Option) (*Client, error) {
 	}
 
 	return &Client{
-		dialOptions: dialOptions,
-		logger:      logger,
+		dialOptions:        dialOptions,
+		logger:             logger,
+		packCallOptions:    packCallOptions,
+		maxChangePackBytes: maxChangePackBytes,
 
 		key:          k,
+		identity:     options.Identity,
 		presenceInfo: types.PresenceInfo{Presence: presence},
 		status:       deactivated,
 		attachments:  make(map[string]*Attachment),
@@ -198,6 +274,7 @@ func (c *Client) Activate(ctx context.Context) error {
 
 	response, err := c.client.ActivateClient(ctx, &api.ActivateClientRequest{
 		ClientKey: c.key,
+		Identity:  c.identity,
 	})
 	if err != nil {
 		return err
@@ -210,6 +287,7 @@ func (c *Client) Activate(ctx context.Context) error {
 
 	c.status = activated
 	c.id = clientID
+	c.recordWallTime = response.RecordWallTime
 
 	return nil
 }
@@ -232,33 +310,150 @@ func (c *Client) Deactivate(ctx context.Context) error {
 	return nil
 }
 
+// CreateDocument creates an empty document of the given key on the server, and
+// returns the ID of the document. It returns AlreadyExists if the document
+// already exists.
+func (c *Client) CreateDocument(ctx context.Context, k key.Key) (types.ID, error) {
+	if c.status != activated {
+		return "", ErrClientNotActivated
+	}
+
+	res, err := c.client.CreateDocument(ctx, &api.CreateDocumentRequest{
+		ClientId:    c.id.Bytes(),
+		DocumentKey: k.String(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return types.ID(res.DocumentId), nil
+}
+
 // Attach attaches the given document to this client. It tells the server that
 // this client will synchronize the given document.
-func (c *Client) Attach(ctx context.Context, doc *document.Document) error {
+func (c *Client) Attach(ctx context.Context, doc *document.Document, options ...AttachOption) error {
 	if c.status != activated {
 		return ErrClientNotActivated
 	}
 
+	opts := AttachOptions{}
+	for _, opt := range options {
+		opt(&opts)
+	}
+
 	doc.SetActor(c.id)
+	doc.SetRecordWallTime(c.recordWallTime)
 
 	pbChangePack, err := converter.ToChangePack(doc.CreateChangePack())
 	if err != nil {
 		return err
 	}
 
+	// NOTE: The document attached at a past version can not be written.
+	readOnly := opts.ReadOnly || opts.TargetServerSeq > 0
+	var createIfMissing *protoTypes.BoolValue
+	if opts.CreateIfMissing != nil {
+		createIfMissing = &protoTypes.BoolValue{Value: *opts.CreateIfMissing}
+	}
 	res, err := c.client.AttachDocument(ctx, &api.AttachDocumentRequest{
-		ClientId:   c.id.Bytes(),
-		ChangePack: pbChangePack,
-	})
+		ClientId:         c.id.Bytes(),
+		ChangePack:       pbChangePack,
+		ReadOnly:         readOnly,
+		TargetServerSeq:  opts.TargetServerSeq,
+		CreateIfMissing:  createIfMissing,
+		ConsistencyToken: opts.ConsistencyToken,
+	}, c.packCallOptions...)
 	if err != nil {
 		return err
 	}
 
+	return c.applyAttachResponse(doc, res, readOnly, opts.TargetServerSeq)
+}
+
+// AttachDocuments attaches the given documents to this client in one round
+// trip. The documents are attached independently, so it returns the errors of
+// the documents in the given order, which are nil for the attached ones. The
+// options of a past version are not supported for the batch.
+func (c *Client) AttachDocuments(
+	ctx context.Context,
+	docs []*document.Document,
+	options ...AttachOption,
+) ([]error, error) {
+	if c.status != activated {
+		return nil, ErrClientNotActivated
+	}
+
+	opts := AttachOptions{}
+	for _, opt := range options {
+		opt(&opts)
+	}
+	if opts.TargetServerSeq > 0 {
+		return nil, ErrBatchTargetServerSeq
+	}
+
+	pbChangePacks := make([]*api.ChangePack, 0, len(docs))
+	for _, doc := range docs {
+		doc.SetActor(c.id)
+		doc.SetRecordWallTime(c.recordWallTime)
+
+		pbChangePack, err := converter.ToChangePack(doc.CreateChangePack())
+		if err != nil {
+			return nil, err
+		}
+		pbChangePacks = append(pbChangePacks, pbChangePack)
+	}
+
+	var createIfMissing *protoTypes.BoolValue
+	if opts.CreateIfMissing != nil {
+		createIfMissing = &protoTypes.BoolValue{Value: *opts.CreateIfMissing}
+	}
+	res, err := c.client.AttachDocuments(ctx, &api.AttachDocumentsRequest{
+		ClientId:        c.id.Bytes(),
+		ChangePacks:     pbChangePacks,
+		ReadOnly:        opts.ReadOnly,
+		CreateIfMissing: createIfMissing,
+	}, c.packCallOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := make([]error, len(docs))
+	for i, result := range res.Results {
+		if result.ErrorCode != 0 {
+			errs[i] = grpcstatus.Error(codes.Code(result.ErrorCode), result.ErrorMessage)
+			continue
+		}
+		errs[i] = c.applyAttachResponse(docs[i], result.Response, opts.ReadOnly, 0)
+	}
+
+	return errs, nil
+}
+
+// applyAttachResponse applies the response of the attachment to the given
+// document and registers it to this client.
+func (c *Client) applyAttachResponse(
+	doc *document.Document,
+	res *api.AttachDocumentResponse,
+	readOnly bool,
+	serverSeq uint64,
+) error {
 	pack, err := converter.FromChangePack(res.ChangePack)
 	if err != nil {
 		return err
 	}
 
+	// NOTE: If this client was deactivated by the server and reactivated by
+	// this request, the documents attached before have been detached by the
+	// server. They should be attached again to resync from scratch.
+	if res.Reactivated {
+		c.logger.Warn("client reactivated, attached documents are detached")
+		for key, attachment := range c.attachments {
+			attachment.doc.SetStatus(document.Detached)
+			delete(c.attachments, key)
+		}
+	}
+
+	doc.SetObjectMergePolicy(json.MergePolicy(res.ObjectMergePolicy))
 	if err := doc.ApplyChangePack(pack); err != nil {
 		return err
 	}
@@ -273,8 +468,13 @@ func (c *Client) Attach(ctx context.Context, doc *document.Document) error {
 
 	doc.SetStatus(document.Attached)
 	c.attachments[doc.Key().String()] = &Attachment{
-		doc:   doc,
-		peers: make(map[string]types.PresenceInfo),
+		doc:              doc,
+		peers:            make(map[string]types.PresenceInfo),
+		readOnly:         readOnly,
+		serverSeq:        serverSeq,
+		consistencyToken: res.ConsistencyToken,
+
+		removedDocumentAction: types.RemovedDocumentAction(res.RemovedDocumentAction),
 	}
 
 	return nil
@@ -291,10 +491,19 @@ func (c *Client) Detach(ctx context.Context, doc *document.Document) error {
 		return ErrClientNotActivated
 	}
 
-	if _, ok := c.attachments[doc.Key().String()]; !ok {
+	attachment, ok := c.attachments[doc.Key().String()]
+	if !ok {
 		return ErrDocumentNotAttached
 	}
 
+	// NOTE: The document attached at a past version is not registered to the
+	// server, so it is only detached locally.
+	if attachment.serverSeq > 0 {
+		doc.SetStatus(document.Detached)
+		delete(c.attachments, doc.Key().String())
+		return nil
+	}
+
 	pbChangePack, err := converter.ToChangePack(doc.CreateChangePack())
 	if err != nil {
 		return err
@@ -303,11 +512,75 @@ func (c *Client) Detach(ctx context.Context, doc *document.Document) error {
 	res, err := c.client.DetachDocument(ctx, &api.DetachDocumentRequest{
 		ClientId:   c.id.Bytes(),
 		ChangePack: pbChangePack,
-	})
+	}, c.packCallOptions...)
 	if err != nil {
 		return err
 	}
 
+	return c.applyDetachResponse(doc, res)
+}
+
+// DetachDocuments detaches the given documents from this client in one round
+// trip. The documents are detached independently, so it returns the errors of
+// the documents in the given order, which are nil for the detached ones.
+func (c *Client) DetachDocuments(ctx context.Context, docs []*document.Document) ([]error, error) {
+	if c.status != activated {
+		return nil, ErrClientNotActivated
+	}
+
+	errs := make([]error, len(docs))
+	var pbChangePacks []*api.ChangePack
+	var requested []int
+	for i, doc := range docs {
+		attachment, ok := c.attachments[doc.Key().String()]
+		if !ok {
+			errs[i] = ErrDocumentNotAttached
+			continue
+		}
+
+		// NOTE: The document attached at a past version is not registered to
+		// the server, so it is only detached locally.
+		if attachment.serverSeq > 0 {
+			doc.SetStatus(document.Detached)
+			delete(c.attachments, doc.Key().String())
+			continue
+		}
+
+		pbChangePack, err := converter.ToChangePack(doc.CreateChangePack())
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		pbChangePacks = append(pbChangePacks, pbChangePack)
+		requested = append(requested, i)
+	}
+	if len(pbChangePacks) == 0 {
+		return errs, nil
+	}
+
+	res, err := c.client.DetachDocuments(ctx, &api.DetachDocumentsRequest{
+		ClientId:    c.id.Bytes(),
+		ChangePacks: pbChangePacks,
+	}, c.packCallOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	for j, result := range res.Results {
+		i := requested[j]
+		if result.ErrorCode != 0 {
+			errs[i] = grpcstatus.Error(codes.Code(result.ErrorCode), result.ErrorMessage)
+			continue
+		}
+		errs[i] = c.applyDetachResponse(docs[i], result.Response)
+	}
+
+	return errs, nil
+}
+
+// applyDetachResponse applies the response of the detachment to the given
+// document and unregisters it from this client.
+func (c *Client) applyDetachResponse(doc *document.Document, res *api.DetachDocumentResponse) error {
 	pack, err := converter.FromChangePack(res.ChangePack)
 	if err != nil {
 		return err
@@ -323,12 +596,56 @@ func (c *Client) Detach(ctx context.Context, doc *document.Document) error {
 	return nil
 }
 
+// ValidateChange asks the server whether the local changes of the given
+// document would be accepted, without pushing them. The server does not store
+// anything, and the changes are kept in the document to be pushed by Sync.
+func (c *Client) ValidateChange(ctx context.Context, doc *document.Document) (*ChangeValidation, error) {
+	if c.status != activated {
+		return nil, ErrClientNotActivated
+	}
+
+	attachment, ok := c.attachments[doc.Key().String()]
+	if !ok {
+		return nil, ErrDocumentNotAttached
+	}
+
+	pbChangePack, err := converter.ToChangePack(attachment.doc.CreateChangePack())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.client.ValidateChange(ctx, &api.ValidateChangeRequest{
+		ClientId:   c.id.Bytes(),
+		ChangePack: pbChangePack,
+	}, c.packCallOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	validation := &ChangeValidation{
+		Accepted: res.Accepted,
+		Code:     codes.Code(res.ErrorCode),
+		Message:  res.ErrorMessage,
+	}
+	for _, violation := range res.Violations {
+		validation.Violations = append(validation.Violations, &types.FieldViolation{
+			Field:       violation.Field,
+			Description: violation.Description,
+		})
+	}
+
+	return validation, nil
+}
+
 // Sync pushes local changes of the attached documents to the server and
 // receives changes of the remote replica from the server then apply them to
 // local documents.
 func (c *Client) Sync(ctx context.Context, keys ...key.Key) error {
 	if len(keys) == 0 {
 		for _, attachment := range c.attachments {
+			if attachment.serverSeq > 0 {
+				continue
+			}
 			keys = append(keys, attachment.doc.Key())
 		}
 	}
@@ -342,6 +659,61 @@ func (c *Client) Sync(ctx context.Context, keys ...key.Key) error {
 	return nil
 }
 
+// TransactDocuments pushes the local changes of the given attached documents
+// to the server atomically: the changes of all the documents are stored, or
+// none of them. Then it applies the changes of the remote replicas received
+// from the server to the documents, like Sync.
+//
+// If it fails, the changes remain in the documents and are pushed by the
+// following Sync or TransactDocuments.
+func (c *Client) TransactDocuments(ctx context.Context, docs ...*document.Document) error {
+	if c.status != activated {
+		return ErrClientNotActivated
+	}
+
+	pbChangePacks := make([]*api.ChangePack, 0, len(docs))
+	for _, doc := range docs {
+		attachment, ok := c.attachments[doc.Key().String()]
+		if !ok {
+			return ErrDocumentNotAttached
+		}
+		if attachment.serverSeq > 0 {
+			return ErrDocumentAttachedAtPastVersion
+		}
+
+		pbChangePack, err := converter.ToChangePack(attachment.doc.CreateChangePack())
+		if err != nil {
+			return err
+		}
+		pbChangePacks = append(pbChangePacks, pbChangePack)
+	}
+
+	res, err := c.client.TransactDocuments(ctx, &api.TransactDocumentsRequest{
+		ClientId:    c.id.Bytes(),
+		ChangePacks: pbChangePacks,
+	}, c.packCallOptions...)
+	if err != nil {
+		c.logger.Error("failed to transact documents", zap.Error(err))
+		return err
+	}
+
+	for _, result := range res.Results {
+		pack, err := converter.FromChangePack(result.ChangePack)
+		if err != nil {
+			return err
+		}
+
+		attachment := c.attachments[result.DocumentKey]
+		if err := attachment.doc.ApplyChangePack(pack); err != nil {
+			c.logger.Error("failed to apply change pack", zap.Error(err))
+			return err
+		}
+		attachment.consistencyToken = result.ConsistencyToken
+	}
+
+	return nil
+}
+
 // Watch subscribes to events on a given document.
 // If an error occurs before stream initialization, the second response, error,
 // is returned. If the context "ctx" is canceled or timed out, returned channel
@@ -350,9 +722,31 @@ func (c *Client) Sync(ctx context.Context, keys ...key.Key) error {
 func (c *Client) Watch(
 	ctx context.Context,
 	docs ...*document.Document,
+) (<-chan WatchResponse, error) {
+	return c.watch(ctx, nil, docs...)
+}
+
+// WatchSubtree subscribes to events on the subtrees of the given paths in the
+// given document, e.g. "$.todos". Changes outside of the subtrees are not
+// notified, and SubtreeRemoved is delivered when the subtrees are removed.
+func (c *Client) WatchSubtree(
+	ctx context.Context,
+	doc *document.Document,
+	paths ...string,
+) (<-chan WatchResponse, error) {
+	return c.watch(ctx, paths, doc)
+}
+
+func (c *Client) watch(
+	ctx context.Context,
+	paths []string,
+	docs ...*document.Document,
 ) (<-chan WatchResponse, error) {
 	var keys []key.Key
 	for _, doc := range docs {
+		if attachment, ok := c.attachments[doc.Key().String()]; ok && attachment.serverSeq > 0 {
+			return nil, ErrDocumentAttachedAtPastVersion
+		}
 		keys = append(keys, doc.Key())
 	}
 
@@ -363,6 +757,7 @@ func (c *Client) Watch(
 			PresenceInfo: c.presenceInfo,
 		}),
 		DocumentKeys: converter.ToDocumentKeys(keys),
+		Paths:        paths,
 	})
 	if err != nil {
 		return nil, err
@@ -396,6 +791,12 @@ func (c *Client) Watch(
 					Type: DocumentsChanged,
 					Keys: converter.FromDocumentKeys(resp.Event.DocumentKeys),
 				}, nil
+			case types.SubtreeRemovedEvent:
+				return &WatchResponse{
+					Type:  SubtreeRemoved,
+					Keys:  converter.FromDocumentKeys(resp.Event.DocumentKeys),
+					Paths: resp.Event.RemovedPaths,
+				}, nil
 			case types.DocumentsWatchedEvent, types.DocumentsUnwatchedEvent, types.PresenceChangedEvent:
 				for _, k := range converter.FromDocumentKeys(resp.Event.DocumentKeys) {
 					cli, err := converter.FromClient(resp.Event.Publisher)
@@ -461,14 +862,16 @@ func (c *Client) UpdatePresence(ctx context.Context, k, v string) error {
 	c.presenceInfo.Presence[k] = v
 	c.presenceInfo.Clock++
 
-	if len(c.attachments) == 0 {
-		return nil
-	}
-
 	var keys []key.Key
 	for _, attachment := range c.attachments {
+		if attachment.serverSeq > 0 {
+			continue
+		}
 		keys = append(keys, attachment.doc.Key())
 	}
+	if len(keys) == 0 {
+		return nil
+	}
 
 	// TODO(hackerwins): We temporarily use Unary Call to update presence,
 	// because grpc-web can't handle Bi-Directional streaming for now.
@@ -520,6 +923,34 @@ func (c *Client) PeersMapByDoc() map[string]map[string]types.Presence {
 	return peersMapByDoc
 }
 
+// ConsistencyToken returns the consistency token of the version of the given
+// document last synchronized by this client. Another client can pass it with
+// WithConsistencyToken to read the writes of this client.
+func (c *Client) ConsistencyToken(key key.Key) string {
+	attachment, ok := c.attachments[key.String()]
+	if !ok {
+		return ""
+	}
+	return attachment.consistencyToken
+}
+
+// RemovedDocumentAction returns the action taken by the server on attaching
+// the given document, because the document of the key was removed. It is
+// empty if the document was not removed.
+func (c *Client) RemovedDocumentAction(key key.Key) types.RemovedDocumentAction {
+	attachment, ok := c.attachments[key.String()]
+	if !ok {
+		return ""
+	}
+	return attachment.removedDocumentAction
+}
+
+// IsReadOnly returns whether the given document is attached in read-only mode.
+func (c *Client) IsReadOnly(key key.Key) bool {
+	attachment, ok := c.attachments[key.String()]
+	return ok && attachment.readOnly
+}
+
 // IsActive returns whether this client is active or not.
 func (c *Client) IsActive() bool {
 	return c.status == activated
@@ -534,16 +965,25 @@ func (c *Client) sync(ctx context.Context, key key.Key) error {
 	if !ok {
 		return ErrDocumentNotAttached
 	}
+	if attachment.serverSeq > 0 {
+		return ErrDocumentAttachedAtPastVersion
+	}
 
 	pbChangePack, err := converter.ToChangePack(attachment.doc.CreateChangePack())
 	if err != nil {
 		return err
 	}
 
-	res, err := c.client.PushPull(ctx, &api.PushPullRequest{
-		ClientId:   c.id.Bytes(),
-		ChangePack: pbChangePack,
-	})
+	var res *api.PushPullResponse
+	if pbChangePack.Size() > c.maxChangePackBytes {
+		res, err = c.pushChangesStream(ctx, pbChangePack)
+	} else {
+		res, err = c.client.PushPull(ctx, &api.PushPullRequest{
+			ClientId:         c.id.Bytes(),
+			ChangePack:       pbChangePack,
+			ConsistencyToken: attachment.consistencyToken,
+		}, c.packCallOptions...)
+	}
 	if err != nil {
 		c.logger.Error("failed to sync", zap.Error(err))
 		return err
@@ -558,6 +998,46 @@ func (c *Client) sync(ctx context.Context, key key.Key) error {
 		c.logger.Error("failed to apply change pack", zap.Error(err))
 		return err
 	}
+	attachment.consistencyToken = res.ConsistencyToken
 
 	return nil
 }
+
+// pushChangesStream sends the given change pack in chunks for the case that
+// it is too large to be sent with PushPull.
+func (c *Client) pushChangesStream(
+	ctx context.Context,
+	pbChangePack *api.ChangePack,
+) (*api.PushPullResponse, error) {
+	data, err := pbChangePack.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.client.PushChangesStream(ctx, c.packCallOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	for seq := 0; seq*changePackChunkBytes < len(data); seq++ {
+		end := (seq + 1) * changePackChunkBytes
+		if end > len(data) {
+			end = len(data)
+		}
+
+		if err := stream.Send(&api.PushChangesStreamRequest{
+			ClientId:  c.id.Bytes(),
+			Seq:       uint32(seq),
+			TotalSize: uint64(len(data)),
+			Chunk:     data[seq*changePackChunkBytes : end],
+		}); err != nil {
+			// NOTE: the actual error is returned by CloseAndRecv.
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+	}
+
+	return stream.CloseAndRecv()
+}
@@ -22,6 +22,10 @@ import (
 	"github.com/yorkie-team/yorkie/api/types"
 )
 
+// DefaultMaxChangePackBytes is the default maximum size in bytes of a change
+// pack sent with PushPull. It is the same as the default of the server.
+const DefaultMaxChangePackBytes = 3 * 1024 * 1024
+
 // Option configures Options.
 type Option func(*Options)
 
@@ -30,6 +34,11 @@ type Options struct {
 	// Key is the key of the client. It is used to identify the client.
 	Key string
 
+	// Identity is the external identity of the user of the client, such as
+	// the ID of the user. The projects which reuse actor IDs key the actor ID
+	// of the client by it instead of the key.
+	Identity string
+
 	// Presence is the presence of the client.
 	Presence types.Presence
 
@@ -50,6 +59,15 @@ type Options struct {
 
 	// MaxCallRecvMsgSize is the maximum message size in bytes the client can receive.
 	MaxCallRecvMsgSize int
+
+	// CompressChangePack is whether to compress change packs with gzip on the
+	// wire. The server responds with compressed change packs as well.
+	CompressChangePack bool
+
+	// MaxChangePackBytes is the maximum size in bytes of a change pack sent
+	// with PushPull. Larger change packs are sent in chunks with
+	// PushChangesStream. It should not exceed the limit of the server.
+	MaxChangePackBytes int
 }
 
 // WithKey configures the key of the client.
@@ -57,6 +75,11 @@ func WithKey(key string) Option {
 	return func(o *Options) { o.Key = key }
 }
 
+// WithIdentity configures the external identity of the user of the client.
+func WithIdentity(identity string) Option {
+	return func(o *Options) { o.Identity = identity }
+}
+
 // WithPresence configures the presence of the client.
 func WithPresence(presence types.Presence) Option {
 	return func(o *Options) { o.Presence = presence }
@@ -91,3 +114,61 @@ func WithLogger(logger *zap.Logger) Option {
 func WithMaxRecvMsgSize(maxRecvMsgSize int) Option {
 	return func(o *Options) { o.MaxCallRecvMsgSize = maxRecvMsgSize }
 }
+
+// WithChangePackCompression configures whether to compress change packs on the wire.
+func WithChangePackCompression(compress bool) Option {
+	return func(o *Options) { o.CompressChangePack = compress }
+}
+
+// WithMaxChangePackBytes configures the maximum size in bytes of a change pack sent with PushPull.
+func WithMaxChangePackBytes(maxChangePackBytes int) Option {
+	return func(o *Options) { o.MaxChangePackBytes = maxChangePackBytes }
+}
+
+// AttachOption configures AttachOptions.
+type AttachOption func(*AttachOptions)
+
+// AttachOptions configures how we attach the document.
+type AttachOptions struct {
+	// ReadOnly is whether to attach the document in read-only mode. The client
+	// receives changes of the document but cannot push its own changes.
+	ReadOnly bool
+
+	// TargetServerSeq is the server sequence of the past version of the
+	// document to attach. If it is set, the document is attached read-only at
+	// the version, and it is neither synchronized nor watched.
+	TargetServerSeq uint64
+
+	// CreateIfMissing is whether to create the document if it does not exist.
+	// If it is nil, the project of the client decides it.
+	CreateIfMissing *bool
+
+	// ConsistencyToken is the token returned by a write to the document, e.g.
+	// by another client of the same user. The document is attached after the
+	// server has caught up to the write.
+	ConsistencyToken string
+}
+
+// WithReadOnly configures the document to be attached in read-only mode.
+func WithReadOnly() AttachOption {
+	return func(o *AttachOptions) { o.ReadOnly = true }
+}
+
+// WithTargetServerSeq configures the document to be attached at the past
+// version of the given server sequence.
+func WithTargetServerSeq(serverSeq uint64) AttachOption {
+	return func(o *AttachOptions) { o.TargetServerSeq = serverSeq }
+}
+
+// WithCreateIfMissing configures whether to create the document if it does
+// not exist. If it is false, attaching a missing document fails with
+// NotFound.
+func WithCreateIfMissing(create bool) AttachOption {
+	return func(o *AttachOptions) { o.CreateIfMissing = &create }
+}
+
+// WithConsistencyToken configures the document to be attached after the
+// server has caught up to the write of the given consistency token.
+func WithConsistencyToken(token string) AttachOption {
+	return func(o *AttachOptions) { o.ConsistencyToken = token }
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+var analyzeMaxDepth int
+
+func newAnalyzeCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "analyze [project name] [document key]",
+		Short: "Show the sizes of the subtrees of the document with their tombstones",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project and document key are required")
+			}
+
+			projectName, docKey := args[0], args[1]
+			cli, err := admin.Dial(config.AdminAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			analysis, err := cli.AnalyzeDocument(ctx, projectName, key.Key(docKey), analyzeMaxDepth)
+			if err != nil {
+				return err
+			}
+
+			tw := table.NewWriter()
+			tw.Style().Options.DrawBorder = false
+			tw.Style().Options.SeparateColumns = false
+			tw.Style().Options.SeparateFooter = false
+			tw.Style().Options.SeparateHeader = false
+			tw.Style().Options.SeparateRows = false
+			tw.AppendHeader(table.Row{
+				"PATH",
+				"BYTES",
+				"LIVE BYTES",
+				"TOMBSTONE BYTES",
+			})
+			for _, subtree := range analysis.Subtrees {
+				tw.AppendRow(table.Row{
+					subtree.Path,
+					subtree.Bytes,
+					subtree.LiveBytes(),
+					subtree.TombstoneBytes,
+				})
+			}
+			cmd.Printf("%s\n", tw.Render())
+			if analysis.Truncated {
+				cmd.Printf("the smaller subtrees are omitted\n")
+			}
+			return nil
+		},
+	}
+}
+
+func init() {
+	cmd := newAnalyzeCommand()
+	cmd.Flags().IntVar(
+		&analyzeMaxDepth,
+		"max-depth",
+		0,
+		"the depth of the subtrees to show, 1 if not set",
+	)
+	SubCmd.AddCommand(cmd)
+}
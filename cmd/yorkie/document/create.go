@@ -0,0 +1,94 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+var (
+	createTemplateID string
+	createVariables  map[string]string
+)
+
+func newCreateCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "create [project name] [document key]",
+		Short: "Create a document, empty or from a template of the project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project and document key are required")
+			}
+			if createTemplateID == "" && len(createVariables) > 0 {
+				return errors.New("variables are only allowed with a template")
+			}
+
+			cli, err := admin.Dial(config.AdminAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			var summary *types.DocumentSummary
+			if createTemplateID == "" {
+				summary, err = cli.CreateDocument(ctx, args[0], key.Key(args[1]))
+			} else {
+				summary, err = cli.CreateDocumentFromTemplate(
+					ctx,
+					args[0],
+					key.Key(args[1]),
+					createTemplateID,
+					createVariables,
+				)
+			}
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(summary.Snapshot)
+			return nil
+		},
+	}
+}
+
+func init() {
+	cmd := newCreateCommand()
+	cmd.Flags().StringVar(
+		&createTemplateID,
+		"template",
+		"",
+		"the ID of the template to create the document from, empty if omitted",
+	)
+	cmd.Flags().StringToStringVar(
+		&createVariables,
+		"var",
+		nil,
+		"the variables of the template, e.g. --var title=Hello",
+	)
+	SubCmd.AddCommand(cmd)
+}
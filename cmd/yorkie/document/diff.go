@@ -0,0 +1,85 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+var (
+	diffFromServerSeq uint64
+	diffToServerSeq   uint64
+)
+
+func newDiffCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff [project name] [document key]",
+		Short: "Show the changes of the document between server sequences as a JSON Patch",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project and document key are required")
+			}
+
+			projectName, docKey := args[0], args[1]
+			cli, err := admin.Dial(config.AdminAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			patch, err := cli.DiffDocument(ctx, projectName, key.Key(docKey), diffFromServerSeq, diffToServerSeq)
+			if err != nil {
+				return err
+			}
+
+			content, err := patch.Marshal()
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(content))
+			return nil
+		},
+	}
+}
+
+func init() {
+	cmd := newDiffCommand()
+	cmd.Flags().Uint64Var(
+		&diffFromServerSeq,
+		"from",
+		0,
+		"the server sequence of the version to diff from",
+	)
+	cmd.Flags().Uint64Var(
+		&diffToServerSeq,
+		"to",
+		0,
+		"the server sequence of the version to diff to",
+	)
+	SubCmd.AddCommand(cmd)
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+)
+
+func newDumpCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "dump [project name]",
+		Short: "Print all documents in the project line by line as they are streamed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("project is required")
+			}
+
+			cli, err := admin.Dial(config.AdminAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			return cli.StreamDocuments(ctx, args[0], func(document *types.DocumentSummary) error {
+				cmd.Printf(
+					"%s\t%s\t%s\t%s\n",
+					document.ID,
+					document.Key,
+					document.CreatedAt.Format(time.RFC3339),
+					document.UpdatedAt.Format(time.RFC3339),
+				)
+				return nil
+			})
+		},
+	}
+}
+
+func init() {
+	SubCmd.AddCommand(newDumpCommand())
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	doctime "github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+var (
+	eventLogFromSeq uint64
+	eventLogToSeq   uint64
+)
+
+func newEventLogCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "eventlog [project name] [document key]",
+		Short: "List the changes applied to the document with the state after them",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project and document key are required")
+			}
+
+			projectName, docKey := args[0], args[1]
+			cli, err := admin.Dial(config.AdminAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			logs, err := cli.ListDocumentEventLogs(
+				ctx,
+				projectName,
+				key.Key(docKey),
+				eventLogFromSeq,
+				eventLogToSeq,
+			)
+			if err != nil {
+				return err
+			}
+
+			tw := table.NewWriter()
+			tw.Style().Options.DrawBorder = false
+			tw.Style().Options.SeparateColumns = false
+			tw.Style().Options.SeparateFooter = false
+			tw.Style().Options.SeparateHeader = false
+			tw.Style().Options.SeparateRows = false
+			tw.AppendHeader(table.Row{
+				"SERVER SEQ",
+				"ACTOR ID",
+				"CLIENT SEQ",
+				"OPERATIONS",
+				"VERSION VECTOR",
+				"FINGERPRINT",
+				"APPLIED AT",
+			})
+			for _, log := range logs {
+				tw.AppendRow(table.Row{
+					log.ServerSeq,
+					log.ActorID,
+					log.ClientSeq,
+					log.OperationCount,
+					formatVersionVector(log.VersionVector),
+					log.Fingerprint,
+					log.CreatedAt.Format(time.RFC3339),
+				})
+			}
+			cmd.Printf("%s\n", tw.Render())
+			return nil
+		},
+	}
+}
+
+// formatVersionVector formats the given version vector as the pairs of the
+// actors and their Lamport timestamps sorted by the actors.
+func formatVersionVector(vector doctime.VersionVector) string {
+	actors := make([]string, 0, len(vector))
+	for actor := range vector {
+		actors = append(actors, actor)
+	}
+	sort.Strings(actors)
+
+	pairs := make([]string, 0, len(actors))
+	for _, actor := range actors {
+		pairs = append(pairs, fmt.Sprintf("%s:%d", actor, vector[actor]))
+	}
+	return strings.Join(pairs, ",")
+}
+
+func init() {
+	cmd := newEventLogCommand()
+	cmd.Flags().Uint64Var(
+		&eventLogFromSeq,
+		"from",
+		0,
+		"the server sequence of the first entry to list",
+	)
+	cmd.Flags().Uint64Var(
+		&eventLogToSeq,
+		"to",
+		0,
+		"the server sequence of the last entry to list, 0 means the last one",
+	)
+	SubCmd.AddCommand(cmd)
+}
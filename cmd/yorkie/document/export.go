@@ -0,0 +1,73 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+var (
+	withMetadata bool
+)
+
+func newExportCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export [project name] [document key]",
+		Short: "Export the content of the document in JSON",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project and document key are required")
+			}
+
+			projectName, docKey := args[0], args[1]
+			cli, err := admin.Dial(config.AdminAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			exported, err := cli.ExportDocument(ctx, projectName, key.Key(docKey), withMetadata)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(exported)
+			return nil
+		},
+	}
+}
+
+func init() {
+	cmd := newExportCommand()
+	cmd.Flags().BoolVar(
+		&withMetadata,
+		"with-metadata",
+		false,
+		"export the creation time and the last modifier of each element",
+	)
+	SubCmd.AddCommand(cmd)
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+var (
+	historySince      time.Duration
+	historyPreviousID string
+	historyPageSize   int32
+	historyIsForward  bool
+)
+
+func newHistoryCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "history [project name] [document key]",
+		Short: "List the attach and detach events of clients on the document",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project and document key are required")
+			}
+
+			projectName, docKey := args[0], args[1]
+			cli, err := admin.Dial(config.AdminAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			var from time.Time
+			if historySince > 0 {
+				from = time.Now().Add(-historySince)
+			}
+
+			ctx := context.Background()
+			events, err := cli.ListDocumentClientEvents(
+				ctx,
+				projectName,
+				key.Key(docKey),
+				from,
+				time.Time{},
+				types.ID(historyPreviousID),
+				historyPageSize,
+				historyIsForward,
+			)
+			if err != nil {
+				return err
+			}
+
+			tw := table.NewWriter()
+			tw.Style().Options.DrawBorder = false
+			tw.Style().Options.SeparateColumns = false
+			tw.Style().Options.SeparateFooter = false
+			tw.Style().Options.SeparateHeader = false
+			tw.Style().Options.SeparateRows = false
+			tw.AppendHeader(table.Row{
+				"ID",
+				"CLIENT ID",
+				"TYPE",
+				"OCCURRED AT",
+			})
+			for _, event := range events {
+				tw.AppendRow(table.Row{
+					event.ID,
+					event.ClientID,
+					event.Type,
+					event.CreatedAt.Format(time.RFC3339),
+				})
+			}
+			cmd.Printf("%s\n", tw.Render())
+			return nil
+		},
+	}
+}
+
+func init() {
+	cmd := newHistoryCommand()
+	cmd.Flags().DurationVar(
+		&historySince,
+		"since",
+		0,
+		"only list the events which occurred within the duration",
+	)
+	cmd.Flags().StringVar(
+		&historyPreviousID,
+		"previous-id",
+		"",
+		"the ID of the last event of the previous page",
+	)
+	cmd.Flags().Int32Var(
+		&historyPageSize,
+		"size",
+		20,
+		"the number of events to list",
+	)
+	cmd.Flags().BoolVar(
+		&historyIsForward,
+		"forward",
+		false,
+		"list the events from the oldest one",
+	)
+	SubCmd.AddCommand(cmd)
+}
@@ -25,10 +25,20 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
 	"github.com/yorkie-team/yorkie/pkg/units"
 )
 
+var (
+	listPreviousID string
+	listPageSize   int32
+	listIsForward  bool
+	listSnapshotAt string
+	listMetadata   map[string]string
+	listPageToken  string
+)
+
 func newListCommand() *cobra.Command {
 	return &cobra.Command{
 		Use:   "ls [project name]",
@@ -47,8 +57,36 @@ func newListCommand() *cobra.Command {
 				_ = cli.Close()
 			}()
 
+			var snapshotAt time.Time
+			if listSnapshotAt != "" {
+				if snapshotAt, err = time.Parse(time.RFC3339Nano, listSnapshotAt); err != nil {
+					return err
+				}
+			}
+
 			ctx := context.Background()
-			documents, err := cli.ListDocuments(ctx, projectName)
+			var documents []*types.DocumentSummary
+			var nextPageToken string
+			if listPageToken != "" || (listPreviousID == "" && snapshotAt.IsZero()) {
+				documents, nextPageToken, err = cli.ListDocumentsByPageToken(
+					ctx,
+					projectName,
+					listPageToken,
+					listPageSize,
+					listIsForward,
+					listMetadata,
+				)
+			} else {
+				documents, snapshotAt, err = cli.ListDocuments(
+					ctx,
+					projectName,
+					types.ID(listPreviousID),
+					listPageSize,
+					listIsForward,
+					snapshotAt,
+					listMetadata,
+				)
+			}
 			if err != nil {
 				return err
 			}
@@ -78,11 +116,54 @@ func newListCommand() *cobra.Command {
 				})
 			}
 			cmd.Printf("%s\n", tw.Render())
+			if !snapshotAt.IsZero() {
+				cmd.Printf("snapshot at: %s\n", snapshotAt.Format(time.RFC3339Nano))
+			}
+			if nextPageToken != "" {
+				cmd.Printf("next page token: %s\n", nextPageToken)
+			}
 			return nil
 		},
 	}
 }
 
 func init() {
-	SubCmd.AddCommand(newListCommand())
+	cmd := newListCommand()
+	cmd.Flags().StringVar(
+		&listPreviousID,
+		"previous-id",
+		"",
+		"the ID of the last document of the previous page",
+	)
+	cmd.Flags().Int32Var(
+		&listPageSize,
+		"size",
+		20,
+		"the number of documents to list",
+	)
+	cmd.Flags().BoolVar(
+		&listIsForward,
+		"forward",
+		false,
+		"list the documents from the oldest one",
+	)
+	cmd.Flags().StringVar(
+		&listSnapshotAt,
+		"snapshot-at",
+		"",
+		"the time of the view printed with the previous page in RFC3339",
+	)
+	cmd.Flags().StringToStringVar(
+		&listMetadata,
+		"metadata",
+		nil,
+		"list only the documents which have the metadata of indexed keys (e.g. owner=alice)",
+	)
+	cmd.Flags().StringVar(
+		&listPageToken,
+		"page-token",
+		"",
+		"the next page token printed with the previous page",
+	)
+	SubCmd.AddCommand(cmd)
 }
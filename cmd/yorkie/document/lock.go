@@ -0,0 +1,98 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+var lockReason string
+
+func newLockCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "lock [project name] [document key]",
+		Short: "Lock the document so that changes can not be pushed to it",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project and document key are required")
+			}
+
+			cli, err := admin.Dial(config.AdminAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			if err := cli.LockDocument(ctx, args[0], key.Key(args[1]), lockReason); err != nil {
+				return err
+			}
+
+			cmd.Printf("%s locked\n", args[1])
+			return nil
+		},
+	}
+}
+
+func newUnlockCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "unlock [project name] [document key]",
+		Short: "Unlock the document",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project and document key are required")
+			}
+
+			cli, err := admin.Dial(config.AdminAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			if err := cli.UnlockDocument(ctx, args[0], key.Key(args[1])); err != nil {
+				return err
+			}
+
+			cmd.Printf("%s unlocked\n", args[1])
+			return nil
+		},
+	}
+}
+
+func init() {
+	cmd := newLockCommand()
+	cmd.Flags().StringVar(
+		&lockReason,
+		"reason",
+		"",
+		"reason of the lock shown to the rejected clients",
+	)
+	SubCmd.AddCommand(cmd)
+	SubCmd.AddCommand(newUnlockCommand())
+}
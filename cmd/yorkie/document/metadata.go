@@ -0,0 +1,71 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+func newMetadataCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "metadata [project name] [document key] [key=value ...]",
+		Short: "Replace the metadata of the document, or clear it if no entry is given",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 2 {
+				return errors.New("project and document key are required")
+			}
+
+			metadata := make(map[string]string)
+			for _, entry := range args[2:] {
+				k, v, ok := strings.Cut(entry, "=")
+				if !ok {
+					return fmt.Errorf("invalid metadata entry %q, expected key=value", entry)
+				}
+				metadata[k] = v
+			}
+
+			cli, err := admin.Dial(config.AdminAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			if err := cli.SetDocumentMetadata(ctx, args[0], key.Key(args[1]), metadata); err != nil {
+				return err
+			}
+
+			cmd.Printf("metadata of %s updated\n", args[1])
+			return nil
+		},
+	}
+}
+
+func init() {
+	SubCmd.AddCommand(newMetadataCommand())
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+func newMoveCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "move [project name] [document key] [target project name]",
+		Short: "Move the document to the target project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 3 {
+				return errors.New("project, document key and target project are required")
+			}
+
+			projectName, docKey, targetProjectName := args[0], args[1], args[2]
+
+			cli, err := admin.Dial(config.AdminAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			if err := cli.MoveDocument(ctx, projectName, key.Key(docKey), targetProjectName); err != nil {
+				return err
+			}
+
+			cmd.Printf("%s moved to %s\n", docKey, targetProjectName)
+			return nil
+		},
+	}
+}
+
+func init() {
+	SubCmd.AddCommand(newMoveCommand())
+}
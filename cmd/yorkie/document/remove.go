@@ -0,0 +1,83 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+)
+
+var (
+	dryRun bool
+	force  bool
+)
+
+func newRemoveCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rm [project name] [key prefix]",
+		Short: "Remove documents whose keys start with the prefix",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project and key prefix are required")
+			}
+
+			projectName, prefix := args[0], args[1]
+			cli, err := admin.Dial(config.AdminAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			removed, skipped, err := cli.RemoveDocumentsByPrefix(ctx, projectName, prefix, dryRun, force)
+			if err != nil {
+				return err
+			}
+
+			if dryRun {
+				cmd.Printf("%d documents would be removed, %d attached documents skipped\n", removed, skipped)
+				return nil
+			}
+			cmd.Printf("%d documents removed, %d attached documents skipped\n", removed, skipped)
+			return nil
+		},
+	}
+}
+
+func init() {
+	cmd := newRemoveCommand()
+	cmd.Flags().BoolVar(
+		&dryRun,
+		"dry-run",
+		false,
+		"only count the documents to remove",
+	)
+	cmd.Flags().BoolVar(
+		&force,
+		"force",
+		false,
+		"remove documents attached by clients as well",
+	)
+	SubCmd.AddCommand(cmd)
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+func newRollbackCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rollback [project name] [document key] [server seq]",
+		Short: "Restore the document to the retained snapshot of the server seq",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 3 {
+				return errors.New("project, document key and server seq are required")
+			}
+
+			projectName, docKey := args[0], args[1]
+			serverSeq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			cli, err := admin.Dial(config.AdminAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			if err := cli.RollbackDocument(ctx, projectName, key.Key(docKey), serverSeq); err != nil {
+				return err
+			}
+
+			cmd.Printf("%s rolled back to %d\n", docKey, serverSeq)
+			return nil
+		},
+	}
+}
+
+func init() {
+	SubCmd.AddCommand(newRollbackCommand())
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+func newTransferCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer [project name] [document key] [owner]",
+		Short: "Transfer the ownership of the document and detach the clients no longer permitted",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 3 {
+				return errors.New("project, document key and owner are required")
+			}
+
+			cli, err := admin.Dial(config.AdminAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			detachedClientIDs, err := cli.TransferDocumentOwnership(ctx, args[0], key.Key(args[1]), args[2])
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("%s transferred to %s\n", args[1], args[2])
+			for _, id := range detachedClientIDs {
+				cmd.Printf("detached %s\n", id)
+			}
+			return nil
+		},
+	}
+}
+
+func init() {
+	SubCmd.AddCommand(newTransferCommand())
+}
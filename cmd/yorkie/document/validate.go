@@ -0,0 +1,80 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+func newValidateCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate [project name] [document key]",
+		Short: "Replay the changes of the document and compare it with the latest snapshot",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project and document key are required")
+			}
+
+			projectName, docKey := args[0], args[1]
+
+			cli, err := admin.Dial(config.AdminAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			validation, err := cli.ValidateDocument(ctx, projectName, key.Key(docKey))
+			if err != nil {
+				return err
+			}
+
+			if !validation.Valid {
+				return fmt.Errorf(
+					"%s diverges from the snapshot of %d at %s: expected %s, actual %s",
+					docKey,
+					validation.ServerSeq,
+					validation.DivergingPath,
+					validation.ExpectedChecksum,
+					validation.ActualChecksum,
+				)
+			}
+
+			cmd.Printf(
+				"%s matches the snapshot of %d: %s\n",
+				docKey,
+				validation.ServerSeq,
+				validation.ActualChecksum,
+			)
+			return nil
+		},
+	}
+}
+
+func init() {
+	SubCmd.AddCommand(newValidateCommand())
+}
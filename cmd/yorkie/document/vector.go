@@ -0,0 +1,86 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+func newVectorCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "vector [project name] [document key]",
+		Short: "Show the largest Lamport timestamps of the changes of each actor of the document",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project and document key are required")
+			}
+
+			projectName, docKey := args[0], args[1]
+			cli, err := admin.Dial(config.AdminAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			vector, err := cli.GetDocumentVersionVector(ctx, projectName, key.Key(docKey))
+			if err != nil {
+				return err
+			}
+
+			actors := make([]string, 0, len(vector))
+			for actor := range vector {
+				actors = append(actors, actor)
+			}
+			sort.Strings(actors)
+
+			tw := table.NewWriter()
+			tw.Style().Options.DrawBorder = false
+			tw.Style().Options.SeparateColumns = false
+			tw.Style().Options.SeparateFooter = false
+			tw.Style().Options.SeparateHeader = false
+			tw.Style().Options.SeparateRows = false
+			tw.AppendHeader(table.Row{
+				"ACTOR ID",
+				"LAMPORT",
+			})
+			for _, actor := range actors {
+				tw.AppendRow(table.Row{
+					actor,
+					vector[actor],
+				})
+			}
+			cmd.Printf("%s\n", tw.Render())
+			return nil
+		},
+	}
+}
+
+func init() {
+	SubCmd.AddCommand(newVectorCommand())
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+)
+
+var maintenanceMessage string
+
+func newMaintenanceCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "maintenance [on|off]",
+		Short: "Show or change the maintenance mode of the server",
+		Long: "Show or change the maintenance mode of the server. In maintenance mode, " +
+			"the server rejects writes such as pushing changes and creating documents, " +
+			"but keeps serving reads and watches.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 1 {
+				return errors.New("too many arguments")
+			}
+
+			cli, err := admin.Dial(config.AdminAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			var maintenance *types.Maintenance
+			if len(args) == 0 {
+				maintenance, err = cli.GetMaintenance(ctx)
+			} else {
+				switch args[0] {
+				case "on":
+					maintenance, err = cli.SetMaintenance(ctx, true, maintenanceMessage)
+				case "off":
+					maintenance, err = cli.SetMaintenance(ctx, false, "")
+				default:
+					return errors.New("argument must be on or off")
+				}
+			}
+			if err != nil {
+				return err
+			}
+
+			if !maintenance.Enabled {
+				cmd.Printf("maintenance off\n")
+				return nil
+			}
+			cmd.Printf("maintenance on since %s: %s\n", maintenance.UpdatedAt, maintenance.Message)
+			return nil
+		},
+	}
+}
+
+func init() {
+	cmd := newMaintenanceCmd()
+	cmd.Flags().StringVar(
+		&maintenanceMessage,
+		"message",
+		"",
+		"The message returned with the writes rejected in maintenance mode",
+	)
+	rootCmd.AddCommand(cmd)
+}
@@ -59,6 +59,7 @@ func newListCommand() *cobra.Command {
 				"SECRET KEY",
 				"AUTH WEBHOOK URL",
 				"AUTH WEBHOOK METHODS",
+				"DOCUMENTS",
 				"CREATED AT",
 			})
 			for _, project := range projects {
@@ -68,6 +69,7 @@ func newListCommand() *cobra.Command {
 					project.SecretKey,
 					project.AuthWebhookURL,
 					project.AuthWebhookMethods,
+					project.DocumentCount,
 					units.HumanDuration(time.Now().UTC().Sub(project.CreatedAt)),
 				})
 			}
@@ -0,0 +1,85 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package project
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+)
+
+var rejectionsMaxPerSecond int
+
+func newRejectionsCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "rejections [name]",
+		Short:   "Print the changes of a project rejected by the server as they occur",
+		Example: "yorkie project rejections sample-project --max-per-second 5",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("name is required")
+			}
+
+			cli, err := admin.Dial(config.AdminAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			return cli.WatchRejections(
+				ctx,
+				args[0],
+				rejectionsMaxPerSecond,
+				func(rejection *types.Rejection) error {
+					if rejection.Dropped > 0 {
+						cmd.Printf("... %d rejections dropped\n", rejection.Dropped)
+					}
+					cmd.Printf(
+						"%s\t%s\t%s\t%s\t%d\t%s\n",
+						rejection.RejectedAt.Format(time.RFC3339),
+						rejection.DocumentKey,
+						rejection.ClientID,
+						rejection.Reason,
+						rejection.Changes,
+						rejection.Message,
+					)
+					return nil
+				},
+			)
+		},
+	}
+}
+
+func init() {
+	cmd := newRejectionsCommand()
+	cmd.Flags().IntVar(
+		&rejectionsMaxPerSecond,
+		"max-per-second",
+		0,
+		"the maximum number of the rejections printed per second, 0 means the default of the server",
+	)
+	SubCmd.AddCommand(cmd)
+}
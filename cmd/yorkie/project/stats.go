@@ -0,0 +1,107 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package project
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/units"
+)
+
+func newStatsCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "stats [name]",
+		Short:   "Show the statistics of a project such as the conflict wins and the hot documents",
+		Example: "yorkie project stats sample-project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("name is required")
+			}
+
+			cli, err := admin.Dial(config.AdminAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			stats, err := cli.GetProjectStats(ctx, args[0])
+			if err != nil {
+				return err
+			}
+
+			tw := newStatsTableWriter()
+			tw.AppendHeader(table.Row{
+				"ACTOR",
+				"CONFLICT WINS",
+			})
+			for _, wins := range stats.ConflictWins {
+				tw.AppendRow(table.Row{
+					wins.Actor,
+					wins.Wins,
+				})
+			}
+			cmd.Printf("%s\n\n", tw.Render())
+
+			tw = newStatsTableWriter()
+			tw.AppendHeader(table.Row{
+				"HOT DOCUMENT",
+				"OPS/SEC",
+				"TOP ACTOR",
+				"TOP ACTOR OPS/SEC",
+				"DETECTED AT",
+			})
+			for _, doc := range stats.HotDocuments {
+				tw.AppendRow(table.Row{
+					doc.DocumentKey,
+					fmt.Sprintf("%.1f", doc.OpsPerSecond),
+					doc.TopActorID,
+					fmt.Sprintf("%.1f", doc.TopActorOpsPerSecond),
+					units.HumanDuration(time.Now().UTC().Sub(doc.DetectedAt)),
+				})
+			}
+			cmd.Printf("%s\n", tw.Render())
+
+			return nil
+		},
+	}
+}
+
+// newStatsTableWriter creates a table writer without borders and separators.
+func newStatsTableWriter() table.Writer {
+	tw := table.NewWriter()
+	tw.Style().Options.DrawBorder = false
+	tw.Style().Options.SeparateColumns = false
+	tw.Style().Options.SeparateFooter = false
+	tw.Style().Options.SeparateHeader = false
+	tw.Style().Options.SeparateRows = false
+	return tw
+}
+
+func init() {
+	SubCmd.AddCommand(newStatsCommand())
+}
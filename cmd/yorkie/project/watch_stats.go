@@ -0,0 +1,82 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package project
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+)
+
+func newWatchStatsCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "watch-stats [name]",
+		Short:   "Print the live statistics of a project such as the active documents as they change",
+		Example: "yorkie project watch-stats sample-project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("name is required")
+			}
+
+			cli, err := admin.Dial(config.AdminAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cli.Close()
+			}()
+
+			ctx := context.Background()
+			return cli.WatchProjectStats(
+				ctx,
+				args[0],
+				func(update *types.ProjectStatsUpdate) error {
+					event := "-"
+					if update.Event != nil {
+						event = string(update.Event.Type) + " " + update.Event.DocumentKey.String()
+					}
+					topWinner := "-"
+					if wins := update.Stats.ConflictWins; len(wins) > 0 {
+						topWinner = fmt.Sprintf("%s(%d)", wins[0].Actor, wins[0].Wins)
+					}
+					cmd.Printf(
+						"%s\tdocs: %d\tclients: %d\tops/sec: %.1f\thot: %d\ttop winner: %s\t%s\n",
+						update.Stats.MeasuredAt.Format(time.RFC3339),
+						update.Stats.ActiveDocuments,
+						update.Stats.AttachedClients,
+						update.Stats.OpsPerSecond,
+						update.Stats.HotDocuments,
+						topWinner,
+						event,
+					)
+					return nil
+				},
+			)
+		},
+	}
+}
+
+func init() {
+	SubCmd.AddCommand(newWatchStatsCommand())
+}
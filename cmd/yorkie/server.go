@@ -25,6 +25,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/server"
 	"github.com/yorkie-team/yorkie/server/backend/database/mongo"
 	"github.com/yorkie-team/yorkie/server/backend/sync/etcd"
@@ -36,21 +37,47 @@ var (
 )
 
 var (
-	flagConfPath string
-	flagLogLevel string
+	flagConfPath  string
+	flagLogLevel  string
+	flagLogFormat string
 
-	housekeepingInterval            time.Duration
-	housekeepingDeactivateThreshold time.Duration
+	rpcShutdownTimeout time.Duration
+
+	housekeepingInterval             time.Duration
+	housekeepingDeactivateThreshold  time.Duration
+	housekeepingClientEventRetention time.Duration
+	housekeepingDocEventLogRetention time.Duration
 
 	mongoConnectionURI     string
 	mongoConnectionTimeout time.Duration
 	mongoYorkieDatabase    string
 	mongoPingTimeout       time.Duration
 
+	clientReactivationGracePeriod time.Duration
+
 	authWebhookMaxWaitInterval time.Duration
 	authWebhookCacheAuthTTL    time.Duration
 	authWebhookCacheUnauthTTL  time.Duration
 
+	eventWebhookMaxWaitInterval time.Duration
+
+	documentCountCacheTTL time.Duration
+	eventBatchWindow      time.Duration
+	hotDocumentWindow     time.Duration
+	projectStatsInterval  time.Duration
+	queryTimeout          time.Duration
+
+	dbHealthCheckInterval time.Duration
+	dbReconnectMaxBackoff time.Duration
+	readYourWritesTimeout time.Duration
+
+	removedDocumentRetention time.Duration
+
+	operationIDWindow time.Duration
+
+	snapshotRetentionPeriod      time.Duration
+	snapshotWriteMaxWaitInterval time.Duration
+
 	etcdEndpoints     []string
 	etcdDialTimeout   time.Duration
 	etcdUsername      string
@@ -65,12 +92,33 @@ func newServerCmd() *cobra.Command {
 		Use:   "server [options]",
 		Short: "Start Yorkie server",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			conf.RPC.ShutdownTimeout = rpcShutdownTimeout.String()
+			if conf.Admin.SocketPath != "" && !cmd.Flags().Changed("admin-port") {
+				conf.Admin.Port = 0
+			}
+
+			conf.Backend.ClientReactivationGracePeriod = clientReactivationGracePeriod.String()
 			conf.Backend.AuthWebhookMaxWaitInterval = authWebhookMaxWaitInterval.String()
 			conf.Backend.AuthWebhookCacheAuthTTL = authWebhookCacheAuthTTL.String()
 			conf.Backend.AuthWebhookCacheUnauthTTL = authWebhookCacheUnauthTTL.String()
+			conf.Backend.EventWebhookMaxWaitInterval = eventWebhookMaxWaitInterval.String()
+			conf.Backend.DocumentCountCacheTTL = documentCountCacheTTL.String()
+			conf.Backend.EventBatchWindow = eventBatchWindow.String()
+			conf.Backend.HotDocumentWindow = hotDocumentWindow.String()
+			conf.Backend.ProjectStatsInterval = projectStatsInterval.String()
+			conf.Backend.QueryTimeout = queryTimeout.String()
+			conf.Backend.DBHealthCheckInterval = dbHealthCheckInterval.String()
+			conf.Backend.DBReconnectMaxBackoff = dbReconnectMaxBackoff.String()
+			conf.Backend.ReadYourWritesTimeout = readYourWritesTimeout.String()
+			conf.Backend.RemovedDocumentRetention = removedDocumentRetention.String()
+			conf.Backend.OperationIDWindow = operationIDWindow.String()
+			conf.Backend.SnapshotRetentionPeriod = snapshotRetentionPeriod.String()
+			conf.Backend.SnapshotWriteMaxWaitInterval = snapshotWriteMaxWaitInterval.String()
 
 			conf.Housekeeping.Interval = housekeepingInterval.String()
 			conf.Housekeeping.DeactivateThreshold = housekeepingDeactivateThreshold.String()
+			conf.Housekeeping.ClientEventRetention = housekeepingClientEventRetention.String()
+			conf.Housekeeping.DocEventLogRetention = housekeepingDocEventLogRetention.String()
 
 			if mongoConnectionURI != "" {
 				conf.Mongo = &mongo.Config{
@@ -100,7 +148,18 @@ func newServerCmd() *cobra.Command {
 				conf = parsed
 			}
 
-			if err := logging.SetLogLevel(flagLogLevel); err != nil {
+			// NOTE: The flags are used for the logging options which the config
+			// file does not have.
+			if conf.Logging == nil {
+				conf.Logging = &logging.Config{}
+			}
+			if conf.Logging.Level == "" {
+				conf.Logging.Level = flagLogLevel
+			}
+			if conf.Logging.Format == "" {
+				conf.Logging.Format = flagLogFormat
+			}
+			if err := logging.Configure(conf.Logging); err != nil {
 				return err
 			}
 
@@ -174,6 +233,12 @@ func init() {
 		"info",
 		"Log level: debug, info, warn, error, panic, fatal",
 	)
+	cmd.Flags().StringVar(
+		&flagLogFormat,
+		"log-format",
+		logging.ConsoleFormat,
+		"Log format: console for human-readable logs, json for structured logs",
+	)
 	cmd.Flags().IntVar(
 		&conf.RPC.Port,
 		"rpc-port",
@@ -198,6 +263,30 @@ func init() {
 		server.DefaultRPCMaxRequestsBytes,
 		"Maximum client request size in bytes the server will accept.",
 	)
+	cmd.Flags().Uint64Var(
+		&conf.RPC.MaxChangePackBytes,
+		"rpc-max-change-pack-bytes",
+		server.DefaultRPCMaxChangePackBytes,
+		"Maximum change pack size in bytes of PushPull. Larger change packs should be sent with PushChangesStream.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.RPC.MaxStreamedChangePackBytes,
+		"rpc-max-streamed-change-pack-bytes",
+		server.DefaultRPCMaxStreamedChangePackBytes,
+		"Maximum change pack size in bytes of PushChangesStream.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.RPC.MaxConcurrentStreamsPerConn,
+		"rpc-max-concurrent-streams-per-conn",
+		server.DefaultRPCMaxConcurrentStreamsPerConn,
+		"Maximum number of concurrent streams on a single connection.",
+	)
+	cmd.Flags().DurationVar(
+		&rpcShutdownTimeout,
+		"rpc-shutdown-timeout",
+		server.DefaultRPCShutdownTimeout,
+		"Maximum time to wait for in-flight requests and streams on graceful shutdown. Zero means no limit.",
+	)
 	cmd.Flags().IntVar(
 		&conf.Profiling.Port,
 		"profiling-port",
@@ -216,6 +305,12 @@ func init() {
 		server.DefaultAdminPort,
 		"Admin port",
 	)
+	cmd.Flags().StringVar(
+		&conf.Admin.SocketPath,
+		"admin-socket-path",
+		"",
+		"Path of the Unix domain socket to serve the admin API on instead of the admin port",
+	)
 	cmd.Flags().DurationVar(
 		&housekeepingInterval,
 		"housekeeping-interval",
@@ -234,6 +329,24 @@ func init() {
 		server.DefaultHousekeepingCandidateLimit,
 		"candidates limit for a single housekeeping run",
 	)
+	cmd.Flags().DurationVar(
+		&housekeepingClientEventRetention,
+		"housekeeping-client-event-retention",
+		server.DefaultHousekeepingClientEventRetention,
+		"time for which the events of clients on documents are kept",
+	)
+	cmd.Flags().DurationVar(
+		&housekeepingDocEventLogRetention,
+		"housekeeping-doc-event-log-retention",
+		server.DefaultHousekeepingDocEventLogRetention,
+		"time for which the entries of the event logs of documents are kept",
+	)
+	cmd.Flags().IntVar(
+		&conf.Housekeeping.SnapshotUpgradeLimit,
+		"housekeeping-snapshot-upgrade-limit",
+		server.DefaultHousekeepingSnapshotUpgradeLimit,
+		"maximum number of snapshots in old formats upgraded in a single housekeeping run",
+	)
 	cmd.Flags().StringVar(
 		&mongoConnectionURI,
 		"mongo-connection-uri",
@@ -308,6 +421,235 @@ func init() {
 		server.DefaultSnapshotInterval,
 		"Interval of changes to create a snapshot.",
 	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.SnapshotOnAttachThreshold,
+		"backend-snapshot-on-attach-threshold",
+		0,
+		"Number of changes after the last snapshot to create a snapshot when a client attaches the document. Zero disables it.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.TombstoneThreshold,
+		"backend-tombstone-threshold",
+		0,
+		"Number of tombstones in the snapshot of a document to force the next snapshot for garbage collection. Zero disables it.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.SnapshotRetentionCount,
+		"backend-snapshot-retention-count",
+		0,
+		"Number of the latest snapshots of each document to retain for rollback. Zero retains all snapshots.",
+	)
+	cmd.Flags().DurationVar(
+		&snapshotRetentionPeriod,
+		"backend-snapshot-retention-period",
+		server.DefaultSnapshotRetentionPeriod,
+		"Period to retain snapshots for rollback regardless of the retention count. Zero disables it.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.SnapshotCompression,
+		"backend-snapshot-compression",
+		string(types.SnapshotCompressionNone),
+		"Algorithm to compress the snapshots stored, one of \"none\" and \"gzip\". Projects can override it.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.SnapshotWriteMaxRetries,
+		"backend-snapshot-write-max-retries",
+		server.DefaultSnapshotWriteMaxRetries,
+		"Maximum number of retries for writing a snapshot.",
+	)
+	cmd.Flags().DurationVar(
+		&snapshotWriteMaxWaitInterval,
+		"backend-snapshot-write-max-wait-interval",
+		server.DefaultSnapshotWriteMaxWaitInterval,
+		"Maximum wait interval for retrying to write a snapshot.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.MaxLamportGap,
+		"backend-max-lamport-gap",
+		server.DefaultMaxLamportGap,
+		"Maximum gap between the Lamport timestamp of a pushed change and the largest one of the document.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.LamportFlushInterval,
+		"backend-lamport-flush-interval",
+		0,
+		"Number of changes after which the largest Lamport timestamp of a document is stored. "+
+			"Zero or one stores it with every push.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.MaxValueBytes,
+		"backend-max-value-bytes",
+		0,
+		"Maximum size in bytes of a primitive value or a text edit in pushed changes. Zero disables it.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.MaxKeysPerObject,
+		"backend-max-keys-per-object",
+		0,
+		"Maximum number of live keys of an Object in documents. Projects can override it. Zero disables it.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.MaxOperationsPerPush,
+		"backend-max-operations-per-push",
+		0,
+		"Maximum number of operations pushed by a request. The rest are deferred to the following requests. Zero disables it.",
+	)
+	cmd.Flags().Float64Var(
+		&conf.Backend.HotDocumentThreshold,
+		"backend-hot-document-threshold",
+		0,
+		"Operations per second pushed to a document above which the document is detected as hot. Zero disables it.",
+	)
+	cmd.Flags().DurationVar(
+		&hotDocumentWindow,
+		"backend-hot-document-window",
+		server.DefaultHotDocumentWindow,
+		"Sliding window to measure the change rate of documents for detecting hot documents.",
+	)
+	cmd.Flags().DurationVar(
+		&projectStatsInterval,
+		"backend-project-stats-interval",
+		server.DefaultProjectStatsInterval,
+		"Interval of the live statistics of a project sent to the admins watching them.",
+	)
+	cmd.Flags().DurationVar(
+		&queryTimeout,
+		"backend-query-timeout",
+		server.DefaultQueryTimeout,
+		"Timeout of each operation of the database. Zero disables it.",
+	)
+	cmd.Flags().StringToStringVar(
+		&conf.Backend.QueryTimeoutOverrides,
+		"backend-query-timeout-overrides",
+		nil,
+		"Timeouts of the operations of the database replacing the default, e.g. FindDocInfosByPaging=2m.",
+	)
+	cmd.Flags().DurationVar(
+		&dbHealthCheckInterval,
+		"backend-db-health-check-interval",
+		server.DefaultDBHealthCheckInterval,
+		"Interval to check the connection to the database. Zero disables it.",
+	)
+	cmd.Flags().DurationVar(
+		&dbReconnectMaxBackoff,
+		"backend-db-reconnect-max-backoff",
+		server.DefaultDBReconnectMaxBackoff,
+		"Max interval between the attempts to reconnect to the database.",
+	)
+	cmd.Flags().DurationVar(
+		&readYourWritesTimeout,
+		"backend-read-your-writes-timeout",
+		server.DefaultReadYourWritesTimeout,
+		"Max time to wait for the server to catch up to the write of a consistency token.",
+	)
+	cmd.Flags().DurationVar(
+		&removedDocumentRetention,
+		"backend-removed-document-retention",
+		server.DefaultRemovedDocumentRetention,
+		"Period within which a removed document can be restored by attaching to its key. Zero means no limit.",
+	)
+	cmd.Flags().DurationVar(
+		&operationIDWindow,
+		"backend-operation-id-window",
+		server.DefaultOperationIDWindow,
+		"Window in which the IDs of pushed operations are checked to reject the resent ones. Zero disables it.",
+	)
+	cmd.Flags().Int64Var(
+		&conf.Backend.SnapshotCacheBytes,
+		"backend-snapshot-cache-bytes",
+		0,
+		"Max total bytes of the snapshots whose materialized roots are cached. Zero disables it.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Backend.BackgroundQueueWarnThreshold,
+		"backend-background-queue-warn-threshold",
+		server.DefaultBackgroundQueueWarnThreshold,
+		"Number of the pending snapshot or GC jobs in the background above which a warning is logged. Zero disables it.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Backend.OperationSampleInterval,
+		"backend-operation-sample-interval",
+		server.DefaultOperationSampleInterval,
+		"Interval of the operations whose apply durations are measured, e.g. 10 measures one in every 10. Zero disables it.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Backend.MaxActorsPerDocument,
+		"backend-max-actors-per-document",
+		0,
+		"Maximum number of distinct clients that have attached a document. Zero disables it.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Backend.MaxVersionVectorSize,
+		"backend-max-version-vector-size",
+		0,
+		"Maximum number of clients attaching a document at the same time. Zero disables it.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Backend.MaxPathDepth,
+		"backend-max-path-depth",
+		server.DefaultMaxPathDepth,
+		"Maximum depth of the paths of elements given by clients.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Backend.MaxProjects,
+		"backend-max-projects",
+		0,
+		"Maximum number of projects of the deployment. Zero disables it.",
+	)
+	cmd.Flags().BoolVar(
+		&conf.Backend.EnableSubtreeWatch,
+		"backend-enable-subtree-watch",
+		server.DefaultEnableSubtreeWatch,
+		"Whether to allow clients to watch subtrees of documents.",
+	)
+	cmd.Flags().BoolVar(
+		&conf.Backend.EnableDocEventLog,
+		"backend-enable-doc-event-log",
+		server.DefaultEnableDocEventLog,
+		"Whether to record the event log of documents for debugging.",
+	)
+	cmd.Flags().BoolVar(
+		&conf.Backend.EnableOperationSquash,
+		"backend-enable-operation-squash",
+		server.DefaultEnableOperationSquash,
+		"Whether to merge adjacent operations of pushed changes before storing them.",
+	)
+	cmd.Flags().StringSliceVar(
+		&conf.Backend.PushMiddlewares,
+		"backend-push-middlewares",
+		nil,
+		"Order of the middlewares which pushed changes pass through: authz, validation, squash and metrics.",
+	)
+	cmd.Flags().StringSliceVar(
+		&conf.Backend.IndexedMetadataKeys,
+		"backend-indexed-metadata-keys",
+		nil,
+		"Metadata keys of documents that can be used to filter the list of documents.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.IDGenerator,
+		"backend-id-generator",
+		server.DefaultIDGenerator,
+		"Generator of IDs of projects, clients and documents: objectid or time-sortable.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Backend.ActorIDSize,
+		"backend-actor-id-size",
+		server.DefaultActorIDSize,
+		"Length of the actor IDs accepted by the server in bytes.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.ActorIDPrefix,
+		"backend-actor-id-prefix",
+		"",
+		"Hexadecimal prefix of the actor IDs accepted by the server and of the IDs of clients.",
+	)
+	cmd.Flags().DurationVar(
+		&clientReactivationGracePeriod,
+		"backend-client-reactivation-grace-period",
+		server.DefaultClientReactivationGracePeriod,
+		"Period during which a deactivated client can be reactivated by attaching a document.",
+	)
 	cmd.Flags().Uint64Var(
 		&conf.Backend.AuthWebhookMaxRetries,
 		"auth-webhook-max-retries",
@@ -338,6 +680,30 @@ func init() {
 		server.DefaultAuthWebhookCacheUnauthTTL,
 		"TTL value to set when caching unauthorized webhook response.",
 	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.EventWebhookMaxRetries,
+		"event-webhook-max-retries",
+		server.DefaultEventWebhookMaxRetries,
+		"Maximum number of retries for an event webhook.",
+	)
+	cmd.Flags().DurationVar(
+		&eventWebhookMaxWaitInterval,
+		"event-webhook-max-wait-interval",
+		server.DefaultEventWebhookMaxWaitInterval,
+		"Maximum wait interval for event webhook.",
+	)
+	cmd.Flags().DurationVar(
+		&documentCountCacheTTL,
+		"backend-document-count-cache-ttl",
+		server.DefaultDocumentCountCacheTTL,
+		"TTL value to set when caching the number of documents of projects.",
+	)
+	cmd.Flags().DurationVar(
+		&eventBatchWindow,
+		"backend-event-batch-window",
+		server.DefaultEventBatchWindow,
+		"Window to coalesce the change events of each document before broadcasting them.",
+	)
 
 	rootCmd.AddCommand(cmd)
 }
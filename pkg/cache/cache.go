@@ -112,3 +112,17 @@ func (c *LRUExpireCache[K, V]) Get(key K) (V, bool) {
 
 	return element.Value.(*cacheEntry[K, V]).value, true
 }
+
+// Remove removes the specified key from the cache if it exists.
+func (c *LRUExpireCache[K, V]) Remove(key K) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return
+	}
+
+	c.evictionList.Remove(element)
+	delete(c.entries, key)
+}
@@ -71,4 +71,50 @@ func TestCache(t *testing.T) {
 		assert.True(t, ok)
 		assert.Equal(t, "response2", response2)
 	})
+
+	t.Run("remove test", func(t *testing.T) {
+		lruCache, err := cache.NewLRUExpireCache[string, string](1)
+		assert.NoError(t, err)
+
+		lruCache.Add("request", "response", time.Minute)
+		lruCache.Remove("request")
+		response, ok := lruCache.Get("request")
+		assert.False(t, ok)
+		assert.Empty(t, response)
+
+		// removing a missing key is a no-op
+		lruCache.Remove("request")
+	})
+
+	t.Run("evict by size test", func(t *testing.T) {
+		sizeCache, err := cache.NewLRUSizeCache[string, string](10)
+		assert.NoError(t, err)
+
+		sizeCache.Add("k1", "v1", 4)
+		sizeCache.Add("k2", "v2", 4)
+		_, ok := sizeCache.Get("k1")
+		assert.True(t, ok)
+
+		// k2 is the least recently accessed, so it is evicted first.
+		sizeCache.Add("k3", "v3", 4)
+		assert.Equal(t, int64(8), sizeCache.Size())
+		_, ok = sizeCache.Get("k2")
+		assert.False(t, ok)
+		_, ok = sizeCache.Get("k1")
+		assert.True(t, ok)
+
+		// the value larger than the max size is not added.
+		sizeCache.Add("k4", "v4", 11)
+		_, ok = sizeCache.Get("k4")
+		assert.False(t, ok)
+		assert.Equal(t, int64(8), sizeCache.Size())
+
+		sizeCache.RemoveFunc(func(key string) bool { return key == "k1" })
+		_, ok = sizeCache.Get("k1")
+		assert.False(t, ok)
+		assert.Equal(t, int64(4), sizeCache.Size())
+
+		_, err = cache.NewLRUSizeCache[string, string](0)
+		assert.ErrorIs(t, err, cache.ErrInvalidMaxSize)
+	})
 }
@@ -0,0 +1,120 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// LRUSizeCache is a cache bounded by the total size of its values rather than
+// the number of them. The least recently accessed values are evicted first
+// when the total size exceeds the max size.
+type LRUSizeCache[K comparable, V any] struct {
+	lock sync.Mutex
+
+	maxSize      int64
+	size         int64
+	evictionList list.List
+	entries      map[K]*list.Element
+}
+
+// NewLRUSizeCache creates a cache whose values sum up to the given size.
+func NewLRUSizeCache[K comparable, V any](maxSize int64) (*LRUSizeCache[K, V], error) {
+	if maxSize <= 0 {
+		return nil, ErrInvalidMaxSize
+	}
+
+	return &LRUSizeCache[K, V]{
+		maxSize: maxSize,
+		entries: map[K]*list.Element{},
+	}, nil
+}
+
+type sizeCacheEntry[K comparable, V any] struct {
+	key   K
+	value V
+	size  int64
+}
+
+// Add adds the value of the given size to the cache at key. The value larger
+// than the max size of the cache is not added.
+func (c *LRUSizeCache[K, V]) Add(key K, value V, size int64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+	if size > c.maxSize {
+		return
+	}
+
+	for c.size+size > c.maxSize {
+		c.remove(c.evictionList.Back())
+	}
+
+	c.entries[key] = c.evictionList.PushFront(&sizeCacheEntry[K, V]{
+		key:   key,
+		value: value,
+		size:  size,
+	})
+	c.size += size
+}
+
+// Get returns the value at the specified key from the cache if it exists, or
+// returns false.
+func (c *LRUSizeCache[K, V]) Get(key K) (V, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		var nilV V
+		return nilV, false
+	}
+
+	c.evictionList.MoveToFront(element)
+	return element.Value.(*sizeCacheEntry[K, V]).value, true
+}
+
+// RemoveFunc removes the values whose keys satisfy the given function.
+func (c *LRUSizeCache[K, V]) RemoveFunc(fn func(key K) bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for key, element := range c.entries {
+		if fn(key) {
+			c.remove(element)
+		}
+	}
+}
+
+// Size returns the total size of the values in the cache.
+func (c *LRUSizeCache[K, V]) Size() int64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.size
+}
+
+func (c *LRUSizeCache[K, V]) remove(element *list.Element) {
+	entry := element.Value.(*sizeCacheEntry[K, V])
+	c.evictionList.Remove(element)
+	delete(c.entries, entry.key)
+	c.size -= entry.size
+}
@@ -17,11 +17,20 @@
 package change
 
 import (
+	"errors"
+
 	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/operations"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
+// MaxMessageBytes is the maximum size in bytes of the message of a change.
+const MaxMessageBytes = 1024
+
+// ErrMessageTooLarge is returned when the message of a change exceeds
+// MaxMessageBytes.
+var ErrMessageTooLarge = errors.New("message too large")
+
 // Change represents a unit of modification in the document.
 type Change struct {
 	// id is the unique identifier of the change.
@@ -83,6 +92,13 @@ func (c *Change) SetServerSeq(serverSeq uint64) {
 	c.id = c.id.SetServerSeq(serverSeq)
 }
 
+// SetWallTime sets the given wall-clock time to the operations of this change.
+func (c *Change) SetWallTime(wallTime int64) {
+	for _, op := range c.operations {
+		op.SetWallTime(wallTime)
+	}
+}
+
 // SetActor sets the given actorID.
 func (c *Change) SetActor(actor *time.ActorID) {
 	c.id = c.id.SetActor(actor)
@@ -38,6 +38,11 @@ type Pack struct {
 	// MinSyncedTicket is the minimum logical time taken by clients who attach the document.
 	// It used to collect garbage on the replica on the client.
 	MinSyncedTicket *time.Ticket
+
+	// DeferredChanges is the number of the local changes not pushed by the
+	// request. The server defers them to the following requests when the
+	// request has too many operations.
+	DeferredChanges uint32
 }
 
 // NewPack creates a new instance of Pack.
@@ -0,0 +1,166 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package change
+
+import (
+	"math"
+
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// Squash merges the adjacent operations of each change into fewer operations
+// when the merged ones are provably equivalent to the original ones, and
+// returns the number of removed operations.
+//
+// Only consecutive increments of the same integer counter are merged for now.
+// Text edits are not merged because the tickets of the operations become the
+// IDs of the inserted nodes that the following changes refer to.
+func Squash(changes []*Change) int {
+	counterTypes := make(map[string]json.CounterType)
+
+	removed := 0
+	for _, c := range changes {
+		var squashed []operations.Operation
+		for _, op := range c.operations {
+			collectCounterTypes(op, counterTypes)
+
+			if len(squashed) > 0 {
+				if merged, ok := mergeIncreases(squashed[len(squashed)-1], op, counterTypes); ok {
+					squashed[len(squashed)-1] = merged
+					continue
+				}
+			}
+			squashed = append(squashed, op)
+		}
+
+		removed += len(c.operations) - len(squashed)
+		c.operations = squashed
+	}
+
+	return removed
+}
+
+// collectCounterTypes records the types of the counters created by the given
+// operation.
+func collectCounterTypes(op operations.Operation, counterTypes map[string]json.CounterType) {
+	var value json.Element
+	switch op := op.(type) {
+	case *operations.Set:
+		value = op.Value()
+	case *operations.Add:
+		value = op.Value()
+	default:
+		return
+	}
+
+	switch elem := value.(type) {
+	case *json.Counter:
+		counterTypes[elem.CreatedAt().Key()] = elem.ValueType()
+	case json.Container:
+		elem.Descendants(func(elem json.Element, parent json.Container) bool {
+			if cnt, ok := elem.(*json.Counter); ok {
+				counterTypes[cnt.CreatedAt().Key()] = cnt.ValueType()
+			}
+			return false
+		})
+	}
+}
+
+// mergeIncreases merges the given increments of the same counter into one.
+// The counter should be created in the given changes so that its type is
+// known, because the result of the increments depends on it:
+//   - Double counters accumulate rounding errors for each increment.
+//   - Integer counters are promoted to Long counters when the intermediate
+//     value overflows, so the increments should have the same sign.
+func mergeIncreases(
+	prev, next operations.Operation,
+	counterTypes map[string]json.CounterType,
+) (operations.Operation, bool) {
+	prevInc, ok := prev.(*operations.Increase)
+	if !ok {
+		return nil, false
+	}
+	nextInc, ok := next.(*operations.Increase)
+	if !ok {
+		return nil, false
+	}
+	if prevInc.ParentCreatedAt().Compare(nextInc.ParentCreatedAt()) != 0 {
+		return nil, false
+	}
+
+	// NOTE: The operations with IDs are kept as they are, so that the stored
+	// operations keep the IDs given by the client.
+	if prevInc.ID() != "" || nextInc.ID() != "" {
+		return nil, false
+	}
+
+	counterType, ok := counterTypes[prevInc.ParentCreatedAt().Key()]
+	if !ok || (counterType != json.IntegerCnt && counterType != json.LongCnt) {
+		return nil, false
+	}
+
+	a, ok := integerOf(prevInc.Value())
+	if !ok {
+		return nil, false
+	}
+	b, ok := integerOf(nextInc.Value())
+	if !ok {
+		return nil, false
+	}
+	if (a < 0 && b > 0) || (a > 0 && b < 0) {
+		return nil, false
+	}
+	if (b > 0 && a > math.MaxInt64-b) || (b < 0 && a < math.MinInt64-b) {
+		return nil, false
+	}
+
+	merged := operations.NewIncrease(
+		nextInc.ParentCreatedAt(),
+		newIntegerPrimitive(a+b, nextInc.Value().CreatedAt()),
+		nextInc.ExecutedAt(),
+	)
+	merged.SetWallTime(nextInc.WallTime())
+	return merged, true
+}
+
+// integerOf returns the value of the given element if it is an integer.
+func integerOf(elem json.Element) (int64, bool) {
+	primitive, ok := elem.(*json.Primitive)
+	if !ok {
+		return 0, false
+	}
+
+	switch primitive.ValueType() {
+	case json.Integer:
+		return int64(primitive.Value().(int)), true
+	case json.Long:
+		return primitive.Value().(int64), true
+	default:
+		return 0, false
+	}
+}
+
+// newIntegerPrimitive creates an Integer primitive if the given value fits in
+// it, otherwise a Long primitive.
+func newIntegerPrimitive(value int64, createdAt *time.Ticket) *json.Primitive {
+	if value >= math.MinInt32 && value <= math.MaxInt32 {
+		return json.NewPrimitive(int(value), createdAt)
+	}
+	return json.NewPrimitive(value, createdAt)
+}
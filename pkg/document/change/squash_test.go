@@ -0,0 +1,114 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package change_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+)
+
+func TestSquash(t *testing.T) {
+	t.Run("squash increments of integer counters test", func(t *testing.T) {
+		doc := document.New("d1")
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewCounter("age", 5)
+			age := root.GetCounter("age")
+			age.Increase(1)
+			age.Increase(int64(2))
+			age.Increase(3)
+
+			root.SetNewCounter("width", 10.5)
+			width := root.GetCounter("width")
+			width.Increase(1)
+			width.Increase(2)
+			return nil
+		})
+		assert.NoError(t, err)
+
+		pack := doc.CreateChangePack()
+		assert.Equal(t, 7, pack.OperationsLen())
+		assert.Equal(t, 2, change.Squash(pack.Changes))
+		assert.Equal(t, 5, pack.OperationsLen())
+
+		replica := document.New("d1")
+		assert.NoError(t, replica.ApplyChangePack(pack))
+		assert.Equal(t, doc.Marshal(), replica.Marshal())
+	})
+
+	t.Run("do not squash increments that could change the result test", func(t *testing.T) {
+		doc := document.New("d1")
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewCounter("age", 2147483640)
+			age := root.GetCounter("age")
+			age.Increase(10)
+			age.Increase(-10)
+			return nil
+		})
+		assert.NoError(t, err)
+
+		pack := doc.CreateChangePack()
+		assert.Equal(t, 0, change.Squash(pack.Changes))
+
+		replica := document.New("d1")
+		assert.NoError(t, replica.ApplyChangePack(pack))
+		assert.Equal(t, doc.Marshal(), replica.Marshal())
+	})
+
+	t.Run("do not squash increments of unknown counters test", func(t *testing.T) {
+		doc := document.New("d1")
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewCounter("age", 5)
+			return nil
+		})
+		assert.NoError(t, err)
+		doc.CreateChangePack()
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			age := root.GetCounter("age")
+			age.Increase(1)
+			age.Increase(2)
+			return nil
+		})
+		assert.NoError(t, err)
+
+		pack := doc.CreateChangePack()
+		assert.Equal(t, 0, change.Squash(pack.Changes[1:]))
+		assert.Equal(t, 2, len(pack.Changes[1].Operations()))
+	})
+
+	t.Run("do not squash increments with operation ids test", func(t *testing.T) {
+		doc := document.New("d1")
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewCounter("age", 5)
+			age := root.GetCounter("age")
+			age.Increase(1)
+			age.Increase(2)
+			return nil
+		})
+		assert.NoError(t, err)
+
+		pack := doc.CreateChangePack()
+		pack.Changes[0].Operations()[2].SetID("op-2")
+		assert.Equal(t, 0, change.Squash(pack.Changes))
+		assert.Equal(t, 3, pack.OperationsLen())
+	})
+}
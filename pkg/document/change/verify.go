@@ -0,0 +1,101 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package change
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+var (
+	// ErrInvalidExecutedAt is returned when the executedAt of an operation is
+	// inconsistent with the ID of its change.
+	ErrInvalidExecutedAt = errors.New("invalid executedAt")
+
+	// ErrLamportNotIncreasing is returned when the Lamport timestamp of a change
+	// does not increase from the previous change of the same actor.
+	ErrLamportNotIncreasing = errors.New("lamport not increasing")
+)
+
+// TicketError is returned when a timestamp of a change is inconsistent.
+type TicketError struct {
+	// Field is the path of the timestamp in the change, e.g.
+	// "operations[1].executed_at".
+	Field string
+
+	// Err is the error describing the inconsistency.
+	Err error
+}
+
+// Error returns the error message.
+func (e *TicketError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Err.Error())
+}
+
+// Unwrap returns the error describing the inconsistency.
+func (e *TicketError) Unwrap() error {
+	return e.Err
+}
+
+// VerifyTickets verifies the timestamps of the given change against the
+// previous change of the same actor. The Lamport timestamp of the change must
+// be larger than the given previous one, and every operation of the change
+// must be executed at the Lamport timestamp of the change with the delimiters
+// increasing in the order of the operations. Zero previous Lamport timestamp
+// skips the check of the change. The actors of the operations are not
+// verified here. It returns a TicketError of the first inconsistency.
+func VerifyTickets(c *Change, prevLamport uint64) error {
+	lamport := c.ID().Lamport()
+	if prevLamport > 0 && lamport <= prevLamport {
+		return &TicketError{
+			Field: "id.lamport",
+			Err:   fmt.Errorf("%d after %d: %w", lamport, prevLamport, ErrLamportNotIncreasing),
+		}
+	}
+
+	var prev *time.Ticket
+	for i, op := range c.Operations() {
+		field := fmt.Sprintf("operations[%d].executed_at", i)
+		executedAt := op.ExecutedAt()
+		if executedAt == nil {
+			return &TicketError{Field: field, Err: fmt.Errorf("missing: %w", ErrInvalidExecutedAt)}
+		}
+
+		if executedAt.Lamport() != lamport {
+			return &TicketError{Field: field, Err: fmt.Errorf(
+				"lamport %d of change lamport %d: %w",
+				executedAt.Lamport(),
+				lamport,
+				ErrInvalidExecutedAt,
+			)}
+		}
+
+		if prev != nil && executedAt.Delimiter() <= prev.Delimiter() {
+			return &TicketError{Field: field, Err: fmt.Errorf(
+				"delimiter %d after %d: %w",
+				executedAt.Delimiter(),
+				prev.Delimiter(),
+				ErrInvalidExecutedAt,
+			)}
+		}
+		prev = executedAt
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package change_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+func TestVerifyTickets(t *testing.T) {
+	t.Run("changes of a document test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewObject("k1").SetString("k1.1", "v1").Delete("k1.1")
+			root.SetNewArray("k2").AddInteger(1, 2, 3).Delete(1)
+			root.SetNewText("k3").Edit(0, 0, "hello").Select(0, 1)
+			root.SetNewRichText("k4").Edit(0, 0, "hello", nil).SetStyle(0, 5, map[string]string{"b": "1"})
+			root.SetNewCounter("k5", 0).Increase(5)
+			root.SetNewTree("k6").Edit(nil, 0, 0, proxy.TreeNode{
+				Type:     "p",
+				Children: []proxy.TreeNode{{Type: "text", Value: "hello"}},
+			}).Style(nil, 0, 1, map[string]string{"b": "1"})
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.Clear()
+			return nil
+		}))
+
+		var prevLamport uint64
+		for _, cn := range doc.CreateChangePack().Changes {
+			assert.NoError(t, change.VerifyTickets(cn, prevLamport))
+			prevLamport = cn.ID().Lamport()
+		}
+	})
+
+	actorID, _ := time.ActorIDFromHex("000000000000000000000001")
+	id := change.NewID(1, 0, 5, actorID)
+	remove := func(executedAt *time.Ticket) operations.Operation {
+		return operations.NewRemove(time.InitialTicket, time.InitialTicket, executedAt)
+	}
+
+	t.Run("consistent tickets test", func(t *testing.T) {
+		cn := change.New(id, "", []operations.Operation{
+			remove(id.NewTimeTicket(1)),
+			remove(id.NewTimeTicket(3)),
+		})
+		assert.NoError(t, change.VerifyTickets(cn, 0))
+		assert.NoError(t, change.VerifyTickets(cn, 4))
+	})
+
+	t.Run("lamport not increasing test", func(t *testing.T) {
+		cn := change.New(id, "", []operations.Operation{remove(id.NewTimeTicket(1))})
+		assert.ErrorIs(t, change.VerifyTickets(cn, 5), change.ErrLamportNotIncreasing)
+		assert.ErrorIs(t, change.VerifyTickets(cn, 6), change.ErrLamportNotIncreasing)
+	})
+
+	t.Run("executedAt of another lamport test", func(t *testing.T) {
+		cn := change.New(id, "", []operations.Operation{
+			remove(id.NewTimeTicket(1)),
+			remove(time.NewTicket(4, 2, actorID)),
+		})
+		err := change.VerifyTickets(cn, 0)
+		assert.ErrorIs(t, err, change.ErrInvalidExecutedAt)
+		var ticketErr *change.TicketError
+		assert.ErrorAs(t, err, &ticketErr)
+		assert.Equal(t, "operations[1].executed_at", ticketErr.Field)
+	})
+
+	t.Run("delimiter not increasing test", func(t *testing.T) {
+		cn := change.New(id, "", []operations.Operation{
+			remove(id.NewTimeTicket(2)),
+			remove(id.NewTimeTicket(2)),
+		})
+		err := change.VerifyTickets(cn, 0)
+		assert.ErrorIs(t, err, change.ErrInvalidExecutedAt)
+		assert.ErrorContains(t, err, "delimiter 2 after 2")
+	})
+
+	t.Run("missing executedAt test", func(t *testing.T) {
+		cn := change.New(id, "", []operations.Operation{remove(nil)})
+		assert.ErrorIs(t, change.VerifyTickets(cn, 0), change.ErrInvalidExecutedAt)
+	})
+}
@@ -18,6 +18,7 @@ package document
 
 import (
 	"fmt"
+	gotime "time"
 
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
@@ -40,6 +41,10 @@ type Document struct {
 	// clone is a copy of `doc` to be exposed to the user and is used to
 	// protect `doc`.
 	clone *json.Root
+
+	// recordWallTime is whether to record the wall-clock time when the
+	// operations are created for latency analysis.
+	recordWallTime bool
 }
 
 // New creates a new instance of Document.
@@ -49,16 +54,35 @@ func New(key key.Key) *Document {
 	}
 }
 
+// NewFromInternalDocument creates a new instance of Document with the given
+// InternalDocument. It is used to make changes on top of the documents built
+// on the server.
+func NewFromInternalDocument(doc *InternalDocument) *Document {
+	return &Document{
+		doc: doc,
+	}
+}
+
 // Update executes the given updater to update this document.
 func (d *Document) Update(
 	updater func(root *proxy.ObjectProxy) error,
 	msgAndArgs ...interface{},
 ) error {
+	message := messageFromMsgAndArgs(msgAndArgs...)
+	if len(message) > change.MaxMessageBytes {
+		return fmt.Errorf(
+			"%d bytes exceeds %d bytes: %w",
+			len(message),
+			change.MaxMessageBytes,
+			change.ErrMessageTooLarge,
+		)
+	}
+
 	d.ensureClone()
 
 	ctx := change.NewContext(
 		d.doc.changeID.Next(),
-		messageFromMsgAndArgs(msgAndArgs...),
+		message,
 		d.clone,
 	)
 
@@ -70,6 +94,9 @@ func (d *Document) Update(
 
 	if ctx.HasOperations() {
 		c := ctx.ToChange()
+		if d.recordWallTime {
+			c.SetWallTime(gotime.Now().UnixMilli())
+		}
 		if err := c.Execute(d.doc.root); err != nil {
 			return err
 		}
@@ -167,6 +194,12 @@ func (d *Document) SetStatus(status statusType) {
 	d.doc.SetStatus(status)
 }
 
+// SetRecordWallTime sets whether to record the wall-clock time when the
+// operations are created. The recorded time is only for diagnostics.
+func (d *Document) SetRecordWallTime(record bool) {
+	d.recordWallTime = record
+}
+
 // IsAttached returns the whether this document is attached or not.
 func (d *Document) IsAttached() bool {
 	return d.doc.IsAttached()
@@ -177,6 +210,31 @@ func (d *Document) RootObject() *json.Object {
 	return d.doc.RootObject()
 }
 
+// SetObjectMergePolicy sets the policy to resolve concurrent writes to the
+// same key of Objects in this document.
+func (d *Document) SetObjectMergePolicy(policy json.MergePolicy) {
+	d.doc.SetObjectMergePolicy(policy)
+	d.clone = nil
+}
+
+// SetStringInterning sets whether the identical string values in this
+// document share the same backing storage. It is disabled by default since it
+// adds a lookup on every insertion, and is useful for the documents with many
+// repeated values such as enum-like fields.
+func (d *Document) SetStringInterning(enabled bool) {
+	d.doc.SetStringInterning(enabled)
+	d.clone = nil
+}
+
+// TakeConflicts returns the conflicts rejected by the merge policy since the
+// last call. Conflicts are recorded only with json.RejectConflicts.
+func (d *Document) TakeConflicts() []json.Conflict {
+	if d.clone != nil {
+		d.clone.TakeConflicts()
+	}
+	return d.doc.root.TakeConflicts()
+}
+
 // Root returns the proxy of the root object.
 func (d *Document) Root() *proxy.ObjectProxy {
 	d.ensureClone()
@@ -19,12 +19,18 @@ package document_test
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
+	gotime "time"
+	"unsafe"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
 	"github.com/yorkie-team/yorkie/pkg/document/proxy"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
@@ -160,6 +166,80 @@ func TestDocument(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("import test", func(t *testing.T) {
+		doc := document.New("d1")
+
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			return root.Import([]byte(`{"k1":"v1","k2":{"k3":[1,2.5,true,null,{"k4":2147483648}]}}`))
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":"v1","k2":{"k3":[1,2.500000,true,null,{"k4":2147483648}]}}`, doc.Marshal())
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			return root.Import([]byte(`{"k5":9007199254740993,"k6":-9007199254740993,"k7":2.0,"k8":1}`))
+		})
+		assert.NoError(t, err)
+		root := doc.RootObject()
+		assert.Equal(t, json.Long, root.Get("k5").(*json.Primitive).ValueType())
+		assert.Equal(t, int64(9007199254740993), root.Get("k5").(*json.Primitive).Value())
+		assert.Equal(t, int64(-9007199254740993), root.Get("k6").(*json.Primitive).Value())
+		assert.Equal(t, json.Double, root.Get("k7").(*json.Primitive).ValueType())
+		assert.Equal(t, json.Integer, root.Get("k8").(*json.Primitive).ValueType())
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			return root.Import([]byte(`[1,2]`))
+		})
+		assert.ErrorIs(t, err, proxy.ErrInvalidJSONObject)
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			return root.Import([]byte(`{"k1":"v1"} {}`))
+		})
+		assert.ErrorIs(t, err, proxy.ErrInvalidJSONObject)
+	})
+
+	t.Run("restore test", func(t *testing.T) {
+		doc := document.New("d1")
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewObject("k1").SetString("k1.1", "v1").SetLong("k1.2", 2)
+			root.SetNewArray("k2").AddInteger(1, 2).AddNewArray().AddString("v2")
+			root.SetNewCounter("k3", 3)
+			root.SetNewText("k4").Edit(0, 0, "ABCD").Edit(1, 3, "12")
+			root.SetNewRichText("k5").Edit(0, 0, "Hello", map[string]string{"b": "1"}).Edit(5, 5, " world", nil)
+			root.SetNewTree("k6").Edit(nil, 0, 0, proxy.TreeNode{
+				Type:       "p",
+				Attributes: map[string]string{"align": "left"},
+				Children:   []proxy.TreeNode{{Type: "text", Value: "tree"}},
+			})
+			return nil
+		})
+		assert.NoError(t, err)
+		expected := doc.Marshal()
+		snapshot := doc.RootObject().DeepCopy().(*json.Object)
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.Delete("k1")
+			root.GetText("k4").Edit(0, 4, "")
+			root.GetCounter("k3").Increase(1)
+			root.SetString("k7", "v7")
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, expected, doc.Marshal())
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.Restore(snapshot)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, expected, doc.Marshal())
+
+		replica := document.New("d1")
+		pack := doc.CreateChangePack()
+		pack.MinSyncedTicket = time.InitialTicket
+		assert.NoError(t, replica.ApplyChangePack(pack))
+		assert.Equal(t, expected, replica.Marshal())
+	})
+
 	t.Run("text test", func(t *testing.T) {
 		doc := document.New("d1")
 
@@ -506,4 +586,302 @@ func TestDocument(t *testing.T) {
 		assert.Equal(t, "{}", doc.Marshal())
 		assert.Equal(t, 0, doc.GarbageLen())
 	})
+
+	t.Run("object merge policy test", func(t *testing.T) {
+		actor1, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actor2, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		for _, tc := range []struct {
+			policy    json.MergePolicy
+			expected  string
+			conflicts int
+		}{
+			{json.LastWriterWins, `{"k":"v2"}`, 0},
+			{json.FirstWriterWins, `{"k":"v1"}`, 0},
+			{json.RejectConflicts, `{"k":"v1"}`, 1},
+		} {
+			d1 := document.New("d1")
+			d1.SetActor(actor1)
+			d1.SetObjectMergePolicy(tc.policy)
+			d2 := document.New("d1")
+			d2.SetActor(actor2)
+			d2.SetObjectMergePolicy(tc.policy)
+
+			assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("k", "v1")
+				return nil
+			}))
+			assert.NoError(t, d2.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("k", "v2")
+				return nil
+			}))
+
+			pack1 := change.NewPack(d1.Key(), change.InitialCheckpoint, d1.CreateChangePack().Changes, nil)
+			pack1.MinSyncedTicket = time.InitialTicket
+			pack2 := change.NewPack(d2.Key(), change.InitialCheckpoint, d2.CreateChangePack().Changes, nil)
+			pack2.MinSyncedTicket = time.InitialTicket
+			assert.NoError(t, d1.ApplyChangePack(pack2))
+			assert.NoError(t, d2.ApplyChangePack(pack1))
+
+			assert.Equal(t, tc.expected, d1.Marshal())
+			assert.Equal(t, d1.Marshal(), d2.Marshal())
+
+			for _, doc := range []*document.Document{d1, d2} {
+				conflicts := doc.TakeConflicts()
+				assert.Len(t, conflicts, tc.conflicts)
+				for _, conflict := range conflicts {
+					assert.Equal(t, "k", conflict.Key)
+					assert.Equal(t, `"v2"`, conflict.Rejected.Marshal())
+				}
+				assert.Len(t, doc.TakeConflicts(), 0)
+			}
+		}
+	})
+
+	t.Run("conflict observer test", func(t *testing.T) {
+		actor1, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actor2, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		for _, tc := range []struct {
+			policy json.MergePolicy
+			winner *time.ActorID
+		}{
+			{json.LastWriterWins, actor2},
+			{json.FirstWriterWins, actor1},
+		} {
+			d1 := document.New("d1")
+			d1.SetActor(actor1)
+			assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("k", "v1")
+				return nil
+			}))
+			d2 := document.New("d1")
+			d2.SetActor(actor2)
+			assert.NoError(t, d2.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("k", "v2")
+				return nil
+			}))
+			changes := append(d1.CreateChangePack().Changes, d2.CreateChangePack().Changes...)
+
+			// The winner of the conflict is observed regardless of the order of
+			// the changes applied.
+			for _, ordered := range [][]*change.Change{changes, {changes[1], changes[0]}} {
+				var winners, losers []*time.ActorID
+				internalDoc := document.NewInternalDocument("d1")
+				internalDoc.SetObjectMergePolicy(tc.policy)
+				internalDoc.SetConflictObserver(func(exposed, rejected json.Element) {
+					winners = append(winners, exposed.CreatedAt().ActorID())
+					losers = append(losers, rejected.CreatedAt().ActorID())
+				})
+				assert.NoError(t, internalDoc.ApplyChanges(ordered...))
+				assert.Len(t, winners, 1)
+				assert.Equal(t, tc.winner, winners[0])
+				assert.NotEqual(t, tc.winner, losers[0])
+			}
+		}
+	})
+
+	t.Run("clear test", func(t *testing.T) {
+		doc := document.New("d1")
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("k1").AddInteger(1, 2, 3)
+			root.SetNewObject("k2").SetString("a", "b").SetString("c", "d")
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":[1,2,3],"k2":{"a":"b","c":"d"}}`, doc.Marshal())
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("k1").Clear().AddInteger(4)
+			root.GetObject("k2").Clear().SetString("e", "f")
+			assert.Equal(t, `{"k1":[4],"k2":{"e":"f"}}`, root.Marshal())
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":[4],"k2":{"e":"f"}}`, doc.Marshal())
+		assert.Equal(t, 5, doc.GarbageLen())
+		assert.Equal(t, 5, doc.GarbageCollect(time.MaxTicket))
+		assert.Equal(t, `{"k1":[4],"k2":{"e":"f"}}`, doc.Marshal())
+	})
+
+	t.Run("clear and concurrent add test", func(t *testing.T) {
+		actor1, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actor2, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		for _, tc := range []struct {
+			name       string
+			clearActor *time.ActorID
+			addActor   *time.ActorID
+			expected   string
+		}{
+			{"add after clear", actor1, actor2, `{"k1":[3],"k2":{"c":"d"}}`},
+			{"add before clear", actor2, actor1, `{"k1":[],"k2":{}}`},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				d1 := document.New("d1")
+				d1.SetActor(tc.clearActor)
+				d2 := document.New("d1")
+				d2.SetActor(tc.addActor)
+
+				assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+					root.SetNewArray("k1").AddInteger(1, 2)
+					root.SetNewObject("k2").SetString("a", "b")
+					return nil
+				}))
+				pack := change.NewPack(d1.Key(), change.InitialCheckpoint, d1.CreateChangePack().Changes, nil)
+				pack.MinSyncedTicket = time.InitialTicket
+				assert.NoError(t, d2.ApplyChangePack(pack))
+				assert.Equal(t, d1.Marshal(), d2.Marshal())
+
+				// NOTE: Clear and additions are made concurrently with the same
+				// lamport, so the order of them is decided by the actors.
+				assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+					root.GetArray("k1").Clear()
+					root.GetObject("k2").Clear()
+					return nil
+				}))
+				assert.NoError(t, d2.Update(func(root *proxy.ObjectProxy) error {
+					root.GetArray("k1").AddInteger(3)
+					root.GetObject("k2").SetString("c", "d")
+					return nil
+				}))
+
+				pack1 := change.NewPack(d1.Key(), change.InitialCheckpoint, d1.CreateChangePack().Changes[1:], nil)
+				pack1.MinSyncedTicket = time.InitialTicket
+				pack2 := change.NewPack(d2.Key(), change.InitialCheckpoint, d2.CreateChangePack().Changes, nil)
+				pack2.MinSyncedTicket = time.InitialTicket
+				assert.NoError(t, d1.ApplyChangePack(pack2))
+				assert.NoError(t, d2.ApplyChangePack(pack1))
+
+				assert.Equal(t, tc.expected, d1.Marshal())
+				assert.Equal(t, d1.Marshal(), d2.Marshal())
+				assert.Equal(t, d1.GarbageLen(), d2.GarbageLen())
+			})
+		}
+	})
+
+	t.Run("apply changes with paths test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewObject("k1").SetNewArray("k2").AddInteger(1, 2)
+			root.SetNewText("k3")
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.GetObject("k1").GetArray("k2").Delete(0)
+			root.GetText("k3").Edit(0, 0, "a")
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		changes := doc.CreateChangePack().Changes
+
+		internalDoc := document.NewInternalDocument("d1")
+		changed, removed, err := internalDoc.ApplyChangesWithPaths(changes[0])
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"$.k1", "$.k1.k2", "$.k3"}, changed)
+		assert.Len(t, removed, 0)
+
+		changed, removed, err = internalDoc.ApplyChangesWithPaths(changes[1])
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"$.k1.k2.0", "$.k3"}, changed)
+		assert.Equal(t, []string{"$.k1.k2.0"}, removed)
+
+		changed, removed, err = internalDoc.ApplyChangesWithPaths(changes[2])
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"$.k1"}, changed)
+		assert.Equal(t, []string{"$.k1"}, removed)
+		assert.Equal(t, doc.Marshal(), internalDoc.Marshal())
+	})
+
+	t.Run("operation observer test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewText("k1").Edit(0, 0, "a")
+			root.SetInteger("k2", 1)
+			root.SetInteger("k3", 2)
+			return nil
+		}))
+		changes := doc.CreateChangePack().Changes
+
+		// 01. One in every 2 operations is observed.
+		var observed []string
+		internalDoc := document.NewInternalDocument("d1")
+		internalDoc.SetOperationObserver(func(op operations.Operation, elapsed gotime.Duration) {
+			observed = append(observed, fmt.Sprintf("%T", op))
+		}, 2)
+		assert.NoError(t, internalDoc.ApplyChanges(changes...))
+		assert.Equal(t, []string{"*operations.Edit", "*operations.Set"}, observed)
+		assert.Equal(t, doc.Marshal(), internalDoc.Marshal())
+
+		// 02. No operation is observed once the observer is unset.
+		observed = nil
+		internalDoc = document.NewInternalDocument("d1")
+		internalDoc.SetOperationObserver(nil, 2)
+		assert.NoError(t, internalDoc.ApplyChanges(changes...))
+		assert.Len(t, observed, 0)
+	})
+
+	t.Run("string interning test", func(t *testing.T) {
+		doc := document.New("d1")
+		doc.SetStringInterning(true)
+		root := doc.InternalDocument().Root()
+
+		// 01. The identical values share the same backing storage.
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			for i := 0; i < 10; i++ {
+				root.SetString(fmt.Sprintf("k%d", i), fmt.Sprintf("status-%d", i%2))
+			}
+			return nil
+		}))
+		assert.Equal(t, 2, root.InternedStringLen())
+		value := func(k string) string {
+			return doc.RootObject().Get(k).(*json.Primitive).Value().(string)
+		}
+		assert.Equal(t, stringData(value("k0")), stringData(value("k2")))
+		assert.Equal(t, stringData(value("k1")), stringData(value("k9")))
+		assert.NotEqual(t, stringData(value("k0")), stringData(value("k1")))
+
+		// 02. The values are released when all the elements referring to
+		// them are collected.
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			for i := 1; i < 10; i += 2 {
+				root.Delete(fmt.Sprintf("k%d", i))
+			}
+			return nil
+		}))
+		assert.Equal(t, 2, root.InternedStringLen())
+		assert.Equal(t, 5, doc.GarbageCollect(time.MaxTicket))
+		assert.Equal(t, 1, root.InternedStringLen())
+
+		// 03. The values decoded from the snapshot are also interned.
+		snapshot, err := converter.ObjectToBytes(doc.RootObject())
+		assert.NoError(t, err)
+		doc2 := document.New("d2")
+		doc2.SetStringInterning(true)
+		assert.NoError(t, doc2.ApplyChangePack(change.NewPack(
+			doc2.Key(),
+			change.InitialCheckpoint.NextServerSeq(1),
+			nil,
+			snapshot,
+		)))
+		assert.Equal(t, 1, doc2.InternalDocument().Root().InternedStringLen())
+		assert.Equal(t,
+			stringData(doc2.RootObject().Get("k0").(*json.Primitive).Value().(string)),
+			stringData(doc2.RootObject().Get("k8").(*json.Primitive).Value().(string)),
+		)
+	})
+}
+
+// stringData returns the address of the backing storage of the given string.
+func stringData(s string) uintptr {
+	return (*reflect.StringHeader)(unsafe.Pointer(&s)).Data
 }
@@ -17,10 +17,13 @@
 package document
 
 import (
+	gotime "time"
+
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
@@ -44,18 +47,33 @@ type InternalDocument struct {
 	checkpoint   change.Checkpoint
 	changeID     change.ID
 	localChanges []*change.Change
+
+	// versionVector is the largest Lamport timestamps of the changes of each
+	// actor applied to this document.
+	versionVector time.VersionVector
+
+	// operationObserver observes the durations of the operations applied to
+	// this document, measuring one in every operationSampleInterval.
+	operationObserver       OperationObserver
+	operationSampleInterval int
+	operationCount          int
 }
 
+// OperationObserver is called with the duration of applying the given
+// operation to a document.
+type OperationObserver func(op operations.Operation, elapsed gotime.Duration)
+
 // NewInternalDocument creates a new instance of InternalDocument.
 func NewInternalDocument(k key.Key) *InternalDocument {
 	root := json.NewObject(json.NewRHTPriorityQueueMap(), time.InitialTicket)
 
 	return &InternalDocument{
-		key:        k,
-		status:     Detached,
-		root:       json.NewRoot(root),
-		checkpoint: change.InitialCheckpoint,
-		changeID:   change.InitialID,
+		key:           k,
+		status:        Detached,
+		root:          json.NewRoot(root),
+		checkpoint:    change.InitialCheckpoint,
+		changeID:      change.InitialID,
+		versionVector: time.NewVersionVector(),
 	}
 }
 
@@ -71,13 +89,26 @@ func NewInternalDocumentFromSnapshot(
 		return nil, err
 	}
 
+	return NewInternalDocumentFromRoot(k, serverSeq, lamport, json.NewRoot(obj)), nil
+}
+
+// NewInternalDocumentFromRoot creates a new instance of InternalDocument with
+// the given root materialized from the snapshot at the given serverSeq. The
+// root is owned by the document afterwards.
+func NewInternalDocumentFromRoot(
+	k key.Key,
+	serverSeq uint64,
+	lamport uint64,
+	root *json.Root,
+) *InternalDocument {
 	return &InternalDocument{
-		key:        k,
-		status:     Detached,
-		root:       json.NewRoot(obj),
-		checkpoint: change.InitialCheckpoint.NextServerSeq(serverSeq),
-		changeID:   change.InitialID.SyncLamport(lamport),
-	}, nil
+		key:           k,
+		status:        Detached,
+		root:          root,
+		checkpoint:    change.InitialCheckpoint.NextServerSeq(serverSeq),
+		changeID:      change.InitialID.SyncLamport(lamport),
+		versionVector: time.NewVersionVector(),
+	}
 }
 
 // Key returns the key of this document.
@@ -164,6 +195,18 @@ func (d *InternalDocument) Lamport() uint64 {
 	return d.changeID.Lamport()
 }
 
+// VersionVector returns the largest Lamport timestamps of the changes of each
+// actor applied to this document.
+func (d *InternalDocument) VersionVector() time.VersionVector {
+	return d.versionVector
+}
+
+// SetVersionVector sets the version vector of this document. It is used to
+// restore the version vector of the snapshot that this document is built from.
+func (d *InternalDocument) SetVersionVector(vector time.VersionVector) {
+	d.versionVector = vector.DeepCopy()
+}
+
 // ActorID returns ID of the actor currently editing the document.
 func (d *InternalDocument) ActorID() *time.ActorID {
 	return d.changeID.ActorID()
@@ -189,13 +232,38 @@ func (d *InternalDocument) RootObject() *json.Object {
 	return d.root.Object()
 }
 
+// SetObjectMergePolicy sets the policy to resolve concurrent writes to the
+// same key of Objects in this document.
+func (d *InternalDocument) SetObjectMergePolicy(policy json.MergePolicy) {
+	d.root.SetMergePolicy(policy)
+}
+
+// SetConflictObserver sets the observer of the concurrent writes to the same
+// key of Objects resolved while applying changes to this document, regardless
+// of the merge policy. The observer is unset if it is nil.
+func (d *InternalDocument) SetConflictObserver(observer json.ConflictObserver) {
+	d.root.SetConflictObserver(observer)
+}
+
+// SetStringInterning sets whether the identical string values in this
+// document share the same backing storage.
+func (d *InternalDocument) SetStringInterning(enabled bool) {
+	d.root.SetStringInterning(enabled)
+}
+
 func (d *InternalDocument) applySnapshot(snapshot []byte, serverSeq uint64) error {
 	rootObj, err := converter.BytesToObject(snapshot)
 	if err != nil {
 		return err
 	}
 
+	policy := d.root.MergePolicy()
+	observer := d.root.ConflictObserver()
+	interning := d.root.StringInterning()
 	d.root = json.NewRoot(rootObj)
+	d.root.SetMergePolicy(policy)
+	d.root.SetConflictObserver(observer)
+	d.root.SetStringInterning(interning)
 
 	if d.HasLocalChanges() {
 		for _, c := range d.localChanges {
@@ -212,11 +280,52 @@ func (d *InternalDocument) applySnapshot(snapshot []byte, serverSeq uint64) erro
 // ApplyChanges applies remote changes to the document.
 func (d *InternalDocument) ApplyChanges(changes ...*change.Change) error {
 	for _, c := range changes {
-		if err := c.Execute(d.root); err != nil {
+		if err := d.executeChange(c); err != nil {
 			return err
 		}
 		d.changeID = d.changeID.SyncLamport(c.ID().Lamport())
+		d.versionVector.Set(c.ID().ActorID(), c.ID().Lamport())
+	}
+
+	return nil
+}
+
+// executeChange executes the operations of the given change, measuring the
+// sampled ones if the observer is set.
+func (d *InternalDocument) executeChange(c *change.Change) error {
+	if d.operationObserver == nil {
+		return c.Execute(d.root)
+	}
+
+	for _, op := range c.Operations() {
+		d.operationCount++
+		if d.operationCount%d.operationSampleInterval != 0 {
+			if err := op.Execute(d.root); err != nil {
+				return err
+			}
+			continue
+		}
+
+		start := gotime.Now()
+		if err := op.Execute(d.root); err != nil {
+			return err
+		}
+		d.operationObserver(op, gotime.Since(start))
 	}
 
 	return nil
 }
+
+// SetOperationObserver sets the observer of the durations of the operations
+// applied to this document. It measures one in every sampleInterval
+// operations to keep the overhead low. The observer is unset if it is nil or
+// sampleInterval is not positive.
+func (d *InternalDocument) SetOperationObserver(observer OperationObserver, sampleInterval int) {
+	if observer == nil || sampleInterval <= 0 {
+		d.operationObserver = nil
+		return
+	}
+
+	d.operationObserver = observer
+	d.operationSampleInterval = sampleInterval
+}
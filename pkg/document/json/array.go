@@ -27,6 +27,7 @@ type Array struct {
 	createdAt *time.Ticket
 	movedAt   *time.Ticket
 	removedAt *time.Ticket
+	clearedAt *time.Ticket
 }
 
 // NewArray creates a new instance of Array.
@@ -104,6 +105,7 @@ func (a *Array) DeepCopy() Element {
 
 	array := NewArray(elements, a.createdAt)
 	array.removedAt = a.removedAt
+	array.clearedAt = a.clearedAt
 	return array
 }
 
@@ -142,6 +144,40 @@ func (a *Array) Remove(removedAt *time.Ticket) bool {
 	return false
 }
 
+// ClearedAt returns the time when this array was cleared last.
+func (a *Array) ClearedAt() *time.Ticket {
+	return a.clearedAt
+}
+
+// SetClearedAt sets the time when this array was cleared last.
+func (a *Array) SetClearedAt(clearedAt *time.Ticket) {
+	a.clearedAt = clearedAt
+}
+
+// Clear removes the elements created before the given time and returns them.
+// The time is recorded so that the elements inserted later by concurrent
+// changes can be removed as well.
+func (a *Array) Clear(clearedAt *time.Ticket) []Element {
+	if a.clearedAt == nil || clearedAt.After(a.clearedAt) {
+		a.clearedAt = clearedAt
+	}
+
+	var removed []Element
+	for _, node := range a.elements.Nodes() {
+		if !clearedAt.After(node.elem.CreatedAt()) {
+			continue
+		}
+
+		removedAt := node.elem.RemovedAt()
+		a.elements.DeleteByCreatedAt(node.elem.CreatedAt(), clearedAt)
+		if node.elem.RemovedAt() != removedAt {
+			removed = append(removed, node.elem)
+		}
+	}
+
+	return removed
+}
+
 // LastCreatedAt returns the creation time of the last element.
 func (a *Array) LastCreatedAt() *time.Ticket {
 	return a.elements.LastCreatedAt()
@@ -51,11 +51,12 @@ func CounterValueFromBytes(counterType CounterType, value []byte) interface{} {
 
 // Counter represents changeable number data type.
 type Counter struct {
-	valueType CounterType
-	value     interface{}
-	createdAt *time.Ticket
-	movedAt   *time.Ticket
-	removedAt *time.Ticket
+	valueType   CounterType
+	value       interface{}
+	createdAt   *time.Ticket
+	movedAt     *time.Ticket
+	removedAt   *time.Ticket
+	increasedAt *time.Ticket
 }
 
 // NewCounter creates a new instance of Counter.
@@ -156,6 +157,16 @@ func (p *Counter) SetRemovedAt(removedAt *time.Ticket) {
 	p.removedAt = removedAt
 }
 
+// IncreasedAt returns the time when this counter was increased last.
+func (p *Counter) IncreasedAt() *time.Ticket {
+	return p.increasedAt
+}
+
+// SetIncreasedAt sets the time when this counter was increased last.
+func (p *Counter) SetIncreasedAt(increasedAt *time.Ticket) {
+	p.increasedAt = increasedAt
+}
+
 // Remove removes this element.
 func (p *Counter) Remove(removedAt *time.Ticket) bool {
 	if (removedAt != nil && removedAt.After(p.createdAt)) &&
@@ -215,6 +226,10 @@ func (p *Counter) Increase(v *Primitive) *Counter {
 		}
 	}
 
+	if p.increasedAt == nil || v.CreatedAt().After(p.increasedAt) {
+		p.increasedAt = v.CreatedAt()
+	}
+
 	return p
 }
 
@@ -97,4 +97,20 @@ func TestCounter(t *testing.T) {
 		integer.Increase(operand)
 		assert.Equal(t, integer.ValueType(), json.LongCnt)
 	})
+
+	t.Run("increased at test", func(t *testing.T) {
+		actorID, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		ticket1 := time.NewTicket(1, 0, actorID)
+		ticket2 := time.NewTicket(2, 0, actorID)
+
+		counter := json.NewCounter(0, time.InitialTicket)
+		assert.Nil(t, counter.IncreasedAt())
+		assert.Equal(t, time.InitialTicket, json.ModifiedAt(counter))
+
+		counter.Increase(json.NewPrimitive(1, ticket2))
+		counter.Increase(json.NewPrimitive(1, ticket1))
+		assert.Equal(t, ticket2, counter.IncreasedAt())
+		assert.Equal(t, ticket2, json.ModifiedAt(counter))
+	})
 }
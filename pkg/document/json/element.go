@@ -34,7 +34,8 @@ type Container interface {
 	DeleteByCreatedAt(createdAt *time.Ticket, deletedAt *time.Ticket) Element
 }
 
-// TextElement represents Text or RichText.
+// TextElement represents Text, RichText or Tree, which has garbage nodes
+// inside of it.
 type TextElement interface {
 	Element
 	removedNodesLen() int
@@ -64,3 +65,66 @@ type Element interface {
 	// Remove removes this element.
 	Remove(*time.Ticket) bool
 }
+
+// ModifiedAt returns the time when the given element was modified last. It
+// considers the changes of the element itself and of its direct contents such
+// as insertions and removals of children, but not those of deeper descendants.
+// NOTE: Tombstones purged by garbage collection are no longer considered.
+func ModifiedAt(elem Element) *time.Ticket {
+	modifiedAt := latestTicket(elem.CreatedAt(), elem.MovedAt())
+
+	switch elem := elem.(type) {
+	case *Object:
+		modifiedAt = latestTicket(modifiedAt, elem.clearedAt)
+		for _, node := range elem.memberNodes.Nodes() {
+			modifiedAt = latestTicket(
+				modifiedAt,
+				node.elem.CreatedAt(),
+				node.elem.MovedAt(),
+				node.elem.RemovedAt(),
+			)
+		}
+	case *Array:
+		modifiedAt = latestTicket(modifiedAt, elem.clearedAt)
+		for _, node := range elem.elements.Nodes() {
+			modifiedAt = latestTicket(
+				modifiedAt,
+				node.elem.CreatedAt(),
+				node.elem.MovedAt(),
+				node.elem.RemovedAt(),
+			)
+		}
+	case *Counter:
+		modifiedAt = latestTicket(modifiedAt, elem.increasedAt)
+	case *Text:
+		for _, node := range elem.Nodes() {
+			modifiedAt = latestTicket(modifiedAt, node.id.createdAt, node.removedAt)
+		}
+	case *RichText:
+		for _, node := range elem.Nodes() {
+			modifiedAt = latestTicket(modifiedAt, node.id.createdAt, node.removedAt)
+		}
+	case *Tree:
+		var traverse func(node *TreeNode)
+		traverse = func(node *TreeNode) {
+			modifiedAt = latestTicket(modifiedAt, node.id, node.removedAt)
+			for _, child := range node.children {
+				traverse(child)
+			}
+		}
+		traverse(elem.Root())
+	}
+
+	return modifiedAt
+}
+
+// latestTicket returns the latest one of the given tickets ignoring nil.
+func latestTicket(tickets ...*time.Ticket) *time.Ticket {
+	var latest *time.Ticket
+	for _, ticket := range tickets {
+		if ticket != nil && (latest == nil || ticket.After(latest)) {
+			latest = ticket
+		}
+	}
+	return latest
+}
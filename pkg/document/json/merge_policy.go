@@ -0,0 +1,101 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// MergePolicy is the policy of Object to resolve concurrent writes to the
+// same key.
+//
+// Every value set to a key has a generation which is one more than the
+// generation of the value exposed when it was written. Values of the same
+// generation are written concurrently. With LastWriterWins, the value created
+// last is exposed regardless of the generation. With the other policies, the
+// value of the highest generation is exposed, and the value created first
+// wins among the values of the same generation. As the exposed value is
+// determined only by the values set to the key, replicas converge regardless
+// of the order of writes.
+type MergePolicy string
+
+const (
+	// LastWriterWins exposes the value created last. It is the default.
+	LastWriterWins MergePolicy = "lww"
+
+	// FirstWriterWins exposes the value created first among the values
+	// written concurrently.
+	FirstWriterWins MergePolicy = "fww"
+
+	// RejectConflicts resolves concurrent writes like FirstWriterWins and
+	// records the rejected values as conflicts to surface them to users.
+	RejectConflicts MergePolicy = "reject"
+)
+
+// IsValid returns whether this policy is supported or not. The empty policy
+// is regarded as LastWriterWins.
+func (p MergePolicy) IsValid() bool {
+	switch p {
+	case "", LastWriterWins, FirstWriterWins, RejectConflicts:
+		return true
+	}
+	return false
+}
+
+// firstWriterWins returns whether the value created first wins among the
+// values written concurrently.
+func (p MergePolicy) firstWriterWins() bool {
+	return p == FirstWriterWins || p == RejectConflicts
+}
+
+// ConflictResolveFunc decides which of two values written concurrently to the
+// same key of an Object is exposed. The nodes are given in the order of the
+// creation times of their values, and it returns true if the later one is
+// exposed. It must return the same result on every replica, otherwise the
+// replicas diverge.
+type ConflictResolveFunc func(parentCreatedAt *time.Ticket, earlier, later *RHTPQMapNode) bool
+
+// conflictResolver resolves concurrent writes to Objects in place of the
+// merge policy if it is set.
+var conflictResolver ConflictResolveFunc
+
+// SetConflictResolver sets the function to resolve concurrent writes to
+// Objects in place of the merge policy, or resets it with nil. Values of
+// higher generations are still exposed over the values of lower ones, and the
+// function only decides among the values of the same generation. It should be
+// set before documents are created, as the values of the existing Objects are
+// not reordered.
+func SetConflictResolver(resolve ConflictResolveFunc) {
+	conflictResolver = resolve
+}
+
+// Conflict represents a value rejected by a concurrent write to the same key
+// of an Object.
+type Conflict struct {
+	// ParentCreatedAt is the creation time of the Object.
+	ParentCreatedAt *time.Ticket
+
+	// Key is the key written concurrently.
+	Key string
+
+	// Rejected is the value rejected.
+	Rejected Element
+}
+
+// ConflictObserver is called with the value exposed and the value rejected
+// when concurrent writes to the same key of an Object are resolved.
+type ConflictObserver func(exposed, rejected Element)
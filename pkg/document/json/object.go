@@ -27,10 +27,12 @@ type Object struct {
 	createdAt   *time.Ticket
 	movedAt     *time.Ticket
 	removedAt   *time.Ticket
+	clearedAt   *time.Ticket
 }
 
 // NewObject creates a new instance of Object.
 func NewObject(memberNodes *RHTPriorityQueueMap, createdAt *time.Ticket) *Object {
+	memberNodes.setParent(createdAt)
 	return &Object{
 		memberNodes: memberNodes,
 		createdAt:   createdAt,
@@ -42,9 +44,29 @@ func (o *Object) Purge(elem Element) {
 	o.memberNodes.purge(elem)
 }
 
-// Set sets the given element of the given key.
-func (o *Object) Set(k string, v Element) Element {
-	return o.memberNodes.Set(k, v)
+// Set sets the given element of the given key. It is a write after the
+// element exposed to the given key. It returns the removed elements.
+func (o *Object) Set(k string, v Element) []Element {
+	removed, _ := o.memberNodes.Set(k, v, o.memberNodes.NextGeneration(k))
+	return removed
+}
+
+// SetWithGeneration sets the given element of the given key with the given
+// generation. It returns the removed elements and the element rejected by the
+// merge policy.
+func (o *Object) SetWithGeneration(k string, v Element, generation uint32) ([]Element, Element) {
+	return o.memberNodes.Set(k, v, generation)
+}
+
+// NextGeneration returns the generation of the element to be set to the
+// given key.
+func (o *Object) NextGeneration(k string) uint32 {
+	return o.memberNodes.NextGeneration(k)
+}
+
+// SetMergePolicy sets the policy to resolve concurrent writes to the same key.
+func (o *Object) SetMergePolicy(policy MergePolicy) {
+	o.memberNodes.SetMergePolicy(policy)
 }
 
 // Members returns the member of this object as a map.
@@ -96,13 +118,15 @@ func (o *Object) Marshal() string {
 // DeepCopy copies itself deeply.
 func (o *Object) DeepCopy() Element {
 	members := NewRHTPriorityQueueMap()
+	members.policy = o.memberNodes.policy
 
 	for _, node := range o.memberNodes.Nodes() {
-		members.SetInternal(node.key, node.elem.DeepCopy())
+		members.SetInternal(node.key, node.elem.DeepCopy(), node.generation)
 	}
 
 	obj := NewObject(members, o.createdAt)
 	obj.removedAt = o.removedAt
+	obj.clearedAt = o.clearedAt
 	return obj
 }
 
@@ -141,6 +165,34 @@ func (o *Object) Remove(removedAt *time.Ticket) bool {
 	return false
 }
 
+// ClearedAt returns the time when this object was cleared last.
+func (o *Object) ClearedAt() *time.Ticket {
+	return o.clearedAt
+}
+
+// SetClearedAt sets the time when this object was cleared last.
+func (o *Object) SetClearedAt(clearedAt *time.Ticket) {
+	o.clearedAt = clearedAt
+}
+
+// Clear removes the members created before the given time and returns them.
+// The time is recorded so that the members set later by concurrent changes
+// can be removed as well.
+func (o *Object) Clear(clearedAt *time.Ticket) []Element {
+	if o.clearedAt == nil || clearedAt.After(o.clearedAt) {
+		o.clearedAt = clearedAt
+	}
+
+	var removed []Element
+	for _, node := range o.memberNodes.Nodes() {
+		if elem := o.memberNodes.DeleteByCreatedAt(node.elem.CreatedAt(), clearedAt); elem != nil {
+			removed = append(removed, elem)
+		}
+	}
+
+	return removed
+}
+
 // RHTNodes returns the RHTPriorityQueueMap nodes.
 func (o *Object) RHTNodes() []*RHTPQMapNode {
 	return o.memberNodes.Nodes()
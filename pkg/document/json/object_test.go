@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/test/helper"
 )
 
@@ -39,4 +40,63 @@ func TestObject(t *testing.T) {
 		obj.Delete("k1", ctx.IssueTimeTicket())
 		assert.Equal(t, `{"k2":"v2"}`, obj.Marshal())
 	})
+
+	t.Run("merge policy test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		// NOTE: v1 and v2 are written concurrently on the empty key, and v3 is
+		// written after v1 is exposed.
+		v1 := json.NewPrimitive("v1", ctx.IssueTimeTicket())
+		v2 := json.NewPrimitive("v2", ctx.IssueTimeTicket())
+		v3 := json.NewPrimitive("v3", ctx.IssueTimeTicket())
+
+		for _, tc := range []struct {
+			policy   json.MergePolicy
+			expected string
+		}{
+			{json.LastWriterWins, `{"k":"v2"}`},
+			{json.FirstWriterWins, `{"k":"v1"}`},
+			{json.RejectConflicts, `{"k":"v1"}`},
+		} {
+			obj1 := json.NewObject(json.NewRHTPriorityQueueMap(), time.InitialTicket)
+			obj1.SetMergePolicy(tc.policy)
+			obj1.SetWithGeneration("k", v1.DeepCopy(), 0)
+			obj1.SetWithGeneration("k", v2.DeepCopy(), 0)
+
+			obj2 := json.NewObject(json.NewRHTPriorityQueueMap(), time.InitialTicket)
+			obj2.SetMergePolicy(tc.policy)
+			obj2.SetWithGeneration("k", v2.DeepCopy(), 0)
+			obj2.SetWithGeneration("k", v1.DeepCopy(), 0)
+
+			assert.Equal(t, tc.expected, obj1.Marshal())
+			assert.Equal(t, obj1.Marshal(), obj2.Marshal())
+
+			obj1.SetWithGeneration("k", v3.DeepCopy(), 1)
+			obj2.SetWithGeneration("k", v3.DeepCopy(), 1)
+			assert.Equal(t, `{"k":"v3"}`, obj1.Marshal())
+			assert.Equal(t, obj1.Marshal(), obj2.Marshal())
+		}
+	})
+
+	t.Run("rejected value test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		obj := json.NewObject(json.NewRHTPriorityQueueMap(), time.InitialTicket)
+		obj.SetMergePolicy(json.RejectConflicts)
+
+		v1 := json.NewPrimitive("v1", ctx.IssueTimeTicket())
+		v2 := json.NewPrimitive("v2", ctx.IssueTimeTicket())
+
+		_, rejected := obj.SetWithGeneration("k", v2, 0)
+		assert.Nil(t, rejected)
+		_, rejected = obj.SetWithGeneration("k", v1, 0)
+		assert.Equal(t, v2, rejected)
+
+		v3 := json.NewPrimitive("v3", ctx.IssueTimeTicket())
+		_, rejected = obj.SetWithGeneration("k", v3, 0)
+		assert.Equal(t, v3, rejected)
+		assert.Equal(t, `{"k":"v1"}`, obj.Marshal())
+	})
 }
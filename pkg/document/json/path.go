@@ -0,0 +1,115 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// RootPath is the path of the root object. The path of an element is made by
+// joining the keys of objects and the indexes of arrays from the root with
+// ".", e.g. "$.todos.0.title".
+const RootPath = "$"
+
+var (
+	// ErrInvalidPath is returned when the given path is not a valid path.
+	ErrInvalidPath = errors.New("invalid path")
+
+	// ErrPathTooDeep is returned when the given path is deeper than the
+	// maximum depth.
+	ErrPathTooDeep = errors.New("path too deep")
+)
+
+// ValidatePath validates the given path. The path should not be deeper than
+// the given maximum depth, and zero means no limit.
+func ValidatePath(path string, maxDepth int) error {
+	if path != RootPath && !strings.HasPrefix(path, RootPath+".") {
+		return fmt.Errorf("%s: %w", path, ErrInvalidPath)
+	}
+	if maxDepth > 0 && PathDepth(path) > maxDepth {
+		return fmt.Errorf("%s deeper than %d: %w", path, maxDepth, ErrPathTooDeep)
+	}
+	return nil
+}
+
+// PathDepth returns the number of the keys and indexes of the given path,
+// e.g. 3 for "$.todos.0.title" and 0 for the root path.
+func PathDepth(path string) int {
+	return strings.Count(path, ".")
+}
+
+// JoinPath returns the path of the child of the given key or index.
+func JoinPath(parent string, key string) string {
+	return parent + "." + key
+}
+
+// IsAncestorPath returns whether the given path is the given descendant path
+// itself or its ancestor.
+func IsAncestorPath(path string, descendant string) bool {
+	return path == descendant || strings.HasPrefix(descendant, path+".")
+}
+
+// FindPath returns the path of the element of the given creation time. It
+// returns false if the element is not reachable from the root, e.g. removed.
+func (r *Root) FindPath(createdAt *time.Ticket) (string, bool) {
+	if r.object.CreatedAt().Compare(createdAt) == 0 {
+		return RootPath, true
+	}
+
+	return findPath(r.object, RootPath, createdAt)
+}
+
+// ChildPaths returns the children of the given container by their paths.
+func ChildPaths(container Container, path string) map[string]Element {
+	children := make(map[string]Element)
+	switch container := container.(type) {
+	case *Object:
+		for key, member := range container.Members() {
+			children[JoinPath(path, key)] = member
+		}
+	case *Array:
+		for idx, elem := range container.Elements() {
+			children[JoinPath(path, strconv.Itoa(idx))] = elem
+		}
+	}
+	return children
+}
+
+// findPath finds the path of the element of the given creation time among the
+// descendants of the given element.
+func findPath(elem Element, path string, createdAt *time.Ticket) (string, bool) {
+	container, ok := elem.(Container)
+	if !ok {
+		return "", false
+	}
+
+	for childPath, child := range ChildPaths(container, path) {
+		if child.CreatedAt().Compare(createdAt) == 0 {
+			return childPath, true
+		}
+		if found, ok := findPath(child, childPath, createdAt); ok {
+			return found, true
+		}
+	}
+
+	return "", false
+}
@@ -27,14 +27,29 @@ import (
 
 // RHTPQMapNode is a node of RHTPQMap.
 type RHTPQMapNode struct {
-	key  string
-	elem Element
+	key        string
+	elem       Element
+	generation uint32
+	policy     MergePolicy
+
+	// parentCreatedAt is the creation time of the Object having this node.
+	// It is given to the conflict resolver.
+	parentCreatedAt *time.Ticket
 }
 
-func newRHTPQMapNode(key string, elem Element) *RHTPQMapNode {
+func newRHTPQMapNode(
+	key string,
+	elem Element,
+	generation uint32,
+	policy MergePolicy,
+	parentCreatedAt *time.Ticket,
+) *RHTPQMapNode {
 	return &RHTPQMapNode{
-		key:  key,
-		elem: elem,
+		key:             key,
+		elem:            elem,
+		generation:      generation,
+		policy:          policy,
+		parentCreatedAt: parentCreatedAt,
 	}
 }
 
@@ -44,9 +59,29 @@ func (n *RHTPQMapNode) Remove(removedAt *time.Ticket) bool {
 }
 
 // Less is the implementation of the PriorityQueue Value interface. In RHTPQMap,
-// elements inserted later must be exposed above.
+// elements inserted later must be exposed above. If the merge policy prefers
+// the first writer, elements of the higher generation are exposed above and
+// elements inserted first are exposed above among the same generation. If a
+// conflict resolver is set, it decides among the same generation instead.
 func (n *RHTPQMapNode) Less(other pq.Value) bool {
 	node := other.(*RHTPQMapNode)
+	if conflictResolver != nil {
+		if n.generation != node.generation {
+			return n.generation > node.generation
+		}
+		if n.elem.CreatedAt().After(node.elem.CreatedAt()) {
+			return conflictResolver(n.parentCreatedAt, node, n)
+		}
+		return !conflictResolver(n.parentCreatedAt, n, node)
+	}
+
+	if n.policy.firstWriterWins() {
+		if n.generation != node.generation {
+			return n.generation > node.generation
+		}
+		return node.elem.CreatedAt().After(n.elem.CreatedAt())
+	}
+
 	return n.elem.CreatedAt().After(node.elem.CreatedAt())
 }
 
@@ -64,6 +99,11 @@ func (n *RHTPQMapNode) Element() Element {
 	return n.elem
 }
 
+// Generation returns the generation of this node.
+func (n *RHTPQMapNode) Generation() uint32 {
+	return n.generation
+}
+
 // RHTPriorityQueueMap is a hashtable with logical clock(Replicated hashtable).
 // The difference from RHT is that it keeps multiple values in one key. Using
 // Max Heap, the recently inserted value from the logical clock is returned
@@ -71,6 +111,8 @@ func (n *RHTPQMapNode) Element() Element {
 type RHTPriorityQueueMap struct {
 	nodeQueueMapByKey  map[string]*pq.PriorityQueue[*RHTPQMapNode]
 	nodeMapByCreatedAt map[string]*RHTPQMapNode
+	policy             MergePolicy
+	parentCreatedAt    *time.Ticket
 }
 
 // NewRHTPriorityQueueMap creates a new instance of RHTPriorityQueueMap.
@@ -106,30 +148,116 @@ func (rht *RHTPriorityQueueMap) Has(key string) bool {
 	return node != nil && !node.isRemoved()
 }
 
-// Set sets the value of the given key. If there is an existing value, it is removed.
-func (rht *RHTPriorityQueueMap) Set(k string, v Element) Element {
-	var removed Element
+// MergePolicy returns the merge policy of this map.
+func (rht *RHTPriorityQueueMap) MergePolicy() MergePolicy {
+	return rht.policy
+}
+
+// SetMergePolicy sets the merge policy of this map. The nodes are reordered
+// by the given policy.
+func (rht *RHTPriorityQueueMap) SetMergePolicy(policy MergePolicy) {
+	if rht.policy == policy {
+		return
+	}
+
+	rht.policy = policy
+	for _, node := range rht.nodeMapByCreatedAt {
+		node.policy = policy
+	}
+	rht.reorder()
+}
 
+// setParent sets the creation time of the Object having this map. The nodes
+// are reordered if a conflict resolver is set, as it is given the time.
+func (rht *RHTPriorityQueueMap) setParent(parentCreatedAt *time.Ticket) {
+	rht.parentCreatedAt = parentCreatedAt
+	for _, node := range rht.nodeMapByCreatedAt {
+		node.parentCreatedAt = parentCreatedAt
+	}
+	if conflictResolver != nil {
+		rht.reorder()
+	}
+}
+
+// reorder rebuilds the queues of this map after the order of nodes changes.
+func (rht *RHTPriorityQueueMap) reorder() {
+	for k, queue := range rht.nodeQueueMapByKey {
+		reordered := pq.NewPriorityQueue[*RHTPQMapNode]()
+		for _, node := range queue.Values() {
+			reordered.Push(node)
+		}
+		rht.nodeQueueMapByKey[k] = reordered
+	}
+}
+
+// NextGeneration returns the generation of the value to be set to the given
+// key. It is one more than the generation of the exposed value.
+func (rht *RHTPriorityQueueMap) NextGeneration(k string) uint32 {
+	queue, ok := rht.nodeQueueMapByKey[k]
+	if !ok || queue.Len() == 0 {
+		return 0
+	}
+
+	return queue.Peek().generation + 1
+}
+
+// Set sets the value of the given key with the given generation. The values
+// hidden by the exposed value are removed at the creation time of the latest
+// value of the key, and the values newly removed are returned. It also returns
+// the value rejected by the merge policy if the value is written concurrently
+// with the value exposed before.
+func (rht *RHTPriorityQueueMap) Set(
+	k string,
+	v Element,
+	generation uint32,
+) (removed []Element, rejected Element) {
+	var prev *RHTPQMapNode
 	if queue, ok := rht.nodeQueueMapByKey[k]; ok && queue.Len() > 0 {
-		node := queue.Peek()
-		if !node.isRemoved() && node.Remove(v.CreatedAt()) {
-			removed = node.elem
+		prev = queue.Peek()
+	}
+
+	node := rht.SetInternal(k, v, generation)
+	if prev == nil {
+		return nil, nil
+	}
+
+	// NOTE: The hidden values are removed at the creation time of the latest
+	// value regardless of the order in which concurrent values arrive, so that
+	// the replicas have the same tombstones after they converge.
+	queue := rht.nodeQueueMapByKey[k]
+	head := queue.Peek()
+	values := queue.Values()
+	latest := v.CreatedAt()
+	for _, value := range values {
+		if value.elem.CreatedAt().After(latest) {
+			latest = value.elem.CreatedAt()
+		}
+	}
+	for _, value := range values {
+		if value != head && value.Remove(latest) {
+			removed = append(removed, value.elem)
 		}
 	}
 
-	rht.SetInternal(k, v)
-	return removed
+	if head != node {
+		return removed, v
+	}
+	if prev.generation >= generation {
+		rejected = prev.elem
+	}
+	return removed, rejected
 }
 
-// SetInternal sets the value of the given key.
-func (rht *RHTPriorityQueueMap) SetInternal(k string, v Element) {
+// SetInternal sets the value of the given key with the given generation.
+func (rht *RHTPriorityQueueMap) SetInternal(k string, v Element, generation uint32) *RHTPQMapNode {
 	if _, ok := rht.nodeQueueMapByKey[k]; !ok {
 		rht.nodeQueueMapByKey[k] = pq.NewPriorityQueue[*RHTPQMapNode]()
 	}
 
-	node := newRHTPQMapNode(k, v)
+	node := newRHTPQMapNode(k, v, generation, rht.policy, rht.parentCreatedAt)
 	rht.nodeQueueMapByKey[k].Push(node)
 	rht.nodeMapByCreatedAt[v.CreatedAt().Key()] = node
+	return node
 }
 
 // Delete deletes the Element of the given key.
@@ -177,14 +305,24 @@ func (rht *RHTPriorityQueueMap) Elements() map[string]Element {
 	return members
 }
 
-// Nodes returns a map of elements because the map easy to use for loop.
+// Nodes returns the nodes of this map sorted by the key and the creation time.
 // TODO: If we encounter performance issues, we need to replace this with other solution.
 func (rht *RHTPriorityQueueMap) Nodes() []*RHTPQMapNode {
+	keys := make([]string, 0, len(rht.nodeQueueMapByKey))
+	for k := range rht.nodeQueueMapByKey {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	// NOTE: Nodes are sorted so that the converged replicas produce the same
+	// snapshot bytes regardless of the order of the applied operations.
 	var nodes []*RHTPQMapNode
-	for _, queue := range rht.nodeQueueMapByKey {
-		for _, value := range queue.Values() {
-			nodes = append(nodes, value)
-		}
+	for _, k := range keys {
+		values := rht.nodeQueueMapByKey[k].Values()
+		sort.Slice(values, func(i, j int) bool {
+			return values[j].elem.CreatedAt().After(values[i].elem.CreatedAt())
+		})
+		nodes = append(nodes, values...)
 	}
 
 	return nodes
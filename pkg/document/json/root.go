@@ -37,6 +37,14 @@ type Root struct {
 	elementMapByCreatedAt                map[string]Element
 	removedElementPairMapByCreatedAt     map[string]ElementPair
 	textElementWithGarbageMapByCreatedAt map[string]TextElement
+
+	mergePolicy      MergePolicy
+	conflicts        []Conflict
+	conflictObserver ConflictObserver
+
+	// stringPool is the pool of the string values of Primitives. It is nil if
+	// string interning is disabled.
+	stringPool *stringPool
 }
 
 // NewRoot creates a new instance of Root.
@@ -74,12 +82,127 @@ func (r *Root) FindByCreatedAt(createdAt *time.Ticket) Element {
 
 // RegisterElement registers the given element to hash table.
 func (r *Root) RegisterElement(elem Element) {
-	r.elementMapByCreatedAt[elem.CreatedAt().Key()] = elem
+	createdAt := elem.CreatedAt().Key()
+	if _, ok := r.elementMapByCreatedAt[createdAt]; !ok {
+		r.internString(elem)
+	}
+
+	r.elementMapByCreatedAt[createdAt] = elem
+	if obj, ok := elem.(*Object); ok {
+		obj.SetMergePolicy(r.mergePolicy)
+	}
+}
+
+// MergePolicy returns the policy of Objects to resolve concurrent writes.
+func (r *Root) MergePolicy() MergePolicy {
+	return r.mergePolicy
+}
+
+// SetMergePolicy sets the policy of Objects to resolve concurrent writes. It
+// is applied to all the Objects in this root.
+func (r *Root) SetMergePolicy(policy MergePolicy) {
+	r.mergePolicy = policy
+	for _, elem := range r.elementMapByCreatedAt {
+		if obj, ok := elem.(*Object); ok {
+			obj.SetMergePolicy(policy)
+		}
+	}
+}
+
+// SetConflictObserver sets the observer of the concurrent writes resolved in
+// this root regardless of the merge policy. The observer is unset if it is
+// nil.
+func (r *Root) SetConflictObserver(observer ConflictObserver) {
+	r.conflictObserver = observer
+}
+
+// ConflictObserver returns the observer of the concurrent writes resolved in
+// this root.
+func (r *Root) ConflictObserver() ConflictObserver {
+	return r.conflictObserver
+}
+
+// StringInterning returns whether the identical string values of Primitives in
+// this root share the same backing storage.
+func (r *Root) StringInterning() bool {
+	return r.stringPool != nil
+}
+
+// SetStringInterning sets whether the identical string values of Primitives
+// in this root share the same backing storage. It reduces the memory of the
+// documents with many repeated values, at the cost of a lookup on every
+// registration of an element.
+func (r *Root) SetStringInterning(enabled bool) {
+	if !enabled {
+		r.stringPool = nil
+		return
+	}
+	if r.stringPool != nil {
+		return
+	}
+
+	r.stringPool = newStringPool()
+	for _, elem := range r.elementMapByCreatedAt {
+		r.internString(elem)
+	}
+}
+
+// InternedStringLen returns the number of the distinct strings shared by the
+// Primitives in this root.
+func (r *Root) InternedStringLen() int {
+	if r.stringPool == nil {
+		return 0
+	}
+	return r.stringPool.len()
+}
+
+// internString replaces the string value of the given Primitive with the one
+// in the pool.
+func (r *Root) internString(elem Element) {
+	if r.stringPool == nil {
+		return
+	}
+
+	if p, ok := elem.(*Primitive); ok && p.valueType == String {
+		p.value = r.stringPool.intern(p.value.(string))
+	}
+}
+
+// RegisterConflict registers the value rejected by a concurrent write. It is
+// only registered with RejectConflicts policy, but the observer is notified
+// of every conflict.
+func (r *Root) RegisterConflict(parent *Object, key string, exposed, rejected Element) {
+	if r.conflictObserver != nil && exposed != nil {
+		r.conflictObserver(exposed, rejected)
+	}
+
+	if r.mergePolicy != RejectConflicts {
+		return
+	}
+
+	r.conflicts = append(r.conflicts, Conflict{
+		ParentCreatedAt: parent.CreatedAt(),
+		Key:             key,
+		Rejected:        rejected,
+	})
+}
+
+// TakeConflicts returns the conflicts registered so far and clears them.
+func (r *Root) TakeConflicts() []Conflict {
+	conflicts := r.conflicts
+	r.conflicts = nil
+	return conflicts
 }
 
 // DeregisterElement deregister the given element from hash tables.
 func (r *Root) DeregisterElement(elem Element) {
 	createdAt := elem.CreatedAt().Key()
+	if _, ok := r.elementMapByCreatedAt[createdAt]; ok && r.stringPool != nil {
+		if p, ok := elem.(*Primitive); ok && p.valueType == String {
+			r.stringPool.release(p.value.(string))
+		}
+	}
+
 	delete(r.elementMapByCreatedAt, createdAt)
 	delete(r.removedElementPairMapByCreatedAt, createdAt)
 }
@@ -99,7 +222,10 @@ func (r *Root) RegisterTextElementWithGarbage(textType TextElement) {
 
 // DeepCopy copies itself deeply.
 func (r *Root) DeepCopy() *Root {
-	return NewRoot(r.object.DeepCopy().(*Object))
+	root := NewRoot(r.object.DeepCopy().(*Object))
+	root.SetMergePolicy(r.mergePolicy)
+	root.SetStringInterning(r.StringInterning())
+	return root
 }
 
 // GarbageCollect purge elements that were removed before the given time.
@@ -190,4 +190,50 @@ func TestRoot(t *testing.T) {
 		assert.Equal(t, 1, root.GarbageCollect(time.MaxTicket))
 		assert.Equal(t, 0, root.GarbageLen())
 	})
+
+	t.Run("find path test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		obj := json.NewObject(json.NewRHTPriorityQueueMap(), ctx.IssueTimeTicket())
+		array := json.NewArray(json.NewRGATreeList(), ctx.IssueTimeTicket())
+		first := json.NewPrimitive(0, ctx.IssueTimeTicket())
+		second := json.NewPrimitive(1, ctx.IssueTimeTicket())
+		array.Add(first).Add(second)
+		obj.Set("k2", array)
+		root.Object().Set("k1", obj)
+
+		path, ok := root.FindPath(root.Object().CreatedAt())
+		assert.True(t, ok)
+		assert.Equal(t, json.RootPath, path)
+		path, ok = root.FindPath(array.CreatedAt())
+		assert.True(t, ok)
+		assert.Equal(t, "$.k1.k2", path)
+		path, ok = root.FindPath(second.CreatedAt())
+		assert.True(t, ok)
+		assert.Equal(t, "$.k1.k2.1", path)
+
+		array.DeleteByCreatedAt(first.CreatedAt(), ctx.IssueTimeTicket())
+		_, ok = root.FindPath(first.CreatedAt())
+		assert.False(t, ok)
+		path, ok = root.FindPath(second.CreatedAt())
+		assert.True(t, ok)
+		assert.Equal(t, "$.k1.k2.0", path)
+
+		assert.True(t, json.IsAncestorPath("$.k1", "$.k1.k2"))
+		assert.True(t, json.IsAncestorPath("$.k1", "$.k1"))
+		assert.False(t, json.IsAncestorPath("$.k1", "$.k10"))
+		assert.NoError(t, json.ValidatePath("$.k1", 0))
+		assert.ErrorIs(t, json.ValidatePath("k1", 0), json.ErrInvalidPath)
+	})
+
+	t.Run("path depth test", func(t *testing.T) {
+		assert.Equal(t, 0, json.PathDepth(json.RootPath))
+		assert.Equal(t, 3, json.PathDepth("$.todos.0.title"))
+
+		assert.NoError(t, json.ValidatePath("$.todos.0.title", 3))
+		assert.ErrorIs(t, json.ValidatePath("$.todos.0.title", 2), json.ErrPathTooDeep)
+		assert.NoError(t, json.ValidatePath("$.todos.0.title", 0))
+		assert.NoError(t, json.ValidatePath(json.RootPath, 1))
+	})
 }
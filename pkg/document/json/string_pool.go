@@ -0,0 +1,69 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+// stringPool is a pool of the string values of Primitives. It lets the
+// identical strings share the same backing storage. Each string is counted by
+// the elements referring to it and released when no element refers to it, so
+// the storage of the string can be collected independently of the others.
+type stringPool struct {
+	entries map[string]*stringEntry
+}
+
+// stringEntry is an entry of stringPool.
+type stringEntry struct {
+	value string
+	refs  int
+}
+
+// newStringPool creates a new instance of stringPool.
+func newStringPool() *stringPool {
+	return &stringPool{
+		entries: make(map[string]*stringEntry),
+	}
+}
+
+// intern returns the string of the pool identical to the given string. If
+// there is no such string, the given string is added to the pool.
+func (p *stringPool) intern(s string) string {
+	entry, ok := p.entries[s]
+	if !ok {
+		entry = &stringEntry{value: s}
+		p.entries[s] = entry
+	}
+	entry.refs++
+	return entry.value
+}
+
+// release releases a reference of the given string, and removes the string
+// from the pool if it is no longer referred to.
+func (p *stringPool) release(s string) {
+	entry, ok := p.entries[s]
+	if !ok {
+		return
+	}
+
+	entry.refs--
+	if entry.refs <= 0 {
+		delete(p.entries, s)
+	}
+}
+
+// len returns the number of the distinct strings in the pool.
+func (p *stringPool) len() int {
+	return len(p.entries)
+}
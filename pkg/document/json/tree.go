@@ -0,0 +1,620 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+const (
+	// DefaultRootNodeType is the type of the root node of Tree.
+	DefaultRootNodeType = "root"
+
+	// DefaultTextNodeType is the type of the text node of Tree.
+	DefaultTextNodeType = "text"
+)
+
+var (
+	// ErrTreeNodeNotFound is returned when the node of the given position
+	// does not exist in the tree.
+	ErrTreeNodeNotFound = errors.New("tree node not found")
+
+	// ErrInvalidTreeRange is returned when the given range does not consist of
+	// siblings of the same parent.
+	ErrInvalidTreeRange = errors.New("invalid tree range")
+)
+
+// TreePos represents the position in the Tree. It is expressed as the
+// parent node and the left sibling node that the position follows. If the
+// left sibling is the parent itself, the position is the beginning of the
+// parent.
+type TreePos struct {
+	parentCreatedAt      *time.Ticket
+	leftSiblingCreatedAt *time.Ticket
+}
+
+// NewTreePos creates a new instance of TreePos.
+func NewTreePos(parentCreatedAt, leftSiblingCreatedAt *time.Ticket) *TreePos {
+	return &TreePos{
+		parentCreatedAt:      parentCreatedAt,
+		leftSiblingCreatedAt: leftSiblingCreatedAt,
+	}
+}
+
+// ParentCreatedAt returns the creation time of the parent node.
+func (p *TreePos) ParentCreatedAt() *time.Ticket {
+	return p.parentCreatedAt
+}
+
+// LeftSiblingCreatedAt returns the creation time of the left sibling node.
+func (p *TreePos) LeftSiblingCreatedAt() *time.Ticket {
+	return p.leftSiblingCreatedAt
+}
+
+// Equal returns whether the given pos equals to this pos or not.
+func (p *TreePos) Equal(other *TreePos) bool {
+	return p.parentCreatedAt.Compare(other.parentCreatedAt) == 0 &&
+		p.leftSiblingCreatedAt.Compare(other.leftSiblingCreatedAt) == 0
+}
+
+// AnnotatedString returns a String containing the metadata of the position
+// for debugging purpose.
+func (p *TreePos) AnnotatedString() string {
+	return fmt.Sprintf(
+		"%s:%s",
+		p.parentCreatedAt.AnnotatedString(),
+		p.leftSiblingCreatedAt.AnnotatedString(),
+	)
+}
+
+// TreeNode is a node of Tree. An element node has a type like "p",
+// attributes and children, and a text node has the value.
+type TreeNode struct {
+	id        *time.Ticket
+	nodeType  string
+	value     string
+	attrs     *RHT
+	removedAt *time.Ticket
+
+	parent *TreeNode
+
+	// children holds the child nodes including tombstones in RGA order.
+	children []*TreeNode
+}
+
+// NewTreeNode creates a new instance of TreeNode.
+func NewTreeNode(id *time.Ticket, nodeType string, value string) *TreeNode {
+	return &TreeNode{
+		id:       id,
+		nodeType: nodeType,
+		value:    value,
+		attrs:    NewRHT(),
+	}
+}
+
+// ID returns the creation time of this node.
+func (n *TreeNode) ID() *time.Ticket {
+	return n.id
+}
+
+// Type returns the type of this node.
+func (n *TreeNode) Type() string {
+	return n.nodeType
+}
+
+// Value returns the value of this node.
+func (n *TreeNode) Value() string {
+	return n.value
+}
+
+// Attrs returns the attributes of this node.
+func (n *TreeNode) Attrs() *RHT {
+	return n.attrs
+}
+
+// IsText returns whether this node is a text node or not.
+func (n *TreeNode) IsText() bool {
+	return n.nodeType == DefaultTextNodeType
+}
+
+// RemovedAt returns the removal time of this node.
+func (n *TreeNode) RemovedAt() *time.Ticket {
+	return n.removedAt
+}
+
+// Children returns the children of this node including tombstones.
+func (n *TreeNode) Children() []*TreeNode {
+	return n.children
+}
+
+// Append appends the given nodes to the children of this node. It is used to
+// build a node that is not yet inserted into a tree.
+func (n *TreeNode) Append(children ...*TreeNode) {
+	for _, child := range children {
+		child.parent = n
+		n.children = append(n.children, child)
+	}
+}
+
+// SetRemovedAt sets the removal time of this node.
+func (n *TreeNode) SetRemovedAt(removedAt *time.Ticket) {
+	n.removedAt = removedAt
+}
+
+// Remove removes this node if it was created before the time of deletion.
+// It only marks the deleted time (tombstone) and the descendants are
+// regarded as removed together.
+func (n *TreeNode) Remove(removedAt *time.Ticket, latestCreatedAt *time.Ticket) bool {
+	if !n.id.After(latestCreatedAt) &&
+		(n.removedAt == nil || removedAt.After(n.removedAt)) {
+		n.removedAt = removedAt
+		return true
+	}
+	return false
+}
+
+// DeepCopy copies itself deeply.
+func (n *TreeNode) DeepCopy() *TreeNode {
+	clone := &TreeNode{
+		id:        n.id,
+		nodeType:  n.nodeType,
+		value:     n.value,
+		attrs:     n.attrs.DeepCopy(),
+		removedAt: n.removedAt,
+	}
+	for _, child := range n.children {
+		clone.Append(child.DeepCopy())
+	}
+	return clone
+}
+
+// Marshal returns the JSON encoding of this node.
+func (n *TreeNode) Marshal() string {
+	if n.IsText() {
+		return fmt.Sprintf(`{"type":"%s","value":"%s"}`, n.nodeType, EscapeString(n.value))
+	}
+
+	var children []string
+	for _, child := range n.children {
+		if child.removedAt == nil {
+			children = append(children, child.Marshal())
+		}
+	}
+
+	if len(n.attrs.Elements()) == 0 {
+		return fmt.Sprintf(
+			`{"type":"%s","children":[%s]}`,
+			EscapeString(n.nodeType),
+			strings.Join(children, ","),
+		)
+	}
+
+	return fmt.Sprintf(
+		`{"type":"%s","attributes":%s,"children":[%s]}`,
+		EscapeString(n.nodeType),
+		n.attrs.Marshal(),
+		strings.Join(children, ","),
+	)
+}
+
+// XML returns the XML encoding of this node.
+func (n *TreeNode) XML() string {
+	if n.IsText() {
+		return n.value
+	}
+
+	attrs := n.attrs.Elements()
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	sb := strings.Builder{}
+	sb.WriteString("<" + n.nodeType)
+	for _, k := range keys {
+		sb.WriteString(fmt.Sprintf(` %s="%s"`, k, attrs[k]))
+	}
+	sb.WriteString(">")
+	for _, child := range n.children {
+		if child.removedAt == nil {
+			sb.WriteString(child.XML())
+		}
+	}
+	sb.WriteString("</" + n.nodeType + ">")
+	return sb.String()
+}
+
+// liveChildren returns the children of this node except tombstones.
+func (n *TreeNode) liveChildren() []*TreeNode {
+	var children []*TreeNode
+	for _, child := range n.children {
+		if child.removedAt == nil {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// indexOf returns the index of the given child in children. It returns -1 if
+// the given ID is the ID of this node, which means the beginning.
+func (n *TreeNode) indexOf(id *time.Ticket) (int, error) {
+	if id.Compare(n.id) == 0 {
+		return -1, nil
+	}
+
+	for i, child := range n.children {
+		if child.id.Compare(id) == 0 {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%s: %w", id.AnnotatedString(), ErrTreeNodeNotFound)
+}
+
+// traverse calls the given callback for this node and its descendants in
+// pre-order.
+func (n *TreeNode) traverse(callback func(node *TreeNode, depth int), depth int) {
+	callback(n, depth)
+	for _, child := range n.children {
+		child.traverse(callback, depth+1)
+	}
+}
+
+// Tree is a CRDT for XML/HTML-style hierarchical documents. Each node keeps
+// its children in RGA order, so concurrent insertions at the same position are
+// ordered deterministically and a node is never split by an edit, which
+// preserves the well-formedness of the tree.
+type Tree struct {
+	root               *TreeNode
+	nodeMapByCreatedAt map[string]*TreeNode
+
+	// removedNodeMap is a map that holds tombstone nodes
+	// when the edit operation is executed.
+	removedNodeMap map[string]*TreeNode
+
+	createdAt *time.Ticket
+	movedAt   *time.Ticket
+	removedAt *time.Ticket
+}
+
+// NewTree creates a new instance of Tree.
+func NewTree(root *TreeNode, createdAt *time.Ticket) *Tree {
+	t := &Tree{
+		root:               root,
+		nodeMapByCreatedAt: make(map[string]*TreeNode),
+		removedNodeMap:     make(map[string]*TreeNode),
+		createdAt:          createdAt,
+	}
+
+	root.traverse(func(node *TreeNode, depth int) {
+		t.nodeMapByCreatedAt[node.id.Key()] = node
+		if node.removedAt != nil {
+			t.removedNodeMap[node.id.Key()] = node
+		}
+	}, 0)
+
+	return t
+}
+
+// NewInitialTree creates a new instance of Tree that only has the root node.
+func NewInitialTree(createdAt *time.Ticket) *Tree {
+	return NewTree(NewTreeNode(createdAt, DefaultRootNodeType, ""), createdAt)
+}
+
+// Root returns the root node of this tree.
+func (t *Tree) Root() *TreeNode {
+	return t.root
+}
+
+// Marshal returns the JSON encoding of this tree.
+func (t *Tree) Marshal() string {
+	return t.root.Marshal()
+}
+
+// XML returns the XML encoding of this tree.
+func (t *Tree) XML() string {
+	return t.root.XML()
+}
+
+// DeepCopy copies itself deeply.
+func (t *Tree) DeepCopy() Element {
+	tree := NewTree(t.root.DeepCopy(), t.createdAt)
+	tree.movedAt = t.movedAt
+	tree.removedAt = t.removedAt
+	return tree
+}
+
+// CreatedAt returns the creation time of this Tree.
+func (t *Tree) CreatedAt() *time.Ticket {
+	return t.createdAt
+}
+
+// RemovedAt returns the removal time of this Tree.
+func (t *Tree) RemovedAt() *time.Ticket {
+	return t.removedAt
+}
+
+// MovedAt returns the move time of this Tree.
+func (t *Tree) MovedAt() *time.Ticket {
+	return t.movedAt
+}
+
+// SetMovedAt sets the move time of this Tree.
+func (t *Tree) SetMovedAt(movedAt *time.Ticket) {
+	t.movedAt = movedAt
+}
+
+// SetRemovedAt sets the removal time of this Tree.
+func (t *Tree) SetRemovedAt(removedAt *time.Ticket) {
+	t.removedAt = removedAt
+}
+
+// Remove removes this Tree.
+func (t *Tree) Remove(removedAt *time.Ticket) bool {
+	if (removedAt != nil && removedAt.After(t.createdAt)) &&
+		(t.removedAt == nil || removedAt.After(t.removedAt)) {
+		t.removedAt = removedAt
+		return true
+	}
+	return false
+}
+
+// FindNode returns the node of the given creation time.
+func (t *Tree) FindNode(createdAt *time.Ticket) *TreeNode {
+	return t.nodeMapByCreatedAt[createdAt.Key()]
+}
+
+// FindByPath returns the element node of the given path. The path is a
+// list of indexes of the live children from the root.
+func (t *Tree) FindByPath(path []int) (*TreeNode, error) {
+	node := t.root
+	for _, idx := range path {
+		children := node.liveChildren()
+		if idx < 0 || idx >= len(children) {
+			return nil, fmt.Errorf("path %v: %w", path, ErrTreeNodeNotFound)
+		}
+		node = children[idx]
+	}
+
+	if node.IsText() {
+		return nil, fmt.Errorf("path %v: %w", path, ErrInvalidTreeRange)
+	}
+
+	return node, nil
+}
+
+// CreateRange returns a pair of TreePos of the given integer offsets in the
+// children of the node of the given path.
+func (t *Tree) CreateRange(path []int, from, to int) (*TreePos, *TreePos, error) {
+	if from > to {
+		return nil, nil, fmt.Errorf("from(%d) > to(%d): %w", from, to, ErrInvalidTreeRange)
+	}
+
+	parent, err := t.FindByPath(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	children := parent.liveChildren()
+	if to > len(children) || from < 0 {
+		return nil, nil, fmt.Errorf("%d-%d: %w", from, to, ErrInvalidTreeRange)
+	}
+
+	toPos := func(index int) *TreePos {
+		if index == 0 {
+			return NewTreePos(parent.id, parent.id)
+		}
+		return NewTreePos(parent.id, children[index-1].id)
+	}
+
+	return toPos(from), toPos(to), nil
+}
+
+// Edit edits the given range with the given contents. The nodes between
+// `from` and `to` are removed and the given contents are inserted at `from`.
+// It returns the map that stores the latest creation time by actor for the
+// removed nodes.
+func (t *Tree) Edit(
+	from,
+	to *TreePos,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+	contents []*TreeNode,
+	executedAt *time.Ticket,
+) (map[string]*time.Ticket, error) {
+	// 01. Find the parent and the boundaries of the range.
+	parent, fromIdx, toIdx, err := t.findRange(from, to, executedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// 02. Remove the nodes between from and to.
+	createdAtMapByActor := make(map[string]*time.Ticket)
+	for _, node := range parent.children[fromIdx+1 : toIdx+1] {
+		actorIDHex := node.id.ActorIDHex()
+		latestCreatedAt := latestCreatedAtOf(latestCreatedAtMapByActor, actorIDHex)
+		if node.Remove(executedAt, latestCreatedAt) {
+			latestCreatedAt := createdAtMapByActor[actorIDHex]
+			if latestCreatedAt == nil || node.id.After(latestCreatedAt) {
+				createdAtMapByActor[actorIDHex] = node.id
+			}
+			t.removedNodeMap[node.id.Key()] = node
+		}
+	}
+
+	// 03. Insert the given contents at from.
+	if len(contents) > 0 {
+		var inserted []*TreeNode
+		for _, content := range contents {
+			node := content.DeepCopy()
+			node.parent = parent
+			node.traverse(func(node *TreeNode, depth int) {
+				t.nodeMapByCreatedAt[node.id.Key()] = node
+			}, 0)
+			inserted = append(inserted, node)
+		}
+
+		children := make([]*TreeNode, 0, len(parent.children)+len(inserted))
+		children = append(children, parent.children[:fromIdx+1]...)
+		children = append(children, inserted...)
+		children = append(children, parent.children[fromIdx+1:]...)
+		parent.children = children
+	}
+
+	return createdAtMapByActor, nil
+}
+
+// Style applies the given attributes to the nodes between `from` and `to`.
+// Text nodes, removed nodes and nodes inserted concurrently are not affected.
+// It returns the map that stores the latest creation time by actor for the
+// styled nodes.
+func (t *Tree) Style(
+	from,
+	to *TreePos,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+	attributes map[string]string,
+	executedAt *time.Ticket,
+) (map[string]*time.Ticket, error) {
+	parent, fromIdx, toIdx, err := t.findRange(from, to, executedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAtMapByActor := make(map[string]*time.Ticket)
+	for _, node := range parent.children[fromIdx+1 : toIdx+1] {
+		actorIDHex := node.id.ActorIDHex()
+		latestCreatedAt := latestCreatedAtOf(latestCreatedAtMapByActor, actorIDHex)
+		if node.removedAt != nil || node.IsText() || node.id.After(latestCreatedAt) {
+			continue
+		}
+
+		for key, value := range attributes {
+			node.attrs.Set(key, value, executedAt)
+		}
+
+		latestCreatedAt = createdAtMapByActor[actorIDHex]
+		if latestCreatedAt == nil || node.id.After(latestCreatedAt) {
+			createdAtMapByActor[actorIDHex] = node.id
+		}
+	}
+
+	return createdAtMapByActor, nil
+}
+
+// latestCreatedAtOf returns the latest creation time of the given actor in
+// the given map. If the map is nil, which means the operation is executed
+// locally, all nodes are regarded as visible.
+func latestCreatedAtOf(latestCreatedAtMapByActor map[string]*time.Ticket, actorIDHex string) *time.Ticket {
+	if latestCreatedAtMapByActor == nil {
+		return time.MaxTicket
+	}
+
+	if createdAt, ok := latestCreatedAtMapByActor[actorIDHex]; ok {
+		return createdAt
+	}
+	return time.InitialTicket
+}
+
+// findRange returns the parent node and the indexes of the children of the
+// given range.
+func (t *Tree) findRange(
+	from,
+	to *TreePos,
+	executedAt *time.Ticket,
+) (*TreeNode, int, int, error) {
+	if from.parentCreatedAt.Compare(to.parentCreatedAt) != 0 {
+		return nil, 0, 0, fmt.Errorf("%s, %s: %w", from.AnnotatedString(), to.AnnotatedString(), ErrInvalidTreeRange)
+	}
+	parent := t.FindNode(from.parentCreatedAt)
+	if parent == nil || parent.IsText() {
+		return nil, 0, 0, fmt.Errorf("%s: %w", from.parentCreatedAt.AnnotatedString(), ErrTreeNodeNotFound)
+	}
+
+	fromIdx, err := t.findInsertionIndex(parent, from.leftSiblingCreatedAt, executedAt)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	toIdx, err := t.findInsertionIndex(parent, to.leftSiblingCreatedAt, executedAt)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if fromIdx > toIdx {
+		return nil, 0, 0, fmt.Errorf("%s, %s: %w", from.AnnotatedString(), to.AnnotatedString(), ErrInvalidTreeRange)
+	}
+
+	return parent, fromIdx, toIdx, nil
+}
+
+// findInsertionIndex returns the index of the child after which the node
+// created at the given time is placed. Like RGA, it skips the nodes that were
+// inserted concurrently later than the given time.
+func (t *Tree) findInsertionIndex(
+	parent *TreeNode,
+	leftSiblingCreatedAt *time.Ticket,
+	executedAt *time.Ticket,
+) (int, error) {
+	idx, err := parent.indexOf(leftSiblingCreatedAt)
+	if err != nil {
+		return 0, err
+	}
+
+	for idx+1 < len(parent.children) && parent.children[idx+1].id.After(executedAt) {
+		idx++
+	}
+
+	return idx, nil
+}
+
+// removedNodesLen returns length of removed nodes
+func (t *Tree) removedNodesLen() int {
+	return len(t.removedNodeMap)
+}
+
+// purgeTextNodesWithGarbage physically purges nodes that have been removed.
+func (t *Tree) purgeTextNodesWithGarbage(ticket *time.Ticket) int {
+	count := 0
+	for key, node := range t.removedNodeMap {
+		if node.removedAt == nil || ticket.Compare(node.removedAt) < 0 {
+			continue
+		}
+
+		if node.parent != nil {
+			for i, child := range node.parent.children {
+				if child == node {
+					node.parent.children = append(node.parent.children[:i], node.parent.children[i+1:]...)
+					break
+				}
+			}
+		}
+
+		node.traverse(func(node *TreeNode, depth int) {
+			delete(t.nodeMapByCreatedAt, node.id.Key())
+			if node.removedAt != nil {
+				delete(t.removedNodeMap, node.id.Key())
+			}
+		}, 0)
+		delete(t.removedNodeMap, key)
+		count++
+	}
+
+	return count
+}
@@ -0,0 +1,239 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestTree(t *testing.T) {
+	t.Run("marshal test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		tree := json.NewInitialTree(ctx.IssueTimeTicket())
+		assert.Equal(t, `{"type":"root","children":[]}`, tree.Marshal())
+
+		p := json.NewTreeNode(ctx.IssueTimeTicket(), "p", "")
+		p.Append(json.NewTreeNode(ctx.IssueTimeTicket(), json.DefaultTextNodeType, "hello"))
+		fromPos, toPos, err := tree.CreateRange(nil, 0, 0)
+		assert.NoError(t, err)
+		_, err = tree.Edit(fromPos, toPos, nil, []*json.TreeNode{p}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.Equal(
+			t,
+			`{"type":"root","children":[{"type":"p","children":[{"type":"text","value":"hello"}]}]}`,
+			tree.Marshal(),
+		)
+		assert.Equal(t, `<root><p>hello</p></root>`, tree.XML())
+
+		fromPos, toPos, err = tree.CreateRange([]int{0}, 0, 1)
+		assert.NoError(t, err)
+		_, err = tree.Edit(fromPos, toPos, nil, []*json.TreeNode{
+			json.NewTreeNode(ctx.IssueTimeTicket(), json.DefaultTextNodeType, "world"),
+		}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.Equal(t, `<root><p>world</p></root>`, tree.XML())
+
+		_, _, err = tree.CreateRange([]int{0, 0}, 0, 0)
+		assert.ErrorIs(t, err, json.ErrInvalidTreeRange)
+		_, _, err = tree.CreateRange([]int{1}, 0, 0)
+		assert.ErrorIs(t, err, json.ErrTreeNodeNotFound)
+	})
+
+	t.Run("concurrent insertion test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		tree1 := json.NewInitialTree(ctx.IssueTimeTicket())
+		tree2 := tree1.DeepCopy().(*json.Tree)
+
+		// 01. Insert nodes at the same position of each replica concurrently.
+		fromPos, toPos, err := tree1.CreateRange(nil, 0, 0)
+		assert.NoError(t, err)
+		edit1 := ctx.IssueTimeTicket()
+		nodes1 := []*json.TreeNode{json.NewTreeNode(ctx.IssueTimeTicket(), "p", "")}
+		edit2 := ctx.IssueTimeTicket()
+		nodes2 := []*json.TreeNode{json.NewTreeNode(ctx.IssueTimeTicket(), "h1", "")}
+
+		// 02. Apply the edits in different orders.
+		_, err = tree1.Edit(fromPos, toPos, nil, nodes1, edit1)
+		assert.NoError(t, err)
+		_, err = tree1.Edit(fromPos, toPos, nil, nodes2, edit2)
+		assert.NoError(t, err)
+
+		_, err = tree2.Edit(fromPos, toPos, nil, nodes2, edit2)
+		assert.NoError(t, err)
+		_, err = tree2.Edit(fromPos, toPos, nil, nodes1, edit1)
+		assert.NoError(t, err)
+
+		assert.Equal(t, `<root><h1></h1><p></p></root>`, tree1.XML())
+		assert.Equal(t, tree1.XML(), tree2.XML())
+	})
+
+	t.Run("concurrent deletion and insertion test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		tree1 := json.NewInitialTree(ctx.IssueTimeTicket())
+		fromPos, toPos, err := tree1.CreateRange(nil, 0, 0)
+		assert.NoError(t, err)
+		_, err = tree1.Edit(fromPos, toPos, nil, []*json.TreeNode{
+			json.NewTreeNode(ctx.IssueTimeTicket(), "p", ""),
+		}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		tree2 := tree1.DeepCopy().(*json.Tree)
+
+		// 01. Remove the paragraph in tree1 and insert into it in tree2.
+		delFrom, delTo, err := tree1.CreateRange(nil, 0, 1)
+		assert.NoError(t, err)
+		delAt := ctx.IssueTimeTicket()
+		createdAtMapByActor, err := tree1.Edit(delFrom, delTo, nil, nil, delAt)
+		assert.NoError(t, err)
+
+		insFrom, insTo, err := tree2.CreateRange([]int{0}, 0, 0)
+		assert.NoError(t, err)
+		insAt := ctx.IssueTimeTicket()
+		text := []*json.TreeNode{
+			json.NewTreeNode(ctx.IssueTimeTicket(), json.DefaultTextNodeType, "a"),
+		}
+		_, err = tree2.Edit(insFrom, insTo, nil, text, insAt)
+		assert.NoError(t, err)
+
+		// 02. Exchange the edits.
+		_, err = tree1.Edit(insFrom, insTo, nil, text, insAt)
+		assert.NoError(t, err)
+		_, err = tree2.Edit(delFrom, delTo, createdAtMapByActor, nil, delAt)
+		assert.NoError(t, err)
+
+		assert.Equal(t, `<root></root>`, tree1.XML())
+		assert.Equal(t, tree1.XML(), tree2.XML())
+	})
+
+	t.Run("style test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		tree := json.NewInitialTree(ctx.IssueTimeTicket())
+		p := json.NewTreeNode(ctx.IssueTimeTicket(), "p", "")
+		p.Append(json.NewTreeNode(ctx.IssueTimeTicket(), json.DefaultTextNodeType, "a"))
+		fromPos, toPos, err := tree.CreateRange(nil, 0, 0)
+		assert.NoError(t, err)
+		_, err = tree.Edit(fromPos, toPos, nil, []*json.TreeNode{p}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+
+		fromPos, toPos, err = tree.CreateRange(nil, 0, 1)
+		assert.NoError(t, err)
+		_, err = tree.Style(fromPos, toPos, nil, map[string]string{"b": "1", "a": "2"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.Equal(t, `<root><p a="2" b="1">a</p></root>`, tree.XML())
+		assert.Equal(
+			t,
+			`{"type":"root","children":[{"type":"p","attributes":{"a":"2","b":"1"},"children":[{"type":"text","value":"a"}]}]}`,
+			tree.Marshal(),
+		)
+
+		// text nodes are not affected by the style.
+		fromPos, toPos, err = tree.CreateRange([]int{0}, 0, 1)
+		assert.NoError(t, err)
+		_, err = tree.Style(fromPos, toPos, nil, map[string]string{"c": "3"}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		assert.Equal(t, `<root><p a="2" b="1">a</p></root>`, tree.XML())
+	})
+
+	t.Run("concurrent style and edit test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		tree1 := json.NewInitialTree(ctx.IssueTimeTicket())
+		fromPos, toPos, err := tree1.CreateRange(nil, 0, 0)
+		assert.NoError(t, err)
+		_, err = tree1.Edit(fromPos, toPos, nil, []*json.TreeNode{
+			json.NewTreeNode(ctx.IssueTimeTicket(), "p", ""),
+			json.NewTreeNode(ctx.IssueTimeTicket(), "p", ""),
+		}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		tree2 := tree1.DeepCopy().(*json.Tree)
+
+		// 01. Remove the second paragraph and insert a heading in tree2.
+		delFrom, delTo, err := tree2.CreateRange(nil, 1, 2)
+		assert.NoError(t, err)
+		delAt := ctx.IssueTimeTicket()
+		createdAtMapByActor, err := tree2.Edit(delFrom, delTo, nil, nil, delAt)
+		assert.NoError(t, err)
+
+		insFrom, insTo, err := tree2.CreateRange(nil, 0, 0)
+		assert.NoError(t, err)
+		insAt := ctx.IssueTimeTicket()
+		heading := []*json.TreeNode{json.NewTreeNode(ctx.IssueTimeTicket(), "h1", "")}
+		_, err = tree2.Edit(insFrom, insTo, nil, heading, insAt)
+		assert.NoError(t, err)
+
+		// 02. Style both paragraphs in tree1. The heading inserted concurrently
+		// has a smaller ticket but must not be styled.
+		styleFrom, styleTo, err := tree1.CreateRange(nil, 0, 2)
+		assert.NoError(t, err)
+		styleAt := ctx.IssueTimeTicket()
+		attrs := map[string]string{"b": "1"}
+		styledAtMapByActor, err := tree1.Style(styleFrom, styleTo, nil, attrs, styleAt)
+		assert.NoError(t, err)
+
+		// 03. Exchange the operations.
+		_, err = tree1.Edit(delFrom, delTo, createdAtMapByActor, nil, delAt)
+		assert.NoError(t, err)
+		_, err = tree1.Edit(insFrom, insTo, nil, heading, insAt)
+		assert.NoError(t, err)
+		_, err = tree2.Style(styleFrom, styleTo, styledAtMapByActor, attrs, styleAt)
+		assert.NoError(t, err)
+
+		assert.Equal(t, `<root><h1></h1><p b="1"></p></root>`, tree1.XML())
+		assert.Equal(t, tree1.XML(), tree2.XML())
+	})
+
+	t.Run("garbage collection test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		tree := json.NewInitialTree(ctx.IssueTimeTicket())
+		root.RegisterElement(tree)
+
+		fromPos, toPos, err := tree.CreateRange(nil, 0, 0)
+		assert.NoError(t, err)
+		p := json.NewTreeNode(ctx.IssueTimeTicket(), "p", "")
+		p.Append(json.NewTreeNode(ctx.IssueTimeTicket(), json.DefaultTextNodeType, "a"))
+		_, err = tree.Edit(fromPos, toPos, nil, []*json.TreeNode{p}, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+
+		fromPos, toPos, err = tree.CreateRange(nil, 0, 1)
+		assert.NoError(t, err)
+		_, err = tree.Edit(fromPos, toPos, nil, nil, ctx.IssueTimeTicket())
+		assert.NoError(t, err)
+		root.RegisterTextElementWithGarbage(tree)
+
+		assert.Equal(t, 1, root.GarbageLen())
+		assert.Equal(t, 1, root.GarbageCollect(time.MaxTicket))
+		assert.Equal(t, 0, root.GarbageLen())
+		assert.Nil(t, tree.FindNode(p.ID()))
+		assert.Equal(t, `<root></root>`, tree.XML())
+	})
+}
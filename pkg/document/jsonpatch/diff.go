@@ -0,0 +1,175 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package jsonpatch
+
+import (
+	gojson "encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+)
+
+// Diff returns the patch which turns the JSON of the given `from` root object
+// into the JSON of the given `to` root object, which are the roots of the
+// same document at different versions.
+//
+// Elements are matched by their creation time rather than by their values or
+// positions, so an element moved in an array is emitted as a move, and an
+// element replaced by a new one with the same value is emitted as a replace.
+func Diff(from, to *json.Object) Patch {
+	d := &differ{}
+	d.diffObject("", from, to)
+	return d.patch
+}
+
+// differ accumulates the operations of a patch.
+type differ struct {
+	patch Patch
+}
+
+func (d *differ) add(op, path, from string, elem json.Element) {
+	operation := Operation{Op: op, Path: path, From: from}
+	if elem != nil {
+		operation.Value = gojson.RawMessage(elem.Marshal())
+	}
+	d.patch = append(d.patch, operation)
+}
+
+// diffElement appends the operations which turn the given `from` element
+// into the given `to` element at the given path.
+func (d *differ) diffElement(path string, from, to json.Element) {
+	if from.CreatedAt().Compare(to.CreatedAt()) != 0 {
+		d.add(OpReplace, path, "", to)
+		return
+	}
+
+	switch from := from.(type) {
+	case *json.Object:
+		d.diffObject(path, from, to.(*json.Object))
+	case *json.Array:
+		d.diffArray(path, from, to.(*json.Array))
+	default:
+		// NOTE: Texts, counters and trees are edited in place, so they are
+		// replaced as a whole if their contents differ.
+		if from.Marshal() != to.Marshal() {
+			d.add(OpReplace, path, "", to)
+		}
+	}
+}
+
+// diffObject appends the operations which turn the members of the given
+// `from` object into the members of the given `to` object.
+func (d *differ) diffObject(path string, from, to *json.Object) {
+	fromMembers, toMembers := from.Members(), to.Members()
+
+	var removed, kept []string
+	for k := range fromMembers {
+		if _, ok := toMembers[k]; ok {
+			kept = append(kept, k)
+		} else {
+			removed = append(removed, k)
+		}
+	}
+	var added []string
+	for k := range toMembers {
+		if _, ok := fromMembers[k]; !ok {
+			added = append(added, k)
+		}
+	}
+	sort.Strings(removed)
+	sort.Strings(kept)
+	sort.Strings(added)
+
+	for _, k := range removed {
+		d.add(OpRemove, path+"/"+escapeToken(k), "", nil)
+	}
+	for _, k := range kept {
+		d.diffElement(path+"/"+escapeToken(k), fromMembers[k], toMembers[k])
+	}
+	for _, k := range added {
+		d.add(OpAdd, path+"/"+escapeToken(k), "", toMembers[k])
+	}
+}
+
+// diffArray appends the operations which turn the elements of the given
+// `from` array into the elements of the given `to` array.
+//
+// The positions of elements in the RGA differ from the indexes of the JSON
+// array, and each index of an operation refers to the array after the
+// previous operations are applied. So the indexes are computed against a
+// simulated array: the removed elements are removed from the back first,
+// and then the elements are placed from the front, so that the elements
+// before the current index are already in their final positions.
+func (d *differ) diffArray(path string, from, to *json.Array) {
+	toElements := to.Elements()
+	toKeys := make(map[string]bool, len(toElements))
+	for _, elem := range toElements {
+		toKeys[elem.CreatedAt().Key()] = true
+	}
+
+	fromElements := from.Elements()
+	var current []json.Element
+	for i := len(fromElements) - 1; i >= 0; i-- {
+		if !toKeys[fromElements[i].CreatedAt().Key()] {
+			d.add(OpRemove, path+"/"+strconv.Itoa(i), "", nil)
+		}
+	}
+	for _, elem := range fromElements {
+		if toKeys[elem.CreatedAt().Key()] {
+			current = append(current, elem)
+		}
+	}
+
+	for i, elem := range toElements {
+		elemPath := path + "/" + strconv.Itoa(i)
+		idx := indexOf(current, elem, i)
+		if idx < 0 {
+			d.add(OpAdd, elemPath, "", elem)
+			current = insert(current, i, elem)
+			continue
+		}
+
+		if idx != i {
+			d.add(OpMove, elemPath, path+"/"+strconv.Itoa(idx), nil)
+			moved := current[idx]
+			current = append(current[:idx], current[idx+1:]...)
+			current = insert(current, i, moved)
+		}
+		d.diffElement(elemPath, current[i], elem)
+	}
+}
+
+// indexOf returns the index of the element created at the same time as the
+// given element in the given elements from the given index, or -1 if there
+// is no such element.
+func indexOf(elements []json.Element, elem json.Element, from int) int {
+	for i := from; i < len(elements); i++ {
+		if elements[i].CreatedAt().Compare(elem.CreatedAt()) == 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+// insert inserts the given element at the given index of the given elements.
+func insert(elements []json.Element, idx int, elem json.Element) []json.Element {
+	elements = append(elements, nil)
+	copy(elements[idx+1:], elements[idx:])
+	elements[idx] = elem
+	return elements
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package jsonpatch_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/jsonpatch"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+)
+
+// diff applies the given updater to the given document and returns the
+// patch between the versions before and after it. It also checks that the
+// patch turns the JSON before the update into the JSON after it.
+func diff(t *testing.T, doc *document.Document, updater func(root *proxy.ObjectProxy) error) jsonpatch.Patch {
+	before := doc.Marshal()
+	from := doc.RootObject().DeepCopy().(*json.Object)
+	assert.NoError(t, doc.Update(updater))
+
+	patch := jsonpatch.Diff(from, doc.RootObject())
+	result, err := patch.Apply([]byte(before))
+	assert.NoError(t, err)
+	assert.JSONEq(t, doc.Marshal(), string(result))
+	return patch
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("object test", func(t *testing.T) {
+		doc := document.New("d1")
+		diff(t, doc, func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			root.SetNewObject("k/2").SetInteger("k~3", 3)
+			return nil
+		})
+
+		patch := diff(t, doc, func(root *proxy.ObjectProxy) error {
+			root.Delete("k1")
+			root.GetObject("k/2").SetInteger("k~3", 4)
+			root.SetBool("k4", true)
+			return nil
+		})
+		assert.Equal(t, jsonpatch.Patch{
+			{Op: jsonpatch.OpRemove, Path: "/k1"},
+			{Op: jsonpatch.OpReplace, Path: "/k~12/k~03", Value: []byte("4")},
+			{Op: jsonpatch.OpAdd, Path: "/k4", Value: []byte("true")},
+		}, patch)
+
+		assert.Empty(t, jsonpatch.Diff(doc.RootObject(), doc.RootObject()))
+	})
+
+	t.Run("array test", func(t *testing.T) {
+		doc := document.New("d1")
+		diff(t, doc, func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("list").AddInteger(0, 1, 2, 3, 4, 5)
+			return nil
+		})
+
+		diff(t, doc, func(root *proxy.ObjectProxy) error {
+			list := root.GetArray("list")
+			list.Delete(4)
+			list.Delete(1)
+			list.InsertIntegerAfter(0, 6)
+			list.AddInteger(7)
+			list.AddNewObject().SetString("k", "v")
+			return nil
+		})
+		assert.Equal(t, `{"list":[0,6,2,3,5,7,{"k":"v"}]}`, doc.Marshal())
+
+		diff(t, doc, func(root *proxy.ObjectProxy) error {
+			list := root.GetArray("list")
+			list.Delete(0)
+			list.AddNewArray().AddString("x")
+			return nil
+		})
+	})
+
+	t.Run("array move test", func(t *testing.T) {
+		doc := document.New("d1")
+		diff(t, doc, func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("list").AddString("a", "b", "c", "d")
+			return nil
+		})
+
+		patch := diff(t, doc, func(root *proxy.ObjectProxy) error {
+			list := root.GetArray("list")
+			list.MoveBefore(list.Get(0).CreatedAt(), list.Get(3).CreatedAt())
+			list.Delete(2)
+			return nil
+		})
+		assert.Equal(t, `{"list":["d","a","c"]}`, doc.Marshal())
+		assert.Equal(t, jsonpatch.Patch{
+			{Op: jsonpatch.OpRemove, Path: "/list/1"},
+			{Op: jsonpatch.OpMove, Path: "/list/0", From: "/list/2"},
+		}, patch)
+	})
+
+	t.Run("replaced element test", func(t *testing.T) {
+		doc := document.New("d1")
+		diff(t, doc, func(root *proxy.ObjectProxy) error {
+			root.SetNewObject("obj").SetString("k", "v")
+			root.SetNewText("text").Edit(0, 0, "hello")
+			root.SetNewCounter("cnt", 1)
+			return nil
+		})
+
+		patch := diff(t, doc, func(root *proxy.ObjectProxy) error {
+			root.SetNewObject("obj").SetString("k", "v")
+			root.GetText("text").Edit(5, 5, " world")
+			root.GetCounter("cnt").Increase(2)
+			return nil
+		})
+		assert.Equal(t, jsonpatch.Patch{
+			{Op: jsonpatch.OpReplace, Path: "/cnt", Value: []byte("3")},
+			{Op: jsonpatch.OpReplace, Path: "/obj", Value: []byte(`{"k":"v"}`)},
+			{Op: jsonpatch.OpReplace, Path: "/text", Value: []byte(`"hello world"`)},
+		}, patch)
+	})
+}
@@ -0,0 +1,437 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package jsonpatch provides JSON Patch (RFC 6902) for the diffs of
+// documents, so that the changes of a document can be applied to plain JSON
+// documents by external systems.
+package jsonpatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// The types of operations of JSON Patch.
+const (
+	OpAdd     = "add"
+	OpRemove  = "remove"
+	OpReplace = "replace"
+	OpMove    = "move"
+	OpCopy    = "copy"
+	OpTest    = "test"
+)
+
+var (
+	// ErrInvalidOperation is returned when an operation of a patch is
+	// malformed, e.g. the type is unknown or the value is missing.
+	ErrInvalidOperation = errors.New("invalid operation")
+
+	// ErrInvalidPointer is returned when a path of an operation is not a
+	// valid JSON Pointer (RFC 6901).
+	ErrInvalidPointer = errors.New("invalid pointer")
+
+	// ErrPathNotFound is returned when a path of an operation does not refer
+	// to an existing location of the document.
+	ErrPathNotFound = errors.New("path not found")
+
+	// ErrTestFailed is returned when the value of a test operation is not
+	// equal to the value of the document.
+	ErrTestFailed = errors.New("test failed")
+)
+
+// Operation is an operation of JSON Patch.
+type Operation struct {
+	// Op is the type of the operation.
+	Op string `json:"op"`
+
+	// Path is the JSON Pointer of the target location.
+	Path string `json:"path"`
+
+	// From is the JSON Pointer of the source location of move and copy.
+	From string `json:"from,omitempty"`
+
+	// Value is the JSON encoding of the value of add, replace and test.
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Patch is a sequence of operations of JSON Patch, which are applied in
+// order.
+type Patch []Operation
+
+// Parse parses the given JSON encoding of a patch.
+func Parse(content []byte) (Patch, error) {
+	var patch Patch
+	if err := json.Unmarshal(content, &patch); err != nil {
+		return nil, fmt.Errorf("parse patch: %w", err)
+	}
+	return patch, nil
+}
+
+// Marshal returns the JSON encoding of this patch.
+func (p Patch) Marshal() ([]byte, error) {
+	if p == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]Operation(p))
+}
+
+// Apply applies this patch to the given JSON document and returns the
+// result. The patch is applied atomically: if an operation fails, the error
+// is returned without the result.
+func (p Patch) Apply(doc []byte) ([]byte, error) {
+	root, err := decode(doc)
+	if err != nil {
+		return nil, fmt.Errorf("decode document: %w", err)
+	}
+
+	for i, op := range p {
+		if root, err = op.apply(root); err != nil {
+			return nil, fmt.Errorf("operations[%d] %s %s: %w", i, op.Op, op.Path, err)
+		}
+	}
+
+	return json.Marshal(root)
+}
+
+// apply applies this operation to the given decoded document.
+func (o Operation) apply(root interface{}) (interface{}, error) {
+	path, err := parsePointer(o.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch o.Op {
+	case OpAdd, OpReplace, OpTest:
+		if o.Value == nil {
+			return nil, fmt.Errorf("value is missing: %w", ErrInvalidOperation)
+		}
+		value, err := decode(o.Value)
+		if err != nil {
+			return nil, fmt.Errorf("decode value: %w", err)
+		}
+
+		switch o.Op {
+		case OpAdd:
+			return add(root, path, value)
+		case OpReplace:
+			return replace(root, path, value)
+		default:
+			current, err := get(root, path)
+			if err != nil {
+				return nil, err
+			}
+			if !equal(current, value) {
+				return nil, ErrTestFailed
+			}
+			return root, nil
+		}
+	case OpRemove:
+		root, _, err = remove(root, path)
+		return root, err
+	case OpMove, OpCopy:
+		from, err := parsePointer(o.From)
+		if err != nil {
+			return nil, err
+		}
+
+		var value interface{}
+		if o.Op == OpMove {
+			if isProperPrefix(from, path) {
+				return nil, fmt.Errorf("move %s into its child: %w", o.From, ErrInvalidOperation)
+			}
+			if root, value, err = remove(root, from); err != nil {
+				return nil, err
+			}
+		} else {
+			if value, err = get(root, from); err != nil {
+				return nil, err
+			}
+			if value, err = deepCopy(value); err != nil {
+				return nil, err
+			}
+		}
+		return add(root, path, value)
+	default:
+		return nil, fmt.Errorf("unknown op %q: %w", o.Op, ErrInvalidOperation)
+	}
+}
+
+// add adds the given value to the given path of the root and returns the new
+// root.
+func add(root interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	return update(root, path, func(parent interface{}, token string) (interface{}, error) {
+		switch p := parent.(type) {
+		case map[string]interface{}:
+			p[token] = value
+			return p, nil
+		case []interface{}:
+			idx := len(p)
+			if token != "-" {
+				var err error
+				if idx, err = arrayIndex(token, len(p)+1); err != nil {
+					return nil, err
+				}
+			}
+			p = append(p, nil)
+			copy(p[idx+1:], p[idx:])
+			p[idx] = value
+			return p, nil
+		default:
+			return nil, fmt.Errorf("%s of a scalar: %w", token, ErrPathNotFound)
+		}
+	})
+}
+
+// remove removes the value of the given path of the root and returns the
+// new root with the removed value.
+func remove(root interface{}, path []string) (interface{}, interface{}, error) {
+	if len(path) == 0 {
+		return nil, nil, fmt.Errorf("remove the root: %w", ErrInvalidOperation)
+	}
+
+	var removed interface{}
+	root, err := update(root, path, func(parent interface{}, token string) (interface{}, error) {
+		switch p := parent.(type) {
+		case map[string]interface{}:
+			value, ok := p[token]
+			if !ok {
+				return nil, fmt.Errorf("%s: %w", token, ErrPathNotFound)
+			}
+			removed = value
+			delete(p, token)
+			return p, nil
+		case []interface{}:
+			idx, err := arrayIndex(token, len(p))
+			if err != nil {
+				return nil, err
+			}
+			removed = p[idx]
+			return append(p[:idx], p[idx+1:]...), nil
+		default:
+			return nil, fmt.Errorf("%s of a scalar: %w", token, ErrPathNotFound)
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return root, removed, nil
+}
+
+// replace replaces the value of the given path of the root with the given
+// value and returns the new root.
+func replace(root interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	return update(root, path, func(parent interface{}, token string) (interface{}, error) {
+		switch p := parent.(type) {
+		case map[string]interface{}:
+			if _, ok := p[token]; !ok {
+				return nil, fmt.Errorf("%s: %w", token, ErrPathNotFound)
+			}
+			p[token] = value
+			return p, nil
+		case []interface{}:
+			idx, err := arrayIndex(token, len(p))
+			if err != nil {
+				return nil, err
+			}
+			p[idx] = value
+			return p, nil
+		default:
+			return nil, fmt.Errorf("%s of a scalar: %w", token, ErrPathNotFound)
+		}
+	})
+}
+
+// update calls the given function with the parent of the given path and the
+// last token of it, and sets the parent returned by the function back to its
+// own parent, since appending to an array may reallocate it.
+func update(
+	node interface{},
+	path []string,
+	fn func(parent interface{}, token string) (interface{}, error),
+) (interface{}, error) {
+	if len(path) == 1 {
+		return fn(node, path[0])
+	}
+
+	child, err := get(node, path[:1])
+	if err != nil {
+		return nil, err
+	}
+	if child, err = update(child, path[1:], fn); err != nil {
+		return nil, err
+	}
+
+	switch n := node.(type) {
+	case map[string]interface{}:
+		n[path[0]] = child
+	case []interface{}:
+		idx, _ := arrayIndex(path[0], len(n))
+		n[idx] = child
+	}
+	return node, nil
+}
+
+// get returns the value of the given path of the node.
+func get(node interface{}, path []string) (interface{}, error) {
+	for _, token := range path {
+		switch n := node.(type) {
+		case map[string]interface{}:
+			value, ok := n[token]
+			if !ok {
+				return nil, fmt.Errorf("%s: %w", token, ErrPathNotFound)
+			}
+			node = value
+		case []interface{}:
+			idx, err := arrayIndex(token, len(n))
+			if err != nil {
+				return nil, err
+			}
+			node = n[idx]
+		default:
+			return nil, fmt.Errorf("%s of a scalar: %w", token, ErrPathNotFound)
+		}
+	}
+
+	return node, nil
+}
+
+// arrayIndex parses the given token as an index of an array and checks that
+// it is less than the given limit.
+func arrayIndex(token string, limit int) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') || strings.Trim(token, "0123456789") != "" {
+		return 0, fmt.Errorf("array index %q: %w", token, ErrInvalidPointer)
+	}
+
+	idx, err := strconv.Atoi(token)
+	if err != nil || idx >= limit {
+		return 0, fmt.Errorf("array index %s: %w", token, ErrPathNotFound)
+	}
+	return idx, nil
+}
+
+// parsePointer parses the given JSON Pointer into its unescaped tokens.
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if pointer[0] != '/' {
+		return nil, fmt.Errorf("%q: %w", pointer, ErrInvalidPointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		// NOTE: "~1" is unescaped before "~0" so that "~01" becomes "~1"
+		// rather than "/".
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// escapeToken escapes the given token of a JSON Pointer.
+func escapeToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// isProperPrefix returns whether the given prefix is a proper prefix of the
+// given path.
+func isProperPrefix(prefix, path []string) bool {
+	if len(prefix) >= len(path) {
+		return false
+	}
+	for i := range prefix {
+		if prefix[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// decode decodes the given JSON keeping numbers as json.Number, so that long
+// values are not rounded to float64.
+func decode(content []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// deepCopy copies the given decoded value deeply.
+func deepCopy(value interface{}) (interface{}, error) {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return decode(content)
+}
+
+// equal returns whether the given decoded values are equal in the sense of
+// the test operation: numbers are compared by their values, and objects
+// regardless of the order of their members.
+func equal(a, b interface{}) bool {
+	switch a := a.(type) {
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, v := range a {
+			w, ok := b[k]
+			if !ok || !equal(v, w) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !equal(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		b, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		x, okX := new(big.Rat).SetString(a.String())
+		y, okY := new(big.Rat).SetString(b.String())
+		return okX && okY && x.Cmp(y) == 0
+	default:
+		return a == b
+	}
+}
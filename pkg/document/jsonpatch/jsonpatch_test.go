@@ -0,0 +1,158 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package jsonpatch_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document/jsonpatch"
+)
+
+func TestApply(t *testing.T) {
+	// NOTE: The cases are the examples of Appendix A of RFC 6902.
+	tests := []struct {
+		name     string
+		doc      string
+		patch    string
+		expected string
+		err      error
+	}{{
+		name:     "A.1. adding an object member",
+		doc:      `{"foo":"bar"}`,
+		patch:    `[{"op":"add","path":"/baz","value":"qux"}]`,
+		expected: `{"baz":"qux","foo":"bar"}`,
+	}, {
+		name:     "A.2. adding an array element",
+		doc:      `{"foo":["bar","baz"]}`,
+		patch:    `[{"op":"add","path":"/foo/1","value":"qux"}]`,
+		expected: `{"foo":["bar","qux","baz"]}`,
+	}, {
+		name:     "A.3. removing an object member",
+		doc:      `{"baz":"qux","foo":"bar"}`,
+		patch:    `[{"op":"remove","path":"/baz"}]`,
+		expected: `{"foo":"bar"}`,
+	}, {
+		name:     "A.4. removing an array element",
+		doc:      `{"foo":["bar","qux","baz"]}`,
+		patch:    `[{"op":"remove","path":"/foo/1"}]`,
+		expected: `{"foo":["bar","baz"]}`,
+	}, {
+		name:     "A.5. replacing a value",
+		doc:      `{"baz":"qux","foo":"bar"}`,
+		patch:    `[{"op":"replace","path":"/baz","value":"boo"}]`,
+		expected: `{"baz":"boo","foo":"bar"}`,
+	}, {
+		name:     "A.6. moving a value",
+		doc:      `{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+		patch:    `[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+		expected: `{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`,
+	}, {
+		name:     "A.7. moving an array element",
+		doc:      `{"foo":["all","grass","cows","eat"]}`,
+		patch:    `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`,
+		expected: `{"foo":["all","cows","eat","grass"]}`,
+	}, {
+		name: "A.8. testing a value: success",
+		doc:  `{"baz":"qux","foo":["a",2,"c"]}`,
+		patch: `[{"op":"test","path":"/baz","value":"qux"},` +
+			`{"op":"test","path":"/foo/1","value":2}]`,
+		expected: `{"baz":"qux","foo":["a",2,"c"]}`,
+	}, {
+		name:  "A.9. testing a value: error",
+		doc:   `{"baz":"qux"}`,
+		patch: `[{"op":"test","path":"/baz","value":"bar"}]`,
+		err:   jsonpatch.ErrTestFailed,
+	}, {
+		name:     "A.10. adding a nested member object",
+		doc:      `{"foo":"bar"}`,
+		patch:    `[{"op":"add","path":"/child","value":{"grandchild":{}}}]`,
+		expected: `{"foo":"bar","child":{"grandchild":{}}}`,
+	}, {
+		name:     "A.11. ignoring unrecognized elements",
+		doc:      `{"foo":"bar"}`,
+		patch:    `[{"op":"add","path":"/baz","value":"qux","xyz":123}]`,
+		expected: `{"foo":"bar","baz":"qux"}`,
+	}, {
+		name:  "A.12. adding to a nonexistent target",
+		doc:   `{"foo":"bar"}`,
+		patch: `[{"op":"add","path":"/baz/bat","value":"qux"}]`,
+		err:   jsonpatch.ErrPathNotFound,
+	}, {
+		name: "A.14. ~ escape ordering",
+		doc:  `{"/":9,"~1":10}`,
+		patch: `[{"op":"test","path":"/~01","value":10},` +
+			`{"op":"test","path":"/~1","value":9}]`,
+		expected: `{"/":9,"~1":10}`,
+	}, {
+		name:  "A.15. comparing strings and numbers",
+		doc:   `{"/":9,"~1":10}`,
+		patch: `[{"op":"test","path":"/~01","value":"10"}]`,
+		err:   jsonpatch.ErrTestFailed,
+	}, {
+		name:     "A.16. adding an array value",
+		doc:      `{"foo":["bar"]}`,
+		patch:    `[{"op":"add","path":"/foo/-","value":["abc","def"]}]`,
+		expected: `{"foo":["bar",["abc","def"]]}`,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			patch, err := jsonpatch.Parse([]byte(test.patch))
+			assert.NoError(t, err)
+
+			result, err := patch.Apply([]byte(test.doc))
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.JSONEq(t, test.expected, string(result))
+		})
+	}
+
+	t.Run("invalid operation test", func(t *testing.T) {
+		for _, content := range []string{
+			`[{"op":"unknown","path":"/foo"}]`,
+			`[{"op":"add","path":"/foo"}]`,
+			`[{"op":"move","from":"/foo","path":"/foo/bar"}]`,
+		} {
+			patch, err := jsonpatch.Parse([]byte(content))
+			assert.NoError(t, err)
+			_, err = patch.Apply([]byte(`{"foo":{}}`))
+			assert.ErrorIs(t, err, jsonpatch.ErrInvalidOperation, content)
+		}
+
+		patch, err := jsonpatch.Parse([]byte(`[{"op":"remove","path":"foo"}]`))
+		assert.NoError(t, err)
+		_, err = patch.Apply([]byte(`{"foo":1}`))
+		assert.ErrorIs(t, err, jsonpatch.ErrInvalidPointer)
+	})
+
+	t.Run("null value test", func(t *testing.T) {
+		patch, err := jsonpatch.Parse([]byte(`[{"op":"add","path":"/foo","value":null}]`))
+		assert.NoError(t, err)
+		result, err := patch.Apply([]byte(`{}`))
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"foo":null}`, string(result))
+
+		content, err := patch.Marshal()
+		assert.NoError(t, err)
+		assert.JSONEq(t, `[{"op":"add","path":"/foo","value":null}]`, string(content))
+	})
+}
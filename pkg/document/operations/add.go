@@ -34,6 +34,14 @@ type Add struct {
 
 	// executedAt is the time the operation was executed.
 	executedAt *time.Ticket
+
+	// wallTime is the wall-clock time in milliseconds when this operation was
+	// created on the client. It is only for diagnostics.
+	wallTime int64
+
+	// id is the ID of this operation given by the client to deduplicate it
+	// when it is resent, or empty if it is not given.
+	id string
 }
 
 // NewAdd creates a new instance of Add.
@@ -64,6 +72,13 @@ func (o *Add) Execute(root *json.Root) error {
 	obj.InsertAfter(o.prevCreatedAt, value)
 
 	root.RegisterElement(value)
+
+	// NOTE: If the array was cleared by a concurrent change after this
+	// operation, the value should be removed as if it had been added before.
+	if clearedAt := obj.ClearedAt(); clearedAt != nil && clearedAt.After(value.CreatedAt()) {
+		obj.DeleteByCreatedAt(value.CreatedAt(), clearedAt)
+		root.RegisterRemovedElementPair(obj, value)
+	}
 	return nil
 }
 
@@ -87,6 +102,26 @@ func (o *Add) SetActor(actorID *time.ActorID) {
 	o.executedAt = o.executedAt.SetActorID(actorID)
 }
 
+// WallTime returns the wall-clock time when this operation was created.
+func (o *Add) WallTime() int64 {
+	return o.wallTime
+}
+
+// SetWallTime sets the wall-clock time when this operation was created.
+func (o *Add) SetWallTime(wallTime int64) {
+	o.wallTime = wallTime
+}
+
+// ID returns the ID of this operation given by the client.
+func (o *Add) ID() string {
+	return o.id
+}
+
+// SetID sets the ID of this operation given by the client.
+func (o *Add) SetID(id string) {
+	o.id = id
+}
+
 // PrevCreatedAt returns the creation time of previous element.
 func (o *Add) PrevCreatedAt() *time.Ticket {
 	return o.prevCreatedAt
@@ -0,0 +1,111 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operations
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// Clear is an operation that removes all the elements of an Array or Object
+// at once. Elements added by concurrent changes with a later time survive,
+// and those with an earlier time are removed.
+type Clear struct {
+	// parentCreatedAt is the creation time of the container that executes
+	// Clear.
+	parentCreatedAt *time.Ticket
+
+	// executedAt is the time the operation was executed.
+	executedAt *time.Ticket
+
+	// wallTime is the wall-clock time in milliseconds when this operation was
+	// created on the client. It is only for diagnostics.
+	wallTime int64
+
+	// id is the ID of this operation given by the client to deduplicate it
+	// when it is resent, or empty if it is not given.
+	id string
+}
+
+// NewClear creates a new instance of Clear.
+func NewClear(
+	parentCreatedAt *time.Ticket,
+	executedAt *time.Ticket,
+) *Clear {
+	return &Clear{
+		parentCreatedAt: parentCreatedAt,
+		executedAt:      executedAt,
+	}
+}
+
+// Execute executes this operation on the given document(`root`).
+func (o *Clear) Execute(root *json.Root) error {
+	parent := root.FindByCreatedAt(o.parentCreatedAt)
+
+	var container json.Container
+	var removed []json.Element
+	switch parent := parent.(type) {
+	case *json.Object:
+		container = parent
+		removed = parent.Clear(o.executedAt)
+	case *json.Array:
+		container = parent
+		removed = parent.Clear(o.executedAt)
+	default:
+		return ErrNotApplicableDataType
+	}
+
+	for _, elem := range removed {
+		root.RegisterRemovedElementPair(container, elem)
+	}
+	return nil
+}
+
+// ParentCreatedAt returns the creation time of the container.
+func (o *Clear) ParentCreatedAt() *time.Ticket {
+	return o.parentCreatedAt
+}
+
+// ExecutedAt returns execution time of this operation.
+func (o *Clear) ExecutedAt() *time.Ticket {
+	return o.executedAt
+}
+
+// SetActor sets the given actor to this operation.
+func (o *Clear) SetActor(actorID *time.ActorID) {
+	o.executedAt = o.executedAt.SetActorID(actorID)
+}
+
+// WallTime returns the wall-clock time when this operation was created.
+func (o *Clear) WallTime() int64 {
+	return o.wallTime
+}
+
+// SetWallTime sets the wall-clock time when this operation was created.
+func (o *Clear) SetWallTime(wallTime int64) {
+	o.wallTime = wallTime
+}
+
+// ID returns the ID of this operation given by the client.
+func (o *Clear) ID() string {
+	return o.id
+}
+
+// SetID sets the ID of this operation given by the client.
+func (o *Clear) SetID(id string) {
+	o.id = id
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operations
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// DataType is the type of the data which operations are executed on.
+type DataType string
+
+// The data types which operations are executed on.
+const (
+	ObjectType  DataType = "object"
+	ArrayType   DataType = "array"
+	CounterType DataType = "counter"
+	TextType    DataType = "text"
+	TreeType    DataType = "tree"
+)
+
+var (
+	// ErrConflictResolverNotSupported is returned when a conflict resolver is
+	// registered for a data type whose concurrent operations are merged by
+	// the data type itself.
+	ErrConflictResolverNotSupported = errors.New("conflict resolver not supported")
+)
+
+// ConflictResolver resolves two concurrent operations on the same target of a
+// data type, which is detected while executing the later arriving one.
+//
+// The operations are given in the order of their executedAt tickets rather
+// than the order of arrival, so that every replica resolves the same
+// conflict. For the same reason, Resolve must be deterministic, depend only
+// on the given operations, and be transitive: if A wins over B and B wins
+// over C, A must win over C, since three or more concurrent operations are
+// resolved in pairs in the order of arrival.
+//
+// The same resolver must be registered on every replica including the server,
+// which builds the snapshots of documents; otherwise the replicas diverge.
+type ConflictResolver interface {
+	// Resolve returns the operation whose effect is exposed, which is either
+	// the earlier or the later one.
+	Resolve(earlier, later Operation) Operation
+}
+
+// ConflictResolverFunc is an adapter to use a function as a ConflictResolver.
+type ConflictResolverFunc func(earlier, later Operation) Operation
+
+// Resolve calls the function.
+func (f ConflictResolverFunc) Resolve(earlier, later Operation) Operation {
+	return f(earlier, later)
+}
+
+var (
+	// LastWriterWins exposes the operation executed later.
+	LastWriterWins ConflictResolver = ConflictResolverFunc(func(_, later Operation) Operation {
+		return later
+	})
+
+	// FirstWriterWins exposes the operation executed earlier.
+	FirstWriterWins ConflictResolver = ConflictResolverFunc(func(earlier, _ Operation) Operation {
+		return earlier
+	})
+)
+
+// RegisterConflictResolver registers the resolver of concurrent operations on
+// the given data type, or resets it to the default with nil. By default,
+// concurrent Sets to the same key of an Object are resolved by the merge
+// policy of the document, which is deterministic.
+//
+// Only Objects can have resolvers: concurrent operations on the other data
+// types are merged by the data types themselves, e.g. concurrent Increases
+// of a Counter are summed and concurrent Edits of a Text are interleaved.
+//
+// Resolvers should be registered before documents are created, e.g. in
+// init functions, as the values of the existing documents are not reordered.
+func RegisterConflictResolver(dataType DataType, resolver ConflictResolver) error {
+	if dataType != ObjectType {
+		return fmt.Errorf("%s: %w", dataType, ErrConflictResolverNotSupported)
+	}
+
+	if resolver == nil {
+		json.SetConflictResolver(nil)
+		return nil
+	}
+
+	// NOTE: The Set operations are rebuilt from the values of the Object,
+	// as the executedAt of a Set is the creation time of its value.
+	json.SetConflictResolver(func(parentCreatedAt *time.Ticket, earlier, later *json.RHTPQMapNode) bool {
+		laterSet := toSet(parentCreatedAt, later)
+		return resolver.Resolve(toSet(parentCreatedAt, earlier), laterSet) == laterSet
+	})
+	return nil
+}
+
+// toSet returns the Set operation which set the value of the given node.
+func toSet(parentCreatedAt *time.Ticket, node *json.RHTPQMapNode) *Set {
+	return NewSet(
+		parentCreatedAt,
+		node.Key(),
+		node.Element(),
+		node.Generation(),
+		node.Element().CreatedAt(),
+	)
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operations_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+// largerValueWins exposes the larger value regardless of the time of writes.
+var largerValueWins = operations.ConflictResolverFunc(func(earlier, later operations.Operation) operations.Operation {
+	if earlier.(*operations.Set).Value().Marshal() > later.(*operations.Set).Value().Marshal() {
+		return earlier
+	}
+	return later
+})
+
+func TestConflictResolver(t *testing.T) {
+	t.Run("resolve concurrent sets test", func(t *testing.T) {
+		assert.NoError(t, operations.RegisterConflictResolver(operations.ObjectType, largerValueWins))
+		defer func() {
+			assert.NoError(t, operations.RegisterConflictResolver(operations.ObjectType, nil))
+		}()
+
+		actor1, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actor2, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		d1 := document.New("d1")
+		d1.SetActor(actor1)
+		d2 := document.New("d1")
+		d2.SetActor(actor2)
+
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k", "z")
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k", "a")
+			return nil
+		}))
+
+		pack1 := change.NewPack(d1.Key(), change.InitialCheckpoint, d1.CreateChangePack().Changes, nil)
+		pack1.MinSyncedTicket = time.InitialTicket
+		pack2 := change.NewPack(d2.Key(), change.InitialCheckpoint, d2.CreateChangePack().Changes, nil)
+		pack2.MinSyncedTicket = time.InitialTicket
+		assert.NoError(t, d1.ApplyChangePack(pack2))
+		assert.NoError(t, d2.ApplyChangePack(pack1))
+
+		assert.Equal(t, `{"k":"z"}`, d1.Marshal())
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+
+		// 01. A causally later write is exposed regardless of the resolver.
+		assert.NoError(t, d2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k", "b")
+			return nil
+		}))
+		assert.Equal(t, `{"k":"b"}`, d2.Marshal())
+	})
+
+	t.Run("converge with resolver test", func(t *testing.T) {
+		assert.NoError(t, operations.RegisterConflictResolver(operations.ObjectType, largerValueWins))
+		defer func() {
+			assert.NoError(t, operations.RegisterConflictResolver(operations.ObjectType, nil))
+		}()
+
+		helper.AssertConvergence(t, func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v0")
+			return nil
+		}, [][]helper.Update{{
+			func(root *proxy.ObjectProxy) error {
+				root.SetString("k1", "v2")
+				return nil
+			},
+		}, {
+			func(root *proxy.ObjectProxy) error {
+				root.SetString("k1", "v3")
+				return nil
+			},
+		}, {
+			func(root *proxy.ObjectProxy) error {
+				root.SetString("k1", "v1")
+				root.SetNewObject("k2").SetString("k3", "v4")
+				return nil
+			},
+		}}, convergenceRounds)
+	})
+
+	t.Run("unsupported data type test", func(t *testing.T) {
+		for _, dataType := range []operations.DataType{
+			operations.ArrayType,
+			operations.CounterType,
+			operations.TextType,
+			operations.TreeType,
+		} {
+			err := operations.RegisterConflictResolver(dataType, operations.LastWriterWins)
+			assert.ErrorIs(t, err, operations.ErrConflictResolverNotSupported)
+		}
+	})
+}
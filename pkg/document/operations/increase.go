@@ -27,6 +27,14 @@ type Increase struct {
 	parentCreatedAt *time.Ticket
 	value           json.Element
 	executedAt      *time.Ticket
+
+	// wallTime is the wall-clock time in milliseconds when this operation was
+	// created on the client. It is only for diagnostics.
+	wallTime int64
+
+	// id is the ID of this operation given by the client to deduplicate it
+	// when it is resent, or empty if it is not given.
+	id string
 }
 
 // NewIncrease creates the increase instance.
@@ -75,3 +83,23 @@ func (o *Increase) ExecutedAt() *time.Ticket {
 func (o *Increase) SetActor(actorID *time.ActorID) {
 	o.executedAt = o.executedAt.SetActorID(actorID)
 }
+
+// WallTime returns the wall-clock time when this operation was created.
+func (o *Increase) WallTime() int64 {
+	return o.wallTime
+}
+
+// SetWallTime sets the wall-clock time when this operation was created.
+func (o *Increase) SetWallTime(wallTime int64) {
+	o.wallTime = wallTime
+}
+
+// ID returns the ID of this operation given by the client.
+func (o *Increase) ID() string {
+	return o.id
+}
+
+// SetID sets the ID of this operation given by the client.
+func (o *Increase) SetID(id string) {
+	o.id = id
+}
@@ -34,6 +34,14 @@ type Move struct {
 
 	// executedAt is the time the operation was executed.
 	executedAt *time.Ticket
+
+	// wallTime is the wall-clock time in milliseconds when this operation was
+	// created on the client. It is only for diagnostics.
+	wallTime int64
+
+	// id is the ID of this operation given by the client to deduplicate it
+	// when it is resent, or empty if it is not given.
+	id string
 }
 
 // NewMove creates a new instance of Move.
@@ -85,6 +93,26 @@ func (o *Move) SetActor(actorID *time.ActorID) {
 	o.executedAt = o.executedAt.SetActorID(actorID)
 }
 
+// WallTime returns the wall-clock time when this operation was created.
+func (o *Move) WallTime() int64 {
+	return o.wallTime
+}
+
+// SetWallTime sets the wall-clock time when this operation was created.
+func (o *Move) SetWallTime(wallTime int64) {
+	o.wallTime = wallTime
+}
+
+// ID returns the ID of this operation given by the client.
+func (o *Move) ID() string {
+	return o.id
+}
+
+// SetID sets the ID of this operation given by the client.
+func (o *Move) SetID(id string) {
+	o.id = id
+}
+
 // PrevCreatedAt returns the creation time of previous element.
 func (o *Move) PrevCreatedAt() *time.Ticket {
 	return o.prevCreatedAt
@@ -43,4 +43,55 @@ type Operation interface {
 	// ParentCreatedAt returns the creation time of the target element to
 	// execute the operation.
 	ParentCreatedAt() *time.Ticket
+
+	// WallTime returns the wall-clock time in milliseconds when this operation
+	// was created on the client, or 0 if it is not recorded. It is only for
+	// diagnostics and does not affect the order of operations.
+	WallTime() int64
+
+	// SetWallTime sets the wall-clock time in milliseconds when this
+	// operation was created on the client.
+	SetWallTime(wallTime int64)
+
+	// ID returns the ID of this operation given by the client, or empty if it
+	// is not given. The server skips the operations whose IDs it has already
+	// received on the document recently, so that resending them is idempotent.
+	ID() string
+
+	// SetID sets the ID of this operation given by the client.
+	SetID(id string)
+}
+
+// Kind returns the kind of the given operation, e.g. "set" or "tree_edit".
+// The kinds are bounded to the known operations, and "unknown" is returned
+// for the others.
+func Kind(op Operation) string {
+	switch op.(type) {
+	case *Set:
+		return "set"
+	case *Add:
+		return "add"
+	case *Move:
+		return "move"
+	case *Remove:
+		return "remove"
+	case *Edit:
+		return "edit"
+	case *Select:
+		return "select"
+	case *RichEdit:
+		return "rich_edit"
+	case *Style:
+		return "style"
+	case *Increase:
+		return "increase"
+	case *TreeEdit:
+		return "tree_edit"
+	case *TreeStyle:
+		return "tree_style"
+	case *Clear:
+		return "clear"
+	default:
+		return "unknown"
+	}
 }
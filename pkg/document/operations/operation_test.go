@@ -0,0 +1,166 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operations_test
+
+import (
+	"testing"
+
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+const convergenceRounds = 50
+
+func TestConvergence(t *testing.T) {
+	t.Run("add test", func(t *testing.T) {
+		helper.AssertConvergence(t, func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("k1").AddInteger(0)
+			return nil
+		}, [][]helper.Update{{
+			func(root *proxy.ObjectProxy) error {
+				root.GetArray("k1").AddInteger(1, 2)
+				return nil
+			},
+			func(root *proxy.ObjectProxy) error {
+				root.GetArray("k1").InsertIntegerAfter(0, 3)
+				return nil
+			},
+		}, {
+			func(root *proxy.ObjectProxy) error {
+				root.GetArray("k1").AddInteger(4)
+				return nil
+			},
+			func(root *proxy.ObjectProxy) error {
+				root.GetArray("k1").InsertIntegerAfter(0, 5)
+				return nil
+			},
+		}, {
+			func(root *proxy.ObjectProxy) error {
+				root.GetArray("k1").InsertIntegerAfter(0, 6)
+				return nil
+			},
+		}}, convergenceRounds)
+	})
+
+	t.Run("set test", func(t *testing.T) {
+		helper.AssertConvergence(t, func(root *proxy.ObjectProxy) error {
+			root.SetNewObject("k1").SetString("k2", "v0")
+			return nil
+		}, [][]helper.Update{{
+			func(root *proxy.ObjectProxy) error {
+				root.GetObject("k1").SetString("k2", "v1")
+				return nil
+			},
+			func(root *proxy.ObjectProxy) error {
+				root.SetNewObject("k3").SetInteger("k4", 1)
+				return nil
+			},
+		}, {
+			func(root *proxy.ObjectProxy) error {
+				root.GetObject("k1").SetString("k2", "v2")
+				root.SetNewObject("k3").SetInteger("k4", 2)
+				return nil
+			},
+		}, {
+			func(root *proxy.ObjectProxy) error {
+				root.SetString("k1", "v3")
+				return nil
+			},
+		}}, convergenceRounds)
+	})
+
+	t.Run("move test", func(t *testing.T) {
+		helper.AssertConvergence(t, func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("k1").AddInteger(0, 1, 2, 3)
+			return nil
+		}, [][]helper.Update{{
+			func(root *proxy.ObjectProxy) error {
+				arr := root.GetArray("k1")
+				arr.MoveBefore(arr.Get(0).CreatedAt(), arr.Get(3).CreatedAt())
+				return nil
+			},
+		}, {
+			func(root *proxy.ObjectProxy) error {
+				arr := root.GetArray("k1")
+				arr.MoveBefore(arr.Get(1).CreatedAt(), arr.Get(3).CreatedAt())
+				return nil
+			},
+			func(root *proxy.ObjectProxy) error {
+				arr := root.GetArray("k1")
+				arr.MoveBefore(arr.Get(0).CreatedAt(), arr.Get(2).CreatedAt())
+				return nil
+			},
+		}, {
+			func(root *proxy.ObjectProxy) error {
+				root.GetArray("k1").Delete(3)
+				return nil
+			},
+		}}, convergenceRounds)
+	})
+
+	t.Run("remove test", func(t *testing.T) {
+		helper.AssertConvergence(t, func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("k1").AddInteger(0, 1, 2)
+			root.SetNewObject("k2").SetString("k3", "v0").SetString("k4", "v0")
+			return nil
+		}, [][]helper.Update{{
+			func(root *proxy.ObjectProxy) error {
+				root.GetArray("k1").Delete(1)
+				root.GetObject("k2").Delete("k3")
+				return nil
+			},
+		}, {
+			func(root *proxy.ObjectProxy) error {
+				root.GetArray("k1").Delete(1)
+				root.GetObject("k2").SetString("k3", "v1")
+				return nil
+			},
+		}, {
+			func(root *proxy.ObjectProxy) error {
+				root.GetArray("k1").InsertIntegerAfter(1, 3)
+				root.Delete("k2")
+				return nil
+			},
+		}}, convergenceRounds)
+	})
+
+	t.Run("increase test", func(t *testing.T) {
+		helper.AssertConvergence(t, func(root *proxy.ObjectProxy) error {
+			root.SetNewCounter("k1", 0)
+			return nil
+		}, [][]helper.Update{{
+			func(root *proxy.ObjectProxy) error {
+				root.GetCounter("k1").Increase(1)
+				return nil
+			},
+			func(root *proxy.ObjectProxy) error {
+				root.GetCounter("k1").Increase(-3)
+				return nil
+			},
+		}, {
+			func(root *proxy.ObjectProxy) error {
+				root.GetCounter("k1").Increase(10)
+				return nil
+			},
+		}, {
+			func(root *proxy.ObjectProxy) error {
+				root.GetCounter("k1").Increase(100)
+				return nil
+			},
+		}}, convergenceRounds)
+	})
+}
@@ -32,6 +32,14 @@ type Remove struct {
 
 	// executedAt is the time the operation was executed.
 	executedAt *time.Ticket
+
+	// wallTime is the wall-clock time in milliseconds when this operation was
+	// created on the client. It is only for diagnostics.
+	wallTime int64
+
+	// id is the ID of this operation given by the client to deduplicate it
+	// when it is resent, or empty if it is not given.
+	id string
 }
 
 // NewRemove creates a new instance of Remove.
@@ -79,6 +87,26 @@ func (o *Remove) SetActor(actorID *time.ActorID) {
 	o.executedAt = o.executedAt.SetActorID(actorID)
 }
 
+// WallTime returns the wall-clock time when this operation was created.
+func (o *Remove) WallTime() int64 {
+	return o.wallTime
+}
+
+// SetWallTime sets the wall-clock time when this operation was created.
+func (o *Remove) SetWallTime(wallTime int64) {
+	o.wallTime = wallTime
+}
+
+// ID returns the ID of this operation given by the client.
+func (o *Remove) ID() string {
+	return o.id
+}
+
+// SetID sets the ID of this operation given by the client.
+func (o *Remove) SetID(id string) {
+	o.id = id
+}
+
 // CreatedAt returns the creation time of the target element.
 func (o *Remove) CreatedAt() *time.Ticket {
 	return o.createdAt
@@ -34,6 +34,14 @@ type Select struct {
 
 	// executedAt is the time the operation was executed.
 	executedAt *time.Ticket
+
+	// wallTime is the wall-clock time in milliseconds when this operation was
+	// created on the client. It is only for diagnostics.
+	wallTime int64
+
+	// id is the ID of this operation given by the client to deduplicate it
+	// when it is resent, or empty if it is not given.
+	id string
 }
 
 // NewSelect creates a new instance of Select.
@@ -87,6 +95,26 @@ func (s *Select) SetActor(actorID *time.ActorID) {
 	s.executedAt = s.executedAt.SetActorID(actorID)
 }
 
+// WallTime returns the wall-clock time when this operation was created.
+func (s *Select) WallTime() int64 {
+	return s.wallTime
+}
+
+// SetWallTime sets the wall-clock time when this operation was created.
+func (s *Select) SetWallTime(wallTime int64) {
+	s.wallTime = wallTime
+}
+
+// ID returns the ID of this operation given by the client.
+func (s *Select) ID() string {
+	return s.id
+}
+
+// SetID sets the ID of this operation given by the client.
+func (s *Select) SetID(id string) {
+	s.id = id
+}
+
 // ParentCreatedAt returns the creation time of the Text.
 func (s *Select) ParentCreatedAt() *time.Ticket {
 	return s.parentCreatedAt
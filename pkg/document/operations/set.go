@@ -33,8 +33,20 @@ type Set struct {
 	// value is the value of this operation.
 	value json.Element
 
+	// generation is the generation of the value. It is one more than the
+	// generation of the value exposed when this operation was created.
+	generation uint32
+
 	// executedAt is the time the operation was executed.
 	executedAt *time.Ticket
+
+	// wallTime is the wall-clock time in milliseconds when this operation was
+	// created on the client. It is only for diagnostics.
+	wallTime int64
+
+	// id is the ID of this operation given by the client to deduplicate it
+	// when it is resent, or empty if it is not given.
+	id string
 }
 
 // NewSet creates a new instance of Set.
@@ -42,11 +54,13 @@ func NewSet(
 	parentCreatedAt *time.Ticket,
 	key string,
 	value json.Element,
+	generation uint32,
 	executedAt *time.Ticket,
 ) *Set {
 	return &Set{
 		key:             key,
 		value:           value,
+		generation:      generation,
 		parentCreatedAt: parentCreatedAt,
 		executedAt:      executedAt,
 	}
@@ -62,10 +76,25 @@ func (o *Set) Execute(root *json.Root) error {
 	}
 
 	value := o.value.DeepCopy()
-	removed := obj.Set(o.key, value)
+	removed, rejected := obj.SetWithGeneration(o.key, value, o.generation)
 	root.RegisterElement(value)
-	if removed != nil {
-		root.RegisterRemovedElementPair(obj, removed)
+	for _, elem := range removed {
+		root.RegisterRemovedElementPair(obj, elem)
+	}
+	if rejected != nil {
+		var exposed json.Element = value
+		if rejected == exposed {
+			exposed = obj.Get(o.key)
+		}
+		root.RegisterConflict(obj, o.key, exposed, rejected)
+	}
+
+	// NOTE: If the object was cleared by a concurrent change after this
+	// operation, the value should be removed as if it had been set before.
+	if clearedAt := obj.ClearedAt(); clearedAt != nil {
+		if obj.DeleteByCreatedAt(value.CreatedAt(), clearedAt) != nil {
+			root.RegisterRemovedElementPair(obj, value)
+		}
 	}
 	return nil
 }
@@ -85,6 +114,26 @@ func (o *Set) SetActor(actorID *time.ActorID) {
 	o.executedAt = o.executedAt.SetActorID(actorID)
 }
 
+// WallTime returns the wall-clock time when this operation was created.
+func (o *Set) WallTime() int64 {
+	return o.wallTime
+}
+
+// SetWallTime sets the wall-clock time when this operation was created.
+func (o *Set) SetWallTime(wallTime int64) {
+	o.wallTime = wallTime
+}
+
+// ID returns the ID of this operation given by the client.
+func (o *Set) ID() string {
+	return o.id
+}
+
+// SetID sets the ID of this operation given by the client.
+func (o *Set) SetID(id string) {
+	o.id = id
+}
+
 // Key returns the key of this operation.
 func (o *Set) Key() string {
 	return o.key
@@ -94,3 +143,8 @@ func (o *Set) Key() string {
 func (o *Set) Value() json.Element {
 	return o.value
 }
+
+// Generation returns the generation of the value of this operation.
+func (o *Set) Generation() uint32 {
+	return o.generation
+}
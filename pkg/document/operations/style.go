@@ -37,6 +37,14 @@ type Style struct {
 
 	// executedAt is the time the operation was executed.
 	executedAt *time.Ticket
+
+	// wallTime is the wall-clock time in milliseconds when this operation was
+	// created on the client. It is only for diagnostics.
+	wallTime int64
+
+	// id is the ID of this operation given by the client to deduplicate it
+	// when it is resent, or empty if it is not given.
+	id string
 }
 
 // NewStyle creates a new instance of Style.
@@ -88,6 +96,26 @@ func (e *Style) SetActor(actorID *time.ActorID) {
 	e.executedAt = e.executedAt.SetActorID(actorID)
 }
 
+// WallTime returns the wall-clock time when this operation was created.
+func (e *Style) WallTime() int64 {
+	return e.wallTime
+}
+
+// SetWallTime sets the wall-clock time when this operation was created.
+func (e *Style) SetWallTime(wallTime int64) {
+	e.wallTime = wallTime
+}
+
+// ID returns the ID of this operation given by the client.
+func (e *Style) ID() string {
+	return e.id
+}
+
+// SetID sets the ID of this operation given by the client.
+func (e *Style) SetID(id string) {
+	e.id = id
+}
+
 // ParentCreatedAt returns the creation time of the RichText.
 func (e *Style) ParentCreatedAt() *time.Ticket {
 	return e.parentCreatedAt
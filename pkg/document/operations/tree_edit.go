@@ -0,0 +1,153 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operations
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// TreeEdit is an operation representing editing Tree. It removes the nodes
+// in the given range and inserts the given contents.
+type TreeEdit struct {
+	// parentCreatedAt is the creation time of the Tree that executes TreeEdit.
+	parentCreatedAt *time.Ticket
+
+	// from represents the start point of the editing range.
+	from *json.TreePos
+
+	// to represents the end point of the editing range.
+	to *json.TreePos
+
+	// latestCreatedAtMapByActor is a map that stores the latest creation time
+	// by actor for the nodes included in the editing range.
+	latestCreatedAtMapByActor map[string]*time.Ticket
+
+	// contents is the nodes to be inserted when editing.
+	contents []*json.TreeNode
+
+	// executedAt is the time the operation was executed.
+	executedAt *time.Ticket
+
+	// wallTime is the wall-clock time in milliseconds when this operation was
+	// created on the client. It is only for diagnostics.
+	wallTime int64
+
+	// id is the ID of this operation given by the client to deduplicate it
+	// when it is resent, or empty if it is not given.
+	id string
+}
+
+// NewTreeEdit creates a new instance of TreeEdit.
+func NewTreeEdit(
+	parentCreatedAt *time.Ticket,
+	from *json.TreePos,
+	to *json.TreePos,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+	contents []*json.TreeNode,
+	executedAt *time.Ticket,
+) *TreeEdit {
+	return &TreeEdit{
+		parentCreatedAt:           parentCreatedAt,
+		from:                      from,
+		to:                        to,
+		latestCreatedAtMapByActor: latestCreatedAtMapByActor,
+		contents:                  contents,
+		executedAt:                executedAt,
+	}
+}
+
+// Execute executes this operation on the given document(`root`).
+func (e *TreeEdit) Execute(root *json.Root) error {
+	parent := root.FindByCreatedAt(e.parentCreatedAt)
+
+	switch obj := parent.(type) {
+	case *json.Tree:
+		if _, err := obj.Edit(
+			e.from,
+			e.to,
+			e.latestCreatedAtMapByActor,
+			e.contents,
+			e.executedAt,
+		); err != nil {
+			return err
+		}
+		if !e.from.Equal(e.to) {
+			root.RegisterTextElementWithGarbage(obj)
+		}
+	default:
+		return ErrNotApplicableDataType
+	}
+
+	return nil
+}
+
+// From returns the start point of the editing range.
+func (e *TreeEdit) From() *json.TreePos {
+	return e.from
+}
+
+// To returns the end point of the editing range.
+func (e *TreeEdit) To() *json.TreePos {
+	return e.to
+}
+
+// ExecutedAt returns execution time of this operation.
+func (e *TreeEdit) ExecutedAt() *time.Ticket {
+	return e.executedAt
+}
+
+// SetActor sets the given actor to this operation.
+func (e *TreeEdit) SetActor(actorID *time.ActorID) {
+	e.executedAt = e.executedAt.SetActorID(actorID)
+}
+
+// WallTime returns the wall-clock time when this operation was created.
+func (e *TreeEdit) WallTime() int64 {
+	return e.wallTime
+}
+
+// SetWallTime sets the wall-clock time when this operation was created.
+func (e *TreeEdit) SetWallTime(wallTime int64) {
+	e.wallTime = wallTime
+}
+
+// ID returns the ID of this operation given by the client.
+func (e *TreeEdit) ID() string {
+	return e.id
+}
+
+// SetID sets the ID of this operation given by the client.
+func (e *TreeEdit) SetID(id string) {
+	e.id = id
+}
+
+// ParentCreatedAt returns the creation time of the Tree.
+func (e *TreeEdit) ParentCreatedAt() *time.Ticket {
+	return e.parentCreatedAt
+}
+
+// Contents returns the contents of TreeEdit.
+func (e *TreeEdit) Contents() []*json.TreeNode {
+	return e.contents
+}
+
+// CreatedAtMapByActor returns the map that stores the latest creation time
+// by actor for the nodes included in the editing range.
+func (e *TreeEdit) CreatedAtMapByActor() map[string]*time.Ticket {
+	return e.latestCreatedAtMapByActor
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operations
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// TreeStyle is an operation applies the attributes of the given range to Tree.
+type TreeStyle struct {
+	// parentCreatedAt is the creation time of the Tree that executes
+	// TreeStyle.
+	parentCreatedAt *time.Ticket
+
+	// from is the starting point of the range to apply the attributes to.
+	from *json.TreePos
+
+	// to is the end point of the range to apply the attributes to.
+	to *json.TreePos
+
+	// latestCreatedAtMapByActor is a map that stores the latest creation time
+	// by actor for the nodes included in the range.
+	latestCreatedAtMapByActor map[string]*time.Ticket
+
+	// attributes represents the attributes of the nodes.
+	attributes map[string]string
+
+	// executedAt is the time the operation was executed.
+	executedAt *time.Ticket
+
+	// wallTime is the wall-clock time in milliseconds when this operation was
+	// created on the client. It is only for diagnostics.
+	wallTime int64
+
+	// id is the ID of this operation given by the client to deduplicate it
+	// when it is resent, or empty if it is not given.
+	id string
+}
+
+// NewTreeStyle creates a new instance of TreeStyle.
+func NewTreeStyle(
+	parentCreatedAt *time.Ticket,
+	from *json.TreePos,
+	to *json.TreePos,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+	attributes map[string]string,
+	executedAt *time.Ticket,
+) *TreeStyle {
+	return &TreeStyle{
+		parentCreatedAt:           parentCreatedAt,
+		from:                      from,
+		to:                        to,
+		latestCreatedAtMapByActor: latestCreatedAtMapByActor,
+		attributes:                attributes,
+		executedAt:                executedAt,
+	}
+}
+
+// Execute executes this operation on the given document(`root`).
+func (e *TreeStyle) Execute(root *json.Root) error {
+	parent := root.FindByCreatedAt(e.parentCreatedAt)
+	obj, ok := parent.(*json.Tree)
+	if !ok {
+		return ErrNotApplicableDataType
+	}
+
+	_, err := obj.Style(e.from, e.to, e.latestCreatedAtMapByActor, e.attributes, e.executedAt)
+	return err
+}
+
+// From returns the start point of the editing range.
+func (e *TreeStyle) From() *json.TreePos {
+	return e.from
+}
+
+// To returns the end point of the editing range.
+func (e *TreeStyle) To() *json.TreePos {
+	return e.to
+}
+
+// ExecutedAt returns execution time of this operation.
+func (e *TreeStyle) ExecutedAt() *time.Ticket {
+	return e.executedAt
+}
+
+// SetActor sets the given actor to this operation.
+func (e *TreeStyle) SetActor(actorID *time.ActorID) {
+	e.executedAt = e.executedAt.SetActorID(actorID)
+}
+
+// WallTime returns the wall-clock time when this operation was created.
+func (e *TreeStyle) WallTime() int64 {
+	return e.wallTime
+}
+
+// SetWallTime sets the wall-clock time when this operation was created.
+func (e *TreeStyle) SetWallTime(wallTime int64) {
+	e.wallTime = wallTime
+}
+
+// ID returns the ID of this operation given by the client.
+func (e *TreeStyle) ID() string {
+	return e.id
+}
+
+// SetID sets the ID of this operation given by the client.
+func (e *TreeStyle) SetID(id string) {
+	e.id = id
+}
+
+// ParentCreatedAt returns the creation time of the Tree.
+func (e *TreeStyle) ParentCreatedAt() *time.Ticket {
+	return e.parentCreatedAt
+}
+
+// CreatedAtMapByActor returns the map that stores the latest creation time
+// by actor for the nodes included in the range.
+func (e *TreeStyle) CreatedAtMapByActor() map[string]*time.Ticket {
+	return e.latestCreatedAtMapByActor
+}
+
+// Attributes returns the attributes of this operation.
+func (e *TreeStyle) Attributes() map[string]string {
+	return e.attributes
+}
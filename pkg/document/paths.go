@@ -0,0 +1,124 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+)
+
+// ApplyChangesWithPaths applies remote changes to the document like
+// ApplyChanges, and returns the paths of the elements changed by the changes
+// and the paths of the elements removed by the changes.
+func (d *InternalDocument) ApplyChangesWithPaths(changes ...*change.Change) ([]string, []string, error) {
+	var changedPaths, removedPaths []string
+	for _, c := range changes {
+		for _, op := range c.Operations() {
+			changed, removed, err := executeWithPaths(d.root, op)
+			if err != nil {
+				return nil, nil, err
+			}
+			changedPaths = appendPaths(changedPaths, changed...)
+			removedPaths = appendPaths(removedPaths, removed...)
+		}
+		d.changeID = d.changeID.SyncLamport(c.ID().Lamport())
+	}
+
+	return changedPaths, removedPaths, nil
+}
+
+// executeWithPaths executes the given operation and returns the paths of the
+// elements changed and removed by it. Operations on elements that are not
+// reachable from the root do not change any path.
+func executeWithPaths(root *json.Root, op operations.Operation) ([]string, []string, error) {
+	parentPath, ok := root.FindPath(op.ParentCreatedAt())
+	if !ok {
+		return nil, nil, op.Execute(root)
+	}
+
+	switch op := op.(type) {
+	case *operations.Set:
+		path := json.JoinPath(parentPath, op.Key())
+		parent, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*json.Object)
+		if !ok {
+			return nil, nil, operations.ErrNotApplicableDataType
+		}
+
+		prev := parent.Get(op.Key())
+		if err := op.Execute(root); err != nil {
+			return nil, nil, err
+		}
+
+		if prev != nil && parent.Get(op.Key()) != prev {
+			return []string{path}, []string{path}, nil
+		}
+		return []string{path}, nil, nil
+	case *operations.Remove:
+		path, ok := root.FindPath(op.CreatedAt())
+		if err := op.Execute(root); err != nil {
+			return nil, nil, err
+		}
+
+		if elem := root.FindByCreatedAt(op.CreatedAt()); ok && elem != nil && elem.RemovedAt() != nil {
+			return []string{path}, []string{path}, nil
+		}
+		return nil, nil, nil
+	case *operations.Clear:
+		parent, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(json.Container)
+		if !ok {
+			return nil, nil, operations.ErrNotApplicableDataType
+		}
+
+		children := json.ChildPaths(parent, parentPath)
+		if err := op.Execute(root); err != nil {
+			return nil, nil, err
+		}
+
+		var removed []string
+		for path, child := range children {
+			if child.RemovedAt() != nil {
+				removed = append(removed, path)
+			}
+		}
+		return []string{parentPath}, removed, nil
+	case *operations.Select:
+		return nil, nil, op.Execute(root)
+	default:
+		if err := op.Execute(root); err != nil {
+			return nil, nil, err
+		}
+		return []string{parentPath}, nil, nil
+	}
+}
+
+// appendPaths appends the given paths to the given slice except duplicates.
+func appendPaths(paths []string, others ...string) []string {
+	for _, other := range others {
+		duplicated := false
+		for _, path := range paths {
+			if path == other {
+				duplicated = true
+				break
+			}
+		}
+		if !duplicated {
+			paths = append(paths, other)
+		}
+	}
+	return paths
+}
@@ -125,6 +125,15 @@ func (p *ArrayProxy) AddDate(values ...gotime.Time) *ArrayProxy {
 	return p
 }
 
+// AddNewObject adds a new object at the last.
+func (p *ArrayProxy) AddNewObject() *ObjectProxy {
+	v := p.addInternal(func(ticket *time.Ticket) json.Element {
+		return NewObjectProxy(p.context, json.NewObject(json.NewRHTPriorityQueueMap(), ticket))
+	})
+
+	return v.(*ObjectProxy)
+}
+
 // AddNewArray adds a new array at the last.
 func (p *ArrayProxy) AddNewArray() *ArrayProxy {
 	v := p.addInternal(func(ticket *time.Ticket) json.Element {
@@ -166,6 +175,20 @@ func (p *ArrayProxy) Delete(idx int) json.Element {
 	return deleted
 }
 
+// Clear removes all the elements of this Array.
+func (p *ArrayProxy) Clear() *ArrayProxy {
+	ticket := p.context.IssueTimeTicket()
+	removed := p.Array.Clear(ticket)
+	p.context.Push(operations.NewClear(
+		p.CreatedAt(),
+		ticket,
+	))
+	for _, elem := range removed {
+		p.context.RegisterRemovedElementPair(p, elem)
+	}
+	return p
+}
+
 // Len returns length of this Array.
 func (p *ArrayProxy) Len() int {
 	return p.Array.Len()
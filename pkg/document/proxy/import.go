@@ -0,0 +1,120 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package proxy
+
+import (
+	"bytes"
+	gojson "encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// ErrInvalidJSONObject is returned when the given content to import is not a
+// JSON object.
+var ErrInvalidJSONObject = errors.New("invalid JSON object")
+
+// ParseJSONObject parses the given content as a JSON object. Numbers are kept
+// as gojson.Number so that integers are imported without losing precision.
+func ParseJSONObject(content []byte) (map[string]interface{}, error) {
+	decoder := gojson.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+
+	var values map[string]interface{}
+	if err := decoder.Decode(&values); err != nil {
+		return nil, fmt.Errorf("%s: %w", err.Error(), ErrInvalidJSONObject)
+	}
+	if values == nil || decoder.More() {
+		return nil, ErrInvalidJSONObject
+	}
+
+	return values, nil
+}
+
+// Import sets the fields of the given JSON object to this Object. Nested
+// objects and arrays are created as Object and Array, and the keys are set in
+// sorted order so that the same content always produces the same operations.
+func (p *ObjectProxy) Import(content []byte) error {
+	values, err := ParseJSONObject(content)
+	if err != nil {
+		return err
+	}
+
+	p.importValues(values)
+	return nil
+}
+
+func (p *ObjectProxy) importValues(values map[string]interface{}) {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		switch v := values[k].(type) {
+		case map[string]interface{}:
+			p.SetNewObject(k).importValues(v)
+		case []interface{}:
+			p.SetNewArray(k).importValues(v)
+		case string:
+			p.SetString(k, v)
+		case bool:
+			p.SetBool(k, v)
+		case gojson.Number:
+			if i, err := v.Int64(); err == nil {
+				if math.MinInt32 <= i && i <= math.MaxInt32 {
+					p.SetInteger(k, int(i))
+				} else {
+					p.SetLong(k, i)
+				}
+			} else if f, err := v.Float64(); err == nil {
+				p.SetDouble(k, f)
+			}
+		case nil:
+			p.SetNull(k)
+		}
+	}
+}
+
+func (p *ArrayProxy) importValues(values []interface{}) {
+	for _, value := range values {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			p.AddNewObject().importValues(v)
+		case []interface{}:
+			p.AddNewArray().importValues(v)
+		case string:
+			p.AddString(v)
+		case bool:
+			p.AddBool(v)
+		case gojson.Number:
+			if i, err := v.Int64(); err == nil {
+				if math.MinInt32 <= i && i <= math.MaxInt32 {
+					p.AddInteger(int(i))
+				} else {
+					p.AddLong(i)
+				}
+			} else if f, err := v.Float64(); err == nil {
+				p.AddDouble(f)
+			}
+		case nil:
+			p.AddNull()
+		}
+	}
+}
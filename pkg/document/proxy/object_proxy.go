@@ -81,6 +81,18 @@ func (p *ObjectProxy) SetNewRichText(k string) *RichTextProxy {
 	return v.(*RichTextProxy)
 }
 
+// SetNewTree sets a new Tree for the given key.
+func (p *ObjectProxy) SetNewTree(k string) *TreeProxy {
+	v := p.setInternal(k, func(ticket *time.Ticket) json.Element {
+		return NewTreeProxy(
+			p.context,
+			json.NewInitialTree(ticket),
+		)
+	})
+
+	return v.(*TreeProxy)
+}
+
 // SetNewCounter sets a new NewCounter for the given key.
 func (p *ObjectProxy) SetNewCounter(k string, n interface{}) *CounterProxy {
 	v := p.setInternal(k, func(ticket *time.Ticket) json.Element {
@@ -182,6 +194,20 @@ func (p *ObjectProxy) Delete(k string) json.Element {
 	return deleted
 }
 
+// Clear removes all the members of this Object.
+func (p *ObjectProxy) Clear() *ObjectProxy {
+	ticket := p.context.IssueTimeTicket()
+	removed := p.Object.Clear(ticket)
+	p.context.Push(operations.NewClear(
+		p.CreatedAt(),
+		ticket,
+	))
+	for _, elem := range removed {
+		p.context.RegisterRemovedElementPair(p, elem)
+	}
+	return p
+}
+
 // GetObject returns Object of the given key.
 func (p *ObjectProxy) GetObject(k string) *ObjectProxy {
 	elem := p.Object.Get(k)
@@ -250,6 +276,23 @@ func (p *ObjectProxy) GetRichText(k string) *RichTextProxy {
 	}
 }
 
+// GetTree returns TreeProxy of the given key.
+func (p *ObjectProxy) GetTree(k string) *TreeProxy {
+	elem := p.Object.Get(k)
+	if elem == nil {
+		return nil
+	}
+
+	switch elem := p.Object.Get(k).(type) {
+	case *json.Tree:
+		return NewTreeProxy(p.context, elem)
+	case *TreeProxy:
+		return elem
+	default:
+		panic("unsupported type")
+	}
+}
+
 // GetCounter returns CounterProxy of the given key.
 func (p *ObjectProxy) GetCounter(k string) *CounterProxy {
 	elem := p.Object.Get(k)
@@ -274,18 +317,20 @@ func (p *ObjectProxy) setInternal(
 	ticket := p.context.IssueTimeTicket()
 	proxy := creator(ticket)
 	value := toOriginal(proxy)
+	generation := p.NextGeneration(k)
 
 	p.context.Push(operations.NewSet(
 		p.CreatedAt(),
 		k,
 		value.DeepCopy(),
+		generation,
 		ticket,
 	))
 
-	removed := p.Set(k, value)
+	removed, _ := p.SetWithGeneration(k, value, generation)
 	p.context.RegisterElement(value)
-	if removed != nil {
-		p.context.RegisterRemovedElementPair(p, removed)
+	for _, elem := range removed {
+		p.context.RegisterRemovedElementPair(p, elem)
 	}
 
 	return proxy
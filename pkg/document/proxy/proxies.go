@@ -32,6 +32,8 @@ func toOriginal(elem json.Element) json.Element {
 		return elem.RichText
 	case *CounterProxy:
 		return elem.Counter
+	case *TreeProxy:
+		return elem.Tree
 	case *json.Primitive:
 		return elem
 	}
@@ -0,0 +1,161 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package proxy
+
+import (
+	"sort"
+
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// Restore replaces the members of this Object with the copies of the members
+// of the given Object. The copies are created with new tickets, so the given
+// Object can be the one of another replica of the document, e.g. a snapshot.
+func (p *ObjectProxy) Restore(obj *json.Object) {
+	for _, k := range sortedKeys(p.Members()) {
+		if !obj.Has(k) {
+			p.Delete(k)
+		}
+	}
+
+	members := obj.Members()
+	for _, k := range sortedKeys(members) {
+		k := k
+		restoreElement(p.context, members[k], func(creator func(ticket *time.Ticket) json.Element) json.Element {
+			return p.setInternal(k, creator)
+		})
+	}
+}
+
+// restore appends the copies of the elements of the given Array to this Array.
+func (p *ArrayProxy) restore(arr *json.Array) {
+	for _, elem := range arr.Elements() {
+		restoreElement(p.context, elem, p.addInternal)
+	}
+}
+
+// restoreElement creates the copy of the given element with the given setter
+// and fills it with the copies of the descendants of the element.
+func restoreElement(
+	ctx *change.Context,
+	elem json.Element,
+	set func(creator func(ticket *time.Ticket) json.Element) json.Element,
+) {
+	switch elem := elem.(type) {
+	case *json.Object:
+		obj := set(func(ticket *time.Ticket) json.Element {
+			return NewObjectProxy(ctx, json.NewObject(json.NewRHTPriorityQueueMap(), ticket))
+		})
+		obj.(*ObjectProxy).Restore(elem)
+	case *json.Array:
+		arr := set(func(ticket *time.Ticket) json.Element {
+			return NewArrayProxy(ctx, json.NewArray(json.NewRGATreeList(), ticket))
+		})
+		arr.(*ArrayProxy).restore(elem)
+	case *json.Primitive:
+		set(func(ticket *time.Ticket) json.Element {
+			return json.NewPrimitive(elem.Value(), ticket)
+		})
+	case *json.Counter:
+		set(func(ticket *time.Ticket) json.Element {
+			return NewCounterProxy(ctx, json.NewCounter(
+				json.CounterValueFromBytes(elem.ValueType(), elem.Bytes()),
+				ticket,
+			))
+		})
+	case *json.Text:
+		text := set(func(ticket *time.Ticket) json.Element {
+			return NewTextProxy(ctx, json.NewText(json.NewRGATreeSplit(json.InitialTextNode()), ticket))
+		})
+		if content := elem.String(); content != "" {
+			text.(*TextProxy).Edit(0, 0, content)
+		}
+	case *json.RichText:
+		text := set(func(ticket *time.Ticket) json.Element {
+			return NewRichTextProxy(
+				ctx,
+				json.NewInitialRichText(json.NewRGATreeSplit(json.InitialRichTextNode()), ticket),
+			)
+		})
+
+		// NOTE: The last line of RichText is created with the RichText itself,
+		// so it is skipped to avoid copying it twice.
+		offset := 0
+		for _, node := range elem.Nodes() {
+			if node.RemovedAt() != nil || node.Len() == 0 ||
+				node.ID().CreatedAt().Compare(elem.CreatedAt()) == 0 {
+				continue
+			}
+
+			value := node.Value()
+			var attrs map[string]string
+			if value.Attrs() != nil && len(value.Attrs().Elements()) > 0 {
+				attrs = value.Attrs().Elements()
+			}
+			text.(*RichTextProxy).Edit(offset, offset, value.Value(), attrs)
+			offset += node.Len()
+		}
+	case *json.Tree:
+		tree := set(func(ticket *time.Ticket) json.Element {
+			return NewTreeProxy(ctx, json.NewInitialTree(ticket))
+		})
+		if children := toTreeNodes(elem.Root().Children()); len(children) > 0 {
+			tree.(*TreeProxy).Edit(nil, 0, 0, children...)
+		}
+	}
+}
+
+// toTreeNodes converts the given live nodes of Tree to TreeNodes.
+func toTreeNodes(nodes []*json.TreeNode) []TreeNode {
+	var contents []TreeNode
+	for _, node := range nodes {
+		if node.RemovedAt() != nil {
+			continue
+		}
+
+		if node.IsText() {
+			contents = append(contents, TreeNode{
+				Type:  node.Type(),
+				Value: node.Value(),
+			})
+			continue
+		}
+
+		content := TreeNode{
+			Type:     node.Type(),
+			Children: toTreeNodes(node.Children()),
+		}
+		if node.Attrs() != nil && len(node.Attrs().Elements()) > 0 {
+			content.Attributes = node.Attrs().Elements()
+		}
+		contents = append(contents, content)
+	}
+	return contents
+}
+
+// sortedKeys returns the keys of the given members in sorted order so that
+// the same content always produces the same operations.
+func sortedKeys(members map[string]json.Element) []string {
+	keys := make([]string, 0, len(members))
+	for k := range members {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
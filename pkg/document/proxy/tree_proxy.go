@@ -0,0 +1,126 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package proxy
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+)
+
+// TreeNode is a node to be inserted into Tree. A text node has the type
+// "text" and the value, and an element node has the type, the attributes and
+// the children.
+type TreeNode struct {
+	Type       string
+	Value      string
+	Attributes map[string]string
+	Children   []TreeNode
+}
+
+// TreeProxy is a proxy representing Tree.
+type TreeProxy struct {
+	*json.Tree
+	context *change.Context
+}
+
+// NewTreeProxy creates a new instance of TreeProxy.
+func NewTreeProxy(ctx *change.Context, tree *json.Tree) *TreeProxy {
+	return &TreeProxy{
+		Tree:    tree,
+		context: ctx,
+	}
+}
+
+// Edit replaces the children of the node of the given path in the given
+// range with the given contents.
+func (p *TreeProxy) Edit(path []int, from, to int, contents ...TreeNode) *TreeProxy {
+	fromPos, toPos, err := p.Tree.CreateRange(path, from, to)
+	if err != nil {
+		panic(err)
+	}
+
+	ticket := p.context.IssueTimeTicket()
+
+	var nodes []*json.TreeNode
+	for _, content := range contents {
+		nodes = append(nodes, p.createNode(content))
+	}
+
+	maxCreationMapByActor, err := p.Tree.Edit(
+		fromPos,
+		toPos,
+		nil,
+		nodes,
+		ticket,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	p.context.Push(operations.NewTreeEdit(
+		p.CreatedAt(),
+		fromPos,
+		toPos,
+		maxCreationMapByActor,
+		nodes,
+		ticket,
+	))
+	if !fromPos.Equal(toPos) {
+		p.context.RegisterTextElementWithGarbage(p)
+	}
+
+	return p
+}
+
+// Style applies the given attributes to the children of the node of the
+// given path in the given range.
+func (p *TreeProxy) Style(path []int, from, to int, attributes map[string]string) *TreeProxy {
+	fromPos, toPos, err := p.Tree.CreateRange(path, from, to)
+	if err != nil {
+		panic(err)
+	}
+
+	ticket := p.context.IssueTimeTicket()
+	maxCreationMapByActor, err := p.Tree.Style(fromPos, toPos, nil, attributes, ticket)
+	if err != nil {
+		panic(err)
+	}
+
+	p.context.Push(operations.NewTreeStyle(
+		p.CreatedAt(),
+		fromPos,
+		toPos,
+		maxCreationMapByActor,
+		attributes,
+		ticket,
+	))
+
+	return p
+}
+
+func (p *TreeProxy) createNode(content TreeNode) *json.TreeNode {
+	ticket := p.context.IssueTimeTicket()
+	node := json.NewTreeNode(ticket, content.Type, content.Value)
+	for key, value := range content.Attributes {
+		node.Attrs().Set(key, value, ticket)
+	}
+	for _, child := range content.Children {
+		node.Append(p.createNode(child))
+	}
+	return node
+}
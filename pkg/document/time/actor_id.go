@@ -25,29 +25,25 @@ import (
 	"math"
 )
 
-const actorIDSize = 12
+const (
+	// DefaultActorIDSize is the default length of ActorID in bytes. It is the
+	// length of the IDs of clients, which are used as their ActorIDs.
+	DefaultActorIDSize = 12
+
+	// MinActorIDSize is the minimum length of ActorID in bytes.
+	MinActorIDSize = 8
+
+	// MaxActorIDSize is the maximum length of ActorID in bytes.
+	MaxActorIDSize = 16
+)
 
 var (
 	// InitialActorID represents the initial value of ActorID.
 	InitialActorID = &ActorID{}
 
-	// MaxActorID represents the maximum value of ActorID.
-	MaxActorID = &ActorID{
-		bytes: [actorIDSize]byte{
-			math.MaxUint8,
-			math.MaxUint8,
-			math.MaxUint8,
-			math.MaxUint8,
-			math.MaxUint8,
-			math.MaxUint8,
-			math.MaxUint8,
-			math.MaxUint8,
-			math.MaxUint8,
-			math.MaxUint8,
-			math.MaxUint8,
-			math.MaxUint8,
-		},
-	}
+	// MaxActorID represents the maximum value of ActorID. It has the maximum
+	// length so that it is greater than ActorIDs of any length.
+	MaxActorID = newMaxActorID()
 
 	// ErrInvalidHexString is returned when the given string is not valid hex.
 	ErrInvalidHexString = errors.New("invalid hex string")
@@ -59,11 +55,29 @@ var (
 // ActorID is bytes represented by the hexadecimal string.
 // It should be generated by unique value.
 type ActorID struct {
-	bytes [actorIDSize]byte
+	bytes [MaxActorIDSize]byte
+
+	// size is the length of the ID in bytes. Zero means DefaultActorIDSize,
+	// so that the zero value is the initial ActorID of the default length.
+	size int
 
 	cachedString string
 }
 
+func newMaxActorID() *ActorID {
+	id := &ActorID{size: MaxActorIDSize}
+	for i := range id.bytes {
+		id.bytes[i] = math.MaxUint8
+	}
+	return id
+}
+
+// isValidActorIDSize returns whether the given length of ActorID in bytes is
+// in the range.
+func isValidActorIDSize(size int) bool {
+	return size >= MinActorIDSize && size <= MaxActorIDSize
+}
+
 // ActorIDFromHex returns the bytes represented by the hexadecimal string str.
 func ActorIDFromHex(str string) (*ActorID, error) {
 	actorID := &ActorID{}
@@ -77,11 +91,11 @@ func ActorIDFromHex(str string) (*ActorID, error) {
 		return actorID, fmt.Errorf("%s: %w", str, ErrInvalidHexString)
 	}
 
-	if len(decoded) != actorIDSize {
+	if !isValidActorIDSize(len(decoded)) {
 		return actorID, fmt.Errorf("decoded length %d: %w", len(decoded), ErrInvalidHexString)
 	}
 
-	copy(actorID.bytes[:], decoded[:actorIDSize])
+	actorID.size = copy(actorID.bytes[:], decoded)
 	return actorID, nil
 }
 
@@ -93,11 +107,11 @@ func ActorIDFromBytes(bytes []byte) (*ActorID, error) {
 		return actorID, fmt.Errorf("bytes length %d: %w", len(bytes), ErrInvalidActorID)
 	}
 
-	if len(bytes) != actorIDSize {
+	if !isValidActorIDSize(len(bytes)) {
 		return actorID, fmt.Errorf("bytes length %d: %w", len(bytes), ErrInvalidActorID)
 	}
 
-	copy(actorID.bytes[:], bytes)
+	actorID.size = copy(actorID.bytes[:], bytes)
 	return actorID, nil
 }
 
@@ -105,7 +119,7 @@ func ActorIDFromBytes(bytes []byte) (*ActorID, error) {
 // If the receiver is nil, it would return empty string.
 func (id *ActorID) String() string {
 	if id.cachedString == "" {
-		id.cachedString = hex.EncodeToString(id.bytes[:])
+		id.cachedString = hex.EncodeToString(id.Bytes())
 	}
 
 	return id.cachedString
@@ -114,34 +128,65 @@ func (id *ActorID) String() string {
 // Bytes returns the bytes of ActorID itself.
 // If the receiver is nil, it would return empty array of byte.
 func (id *ActorID) Bytes() []byte {
-	return id.bytes[:]
+	return id.bytes[:id.Size()]
+}
+
+// Size returns the length of ActorID in bytes.
+func (id *ActorID) Size() int {
+	if id.size == 0 {
+		return DefaultActorIDSize
+	}
+	return id.size
 }
 
 // Compare returns an integer comparing two ActorID lexicographically.
 // The result will be 0 if id==other, -1 if id < other, and +1 if id > other.
 // If the receiver or argument is nil, it would panic at runtime.
+//
+// NOTE: ActorIDs of different lengths are compared byte by byte and the
+// shorter one is smaller if it is a prefix of the other, so that ActorIDs of
+// any lengths are in a total order.
 func (id *ActorID) Compare(other *ActorID) int {
-	return bytes.Compare(id.bytes[:], other.bytes[:])
+	return bytes.Compare(id.Bytes(), other.Bytes())
 }
 
 // MarshalJSON ensures that when calling json.Marshal(),
 // it is marshaled including private field.
+//
+// NOTE: The bytes are marshaled as an array of numbers, not as a base64
+// string, to keep the format of ActorIDs of the default length.
 func (id *ActorID) MarshalJSON() ([]byte, error) {
-	return json.Marshal(&struct{ Bytes [actorIDSize]byte }{
-		Bytes: id.bytes,
+	values := make([]int, id.Size())
+	for i, b := range id.Bytes() {
+		values[i] = int(b)
+	}
+
+	return json.Marshal(&struct{ Bytes []int }{
+		Bytes: values,
 	})
 }
 
 // UnmarshalJSON ensures that when calling json.Unmarshal(),
 // it is unmarshalled including private field.
 func (id *ActorID) UnmarshalJSON(bytes []byte) error {
-	temp := &(struct{ Bytes [actorIDSize]byte }{
-		Bytes: id.bytes,
-	})
+	temp := &struct{ Bytes []int }{}
 	if err := json.Unmarshal(bytes, temp); err != nil {
 		return err
 	}
+	if !isValidActorIDSize(len(temp.Bytes)) {
+		return fmt.Errorf("bytes length %d: %w", len(temp.Bytes), ErrInvalidActorID)
+	}
+
+	decoded := make([]byte, len(temp.Bytes))
+	for i, v := range temp.Bytes {
+		if v < 0 || v > math.MaxUint8 {
+			return fmt.Errorf("byte %d: %w", v, ErrInvalidActorID)
+		}
+		decoded[i] = byte(v)
+	}
 
-	id.bytes = temp.Bytes
+	id.bytes = [MaxActorIDSize]byte{}
+	id.size = copy(id.bytes[:], decoded)
+	id.cachedString = ""
 	return nil
 }
@@ -3,6 +3,7 @@ package time_test
 import (
 	"crypto/rand"
 	"encoding/hex"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -56,4 +57,49 @@ func TestActorID(t *testing.T) {
 		_, err := time.ActorIDFromBytes(invalidBytes)
 		assert.ErrorIs(t, err, time.ErrInvalidActorID)
 	})
+	t.Run("get ActorID of various lengths test", func(t *testing.T) {
+		for _, size := range []int{time.MinActorIDSize, time.DefaultActorIDSize, time.MaxActorIDSize} {
+			bytes := make([]byte, size)
+			_, err := rand.Read(bytes)
+			assert.NoError(t, err)
+
+			actorID, err := time.ActorIDFromBytes(bytes)
+			assert.NoError(t, err)
+			assert.Equal(t, size, actorID.Size())
+			assert.Equal(t, hex.EncodeToString(bytes), actorID.String())
+		}
+
+		_, err := time.ActorIDFromBytes(make([]byte, time.MaxActorIDSize+1))
+		assert.ErrorIs(t, err, time.ErrInvalidActorID)
+	})
+
+	t.Run("compare ActorIDs of different lengths test", func(t *testing.T) {
+		short, _ := time.ActorIDFromHex("0123456789abcdef")
+		long, _ := time.ActorIDFromHex("0123456789abcdef00000000")
+		other, _ := time.ActorIDFromHex("0123456789abcdf0")
+
+		assert.Equal(t, -1, short.Compare(long))
+		assert.Equal(t, 1, long.Compare(short))
+		assert.Equal(t, -1, long.Compare(other))
+		assert.Equal(t, 1, other.Compare(short))
+		assert.Equal(t, -1, time.InitialActorID.Compare(short))
+		assert.Equal(t, 1, time.MaxActorID.Compare(long))
+	})
+
+	t.Run("marshal ActorID to JSON test", func(t *testing.T) {
+		actorID, _ := time.ActorIDFromHex("0123456789abcdef01234567")
+		bytes, err := json.Marshal(actorID)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"Bytes":[1,35,69,103,137,171,205,239,1,35,69,103]}`, string(bytes))
+
+		for _, hexString := range []string{"0123456789abcdef", "0123456789abcdef01234567"} {
+			expected, _ := time.ActorIDFromHex(hexString)
+			bytes, err := json.Marshal(expected)
+			assert.NoError(t, err)
+
+			actual := &time.ActorID{}
+			assert.NoError(t, json.Unmarshal(bytes, actual))
+			assert.Equal(t, expected.String(), actual.String())
+		}
+	})
 }
@@ -73,8 +73,9 @@ func NewTicket(
 // AnnotatedString returns a string containing the metadata of the ticket
 // for debugging purpose.
 func (t *Ticket) AnnotatedString() string {
+	actorID := t.actorID.String()
 	return fmt.Sprintf(
-		"%d:%d:%s", t.lamport, t.delimiter, t.actorID.String()[22:24],
+		"%d:%d:%s", t.lamport, t.delimiter, actorID[len(actorID)-2:],
 	)
 }
 
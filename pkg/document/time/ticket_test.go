@@ -55,4 +55,17 @@ func TestTicket(t *testing.T) {
 
 		assert.False(t, before.After(before))
 	})
+	t.Run("ticket comparing with actors of different lengths test", func(t *testing.T) {
+		shortActorID, _ := time.ActorIDFromHex("0123456789abcdef")
+		longActorID, _ := time.ActorIDFromHex("0123456789abcdef01234567")
+
+		short := time.NewTicket(0, 0, shortActorID)
+		long := time.NewTicket(0, 0, longActorID)
+		assert.Equal(t, -1, short.Compare(long))
+		assert.Equal(t, 1, long.Compare(short))
+		assert.Equal(t, 0, short.Compare(time.NewTicket(0, 0, shortActorID)))
+
+		assert.Equal(t, "0:0:ef", short.AnnotatedString())
+		assert.True(t, time.MaxTicket.After(long))
+	})
 }
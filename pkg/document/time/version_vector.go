@@ -0,0 +1,58 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package time
+
+// VersionVector is the map of the largest Lamport timestamps of the changes
+// of each actor. The keys are the hexadecimal strings of the actor IDs.
+type VersionVector map[string]uint64
+
+// NewVersionVector creates a new instance of VersionVector.
+func NewVersionVector() VersionVector {
+	return make(VersionVector)
+}
+
+// Get returns the largest Lamport timestamp of the given actor.
+func (v VersionVector) Get(actorID *ActorID) uint64 {
+	return v[actorID.String()]
+}
+
+// Set records the given Lamport timestamp of the given actor if it is larger
+// than the recorded one.
+func (v VersionVector) Set(actorID *ActorID, lamport uint64) {
+	key := actorID.String()
+	if lamport > v[key] {
+		v[key] = lamport
+	}
+}
+
+// Merge records the Lamport timestamps of the given vector.
+func (v VersionVector) Merge(other VersionVector) {
+	for key, lamport := range other {
+		if lamport > v[key] {
+			v[key] = lamport
+		}
+	}
+}
+
+// DeepCopy copies itself deeply.
+func (v VersionVector) DeepCopy() VersionVector {
+	vector := make(VersionVector, len(v))
+	for key, lamport := range v {
+		vector[key] = lamport
+	}
+	return vector
+}
@@ -18,6 +18,9 @@ package admin
 
 import (
 	"context"
+	"sort"
+
+	protoTypes "github.com/gogo/protobuf/types"
 
 	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/api/converter"
@@ -73,3 +76,31 @@ func (s *clusterServer) BroadcastEvent(
 
 	return &api.BroadcastEventResponse{}, nil
 }
+
+// ListMembers returns the members of the cluster with their last heartbeat
+// times and versions.
+func (s *clusterServer) ListMembers(
+	_ context.Context,
+	_ *api.ListMembersRequest,
+) (*api.ListMembersResponse, error) {
+	var members []*api.Member
+	for _, info := range s.backend.Members() {
+		updatedAt, err := protoTypes.TimestampProto(info.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, &api.Member{
+			Id:          info.ID,
+			Hostname:    info.Hostname,
+			ClusterAddr: info.ClusterAddr,
+			Version:     info.Version,
+			UpdatedAt:   updatedAt,
+		})
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Id < members[j].Id
+	})
+
+	return &api.ListMembersResponse{Members: members}, nil
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interceptors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/yorkie-team/yorkie/server/backend"
+)
+
+// writeMethods are the methods of the admin service which modify projects or
+// documents, so they are rejected in maintenance mode.
+var writeMethods = map[string]bool{
+	"/api.Admin/CreateProject":              true,
+	"/api.Admin/UpdateProject":              true,
+	"/api.Admin/RemoveDocumentsByPrefix":    true,
+	"/api.Admin/RollbackDocument":           true,
+	"/api.Admin/ResetDocument":              true,
+	"/api.Admin/CreateDocumentFromTemplate": true,
+	"/api.Admin/CreateDocumentByAdmin":      true,
+	"/api.Admin/LockDocument":               true,
+	"/api.Admin/UnlockDocument":             true,
+	"/api.Admin/MoveDocument":               true,
+	"/api.Admin/UnarchiveDocument":          true,
+	"/api.Admin/SetDocumentMetadata":        true,
+	"/api.Admin/TransferDocumentOwnership":  true,
+}
+
+// MaintenanceInterceptor is an interceptor which rejects the writes of the
+// admin service while the server is in maintenance mode.
+type MaintenanceInterceptor struct {
+	backend *backend.Backend
+}
+
+// NewMaintenanceInterceptor creates a new instance of MaintenanceInterceptor.
+func NewMaintenanceInterceptor(be *backend.Backend) *MaintenanceInterceptor {
+	return &MaintenanceInterceptor{backend: be}
+}
+
+// Unary creates a unary server interceptor for maintenance.
+func (i *MaintenanceInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if writeMethods[info.FullMethod] {
+			if err := i.backend.Maintenance.Check(); err != nil {
+				return nil, err
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -21,7 +21,11 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"os"
+	"strconv"
+	gotime "time"
 
+	protoTypes "github.com/gogo/protobuf/types"
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
 
@@ -29,6 +33,7 @@ import (
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/server/admin/interceptors"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/documents"
@@ -38,16 +43,49 @@ import (
 	"github.com/yorkie-team/yorkie/server/projects"
 )
 
-// ErrInvalidAdminPort occurs when the port in the config is invalid.
-var ErrInvalidAdminPort = errors.New("invalid port number for Admin server")
+var (
+	// ErrInvalidAdminPort occurs when the port in the config is invalid.
+	ErrInvalidAdminPort = errors.New("invalid port number for Admin server")
+
+	// ErrInvalidAdminListener occurs when both or neither of the port and the
+	// socket path are given in the config.
+	ErrInvalidAdminListener = errors.New("either port or socket path must be given for Admin server")
+)
+
+// socketFileMode is the permission of the Unix domain socket file, which
+// allows only the owner and the group of the server to connect to it.
+const socketFileMode = 0660
+
+// defaultRejectionsPerSecond is the number of the rejections delivered to a
+// watcher per second if the watcher does not specify it.
+const defaultRejectionsPerSecond = 10
 
 // Config is the configuration for creating a Server.
 type Config struct {
+	// Port is the port number for the admin server.
 	Port int `yaml:"Port"`
+
+	// SocketPath is the path of the Unix domain socket for the admin server.
+	// If it is given, the server listens on the socket instead of the port,
+	// so that the admin service is not exposed to the network.
+	SocketPath string `yaml:"SocketPath"`
 }
 
-// Validate validates the port number.
+// Validate validates that exactly one of the port and the socket path is
+// given, and the port number.
 func (c *Config) Validate() error {
+	if c.SocketPath != "" {
+		if c.Port != 0 {
+			return fmt.Errorf(
+				"port %d and socket path %s are given: %w",
+				c.Port,
+				c.SocketPath,
+				ErrInvalidAdminListener,
+			)
+		}
+		return nil
+	}
+
 	if c.Port < 1 || 65535 < c.Port {
 		return fmt.Errorf("must be between 1 and 65535, given %d: %w", c.Port, ErrInvalidAdminPort)
 	}
@@ -65,17 +103,22 @@ type Server struct {
 // NewServer creates a new Server.
 func NewServer(conf *Config, be *backend.Backend) *Server {
 	loggingInterceptor := grpchelper.NewLoggingInterceptor()
+	dbHealthInterceptor := grpchelper.NewDBHealthInterceptor(be)
 	defaultInterceptor := interceptors.NewDefaultInterceptor()
+	maintenanceInterceptor := interceptors.NewMaintenanceInterceptor(be)
 
 	opts := []grpc.ServerOption{
 		grpc.UnaryInterceptor(grpcmiddleware.ChainUnaryServer(
 			loggingInterceptor.Unary(),
 			be.Metrics.ServerMetrics().UnaryServerInterceptor(),
+			dbHealthInterceptor.Unary(),
 			defaultInterceptor.Unary(),
+			maintenanceInterceptor.Unary(),
 		)),
 		grpc.StreamInterceptor(grpcmiddleware.ChainStreamServer(
 			loggingInterceptor.Stream(),
 			be.Metrics.ServerMetrics().StreamServerInterceptor(),
+			dbHealthInterceptor.Stream(),
 			defaultInterceptor.Stream(),
 		)),
 	}
@@ -96,11 +139,24 @@ func NewServer(conf *Config, be *backend.Backend) *Server {
 	return server
 }
 
-// Start starts this server by opening the rpc port.
+// Start starts this server by opening the rpc port, or the Unix domain
+// socket if the socket path is given.
 func (s *Server) Start() error {
+	if s.conf.SocketPath != "" {
+		return s.listenAndServeUnix()
+	}
+
 	return s.listenAndServeGRPC()
 }
 
+// StartWithListener starts this server on the given listener instead of
+// opening the port, e.g. an in-memory listener for tests or a Unix domain
+// socket. The listener is closed when the server is shut down.
+func (s *Server) StartWithListener(lis net.Listener) error {
+	s.serveGRPC(lis)
+	return nil
+}
+
 // Shutdown shuts down this server.
 func (s *Server) Shutdown(graceful bool) {
 	if graceful {
@@ -108,6 +164,12 @@ func (s *Server) Shutdown(graceful bool) {
 	} else {
 		s.grpcServer.Stop()
 	}
+
+	if s.conf.SocketPath != "" {
+		if err := os.Remove(s.conf.SocketPath); err != nil && !os.IsNotExist(err) {
+			logging.DefaultLogger().Error(err)
+		}
+	}
 }
 
 // GRPCServer returns the gRPC server.
@@ -122,8 +184,40 @@ func (s *Server) listenAndServeGRPC() error {
 		return err
 	}
 
+	s.serveGRPC(lis)
+	return nil
+}
+
+// listenAndServeUnix listens on the Unix domain socket of the socket path.
+// The socket file left by the previous run which was not shut down cleanly
+// is removed before listening.
+func (s *Server) listenAndServeUnix() error {
+	if info, err := os.Stat(s.conf.SocketPath); err == nil && info.Mode()&os.ModeSocket != 0 {
+		if err := os.Remove(s.conf.SocketPath); err != nil {
+			logging.DefaultLogger().Error(err)
+			return err
+		}
+	}
+
+	lis, err := net.Listen("unix", s.conf.SocketPath)
+	if err != nil {
+		logging.DefaultLogger().Error(err)
+		return err
+	}
+
+	if err := os.Chmod(s.conf.SocketPath, socketFileMode); err != nil {
+		logging.DefaultLogger().Error(err)
+		_ = lis.Close()
+		return err
+	}
+
+	s.serveGRPC(lis)
+	return nil
+}
+
+func (s *Server) serveGRPC(lis net.Listener) {
 	go func() {
-		logging.DefaultLogger().Infof("serving admin on %d", s.conf.Port)
+		logging.DefaultLogger().Infof("serving admin on %s", lis.Addr())
 
 		if err := s.grpcServer.Serve(lis); err != nil {
 			if err != grpc.ErrServerStopped {
@@ -131,8 +225,6 @@ func (s *Server) listenAndServeGRPC() error {
 			}
 		}
 	}()
-
-	return nil
 }
 
 // CreateProject creates a new project.
@@ -184,6 +276,10 @@ func (s *Server) GetProject(
 		return nil, err
 	}
 
+	// NOTE: The snapshot policy is surfaced with the defaults of the server
+	// merged, so that operators can see the settings actually applied.
+	project.SnapshotPolicy = s.backend.Config.SnapshotPolicy(project)
+
 	pbProject, err := converter.ToProject(project)
 	if err != nil {
 		return nil, err
@@ -193,6 +289,38 @@ func (s *Server) GetProject(
 	}, nil
 }
 
+// GetProjects gets the projects of the given IDs. The projects not found are
+// omitted and reported with their IDs.
+func (s *Server) GetProjects(
+	ctx context.Context,
+	req *api.GetProjectsRequest,
+) (*api.GetProjectsResponse, error) {
+	var ids []types.ID
+	for _, id := range req.Ids {
+		ids = append(ids, types.ID(id))
+	}
+
+	projectList, notFoundIDs, err := projects.GetProjects(ctx, s.backend, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	pbProjects, err := converter.ToProjects(projectList)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbNotFoundIDs []string
+	for _, id := range notFoundIDs {
+		pbNotFoundIDs = append(pbNotFoundIDs, id.String())
+	}
+
+	return &api.GetProjectsResponse{
+		Projects:    pbProjects,
+		NotFoundIds: pbNotFoundIDs,
+	}, nil
+}
+
 // UpdateProject updates the project.
 func (s *Server) UpdateProject(
 	ctx context.Context,
@@ -202,15 +330,13 @@ func (s *Server) UpdateProject(
 	if err != nil {
 		return nil, err
 	}
-	if err = fields.Validate(); err != nil {
-		return nil, err
-	}
 
 	project, err := projects.UpdateProject(
 		ctx,
 		s.backend,
 		types.ID(req.Id),
 		fields,
+		req.UpdateMask.GetPaths(),
 	)
 	if err != nil {
 		return nil, err
@@ -236,23 +362,43 @@ func (s *Server) GetDocument(
 		return nil, err
 	}
 
-	document, err := documents.GetDocumentSummary(
+	detail, err := documents.GetDocumentDetail(
 		ctx,
 		s.backend,
 		project,
 		key.Key(req.DocumentKey),
+		req.IncludeRemoved,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	pbDocument, err := converter.ToDocumentSummary(document)
+	var changesSinceCheckpoint uint64
+	if req.CheckpointServerSeq != nil {
+		if changesSinceCheckpoint, err = documents.CountChangesSinceCheckpoint(
+			ctx,
+			s.backend,
+			project,
+			key.Key(req.DocumentKey),
+			req.CheckpointServerSeq.Value,
+			req.IncludeRemoved,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	pbDocument, err := converter.ToDocumentSummary(detail.Summary)
 	if err != nil {
 		return nil, err
 	}
 
 	return &api.GetDocumentResponse{
-		Document: pbDocument,
+		Document:               pbDocument,
+		ServerSeq:              detail.ServerSeq,
+		SnapshotServerSeq:      detail.SnapshotServerSeq,
+		AttachedClients:        int32(detail.AttachedClients),
+		ChangesSinceCheckpoint: changesSinceCheckpoint,
+		GcInterval:             detail.GCInterval.String(),
 	}, nil
 }
 
@@ -283,12 +429,15 @@ func (s *Server) GetSnapshotMeta(
 	}
 
 	return &api.GetSnapshotMetaResponse{
-		Lamport:  doc.Lamport(),
-		Snapshot: snapshot,
+		Lamport:           doc.Lamport(),
+		Snapshot:          snapshot,
+		ObjectMergePolicy: project.ObjectMergePolicy,
 	}, nil
 }
 
-// ListDocuments lists documents.
+// ListDocuments lists documents. The documents are listed from the view at
+// the given snapshot time, or at the current time if it is not given. The
+// time of the view is returned to be passed for the next pages.
 func (s *Server) ListDocuments(
 	ctx context.Context,
 	req *api.ListDocumentsRequest,
@@ -298,16 +447,28 @@ func (s *Server) ListDocuments(
 		return nil, err
 	}
 
-	docs, err := documents.ListDocumentSummaries(
-		ctx,
-		s.backend,
-		project,
-		types.Paging[types.ID]{
-			Offset:    types.ID(req.PreviousId),
-			PageSize:  int(req.PageSize),
-			IsForward: req.IsForward,
-		},
-	)
+	paging := types.Paging[types.ID]{
+		Offset:     types.ID(req.PreviousId),
+		PageSize:   int(req.PageSize),
+		IsForward:  req.IsForward,
+		SnapshotAt: gotime.Now(),
+		Metadata:   req.Metadata,
+	}
+	if req.PageToken != "" {
+		token, err := types.DecodePageToken(req.PageToken, types.SortByID)
+		if err != nil {
+			return nil, err
+		}
+		paging.Offset = types.ID(token.LastValue)
+		paging.IsForward = token.IsForward
+		paging.SnapshotAt = token.SnapshotAt
+	} else if req.SnapshotAt != nil {
+		if paging.SnapshotAt, err = protoTypes.TimestampFromProto(req.SnapshotAt); err != nil {
+			return nil, err
+		}
+	}
+
+	docs, err := documents.ListDocumentSummaries(ctx, s.backend, project, paging)
 	if err != nil {
 		return nil, err
 	}
@@ -317,11 +478,56 @@ func (s *Server) ListDocuments(
 		return nil, err
 	}
 
+	pbSnapshotAt, err := protoTypes.TimestampProto(paging.SnapshotAt)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	if paging.PageSize > 0 && len(docs) == paging.PageSize {
+		token := types.NewPageToken(types.SortByID, docs[len(docs)-1].ID.String(), paging.IsForward)
+		token.SnapshotAt = paging.SnapshotAt
+		if nextPageToken, err = token.Encode(); err != nil {
+			return nil, err
+		}
+	}
+
 	return &api.ListDocumentsResponse{
-		Documents: pbDocuments,
+		Documents:     pbDocuments,
+		SnapshotAt:    pbSnapshotAt,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
+// StreamDocuments streams the summaries of all the documents of the project.
+// The documents created during the stream may not be included.
+func (s *Server) StreamDocuments(
+	req *api.StreamDocumentsRequest,
+	stream api.Admin_StreamDocumentsServer,
+) error {
+	ctx := stream.Context()
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return err
+	}
+
+	return documents.StreamDocumentSummaries(
+		ctx,
+		s.backend,
+		project,
+		func(summary *types.DocumentSummary) error {
+			pbSummary, err := converter.ToDocumentSummary(summary)
+			if err != nil {
+				return err
+			}
+
+			return stream.Send(&api.StreamDocumentsResponse{
+				Document: pbSummary,
+			})
+		},
+	)
+}
+
 // SearchDocuments searches documents for a specified string.
 func (s *Server) SearchDocuments(
 	ctx context.Context,
@@ -354,17 +560,120 @@ func (s *Server) SearchDocuments(
 	}, nil
 }
 
-// ListChanges lists of changes for the given document.
-func (s *Server) ListChanges(
+// RemoveDocumentsByPrefix removes documents whose keys start with the given
+// prefix.
+func (s *Server) RemoveDocumentsByPrefix(
 	ctx context.Context,
-	req *api.ListChangesRequest,
-) (*api.ListChangesResponse, error) {
+	req *api.RemoveDocumentsByPrefixRequest,
+) (*api.RemoveDocumentsByPrefixResponse, error) {
 	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
 	if err != nil {
 		return nil, err
 	}
 
-	docInfo, err := documents.FindDocInfoByKey(
+	removed, skipped, err := documents.RemoveDocumentsByPrefix(
+		ctx,
+		s.backend,
+		project,
+		req.KeyPrefix,
+		req.DryRun,
+		req.Force,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.RemoveDocumentsByPrefixResponse{
+		RemovedCount: int32(removed),
+		SkippedCount: int32(skipped),
+	}, nil
+}
+
+// ListSnapshotMetas lists the metadata of the snapshots of the given document
+// retained for rollback.
+func (s *Server) ListSnapshotMetas(
+	ctx context.Context,
+	req *api.ListSnapshotMetasRequest,
+) (*api.ListSnapshotMetasResponse, error) {
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	metas, err := documents.ListSnapshotMetas(ctx, s.backend, project, key.Key(req.DocumentKey))
+	if err != nil {
+		return nil, err
+	}
+
+	pbMetas, err := converter.ToSnapshotMetas(metas)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ListSnapshotMetasResponse{
+		Snapshots: pbMetas,
+	}, nil
+}
+
+// RollbackDocument restores the given document to the retained snapshot of
+// the given server sequence.
+func (s *Server) RollbackDocument(
+	ctx context.Context,
+	req *api.RollbackDocumentRequest,
+) (*api.RollbackDocumentResponse, error) {
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := documents.RollbackDocument(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+		req.ServerSeq,
+	); err != nil {
+		return nil, err
+	}
+
+	return &api.RollbackDocumentResponse{}, nil
+}
+
+// ResetDocument clears all the content of the given document while keeping
+// its key and the clients attaching it.
+func (s *Server) ResetDocument(
+	ctx context.Context,
+	req *api.ResetDocumentRequest,
+) (*api.ResetDocumentResponse, error) {
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := documents.ResetDocument(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+	); err != nil {
+		return nil, err
+	}
+
+	return &api.ResetDocumentResponse{}, nil
+}
+
+// ValidateDocument replays the change log of the given document and compares
+// the result with the latest snapshot of it.
+func (s *Server) ValidateDocument(
+	ctx context.Context,
+	req *api.ValidateDocumentRequest,
+) (*api.ValidateDocumentResponse, error) {
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	validation, err := documents.ValidateDocument(
 		ctx,
 		s.backend,
 		project,
@@ -373,31 +682,693 @@ func (s *Server) ListChanges(
 	if err != nil {
 		return nil, err
 	}
-	lastSeq := docInfo.ServerSeq
 
-	from, to := types.GetChangesRange(types.Paging[uint64]{
-		Offset:    req.PreviousSeq,
-		PageSize:  int(req.PageSize),
-		IsForward: req.IsForward,
-	}, lastSeq)
+	return &api.ValidateDocumentResponse{
+		ServerSeq:        validation.ServerSeq,
+		Valid:            validation.Valid,
+		ExpectedChecksum: validation.ExpectedChecksum,
+		ActualChecksum:   validation.ActualChecksum,
+		DivergingPath:    validation.DivergingPath,
+	}, nil
+}
 
-	changes, err := packs.FindChanges(
+// DiffDocument returns the JSON Patch (RFC 6902) between the given versions
+// of the document.
+func (s *Server) DiffDocument(
+	ctx context.Context,
+	req *api.DiffDocumentRequest,
+) (*api.DiffDocumentResponse, error) {
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := documents.DiffDocument(
 		ctx,
 		s.backend,
-		docInfo,
-		from,
-		to,
+		project,
+		key.Key(req.DocumentKey),
+		req.FromServerSeq,
+		req.ToServerSeq,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	pbChanges, err := converter.ToChanges(changes)
+	content, err := patch.Marshal()
 	if err != nil {
 		return nil, err
 	}
 
-	return &api.ListChangesResponse{
-		Changes: pbChanges,
+	return &api.DiffDocumentResponse{
+		Patch: string(content),
+	}, nil
+}
+
+// AnalyzeDocument returns the sizes of the subtrees of the given document up
+// to the given depth, with their tombstones.
+func (s *Server) AnalyzeDocument(
+	ctx context.Context,
+	req *api.AnalyzeDocumentRequest,
+) (*api.AnalyzeDocumentResponse, error) {
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	analysis, err := documents.AnalyzeDocument(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+		int(req.MaxDepth),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	subtrees := make([]*api.AnalyzeDocumentResponse_Subtree, 0, len(analysis.Subtrees))
+	for _, subtree := range analysis.Subtrees {
+		subtrees = append(subtrees, &api.AnalyzeDocumentResponse_Subtree{
+			Path:           subtree.Path,
+			Bytes:          subtree.Bytes,
+			TombstoneBytes: subtree.TombstoneBytes,
+		})
+	}
+
+	return &api.AnalyzeDocumentResponse{
+		ServerSeq: analysis.ServerSeq,
+		Subtrees:  subtrees,
+		Truncated: analysis.Truncated,
+	}, nil
+}
+
+// CreateDocumentFromTemplate creates a document from the template of the
+// project rendered with the given variables.
+func (s *Server) CreateDocumentFromTemplate(
+	ctx context.Context,
+	req *api.CreateDocumentFromTemplateRequest,
+) (*api.CreateDocumentFromTemplateResponse, error) {
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := documents.CreateDocumentFromTemplate(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+		req.TemplateId,
+		req.Variables,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pbSummary, err := converter.ToDocumentSummary(summary)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.CreateDocumentFromTemplateResponse{
+		Document: pbSummary,
+	}, nil
+}
+
+// ReplayOperations stores the given changes, authored in another system, in
+// the given order as the history of the given document. The document is
+// created if it does not exist.
+func (s *Server) ReplayOperations(
+	ctx context.Context,
+	req *api.ReplayOperationsRequest,
+) (*api.ReplayOperationsResponse, error) {
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := converter.FromChanges(req.Changes)
+	if err != nil {
+		return nil, err
+	}
+
+	replay, err := documents.ReplayChanges(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ReplayOperationsResponse{
+		ServerSeq: replay.ServerSeq,
+		Replayed:  int32(replay.Replayed),
+		Skipped:   int32(replay.Skipped),
+	}, nil
+}
+
+// CreateDocumentByAdmin creates an empty document of the given key. The
+// document is owned by the initial actor.
+func (s *Server) CreateDocumentByAdmin(
+	ctx context.Context,
+	req *api.CreateDocumentByAdminRequest,
+) (*api.CreateDocumentByAdminResponse, error) {
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := documents.CreateDocument(
+		ctx,
+		s.backend,
+		project,
+		types.IDFromActorID(time.InitialActorID),
+		key.Key(req.DocumentKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pbSummary, err := converter.ToDocumentSummary(summary)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.CreateDocumentByAdminResponse{
+		Document: pbSummary,
+	}, nil
+}
+
+// LockDocument locks the given document so that changes can not be pushed to
+// it until it is unlocked.
+func (s *Server) LockDocument(
+	ctx context.Context,
+	req *api.LockDocumentRequest,
+) (*api.LockDocumentResponse, error) {
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := documents.LockDocument(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+		req.Reason,
+	); err != nil {
+		return nil, err
+	}
+
+	return &api.LockDocumentResponse{}, nil
+}
+
+// UnlockDocument unlocks the given document.
+func (s *Server) UnlockDocument(
+	ctx context.Context,
+	req *api.UnlockDocumentRequest,
+) (*api.UnlockDocumentResponse, error) {
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := documents.UnlockDocument(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+	); err != nil {
+		return nil, err
+	}
+
+	return &api.UnlockDocumentResponse{}, nil
+}
+
+// MoveDocument moves the given document to the target project. The clients
+// attached to the document must attach it again under the target project.
+func (s *Server) MoveDocument(
+	ctx context.Context,
+	req *api.MoveDocumentRequest,
+) (*api.MoveDocumentResponse, error) {
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+	targetProject, err := projects.GetProject(ctx, s.backend, req.TargetProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := documents.MoveDocument(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+		targetProject,
+	); err != nil {
+		return nil, err
+	}
+
+	return &api.MoveDocumentResponse{}, nil
+}
+
+// UnarchiveDocument unarchives the given document.
+func (s *Server) UnarchiveDocument(
+	ctx context.Context,
+	req *api.UnarchiveDocumentRequest,
+) (*api.UnarchiveDocumentResponse, error) {
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := documents.UnarchiveDocument(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+	); err != nil {
+		return nil, err
+	}
+
+	return &api.UnarchiveDocumentResponse{}, nil
+}
+
+// SetDocumentMetadata replaces the metadata of the given document.
+func (s *Server) SetDocumentMetadata(
+	ctx context.Context,
+	req *api.SetDocumentMetadataRequest,
+) (*api.SetDocumentMetadataResponse, error) {
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := documents.SetDocumentMetadata(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+		req.Metadata,
+	); err != nil {
+		return nil, err
+	}
+
+	return &api.SetDocumentMetadataResponse{}, nil
+}
+
+// TransferDocumentOwnership transfers the ownership of the given document and
+// detaches the clients which are no longer permitted to access it.
+func (s *Server) TransferDocumentOwnership(
+	ctx context.Context,
+	req *api.TransferDocumentOwnershipRequest,
+) (*api.TransferDocumentOwnershipResponse, error) {
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	detachedClientIDs, err := documents.TransferDocumentOwnership(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+		req.Owner,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, id := range detachedClientIDs {
+		ids = append(ids, id.String())
+	}
+
+	return &api.TransferDocumentOwnershipResponse{
+		DetachedClientIds: ids,
+	}, nil
+}
+
+// ListChanges lists of changes for the given document.
+func (s *Server) ListChanges(
+	ctx context.Context,
+	req *api.ListChangesRequest,
+) (*api.ListChangesResponse, error) {
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	docInfo, err := documents.FindDocInfoByKey(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+	lastSeq := docInfo.ServerSeq
+
+	paging := types.Paging[uint64]{
+		Offset:    req.PreviousSeq,
+		PageSize:  int(req.PageSize),
+		IsForward: req.IsForward,
+	}
+	if req.PageToken != "" {
+		token, err := types.DecodePageToken(req.PageToken, types.SortByServerSeq)
+		if err != nil {
+			return nil, err
+		}
+		if paging.Offset, err = strconv.ParseUint(token.LastValue, 10, 64); err != nil {
+			return nil, fmt.Errorf("server seq %q: %w", token.LastValue, types.ErrInvalidPageToken)
+		}
+		paging.IsForward = token.IsForward
+	}
+
+	from, to := types.GetChangesRange(paging, lastSeq)
+
+	changes, err := packs.FindChanges(
+		ctx,
+		s.backend,
+		project,
+		docInfo,
+		from,
+		to,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pbChanges, err := converter.ToChanges(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: The changes are listed in ascending order in both directions, so
+	// the cursor of the backward pages is the first change.
+	var nextPageToken string
+	if paging.PageSize > 0 && len(changes) == paging.PageSize {
+		cursor := changes[len(changes)-1].ServerSeq()
+		hasNext := cursor < lastSeq
+		if !paging.IsForward {
+			cursor = changes[0].ServerSeq()
+			hasNext = cursor > 1
+		}
+
+		if hasNext {
+			token := types.NewPageToken(types.SortByServerSeq, strconv.FormatUint(cursor, 10), paging.IsForward)
+			if nextPageToken, err = token.Encode(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &api.ListChangesResponse{
+		Changes:       pbChanges,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// ListDocumentClientEvents lists the events of clients on the given document.
+func (s *Server) ListDocumentClientEvents(
+	ctx context.Context,
+	req *api.ListDocumentClientEventsRequest,
+) (*api.ListDocumentClientEventsResponse, error) {
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var from, to gotime.Time
+	if req.From != nil {
+		if from, err = protoTypes.TimestampFromProto(req.From); err != nil {
+			return nil, err
+		}
+	}
+	if req.To != nil {
+		if to, err = protoTypes.TimestampFromProto(req.To); err != nil {
+			return nil, err
+		}
+	}
+
+	paging := types.Paging[types.ID]{
+		Offset:    types.ID(req.PreviousId),
+		PageSize:  int(req.PageSize),
+		IsForward: req.IsForward,
+	}
+	if req.PageToken != "" {
+		token, err := types.DecodePageToken(req.PageToken, types.SortByID)
+		if err != nil {
+			return nil, err
+		}
+		paging.Offset = types.ID(token.LastValue)
+		paging.IsForward = token.IsForward
+	}
+
+	events, err := documents.ListDocumentClientEvents(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+		from,
+		to,
+		paging,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pbEvents, err := converter.ToDocumentClientEvents(events)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	if paging.PageSize > 0 && len(events) == paging.PageSize {
+		token := types.NewPageToken(types.SortByID, events[len(events)-1].ID.String(), paging.IsForward)
+		if nextPageToken, err = token.Encode(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &api.ListDocumentClientEventsResponse{
+		Events:        pbEvents,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// ListDocumentEventLogs lists the entries of the event log of the given
+// document.
+func (s *Server) ListDocumentEventLogs(
+	ctx context.Context,
+	req *api.ListDocumentEventLogsRequest,
+) (*api.ListDocumentEventLogsResponse, error) {
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := documents.ListDocumentEventLogs(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+		req.FromSeq,
+		req.ToSeq,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pbLogs, err := converter.ToDocumentEventLogs(logs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ListDocumentEventLogsResponse{
+		EventLogs: pbLogs,
+	}, nil
+}
+
+// GetDocumentVersionVector gets the version vector of the given document.
+func (s *Server) GetDocumentVersionVector(
+	ctx context.Context,
+	req *api.GetDocumentVersionVectorRequest,
+) (*api.GetDocumentVersionVectorResponse, error) {
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	vector, err := documents.GetDocumentVersionVector(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.DocumentKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pbVector, err := converter.ToVersionVector(vector)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetDocumentVersionVectorResponse{
+		VersionVector: pbVector,
+	}, nil
+}
+
+// GetProjectStats gets the statistics of the given project such as the
+// conflict wins of the actors and the hot documents.
+func (s *Server) GetProjectStats(
+	ctx context.Context,
+	req *api.GetProjectStatsRequest,
+) (*api.GetProjectStatsResponse, error) {
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	pbStats, err := converter.ToProjectStats(projects.GetProjectStats(s.backend, project))
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetProjectStatsResponse{
+		Stats: pbStats,
+	}, nil
+}
+
+// WatchRejections streams the changes of the given project rejected by this
+// server. The rejections beyond the given rate are dropped and their number
+// is reported with the next delivered rejection.
+func (s *Server) WatchRejections(
+	req *api.WatchRejectionsRequest,
+	stream api.Admin_WatchRejectionsServer,
+) error {
+	ctx := stream.Context()
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return err
+	}
+
+	maxPerSecond := int(req.MaxPerSecond)
+	if maxPerSecond <= 0 {
+		maxPerSecond = defaultRejectionsPerSecond
+	}
+
+	sub := s.backend.Rejections.Subscribe(project.ID, maxPerSecond)
+	defer s.backend.Rejections.Unsubscribe(sub)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case rejection := <-sub.Events():
+			pbRejection, err := converter.ToRejection(rejection)
+			if err != nil {
+				return err
+			}
+
+			if err := stream.Send(&api.WatchRejectionsResponse{
+				Rejection: pbRejection,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// WatchProjectStats streams the live statistics of the given project measured
+// by this server. The statistics are sent right away, periodically, and on
+// each event changing them significantly.
+func (s *Server) WatchProjectStats(
+	req *api.WatchProjectStatsRequest,
+	stream api.Admin_WatchProjectStatsServer,
+) error {
+	ctx := stream.Context()
+	project, err := projects.GetProject(ctx, s.backend, req.ProjectName)
+	if err != nil {
+		return err
+	}
+
+	sub := s.backend.ProjectStats.Subscribe(project.ID)
+	defer s.backend.ProjectStats.Unsubscribe(sub)
+
+	ticker := gotime.NewTicker(s.backend.Config.ParseProjectStatsInterval())
+	defer ticker.Stop()
+
+	send := func(event *types.ProjectStatsEvent) error {
+		resp, err := converter.ToProjectStatsUpdate(&types.ProjectStatsUpdate{
+			Event: event,
+			Stats: *projects.GetLiveProjectStats(s.backend, project),
+		})
+		if err != nil {
+			return err
+		}
+		return stream.Send(resp)
+	}
+
+	if err := send(nil); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := send(nil); err != nil {
+				return err
+			}
+		case event := <-sub.Events():
+			if err := send(&event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// GetMaintenance gets the maintenance mode of the server.
+func (s *Server) GetMaintenance(
+	_ context.Context,
+	_ *api.GetMaintenanceRequest,
+) (*api.GetMaintenanceResponse, error) {
+	pbMaintenance, err := converter.ToMaintenance(s.backend.Maintenance.Info().ToMaintenance())
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetMaintenanceResponse{
+		Maintenance: pbMaintenance,
+	}, nil
+}
+
+// SetMaintenance enables or disables the maintenance mode of the server, in
+// which the server rejects writes but keeps serving reads.
+func (s *Server) SetMaintenance(
+	ctx context.Context,
+	req *api.SetMaintenanceRequest,
+) (*api.SetMaintenanceResponse, error) {
+	info, err := s.backend.SetMaintenance(ctx, req.Enabled, req.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	pbMaintenance, err := converter.ToMaintenance(info.ToMaintenance())
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.SetMaintenanceResponse{
+		Maintenance: pbMaintenance,
 	}, nil
 }
@@ -19,17 +19,27 @@ package backend
 import (
 	"context"
 	"os"
+	gosync "sync"
 	"time"
 
 	"github.com/rs/xid"
 
 	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/internal/version"
 	"github.com/yorkie-team/yorkie/pkg/cache"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/server/backend/background"
+	"github.com/yorkie-team/yorkie/server/backend/changefeed"
+	"github.com/yorkie-team/yorkie/server/backend/conflictwins"
 	"github.com/yorkie-team/yorkie/server/backend/database"
 	memdb "github.com/yorkie-team/yorkie/server/backend/database/memory"
 	"github.com/yorkie-team/yorkie/server/backend/database/mongo"
+	"github.com/yorkie-team/yorkie/server/backend/hotdocs"
 	"github.com/yorkie-team/yorkie/server/backend/housekeeping"
+	"github.com/yorkie-team/yorkie/server/backend/projectstats"
+	"github.com/yorkie-team/yorkie/server/backend/rejections"
 	"github.com/yorkie-team/yorkie/server/backend/sync"
 	"github.com/yorkie-team/yorkie/server/backend/sync/etcd"
 	memsync "github.com/yorkie-team/yorkie/server/backend/sync/memory"
@@ -37,19 +47,92 @@ import (
 	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
 )
 
+// documentCountCacheSize is the max number of projects whose document counts
+// are cached.
+const documentCountCacheSize = 1000
+
+// headDocumentCacheSize is the max number of documents kept materialized at
+// their heads.
+const headDocumentCacheSize = 100
+
+// HeadDocument is a document materialized at the head of its changes. It
+// should be locked to be accessed, since it is also read without the lock of
+// the document, e.g. by ValidateChange.
+type HeadDocument struct {
+	gosync.Mutex
+
+	// ServerSeq is the server sequence of the last change applied to Doc.
+	ServerSeq uint64
+
+	// Doc is the document materialized.
+	Doc *document.InternalDocument
+}
+
+// SnapshotCacheKey is the key of a root materialized from the snapshot of a
+// document at a server sequence.
+type SnapshotCacheKey struct {
+	DocID     types.ID
+	ServerSeq uint64
+}
+
 // Backend manages Yorkie's backend such as Database and Coordinator. And it
 // has the server status such as the information of this Server.
 type Backend struct {
 	Config     *Config
 	serverInfo *sync.ServerInfo
 
-	DB           database.Database
-	Coordinator  sync.Coordinator
+	DB          database.Database
+	Coordinator sync.Coordinator
+
+	// EventBatcher coalesces the change events of each document before
+	// publishing them to the Coordinator.
+	EventBatcher *sync.EventBatcher
+
+	// EphemeralDB keeps the state of the ephemeral documents in the memory of
+	// this server.
+	EphemeralDB *memdb.DB
+
+	// Changefeed publishes the changes committed to documents to the message
+	// sinks of projects.
+	Changefeed *changefeed.Changefeed
+
+	// HotDocuments detects the documents whose change rate exceeds the
+	// threshold. Each server detects them from the changes pushed to it.
+	HotDocuments *hotdocs.Detector
+
+	// Rejections delivers the changes rejected by this server to the
+	// operators watching them.
+	Rejections *rejections.Feed
+
+	// ProjectStats tracks the live statistics of the projects measured by
+	// this server and delivers their events to the operators watching them.
+	ProjectStats *projectstats.Tracker
+
 	Metrics      *prometheus.Metrics
 	Background   *background.Background
 	Housekeeping *housekeeping.Housekeeping
 
 	AuthWebhookCache *cache.LRUExpireCache[string, *types.AuthWebhookResponse]
+
+	// DocumentCountCache caches the number of documents of each project.
+	DocumentCountCache *cache.LRUExpireCache[types.ID, int]
+
+	// HeadDocumentCache caches the documents materialized at their heads, so
+	// that the changes pushed to them are applied without rebuilding them.
+	HeadDocumentCache *cache.LRUExpireCache[types.ID, *HeadDocument]
+
+	// ConflictWins counts the concurrent writes won by each actor.
+	ConflictWins *conflictwins.Counter
+
+	// SnapshotCache caches the roots materialized from the snapshots of
+	// documents. It is nil if the cache is disabled.
+	SnapshotCache *cache.LRUSizeCache[SnapshotCacheKey, *json.Root]
+
+	// Maintenance keeps the maintenance mode of the server.
+	Maintenance *Maintenance
+
+	// DBHealth supervises the connection to the database.
+	DBHealth *DBHealth
 }
 
 // New creates a new instance of Backend.
@@ -70,24 +153,50 @@ func New(
 		ID:          xid.New().String(),
 		Hostname:    hostname,
 		ClusterAddr: clusterAddr,
+		Version:     version.Version,
 		UpdatedAt:   time.Now(),
 	}
 
-	bg := background.New()
+	bg := background.New(conf.BackgroundQueueWarnThreshold)
+	for _, jobType := range background.JobTypes {
+		jobType := jobType
+		metrics.RegisterBackgroundQueue(string(jobType), func() (int, time.Duration) {
+			stats := bg.QueueStats(jobType)
+			return stats.Depth, stats.OldestAge
+		})
+	}
+
+	idGenerator, err := database.NewIDGenerator(conf.IDGenerator, conf.ActorIDFormat().Prefix)
+	if err != nil {
+		return nil, err
+	}
+	if err := database.ValidateIDGenerator(idGenerator); err != nil {
+		return nil, err
+	}
 
 	var db database.Database
 	if mongoConf != nil {
-		db, err = mongo.Dial(mongoConf)
+		db, err = mongo.Dial(mongoConf, idGenerator)
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		db, err = memdb.New()
+		db, err = memdb.New(idGenerator)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	queryTimeout, queryTimeoutOverrides := conf.ParseQueryTimeout(), conf.ParseQueryTimeoutOverrides()
+	if queryTimeout > 0 || len(queryTimeoutOverrides) > 0 {
+		db = database.WithQueryTimeout(db, queryTimeout, queryTimeoutOverrides, metrics.AddBackendQueryTimeouts)
+	}
+
+	ephemeralDB, err := memdb.New(idGenerator)
+	if err != nil {
+		return nil, err
+	}
+
 	var coordinator sync.Coordinator
 	if etcdConf != nil {
 		etcdClient, err := etcd.Dial(etcdConf, serverInfo)
@@ -108,6 +217,24 @@ func New(
 		return nil, err
 	}
 
+	documentCountCache, err := cache.NewLRUExpireCache[types.ID, int](documentCountCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	headDocumentCache, err := cache.NewLRUExpireCache[types.ID, *HeadDocument](headDocumentCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshotCache *cache.LRUSizeCache[SnapshotCacheKey, *json.Root]
+	if conf.SnapshotCacheBytes > 0 {
+		snapshotCache, err = cache.NewLRUSizeCache[SnapshotCacheKey, *json.Root](conf.SnapshotCacheBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	keeping, err := housekeeping.Start(
 		housekeepingConf,
 		db,
@@ -134,6 +261,14 @@ func New(
 		return nil, err
 	}
 
+	maintenance, err := newMaintenance(context.Background(), db)
+	if err != nil {
+		return nil, err
+	}
+
+	dbHealth := newDBHealth(db, conf.ParseDBHealthCheckInterval(), conf.ParseDBReconnectMaxBackoff(), metrics)
+	dbHealth.start()
+
 	return &Backend{
 		Config:     conf,
 		serverInfo: serverInfo,
@@ -141,16 +276,35 @@ func New(
 		Background:   bg,
 		Metrics:      metrics,
 		DB:           db,
+		EphemeralDB:  ephemeralDB,
+		Changefeed:   changefeed.New(),
 		Coordinator:  coordinator,
+		EventBatcher: sync.NewEventBatcher(conf.ParseEventBatchWindow(), coordinator.Publish),
 		Housekeeping: keeping,
+		HotDocuments: hotdocs.New(conf.HotDocumentThreshold, conf.ParseHotDocumentWindow()),
+		Rejections:   rejections.New(time.Second),
+		ProjectStats: projectstats.New(),
 
-		AuthWebhookCache: authWebhookCache,
+		AuthWebhookCache:   authWebhookCache,
+		DocumentCountCache: documentCountCache,
+		HeadDocumentCache:  headDocumentCache,
+		ConflictWins:       conflictwins.New(),
+		SnapshotCache:      snapshotCache,
+		Maintenance:        maintenance,
+		DBHealth:           dbHealth,
 	}, nil
 }
 
 // Shutdown closes all resources of this instance.
 func (b *Backend) Shutdown() error {
+	// NOTE: The changefeed is closed first so that the background routines
+	// blocked on the full queue of the changefeed can exit.
+	if err := b.Changefeed.Close(); err != nil {
+		logging.DefaultLogger().Error(err)
+	}
+
 	b.Background.Close()
+	b.EventBatcher.Close()
 
 	if err := b.Housekeeping.Stop(); err != nil {
 		return err
@@ -160,10 +314,15 @@ func (b *Backend) Shutdown() error {
 		logging.DefaultLogger().Error(err)
 	}
 
+	b.DBHealth.close()
 	if err := b.DB.Close(); err != nil {
 		logging.DefaultLogger().Error(err)
 	}
 
+	if err := b.EphemeralDB.Close(); err != nil {
+		logging.DefaultLogger().Error(err)
+	}
+
 	logging.DefaultLogger().Infof(
 		"backend stoped: id: %s, rpc: %s",
 		b.serverInfo.ID,
@@ -173,6 +332,20 @@ func (b *Backend) Shutdown() error {
 	return nil
 }
 
+// DocDB returns the database which keeps the state of the document of the
+// given key. The ephemeral documents are kept in EphemeralDB, and the others
+// in DB. Clients and projects are always kept in DB.
+//
+// NOTE: EphemeralDB is local to this server, so the clients of an ephemeral
+// document should be routed to the same server in cluster mode. The state is
+// also lost on restart, and the clients should attach the document again.
+func (b *Backend) DocDB(project *types.Project, k key.Key) database.Database {
+	if project.IsEphemeralDocument(k) {
+		return b.EphemeralDB
+	}
+	return b.DB
+}
+
 // Members returns the members of this cluster.
 func (b *Backend) Members() map[string]*sync.ServerInfo {
 	return b.Coordinator.Members()
@@ -31,12 +31,23 @@ type Background struct {
 
 	// routineID is used to generate routine ID.
 	routineID routineID
+
+	// jobs is the queues of the pending jobs by their types.
+	jobsMu    sync.Mutex
+	jobs      map[JobType]*jobQueue
+	lastJobID uint64
+
+	// queueWarnThreshold is the number of the pending jobs of a type above
+	// which a warning is logged. Zero disables it.
+	queueWarnThreshold int
 }
 
 // New creates a new background service.
-func New() *Background {
+func New(queueWarnThreshold int) *Background {
 	return &Background{
-		closing: make(chan struct{}),
+		closing:            make(chan struct{}),
+		jobs:               make(map[JobType]*jobQueue),
+		queueWarnThreshold: queueWarnThreshold,
 	}
 }
 
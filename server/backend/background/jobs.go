@@ -0,0 +1,130 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package background
+
+import (
+	"context"
+	"time"
+
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+// JobType is the type of the jobs run in the background.
+type JobType string
+
+const (
+	// JobSnapshot is the type of the jobs storing the snapshots of documents.
+	JobSnapshot JobType = "snapshot"
+
+	// JobGC is the type of the jobs collecting the garbage of documents.
+	JobGC JobType = "gc"
+)
+
+// JobTypes is the types of the jobs whose queues are observed.
+var JobTypes = []JobType{JobSnapshot, JobGC}
+
+// QueueStats is the statistics of the pending jobs of a type.
+type QueueStats struct {
+	// Depth is the number of the pending jobs.
+	Depth int
+
+	// OldestAge is the time since the oldest pending job is enqueued. It is
+	// zero if no job is pending.
+	OldestAge time.Duration
+}
+
+// jobQueue is the pending jobs of a type.
+type jobQueue struct {
+	// pending is the enqueued time of the pending jobs by their IDs.
+	pending map[uint64]time.Time
+
+	// warned is whether the depth of the queue is reported to exceed the
+	// threshold. It is reset once the depth falls back to the threshold.
+	warned bool
+}
+
+// EnqueueJob records a pending job of the given type, and returns the
+// function to be called once the job is done. A warning is logged when the
+// number of the pending jobs exceeds the threshold.
+func (b *Background) EnqueueJob(jobType JobType) func() {
+	b.jobsMu.Lock()
+	defer b.jobsMu.Unlock()
+
+	queue, ok := b.jobs[jobType]
+	if !ok {
+		queue = &jobQueue{pending: make(map[uint64]time.Time)}
+		b.jobs[jobType] = queue
+	}
+
+	b.lastJobID++
+	id := b.lastJobID
+	queue.pending[id] = time.Now()
+
+	if b.queueWarnThreshold > 0 && len(queue.pending) > b.queueWarnThreshold && !queue.warned {
+		queue.warned = true
+		logging.DefaultLogger().Warnf(
+			"BKGD: %s jobs pending %d exceed %d",
+			jobType,
+			len(queue.pending),
+			b.queueWarnThreshold,
+		)
+	}
+
+	return func() {
+		b.jobsMu.Lock()
+		defer b.jobsMu.Unlock()
+
+		delete(queue.pending, id)
+		if len(queue.pending) <= b.queueWarnThreshold {
+			queue.warned = false
+		}
+	}
+}
+
+// AttachJob creates a goroutine on the given function as a job of the given
+// type, so that it is counted as pending until the function returns.
+func (b *Background) AttachJob(jobType JobType, f func(ctx context.Context)) {
+	done := b.EnqueueJob(jobType)
+	b.AttachGoroutine(func(ctx context.Context) {
+		defer done()
+		f(ctx)
+	})
+}
+
+// QueueStats returns the statistics of the pending jobs of the given type.
+func (b *Background) QueueStats(jobType JobType) QueueStats {
+	b.jobsMu.Lock()
+	defer b.jobsMu.Unlock()
+
+	queue, ok := b.jobs[jobType]
+	if !ok {
+		return QueueStats{}
+	}
+
+	var oldest time.Time
+	for _, enqueuedAt := range queue.pending {
+		if oldest.IsZero() || enqueuedAt.Before(oldest) {
+			oldest = enqueuedAt
+		}
+	}
+
+	stats := QueueStats{Depth: len(queue.pending)}
+	if !oldest.IsZero() {
+		stats.OldestAge = time.Since(oldest)
+	}
+	return stats
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package background_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/server/backend/background"
+)
+
+func TestJobQueue(t *testing.T) {
+	t.Run("enqueue job test", func(t *testing.T) {
+		bg := background.New(1)
+		defer bg.Close()
+		assert.Equal(t, background.QueueStats{}, bg.QueueStats(background.JobSnapshot))
+
+		done1 := bg.EnqueueJob(background.JobSnapshot)
+		time.Sleep(10 * time.Millisecond)
+		done2 := bg.EnqueueJob(background.JobSnapshot)
+
+		stats := bg.QueueStats(background.JobSnapshot)
+		assert.Equal(t, 2, stats.Depth)
+		assert.GreaterOrEqual(t, stats.OldestAge, 10*time.Millisecond)
+		assert.Equal(t, background.QueueStats{}, bg.QueueStats(background.JobGC))
+
+		done1()
+		assert.Equal(t, 1, bg.QueueStats(background.JobSnapshot).Depth)
+
+		done2()
+		assert.Equal(t, background.QueueStats{}, bg.QueueStats(background.JobSnapshot))
+	})
+
+	t.Run("attach job test", func(t *testing.T) {
+		bg := background.New(0)
+		release := make(chan struct{})
+		bg.AttachJob(background.JobGC, func(ctx context.Context) {
+			<-release
+		})
+		assert.Equal(t, 1, bg.QueueStats(background.JobGC).Depth)
+
+		close(release)
+		bg.Close()
+		assert.Equal(t, 0, bg.QueueStats(background.JobGC).Depth)
+	})
+}
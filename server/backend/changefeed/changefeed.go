@@ -0,0 +1,201 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package changefeed publishes the changes committed to documents to the
+// external message sinks of projects, e.g. for analytics pipelines.
+package changefeed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+)
+
+var (
+	// ErrUnsupportedSink is returned when the scheme of the given url is not
+	// supported by any sink.
+	ErrUnsupportedSink = errors.New("unsupported changefeed sink")
+)
+
+// Event represents a change committed to a document. Consumers can detect gaps
+// and duplicates of the events of a document with ServerSeq, which increases
+// by one for each change of the document.
+type Event struct {
+	ProjectID   types.ID       `json:"project_id"`
+	DocumentKey string         `json:"document_key"`
+	ServerSeq   uint64         `json:"server_seq"`
+	ActorID     string         `json:"actor_id"`
+	ClientSeq   uint32         `json:"client_seq"`
+	Message     string         `json:"message,omitempty"`
+	Operations  map[string]int `json:"operations"`
+	CommittedAt time.Time      `json:"committed_at"`
+}
+
+// NewEvents creates the events of the given changes committed to the document.
+func NewEvents(projectID types.ID, docKey key.Key, changes []*change.Change) []Event {
+	committedAt := time.Now()
+
+	var events []Event
+	for _, c := range changes {
+		events = append(events, Event{
+			ProjectID:   projectID,
+			DocumentKey: docKey.String(),
+			ServerSeq:   c.ServerSeq(),
+			ActorID:     c.ID().ActorID().String(),
+			ClientSeq:   c.ClientSeq(),
+			Message:     c.Message(),
+			Operations:  summarizeOperations(c.Operations()),
+			CommittedAt: committedAt,
+		})
+	}
+	return events
+}
+
+// summarizeOperations returns the number of the given operations by kind.
+func summarizeOperations(ops []operations.Operation) map[string]int {
+	summary := make(map[string]int)
+	for _, op := range ops {
+		summary[operations.Kind(op)]++
+	}
+	return summary
+}
+
+// Sink is an external message sink that the events are published to.
+type Sink interface {
+	// Publish publishes the given events in order. If it returns an error,
+	// some of the events may have been published.
+	Publish(ctx context.Context, events []Event) error
+
+	// Close closes the sink.
+	Close() error
+}
+
+// NoopSink is a sink that discards all events.
+type NoopSink struct{}
+
+// Publish discards the given events.
+func (s NoopSink) Publish(_ context.Context, _ []Event) error {
+	return nil
+}
+
+// Close does nothing.
+func (s NoopSink) Close() error {
+	return nil
+}
+
+// NewSink creates a sink for the given url. An empty url creates NoopSink.
+func NewSink(rawURL string) (Sink, error) {
+	if rawURL == "" {
+		return NoopSink{}, nil
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", rawURL, err)
+	}
+
+	switch u.Scheme {
+	case "nats":
+		return NewNATSSink(u), nil
+	default:
+		return nil, fmt.Errorf("%s: %w", u.Scheme, ErrUnsupportedSink)
+	}
+}
+
+// Changefeed manages the publishers of the sinks of projects.
+type Changefeed struct {
+	publishersMu sync.Mutex
+	publishers   map[string]*Publisher
+	closed       bool
+}
+
+// New creates a new instance of Changefeed.
+func New() *Changefeed {
+	return &Changefeed{
+		publishers: make(map[string]*Publisher),
+	}
+}
+
+// Publish publishes the events of the given changes to the sink of the
+// project. The events are batched and published in background.
+func (c *Changefeed) Publish(
+	ctx context.Context,
+	project *types.Project,
+	docKey key.Key,
+	changes []*change.Change,
+) error {
+	if project.ChangefeedURL == "" || len(changes) == 0 {
+		return nil
+	}
+
+	publisher, err := c.publisher(project.ChangefeedURL)
+	if err != nil {
+		return err
+	}
+
+	return publisher.Add(ctx, NewEvents(project.ID, docKey, changes)...)
+}
+
+// publisher returns the publisher of the given url. It creates a new one if
+// it does not exist.
+func (c *Changefeed) publisher(rawURL string) (*Publisher, error) {
+	c.publishersMu.Lock()
+	defer c.publishersMu.Unlock()
+
+	if c.closed {
+		return nil, ErrPublisherClosed
+	}
+
+	if publisher, ok := c.publishers[rawURL]; ok {
+		return publisher, nil
+	}
+
+	sink, err := NewSink(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	publisher := NewPublisher(sink, DefaultBatchSize, DefaultFlushInterval)
+	c.publishers[rawURL] = publisher
+	return publisher, nil
+}
+
+// Close flushes the pending events and closes all the sinks.
+func (c *Changefeed) Close() error {
+	c.publishersMu.Lock()
+	defer c.publishersMu.Unlock()
+
+	c.closed = true
+	var errs []error
+	for _, publisher := range c.publishers {
+		if err := publisher.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("close changefeed: %v", errs)
+	}
+	return nil
+}
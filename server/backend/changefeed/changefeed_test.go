@@ -0,0 +1,185 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changefeed_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/server/backend/changefeed"
+)
+
+// fakeSink is a sink that records the published batches. It fails the first
+// `failures` publishes.
+type fakeSink struct {
+	mu       sync.Mutex
+	failures int
+	batches  [][]changefeed.Event
+}
+
+func (s *fakeSink) Publish(_ context.Context, events []changefeed.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("unavailable")
+	}
+	s.batches = append(s.batches, append([]changefeed.Event{}, events...))
+	return nil
+}
+
+func (s *fakeSink) Close() error {
+	return nil
+}
+
+func (s *fakeSink) serverSeqs() []uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var seqs []uint64
+	for _, batch := range s.batches {
+		for _, event := range batch {
+			seqs = append(seqs, event.ServerSeq)
+		}
+	}
+	return seqs
+}
+
+func newEvents(from, to uint64) []changefeed.Event {
+	var events []changefeed.Event
+	for seq := from; seq <= to; seq++ {
+		events = append(events, changefeed.Event{DocumentKey: "doc", ServerSeq: seq})
+	}
+	return events
+}
+
+func TestPublisher(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("batch test", func(t *testing.T) {
+		sink := &fakeSink{}
+		publisher := changefeed.NewPublisher(sink, 3, time.Hour)
+		assert.NoError(t, publisher.Add(ctx, newEvents(1, 7)...))
+		assert.Eventually(t, func() bool {
+			return len(sink.serverSeqs()) == 6
+		}, time.Second, 10*time.Millisecond)
+
+		// the remaining event is published when closing.
+		assert.NoError(t, publisher.Close())
+		assert.Len(t, sink.batches, 3)
+		assert.Equal(t, []uint64{1, 2, 3, 4, 5, 6, 7}, sink.serverSeqs())
+		assert.ErrorIs(t, publisher.Add(ctx, newEvents(8, 8)...), changefeed.ErrPublisherClosed)
+	})
+
+	t.Run("retry test", func(t *testing.T) {
+		sink := &fakeSink{failures: 2}
+		publisher := changefeed.NewPublisher(sink, 10, time.Millisecond)
+		assert.NoError(t, publisher.Add(ctx, newEvents(1, 3)...))
+		assert.Eventually(t, func() bool {
+			return len(sink.serverSeqs()) == 3
+		}, 3*time.Second, 10*time.Millisecond)
+		assert.NoError(t, publisher.Close())
+		assert.Equal(t, []uint64{1, 2, 3}, sink.serverSeqs())
+	})
+}
+
+// newNATSServer starts a fake NATS server that sends the payloads of the
+// received messages of the given subject to the returned channel.
+func newNATSServer(t *testing.T, subject string) (net.Listener, <-chan []byte) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	payloads := make(chan []byte, 100)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+
+		reader := bufio.NewReader(conn)
+		_, _ = conn.Write([]byte("INFO {\"max_payload\":1048576}\r\n"))
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			fields := strings.Fields(line)
+			switch fields[0] {
+			case "PING":
+				_, _ = conn.Write([]byte("PONG\r\n"))
+			case "PUB":
+				assert.Equal(t, subject, fields[1])
+				size, err := strconv.Atoi(fields[2])
+				assert.NoError(t, err)
+				payload := make([]byte, size+2)
+				_, err = io.ReadFull(reader, payload)
+				assert.NoError(t, err)
+				payloads <- payload[:size]
+			}
+		}
+	}()
+
+	return listener, payloads
+}
+
+func TestNATSSink(t *testing.T) {
+	t.Run("publish test", func(t *testing.T) {
+		listener, payloads := newNATSServer(t, "changes")
+		defer func() { assert.NoError(t, listener.Close()) }()
+
+		sink, err := changefeed.NewSink("nats://" + listener.Addr().String() + "/changes")
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, sink.Close()) }()
+
+		assert.NoError(t, sink.Publish(context.Background(), newEvents(1, 2)))
+		for seq := uint64(1); seq <= 2; seq++ {
+			var event changefeed.Event
+			assert.NoError(t, json.Unmarshal(<-payloads, &event))
+			assert.Equal(t, seq, event.ServerSeq)
+		}
+	})
+
+	t.Run("unavailable test", func(t *testing.T) {
+		u, err := url.Parse("nats://127.0.0.1:1")
+		assert.NoError(t, err)
+		sink := changefeed.NewNATSSink(u)
+		assert.Error(t, sink.Publish(context.Background(), newEvents(1, 1)))
+	})
+
+	t.Run("unsupported sink test", func(t *testing.T) {
+		_, err := changefeed.NewSink("kafka://localhost:9092")
+		assert.ErrorIs(t, err, changefeed.ErrUnsupportedSink)
+
+		sink, err := changefeed.NewSink("")
+		assert.NoError(t, err)
+		assert.Equal(t, changefeed.NoopSink{}, sink)
+	})
+}
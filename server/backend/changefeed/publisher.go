@@ -0,0 +1,198 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changefeed
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+const (
+	// DefaultBatchSize is the default max number of events published at once.
+	DefaultBatchSize = 100
+
+	// DefaultFlushInterval is the default interval to publish the pending
+	// events even if the batch is not full.
+	DefaultFlushInterval = 100 * time.Millisecond
+
+	// queueSize is the number of events that can be queued before Add blocks.
+	queueSize = 10000
+
+	// maxWaitInterval is the max interval to wait before retrying to publish.
+	maxWaitInterval = 5 * time.Second
+
+	// closeTimeout is the timeout of the last publish when closing.
+	closeTimeout = 5 * time.Second
+)
+
+var (
+	// ErrPublisherClosed is returned when events are added to the closed
+	// publisher.
+	ErrPublisherClosed = errors.New("changefeed publisher closed")
+)
+
+// Publisher publishes the events to the sink in batches. It retries a batch
+// until it succeeds, so an event is delivered at least once as long as the
+// server is running.
+//
+// NOTE: The pending events are kept only in memory. The events that are not
+// published before the server stops are lost, and consumers can detect the
+// gap with ServerSeq of the events.
+type Publisher struct {
+	sink          Sink
+	batchSize     int
+	flushInterval time.Duration
+
+	events    chan Event
+	closing   chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// NewPublisher creates a new instance of Publisher and starts publishing.
+func NewPublisher(sink Sink, batchSize int, flushInterval time.Duration) *Publisher {
+	p := &Publisher{
+		sink:          sink,
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		events:        make(chan Event, queueSize),
+		closing:       make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+	go p.run()
+	return p
+}
+
+// Add adds the given events to be published. It blocks if the queue is full.
+func (p *Publisher) Add(ctx context.Context, events ...Event) error {
+	for _, event := range events {
+		// NOTE: select chooses randomly among the ready cases, so closing is
+		// checked first not to queue events after the publisher is closed.
+		select {
+		case <-p.closing:
+			return ErrPublisherClosed
+		default:
+		}
+
+		select {
+		case p.events <- event:
+		case <-p.closing:
+			return ErrPublisherClosed
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Close publishes the pending events and closes the sink.
+func (p *Publisher) Close() error {
+	p.closeOnce.Do(func() {
+		close(p.closing)
+		<-p.done
+		if err := p.sink.Close(); err != nil && p.closeErr == nil {
+			p.closeErr = err
+		}
+	})
+	return p.closeErr
+}
+
+func (p *Publisher) run() {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.flushInterval)
+	defer ticker.Stop()
+
+	var batch []Event
+	for {
+		select {
+		case event := <-p.events:
+			batch = append(batch, event)
+			if len(batch) >= p.batchSize {
+				batch = p.flush(batch)
+			}
+		case <-ticker.C:
+			batch = p.flush(batch)
+		case <-p.closing:
+			p.closeErr = p.flushPending(batch)
+			return
+		}
+	}
+}
+
+// flush publishes the given batch, retrying with exponential backoff until it
+// succeeds. It returns the remaining events, which are not empty only if the
+// publisher is closing.
+func (p *Publisher) flush(batch []Event) []Event {
+	if len(batch) == 0 {
+		return batch
+	}
+
+	var retries uint64
+	for {
+		err := p.sink.Publish(context.Background(), batch)
+		if err == nil {
+			return batch[:0]
+		}
+		logging.DefaultLogger().Warnf("changefeed: fail to publish %d events: %v", len(batch), err)
+
+		select {
+		case <-p.closing:
+			return batch
+		case <-time.After(waitInterval(retries)):
+		}
+		if waitInterval(retries) < maxWaitInterval {
+			retries++
+		}
+	}
+}
+
+// flushPending publishes the given batch and the queued events once.
+func (p *Publisher) flushPending(batch []Event) error {
+	for {
+		select {
+		case event := <-p.events:
+			batch = append(batch, event)
+			continue
+		default:
+		}
+		break
+	}
+	if len(batch) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+	return p.sink.Publish(ctx, batch)
+}
+
+// waitInterval returns the interval of given retries. (2^retries * 100) milliseconds.
+func waitInterval(retries uint64) time.Duration {
+	interval := time.Duration(math.Pow(2, float64(retries))) * 100 * time.Millisecond
+	if maxWaitInterval < interval {
+		return maxWaitInterval
+	}
+
+	return interval
+}
@@ -17,10 +17,60 @@
 package backend
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	doctime "github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+)
+
+var (
+	// ErrInvalidHotDocumentWindow occurs when the window for detecting hot
+	// documents is not positive while the detection is enabled.
+	ErrInvalidHotDocumentWindow = errors.New("invalid window for detecting hot documents")
+
+	// ErrInvalidProjectStatsInterval occurs when the interval of the live
+	// statistics of projects is not positive.
+	ErrInvalidProjectStatsInterval = errors.New("invalid interval of project stats")
+
+	// ErrInvalidQueryTimeout occurs when the timeout of database queries is
+	// negative or given for an unknown operation.
+	ErrInvalidQueryTimeout = errors.New("invalid query timeout")
+
+	// ErrInvalidPushMiddlewares occurs when the middlewares of pushes include
+	// an unknown or duplicated one, or miss a required one.
+	ErrInvalidPushMiddlewares = errors.New("invalid push middlewares")
+)
+
+// Below are the names of the built-in middlewares which the changes pass
+// through before they are pushed to a document.
+const (
+	// PushMiddlewareAuthz rejects the changes if the document is not writable
+	// by the client, e.g. locked or in maintenance mode.
+	PushMiddlewareAuthz = "authz"
+
+	// PushMiddlewareValidation rejects the change packs with invalid changes.
+	PushMiddlewareValidation = "validation"
+
+	// PushMiddlewareSquash merges the adjacent operations of the pushed
+	// changes if EnableOperationSquash is set.
+	PushMiddlewareSquash = "squash"
+
+	// PushMiddlewareMetrics records the metrics of the pushed changes.
+	PushMiddlewareMetrics = "metrics"
 )
 
+// DefaultPushMiddlewares is the default order of the middlewares of pushes.
+var DefaultPushMiddlewares = []string{
+	PushMiddlewareAuthz,
+	PushMiddlewareValidation,
+	PushMiddlewareSquash,
+	PushMiddlewareMetrics,
+}
+
 // Config is the configuration for creating a Backend instance.
 type Config struct {
 	// UseDefaultProject is whether to use the default project. Even if public
@@ -35,6 +85,142 @@ type Config struct {
 	// SnapshotInterval is the interval of changes to create a snapshot.
 	SnapshotInterval uint64 `yaml:"SnapshotInterval"`
 
+	// SnapshotOnAttachThreshold is the number of changes after the last
+	// snapshot to create a snapshot in the background when a client attaches
+	// the document. Zero disables it.
+	SnapshotOnAttachThreshold uint64 `yaml:"SnapshotOnAttachThreshold"`
+
+	// TombstoneThreshold is the number of tombstones in the snapshot of a
+	// document to store the next snapshot regardless of SnapshotInterval, as
+	// soon as the tombstones can be collected. Zero disables it.
+	TombstoneThreshold uint64 `yaml:"TombstoneThreshold"`
+
+	// SnapshotRetentionCount is the number of the latest snapshots of each
+	// document to retain for rollback. Zero retains all snapshots unless
+	// SnapshotRetentionPeriod is set.
+	SnapshotRetentionCount uint64 `yaml:"SnapshotRetentionCount"`
+
+	// SnapshotRetentionPeriod is the period to retain snapshots for rollback.
+	// The snapshots created within the period are retained regardless of
+	// SnapshotRetentionCount. Zero disables it.
+	SnapshotRetentionPeriod string `yaml:"SnapshotRetentionPeriod"`
+
+	// SnapshotCompression is the algorithm to compress the snapshots stored.
+	// One of "none" and "gzip". Empty means "none".
+	SnapshotCompression string `yaml:"SnapshotCompression"`
+
+	// SnapshotWriteMaxRetries is the max count that retries writing a
+	// snapshot after a failure.
+	SnapshotWriteMaxRetries uint64 `yaml:"SnapshotWriteMaxRetries"`
+
+	// SnapshotWriteMaxWaitInterval is the max interval that waits before
+	// retrying to write a snapshot.
+	SnapshotWriteMaxWaitInterval string `yaml:"SnapshotWriteMaxWaitInterval"`
+
+	// MaxLamportGap is the acceptance window of Lamport timestamps. Changes
+	// whose Lamport timestamp exceeds the largest one of the document by more
+	// than this are rejected. Zero disables it.
+	MaxLamportGap uint64 `yaml:"MaxLamportGap"`
+
+	// LamportFlushInterval is the number of changes after which the largest
+	// Lamport timestamp of a document is stored by a push. The Lamport of the
+	// changes stored in between is recovered from the changes when it is
+	// needed. Zero or one stores it with every push.
+	LamportFlushInterval uint64 `yaml:"LamportFlushInterval"`
+
+	// MaxValueBytes is the maximum size in bytes of a primitive value or the
+	// content of a text edit in pushed changes. Zero disables it.
+	MaxValueBytes uint64 `yaml:"MaxValueBytes"`
+
+	// MaxKeysPerObject is the maximum number of live keys of an Object in
+	// documents. Sets adding keys beyond it are rejected, while the removed
+	// keys are not counted. Projects can override it. Zero disables it.
+	MaxKeysPerObject uint64 `yaml:"MaxKeysPerObject"`
+
+	// MaxOperationsPerPush is the maximum number of operations of the changes
+	// pushed by a request. The changes after the limit are deferred to the
+	// following requests, so that a client replaying a large backlog can not
+	// monopolize a document. Zero disables it.
+	MaxOperationsPerPush uint64 `yaml:"MaxOperationsPerPush"`
+
+	// HotDocumentThreshold is the number of operations per second pushed to a
+	// document above which the document is detected as hot. Zero disables it.
+	HotDocumentThreshold float64 `yaml:"HotDocumentThreshold"`
+
+	// HotDocumentWindow is the sliding window to measure the change rate of
+	// documents for detecting hot documents.
+	HotDocumentWindow string `yaml:"HotDocumentWindow"`
+
+	// ProjectStatsInterval is the interval of the live statistics of a project
+	// sent to the admins watching them. The events changing the statistics
+	// significantly are sent without waiting for it.
+	ProjectStatsInterval string `yaml:"ProjectStatsInterval"`
+
+	// MaxActorsPerDocument is the maximum number of distinct clients that have
+	// attached a document. New clients are rejected to attach the document
+	// when it is exceeded. Zero disables it.
+	MaxActorsPerDocument int `yaml:"MaxActorsPerDocument"`
+
+	// MaxVersionVectorSize is the maximum number of synced seqs of a document,
+	// one for each client attaching the document, which are referenced to
+	// find the min synced ticket. New clients are rejected to attach the
+	// document when it is exceeded. Zero disables it.
+	MaxVersionVectorSize int `yaml:"MaxVersionVectorSize"`
+
+	// MaxPathDepth is the maximum depth of the paths of elements given by
+	// clients, e.g. 3 for "$.todos.0.title", so that a pathological path
+	// does not make the server traverse deep structures. Zero disables it.
+	MaxPathDepth int `yaml:"MaxPathDepth"`
+
+	// MaxProjects is the maximum number of projects of the deployment. New
+	// projects are rejected to be created when it is reached, so that a
+	// runaway provisioning can not flood a shared cluster with projects. Zero
+	// disables it.
+	MaxProjects int `yaml:"MaxProjects"`
+
+	// EnableSubtreeWatch is whether to map pushed changes to the paths of the
+	// changed elements so that clients can watch subtrees of documents. It
+	// rebuilds the document for each push, so it is disabled by default.
+	EnableSubtreeWatch bool `yaml:"EnableSubtreeWatch"`
+
+	// EnableDocEventLog is whether to record the event log of documents,
+	// which keeps the version vector and the fingerprint of the document after
+	// each change for debugging. It stores an entry for each change, so it is
+	// disabled by default.
+	EnableDocEventLog bool `yaml:"EnableDocEventLog"`
+
+	// EnableOperationSquash is whether to merge the adjacent operations of
+	// pushed changes into fewer operations before storing them. Only the
+	// operations that are provably equivalent are merged.
+	EnableOperationSquash bool `yaml:"EnableOperationSquash"`
+
+	// IndexedMetadataKeys is the metadata keys of documents that can be used
+	// to filter the list of documents. Filtering by the other keys is
+	// rejected to avoid scanning all the documents of a project.
+	//
+	// NOTE: MongoDB deployments must create the index below on the documents
+	// collection for each key, since the indexes are not created by Yorkie:
+	// {"project_id": 1, "metadata.<key>": 1, "_id": 1}
+	IndexedMetadataKeys []string `yaml:"IndexedMetadataKeys"`
+
+	// IDGenerator is the name of the generator of IDs of projects, clients
+	// and documents. It is one of "objectid" and "time-sortable".
+	IDGenerator string `yaml:"IDGenerator"`
+
+	// ActorIDSize is the length of the actor IDs accepted by the server in
+	// bytes. Zero means the default length, 12, which is the length of the
+	// IDs of the clients.
+	ActorIDSize int `yaml:"ActorIDSize"`
+
+	// ActorIDPrefix is the hexadecimal prefix of the actor IDs accepted by the
+	// server, e.g. to embed tenant info. The IDs of the clients are generated
+	// with it, which only the "time-sortable" IDGenerator supports.
+	ActorIDPrefix string `yaml:"ActorIDPrefix"`
+
+	// ClientReactivationGracePeriod is the period during which a deactivated
+	// client can be reactivated by attaching a document. Zero disables it.
+	ClientReactivationGracePeriod string `yaml:"ClientReactivationGracePeriod"`
+
 	// AuthWebhookMaxRetries is the max count that retries the authorization webhook.
 	AuthWebhookMaxRetries uint64 `yaml:"AuthWebhookMaxRetries"`
 
@@ -49,10 +235,144 @@ type Config struct {
 
 	// AuthWebhookCacheUnauthTTL is the TTL value to set when caching the unauthorized result.
 	AuthWebhookCacheUnauthTTL string `yaml:"AuthWebhookCacheUnauthTTL"`
+
+	// DocumentCountCacheTTL is the TTL value to set when caching the number of
+	// documents of projects. The document counts in the list of projects may
+	// be stale by up to this value.
+	DocumentCountCacheTTL string `yaml:"DocumentCountCacheTTL"`
+
+	// EventBatchWindow is the window to coalesce the change events of each
+	// document before broadcasting them to the watchers. Events are delayed
+	// by the window at most. Zero disables it.
+	EventBatchWindow string `yaml:"EventBatchWindow"`
+
+	// QueryTimeout is the timeout of each operation of the database, so that
+	// a stuck query fails rather than hanging the request. Empty or zero
+	// disables it.
+	QueryTimeout string `yaml:"QueryTimeout"`
+
+	// QueryTimeoutOverrides is the timeouts of the operations of the database
+	// which replace QueryTimeout, keyed by the name of the operation, e.g.
+	// "FindDocInfosByPaging" for listing documents. Zero disables the
+	// timeout of the operation.
+	QueryTimeoutOverrides map[string]string `yaml:"QueryTimeoutOverrides"`
+
+	// DBHealthCheckInterval is the interval to check the connection to the
+	// database. While the connection is lost, the server reconnects with
+	// backoff and rejects requests. Empty or zero disables it.
+	DBHealthCheckInterval string `yaml:"DBHealthCheckInterval"`
+
+	// DBReconnectMaxBackoff is the max interval between the attempts to
+	// reconnect to the database. The interval doubles from the health check
+	// interval up to this value.
+	DBReconnectMaxBackoff string `yaml:"DBReconnectMaxBackoff"`
+
+	// ReadYourWritesTimeout is the max time to wait for the server to catch
+	// up to the write of a consistency token, e.g. when it reads from a
+	// replica of the database behind the primary. The request is rejected if
+	// the server does not catch up in time. Zero rejects it without waiting.
+	ReadYourWritesTimeout string `yaml:"ReadYourWritesTimeout"`
+
+	// RemovedDocumentRetention is the period within which a removed document
+	// can be restored by attaching to its key, if the project restores the
+	// removed documents. Empty or zero means no limit.
+	RemovedDocumentRetention string `yaml:"RemovedDocumentRetention"`
+
+	// OperationIDWindow is the window in which the IDs of the operations
+	// pushed to each document are checked. The changes with the operations
+	// whose IDs are already stored in the document within the window are
+	// rejected, so that clients can not apply them twice. Empty or zero
+	// disables it.
+	OperationIDWindow string `yaml:"OperationIDWindow"`
+
+	// SnapshotCacheBytes is the max total bytes of the snapshots whose
+	// materialized roots are cached, so that the documents read frequently,
+	// e.g. by Export and Diff, are not decoded from the snapshots each time.
+	// Zero disables it.
+	SnapshotCacheBytes int64 `yaml:"SnapshotCacheBytes"`
+
+	// OperationSampleInterval is the interval of the operations whose apply
+	// durations are measured when the server applies changes to documents,
+	// e.g. 10 measures one in every 10 operations. Zero disables it.
+	OperationSampleInterval int `yaml:"OperationSampleInterval"`
+
+	// BackgroundQueueWarnThreshold is the number of the pending snapshot or
+	// GC jobs in the background above which a warning is logged, telling
+	// that the server can not keep up with them. Zero disables it.
+	BackgroundQueueWarnThreshold int `yaml:"BackgroundQueueWarnThreshold"`
+
+	// PushMiddlewares is the order of the middlewares which the changes pass
+	// through before they are pushed, e.g. ["authz", "validation"]. The
+	// middlewares not listed are skipped, except "authz" and "validation"
+	// which are required. Empty means DefaultPushMiddlewares.
+	PushMiddlewares []string `yaml:"PushMiddlewares"`
+
+	// EventWebhookMaxRetries is the max count that retries the event webhook.
+	EventWebhookMaxRetries uint64 `yaml:"EventWebhookMaxRetries"`
+
+	// EventWebhookMaxWaitInterval is the max interval that waits before retrying the event webhook.
+	EventWebhookMaxWaitInterval string `yaml:"EventWebhookMaxWaitInterval"`
 }
 
 // Validate validates this config.
 func (c *Config) Validate() error {
+	actorIDFormat, err := c.newActorIDFormat()
+	if err != nil {
+		return fmt.Errorf(
+			`invalid argument "%d" and "%s" for "--backend-actor-id-size" and "--backend-actor-id-prefix" flags: %w`,
+			c.ActorIDSize,
+			c.ActorIDPrefix,
+			err,
+		)
+	}
+
+	idGenerator, err := database.NewIDGenerator(c.IDGenerator, actorIDFormat.Prefix)
+	if err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-id-generator" flag: %w`,
+			c.IDGenerator,
+			err,
+		)
+	}
+
+	// NOTE: The clients use their IDs as their actor IDs, so the IDs must
+	// match the actor ID format to push changes.
+	id := idGenerator.NewID()
+	actorID, err := id.ToActorID()
+	if err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-id-generator" flag: %w`,
+			c.IDGenerator,
+			err,
+		)
+	}
+	if err := actorIDFormat.Validate(actorID); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%d" for "--backend-actor-id-size" flag: client ID %s: %w`,
+			c.ActorIDSize,
+			id,
+			err,
+		)
+	}
+
+	for _, k := range c.IndexedMetadataKeys {
+		if !types.IsValidDocumentMetadataKey(k) {
+			return fmt.Errorf(
+				`invalid argument "%s" for "--backend-indexed-metadata-keys" flag: %w`,
+				k,
+				types.ErrInvalidDocumentMetadata,
+			)
+		}
+	}
+
+	if _, err := time.ParseDuration(c.ClientReactivationGracePeriod); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-client-reactivation-grace-period" flag: %w`,
+			c.ClientReactivationGracePeriod,
+			err,
+		)
+	}
+
 	if _, err := time.ParseDuration(c.AuthWebhookMaxWaitInterval); err != nil {
 		return fmt.Errorf(
 			`invalid argument "%s" for "--auth-webhook-max-wait-interval" flag: %w`,
@@ -77,9 +397,228 @@ func (c *Config) Validate() error {
 		)
 	}
 
+	if _, err := time.ParseDuration(c.SnapshotRetentionPeriod); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-snapshot-retention-period" flag: %w`,
+			c.SnapshotRetentionPeriod,
+			err,
+		)
+	}
+
+	if c.SnapshotCompression != "" && !types.IsSnapshotCompression(c.SnapshotCompression) {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-snapshot-compression" flag: %w`,
+			c.SnapshotCompression,
+			types.ErrInvalidSnapshotPolicy,
+		)
+	}
+
+	if _, err := time.ParseDuration(c.SnapshotWriteMaxWaitInterval); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-snapshot-write-max-wait-interval" flag: %w`,
+			c.SnapshotWriteMaxWaitInterval,
+			err,
+		)
+	}
+
+	if _, err := time.ParseDuration(c.DocumentCountCacheTTL); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-document-count-cache-ttl" flag: %w`,
+			c.DocumentCountCacheTTL,
+			err,
+		)
+	}
+
+	if _, err := time.ParseDuration(c.EventBatchWindow); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-event-batch-window" flag: %w`,
+			c.EventBatchWindow,
+			err,
+		)
+	}
+
+	hotDocumentWindow, err := time.ParseDuration(c.HotDocumentWindow)
+	if err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-hot-document-window" flag: %w`,
+			c.HotDocumentWindow,
+			err,
+		)
+	}
+	if c.HotDocumentThreshold > 0 && hotDocumentWindow <= 0 {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-hot-document-window" flag: %w`,
+			c.HotDocumentWindow,
+			ErrInvalidHotDocumentWindow,
+		)
+	}
+
+	projectStatsInterval, err := time.ParseDuration(c.ProjectStatsInterval)
+	if err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-project-stats-interval" flag: %w`,
+			c.ProjectStatsInterval,
+			err,
+		)
+	}
+	if projectStatsInterval <= 0 {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-project-stats-interval" flag: %w`,
+			c.ProjectStatsInterval,
+			ErrInvalidProjectStatsInterval,
+		)
+	}
+
+	if _, err := time.ParseDuration(c.EventWebhookMaxWaitInterval); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--event-webhook-max-wait-interval" flag: %w`,
+			c.EventWebhookMaxWaitInterval,
+			err,
+		)
+	}
+
+	if _, err := parseQueryTimeout(c.QueryTimeout); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-query-timeout" flag: %w`,
+			c.QueryTimeout,
+			err,
+		)
+	}
+
+	for operation, timeout := range c.QueryTimeoutOverrides {
+		if !database.IsOperation(operation) {
+			return fmt.Errorf(
+				`invalid argument "%s=%s" for "--backend-query-timeout-overrides" flag: unknown operation: %w`,
+				operation,
+				timeout,
+				ErrInvalidQueryTimeout,
+			)
+		}
+		if _, err := parseQueryTimeout(timeout); err != nil {
+			return fmt.Errorf(
+				`invalid argument "%s=%s" for "--backend-query-timeout-overrides" flag: %w`,
+				operation,
+				timeout,
+				err,
+			)
+		}
+	}
+
+	if _, err := parseOptionalDuration(c.DBHealthCheckInterval); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-db-health-check-interval" flag: %w`,
+			c.DBHealthCheckInterval,
+			err,
+		)
+	}
+
+	if _, err := parseOptionalDuration(c.DBReconnectMaxBackoff); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-db-reconnect-max-backoff" flag: %w`,
+			c.DBReconnectMaxBackoff,
+			err,
+		)
+	}
+
+	if _, err := parseOptionalDuration(c.ReadYourWritesTimeout); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-read-your-writes-timeout" flag: %w`,
+			c.ReadYourWritesTimeout,
+			err,
+		)
+	}
+
+	if _, err := parseOptionalDuration(c.RemovedDocumentRetention); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-removed-document-retention" flag: %w`,
+			c.RemovedDocumentRetention,
+			err,
+		)
+	}
+
+	if _, err := parseOptionalDuration(c.OperationIDWindow); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-operation-id-window" flag: %w`,
+			c.OperationIDWindow,
+			err,
+		)
+	}
+	if err := validatePushMiddlewares(c.PushMiddlewares); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-push-middlewares" flag: %w`,
+			strings.Join(c.PushMiddlewares, ","),
+			err,
+		)
+	}
+
+	return nil
+}
+
+// validatePushMiddlewares validates the given names of the middlewares of
+// pushes.
+func validatePushMiddlewares(names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		known := false
+		for _, builtin := range DefaultPushMiddlewares {
+			if name == builtin {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("unknown middleware %s: %w", name, ErrInvalidPushMiddlewares)
+		}
+		if seen[name] {
+			return fmt.Errorf("duplicated middleware %s: %w", name, ErrInvalidPushMiddlewares)
+		}
+		seen[name] = true
+	}
+
+	for _, required := range []string{PushMiddlewareAuthz, PushMiddlewareValidation} {
+		if !seen[required] {
+			return fmt.Errorf("missing middleware %s: %w", required, ErrInvalidPushMiddlewares)
+		}
+	}
+
 	return nil
 }
 
+// ParsePushMiddlewares returns the names of the middlewares of pushes in
+// order.
+func (c *Config) ParsePushMiddlewares() []string {
+	if len(c.PushMiddlewares) == 0 {
+		return DefaultPushMiddlewares
+	}
+	return c.PushMiddlewares
+}
+
+// IsIndexedMetadataKey returns whether the given metadata key can be used to
+// filter the list of documents.
+func (c *Config) IsIndexedMetadataKey(k string) bool {
+	for _, indexed := range c.IndexedMetadataKeys {
+		if indexed == k {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseClientReactivationGracePeriod returns the grace period for reactivating
+// deactivated clients.
+func (c *Config) ParseClientReactivationGracePeriod() time.Duration {
+	result, err := time.ParseDuration(c.ClientReactivationGracePeriod)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
 // ParseAuthWebhookMaxWaitInterval returns max wait interval.
 func (c *Config) ParseAuthWebhookMaxWaitInterval() time.Duration {
 	result, err := time.ParseDuration(c.AuthWebhookMaxWaitInterval)
@@ -109,3 +648,228 @@ func (c *Config) ParseAuthWebhookCacheUnauthTTL() time.Duration {
 
 	return result
 }
+
+// ActorIDFormat returns the format of the actor IDs accepted by the server.
+func (c *Config) ActorIDFormat() *types.ActorIDFormat {
+	result, err := c.newActorIDFormat()
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+func (c *Config) newActorIDFormat() (*types.ActorIDFormat, error) {
+	size := c.ActorIDSize
+	if size == 0 {
+		size = doctime.DefaultActorIDSize
+	}
+
+	return types.NewActorIDFormat(size, c.ActorIDPrefix)
+}
+
+// ParseSnapshotRetentionPeriod returns the period to retain snapshots.
+func (c *Config) ParseSnapshotRetentionPeriod() time.Duration {
+	result, err := time.ParseDuration(c.SnapshotRetentionPeriod)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// SnapshotPolicy returns the effective snapshot policy of the given project,
+// whose settings not set in the project follow this config.
+func (c *Config) SnapshotPolicy(project *types.Project) types.SnapshotPolicy {
+	compression := c.SnapshotCompression
+	if compression == "" {
+		compression = string(types.SnapshotCompressionNone)
+	}
+
+	return project.SnapshotPolicy.Merge(types.SnapshotPolicy{
+		Threshold:      c.SnapshotThreshold,
+		Compression:    compression,
+		RetentionCount: c.SnapshotRetentionCount,
+	})
+}
+
+// ObjectKeyLimit returns the maximum number of live keys of an Object in
+// documents of the given project. Zero means that it is unlimited.
+func (c *Config) ObjectKeyLimit(project *types.Project) int {
+	if project != nil && project.MaxKeysPerObject > 0 {
+		return project.MaxKeysPerObject
+	}
+
+	return int(c.MaxKeysPerObject)
+}
+
+// ParseSnapshotWriteMaxWaitInterval returns the max interval to wait before
+// retrying to write a snapshot.
+func (c *Config) ParseSnapshotWriteMaxWaitInterval() time.Duration {
+	result, err := time.ParseDuration(c.SnapshotWriteMaxWaitInterval)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// ParseDocumentCountCacheTTL returns TTL for the document count cache.
+func (c *Config) ParseDocumentCountCacheTTL() time.Duration {
+	result, err := time.ParseDuration(c.DocumentCountCacheTTL)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// ParseEventBatchWindow returns the window to coalesce the change events.
+func (c *Config) ParseEventBatchWindow() time.Duration {
+	result, err := time.ParseDuration(c.EventBatchWindow)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// ParseHotDocumentWindow returns the window to measure the change rate of
+// documents.
+func (c *Config) ParseHotDocumentWindow() time.Duration {
+	result, err := time.ParseDuration(c.HotDocumentWindow)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// ParseProjectStatsInterval returns the interval of the live statistics of a
+// project sent to the watchers.
+func (c *Config) ParseProjectStatsInterval() time.Duration {
+	result, err := time.ParseDuration(c.ProjectStatsInterval)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// ParseEventWebhookMaxWaitInterval returns max wait interval of the event
+// webhook.
+func (c *Config) ParseEventWebhookMaxWaitInterval() time.Duration {
+	result, err := time.ParseDuration(c.EventWebhookMaxWaitInterval)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// ParseQueryTimeout returns the timeout of each operation of the database.
+// Zero means no limit.
+func (c *Config) ParseQueryTimeout() time.Duration {
+	result, err := parseQueryTimeout(c.QueryTimeout)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// ParseQueryTimeoutOverrides returns the timeouts of the operations of the
+// database which replace QueryTimeout.
+func (c *Config) ParseQueryTimeoutOverrides() map[string]time.Duration {
+	overrides := make(map[string]time.Duration, len(c.QueryTimeoutOverrides))
+	for operation, timeout := range c.QueryTimeoutOverrides {
+		result, err := parseQueryTimeout(timeout)
+		if err != nil {
+			panic(err)
+		}
+		overrides[operation] = result
+	}
+
+	return overrides
+}
+
+// ParseDBHealthCheckInterval returns the interval to check the connection to
+// the database. Zero means the check is disabled.
+func (c *Config) ParseDBHealthCheckInterval() time.Duration {
+	result, err := parseOptionalDuration(c.DBHealthCheckInterval)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// ParseDBReconnectMaxBackoff returns the max interval between the attempts to
+// reconnect to the database.
+func (c *Config) ParseDBReconnectMaxBackoff() time.Duration {
+	result, err := parseOptionalDuration(c.DBReconnectMaxBackoff)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// ParseReadYourWritesTimeout returns the max time to wait for the server to
+// catch up to the write of a consistency token.
+func (c *Config) ParseReadYourWritesTimeout() time.Duration {
+	result, err := parseOptionalDuration(c.ReadYourWritesTimeout)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// ParseRemovedDocumentRetention returns the period within which a removed
+// document can be restored. Zero means no limit.
+func (c *Config) ParseRemovedDocumentRetention() time.Duration {
+	result, err := parseOptionalDuration(c.RemovedDocumentRetention)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// ParseOperationIDWindow returns the window in which the IDs of the operations
+// are checked. Zero means the check is disabled.
+func (c *Config) ParseOperationIDWindow() time.Duration {
+	result, err := parseOptionalDuration(c.OperationIDWindow)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// parseOptionalDuration parses the given duration. Empty means zero.
+func parseOptionalDuration(duration string) (time.Duration, error) {
+	if duration == "" {
+		return 0, nil
+	}
+
+	return time.ParseDuration(duration)
+}
+
+// parseQueryTimeout parses the given timeout of queries. Empty means no limit.
+func parseQueryTimeout(timeout string) (time.Duration, error) {
+	if timeout == "" {
+		return 0, nil
+	}
+
+	result, err := time.ParseDuration(timeout)
+	if err != nil {
+		return 0, err
+	}
+	if result < 0 {
+		return 0, ErrInvalidQueryTimeout
+	}
+
+	return result, nil
+}
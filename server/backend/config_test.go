@@ -18,18 +18,29 @@ package backend_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
 )
 
 func TestConfig(t *testing.T) {
 	t.Run("validate test", func(t *testing.T) {
 		validConf := backend.Config{
-			AuthWebhookMaxWaitInterval: "0ms",
-			AuthWebhookCacheAuthTTL:    "10s",
-			AuthWebhookCacheUnauthTTL:  "10s",
+			ClientReactivationGracePeriod: "10m",
+			AuthWebhookMaxWaitInterval:    "0ms",
+			AuthWebhookCacheAuthTTL:       "10s",
+			AuthWebhookCacheUnauthTTL:     "10s",
+			EventWebhookMaxWaitInterval:   "0ms",
+			DocumentCountCacheTTL:         "10s",
+			EventBatchWindow:              "10ms",
+			HotDocumentWindow:             "10s",
+			ProjectStatsInterval:          "5s",
+			SnapshotRetentionPeriod:       "0s",
+			SnapshotWriteMaxWaitInterval:  "0ms",
 		}
 		assert.NoError(t, validConf.Validate())
 
@@ -44,5 +55,130 @@ func TestConfig(t *testing.T) {
 		conf4 := validConf
 		conf4.AuthWebhookCacheUnauthTTL = "s"
 		assert.Error(t, conf4.Validate())
+
+		conf5 := validConf
+		conf5.ClientReactivationGracePeriod = "10"
+		assert.Error(t, conf5.Validate())
+
+		conf6 := validConf
+		conf6.IDGenerator = "uuid"
+		assert.Error(t, conf6.Validate())
+
+		conf7 := validConf
+		conf7.EventWebhookMaxWaitInterval = "5"
+		assert.Error(t, conf7.Validate())
+
+		conf8 := validConf
+		conf8.DocumentCountCacheTTL = "10"
+		assert.Error(t, conf8.Validate())
+
+		conf9 := validConf
+		conf9.SnapshotRetentionPeriod = "1"
+		assert.Error(t, conf9.Validate())
+
+		conf10 := validConf
+		conf10.IndexedMetadataKeys = []string{"owner", "metadata.owner"}
+		assert.Error(t, conf10.Validate())
+
+		conf11 := validConf
+		conf11.EventBatchWindow = "10"
+		assert.Error(t, conf11.Validate())
+
+		conf12 := validConf
+		conf12.HotDocumentWindow = "10"
+		assert.Error(t, conf12.Validate())
+
+		conf13 := validConf
+		conf13.HotDocumentThreshold = 100
+		conf13.HotDocumentWindow = "0s"
+		assert.ErrorIs(t, conf13.Validate(), backend.ErrInvalidHotDocumentWindow)
+
+		conf14 := validConf
+		conf14.SnapshotWriteMaxWaitInterval = "5"
+		assert.Error(t, conf14.Validate())
+
+		conf15 := validConf
+		conf15.QueryTimeout = "-1s"
+		assert.ErrorIs(t, conf15.Validate(), backend.ErrInvalidQueryTimeout)
+
+		conf16 := validConf
+		conf16.QueryTimeoutOverrides = map[string]string{"FindDocInfosByPaging": "1m"}
+		assert.NoError(t, conf16.Validate())
+		assert.Equal(t, time.Minute, conf16.ParseQueryTimeoutOverrides()["FindDocInfosByPaging"])
+
+		conf16.QueryTimeoutOverrides = map[string]string{"FindEverything": "1m"}
+		assert.ErrorIs(t, conf16.Validate(), backend.ErrInvalidQueryTimeout)
+
+		conf16.QueryTimeoutOverrides = map[string]string{"FindDocInfosByPaging": "1"}
+		assert.Error(t, conf16.Validate())
+
+		conf17 := validConf
+		assert.Equal(t, backend.DefaultPushMiddlewares, conf17.ParsePushMiddlewares())
+		conf17.PushMiddlewares = []string{"validation", "authz", "metrics"}
+		assert.NoError(t, conf17.Validate())
+		assert.Equal(t, conf17.PushMiddlewares, conf17.ParsePushMiddlewares())
+
+		conf17.PushMiddlewares = []string{"authz", "validation", "unknown"}
+		assert.ErrorIs(t, conf17.Validate(), backend.ErrInvalidPushMiddlewares)
+		conf17.PushMiddlewares = []string{"authz", "validation", "authz"}
+		assert.ErrorIs(t, conf17.Validate(), backend.ErrInvalidPushMiddlewares)
+		conf17.PushMiddlewares = []string{"validation", "metrics"}
+		assert.ErrorIs(t, conf17.Validate(), backend.ErrInvalidPushMiddlewares)
+
+		conf18 := validConf
+		conf18.OperationIDWindow = "1m"
+		assert.NoError(t, conf18.Validate())
+		assert.Equal(t, time.Minute, conf18.ParseOperationIDWindow())
+
+		conf18.OperationIDWindow = "1"
+		assert.Error(t, conf18.Validate())
+
+		conf19 := validConf
+		assert.Equal(t, 12, conf19.ActorIDFormat().Size)
+		conf19.ActorIDSize = 8
+		assert.ErrorIs(t, conf19.Validate(), types.ErrActorIDFormatMismatch)
+		conf19.ActorIDSize = 4
+		assert.ErrorIs(t, conf19.Validate(), types.ErrInvalidActorIDFormat)
+
+		conf20 := validConf
+		conf20.ActorIDPrefix = "0a0b"
+		assert.ErrorIs(t, conf20.Validate(), database.ErrUnsupportedIDGenerator)
+		conf20.IDGenerator = database.TimeSortableIDGeneratorName
+		assert.NoError(t, conf20.Validate())
+		assert.Equal(t, []byte{0x0a, 0x0b}, conf20.ActorIDFormat().Prefix)
+		conf20.ActorIDPrefix = "0a0b0c"
+		assert.ErrorIs(t, conf20.Validate(), database.ErrInvalidIDGenerator)
+
+		conf21 := validConf
+		conf21.ReadYourWritesTimeout = "1"
+		assert.Error(t, conf21.Validate())
+
+		conf22 := validConf
+		conf22.ProjectStatsInterval = "5"
+		assert.Error(t, conf22.Validate())
+		conf22.ProjectStatsInterval = "0s"
+		assert.ErrorIs(t, conf22.Validate(), backend.ErrInvalidProjectStatsInterval)
+
+		conf23 := validConf
+		conf23.RemovedDocumentRetention = "1"
+		assert.Error(t, conf23.Validate())
+		conf23.RemovedDocumentRetention = "1h"
+		assert.NoError(t, conf23.Validate())
+		assert.Equal(t, time.Hour, conf23.ParseRemovedDocumentRetention())
+	})
+
+	t.Run("object key limit test", func(t *testing.T) {
+		conf := backend.Config{}
+		assert.Equal(t, 0, conf.ObjectKeyLimit(&types.Project{}))
+
+		conf.MaxKeysPerObject = 100
+		assert.Equal(t, 100, conf.ObjectKeyLimit(&types.Project{}))
+		assert.Equal(t, 10, conf.ObjectKeyLimit(&types.Project{MaxKeysPerObject: 10}))
+	})
+
+	t.Run("indexed metadata keys test", func(t *testing.T) {
+		conf := backend.Config{IndexedMetadataKeys: []string{"owner"}}
+		assert.True(t, conf.IsIndexedMetadataKey("owner"))
+		assert.False(t, conf.IsIndexedMetadataKey("category"))
 	})
 }
@@ -0,0 +1,95 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package conflictwins counts the concurrent writes to the same keys of
+// Objects won by each actor, to detect a client which consistently overrides
+// the others.
+package conflictwins
+
+import (
+	"sort"
+	gosync "sync"
+
+	"github.com/yorkie-team/yorkie/api/types"
+)
+
+// MaxActors is the maximum number of the actors whose wins are counted
+// separately in a project. The wins of the other actors are counted together
+// as OtherActors to keep the statistics bounded.
+const MaxActors = 16
+
+// OtherActors is the label of the actors beyond MaxActors.
+const OtherActors = "other"
+
+// Counter counts the conflict wins of the actors of each project.
+type Counter struct {
+	lock gosync.RWMutex
+	wins map[types.ID]map[string]int
+}
+
+// New creates a new instance of Counter.
+func New() *Counter {
+	return &Counter{
+		wins: make(map[types.ID]map[string]int),
+	}
+}
+
+// Record records that the value written by the given actor wins a concurrent
+// write in a document of the given project.
+func (c *Counter) Record(projectID types.ID, actorID types.ID) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	wins, ok := c.wins[projectID]
+	if !ok {
+		wins = make(map[string]int)
+		c.wins[projectID] = wins
+	}
+
+	label := actorID.String()
+	if _, ok := wins[label]; !ok {
+		actors := len(wins)
+		if _, ok := wins[OtherActors]; ok {
+			actors--
+		}
+		if actors >= MaxActors {
+			label = OtherActors
+		}
+	}
+	wins[label]++
+}
+
+// Wins returns the conflict wins of the actors of the given project in
+// descending order of the wins.
+func (c *Counter) Wins(projectID types.ID) []*types.ActorConflictWins {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var wins []*types.ActorConflictWins
+	for actor, count := range c.wins[projectID] {
+		wins = append(wins, &types.ActorConflictWins{
+			Actor: actor,
+			Wins:  count,
+		})
+	}
+	sort.Slice(wins, func(i, j int) bool {
+		if wins[i].Wins != wins[j].Wins {
+			return wins[i].Wins > wins[j].Wins
+		}
+		return wins[i].Actor < wins[j].Actor
+	})
+	return wins
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conflictwins_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/server/backend/conflictwins"
+)
+
+func TestCounter(t *testing.T) {
+	projectA := types.ID("000000000000000000000001")
+	projectB := types.ID("000000000000000000000002")
+	actorID := func(i int) types.ID {
+		return types.ID(fmt.Sprintf("%024x", i+1))
+	}
+
+	t.Run("count wins of actors test", func(t *testing.T) {
+		counter := conflictwins.New()
+		counter.Record(projectA, actorID(0))
+		counter.Record(projectA, actorID(1))
+		counter.Record(projectA, actorID(1))
+
+		wins := counter.Wins(projectA)
+		assert.Len(t, wins, 2)
+		assert.Equal(t, string(actorID(1)), wins[0].Actor)
+		assert.Equal(t, 2, wins[0].Wins)
+		assert.Equal(t, string(actorID(0)), wins[1].Actor)
+		assert.Equal(t, 1, wins[1].Wins)
+		assert.Empty(t, counter.Wins(projectB))
+	})
+
+	t.Run("bound actors test", func(t *testing.T) {
+		counter := conflictwins.New()
+
+		// 01. The actors beyond the limit are counted together.
+		for i := 0; i < conflictwins.MaxActors+3; i++ {
+			counter.Record(projectA, actorID(i))
+		}
+		wins := counter.Wins(projectA)
+		assert.Len(t, wins, conflictwins.MaxActors+1)
+		assert.Equal(t, conflictwins.OtherActors, wins[0].Actor)
+		assert.Equal(t, 3, wins[0].Wins)
+
+		// 02. The actors already counted keep their own counts.
+		counter.Record(projectA, actorID(0))
+		counter.Record(projectA, actorID(0))
+		counter.Record(projectA, actorID(0))
+		assert.Equal(t, string(actorID(0)), counter.Wins(projectA)[0].Actor)
+	})
+}
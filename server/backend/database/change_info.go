@@ -17,7 +17,11 @@
 package database
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
 	"errors"
+	gotime "time"
 
 	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/api/converter"
@@ -40,6 +44,57 @@ type ChangeInfo struct {
 	ActorID    types.ID `bson:"actor_id"`
 	Message    string   `bson:"message"`
 	Operations [][]byte `bson:"operations"`
+
+	// PayloadHash is the hash of the message and the operations. The ID of a
+	// change is not unique, since a client reuses it after attaching a new
+	// document of the same key, so the change is identified by its ID and
+	// this hash.
+	PayloadHash string `bson:"payload_hash"`
+
+	// OperationIDs is the IDs given by the client to the operations.
+	OperationIDs []string `bson:"operation_ids,omitempty"`
+
+	// CreatedAt is the time when the change is stored.
+	CreatedAt gotime.Time `bson:"created_at"`
+}
+
+// ChangeInfoBatch is the changes of a document to store together with the
+// changes of other documents by CreateChangeInfosOfDocuments.
+type ChangeInfoBatch struct {
+	// DocInfo is the document of the changes, whose server sequence and
+	// Lamport timestamp are already increased for the changes.
+	DocInfo *DocInfo
+
+	// InitialServerSeq is the server sequence of the document before the
+	// changes.
+	InitialServerSeq uint64
+
+	// Changes is the changes to store.
+	Changes []*change.Change
+}
+
+// CountOperations returns the number of the operations of the given changes
+// by kind.
+func CountOperations(changes []*change.Change) map[string]int64 {
+	counts := make(map[string]int64)
+	for _, cn := range changes {
+		for _, op := range cn.Operations() {
+			counts[operations.Kind(op)]++
+		}
+	}
+	return counts
+}
+
+// OperationIDs returns the IDs given by the client to the operations of the
+// given change.
+func OperationIDs(cn *change.Change) []string {
+	var ids []string
+	for _, op := range cn.Operations() {
+		if op.ID() != "" {
+			ids = append(ids, op.ID())
+		}
+	}
+	return ids
 }
 
 // EncodeOperations encodes the given operations into bytes array.
@@ -62,6 +117,25 @@ func EncodeOperations(operations []operations.Operation) ([][]byte, error) {
 	return encodedOps, nil
 }
 
+// PayloadHash returns the hash of the given message and encoded operations.
+// A change stored with the same ID and payload hash is the same change
+// delivered again.
+func PayloadHash(message string, encodedOps [][]byte) string {
+	hash := sha256.New()
+	write := func(b []byte) {
+		var size [8]byte
+		binary.BigEndian.PutUint64(size[:], uint64(len(b)))
+		hash.Write(size[:])
+		hash.Write(b)
+	}
+
+	write([]byte(message))
+	for _, op := range encodedOps {
+		write(op)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
 // ToChange creates Change model from this ChangeInfo.
 func (i *ChangeInfo) ToChange() (*change.Change, error) {
 	actorID, err := time.ActorIDFromHex(i.ActorID.String())
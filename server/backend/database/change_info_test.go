@@ -42,4 +42,15 @@ func TestChangeInfo(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, change.ID().ActorID().String(), expectedID)
 	})
+
+	t.Run("payload hash test", func(t *testing.T) {
+		hash := database.PayloadHash("message", [][]byte{[]byte("ab"), []byte("c")})
+		assert.Equal(t, hash, database.PayloadHash("message", [][]byte{[]byte("ab"), []byte("c")}))
+
+		// NOTE: The boundaries of the message and the operations are a part
+		// of the payload.
+		assert.NotEqual(t, hash, database.PayloadHash("message", [][]byte{[]byte("a"), []byte("bc")}))
+		assert.NotEqual(t, hash, database.PayloadHash("messageab", [][]byte{[]byte("c")}))
+		assert.NotEqual(t, hash, database.PayloadHash("message", [][]byte{[]byte("ab")}))
+	})
 }
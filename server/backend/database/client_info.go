@@ -30,6 +30,7 @@ var (
 	ErrDocumentNotAttached     = errors.New("document not attached")
 	ErrDocumentNeverAttached   = errors.New("client has never attached the document")
 	ErrDocumentAlreadyAttached = errors.New("document already attached")
+	ErrDocumentReadOnly        = errors.New("document attached in read-only mode")
 )
 
 // Below are statuses of the client.
@@ -38,9 +39,10 @@ const (
 	ClientActivated   = "activated"
 )
 
+// Below are statuses of the document attached to the client.
 const (
-	documentAttached = "attached"
-	documentDetached = "detached"
+	DocumentAttached = "attached"
+	DocumentDetached = "detached"
 )
 
 // ClientDocInfo is a structure representing information of the document
@@ -49,6 +51,10 @@ type ClientDocInfo struct {
 	Status    string `bson:"status"`
 	ServerSeq uint64 `bson:"server_seq"`
 	ClientSeq uint32 `bson:"client_seq"`
+
+	// ReadOnly is whether the document is attached in read-only mode. The
+	// client can receive changes of the document but cannot push changes.
+	ReadOnly bool `bson:"read_only"`
 }
 
 // ClientInfo is a structure representing information of a client.
@@ -91,8 +97,9 @@ func (i *ClientInfo) Deactivate() {
 	i.UpdatedAt = time.Now()
 }
 
-// AttachDocument attaches the given document to this client.
-func (i *ClientInfo) AttachDocument(docID types.ID) error {
+// AttachDocument attaches the given document to this client. If readOnly is
+// true, the client cannot push changes of the document.
+func (i *ClientInfo) AttachDocument(docID types.ID, readOnly bool) error {
 	if i.Status != ClientActivated {
 		return ErrClientNotActivated
 	}
@@ -101,14 +108,15 @@ func (i *ClientInfo) AttachDocument(docID types.ID) error {
 		i.Documents = make(map[types.ID]*ClientDocInfo)
 	}
 
-	if i.hasDocument(docID) && i.Documents[docID].Status == documentAttached {
+	if i.hasDocument(docID) && i.Documents[docID].Status == DocumentAttached {
 		return ErrDocumentAlreadyAttached
 	}
 
 	i.Documents[docID] = &ClientDocInfo{
-		Status:    documentAttached,
+		Status:    DocumentAttached,
 		ServerSeq: 0,
 		ClientSeq: 0,
+		ReadOnly:  readOnly,
 	}
 	i.UpdatedAt = time.Now()
 
@@ -121,9 +129,10 @@ func (i *ClientInfo) DetachDocument(docID types.ID) error {
 		return err
 	}
 
-	i.Documents[docID].Status = documentDetached
+	i.Documents[docID].Status = DocumentDetached
 	i.Documents[docID].ClientSeq = 0
 	i.Documents[docID].ServerSeq = 0
+	i.Documents[docID].ReadOnly = false
 	i.UpdatedAt = time.Now()
 
 	return nil
@@ -135,7 +144,17 @@ func (i *ClientInfo) IsAttached(docID types.ID) (bool, error) {
 		return false, ErrDocumentNeverAttached
 	}
 
-	return i.Documents[docID].Status == documentAttached, nil
+	return i.Documents[docID].Status == DocumentAttached, nil
+}
+
+// EnsureDocumentWritable ensures the given document is not attached in
+// read-only mode.
+func (i *ClientInfo) EnsureDocumentWritable(docID types.ID) error {
+	if i.hasDocument(docID) && i.Documents[docID].ReadOnly {
+		return ErrDocumentReadOnly
+	}
+
+	return nil
 }
 
 // Checkpoint returns the checkpoint of the given document.
@@ -170,7 +189,7 @@ func (i *ClientInfo) EnsureDocumentAttached(docID types.ID) error {
 		return ErrClientNotActivated
 	}
 
-	if !i.hasDocument(docID) || i.Documents[docID].Status == documentDetached {
+	if !i.hasDocument(docID) || i.Documents[docID].Status == DocumentDetached {
 		return ErrDocumentNotAttached
 	}
 
@@ -189,6 +208,7 @@ func (i *ClientInfo) DeepCopy() *ClientInfo {
 			Status:    v.Status,
 			ServerSeq: v.ServerSeq,
 			ClientSeq: v.ClientSeq,
+			ReadOnly:  v.ReadOnly,
 		}
 	}
 
@@ -33,7 +33,7 @@ func TestClientInfo(t *testing.T) {
 			Status: database.ClientActivated,
 		}
 
-		err := clientInfo.AttachDocument(docID)
+		err := clientInfo.AttachDocument(docID, false)
 		assert.NoError(t, err)
 		isAttached, err := clientInfo.IsAttached(docID)
 		assert.NoError(t, err)
@@ -48,4 +48,24 @@ func TestClientInfo(t *testing.T) {
 		assert.NoError(t, err)
 		assert.False(t, isAttached)
 	})
+
+	t.Run("read-only attachment test", func(t *testing.T) {
+		docID := types.ID("000000000000000000000000")
+		clientInfo := database.ClientInfo{
+			Status: database.ClientActivated,
+		}
+
+		assert.NoError(t, clientInfo.EnsureDocumentWritable(docID))
+
+		err := clientInfo.AttachDocument(docID, true)
+		assert.NoError(t, err)
+		assert.ErrorIs(t, clientInfo.EnsureDocumentWritable(docID), database.ErrDocumentReadOnly)
+		assert.True(t, clientInfo.DeepCopy().Documents[docID].ReadOnly)
+
+		err = clientInfo.DetachDocument(docID)
+		assert.NoError(t, err)
+		err = clientInfo.AttachDocument(docID, false)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.EnsureDocumentWritable(docID))
+	})
 }
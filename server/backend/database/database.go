@@ -46,6 +46,27 @@ var (
 
 	// ErrProjectNameAlreadyExists is returned when the project name already exists.
 	ErrProjectNameAlreadyExists = errors.New("project name already exists")
+
+	// ErrDocumentAlreadyExists is returned when the document of the same key
+	// already exists.
+	ErrDocumentAlreadyExists = errors.New("document already exists")
+
+	// ErrTooManyActors is returned when a new actor attaches the document
+	// which already has the maximum number of actors.
+	ErrTooManyActors = errors.New("too many actors")
+
+	// ErrSnapshotBlobNotFound is returned when the blob referenced by a
+	// snapshot could not be found.
+	ErrSnapshotBlobNotFound = errors.New("snapshot blob not found")
+
+	// ErrChangeAlreadyExists is returned when a change of the same ID, its
+	// actor, client sequence and Lamport timestamp, is already stored in the
+	// document with the same payload hash.
+	ErrChangeAlreadyExists = errors.New("change already exists")
+
+	// ErrTransactionNotSupported is returned when the database can not store
+	// the changes of multiple documents in a transaction.
+	ErrTransactionNotSupported = errors.New("transaction not supported")
 )
 
 // Database represents database which reads or saves Yorkie data.
@@ -53,6 +74,13 @@ type Database interface {
 	// Close all resources of this database.
 	Close() error
 
+	// Ping checks whether the connection to this database is alive.
+	Ping(ctx context.Context) error
+
+	// Reconnect replaces the connection to this database with a new one. It
+	// is called to recover the connection after it is lost.
+	Reconnect(ctx context.Context) error
+
 	// FindProjectInfoByPublicKey returns a project by public key.
 	FindProjectInfoByPublicKey(ctx context.Context, publicKey string) (*ProjectInfo, error)
 
@@ -62,6 +90,10 @@ type Database interface {
 	// FindProjectInfoByID returns a project by the given id.
 	FindProjectInfoByID(ctx context.Context, id types.ID) (*ProjectInfo, error)
 
+	// FindProjectInfosByIDs returns the projects of the given ids. The
+	// projects not found are omitted.
+	FindProjectInfosByIDs(ctx context.Context, ids []types.ID) ([]*ProjectInfo, error)
+
 	// EnsureDefaultProjectInfo ensures that the default project exists.
 	EnsureDefaultProjectInfo(ctx context.Context) (*ProjectInfo, error)
 
@@ -71,6 +103,9 @@ type Database interface {
 	// ListProjectInfos returns all projects.
 	ListProjectInfos(ctx context.Context) ([]*ProjectInfo, error)
 
+	// CountProjectInfos returns the number of the projects.
+	CountProjectInfos(ctx context.Context) (int, error)
+
 	// UpdateProjectInfo updates the project.
 	UpdateProjectInfo(ctx context.Context, id types.ID, fields *types.UpdatableProjectFields) (*ProjectInfo, error)
 
@@ -83,6 +118,14 @@ type Database interface {
 	// FindClientInfoByID finds the client of the given ID.
 	FindClientInfoByID(ctx context.Context, projectID, clientID types.ID) (*ClientInfo, error)
 
+	// CountAttachedClients returns the number of the activated clients that
+	// attach the given document.
+	CountAttachedClients(ctx context.Context, projectID, docID types.ID) (int, error)
+
+	// FindAttachedClientInfos returns the activated clients that attach the
+	// given document.
+	FindAttachedClientInfos(ctx context.Context, projectID, docID types.ID) ([]*ClientInfo, error)
+
 	// UpdateClientInfoAfterPushPull updates the client from the given clientInfo
 	// after handling PushPull.
 	UpdateClientInfoAfterPushPull(ctx context.Context, clientInfo *ClientInfo, docInfo *DocInfo) error
@@ -119,6 +162,12 @@ type Database interface {
 	) (*DocInfo, error)
 
 	// CreateChangeInfos stores the given changes then updates the given docInfo.
+	// A change of the same ID, its actor, client sequence and Lamport
+	// timestamp, and the same payload hash as a stored one is the same change
+	// delivered again, so it returns ErrChangeAlreadyExists instead of storing
+	// it twice. The ID alone is not unique, since a client reuses it after
+	// attaching a new document of the same key. The Lamport of the docInfo is
+	// stored only if its LamportServerSeq is its ServerSeq.
 	CreateChangeInfos(
 		ctx context.Context,
 		projectID types.ID,
@@ -127,6 +176,25 @@ type Database interface {
 		changes []*change.Change,
 	) error
 
+	// CreateChangeInfosOfDocuments stores the changes of the given batches then
+	// updates their documents like CreateChangeInfos, atomically: the changes
+	// of all the documents are stored, or none of them. It returns
+	// ErrTransactionNotSupported if the database can not do it.
+	CreateChangeInfosOfDocuments(
+		ctx context.Context,
+		projectID types.ID,
+		batches []*ChangeInfoBatch,
+	) error
+
+	// FindOperationIDs returns the IDs among the given ones of the operations
+	// stored in the document since the given time.
+	FindOperationIDs(
+		ctx context.Context,
+		docID types.ID,
+		ids []string,
+		since gotime.Time,
+	) ([]string, error)
+
 	// FindChangesBetweenServerSeqs returns the changes between two server sequences.
 	FindChangesBetweenServerSeqs(
 		ctx context.Context,
@@ -143,12 +211,44 @@ type Database interface {
 		to uint64,
 	) ([]*ChangeInfo, error)
 
-	// CreateSnapshotInfo stores the snapshot of the given document.
-	CreateSnapshotInfo(ctx context.Context, docID types.ID, doc *document.InternalDocument) error
+	// CreateSnapshotInfo stores the snapshot of the given document. The
+	// snapshot is written atomically and replaces the snapshot at the same
+	// server sequence, so the write can be retried after a failure. The
+	// snapshot data is stored once in the blob of its checksum, which is
+	// shared by the snapshots with the same content, compressed with the
+	// given compression.
+	CreateSnapshotInfo(
+		ctx context.Context,
+		docID types.ID,
+		doc *document.InternalDocument,
+		compression types.SnapshotCompression,
+	) error
 
-	// FindClosestSnapshotInfo finds the closest snapshot info in a given serverSeq.
+	// FindClosestSnapshotInfo finds the closest snapshot info in a given
+	// serverSeq. The snapshot data is read from its blob.
 	FindClosestSnapshotInfo(ctx context.Context, docID types.ID, serverSeq uint64) (*SnapshotInfo, error)
 
+	// FindSnapshotBlobInfo returns the snapshot blob of the given checksum.
+	FindSnapshotBlobInfo(ctx context.Context, checksum string) (*SnapshotBlobInfo, error)
+
+	// FindSnapshotInfos returns the snapshot infos of the given document from
+	// the latest one. The snapshot data is not included.
+	FindSnapshotInfos(ctx context.Context, docID types.ID) ([]*SnapshotInfo, error)
+
+	// FindSnapshotInfosToUpgrade returns at most limit snapshot infos whose
+	// format version is older than the given version. The snapshot data is not
+	// included.
+	FindSnapshotInfosToUpgrade(ctx context.Context, version int32, limit int) ([]*SnapshotInfo, error)
+
+	// CountSnapshotInfosToUpgrade returns the number of all the snapshot infos
+	// and the number of the ones whose format version is older than the given
+	// version.
+	CountSnapshotInfosToUpgrade(ctx context.Context, version int32) (int64, int64, error)
+
+	// RemoveSnapshotInfos removes the given snapshot infos of the given
+	// document. The blobs which are no longer referenced are removed too.
+	RemoveSnapshotInfos(ctx context.Context, docID types.ID, snapshotIDs []types.ID) error
+
 	// UpdateAndFindMinSyncedTicket updates the given serverSeq of the given client
 	// and returns the min synced ticket.
 	UpdateAndFindMinSyncedTicket(
@@ -166,7 +266,9 @@ type Database interface {
 		serverSeq uint64,
 	) error
 
-	// FindDocInfosByPaging returns the documentInfos of the given paging.
+	// FindDocInfosByPaging returns the documentInfos of the given paging. If
+	// the paging has SnapshotAt, it returns the documents which existed at
+	// that time including the ones removed after it.
 	FindDocInfosByPaging(
 		ctx context.Context,
 		projectID types.ID,
@@ -180,4 +282,136 @@ type Database interface {
 		query string,
 		pageSize int,
 	) (*types.SearchResult[*DocInfo], error)
+
+	// FindDocInfosByKeyPrefix returns at most limit documentInfos whose keys
+	// start with the given prefix, in ascending order of ID after the given
+	// offset.
+	FindDocInfosByKeyPrefix(
+		ctx context.Context,
+		projectID types.ID,
+		prefix string,
+		offset types.ID,
+		limit int,
+	) ([]*DocInfo, error)
+
+	// RemoveDocInfo soft-removes the document of the given ID.
+	RemoveDocInfo(ctx context.Context, projectID, docID types.ID) error
+
+	// FindRemovedDocInfoByKey finds the document of the given key removed
+	// most recently.
+	FindRemovedDocInfoByKey(ctx context.Context, projectID types.ID, docKey key.Key) (*DocInfo, error)
+
+	// RestoreDocInfo restores the removed document of the given ID. It returns
+	// ErrDocumentAlreadyExists if a document of the same key exists.
+	RestoreDocInfo(ctx context.Context, projectID, docID types.ID) error
+
+	// UpdateDocInfoLock locks or unlocks the document of the given ID. The
+	// reason is cleared when unlocking.
+	UpdateDocInfoLock(ctx context.Context, projectID, docID types.ID, locked bool, reason string) error
+
+	// UpdateDocInfoMetadata replaces the metadata of the document of the given
+	// ID with the given metadata.
+	UpdateDocInfoMetadata(
+		ctx context.Context,
+		projectID types.ID,
+		docID types.ID,
+		metadata map[string]string,
+	) error
+
+	// MoveDocInfo moves the document of the given ID to the destination
+	// project. The clients attached to the document are detached, and the
+	// actors of the document are cleared.
+	MoveDocInfo(ctx context.Context, srcProjectID, docID, dstProjectID types.ID) error
+
+	// FindDocInfosToArchive returns at most limit documents of the given
+	// project which are neither removed nor archived, and have been neither
+	// created nor updated since the given time.
+	FindDocInfosToArchive(
+		ctx context.Context,
+		projectID types.ID,
+		inactiveSince gotime.Time,
+		limit int,
+	) ([]*DocInfo, error)
+
+	// ArchiveDocInfo archives the document of the given ID. The clients
+	// attached to the document are detached, the actors of the document are
+	// cleared and the changes and the event log entries before the given
+	// serverSeq are removed. The change of the serverSeq is kept to find the
+	// ticket of the clients synced to it.
+	ArchiveDocInfo(ctx context.Context, projectID, docID types.ID, serverSeq uint64) error
+
+	// UnarchiveDocInfo unarchives the document of the given ID. The document
+	// is regarded as updated so that it is not archived again right away.
+	UnarchiveDocInfo(ctx context.Context, projectID, docID types.ID) error
+
+	// CreateDocClientEventInfo stores the event of the given client on the
+	// given document.
+	CreateDocClientEventInfo(
+		ctx context.Context,
+		docID types.ID,
+		clientID types.ID,
+		eventType types.DocumentClientEventType,
+	) error
+
+	// FindDocClientEventInfos returns the events of clients on the given
+	// document which occurred in [from, to) in order of ID. Zero from or to
+	// means no bound.
+	FindDocClientEventInfos(
+		ctx context.Context,
+		docID types.ID,
+		from gotime.Time,
+		to gotime.Time,
+		paging types.Paging[types.ID],
+	) ([]*DocClientEventInfo, error)
+
+	// RemoveDocClientEventInfosBefore removes the events of clients which
+	// occurred before the given time and returns the number of removed events.
+	RemoveDocClientEventInfosBefore(ctx context.Context, before gotime.Time) (int, error)
+
+	// CountDocInfos returns the number of the documents of the given project,
+	// excluding the removed ones.
+	CountDocInfos(ctx context.Context, projectID types.ID) (int, error)
+
+	// AddDocActor adds the given actor to the actors of the given document. It
+	// returns ErrTooManyActors if the actor is new and the document already has
+	// maxActors actors. Zero maxActors means no limit.
+	AddDocActor(ctx context.Context, docID, actorID types.ID, maxActors int) error
+
+	// RemoveDocActors removes the given actors from the actors of the given
+	// document.
+	RemoveDocActors(ctx context.Context, docID types.ID, actorIDs []types.ID) error
+
+	// FindDocInfosWithActors returns at most limit documentInfos which have
+	// actors, in ascending order of ID after the given offset.
+	FindDocInfosWithActors(ctx context.Context, offset types.ID, limit int) ([]*DocInfo, error)
+
+	// FindDocInfosWithGCInterval returns at most limit documentInfos which
+	// have the GC interval in their metadata, in ascending order of ID after
+	// the given offset.
+	FindDocInfosWithGCInterval(ctx context.Context, offset types.ID, limit int) ([]*DocInfo, error)
+
+	// FindMinSyncedSeqInfo returns the syncedSeqInfo of the given document with
+	// the smallest serverSeq. It returns nil if no client attaches the document.
+	FindMinSyncedSeqInfo(ctx context.Context, docID types.ID) (*SyncedSeqInfo, error)
+
+	// CreateDocEventLogInfos appends the given entries to the event logs of
+	// their documents.
+	CreateDocEventLogInfos(ctx context.Context, infos []*DocEventLogInfo) error
+
+	// FindDocEventLogInfos returns the entries of the event log of the given
+	// document whose server sequences are in [from, to], in order of server
+	// sequence.
+	FindDocEventLogInfos(ctx context.Context, docID types.ID, from, to uint64) ([]*DocEventLogInfo, error)
+
+	// RemoveDocEventLogInfosBefore removes the entries of the event logs
+	// created before the given time and returns the number of removed entries.
+	RemoveDocEventLogInfosBefore(ctx context.Context, before gotime.Time) (int, error)
+
+	// FindMaintenanceInfo returns the maintenance mode of the server. It
+	// returns a disabled one if the mode has never been updated.
+	FindMaintenanceInfo(ctx context.Context) (*MaintenanceInfo, error)
+
+	// UpdateMaintenanceInfo updates the maintenance mode of the server and
+	// returns the updated one.
+	UpdateMaintenanceInfo(ctx context.Context, enabled bool, message string) (*MaintenanceInfo, error)
 }
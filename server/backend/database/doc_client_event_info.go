@@ -0,0 +1,52 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database
+
+import (
+	"time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+)
+
+// DocClientEventInfo is a structure representing information of the event of
+// a client on a document.
+type DocClientEventInfo struct {
+	// ID is the unique ID of the event.
+	ID types.ID `bson:"_id"`
+
+	// DocID is the ID of the document which the event belongs to.
+	DocID types.ID `bson:"doc_id"`
+
+	// ClientID is the ID of the client which caused the event.
+	ClientID types.ID `bson:"client_id"`
+
+	// Type is the type of the event.
+	Type types.DocumentClientEventType `bson:"type"`
+
+	// CreatedAt is the time when the event occurred.
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// ToDocumentClientEvent converts the DocClientEventInfo to DocumentClientEvent.
+func (i *DocClientEventInfo) ToDocumentClientEvent() *types.DocumentClientEvent {
+	return &types.DocumentClientEvent{
+		ID:        i.ID,
+		ClientID:  i.ClientID,
+		Type:      i.Type,
+		CreatedAt: i.CreatedAt,
+	}
+}
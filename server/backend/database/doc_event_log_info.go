@@ -0,0 +1,90 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database
+
+import (
+	"time"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+)
+
+// DocEventLogInfo is a structure representing information of an entry of the
+// event log of a document, which records a change applied to the document.
+type DocEventLogInfo struct {
+	// ID is the unique ID of the entry.
+	ID types.ID `bson:"_id"`
+
+	// DocID is the ID of the document which the entry belongs to.
+	DocID types.ID `bson:"doc_id"`
+
+	// ServerSeq is the server sequence of the change.
+	ServerSeq uint64 `bson:"server_seq"`
+
+	// ActorID is the ID of the actor of the change.
+	ActorID types.ID `bson:"actor_id"`
+
+	// ClientSeq is the client sequence of the change.
+	ClientSeq uint32 `bson:"client_seq"`
+
+	// Lamport is the Lamport timestamp of the change.
+	Lamport uint64 `bson:"lamport"`
+
+	// OperationCount is the number of the operations of the change.
+	OperationCount int `bson:"operation_count"`
+
+	// VersionVector is the encoded version vector of the document after the
+	// change is applied.
+	VersionVector []byte `bson:"version_vector"`
+
+	// Fingerprint is the fingerprint of the document after the change is
+	// applied.
+	Fingerprint string `bson:"fingerprint"`
+
+	// CreatedAt is the time when the change is applied.
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// DeepCopy returns a deep copy of the DocEventLogInfo.
+func (i *DocEventLogInfo) DeepCopy() *DocEventLogInfo {
+	if i == nil {
+		return nil
+	}
+
+	clone := *i
+	clone.VersionVector = append([]byte(nil), i.VersionVector...)
+	return &clone
+}
+
+// ToDocumentEventLog converts the DocEventLogInfo to DocumentEventLog.
+func (i *DocEventLogInfo) ToDocumentEventLog() (*types.DocumentEventLog, error) {
+	vector, err := converter.BytesToVersionVector(i.VersionVector)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.DocumentEventLog{
+		ServerSeq:      i.ServerSeq,
+		ActorID:        i.ActorID,
+		ClientSeq:      i.ClientSeq,
+		Lamport:        i.Lamport,
+		OperationCount: i.OperationCount,
+		VersionVector:  vector,
+		Fingerprint:    i.Fingerprint,
+		CreatedAt:      i.CreatedAt,
+	}, nil
+}
@@ -17,12 +17,23 @@
 package database
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 )
 
+var (
+	// ErrDocumentLocked is returned when changes are pushed to the locked
+	// document.
+	ErrDocumentLocked = errors.New("document is locked")
+
+	// ErrDocumentArchived is returned when the archived document is attached.
+	ErrDocumentArchived = errors.New("document is archived")
+)
+
 // DocInfo is a structure representing information of the document.
 type DocInfo struct {
 	// ID is the unique ID of the document.
@@ -37,9 +48,25 @@ type DocInfo struct {
 	// ServerSeq is the sequence number of the last change of the document on the server.
 	ServerSeq uint64 `bson:"server_seq"`
 
+	// Lamport is the largest Lamport timestamp of the changes of the document
+	// up to LamportServerSeq. Zero means that it is unknown. It never goes
+	// backward.
+	Lamport uint64 `bson:"lamport"`
+
+	// LamportServerSeq is the server sequence up to which Lamport covers the
+	// changes. Lamport is stored once per LamportFlushInterval changes, so the
+	// changes after it may have larger Lamport timestamps. They are recovered
+	// from the stored changes, so a restarted server does not reuse them.
+	LamportServerSeq uint64 `bson:"lamport_server_seq"`
+
 	// Owner is the owner(ID of the client) of the document.
 	Owner types.ID `bson:"owner"`
 
+	// ActorIDs is the IDs of the clients that have attached the document. The
+	// clients deactivated are pruned by housekeeping once the replicas of the
+	// document have synced all the changes.
+	ActorIDs []types.ID `bson:"actor_ids,omitempty"`
+
 	// CreatedAt is the time when the document is created.
 	CreatedAt time.Time `bson:"created_at"`
 
@@ -48,6 +75,35 @@ type DocInfo struct {
 
 	// UpdatedAt is the time when the document is updated.
 	UpdatedAt time.Time `bson:"updated_at"`
+
+	// RemovedAt is the time when the document is removed. Removed documents
+	// are excluded from the lookups by key, so the key can be used again.
+	RemovedAt time.Time `bson:"removed_at,omitempty"`
+
+	// Locked is whether the document is locked by the admin. Changes can not
+	// be pushed to locked documents, but they can still be read and watched.
+	Locked bool `bson:"locked,omitempty"`
+
+	// LockReason is the reason of the lock shown to the rejected clients.
+	LockReason string `bson:"lock_reason,omitempty"`
+
+	// Metadata is the metadata of the document set by the admin. It is
+	// outside the content of the document, and the last update wins.
+	Metadata map[string]string `bson:"metadata,omitempty"`
+
+	// ArchivedAt is the time when the document is archived after the period
+	// of inactivity of the project. Archived documents are excluded from the
+	// listing and can not be attached until they are unarchived.
+	ArchivedAt time.Time `bson:"archived_at,omitempty"`
+
+	// CompactedServerSeq is the sequence before which the changes of the
+	// document are removed when it is archived. The document at this sequence
+	// is kept as a snapshot.
+	CompactedServerSeq uint64 `bson:"compacted_server_seq,omitempty"`
+
+	// OperationCounts is the number of the operations applied to the document
+	// over its lifetime, keyed by the kind of the operation, e.g. "set".
+	OperationCounts map[string]int64 `bson:"operation_counts,omitempty"`
 }
 
 // IncreaseServerSeq increases server sequence of the document.
@@ -56,20 +112,92 @@ func (info *DocInfo) IncreaseServerSeq() uint64 {
 	return info.ServerSeq
 }
 
+// IsRemoved returns whether the document is removed or not.
+func (info *DocInfo) IsRemoved() bool {
+	return !info.RemovedAt.IsZero()
+}
+
+// IsArchived returns whether the document is archived or not.
+func (info *DocInfo) IsArchived() bool {
+	return !info.ArchivedAt.IsZero()
+}
+
+// IsActiveSince returns whether the document has been created or updated since
+// the given time.
+func (info *DocInfo) IsActiveSince(t time.Time) bool {
+	return !info.CreatedAt.Before(t) || !info.UpdatedAt.Before(t)
+}
+
+// EnsureUnarchived ensures that the document is not archived.
+func (info *DocInfo) EnsureUnarchived() error {
+	if info.IsArchived() {
+		return fmt.Errorf("%s: %w", info.Key, ErrDocumentArchived)
+	}
+	return nil
+}
+
+// HasMetadata returns whether the document has all the given metadata entries.
+func (info *DocInfo) HasMetadata(metadata map[string]string) bool {
+	for k, v := range metadata {
+		if value, ok := info.Metadata[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+// EnsureWritable ensures that changes can be pushed to the document.
+func (info *DocInfo) EnsureWritable() error {
+	if !info.Locked {
+		return nil
+	}
+
+	if info.LockReason == "" {
+		return fmt.Errorf("%s: %w", info.Key, ErrDocumentLocked)
+	}
+	return fmt.Errorf("%s: %s: %w", info.Key, info.LockReason, ErrDocumentLocked)
+}
+
 // DeepCopy creates a deep copy of this DocInfo.
 func (info *DocInfo) DeepCopy() *DocInfo {
 	if info == nil {
 		return nil
 	}
 
+	var metadata map[string]string
+	if info.Metadata != nil {
+		metadata = make(map[string]string, len(info.Metadata))
+		for k, v := range info.Metadata {
+			metadata[k] = v
+		}
+	}
+
+	var operationCounts map[string]int64
+	if info.OperationCounts != nil {
+		operationCounts = make(map[string]int64, len(info.OperationCounts))
+		for k, v := range info.OperationCounts {
+			operationCounts[k] = v
+		}
+	}
+
 	return &DocInfo{
-		ID:         info.ID,
-		ProjectID:  info.ProjectID,
-		Key:        info.Key,
-		ServerSeq:  info.ServerSeq,
-		Owner:      info.Owner,
-		CreatedAt:  info.CreatedAt,
-		AccessedAt: info.AccessedAt,
-		UpdatedAt:  info.UpdatedAt,
+		ID:                 info.ID,
+		ProjectID:          info.ProjectID,
+		Key:                info.Key,
+		ServerSeq:          info.ServerSeq,
+		Lamport:            info.Lamport,
+		LamportServerSeq:   info.LamportServerSeq,
+		Owner:              info.Owner,
+		ActorIDs:           append([]types.ID(nil), info.ActorIDs...),
+		CreatedAt:          info.CreatedAt,
+		AccessedAt:         info.AccessedAt,
+		UpdatedAt:          info.UpdatedAt,
+		RemovedAt:          info.RemovedAt,
+		Locked:             info.Locked,
+		LockReason:         info.LockReason,
+		Metadata:           metadata,
+		ArchivedAt:         info.ArchivedAt,
+		CompactedServerSeq: info.CompactedServerSeq,
+		OperationCounts:    operationCounts,
 	}
 }
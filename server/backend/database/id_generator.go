@@ -0,0 +1,168 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	gotime "time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/yorkie-team/yorkie/api/types"
+)
+
+const (
+	// ObjectIDGeneratorName is the name of the ID generator that generates
+	// MongoDB ObjectIDs. It is the default.
+	ObjectIDGeneratorName = "objectid"
+
+	// TimeSortableIDGeneratorName is the name of the ID generator that
+	// generates IDs sorted by milliseconds.
+	TimeSortableIDGeneratorName = "time-sortable"
+
+	// MaxIDPrefixSize is the maximum length of the prefix of the generated IDs
+	// in bytes. The rest of the IDs must have enough random bits to avoid
+	// collisions between the servers of a cluster.
+	MaxIDPrefixSize = 2
+)
+
+var (
+	// ErrUnsupportedIDGenerator is returned when the given ID generator is not
+	// supported.
+	ErrUnsupportedIDGenerator = errors.New("unsupported id generator")
+
+	// ErrInvalidIDGenerator is returned when the IDs generated by the given
+	// generator do not satisfy the requirements.
+	ErrInvalidIDGenerator = errors.New("invalid id generator")
+)
+
+// IDGenerator generates IDs of projects, clients and documents.
+//
+// The generated IDs must be 12-byte lowercase hexadecimal strings so that
+// they can be used as ActorIDs and compared in the same order as strings and
+// as bytes, which paging relies on. IDs generated later should be greater to
+// keep the locality of paging.
+type IDGenerator interface {
+	// NewID returns a new ID.
+	NewID() types.ID
+}
+
+// NewIDGenerator creates a new instance of IDGenerator of the given name. The
+// generated IDs start with the given prefix, which only the time-sortable
+// generator supports.
+func NewIDGenerator(name string, prefix []byte) (IDGenerator, error) {
+	if len(prefix) > MaxIDPrefixSize {
+		return nil, fmt.Errorf(
+			"prefix of %d bytes exceeds %d bytes: %w",
+			len(prefix),
+			MaxIDPrefixSize,
+			ErrInvalidIDGenerator,
+		)
+	}
+
+	switch name {
+	case "", ObjectIDGeneratorName:
+		if len(prefix) > 0 {
+			return nil, fmt.Errorf("%s with prefix: %w", ObjectIDGeneratorName, ErrUnsupportedIDGenerator)
+		}
+		return &ObjectIDGenerator{}, nil
+	case TimeSortableIDGeneratorName:
+		return &TimeSortableIDGenerator{Prefix: prefix}, nil
+	default:
+		return nil, fmt.Errorf("%s: %w", name, ErrUnsupportedIDGenerator)
+	}
+}
+
+// ValidateIDGenerator checks that the IDs generated by the given generator
+// satisfy the requirements of IDGenerator.
+func ValidateIDGenerator(generator IDGenerator) error {
+	var prev types.ID
+	for i := 0; i < 3; i++ {
+		id := generator.NewID()
+		if err := id.Validate(); err != nil {
+			return fmt.Errorf("%s: %w", id, ErrInvalidIDGenerator)
+		}
+		if id.String() != strings.ToLower(id.String()) {
+			return fmt.Errorf("%s is not lowercase: %w", id, ErrInvalidIDGenerator)
+		}
+		if i > 0 && id <= prev {
+			return fmt.Errorf("%s is not greater than %s: %w", id, prev, ErrInvalidIDGenerator)
+		}
+		prev = id
+	}
+
+	return nil
+}
+
+// ObjectIDGenerator generates MongoDB ObjectIDs. ObjectIDs are sorted by
+// seconds.
+type ObjectIDGenerator struct{}
+
+// NewID returns a new ID.
+func (g *ObjectIDGenerator) NewID() types.ID {
+	return types.ID(primitive.NewObjectID().Hex())
+}
+
+// TimeSortableIDGenerator generates IDs with the layout similar to UUIDv7 in
+// 12 bytes: 48 bits of Unix time in milliseconds, 16 bits of the sequence in
+// the same millisecond and 32 random bits. The IDs generated by the same
+// generator are monotonically increasing.
+//
+// If Prefix is given, the IDs start with it, e.g. to embed tenant info in the
+// ActorIDs of the clients, and the random bits are reduced by its length.
+type TimeSortableIDGenerator struct {
+	Prefix []byte
+
+	mu       sync.Mutex
+	lastTime int64
+	sequence uint16
+}
+
+// NewID returns a new ID.
+func (g *TimeSortableIDGenerator) NewID() types.ID {
+	g.mu.Lock()
+	now := gotime.Now().UnixMilli()
+	if now <= g.lastTime {
+		// NOTE: If the sequence overflows or the clock goes backwards, we
+		// borrow the next millisecond to keep the IDs increasing.
+		now = g.lastTime
+		g.sequence++
+		if g.sequence == 0 {
+			now++
+		}
+	} else {
+		g.sequence = 0
+	}
+	g.lastTime = now
+	sequence := g.sequence
+	g.mu.Unlock()
+
+	var b [12]byte
+	n := copy(b[:], g.Prefix)
+	binary.BigEndian.PutUint64(b[n:n+8], uint64(now)<<16|uint64(sequence))
+	if _, err := rand.Read(b[n+8:]); err != nil {
+		panic(err)
+	}
+
+	return types.ID(hex.EncodeToString(b[:]))
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+)
+
+type constantIDGenerator struct{}
+
+func (g *constantIDGenerator) NewID() types.ID {
+	return "000000000000000000000000"
+}
+
+type uppercaseIDGenerator struct{}
+
+func (g *uppercaseIDGenerator) NewID() types.ID {
+	return "ABCDEF000000000000000000"
+}
+
+func TestIDGenerator(t *testing.T) {
+	t.Run("new id generator test", func(t *testing.T) {
+		for _, name := range []string{
+			"",
+			database.ObjectIDGeneratorName,
+			database.TimeSortableIDGeneratorName,
+		} {
+			generator, err := database.NewIDGenerator(name, nil)
+			assert.NoError(t, err)
+			assert.NoError(t, database.ValidateIDGenerator(generator))
+		}
+
+		_, err := database.NewIDGenerator("uuid", nil)
+		assert.ErrorIs(t, err, database.ErrUnsupportedIDGenerator)
+	})
+
+	t.Run("time sortable id generator test", func(t *testing.T) {
+		generator := &database.TimeSortableIDGenerator{}
+
+		prev := generator.NewID()
+		for i := 0; i < 100000; i++ {
+			id := generator.NewID()
+			assert.NoError(t, id.Validate())
+			if !assert.Greater(t, id, prev) {
+				break
+			}
+			prev = id
+		}
+	})
+
+	t.Run("id generator with prefix test", func(t *testing.T) {
+		generator, err := database.NewIDGenerator(database.TimeSortableIDGeneratorName, []byte{0x0a, 0x0b})
+		assert.NoError(t, err)
+		assert.NoError(t, database.ValidateIDGenerator(generator))
+		assert.True(t, strings.HasPrefix(string(generator.NewID()), "0a0b"))
+
+		_, err = database.NewIDGenerator(database.ObjectIDGeneratorName, []byte{0x0a})
+		assert.ErrorIs(t, err, database.ErrUnsupportedIDGenerator)
+
+		_, err = database.NewIDGenerator(database.TimeSortableIDGeneratorName, []byte{0x0a, 0x0b, 0x0c})
+		assert.ErrorIs(t, err, database.ErrInvalidIDGenerator)
+	})
+
+	t.Run("validate id generator test", func(t *testing.T) {
+		err := database.ValidateIDGenerator(&constantIDGenerator{})
+		assert.ErrorIs(t, err, database.ErrInvalidIDGenerator)
+
+		err = database.ValidateIDGenerator(&uppercaseIDGenerator{})
+		assert.ErrorIs(t, err, database.ErrInvalidIDGenerator)
+	})
+}
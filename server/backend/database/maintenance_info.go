@@ -0,0 +1,66 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database
+
+import (
+	"time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+)
+
+// MaintenanceInfoID is the ID of the maintenanceInfo, which is the only one
+// shared by the servers using the same database.
+const MaintenanceInfoID = "maintenance"
+
+// MaintenanceInfo is a structure representing information of the maintenance
+// mode of the server.
+type MaintenanceInfo struct {
+	// ID is always MaintenanceInfoID.
+	ID types.ID `bson:"_id"`
+
+	// Enabled is whether the maintenance mode is enabled.
+	Enabled bool `bson:"enabled"`
+
+	// Message is the message returned with the rejected writes.
+	Message string `bson:"message"`
+
+	// UpdatedAt is the time when the maintenance mode is updated.
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+// DeepCopy returns a deep copy of the MaintenanceInfo.
+func (i *MaintenanceInfo) DeepCopy() *MaintenanceInfo {
+	if i == nil {
+		return nil
+	}
+
+	return &MaintenanceInfo{
+		ID:        i.ID,
+		Enabled:   i.Enabled,
+		Message:   i.Message,
+		UpdatedAt: i.UpdatedAt,
+	}
+}
+
+// ToMaintenance converts the MaintenanceInfo to Maintenance.
+func (i *MaintenanceInfo) ToMaintenance() *types.Maintenance {
+	return &types.Maintenance{
+		Enabled:   i.Enabled,
+		Message:   i.Message,
+		UpdatedAt: i.UpdatedAt,
+	}
+}
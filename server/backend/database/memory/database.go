@@ -19,6 +19,8 @@ package memory
 import (
 	"context"
 	"fmt"
+	"math"
+	"strings"
 	gotime "time"
 
 	"github.com/hashicorp/go-memdb"
@@ -35,18 +37,21 @@ import (
 
 // DB is an in-memory database for testing or temporarily.
 type DB struct {
-	db *memdb.MemDB
+	db          *memdb.MemDB
+	idGenerator database.IDGenerator
 }
 
-// New returns a new in-memory database.
-func New() (*DB, error) {
+// New returns a new in-memory database. The given generator is used to
+// generate IDs of projects, clients and documents.
+func New(idGenerator database.IDGenerator) (*DB, error) {
 	memDB, err := memdb.NewMemDB(schema)
 	if err != nil {
 		return nil, err
 	}
 
 	return &DB{
-		db: memDB,
+		db:          memDB,
+		idGenerator: idGenerator,
 	}, nil
 }
 
@@ -55,6 +60,18 @@ func (d *DB) Close() error {
 	return nil
 }
 
+// Ping checks whether the connection to this database is alive. The memory
+// database is always alive.
+func (d *DB) Ping(_ context.Context) error {
+	return nil
+}
+
+// Reconnect replaces the connection to this database with a new one. The
+// memory database has no connection to replace.
+func (d *DB) Reconnect(_ context.Context) error {
+	return nil
+}
+
 // FindProjectInfoByPublicKey returns a project by public key.
 func (d *DB) FindProjectInfoByPublicKey(ctx context.Context, publicKey string) (*database.ProjectInfo, error) {
 	txn := d.db.Txn(false)
@@ -102,6 +119,28 @@ func (d *DB) FindProjectInfoByID(ctx context.Context, id types.ID) (*database.Pr
 	return raw.(*database.ProjectInfo).DeepCopy(), nil
 }
 
+// FindProjectInfosByIDs returns the projects of the given ids. The projects
+// not found are omitted.
+func (d *DB) FindProjectInfosByIDs(ctx context.Context, ids []types.ID) ([]*database.ProjectInfo, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	var infos []*database.ProjectInfo
+	for _, id := range ids {
+		raw, err := txn.First(tblProjects, "id", id.String())
+		if err != nil {
+			return nil, err
+		}
+		if raw == nil {
+			continue
+		}
+
+		infos = append(infos, raw.(*database.ProjectInfo).DeepCopy())
+	}
+
+	return infos, nil
+}
+
 // EnsureDefaultProjectInfo creates the default project if it does not exist.
 func (d *DB) EnsureDefaultProjectInfo(ctx context.Context) (*database.ProjectInfo, error) {
 	txn := d.db.Txn(true)
@@ -143,7 +182,7 @@ func (d *DB) CreateProjectInfo(ctx context.Context, name string) (*database.Proj
 	}
 
 	info := database.NewProjectInfo(name)
-	info.ID = newID()
+	info.ID = d.idGenerator.NewID()
 	if err := txn.Insert(tblProjects, info); err != nil {
 		return nil, err
 	}
@@ -174,6 +213,24 @@ func (d *DB) ListProjectInfos(ctx context.Context) ([]*database.ProjectInfo, err
 	return infos, nil
 }
 
+// CountProjectInfos returns the number of the projects.
+func (d *DB) CountProjectInfos(ctx context.Context) (int, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	iter, err := txn.Get(tblProjects, "id")
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		count++
+	}
+
+	return count, nil
+}
+
 // UpdateProjectInfo updates the given project.
 func (d *DB) UpdateProjectInfo(
 	ctx context.Context,
@@ -236,7 +293,7 @@ func (d *DB) ActivateClient(
 	}
 
 	if raw == nil {
-		clientInfo.ID = newID()
+		clientInfo.ID = d.idGenerator.NewID()
 		clientInfo.CreatedAt = now
 	} else {
 		loaded := raw.(*database.ClientInfo)
@@ -313,6 +370,61 @@ func (d *DB) FindClientInfoByID(ctx context.Context, projectID, clientID types.I
 	return clientInfo.DeepCopy(), nil
 }
 
+// CountAttachedClients returns the number of the activated clients that
+// attach the given document.
+func (d *DB) CountAttachedClients(ctx context.Context, projectID, docID types.ID) (int, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	iterator, err := txn.Get(tblClients, "project_id_key_prefix", projectID.String())
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		clientInfo := raw.(*database.ClientInfo)
+		if clientInfo.Status != database.ClientActivated {
+			continue
+		}
+
+		if attached, err := clientInfo.IsAttached(docID); err == nil && attached {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// FindAttachedClientInfos returns the activated clients that attach the given
+// document.
+func (d *DB) FindAttachedClientInfos(
+	ctx context.Context,
+	projectID, docID types.ID,
+) ([]*database.ClientInfo, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	iterator, err := txn.Get(tblClients, "project_id_key_prefix", projectID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var infos []*database.ClientInfo
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		clientInfo := raw.(*database.ClientInfo)
+		if clientInfo.Status != database.ClientActivated {
+			continue
+		}
+
+		if attached, err := clientInfo.IsAttached(docID); err == nil && attached {
+			infos = append(infos, clientInfo.DeepCopy())
+		}
+	}
+
+	return infos, nil
+}
+
 // UpdateClientInfoAfterPushPull updates the client from the given clientInfo
 // after handling PushPull.
 func (d *DB) UpdateClientInfoAfterPushPull(
@@ -362,6 +474,7 @@ func (d *DB) UpdateClientInfoAfterPushPull(
 			ServerSeq: serverSeq,
 			ClientSeq: clientSeq,
 			Status:    clientDocInfo.Status,
+			ReadOnly:  clientDocInfo.ReadOnly,
 		}
 		loaded.UpdatedAt = gotime.Now()
 	}
@@ -422,19 +535,18 @@ func (d *DB) FindDocInfoByKeyAndOwner(
 	txn := d.db.Txn(true)
 	defer txn.Abort()
 
-	raw, err := txn.First(tblDocuments, "project_id_key", projectID.String(), key.String())
+	docInfo, err := findDocInfoByKey(txn, projectID, key)
 	if err != nil {
 		return nil, err
 	}
-	if !createDocIfNotExist && raw == nil {
+	if !createDocIfNotExist && docInfo == nil {
 		return nil, fmt.Errorf("%s: %w", key, database.ErrDocumentNotFound)
 	}
 
 	now := gotime.Now()
-	var docInfo *database.DocInfo
-	if raw == nil {
+	if docInfo == nil {
 		docInfo = &database.DocInfo{
-			ID:         newID(),
+			ID:         d.idGenerator.NewID(),
 			ProjectID:  projectID,
 			Key:        key,
 			Owner:      clientID,
@@ -446,8 +558,6 @@ func (d *DB) FindDocInfoByKeyAndOwner(
 			return nil, err
 		}
 		txn.Commit()
-	} else {
-		docInfo = raw.(*database.DocInfo)
 	}
 
 	return docInfo.DeepCopy(), nil
@@ -462,15 +572,15 @@ func (d *DB) FindDocInfoByKey(
 	txn := d.db.Txn(false)
 	defer txn.Abort()
 
-	raw, err := txn.First(tblDocuments, "project_id_key", projectID.String(), key.String())
+	docInfo, err := findDocInfoByKey(txn, projectID, key)
 	if err != nil {
 		return nil, err
 	}
-	if raw == nil {
+	if docInfo == nil {
 		return nil, fmt.Errorf("%s: %w", key, database.ErrDocumentNotFound)
 	}
 
-	return raw.(*database.DocInfo).DeepCopy(), nil
+	return docInfo.DeepCopy(), nil
 }
 
 // FindDocInfoByID finds a docInfo of the given ID.
@@ -505,27 +615,96 @@ func (d *DB) CreateChangeInfos(
 	txn := d.db.Txn(true)
 	defer txn.Abort()
 
+	if err := createChangeInfos(txn, docInfo, initialServerSeq, changes); err != nil {
+		return err
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// CreateChangeInfosOfDocuments stores the changes of the given batches and
+// their doc infos in a transaction.
+func (d *DB) CreateChangeInfosOfDocuments(
+	ctx context.Context,
+	projectID types.ID,
+	batches []*database.ChangeInfoBatch,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	for _, batch := range batches {
+		if err := createChangeInfos(txn, batch.DocInfo, batch.InitialServerSeq, batch.Changes); err != nil {
+			return err
+		}
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// createChangeInfos stores the given changes and doc info in the given
+// transaction.
+func createChangeInfos(
+	txn *memdb.Txn,
+	docInfo *database.DocInfo,
+	initialServerSeq uint64,
+	changes []*change.Change,
+) error {
+	now := gotime.Now()
 	for _, cn := range changes {
 		encodedOperations, err := database.EncodeOperations(cn.Operations())
 		if err != nil {
 			return err
 		}
 
+		actorID := types.ID(cn.ID().ActorID().String())
+		payloadHash := database.PayloadHash(cn.Message(), encodedOperations)
+
+		// NOTE: Check if the change already exists, since go-memdb does not
+		// reject the entries violating a unique index.
+		// https://github.com/hashicorp/go-memdb/issues/7#issuecomment-270427642
+		existing, err := txn.First(
+			tblChanges,
+			"doc_id_actor_id_client_seq_lamport_payload_hash",
+			docInfo.ID.String(),
+			actorID.String(),
+			cn.ClientSeq(),
+			cn.ID().Lamport(),
+			payloadHash,
+		)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return fmt.Errorf(
+				"%s:%d:%d of %s: %w",
+				actorID,
+				cn.ClientSeq(),
+				cn.ID().Lamport(),
+				docInfo.ID,
+				database.ErrChangeAlreadyExists,
+			)
+		}
+
 		if err := txn.Insert(tblChanges, &database.ChangeInfo{
-			ID:         newID(),
-			DocID:      docInfo.ID,
-			ServerSeq:  cn.ServerSeq(),
-			ActorID:    types.ID(cn.ID().ActorID().String()),
-			ClientSeq:  cn.ClientSeq(),
-			Lamport:    cn.ID().Lamport(),
-			Message:    cn.Message(),
-			Operations: encodedOperations,
+			ID:           newID(),
+			DocID:        docInfo.ID,
+			ServerSeq:    cn.ServerSeq(),
+			ActorID:      actorID,
+			ClientSeq:    cn.ClientSeq(),
+			Lamport:      cn.ID().Lamport(),
+			Message:      cn.Message(),
+			Operations:   encodedOperations,
+			PayloadHash:  payloadHash,
+			OperationIDs: database.OperationIDs(cn),
+			CreatedAt:    now,
 		}); err != nil {
 			return err
 		}
 	}
 
-	raw, err := txn.First(tblDocuments, "project_id_key", projectID.String(), docInfo.Key.String())
+	raw, err := txn.First(tblDocuments, "id", docInfo.ID.String())
 	if err != nil {
 		return err
 	}
@@ -538,13 +717,66 @@ func (d *DB) CreateChangeInfos(
 	}
 
 	loadedDocInfo.ServerSeq = docInfo.ServerSeq
+	if docInfo.LamportServerSeq == docInfo.ServerSeq {
+		if docInfo.Lamport > loadedDocInfo.Lamport {
+			loadedDocInfo.Lamport = docInfo.Lamport
+		}
+		loadedDocInfo.LamportServerSeq = docInfo.LamportServerSeq
+	}
+	for kind, count := range database.CountOperations(changes) {
+		if loadedDocInfo.OperationCounts == nil {
+			loadedDocInfo.OperationCounts = make(map[string]int64)
+		}
+		loadedDocInfo.OperationCounts[kind] += count
+	}
 	loadedDocInfo.UpdatedAt = gotime.Now()
-	if err := txn.Insert(tblDocuments, loadedDocInfo); err != nil {
-		return err
+	return txn.Insert(tblDocuments, loadedDocInfo)
+}
+
+// FindOperationIDs returns the IDs among the given ones of the operations
+// stored in the document since the given time.
+func (d *DB) FindOperationIDs(
+	ctx context.Context,
+	docID types.ID,
+	ids []string,
+	since gotime.Time,
+) ([]string, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	iterator, err := txn.ReverseLowerBound(
+		tblChanges,
+		"doc_id_server_seq",
+		docID.String(),
+		uint64(math.MaxUint64),
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	txn.Commit()
-	return nil
+	wanted := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	// NOTE: The changes are stored in the order of their server sequences, so
+	// the recent ones are visited until the one stored before the given time.
+	var found []string
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		info := raw.(*database.ChangeInfo)
+		if info.DocID != docID || info.CreatedAt.Before(since) {
+			break
+		}
+
+		for _, id := range info.OperationIDs {
+			if wanted[id] {
+				found = append(found, id)
+				delete(wanted, id)
+			}
+		}
+	}
+
+	return found, nil
 }
 
 // FindChangesBetweenServerSeqs returns the changes between two server sequences.
@@ -608,8 +840,17 @@ func (d *DB) CreateSnapshotInfo(
 	ctx context.Context,
 	docID types.ID,
 	doc *document.InternalDocument,
+	compression types.SnapshotCompression,
 ) error {
-	snapshot, err := converter.ObjectToBytes(doc.RootObject())
+	snapshot, err := converter.ObjectToSnapshotBytes(doc.RootObject())
+	if err != nil {
+		return err
+	}
+	snapshot, err = converter.CompressSnapshot(snapshot, compression)
+	if err != nil {
+		return err
+	}
+	versionVector, err := converter.VersionVectorToBytes(doc.VersionVector())
 	if err != nil {
 		return err
 	}
@@ -617,13 +858,37 @@ func (d *DB) CreateSnapshotInfo(
 	txn := d.db.Txn(true)
 	defer txn.Abort()
 
+	// NOTE: The snapshot at the same server sequence is replaced in the same
+	// transaction, so that the write can be retried after a failure.
+	raw, err := txn.First(
+		tblSnapshots,
+		"doc_id_server_seq",
+		docID.String(),
+		doc.Checkpoint().ServerSeq,
+	)
+	if err != nil {
+		return err
+	}
+	if raw != nil {
+		if err := deleteSnapshotInfo(txn, raw.(*database.SnapshotInfo)); err != nil {
+			return err
+		}
+	}
+
+	checksum := database.SnapshotChecksum(snapshot)
+	if err := acquireSnapshotBlob(txn, checksum, snapshot); err != nil {
+		return err
+	}
 	if err := txn.Insert(tblSnapshots, &database.SnapshotInfo{
-		ID:        newID(),
-		DocID:     docID,
-		ServerSeq: doc.Checkpoint().ServerSeq,
-		Lamport:   doc.Lamport(),
-		Snapshot:  snapshot,
-		CreatedAt: gotime.Now(),
+		ID:            newID(),
+		DocID:         docID,
+		ServerSeq:     doc.Checkpoint().ServerSeq,
+		Lamport:       doc.Lamport(),
+		Checksum:      checksum,
+		VersionVector: versionVector,
+		Version:       converter.CurrentSnapshotVersion,
+		GarbageLen:    doc.GarbageLen(),
+		CreatedAt:     gotime.Now(),
 	}); err != nil {
 		return err
 	}
@@ -631,6 +896,55 @@ func (d *DB) CreateSnapshotInfo(
 	return nil
 }
 
+// acquireSnapshotBlob stores the given snapshot data in the blob of the given
+// checksum, or increases the reference count of the blob if it exists.
+func acquireSnapshotBlob(txn *memdb.Txn, checksum string, snapshot []byte) error {
+	raw, err := txn.First(tblSnapshotBlobs, "id", checksum)
+	if err != nil {
+		return err
+	}
+
+	blob := &database.SnapshotBlobInfo{
+		ID:        checksum,
+		Snapshot:  snapshot,
+		RefCount:  1,
+		CreatedAt: gotime.Now(),
+	}
+	if raw != nil {
+		loaded := *raw.(*database.SnapshotBlobInfo)
+		loaded.RefCount++
+		blob = &loaded
+	}
+
+	return txn.Insert(tblSnapshotBlobs, blob)
+}
+
+// deleteSnapshotInfo deletes the given snapshot info and decreases the
+// reference count of its blob. The blob is removed when it is no longer
+// referenced.
+func deleteSnapshotInfo(txn *memdb.Txn, info *database.SnapshotInfo) error {
+	if err := txn.Delete(tblSnapshots, info); err != nil {
+		return err
+	}
+	if info.Checksum == "" {
+		return nil
+	}
+
+	raw, err := txn.First(tblSnapshotBlobs, "id", info.Checksum)
+	if err != nil {
+		return err
+	}
+	if raw == nil {
+		return nil
+	}
+
+	blob := *raw.(*database.SnapshotBlobInfo)
+	if blob.RefCount--; blob.RefCount <= 0 {
+		return txn.Delete(tblSnapshotBlobs, raw)
+	}
+	return txn.Insert(tblSnapshotBlobs, &blob)
+}
+
 // FindClosestSnapshotInfo finds the last snapshot of the given document.
 func (d *DB) FindClosestSnapshotInfo(
 	ctx context.Context,
@@ -662,78 +976,239 @@ func (d *DB) FindClosestSnapshotInfo(
 	if snapshotInfo == nil {
 		return &database.SnapshotInfo{}, nil
 	}
+	if snapshotInfo.Checksum == "" {
+		return snapshotInfo, nil
+	}
+
+	raw, err := txn.First(tblSnapshotBlobs, "id", snapshotInfo.Checksum)
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, fmt.Errorf("%s: %w", snapshotInfo.Checksum, database.ErrSnapshotBlobNotFound)
+	}
 
-	return snapshotInfo, nil
+	resolved := *snapshotInfo
+	resolved.Snapshot = raw.(*database.SnapshotBlobInfo).Snapshot
+	return &resolved, nil
 }
 
-// UpdateAndFindMinSyncedTicket updates the given serverSeq of the given client
-// and returns the min synced ticket.
-func (d *DB) UpdateAndFindMinSyncedTicket(
+// FindSnapshotBlobInfo returns the snapshot blob of the given checksum.
+func (d *DB) FindSnapshotBlobInfo(
 	ctx context.Context,
-	clientInfo *database.ClientInfo,
-	docID types.ID,
-	serverSeq uint64,
-) (*time.Ticket, error) {
-	if err := d.UpdateSyncedSeq(ctx, clientInfo, docID, serverSeq); err != nil {
+	checksum string,
+) (*database.SnapshotBlobInfo, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblSnapshotBlobs, "id", checksum)
+	if err != nil {
 		return nil, err
 	}
+	if raw == nil {
+		return nil, fmt.Errorf("%s: %w", checksum, database.ErrSnapshotBlobNotFound)
+	}
 
+	blob := *raw.(*database.SnapshotBlobInfo)
+	return &blob, nil
+}
+
+// FindSnapshotInfos returns the snapshot infos of the given document from
+// the latest one. The snapshot data is not included.
+func (d *DB) FindSnapshotInfos(
+	ctx context.Context,
+	docID types.ID,
+) ([]*database.SnapshotInfo, error) {
 	txn := d.db.Txn(false)
 	defer txn.Abort()
 
-	iterator, err := txn.LowerBound(
-		tblSyncedSeqs,
-		"doc_id_lamport_actor_id",
+	iterator, err := txn.ReverseLowerBound(
+		tblSnapshots,
+		"doc_id_server_seq",
 		docID.String(),
-		uint64(0),
-		time.InitialActorID.String(),
+		uint64(math.MaxUint64),
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	var syncedSeqInfo *database.SyncedSeqInfo
-	if raw := iterator.Next(); raw != nil {
-		info := raw.(*database.SyncedSeqInfo)
-		if info.DocID == docID {
-			syncedSeqInfo = info
+	var infos []*database.SnapshotInfo
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		info := raw.(*database.SnapshotInfo)
+		if info.DocID != docID {
+			break
 		}
-	}
-
-	if syncedSeqInfo == nil || syncedSeqInfo.ServerSeq == change.InitialServerSeq {
-		return time.InitialTicket, nil
-	}
 
-	actorID, err := time.ActorIDFromHex(syncedSeqInfo.ActorID.String())
-	if err != nil {
-		return nil, err
+		infos = append(infos, &database.SnapshotInfo{
+			ID:         info.ID,
+			DocID:      info.DocID,
+			ServerSeq:  info.ServerSeq,
+			Lamport:    info.Lamport,
+			Checksum:   info.Checksum,
+			GarbageLen: info.GarbageLen,
+			CreatedAt:  info.CreatedAt,
+		})
 	}
 
-	return time.NewTicket(
-		syncedSeqInfo.Lamport,
-		time.MaxDelimiter,
-		actorID,
-	), nil
+	return infos, nil
 }
 
-// UpdateSyncedSeq updates the syncedSeq of the given client.
-func (d *DB) UpdateSyncedSeq(
+// FindSnapshotInfosToUpgrade returns at most limit snapshot infos whose format
+// version is older than the given version. The snapshot data is not included.
+func (d *DB) FindSnapshotInfosToUpgrade(
 	ctx context.Context,
-	clientInfo *database.ClientInfo,
-	docID types.ID,
-	serverSeq uint64,
-) error {
-	txn := d.db.Txn(true)
+	version int32,
+	limit int,
+) ([]*database.SnapshotInfo, error) {
+	txn := d.db.Txn(false)
 	defer txn.Abort()
 
-	isAttached, err := clientInfo.IsAttached(docID)
+	iterator, err := txn.Get(tblSnapshots, "id")
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if !isAttached {
-		if _, err = txn.DeleteAll(
-			tblSyncedSeqs,
+	var infos []*database.SnapshotInfo
+	for raw := iterator.Next(); raw != nil && len(infos) < limit; raw = iterator.Next() {
+		info := raw.(*database.SnapshotInfo)
+		if info.Version >= version {
+			continue
+		}
+
+		infos = append(infos, &database.SnapshotInfo{
+			ID:        info.ID,
+			DocID:     info.DocID,
+			ServerSeq: info.ServerSeq,
+			Lamport:   info.Lamport,
+			Checksum:  info.Checksum,
+			Version:   info.Version,
+			CreatedAt: info.CreatedAt,
+		})
+	}
+
+	return infos, nil
+}
+
+// CountSnapshotInfosToUpgrade returns the number of all the snapshot infos and
+// the number of the ones whose format version is older than the given version.
+func (d *DB) CountSnapshotInfosToUpgrade(
+	ctx context.Context,
+	version int32,
+) (int64, int64, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	iterator, err := txn.Get(tblSnapshots, "id")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var total, outdated int64
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		total++
+		if raw.(*database.SnapshotInfo).Version < version {
+			outdated++
+		}
+	}
+
+	return total, outdated, nil
+}
+
+// RemoveSnapshotInfos removes the given snapshot infos of the given document.
+func (d *DB) RemoveSnapshotInfos(
+	ctx context.Context,
+	docID types.ID,
+	snapshotIDs []types.ID,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	for _, id := range snapshotIDs {
+		raw, err := txn.First(tblSnapshots, "id", id.String())
+		if err != nil {
+			return err
+		}
+		if raw == nil || raw.(*database.SnapshotInfo).DocID != docID {
+			continue
+		}
+
+		if err := deleteSnapshotInfo(txn, raw.(*database.SnapshotInfo)); err != nil {
+			return err
+		}
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// UpdateAndFindMinSyncedTicket updates the given serverSeq of the given client
+// and returns the min synced ticket.
+func (d *DB) UpdateAndFindMinSyncedTicket(
+	ctx context.Context,
+	clientInfo *database.ClientInfo,
+	docID types.ID,
+	serverSeq uint64,
+) (*time.Ticket, error) {
+	if err := d.UpdateSyncedSeq(ctx, clientInfo, docID, serverSeq); err != nil {
+		return nil, err
+	}
+
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	iterator, err := txn.LowerBound(
+		tblSyncedSeqs,
+		"doc_id_lamport_actor_id",
+		docID.String(),
+		uint64(0),
+		time.InitialActorID.String(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var syncedSeqInfo *database.SyncedSeqInfo
+	if raw := iterator.Next(); raw != nil {
+		info := raw.(*database.SyncedSeqInfo)
+		if info.DocID == docID {
+			syncedSeqInfo = info
+		}
+	}
+
+	if syncedSeqInfo == nil || syncedSeqInfo.ServerSeq == change.InitialServerSeq {
+		return time.InitialTicket, nil
+	}
+
+	actorID, err := time.ActorIDFromHex(syncedSeqInfo.ActorID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return time.NewTicket(
+		syncedSeqInfo.Lamport,
+		time.MaxDelimiter,
+		actorID,
+	), nil
+}
+
+// UpdateSyncedSeq updates the syncedSeq of the given client.
+func (d *DB) UpdateSyncedSeq(
+	ctx context.Context,
+	clientInfo *database.ClientInfo,
+	docID types.ID,
+	serverSeq uint64,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	isAttached, err := clientInfo.IsAttached(docID)
+	if err != nil {
+		return err
+	}
+
+	if !isAttached {
+		if _, err = txn.DeleteAll(
+			tblSyncedSeqs,
 			"doc_id_client_id",
 			docID.String(),
 			clientInfo.ID.String(),
@@ -833,7 +1308,10 @@ func (d *DB) FindDocInfosByPaging(
 			break
 		}
 
-		if info.ID != paging.Offset {
+		if info.ID != paging.Offset &&
+			existsAt(info, paging.SnapshotAt) &&
+			!info.IsArchived() &&
+			info.HasMetadata(paging.Metadata) {
 			docInfos = append(docInfos, info)
 		}
 	}
@@ -859,8 +1337,12 @@ func (d *DB) FindDocInfosByQuery(
 	var docInfos []*database.DocInfo
 	count := 0
 	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		info := raw.(*database.DocInfo)
+		if info.IsRemoved() || info.IsArchived() {
+			continue
+		}
+
 		if count < pageSize {
-			info := raw.(*database.DocInfo)
 			docInfos = append(docInfos, info)
 		}
 		count++
@@ -872,44 +1354,972 @@ func (d *DB) FindDocInfosByQuery(
 	}, nil
 }
 
-func (d *DB) findTicketByServerSeq(
-	txn *memdb.Txn,
-	docID types.ID,
-	serverSeq uint64,
-) (*time.Ticket, error) {
-	if serverSeq == change.InitialServerSeq {
-		return time.InitialTicket, nil
-	}
+// FindDocInfosByKeyPrefix returns at most limit documentInfos whose keys
+// start with the given prefix, in ascending order of ID after the given offset.
+func (d *DB) FindDocInfosByKeyPrefix(
+	ctx context.Context,
+	projectID types.ID,
+	prefix string,
+	offset types.ID,
+	limit int,
+) ([]*database.DocInfo, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
 
-	raw, err := txn.First(
-		tblChanges,
-		"doc_id_server_seq",
-		docID.String(),
-		serverSeq,
+	iterator, err := txn.LowerBound(
+		tblDocuments,
+		"project_id_id",
+		projectID.String(),
+		offset.String(),
 	)
 	if err != nil {
 		return nil, err
 	}
+
+	var docInfos []*database.DocInfo
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		info := raw.(*database.DocInfo)
+		if len(docInfos) >= limit || info.ProjectID != projectID {
+			break
+		}
+
+		if info.ID != offset && !info.IsRemoved() && strings.HasPrefix(info.Key.String(), prefix) {
+			docInfos = append(docInfos, info.DeepCopy())
+		}
+	}
+
+	return docInfos, nil
+}
+
+// PurgeDocInfo removes the document of the given ID with its changes,
+// snapshots and synced sequences. Unlike RemoveDocInfo, nothing of the
+// document remains. It is only provided by the memory database to discard
+// the ephemeral documents.
+func (d *DB) PurgeDocInfo(
+	ctx context.Context,
+	docID types.ID,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	if _, err := txn.DeleteAll(tblDocuments, "id", docID.String()); err != nil {
+		return err
+	}
+	if _, err := txn.DeleteAll(tblChanges, "doc_id_server_seq_prefix", docID.String()); err != nil {
+		return err
+	}
+	snapshots, err := txn.Get(tblSnapshots, "doc_id_server_seq_prefix", docID.String())
+	if err != nil {
+		return err
+	}
+	var snapshotInfos []*database.SnapshotInfo
+	for raw := snapshots.Next(); raw != nil; raw = snapshots.Next() {
+		snapshotInfos = append(snapshotInfos, raw.(*database.SnapshotInfo))
+	}
+	for _, info := range snapshotInfos {
+		if err := deleteSnapshotInfo(txn, info); err != nil {
+			return err
+		}
+	}
+	if _, err := txn.DeleteAll(tblSyncedSeqs, "doc_id_client_id_prefix", docID.String()); err != nil {
+		return err
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// RemoveDocInfo soft-removes the document of the given ID.
+func (d *DB) RemoveDocInfo(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblDocuments, "id", docID.String())
+	if err != nil {
+		return err
+	}
 	if raw == nil {
-		return nil, fmt.Errorf(
-			"docID %s, serverSeq %d: %w",
-			docID.String(),
-			serverSeq,
-			database.ErrDocumentNotFound,
-		)
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
 	}
 
-	changeInfo := raw.(*database.ChangeInfo)
-	actorID, err := time.ActorIDFromHex(changeInfo.ActorID.String())
+	docInfo := raw.(*database.DocInfo).DeepCopy()
+	if docInfo.ProjectID != projectID || docInfo.IsRemoved() {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	docInfo.RemovedAt = gotime.Now()
+	if err := txn.Insert(tblDocuments, docInfo); err != nil {
+		return err
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// FindRemovedDocInfoByKey finds the document of the given key removed most
+// recently.
+func (d *DB) FindRemovedDocInfoByKey(
+	ctx context.Context,
+	projectID types.ID,
+	docKey key.Key,
+) (*database.DocInfo, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	iterator, err := txn.Get(tblDocuments, "project_id_key", projectID.String(), docKey.String())
 	if err != nil {
 		return nil, err
 	}
 
-	return time.NewTicket(
-		changeInfo.Lamport,
-		time.MaxDelimiter,
-		actorID,
-	), nil
+	var latest *database.DocInfo
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		info := raw.(*database.DocInfo)
+		if info.IsRemoved() && (latest == nil || info.RemovedAt.After(latest.RemovedAt)) {
+			latest = info
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("%s %s: %w", projectID, docKey, database.ErrDocumentNotFound)
+	}
+
+	return latest.DeepCopy(), nil
+}
+
+// RestoreDocInfo restores the removed document of the given ID. It returns
+// ErrDocumentAlreadyExists if a document of the same key exists.
+func (d *DB) RestoreDocInfo(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblDocuments, "id", docID.String())
+	if err != nil {
+		return err
+	}
+	if raw == nil {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	docInfo := raw.(*database.DocInfo).DeepCopy()
+	if docInfo.ProjectID != projectID || !docInfo.IsRemoved() {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	existing, err := findDocInfoByKey(txn, projectID, docInfo.Key)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return fmt.Errorf("%s: %w", docInfo.Key, database.ErrDocumentAlreadyExists)
+	}
+
+	docInfo.RemovedAt = gotime.Time{}
+	docInfo.UpdatedAt = gotime.Now()
+	if err := txn.Insert(tblDocuments, docInfo); err != nil {
+		return err
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// UpdateDocInfoLock locks or unlocks the document of the given ID. The reason
+// is cleared when unlocking.
+func (d *DB) UpdateDocInfoLock(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+	locked bool,
+	reason string,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblDocuments, "id", docID.String())
+	if err != nil {
+		return err
+	}
+	if raw == nil {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	docInfo := raw.(*database.DocInfo).DeepCopy()
+	if docInfo.ProjectID != projectID || docInfo.IsRemoved() {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	docInfo.Locked = locked
+	docInfo.LockReason = ""
+	if locked {
+		docInfo.LockReason = reason
+	}
+	if err := txn.Insert(tblDocuments, docInfo); err != nil {
+		return err
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// UpdateDocInfoMetadata replaces the metadata of the document of the given ID
+// with the given metadata.
+func (d *DB) UpdateDocInfoMetadata(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+	metadata map[string]string,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblDocuments, "id", docID.String())
+	if err != nil {
+		return err
+	}
+	if raw == nil {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	docInfo := raw.(*database.DocInfo).DeepCopy()
+	if docInfo.ProjectID != projectID || docInfo.IsRemoved() {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	docInfo.Metadata = nil
+	if len(metadata) > 0 {
+		docInfo.Metadata = make(map[string]string, len(metadata))
+		for k, v := range metadata {
+			docInfo.Metadata[k] = v
+		}
+	}
+	if err := txn.Insert(tblDocuments, docInfo); err != nil {
+		return err
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// MoveDocInfo moves the document of the given ID to the destination project.
+// The clients attached to the document are detached, and the actors of the
+// document are cleared.
+func (d *DB) MoveDocInfo(
+	ctx context.Context,
+	srcProjectID types.ID,
+	docID types.ID,
+	dstProjectID types.ID,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblDocuments, "id", docID.String())
+	if err != nil {
+		return err
+	}
+	if raw == nil {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	docInfo := raw.(*database.DocInfo).DeepCopy()
+	if docInfo.ProjectID != srcProjectID || docInfo.IsRemoved() {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	existing, err := findDocInfoByKey(txn, dstProjectID, docInfo.Key)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return fmt.Errorf("%s: %w", docInfo.Key, database.ErrDocumentAlreadyExists)
+	}
+
+	iterator, err := txn.Get(tblClients, "project_id_key_prefix", srcProjectID.String())
+	if err != nil {
+		return err
+	}
+	var clientInfos []*database.ClientInfo
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		clientInfo := raw.(*database.ClientInfo)
+		if attached, err := clientInfo.IsAttached(docID); err == nil && attached {
+			clientInfos = append(clientInfos, clientInfo.DeepCopy())
+		}
+	}
+	for _, clientInfo := range clientInfos {
+		if err := clientInfo.DetachDocument(docID); err != nil {
+			return err
+		}
+		if err := txn.Insert(tblClients, clientInfo); err != nil {
+			return err
+		}
+	}
+
+	if _, err := txn.DeleteAll(tblSyncedSeqs, "doc_id_client_id_prefix", docID.String()); err != nil {
+		return err
+	}
+
+	docInfo.ProjectID = dstProjectID
+	docInfo.ActorIDs = nil
+	docInfo.UpdatedAt = gotime.Now()
+	if err := txn.Insert(tblDocuments, docInfo); err != nil {
+		return err
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// FindDocInfosToArchive returns at most limit documents of the given project
+// which are neither removed nor archived, and have been neither created nor
+// updated since the given time.
+func (d *DB) FindDocInfosToArchive(
+	ctx context.Context,
+	projectID types.ID,
+	inactiveSince gotime.Time,
+	limit int,
+) ([]*database.DocInfo, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	iterator, err := txn.LowerBound(tblDocuments, "project_id_id", projectID.String(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	var docInfos []*database.DocInfo
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		info := raw.(*database.DocInfo)
+		if len(docInfos) >= limit || info.ProjectID != projectID {
+			break
+		}
+
+		if !info.IsRemoved() && !info.IsArchived() && !info.IsActiveSince(inactiveSince) {
+			docInfos = append(docInfos, info.DeepCopy())
+		}
+	}
+
+	return docInfos, nil
+}
+
+// ArchiveDocInfo archives the document of the given ID. The clients attached
+// to the document are detached, the actors of the document are cleared and the
+// changes and the event log entries before the given serverSeq are removed.
+func (d *DB) ArchiveDocInfo(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+	serverSeq uint64,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblDocuments, "id", docID.String())
+	if err != nil {
+		return err
+	}
+	if raw == nil {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	docInfo := raw.(*database.DocInfo).DeepCopy()
+	if docInfo.ProjectID != projectID || docInfo.IsRemoved() {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	iterator, err := txn.Get(tblClients, "project_id_key_prefix", projectID.String())
+	if err != nil {
+		return err
+	}
+	var clientInfos []*database.ClientInfo
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		clientInfo := raw.(*database.ClientInfo)
+		if attached, err := clientInfo.IsAttached(docID); err == nil && attached {
+			clientInfos = append(clientInfos, clientInfo.DeepCopy())
+		}
+	}
+	for _, clientInfo := range clientInfos {
+		if err := clientInfo.DetachDocument(docID); err != nil {
+			return err
+		}
+		if err := txn.Insert(tblClients, clientInfo); err != nil {
+			return err
+		}
+	}
+
+	if _, err := txn.DeleteAll(tblSyncedSeqs, "doc_id_client_id_prefix", docID.String()); err != nil {
+		return err
+	}
+
+	changes, err := txn.LowerBound(tblChanges, "doc_id_server_seq", docID.String(), uint64(0))
+	if err != nil {
+		return err
+	}
+	var compacted []*database.ChangeInfo
+	for raw := changes.Next(); raw != nil; raw = changes.Next() {
+		info := raw.(*database.ChangeInfo)
+		if info.DocID != docID || info.ServerSeq >= serverSeq {
+			break
+		}
+		compacted = append(compacted, info)
+	}
+	for _, info := range compacted {
+		if err := txn.Delete(tblChanges, info); err != nil {
+			return err
+		}
+	}
+
+	logs, err := txn.LowerBound(tblDocEventLogs, "doc_id_server_seq", docID.String(), uint64(0))
+	if err != nil {
+		return err
+	}
+	var compactedLogs []*database.DocEventLogInfo
+	for raw := logs.Next(); raw != nil; raw = logs.Next() {
+		info := raw.(*database.DocEventLogInfo)
+		if info.DocID != docID || info.ServerSeq >= serverSeq {
+			break
+		}
+		compactedLogs = append(compactedLogs, info)
+	}
+	for _, info := range compactedLogs {
+		if err := txn.Delete(tblDocEventLogs, info); err != nil {
+			return err
+		}
+	}
+
+	docInfo.ActorIDs = nil
+	docInfo.ArchivedAt = gotime.Now()
+	docInfo.CompactedServerSeq = serverSeq
+	if err := txn.Insert(tblDocuments, docInfo); err != nil {
+		return err
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// UnarchiveDocInfo unarchives the document of the given ID. The document is
+// regarded as updated so that it is not archived again right away.
+func (d *DB) UnarchiveDocInfo(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblDocuments, "id", docID.String())
+	if err != nil {
+		return err
+	}
+	if raw == nil {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	docInfo := raw.(*database.DocInfo).DeepCopy()
+	if docInfo.ProjectID != projectID || docInfo.IsRemoved() {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	docInfo.ArchivedAt = gotime.Time{}
+	docInfo.UpdatedAt = gotime.Now()
+	if err := txn.Insert(tblDocuments, docInfo); err != nil {
+		return err
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// CreateDocClientEventInfo stores the event of the given client on the given
+// document.
+func (d *DB) CreateDocClientEventInfo(
+	ctx context.Context,
+	docID types.ID,
+	clientID types.ID,
+	eventType types.DocumentClientEventType,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	if err := txn.Insert(tblDocClientEvents, &database.DocClientEventInfo{
+		ID:        newID(),
+		DocID:     docID,
+		ClientID:  clientID,
+		Type:      eventType,
+		CreatedAt: gotime.Now(),
+	}); err != nil {
+		return err
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// FindDocClientEventInfos returns the events of clients on the given document
+// which occurred in [from, to) in order of ID.
+func (d *DB) FindDocClientEventInfos(
+	ctx context.Context,
+	docID types.ID,
+	from gotime.Time,
+	to gotime.Time,
+	paging types.Paging[types.ID],
+) ([]*database.DocClientEventInfo, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	var iterator memdb.ResultIterator
+	var err error
+	if paging.IsForward {
+		iterator, err = txn.LowerBound(
+			tblDocClientEvents,
+			"doc_id_id",
+			docID.String(),
+			paging.Offset.String(),
+		)
+	} else {
+		offset := paging.Offset
+		if paging.Offset == "" {
+			offset = types.IDFromActorID(time.MaxActorID)
+		}
+
+		iterator, err = txn.ReverseLowerBound(
+			tblDocClientEvents,
+			"doc_id_id",
+			docID.String(),
+			offset.String(),
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var infos []*database.DocClientEventInfo
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		info := raw.(*database.DocClientEventInfo)
+		if len(infos) >= paging.PageSize || info.DocID != docID {
+			break
+		}
+
+		if info.ID == paging.Offset ||
+			(!from.IsZero() && info.CreatedAt.Before(from)) ||
+			(!to.IsZero() && !info.CreatedAt.Before(to)) {
+			continue
+		}
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
+
+// RemoveDocClientEventInfosBefore removes the events of clients which occurred
+// before the given time.
+func (d *DB) RemoveDocClientEventInfosBefore(
+	ctx context.Context,
+	before gotime.Time,
+) (int, error) {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	iterator, err := txn.Get(tblDocClientEvents, "id")
+	if err != nil {
+		return 0, err
+	}
+
+	var infos []*database.DocClientEventInfo
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		if info := raw.(*database.DocClientEventInfo); info.CreatedAt.Before(before) {
+			infos = append(infos, info)
+		}
+	}
+
+	for _, info := range infos {
+		if err := txn.Delete(tblDocClientEvents, info); err != nil {
+			return 0, err
+		}
+	}
+
+	txn.Commit()
+	return len(infos), nil
+}
+
+// CountDocInfos returns the number of the documents of the given project,
+// excluding the removed ones.
+func (d *DB) CountDocInfos(ctx context.Context, projectID types.ID) (int, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	iterator, err := txn.Get(tblDocuments, "project_id_id_prefix", projectID.String())
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		if info := raw.(*database.DocInfo); !info.IsRemoved() {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// AddDocActor adds the given actor to the actors of the given document.
+func (d *DB) AddDocActor(
+	ctx context.Context,
+	docID types.ID,
+	actorID types.ID,
+	maxActors int,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblDocuments, "id", docID.String())
+	if err != nil {
+		return err
+	}
+	if raw == nil {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	docInfo := raw.(*database.DocInfo).DeepCopy()
+	for _, id := range docInfo.ActorIDs {
+		if id == actorID {
+			return nil
+		}
+	}
+	if maxActors > 0 && len(docInfo.ActorIDs) >= maxActors {
+		return fmt.Errorf("%s: %w", docID, database.ErrTooManyActors)
+	}
+
+	docInfo.ActorIDs = append(docInfo.ActorIDs, actorID)
+	if err := txn.Insert(tblDocuments, docInfo); err != nil {
+		return err
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// RemoveDocActors removes the given actors from the actors of the given
+// document.
+func (d *DB) RemoveDocActors(
+	ctx context.Context,
+	docID types.ID,
+	actorIDs []types.ID,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblDocuments, "id", docID.String())
+	if err != nil {
+		return err
+	}
+	if raw == nil {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	removed := make(map[types.ID]bool)
+	for _, id := range actorIDs {
+		removed[id] = true
+	}
+
+	docInfo := raw.(*database.DocInfo).DeepCopy()
+	var remaining []types.ID
+	for _, id := range docInfo.ActorIDs {
+		if !removed[id] {
+			remaining = append(remaining, id)
+		}
+	}
+	docInfo.ActorIDs = remaining
+
+	if err := txn.Insert(tblDocuments, docInfo); err != nil {
+		return err
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// FindDocInfosWithActors returns at most limit documentInfos which have
+// actors, in ascending order of ID after the given offset.
+func (d *DB) FindDocInfosWithActors(
+	ctx context.Context,
+	offset types.ID,
+	limit int,
+) ([]*database.DocInfo, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	iterator, err := txn.LowerBound(tblDocuments, "id", offset.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var docInfos []*database.DocInfo
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		if len(docInfos) >= limit {
+			break
+		}
+
+		info := raw.(*database.DocInfo)
+		if info.ID != offset && len(info.ActorIDs) > 0 {
+			docInfos = append(docInfos, info.DeepCopy())
+		}
+	}
+
+	return docInfos, nil
+}
+
+// FindDocInfosWithGCInterval returns at most limit documentInfos which have
+// the GC interval in their metadata, in ascending order of ID after the given
+// offset.
+func (d *DB) FindDocInfosWithGCInterval(
+	ctx context.Context,
+	offset types.ID,
+	limit int,
+) ([]*database.DocInfo, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	iterator, err := txn.LowerBound(tblDocuments, "id", offset.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var docInfos []*database.DocInfo
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		if len(docInfos) >= limit {
+			break
+		}
+
+		info := raw.(*database.DocInfo)
+		if info.ID == offset {
+			continue
+		}
+		if _, ok := info.Metadata[types.DocumentGCIntervalMetadataKey]; ok {
+			docInfos = append(docInfos, info.DeepCopy())
+		}
+	}
+
+	return docInfos, nil
+}
+
+// FindMinSyncedSeqInfo returns the syncedSeqInfo of the given document with
+// the smallest serverSeq.
+func (d *DB) FindMinSyncedSeqInfo(
+	ctx context.Context,
+	docID types.ID,
+) (*database.SyncedSeqInfo, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	iterator, err := txn.Get(tblSyncedSeqs, "doc_id_client_id_prefix", docID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var minSyncedSeqInfo *database.SyncedSeqInfo
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		info := raw.(*database.SyncedSeqInfo)
+		if minSyncedSeqInfo == nil || info.ServerSeq < minSyncedSeqInfo.ServerSeq {
+			minSyncedSeqInfo = info
+		}
+	}
+
+	return minSyncedSeqInfo, nil
+}
+
+// CreateDocEventLogInfos appends the given entries to the event logs of their
+// documents.
+func (d *DB) CreateDocEventLogInfos(ctx context.Context, infos []*database.DocEventLogInfo) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	for _, info := range infos {
+		info = info.DeepCopy()
+		info.ID = newID()
+		if err := txn.Insert(tblDocEventLogs, info); err != nil {
+			return err
+		}
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// FindDocEventLogInfos returns the entries of the event log of the given
+// document whose server sequences are in [from, to].
+func (d *DB) FindDocEventLogInfos(
+	ctx context.Context,
+	docID types.ID,
+	from uint64,
+	to uint64,
+) ([]*database.DocEventLogInfo, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	iterator, err := txn.LowerBound(tblDocEventLogs, "doc_id_server_seq", docID.String(), from)
+	if err != nil {
+		return nil, err
+	}
+
+	var infos []*database.DocEventLogInfo
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		info := raw.(*database.DocEventLogInfo)
+		if info.DocID != docID || info.ServerSeq > to {
+			break
+		}
+		infos = append(infos, info.DeepCopy())
+	}
+
+	return infos, nil
+}
+
+// RemoveDocEventLogInfosBefore removes the entries of the event logs created
+// before the given time.
+func (d *DB) RemoveDocEventLogInfosBefore(ctx context.Context, before gotime.Time) (int, error) {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	iterator, err := txn.Get(tblDocEventLogs, "id")
+	if err != nil {
+		return 0, err
+	}
+
+	var infos []*database.DocEventLogInfo
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		if info := raw.(*database.DocEventLogInfo); info.CreatedAt.Before(before) {
+			infos = append(infos, info)
+		}
+	}
+
+	for _, info := range infos {
+		if err := txn.Delete(tblDocEventLogs, info); err != nil {
+			return 0, err
+		}
+	}
+
+	txn.Commit()
+	return len(infos), nil
+}
+
+// FindMaintenanceInfo returns the maintenance mode of the server.
+func (d *DB) FindMaintenanceInfo(ctx context.Context) (*database.MaintenanceInfo, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblMaintenance, "id", database.MaintenanceInfoID)
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return &database.MaintenanceInfo{ID: database.MaintenanceInfoID}, nil
+	}
+
+	return raw.(*database.MaintenanceInfo).DeepCopy(), nil
+}
+
+// UpdateMaintenanceInfo updates the maintenance mode of the server.
+func (d *DB) UpdateMaintenanceInfo(
+	ctx context.Context,
+	enabled bool,
+	message string,
+) (*database.MaintenanceInfo, error) {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	info := &database.MaintenanceInfo{
+		ID:        database.MaintenanceInfoID,
+		Enabled:   enabled,
+		Message:   message,
+		UpdatedAt: gotime.Now(),
+	}
+	if err := txn.Insert(tblMaintenance, info); err != nil {
+		return nil, err
+	}
+
+	txn.Commit()
+	return info.DeepCopy(), nil
+}
+
+// findDocInfoByKey returns the document of the given key which is not removed.
+// It returns nil if there is no such document.
+func findDocInfoByKey(
+	txn *memdb.Txn,
+	projectID types.ID,
+	key key.Key,
+) (*database.DocInfo, error) {
+	iterator, err := txn.Get(tblDocuments, "project_id_key", projectID.String(), key.String())
+	if err != nil {
+		return nil, err
+	}
+
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		if info := raw.(*database.DocInfo); !info.IsRemoved() {
+			return info, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (d *DB) findTicketByServerSeq(
+	txn *memdb.Txn,
+	docID types.ID,
+	serverSeq uint64,
+) (*time.Ticket, error) {
+	if serverSeq == change.InitialServerSeq {
+		return time.InitialTicket, nil
+	}
+
+	raw, err := txn.First(
+		tblChanges,
+		"doc_id_server_seq",
+		docID.String(),
+		serverSeq,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, fmt.Errorf(
+			"docID %s, serverSeq %d: %w",
+			docID.String(),
+			serverSeq,
+			database.ErrDocumentNotFound,
+		)
+	}
+
+	changeInfo := raw.(*database.ChangeInfo)
+	actorID, err := time.ActorIDFromHex(changeInfo.ActorID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return time.NewTicket(
+		changeInfo.Lamport,
+		time.MaxDelimiter,
+		actorID,
+	), nil
+}
+
+// existsAt returns whether the given document existed at the given time. Zero
+// time means now.
+func existsAt(info *database.DocInfo, at gotime.Time) bool {
+	if at.IsZero() {
+		return !info.IsRemoved()
+	}
+
+	if info.CreatedAt.After(at) {
+		return false
+	}
+	return !info.IsRemoved() || info.RemovedAt.After(at)
 }
 
 func newID() types.ID {
@@ -18,11 +18,15 @@ package memory_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"testing"
+	gotime "time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
@@ -35,7 +39,7 @@ import (
 
 func TestDB(t *testing.T) {
 	ctx := context.Background()
-	db, err := memory.New()
+	db, err := memory.New(&database.ObjectIDGenerator{})
 	assert.NoError(t, err)
 
 	projectID := database.DefaultProjectID
@@ -79,6 +83,17 @@ func TestDB(t *testing.T) {
 
 		_, err = db.CreateProjectInfo(ctx, t.Name())
 		assert.ErrorIs(t, err, database.ErrProjectAlreadyExists)
+
+		infos, err := db.ListProjectInfos(ctx)
+		assert.NoError(t, err)
+		count, err := db.CountProjectInfos(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, len(infos), count)
+
+		infos, err = db.FindProjectInfosByIDs(ctx, []types.ID{info.ID, "ffffffffffffffffffffffff"})
+		assert.NoError(t, err)
+		assert.Len(t, infos, 1)
+		assert.Equal(t, info.ID, infos[0].ID)
 	})
 
 	t.Run("activate and find client test", func(t *testing.T) {
@@ -107,7 +122,7 @@ func TestDB(t *testing.T) {
 	})
 
 	t.Run("search docInfos test", func(t *testing.T) {
-		localDB, err := memory.New()
+		localDB, err := memory.New(&database.ObjectIDGenerator{})
 		assert.NoError(t, err)
 
 		clientInfo, err := localDB.ActivateClient(ctx, projectID, t.Name())
@@ -137,6 +152,365 @@ func TestDB(t *testing.T) {
 		assert.Equal(t, 15, res.TotalCount)
 	})
 
+	t.Run("remove docInfo test", func(t *testing.T) {
+		localDB, err := memory.New(&database.ObjectIDGenerator{})
+		assert.NoError(t, err)
+
+		clientInfo, err := localDB.ActivateClient(ctx, projectID, t.Name())
+		assert.NoError(t, err)
+
+		var docInfos []*database.DocInfo
+		for _, docKey := range []key.Key{"tmp-1", "tmp-2", "tmp-3", "keep"} {
+			docInfo, err := localDB.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, docKey, true)
+			assert.NoError(t, err)
+			docInfos = append(docInfos, docInfo)
+		}
+
+		found, err := localDB.FindDocInfosByKeyPrefix(ctx, projectID, "tmp-", "", 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []*database.DocInfo{docInfos[0], docInfos[1]}, found)
+		found, err = localDB.FindDocInfosByKeyPrefix(ctx, projectID, "tmp-", found[1].ID, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []*database.DocInfo{docInfos[2]}, found)
+
+		// removed documents are excluded from the lookups by key.
+		assert.NoError(t, localDB.RemoveDocInfo(ctx, projectID, docInfos[0].ID))
+		assert.ErrorIs(t, localDB.RemoveDocInfo(ctx, projectID, docInfos[0].ID), database.ErrDocumentNotFound)
+		_, err = localDB.FindDocInfoByKey(ctx, projectID, "tmp-1")
+		assert.ErrorIs(t, err, database.ErrDocumentNotFound)
+		found, err = localDB.FindDocInfosByKeyPrefix(ctx, projectID, "tmp-", "", 10)
+		assert.NoError(t, err)
+		assert.Len(t, found, 2)
+		res, err := localDB.FindDocInfosByQuery(ctx, projectID, "tmp-", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, res.TotalCount)
+
+		removed, err := localDB.FindDocInfoByID(ctx, docInfos[0].ID)
+		assert.NoError(t, err)
+		assert.True(t, removed.IsRemoved())
+
+		// the key of the removed document can be used again.
+		docInfo, err := localDB.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, "tmp-1", true)
+		assert.NoError(t, err)
+		assert.NotEqual(t, docInfos[0].ID, docInfo.ID)
+		assert.False(t, docInfo.IsRemoved())
+	})
+
+	t.Run("restore docInfo test", func(t *testing.T) {
+		localDB, err := memory.New(&database.ObjectIDGenerator{})
+		assert.NoError(t, err)
+
+		clientInfo, err := localDB.ActivateClient(ctx, projectID, t.Name())
+		assert.NoError(t, err)
+
+		_, err = localDB.FindRemovedDocInfoByKey(ctx, projectID, "doc")
+		assert.ErrorIs(t, err, database.ErrDocumentNotFound)
+
+		// the document removed most recently is found.
+		first, err := localDB.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, "doc", true)
+		assert.NoError(t, err)
+		assert.NoError(t, localDB.RemoveDocInfo(ctx, projectID, first.ID))
+		second, err := localDB.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, "doc", true)
+		assert.NoError(t, err)
+		assert.NoError(t, localDB.RemoveDocInfo(ctx, projectID, second.ID))
+
+		removed, err := localDB.FindRemovedDocInfoByKey(ctx, projectID, "doc")
+		assert.NoError(t, err)
+		assert.Equal(t, second.ID, removed.ID)
+
+		// the removed document is restored only if the key is not used.
+		assert.NoError(t, localDB.RestoreDocInfo(ctx, projectID, second.ID))
+		assert.ErrorIs(t, localDB.RestoreDocInfo(ctx, projectID, second.ID), database.ErrDocumentNotFound)
+		assert.ErrorIs(t, localDB.RestoreDocInfo(ctx, projectID, first.ID), database.ErrDocumentAlreadyExists)
+
+		docInfo, err := localDB.FindDocInfoByKey(ctx, projectID, "doc")
+		assert.NoError(t, err)
+		assert.Equal(t, second.ID, docInfo.ID)
+		assert.False(t, docInfo.IsRemoved())
+	})
+
+	t.Run("move docInfo test", func(t *testing.T) {
+		localDB, err := memory.New(&database.ObjectIDGenerator{})
+		assert.NoError(t, err)
+		dstProjectInfo, err := localDB.CreateProjectInfo(ctx, "move-test")
+		assert.NoError(t, err)
+
+		clientInfo, err := localDB.ActivateClient(ctx, projectID, t.Name())
+		assert.NoError(t, err)
+		docKey := key.Key(t.Name())
+		docInfo, err := localDB.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, docKey, true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID, false))
+		assert.NoError(t, localDB.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo))
+		assert.NoError(t, localDB.AddDocActor(ctx, docInfo.ID, clientInfo.ID, 0))
+
+		// 01. the document is moved and the attached clients are detached.
+		assert.NoError(t, localDB.MoveDocInfo(ctx, projectID, docInfo.ID, dstProjectInfo.ID))
+		_, err = localDB.FindDocInfoByKey(ctx, projectID, docKey)
+		assert.ErrorIs(t, err, database.ErrDocumentNotFound)
+		moved, err := localDB.FindDocInfoByKey(ctx, dstProjectInfo.ID, docKey)
+		assert.NoError(t, err)
+		assert.Equal(t, docInfo.ID, moved.ID)
+		assert.Empty(t, moved.ActorIDs)
+
+		clientInfo, err = localDB.FindClientInfoByID(ctx, projectID, clientInfo.ID)
+		assert.NoError(t, err)
+		attached, err := clientInfo.IsAttached(docInfo.ID)
+		assert.NoError(t, err)
+		assert.False(t, attached)
+
+		// 02. the document is not moved if the key exists in the destination.
+		docInfo, err = localDB.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, docKey, true)
+		assert.NoError(t, err)
+		assert.ErrorIs(
+			t,
+			localDB.MoveDocInfo(ctx, projectID, docInfo.ID, dstProjectInfo.ID),
+			database.ErrDocumentAlreadyExists,
+		)
+		_, err = localDB.FindDocInfoByKey(ctx, projectID, docKey)
+		assert.NoError(t, err)
+	})
+
+	t.Run("lock docInfo test", func(t *testing.T) {
+		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name())
+		assert.NoError(t, err)
+		docInfo, err := db.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, key.Key(t.Name()), true)
+		assert.NoError(t, err)
+		assert.NoError(t, docInfo.EnsureWritable())
+
+		assert.NoError(t, db.UpdateDocInfoLock(ctx, projectID, docInfo.ID, true, "maintenance"))
+		locked, err := db.FindDocInfoByKey(ctx, projectID, docInfo.Key)
+		assert.NoError(t, err)
+		assert.True(t, locked.Locked)
+		assert.ErrorIs(t, locked.EnsureWritable(), database.ErrDocumentLocked)
+		assert.Contains(t, locked.EnsureWritable().Error(), "maintenance")
+
+		assert.NoError(t, db.UpdateDocInfoLock(ctx, projectID, docInfo.ID, false, ""))
+		unlocked, err := db.FindDocInfoByKey(ctx, projectID, docInfo.Key)
+		assert.NoError(t, err)
+		assert.NoError(t, unlocked.EnsureWritable())
+		assert.Empty(t, unlocked.LockReason)
+
+		assert.ErrorIs(
+			t,
+			db.UpdateDocInfoLock(ctx, "ffffffffffffffffffffffff", docInfo.ID, true, ""),
+			database.ErrDocumentNotFound,
+		)
+	})
+
+	t.Run("update docInfo metadata test", func(t *testing.T) {
+		localDB, err := memory.New(&database.ObjectIDGenerator{})
+		assert.NoError(t, err)
+		clientInfo, err := localDB.ActivateClient(ctx, projectID, t.Name())
+		assert.NoError(t, err)
+
+		var docInfos []*database.DocInfo
+		for i := 0; i < 3; i++ {
+			docInfo, err := localDB.FindDocInfoByKeyAndOwner(
+				ctx,
+				projectID,
+				clientInfo.ID,
+				key.Key(fmt.Sprintf("doc-%d", i)),
+				true,
+			)
+			assert.NoError(t, err)
+			docInfos = append(docInfos, docInfo)
+		}
+
+		owner := map[string]string{"owner": "alice", "category": "memo"}
+		assert.NoError(t, localDB.UpdateDocInfoMetadata(ctx, projectID, docInfos[0].ID, owner))
+		assert.NoError(t, localDB.UpdateDocInfoMetadata(ctx, projectID, docInfos[2].ID, map[string]string{
+			"owner": "bob",
+		}))
+
+		// NOTE: The metadata is copied, so the given map does not affect it.
+		owner["owner"] = "carol"
+		found, err := localDB.FindDocInfoByKey(ctx, projectID, docInfos[0].Key)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"owner": "alice", "category": "memo"}, found.Metadata)
+
+		infos, err := localDB.FindDocInfosByPaging(ctx, projectID, types.Paging[types.ID]{
+			PageSize:  10,
+			IsForward: true,
+			Metadata:  map[string]string{"owner": "alice"},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, infos, 1)
+		assert.Equal(t, docInfos[0].ID, infos[0].ID)
+
+		assert.NoError(t, localDB.UpdateDocInfoMetadata(ctx, projectID, docInfos[0].ID, nil))
+		found, err = localDB.FindDocInfoByKey(ctx, projectID, docInfos[0].Key)
+		assert.NoError(t, err)
+		assert.Nil(t, found.Metadata)
+
+		assert.ErrorIs(
+			t,
+			localDB.UpdateDocInfoMetadata(ctx, "ffffffffffffffffffffffff", docInfos[1].ID, owner),
+			database.ErrDocumentNotFound,
+		)
+	})
+
+	t.Run("archive docInfo test", func(t *testing.T) {
+		localDB, err := memory.New(&database.ObjectIDGenerator{})
+		assert.NoError(t, err)
+		clientInfo, err := localDB.ActivateClient(ctx, projectID, t.Name())
+		assert.NoError(t, err)
+		docInfo, err := localDB.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, key.Key(t.Name()), true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID, false))
+		assert.NoError(t, localDB.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo))
+
+		bytesID, _ := clientInfo.ID.Bytes()
+		actorID, _ := time.ActorIDFromBytes(bytesID)
+		doc := document.New(key.Key(t.Name()))
+		doc.SetActor(actorID)
+		for idx := 0; idx < 3; idx++ {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger("k", idx)
+				return nil
+			}))
+		}
+		pack := doc.CreateChangePack()
+		for _, c := range pack.Changes {
+			c.SetServerSeq(docInfo.IncreaseServerSeq())
+		}
+		assert.NoError(t, localDB.CreateChangeInfos(ctx, projectID, docInfo, 0, pack.Changes))
+
+		// 01. Only the documents inactive since the given time are found.
+		infos, err := localDB.FindDocInfosToArchive(ctx, projectID, gotime.Now().Add(-gotime.Hour), 10)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 0)
+		infos, err = localDB.FindDocInfosToArchive(ctx, projectID, gotime.Now().Add(gotime.Second), 10)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 1)
+
+		// 02. The archived document is detached and its changes are compacted.
+		assert.NoError(t, localDB.ArchiveDocInfo(ctx, projectID, docInfo.ID, docInfo.ServerSeq))
+		found, err := localDB.FindDocInfoByID(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.True(t, found.IsArchived())
+		assert.Equal(t, docInfo.ServerSeq, found.CompactedServerSeq)
+		assert.ErrorIs(t, found.EnsureUnarchived(), database.ErrDocumentArchived)
+
+		changes, err := localDB.FindChangeInfosBetweenServerSeqs(ctx, docInfo.ID, 1, docInfo.ServerSeq)
+		assert.NoError(t, err)
+		assert.Len(t, changes, 1)
+		assert.Equal(t, docInfo.ServerSeq, changes[0].ServerSeq)
+
+		loadedClientInfo, err := localDB.FindClientInfoByID(ctx, projectID, clientInfo.ID)
+		assert.NoError(t, err)
+		attached, err := loadedClientInfo.IsAttached(docInfo.ID)
+		assert.NoError(t, err)
+		assert.False(t, attached)
+
+		// 03. The archived document is excluded from the listing.
+		infos, err = localDB.FindDocInfosByPaging(ctx, projectID, types.Paging[types.ID]{
+			PageSize:  10,
+			IsForward: true,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, infos, 0)
+		infos, err = localDB.FindDocInfosToArchive(ctx, projectID, gotime.Now().Add(gotime.Second), 10)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 0)
+
+		// 04. The unarchived document is regarded as updated.
+		assert.NoError(t, localDB.UnarchiveDocInfo(ctx, projectID, docInfo.ID))
+		found, err = localDB.FindDocInfoByID(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.False(t, found.IsArchived())
+		assert.True(t, found.UpdatedAt.After(docInfo.UpdatedAt))
+
+		assert.ErrorIs(
+			t,
+			localDB.ArchiveDocInfo(ctx, "ffffffffffffffffffffffff", docInfo.ID, docInfo.ServerSeq),
+			database.ErrDocumentNotFound,
+		)
+	})
+
+	t.Run("doc actors test", func(t *testing.T) {
+		localDB, err := memory.New(&database.ObjectIDGenerator{})
+		assert.NoError(t, err)
+
+		var clientInfos []*database.ClientInfo
+		for i := 0; i < 3; i++ {
+			clientInfo, err := localDB.ActivateClient(ctx, projectID, fmt.Sprintf("%s-%d", t.Name(), i))
+			assert.NoError(t, err)
+			clientInfos = append(clientInfos, clientInfo)
+		}
+
+		docInfo, err := localDB.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfos[0].ID, "doc", true)
+		assert.NoError(t, err)
+		found, err := localDB.FindDocInfosWithActors(ctx, "", 10)
+		assert.NoError(t, err)
+		assert.Len(t, found, 0)
+
+		// new actors are rejected when the document has the maximum actors.
+		assert.NoError(t, localDB.AddDocActor(ctx, docInfo.ID, clientInfos[0].ID, 2))
+		assert.NoError(t, localDB.AddDocActor(ctx, docInfo.ID, clientInfos[1].ID, 2))
+		assert.NoError(t, localDB.AddDocActor(ctx, docInfo.ID, clientInfos[1].ID, 2))
+		err = localDB.AddDocActor(ctx, docInfo.ID, clientInfos[2].ID, 2)
+		assert.ErrorIs(t, err, database.ErrTooManyActors)
+
+		found, err = localDB.FindDocInfosWithActors(ctx, "", 10)
+		assert.NoError(t, err)
+		assert.Len(t, found, 1)
+		assert.Equal(t, []types.ID{clientInfos[0].ID, clientInfos[1].ID}, found[0].ActorIDs)
+
+		// the removed actors leave room for new actors.
+		assert.NoError(t, localDB.RemoveDocActors(ctx, docInfo.ID, []types.ID{clientInfos[0].ID}))
+		assert.NoError(t, localDB.AddDocActor(ctx, docInfo.ID, clientInfos[2].ID, 2))
+		docInfo, err = localDB.FindDocInfoByID(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []types.ID{clientInfos[1].ID, clientInfos[2].ID}, docInfo.ActorIDs)
+
+		minSyncedSeqInfo, err := localDB.FindMinSyncedSeqInfo(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Nil(t, minSyncedSeqInfo)
+	})
+
+	t.Run("doc client events test", func(t *testing.T) {
+		localDB, err := memory.New(&database.ObjectIDGenerator{})
+		assert.NoError(t, err)
+
+		clientInfo, err := localDB.ActivateClient(ctx, projectID, t.Name())
+		assert.NoError(t, err)
+		docInfo, err := localDB.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, "doc", true)
+		assert.NoError(t, err)
+
+		for _, eventType := range []types.DocumentClientEventType{
+			types.DocumentAttachedByClientEvent,
+			types.DocumentDetachedByClientEvent,
+			types.DocumentAttachedByClientEvent,
+		} {
+			assert.NoError(t, localDB.CreateDocClientEventInfo(ctx, docInfo.ID, clientInfo.ID, eventType))
+		}
+
+		// the events are listed from the latest one by default.
+		infos, err := localDB.FindDocClientEventInfos(ctx, docInfo.ID, gotime.Time{}, gotime.Time{},
+			types.Paging[types.ID]{PageSize: 2})
+		assert.NoError(t, err)
+		assert.Len(t, infos, 2)
+		assert.Equal(t, types.DocumentAttachedByClientEvent, infos[0].Type)
+		assert.Equal(t, types.DocumentDetachedByClientEvent, infos[1].Type)
+
+		infos, err = localDB.FindDocClientEventInfos(ctx, docInfo.ID, gotime.Time{}, gotime.Time{},
+			types.Paging[types.ID]{Offset: infos[1].ID, PageSize: 2})
+		assert.NoError(t, err)
+		assert.Len(t, infos, 1)
+		assert.Equal(t, clientInfo.ID, infos[0].ClientID)
+
+		// the events out of the time range are excluded.
+		infos, err = localDB.FindDocClientEventInfos(ctx, docInfo.ID, gotime.Now().Add(gotime.Hour), gotime.Time{},
+			types.Paging[types.ID]{PageSize: 10, IsForward: true})
+		assert.NoError(t, err)
+		assert.Len(t, infos, 0)
+
+		removed, err := localDB.RemoveDocClientEventInfosBefore(ctx, gotime.Now().Add(gotime.Hour))
+		assert.NoError(t, err)
+		assert.Equal(t, 3, removed)
+	})
+
 	t.Run("update clientInfo after PushPull test", func(t *testing.T) {
 		clientInfo, err := db.ActivateClient(ctx, projectID, t.Name())
 		assert.NoError(t, err)
@@ -147,7 +521,7 @@ func TestDB(t *testing.T) {
 
 		err = db.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo)
 		assert.ErrorIs(t, err, database.ErrDocumentNeverAttached)
-		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID, false))
 		assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo))
 	})
 
@@ -156,7 +530,7 @@ func TestDB(t *testing.T) {
 
 		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name())
 		docInfo, _ := db.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, docKey, true)
-		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID, false))
 		assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo))
 
 		bytesID, _ := clientInfo.ID.Bytes()
@@ -193,6 +567,217 @@ func TestDB(t *testing.T) {
 		assert.Len(t, loadedChanges, 5)
 	})
 
+	t.Run("store the same change from two nodes test", func(t *testing.T) {
+		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
+
+		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name())
+		docInfo, _ := db.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, docKey, true)
+
+		bytesID, _ := clientInfo.ID.Bytes()
+		actorID, _ := time.ActorIDFromBytes(bytesID)
+		doc := document.New(docKey)
+		doc.SetActor(actorID)
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger("k", 1)
+			return nil
+		}))
+		pbPack, err := converter.ToChangePack(doc.CreateChangePack())
+		assert.NoError(t, err)
+
+		// 01. Two nodes store the same change concurrently, each with the
+		// document it has loaded.
+		var wg sync.WaitGroup
+		errs := make([]error, 2)
+		for i := range errs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				pack, err := converter.FromChangePack(pbPack)
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				cn := pack.Changes[0]
+				loaded, err := db.FindDocInfoByID(ctx, docInfo.ID)
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				initialServerSeq := loaded.ServerSeq
+				cn.SetServerSeq(loaded.IncreaseServerSeq())
+				errs[i] = db.CreateChangeInfos(ctx, projectID, loaded, initialServerSeq, []*change.Change{cn})
+			}(i)
+		}
+		wg.Wait()
+
+		var stored int
+		for _, err := range errs {
+			if err == nil {
+				stored++
+				continue
+			}
+			assert.True(t,
+				errors.Is(err, database.ErrChangeAlreadyExists) ||
+					errors.Is(err, database.ErrConflictOnUpdate),
+			)
+		}
+		assert.Equal(t, 1, stored)
+
+		// 02. A node which has missed the stored change can not store it again
+		// after it.
+		pack, err := converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+		cn := pack.Changes[0]
+		loaded, err := db.FindDocInfoByID(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		initialServerSeq := loaded.ServerSeq
+		cn.SetServerSeq(loaded.IncreaseServerSeq())
+		err = db.CreateChangeInfos(ctx, projectID, loaded, initialServerSeq, []*change.Change{cn})
+		assert.ErrorIs(t, err, database.ErrChangeAlreadyExists)
+
+		changes, err := db.FindChangesBetweenServerSeqs(ctx, docInfo.ID, 1, initialServerSeq+1)
+		assert.NoError(t, err)
+		assert.Len(t, changes, 1)
+
+		// 03. A different change of the same ID, e.g. pushed after attaching
+		// a new document of the same key, is stored.
+		other := document.New(docKey)
+		other.SetActor(actorID)
+		assert.NoError(t, other.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger("k", 2)
+			return nil
+		}))
+		cn = other.CreateChangePack().Changes[0]
+		assert.Equal(t, pack.Changes[0].ID().ClientSeq(), cn.ID().ClientSeq())
+		assert.Equal(t, pack.Changes[0].ID().Lamport(), cn.ID().Lamport())
+		loaded, err = db.FindDocInfoByID(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		initialServerSeq = loaded.ServerSeq
+		cn.SetServerSeq(loaded.IncreaseServerSeq())
+		assert.NoError(t, db.CreateChangeInfos(ctx, projectID, loaded, initialServerSeq, []*change.Change{cn}))
+
+		changes, err = db.FindChangesBetweenServerSeqs(ctx, docInfo.ID, 1, loaded.ServerSeq)
+		assert.NoError(t, err)
+		assert.Len(t, changes, 2)
+	})
+
+	t.Run("store changes of documents atomically test", func(t *testing.T) {
+		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name())
+		bytesID, _ := clientInfo.ID.Bytes()
+		actorID, _ := time.ActorIDFromBytes(bytesID)
+
+		var batches []*database.ChangeInfoBatch
+		for i := 0; i < 2; i++ {
+			docKey := key.Key(fmt.Sprintf("tests$%s-%d", t.Name(), i))
+			docInfo, err := db.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, docKey, true)
+			assert.NoError(t, err)
+
+			doc := document.New(docKey)
+			doc.SetActor(actorID)
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger("k", i)
+				return nil
+			}))
+			pack := doc.CreateChangePack()
+			initialServerSeq := docInfo.ServerSeq
+			for _, c := range pack.Changes {
+				c.SetServerSeq(docInfo.IncreaseServerSeq())
+			}
+			batches = append(batches, &database.ChangeInfoBatch{
+				DocInfo:          docInfo,
+				InitialServerSeq: initialServerSeq,
+				Changes:          pack.Changes,
+			})
+		}
+
+		// 01. A conflict on the second document leaves the first one unstored.
+		batches[1].InitialServerSeq++
+		err := db.CreateChangeInfosOfDocuments(ctx, projectID, batches)
+		assert.ErrorIs(t, err, database.ErrConflictOnUpdate)
+		for _, batch := range batches {
+			loaded, err := db.FindDocInfoByID(ctx, batch.DocInfo.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, uint64(0), loaded.ServerSeq)
+			changes, err := db.FindChangesBetweenServerSeqs(ctx, batch.DocInfo.ID, 1, 1)
+			assert.NoError(t, err)
+			assert.Len(t, changes, 0)
+		}
+
+		// 02. The changes of all the documents are stored together.
+		batches[1].InitialServerSeq--
+		assert.NoError(t, db.CreateChangeInfosOfDocuments(ctx, projectID, batches))
+		for _, batch := range batches {
+			loaded, err := db.FindDocInfoByID(ctx, batch.DocInfo.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, uint64(1), loaded.ServerSeq)
+			changes, err := db.FindChangesBetweenServerSeqs(ctx, batch.DocInfo.ID, 1, 1)
+			assert.NoError(t, err)
+			assert.Len(t, changes, 1)
+		}
+	})
+
+	t.Run("lamport never goes backward test", func(t *testing.T) {
+		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
+
+		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name())
+		docInfo, _ := db.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, docKey, true)
+
+		bytesID, _ := clientInfo.ID.Bytes()
+		actorID, _ := time.ActorIDFromBytes(bytesID)
+		doc := document.New(docKey)
+		doc.SetActor(actorID)
+		for idx := 0; idx < 3; idx++ {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger("k", idx)
+				return nil
+			}))
+		}
+		pack := doc.CreateChangePack()
+		for _, c := range pack.Changes {
+			c.SetServerSeq(docInfo.IncreaseServerSeq())
+			docInfo.Lamport = c.ID().Lamport()
+		}
+		docInfo.LamportServerSeq = docInfo.ServerSeq
+		assert.NoError(t, db.CreateChangeInfos(ctx, projectID, docInfo, 0, pack.Changes))
+
+		// the document recovered from the database has the largest Lamport of
+		// the stored changes.
+		recovered, err := db.FindDocInfoByID(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, pack.Changes[2].ID().Lamport(), recovered.Lamport)
+
+		// the Lamport is not lowered by the following writes.
+		stale := recovered.DeepCopy()
+		stale.Lamport = 1
+		assert.NoError(t, db.CreateChangeInfos(ctx, projectID, stale, recovered.ServerSeq, nil))
+		recovered, err = db.FindDocInfoByID(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, pack.Changes[2].ID().Lamport(), recovered.Lamport)
+
+		// the Lamport is not stored by the writes which do not flush it.
+		flushed := recovered
+		unflushed := flushed.DeepCopy()
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger("k", 3)
+			return nil
+		}))
+		pack = doc.CreateChangePack()
+		pack.Changes[len(pack.Changes)-1].SetServerSeq(unflushed.IncreaseServerSeq())
+		unflushed.Lamport = pack.Changes[len(pack.Changes)-1].ID().Lamport()
+		assert.NoError(t, db.CreateChangeInfos(
+			ctx,
+			projectID,
+			unflushed,
+			flushed.ServerSeq,
+			pack.Changes[len(pack.Changes)-1:],
+		))
+		recovered, err = db.FindDocInfoByID(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, unflushed.ServerSeq, recovered.ServerSeq)
+		assert.Equal(t, flushed.Lamport, recovered.Lamport)
+		assert.Equal(t, flushed.LamportServerSeq, recovered.LamportServerSeq)
+	})
+
 	t.Run("store and find snapshots test", func(t *testing.T) {
 		ctx := context.Background()
 		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
@@ -210,33 +795,133 @@ func TestDB(t *testing.T) {
 			return nil
 		}))
 
-		assert.NoError(t, db.CreateSnapshotInfo(ctx, docInfo.ID, doc.InternalDocument()))
+		assert.NoError(t, db.CreateSnapshotInfo(ctx, docInfo.ID, doc.InternalDocument(), types.SnapshotCompressionNone))
 		snapshot, err := db.FindClosestSnapshotInfo(ctx, docInfo.ID, change.MaxCheckpoint.ServerSeq)
 		assert.NoError(t, err)
 		assert.Equal(t, uint64(0), snapshot.ServerSeq)
 
 		pack := change.NewPack(doc.Key(), doc.Checkpoint().NextServerSeq(1), nil, nil)
 		assert.NoError(t, doc.ApplyChangePack(pack))
-		assert.NoError(t, db.CreateSnapshotInfo(ctx, docInfo.ID, doc.InternalDocument()))
+		assert.NoError(t, db.CreateSnapshotInfo(ctx, docInfo.ID, doc.InternalDocument(), types.SnapshotCompressionNone))
 		snapshot, err = db.FindClosestSnapshotInfo(ctx, docInfo.ID, change.MaxCheckpoint.ServerSeq)
 		assert.NoError(t, err)
 		assert.Equal(t, uint64(1), snapshot.ServerSeq)
 
 		pack = change.NewPack(doc.Key(), doc.Checkpoint().NextServerSeq(2), nil, nil)
 		assert.NoError(t, doc.ApplyChangePack(pack))
-		assert.NoError(t, db.CreateSnapshotInfo(ctx, docInfo.ID, doc.InternalDocument()))
+		assert.NoError(t, db.CreateSnapshotInfo(ctx, docInfo.ID, doc.InternalDocument(), types.SnapshotCompressionNone))
 		snapshot, err = db.FindClosestSnapshotInfo(ctx, docInfo.ID, change.MaxCheckpoint.ServerSeq)
 		assert.NoError(t, err)
 		assert.Equal(t, uint64(2), snapshot.ServerSeq)
 
-		assert.NoError(t, db.CreateSnapshotInfo(ctx, docInfo.ID, doc.InternalDocument()))
+		assert.NoError(t, db.CreateSnapshotInfo(ctx, docInfo.ID, doc.InternalDocument(), types.SnapshotCompressionNone))
 		snapshot, err = db.FindClosestSnapshotInfo(ctx, docInfo.ID, 1)
 		assert.NoError(t, err)
 		assert.Equal(t, uint64(1), snapshot.ServerSeq)
+
+		infos, err := db.FindSnapshotInfos(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 3)
+		assert.Equal(t, uint64(2), infos[0].ServerSeq)
+		assert.Equal(t, uint64(0), infos[2].ServerSeq)
+		assert.Nil(t, infos[0].Snapshot)
+
+		assert.NoError(t, db.RemoveSnapshotInfos(ctx, docInfo.ID, []types.ID{infos[1].ID}))
+		snapshot, err = db.FindClosestSnapshotInfo(ctx, docInfo.ID, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(0), snapshot.ServerSeq)
+	})
+
+	t.Run("share snapshot blobs test", func(t *testing.T) {
+		ctx := context.Background()
+		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name())
+
+		// 01. Store the identical snapshots of two documents.
+		var docIDs []types.ID
+		for i := 0; i < 2; i++ {
+			docKey := key.Key(fmt.Sprintf("tests$%s-%d", t.Name(), i))
+			docInfo, err := db.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, docKey, true)
+			assert.NoError(t, err)
+			docIDs = append(docIDs, docInfo.ID)
+
+			doc := document.New(docKey)
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("k1", "v1")
+				return nil
+			}))
+			pack := change.NewPack(doc.Key(), doc.Checkpoint().NextServerSeq(1), nil, nil)
+			assert.NoError(t, doc.ApplyChangePack(pack))
+			assert.NoError(t, db.CreateSnapshotInfo(ctx, docInfo.ID, doc.InternalDocument(), types.SnapshotCompressionNone))
+
+			// the retried write does not add a reference.
+			assert.NoError(t, db.CreateSnapshotInfo(ctx, docInfo.ID, doc.InternalDocument(), types.SnapshotCompressionNone))
+		}
+
+		first, err := db.FindClosestSnapshotInfo(ctx, docIDs[0], 1)
+		assert.NoError(t, err)
+		second, err := db.FindClosestSnapshotInfo(ctx, docIDs[1], 1)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, first.Checksum)
+		assert.Equal(t, first.Checksum, second.Checksum)
+		assert.Equal(t, first.Snapshot, second.Snapshot)
+
+		blob, err := db.FindSnapshotBlobInfo(ctx, first.Checksum)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), blob.RefCount)
+		assert.Equal(t, first.Snapshot, blob.Snapshot)
+
+		// 02. The blob is kept while any snapshot references it.
+		assert.NoError(t, db.RemoveSnapshotInfos(ctx, docIDs[0], []types.ID{first.ID}))
+		blob, err = db.FindSnapshotBlobInfo(ctx, first.Checksum)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), blob.RefCount)
+		second, err = db.FindClosestSnapshotInfo(ctx, docIDs[1], 1)
+		assert.NoError(t, err)
+		assert.Equal(t, blob.Snapshot, second.Snapshot)
+
+		// 03. The blob is removed with the last reference.
+		assert.NoError(t, db.RemoveSnapshotInfos(ctx, docIDs[1], []types.ID{second.ID}))
+		_, err = db.FindSnapshotBlobInfo(ctx, first.Checksum)
+		assert.ErrorIs(t, err, database.ErrSnapshotBlobNotFound)
+	})
+
+	t.Run("find snapshot infos to upgrade test", func(t *testing.T) {
+		ctx := context.Background()
+		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
+
+		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name())
+		docInfo, _ := db.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, docKey, true)
+
+		doc := document.New(docKey)
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		pack := change.NewPack(doc.Key(), doc.Checkpoint().NextServerSeq(1), nil, nil)
+		assert.NoError(t, doc.ApplyChangePack(pack))
+		assert.NoError(t, db.CreateSnapshotInfo(ctx, docInfo.ID, doc.InternalDocument(), types.SnapshotCompressionNone))
+
+		// 01. The snapshots are created in the current format.
+		infos, err := db.FindSnapshotInfosToUpgrade(ctx, converter.CurrentSnapshotVersion, 100)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 0)
+		total, outdated, err := db.CountSnapshotInfosToUpgrade(ctx, converter.CurrentSnapshotVersion)
+		assert.NoError(t, err)
+		assert.Greater(t, total, int64(0))
+		assert.Equal(t, int64(0), outdated)
+
+		// 02. All the snapshots are outdated for the next format.
+		infos, err = db.FindSnapshotInfosToUpgrade(ctx, converter.CurrentSnapshotVersion+1, 1)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 1)
+		assert.Nil(t, infos[0].Snapshot)
+		total, outdated, err = db.CountSnapshotInfosToUpgrade(ctx, converter.CurrentSnapshotVersion+1)
+		assert.NoError(t, err)
+		assert.Equal(t, total, outdated)
 	})
 
 	t.Run("docInfo pagination test", func(t *testing.T) {
-		localDB, err := memory.New()
+		localDB, err := memory.New(&database.ObjectIDGenerator{})
 		assert.NoError(t, err)
 
 		assertKeys := func(expectedKeys []key.Key, infos []*database.DocInfo) {
@@ -295,8 +980,60 @@ func TestDB(t *testing.T) {
 		assertKeys(nil, emptyInfos)
 	})
 
+	t.Run("docInfo pagination with snapshot test", func(t *testing.T) {
+		localDB, err := memory.New(&database.ObjectIDGenerator{})
+		assert.NoError(t, err)
+
+		totalSize := 9
+		clientInfo, _ := localDB.ActivateClient(ctx, projectID, t.Name())
+		var expectedKeys []key.Key
+		for i := 0; i < totalSize; i++ {
+			k := key.Key(fmt.Sprintf("%d", i))
+			_, err := localDB.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, k, true)
+			assert.NoError(t, err)
+			expectedKeys = append(expectedKeys, k)
+		}
+		snapshotAt := gotime.Now()
+
+		// create and remove documents concurrently while paging.
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for i := totalSize; i < totalSize*2; i++ {
+				k := key.Key(fmt.Sprintf("%d", i))
+				_, err := localDB.FindDocInfoByKeyAndOwner(ctx, projectID, clientInfo.ID, k, true)
+				assert.NoError(t, err)
+			}
+		}()
+
+		var keys []key.Key
+		paging := types.Paging[types.ID]{PageSize: 2, IsForward: true, SnapshotAt: snapshotAt}
+		for {
+			infos, err := localDB.FindDocInfosByPaging(ctx, projectID, paging)
+			assert.NoError(t, err)
+			if len(infos) == 0 {
+				break
+			}
+
+			for _, info := range infos {
+				keys = append(keys, info.Key)
+			}
+			paging.Offset = infos[len(infos)-1].ID
+
+			// remove the first document of the next page.
+			next, err := localDB.FindDocInfosByPaging(ctx, projectID, paging)
+			assert.NoError(t, err)
+			if len(next) > 0 {
+				assert.NoError(t, localDB.RemoveDocInfo(ctx, projectID, next[0].ID))
+			}
+		}
+		<-done
+
+		assert.Equal(t, expectedKeys, keys)
+	})
+
 	t.Run("FindDocInfoByID test", func(t *testing.T) {
-		localDB, err := memory.New()
+		localDB, err := memory.New(&database.ObjectIDGenerator{})
 		assert.NoError(t, err)
 
 		_, err = localDB.FindDocInfoByID(context.Background(), notExistsID)
@@ -362,4 +1099,58 @@ func TestDB(t *testing.T) {
 		_, err = db.UpdateProjectInfo(ctx, id, fields)
 		assert.ErrorIs(t, err, database.ErrProjectNameAlreadyExists)
 	})
+
+	t.Run("maintenance info test", func(t *testing.T) {
+		info, err := db.FindMaintenanceInfo(ctx)
+		assert.NoError(t, err)
+		assert.False(t, info.Enabled)
+
+		updated, err := db.UpdateMaintenanceInfo(ctx, true, "migrating")
+		assert.NoError(t, err)
+		info, err = db.FindMaintenanceInfo(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, updated, info)
+		assert.True(t, info.Enabled)
+		assert.Equal(t, "migrating", info.Message)
+
+		_, err = db.UpdateMaintenanceInfo(ctx, false, "")
+		assert.NoError(t, err)
+		info, err = db.FindMaintenanceInfo(ctx)
+		assert.NoError(t, err)
+		assert.False(t, info.Enabled)
+	})
+
+	t.Run("doc event log test", func(t *testing.T) {
+		docID := types.ID("000000000000000000000001")
+		now := gotime.Now()
+		var infos []*database.DocEventLogInfo
+		for seq := uint64(1); seq <= 5; seq++ {
+			createdAt := now
+			if seq <= 2 {
+				createdAt = now.Add(-gotime.Hour)
+			}
+			infos = append(infos, &database.DocEventLogInfo{
+				DocID:     docID,
+				ServerSeq: seq,
+				CreatedAt: createdAt,
+			})
+		}
+		assert.NoError(t, db.CreateDocEventLogInfos(ctx, infos))
+
+		found, err := db.FindDocEventLogInfos(ctx, docID, 2, 4)
+		assert.NoError(t, err)
+		assert.Len(t, found, 3)
+		for i, info := range found {
+			assert.Equal(t, uint64(i+2), info.ServerSeq)
+		}
+
+		removed, err := db.RemoveDocEventLogInfosBefore(ctx, now.Add(-gotime.Minute))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, removed)
+
+		found, err = db.FindDocEventLogInfos(ctx, docID, 0, 5)
+		assert.NoError(t, err)
+		assert.Len(t, found, 3)
+		assert.Equal(t, uint64(3), found[0].ServerSeq)
+	})
 }
@@ -32,7 +32,7 @@ import (
 )
 
 func TestHousekeeping(t *testing.T) {
-	memdb, err := memory.New()
+	memdb, err := memory.New(&database.ObjectIDGenerator{})
 	assert.NoError(t, err)
 	projectID := database.DefaultProjectID
 
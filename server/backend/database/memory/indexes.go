@@ -25,6 +25,12 @@ var (
 	tblChanges    = "changes"
 	tblSnapshots  = "snapshots"
 	tblSyncedSeqs = "syncedseqs"
+
+	tblSnapshotBlobs = "snapshotblobs"
+
+	tblDocClientEvents = "docclientevents"
+	tblDocEventLogs    = "doceventlogs"
+	tblMaintenance     = "maintenance"
 )
 
 var schema = &memdb.DBSchema{
@@ -101,9 +107,10 @@ var schema = &memdb.DBSchema{
 						},
 					},
 				},
+				// NOTE: Removed documents keep their keys, so the key is unique
+				// only among the documents that are not removed.
 				"project_id_key": {
-					Name:   "project_id_key",
-					Unique: true,
+					Name: "project_id_key",
 					Indexer: &memdb.CompoundIndex{
 						Indexes: []memdb.Indexer{
 							&memdb.StringFieldIndex{Field: "ProjectID"},
@@ -131,6 +138,19 @@ var schema = &memdb.DBSchema{
 						},
 					},
 				},
+				"doc_id_actor_id_client_seq_lamport_payload_hash": {
+					Name:   "doc_id_actor_id_client_seq_lamport_payload_hash",
+					Unique: true,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "DocID"},
+							&memdb.StringFieldIndex{Field: "ActorID"},
+							&memdb.UintFieldIndex{Field: "ClientSeq"},
+							&memdb.UintFieldIndex{Field: "Lamport"},
+							&memdb.StringFieldIndex{Field: "PayloadHash"},
+						},
+					},
+				},
 			},
 		},
 		tblSnapshots: {
@@ -183,5 +203,65 @@ var schema = &memdb.DBSchema{
 				},
 			},
 		},
+		tblDocClientEvents: {
+			Name: tblDocClientEvents,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:    "id",
+					Unique:  true,
+					Indexer: &memdb.StringFieldIndex{Field: "ID"},
+				},
+				"doc_id_id": {
+					Name:   "doc_id_id",
+					Unique: true,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "DocID"},
+							&memdb.StringFieldIndex{Field: "ID"},
+						},
+					},
+				},
+			},
+		},
+		tblDocEventLogs: {
+			Name: tblDocEventLogs,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:    "id",
+					Unique:  true,
+					Indexer: &memdb.StringFieldIndex{Field: "ID"},
+				},
+				"doc_id_server_seq": {
+					Name:   "doc_id_server_seq",
+					Unique: true,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "DocID"},
+							&memdb.UintFieldIndex{Field: "ServerSeq"},
+						},
+					},
+				},
+			},
+		},
+		tblSnapshotBlobs: {
+			Name: tblSnapshotBlobs,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:    "id",
+					Unique:  true,
+					Indexer: &memdb.StringFieldIndex{Field: "ID"},
+				},
+			},
+		},
+		tblMaintenance: {
+			Name: tblMaintenance,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:    "id",
+					Unique:  true,
+					Indexer: &memdb.StringFieldIndex{Field: "ID"},
+				},
+			},
+		},
 	},
 }
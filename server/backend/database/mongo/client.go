@@ -18,8 +18,10 @@ package mongo
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	gosync "sync"
 	gotime "time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -40,15 +42,41 @@ import (
 
 // Client is a client that connects to Mongo DB and reads or saves Yorkie data.
 type Client struct {
-	config *Config
-	client *mongo.Client
+	config      *Config
+	idGenerator database.IDGenerator
+
+	// clientMu guards client, which is replaced by Reconnect.
+	clientMu gosync.RWMutex
+	client   *mongo.Client
 }
 
-// Dial creates an instance of Client and dials the given MongoDB.
-func Dial(conf *Config) (*Client, error) {
+// Dial creates an instance of Client and dials the given MongoDB. The given
+// generator is used to generate IDs of projects, clients and documents.
+func Dial(conf *Config, idGenerator database.IDGenerator) (*Client, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), conf.ParseConnectionTimeout())
 	defer cancel()
 
+	client, err := connect(ctx, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ensureIndexes(ctx, client.Database(conf.YorkieDatabase)); err != nil {
+		logging.DefaultLogger().Error(err)
+		return nil, err
+	}
+
+	logging.DefaultLogger().Infof("MongoDB connected, URI: %s, DB: %s", conf.ConnectionURI, conf.YorkieDatabase)
+
+	return &Client{
+		config:      conf,
+		client:      client,
+		idGenerator: idGenerator,
+	}, nil
+}
+
+// connect connects to the given MongoDB and checks the connection with ping.
+func connect(ctx context.Context, conf *Config) (*mongo.Client, error) {
 	client, err := mongo.Connect(
 		ctx,
 		options.Client().
@@ -66,32 +94,65 @@ func Dial(conf *Config) (*Client, error) {
 
 	if err := client.Ping(ctxPing, readpref.Primary()); err != nil {
 		logging.DefaultLogger().Errorf("fail to connect to %s in %f sec", conf.ConnectionURI, pingTimeout.Seconds())
+		if err := client.Disconnect(context.Background()); err != nil {
+			logging.DefaultLogger().Error(err)
+		}
 		return nil, err
 	}
 
-	if err := ensureIndexes(ctx, client.Database(conf.YorkieDatabase)); err != nil {
+	return client, nil
+}
+
+// Close all resources of this client.
+func (c *Client) Close() error {
+	if err := c.mongoClient().Disconnect(context.Background()); err != nil {
 		logging.DefaultLogger().Error(err)
-		return nil, err
+		return err
 	}
 
-	logging.DefaultLogger().Infof("MongoDB connected, URI: %s, DB: %s", conf.ConnectionURI, conf.YorkieDatabase)
+	return nil
+}
 
-	return &Client{
-		config: conf,
-		client: client,
-	}, nil
+// Ping checks whether the connection to MongoDB is alive.
+func (c *Client) Ping(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.config.ParsePingTimeout())
+	defer cancel()
+
+	return c.mongoClient().Ping(ctx, readpref.Primary())
 }
 
-// Close all resources of this client.
-func (c *Client) Close() error {
-	if err := c.client.Disconnect(context.Background()); err != nil {
-		logging.DefaultLogger().Error(err)
+// Reconnect replaces the connection to MongoDB with a new one. The operations
+// in progress with the old connection fail when it is disconnected.
+func (c *Client) Reconnect(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.config.ParseConnectionTimeout())
+	defer cancel()
+
+	client, err := connect(ctx, c.config)
+	if err != nil {
 		return err
 	}
 
+	c.clientMu.Lock()
+	old := c.client
+	c.client = client
+	c.clientMu.Unlock()
+
+	if err := old.Disconnect(ctx); err != nil {
+		logging.DefaultLogger().Error(err)
+	}
+
+	logging.DefaultLogger().Infof("MongoDB reconnected, URI: %s, DB: %s", c.config.ConnectionURI, c.config.YorkieDatabase)
 	return nil
 }
 
+// mongoClient returns the current connection to MongoDB.
+func (c *Client) mongoClient() *mongo.Client {
+	c.clientMu.RLock()
+	defer c.clientMu.RUnlock()
+
+	return c.client
+}
+
 // EnsureDefaultProjectInfo creates the default project info if it does not exist.
 func (c *Client) EnsureDefaultProjectInfo(ctx context.Context) (*database.ProjectInfo, error) {
 	candidate := database.NewProjectInfo(database.DefaultProjectName)
@@ -133,7 +194,14 @@ func (c *Client) EnsureDefaultProjectInfo(ctx context.Context) (*database.Projec
 // CreateProjectInfo creates a new project.
 func (c *Client) CreateProjectInfo(ctx context.Context, name string) (*database.ProjectInfo, error) {
 	info := database.NewProjectInfo(name)
-	result, err := c.collection(colProjects).InsertOne(ctx, bson.M{
+	info.ID = c.idGenerator.NewID()
+	encodedID, err := encodeID(info.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.collection(colProjects).InsertOne(ctx, bson.M{
+		"_id":        encodedID,
 		"name":       info.Name,
 		"public_key": info.PublicKey,
 		"secret_key": info.SecretKey,
@@ -148,7 +216,6 @@ func (c *Client) CreateProjectInfo(ctx context.Context, name string) (*database.
 		return nil, err
 	}
 
-	info.ID = types.ID(result.InsertedID.(primitive.ObjectID).Hex())
 	return info, nil
 }
 
@@ -168,6 +235,17 @@ func (c *Client) ListProjectInfos(ctx context.Context) ([]*database.ProjectInfo,
 	return infos, nil
 }
 
+// CountProjectInfos returns the number of the projects.
+func (c *Client) CountProjectInfos(ctx context.Context) (int, error) {
+	count, err := c.collection(colProjects).CountDocuments(ctx, bson.M{})
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
 // FindProjectInfoByPublicKey returns a project by public key.
 func (c *Client) FindProjectInfoByPublicKey(ctx context.Context, publicKey string) (*database.ProjectInfo, error) {
 	result := c.collection(colProjects).FindOne(ctx, bson.M{
@@ -224,6 +302,36 @@ func (c *Client) FindProjectInfoByID(ctx context.Context, id types.ID) (*databas
 	return &projectInfo, nil
 }
 
+// FindProjectInfosByIDs returns the projects of the given ids. The projects
+// not found are omitted.
+func (c *Client) FindProjectInfosByIDs(ctx context.Context, ids []types.ID) ([]*database.ProjectInfo, error) {
+	var encodedIDs []primitive.ObjectID
+	for _, id := range ids {
+		encodedID, err := encodeID(id)
+		if err != nil {
+			return nil, err
+		}
+		encodedIDs = append(encodedIDs, encodedID)
+	}
+
+	cursor, err := c.collection(colProjects).Find(ctx, bson.M{
+		"_id": bson.M{
+			"$in": encodedIDs,
+		},
+	})
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return nil, err
+	}
+
+	var infos []*database.ProjectInfo
+	if err := cursor.All(ctx, &infos); err != nil {
+		return nil, err
+	}
+
+	return infos, nil
+}
+
 // UpdateProjectInfo updates the project info.
 func (c *Client) UpdateProjectInfo(
 	ctx context.Context,
@@ -274,6 +382,11 @@ func (c *Client) ActivateClient(ctx context.Context, projectID types.ID, key str
 		return nil, err
 	}
 
+	encodedNewID, err := encodeID(c.idGenerator.NewID())
+	if err != nil {
+		return nil, err
+	}
+
 	now := gotime.Now()
 	res, err := c.collection(colClients).UpdateOne(ctx, bson.M{
 		"project_id": encodedProjectID,
@@ -283,6 +396,9 @@ func (c *Client) ActivateClient(ctx context.Context, projectID types.ID, key str
 			"status":     database.ClientActivated,
 			"updated_at": now,
 		},
+		"$setOnInsert": bson.M{
+			"_id": encodedNewID,
+		},
 	}, options.Update().SetUpsert(true))
 	if err != nil {
 		logging.From(ctx).Error(err)
@@ -374,6 +490,55 @@ func (c *Client) FindClientInfoByID(ctx context.Context, projectID, clientID typ
 	return &clientInfo, nil
 }
 
+// CountAttachedClients returns the number of the activated clients that
+// attach the given document.
+func (c *Client) CountAttachedClients(ctx context.Context, projectID, docID types.ID) (int, error) {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := c.collection(colClients).CountDocuments(ctx, bson.M{
+		"project_id": encodedProjectID,
+		"status":     database.ClientActivated,
+		"documents." + docID.String() + ".status": database.DocumentAttached,
+	})
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+// FindAttachedClientInfos returns the activated clients that attach the given
+// document.
+func (c *Client) FindAttachedClientInfos(
+	ctx context.Context,
+	projectID, docID types.ID,
+) ([]*database.ClientInfo, error) {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := c.collection(colClients).Find(ctx, bson.M{
+		"project_id": encodedProjectID,
+		"status":     database.ClientActivated,
+		"documents." + docID.String() + ".status": database.DocumentAttached,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var infos []*database.ClientInfo
+	if err := cursor.All(ctx, &infos); err != nil {
+		return nil, err
+	}
+
+	return infos, nil
+}
+
 // UpdateClientInfoAfterPushPull updates the client from the given clientInfo
 // after handling PushPull.
 func (c *Client) UpdateClientInfoAfterPushPull(
@@ -390,8 +555,9 @@ func (c *Client) UpdateClientInfoAfterPushPull(
 			clientDocInfoKey + "client_seq": clientDocInfo.ClientSeq,
 		},
 		"$set": bson.M{
-			clientDocInfoKey + "status": clientDocInfo.Status,
-			"updated_at":                clientInfo.UpdatedAt,
+			clientDocInfoKey + "status":    clientDocInfo.Status,
+			clientDocInfoKey + "read_only": clientDocInfo.ReadOnly,
+			"updated_at":                   clientInfo.UpdatedAt,
 		},
 	}
 
@@ -406,6 +572,7 @@ func (c *Client) UpdateClientInfoAfterPushPull(
 				clientDocInfoKey + "server_seq": 0,
 				clientDocInfoKey + "client_seq": 0,
 				clientDocInfoKey + "status":     clientDocInfo.Status,
+				clientDocInfoKey + "read_only":  false,
 				"updated_at":                    clientInfo.UpdatedAt,
 			},
 		}
@@ -470,14 +637,23 @@ func (c *Client) FindDocInfoByKeyAndOwner(
 		return nil, err
 	}
 
+	encodedNewID, err := encodeID(c.idGenerator.NewID())
+	if err != nil {
+		return nil, err
+	}
+
 	now := gotime.Now()
 	res, err := c.collection(colDocuments).UpdateOne(ctx, bson.M{
 		"project_id": encodedProjectID,
 		"key":        docKey,
+		"removed_at": bson.M{"$exists": false},
 	}, bson.M{
 		"$set": bson.M{
 			"accessed_at": now,
 		},
+		"$setOnInsert": bson.M{
+			"_id": encodedNewID,
+		},
 	}, options.Update().SetUpsert(createDocIfNotExist))
 	if err != nil {
 		logging.From(ctx).Error(err)
@@ -499,6 +675,7 @@ func (c *Client) FindDocInfoByKeyAndOwner(
 		result = c.collection(colDocuments).FindOne(ctx, bson.M{
 			"project_id": encodedProjectID,
 			"key":        docKey,
+			"removed_at": bson.M{"$exists": false},
 		})
 		if result.Err() == mongo.ErrNoDocuments {
 			return nil, fmt.Errorf("%s %s: %w", projectID, docKey, database.ErrDocumentNotFound)
@@ -531,6 +708,7 @@ func (c *Client) FindDocInfoByKey(
 	result := c.collection(colDocuments).FindOne(ctx, bson.M{
 		"project_id": encodedProjectID,
 		"key":        docKey,
+		"removed_at": bson.M{"$exists": false},
 	})
 	if result.Err() == mongo.ErrNoDocuments {
 		return nil, fmt.Errorf("%s %s: %w", projectID, docKey, database.ErrDocumentNotFound)
@@ -583,50 +761,154 @@ func (c *Client) CreateChangeInfos(
 	initialServerSeq uint64,
 	changes []*change.Change,
 ) error {
-	encodedDocID, err := encodeID(docInfo.ID)
+	encodedDocID, infos, err := encodeChangeInfos(docInfo, changes)
 	if err != nil {
 		return err
 	}
 
-	var models []mongo.WriteModel
+	// TODO(hackerwins): We need to handle the updates for the two collections
+	// below atomically.
+	if err := c.insertChangeInfos(ctx, encodedDocID, initialServerSeq, infos); err != nil {
+		if errors.Is(err, database.ErrChangeAlreadyExists) || errors.Is(err, database.ErrConflictOnUpdate) {
+			return fmt.Errorf("%s: %w", docInfo.ID, err)
+		}
+		logging.From(ctx).Error(err)
+		return err
+	}
+
+	return c.updateDocInfoOfChanges(ctx, encodedDocID, docInfo, initialServerSeq, changes)
+}
+
+// encodeChangeInfos encodes the given changes of the document to insert.
+func encodeChangeInfos(
+	docInfo *database.DocInfo,
+	changes []*change.Change,
+) (primitive.ObjectID, []interface{}, error) {
+	encodedDocID, err := encodeID(docInfo.ID)
+	if err != nil {
+		return encodedDocID, nil, err
+	}
+
+	now := gotime.Now()
+	var infos []interface{}
 	for _, cn := range changes {
 		encodedOperations, err := database.EncodeOperations(cn.Operations())
 		if err != nil {
-			return err
+			return encodedDocID, nil, err
 		}
 
-		models = append(models, mongo.NewUpdateOneModel().SetFilter(bson.M{
-			"doc_id":     encodedDocID,
-			"server_seq": cn.ServerSeq(),
-		}).SetUpdate(bson.M{"$set": bson.M{
-			"actor_id":   encodeActorID(cn.ID().ActorID()),
-			"client_seq": cn.ID().ClientSeq(),
-			"lamport":    cn.ID().Lamport(),
-			"message":    cn.Message(),
-			"operations": encodedOperations,
-		}}).SetUpsert(true))
+		info := bson.M{
+			"doc_id":       encodedDocID,
+			"server_seq":   cn.ServerSeq(),
+			"actor_id":     encodeActorID(cn.ID().ActorID()),
+			"client_seq":   cn.ID().ClientSeq(),
+			"lamport":      cn.ID().Lamport(),
+			"message":      cn.Message(),
+			"operations":   encodedOperations,
+			"payload_hash": database.PayloadHash(cn.Message(), encodedOperations),
+			"created_at":   now,
+		}
+		if ids := database.OperationIDs(cn); len(ids) > 0 {
+			info["operation_ids"] = ids
+		}
+		infos = append(infos, info)
 	}
 
-	// TODO(hackerwins): We need to handle the updates for the two collections
-	// below atomically.
-	if _, err = c.collection(colChanges).BulkWrite(
-		ctx,
-		models,
-		options.BulkWrite().SetOrdered(true),
-	); err != nil {
-		logging.From(ctx).Error(err)
+	return encodedDocID, infos, nil
+}
+
+// insertChangeInfos inserts the given changes of the document. It returns
+// ErrChangeAlreadyExists if one of them is already stored.
+func (c *Client) insertChangeInfos(
+	ctx context.Context,
+	encodedDocID primitive.ObjectID,
+	initialServerSeq uint64,
+	infos []interface{},
+) error {
+	_, insertErr := c.collection(colChanges).InsertMany(ctx, infos, options.InsertMany().SetOrdered(true))
+	if !mongo.IsDuplicateKeyError(insertErr) {
+		return insertErr
+	}
+
+	removed, err := c.removeUncommittedChangeInfos(ctx, encodedDocID, initialServerSeq)
+	if err != nil {
 		return err
 	}
+	if removed > 0 {
+		_, insertErr = c.collection(colChanges).InsertMany(ctx, infos, options.InsertMany().SetOrdered(true))
+	}
 
-	res, err := c.collection(colDocuments).UpdateOne(ctx, bson.M{
+	return toChangeInsertError(insertErr)
+}
+
+// removeUncommittedChangeInfos removes the changes after the given server
+// sequence of the document, and returns the number of the removed changes. It
+// returns ErrConflictOnUpdate if the document is updated since it is loaded.
+//
+// NOTE: The changes after the server sequence of the document are left behind
+// by a store that failed before updating the document. They are never read,
+// since the changes are read up to the server sequence of the document.
+func (c *Client) removeUncommittedChangeInfos(
+	ctx context.Context,
+	encodedDocID primitive.ObjectID,
+	initialServerSeq uint64,
+) (int64, error) {
+	result := c.collection(colDocuments).FindOne(ctx, bson.M{
 		"_id":        encodedDocID,
 		"server_seq": initialServerSeq,
-	}, bson.M{
+	})
+	if result.Err() == mongo.ErrNoDocuments {
+		return 0, database.ErrConflictOnUpdate
+	}
+	if result.Err() != nil {
+		return 0, result.Err()
+	}
+
+	res, err := c.collection(colChanges).DeleteMany(ctx, bson.M{
+		"doc_id":     encodedDocID,
+		"server_seq": bson.M{"$gt": initialServerSeq},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return res.DeletedCount, nil
+}
+
+// updateDocInfoOfChanges updates the document after the given changes are
+// stored. It returns ErrConflictOnUpdate if the document is updated since it
+// is loaded.
+func (c *Client) updateDocInfoOfChanges(
+	ctx context.Context,
+	encodedDocID primitive.ObjectID,
+	docInfo *database.DocInfo,
+	initialServerSeq uint64,
+	changes []*change.Change,
+) error {
+	update := bson.M{
 		"$set": bson.M{
 			"server_seq": docInfo.ServerSeq,
 			"updated_at": gotime.Now(),
 		},
-	})
+	}
+	if docInfo.LamportServerSeq == docInfo.ServerSeq {
+		update["$max"] = bson.M{
+			"lamport":            docInfo.Lamport,
+			"lamport_server_seq": docInfo.LamportServerSeq,
+		}
+	}
+	if counts := database.CountOperations(changes); len(counts) > 0 {
+		inc := bson.M{}
+		for kind, count := range counts {
+			inc["operation_counts."+kind] = count
+		}
+		update["$inc"] = inc
+	}
+
+	res, err := c.collection(colDocuments).UpdateOne(ctx, bson.M{
+		"_id":        encodedDocID,
+		"server_seq": initialServerSeq,
+	}, update)
 	if err != nil {
 		logging.From(ctx).Error(err)
 		return err
@@ -638,6 +920,124 @@ func (c *Client) CreateChangeInfos(
 	return nil
 }
 
+// CreateChangeInfosOfDocuments stores the changes of the given batches and
+// updates their documents in a transaction. It returns
+// ErrTransactionNotSupported if the deployment can not run transactions, e.g.
+// a standalone server.
+func (c *Client) CreateChangeInfosOfDocuments(
+	ctx context.Context,
+	projectID types.ID,
+	batches []*database.ChangeInfoBatch,
+) error {
+	session, err := c.mongoClient().StartSession()
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	if _, err := session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		for _, batch := range batches {
+			if err := c.createChangeInfosInTransaction(sessCtx, batch); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}); err != nil {
+		if isTransactionNotSupportedError(err) {
+			return fmt.Errorf("%s: %w", projectID, database.ErrTransactionNotSupported)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// createChangeInfosInTransaction stores the changes of the given batch and
+// updates its document in the transaction of the given context.
+func (c *Client) createChangeInfosInTransaction(
+	ctx mongo.SessionContext,
+	batch *database.ChangeInfoBatch,
+) error {
+	encodedDocID, infos, err := encodeChangeInfos(batch.DocInfo, batch.Changes)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: A write error aborts the transaction, so the uncommitted changes
+	// are removed before inserting, unlike CreateChangeInfos.
+	if _, err := c.removeUncommittedChangeInfos(ctx, encodedDocID, batch.InitialServerSeq); err != nil {
+		if errors.Is(err, database.ErrConflictOnUpdate) {
+			return fmt.Errorf("%s: %w", batch.DocInfo.ID, err)
+		}
+		return err
+	}
+
+	if _, err := c.collection(colChanges).InsertMany(
+		ctx,
+		infos,
+		options.InsertMany().SetOrdered(true),
+	); err != nil {
+		err = toChangeInsertError(err)
+		if errors.Is(err, database.ErrChangeAlreadyExists) || errors.Is(err, database.ErrConflictOnUpdate) {
+			return fmt.Errorf("%s: %w", batch.DocInfo.ID, err)
+		}
+		return err
+	}
+
+	return c.updateDocInfoOfChanges(ctx, encodedDocID, batch.DocInfo, batch.InitialServerSeq, batch.Changes)
+}
+
+// FindOperationIDs returns the IDs among the given ones of the operations
+// stored in the document since the given time.
+func (c *Client) FindOperationIDs(
+	ctx context.Context,
+	docID types.ID,
+	ids []string,
+	since gotime.Time,
+) ([]string, error) {
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := c.collection(colChanges).Find(ctx, bson.M{
+		"doc_id": encodedDocID,
+		"operation_ids": bson.M{
+			"$exists": true,
+			"$in":     ids,
+		},
+		"created_at": bson.M{"$gte": since},
+	}, options.Find().SetProjection(bson.M{"operation_ids": 1}))
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return nil, err
+	}
+
+	var infos []*database.ChangeInfo
+	if err := cursor.All(ctx, &infos); err != nil {
+		logging.From(ctx).Error(err)
+		return nil, err
+	}
+
+	wanted := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	var found []string
+	for _, info := range infos {
+		for _, id := range info.OperationIDs {
+			if wanted[id] {
+				found = append(found, id)
+				delete(wanted, id)
+			}
+		}
+	}
+
+	return found, nil
+}
+
 // FindChangesBetweenServerSeqs returns the changes between two server sequences.
 func (c *Client) FindChangesBetweenServerSeqs(
 	ctx context.Context,
@@ -700,26 +1100,106 @@ func (c *Client) CreateSnapshotInfo(
 	ctx context.Context,
 	docID types.ID,
 	doc *document.InternalDocument,
+	compression types.SnapshotCompression,
 ) error {
 	encodedDocID, err := encodeID(docID)
 	if err != nil {
 		return err
 	}
-	snapshot, err := converter.ObjectToBytes(doc.RootObject())
+	snapshot, err := converter.ObjectToSnapshotBytes(doc.RootObject())
 	if err != nil {
 		return err
 	}
-
-	if _, err := c.collection(colSnapshots).InsertOne(ctx, bson.M{
+	snapshot, err = converter.CompressSnapshot(snapshot, compression)
+	if err != nil {
+		return err
+	}
+	versionVector, err := converter.VersionVectorToBytes(doc.VersionVector())
+	if err != nil {
+		return err
+	}
+
+	// NOTE: The blob is referenced before the snapshot info is written and
+	// released after the snapshot info is replaced, so a failure between the
+	// writes leaves an extra reference rather than a dangling one. A blob is
+	// never removed while it is referenced.
+	checksum := database.SnapshotChecksum(snapshot)
+	if _, err := c.collection(colSnapshotBlobs).UpdateOne(ctx, bson.M{
+		"_id": checksum,
+	}, bson.M{
+		"$inc": bson.M{"ref_count": 1},
+		"$setOnInsert": bson.M{
+			"snapshot":   snapshot,
+			"created_at": gotime.Now(),
+		},
+	}, options.Update().SetUpsert(true)); err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+
+	// NOTE: The snapshot at the same server sequence is replaced atomically,
+	// so that the write can be retried after a failure whose result is
+	// unknown, e.g. a timeout.
+	result := c.collection(colSnapshots).FindOneAndUpdate(ctx, bson.M{
 		"doc_id":     encodedDocID,
 		"server_seq": doc.Checkpoint().ServerSeq,
-		"lamport":    doc.Lamport(),
-		"snapshot":   snapshot,
-		"created_at": gotime.Now(),
-	}); err != nil {
-		logging.From(ctx).Error(err)
+	}, bson.M{
+		"$set": bson.M{
+			"lamport":        doc.Lamport(),
+			"checksum":       checksum,
+			"version_vector": versionVector,
+			"version":        converter.CurrentSnapshotVersion,
+			"garbage_len":    doc.GarbageLen(),
+			"created_at":     gotime.Now(),
+		},
+		"$unset": bson.M{
+			"snapshot": "",
+		},
+	}, options.FindOneAndUpdate().SetUpsert(true).SetProjection(bson.M{
+		"checksum": 1,
+	}))
+	if result.Err() == mongo.ErrNoDocuments {
+		return nil
+	}
+	if result.Err() != nil {
+		logging.From(ctx).Error(result.Err())
+		return result.Err()
+	}
+
+	replaced := &database.SnapshotInfo{}
+	if err := result.Decode(replaced); err != nil {
 		return err
 	}
+	return c.releaseSnapshotBlobs(ctx, []string{replaced.Checksum})
+}
+
+// releaseSnapshotBlobs decreases the reference counts of the blobs of the
+// given checksums, and removes the blobs which are no longer referenced.
+func (c *Client) releaseSnapshotBlobs(ctx context.Context, checksums []string) error {
+	for _, checksum := range checksums {
+		if checksum == "" {
+			continue
+		}
+
+		if _, err := c.collection(colSnapshotBlobs).UpdateOne(ctx, bson.M{
+			"_id": checksum,
+		}, bson.M{
+			"$inc": bson.M{"ref_count": -1},
+		}); err != nil {
+			logging.From(ctx).Error(err)
+			return err
+		}
+
+		// NOTE: The blob is removed only if no snapshot has referenced it
+		// again since the decrement.
+		if _, err := c.collection(colSnapshotBlobs).DeleteOne(ctx, bson.M{
+			"_id":       checksum,
+			"ref_count": bson.M{"$lte": 0},
+		}); err != nil {
+			logging.From(ctx).Error(err)
+			return err
+		}
+	}
 
 	return nil
 }
@@ -757,10 +1237,184 @@ func (c *Client) FindClosestSnapshotInfo(
 	if err := result.Decode(snapshotInfo); err != nil {
 		return nil, err
 	}
+	if snapshotInfo.Checksum == "" {
+		return snapshotInfo, nil
+	}
+
+	blob, err := c.FindSnapshotBlobInfo(ctx, snapshotInfo.Checksum)
+	if err != nil {
+		return nil, err
+	}
+	snapshotInfo.Snapshot = blob.Snapshot
 
 	return snapshotInfo, nil
 }
 
+// FindSnapshotBlobInfo returns the snapshot blob of the given checksum.
+func (c *Client) FindSnapshotBlobInfo(
+	ctx context.Context,
+	checksum string,
+) (*database.SnapshotBlobInfo, error) {
+	result := c.collection(colSnapshotBlobs).FindOne(ctx, bson.M{
+		"_id": checksum,
+	})
+	if result.Err() == mongo.ErrNoDocuments {
+		return nil, fmt.Errorf("%s: %w", checksum, database.ErrSnapshotBlobNotFound)
+	}
+	if result.Err() != nil {
+		logging.From(ctx).Error(result.Err())
+		return nil, result.Err()
+	}
+
+	blob := &database.SnapshotBlobInfo{}
+	if err := result.Decode(blob); err != nil {
+		return nil, err
+	}
+
+	return blob, nil
+}
+
+// FindSnapshotInfos returns the snapshot infos of the given document from
+// the latest one. The snapshot data is not included.
+func (c *Client) FindSnapshotInfos(
+	ctx context.Context,
+	docID types.ID,
+) ([]*database.SnapshotInfo, error) {
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := c.collection(colSnapshots).Find(ctx, bson.M{
+		"doc_id": encodedDocID,
+	}, options.Find().SetSort(bson.M{
+		"server_seq": -1,
+	}).SetProjection(bson.M{
+		"snapshot": 0,
+	}))
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return nil, err
+	}
+
+	var infos []*database.SnapshotInfo
+	if err := cursor.All(ctx, &infos); err != nil {
+		logging.From(ctx).Error(cursor.Err())
+		return nil, cursor.Err()
+	}
+
+	return infos, nil
+}
+
+// outdatedSnapshotsFilter returns the filter of the snapshots whose format
+// version is older than the given version, including the ones without version.
+func outdatedSnapshotsFilter(version int32) bson.M {
+	return bson.M{
+		"$or": bson.A{
+			bson.M{"version": bson.M{"$lt": version}},
+			bson.M{"version": bson.M{"$exists": false}},
+		},
+	}
+}
+
+// FindSnapshotInfosToUpgrade returns at most limit snapshot infos whose format
+// version is older than the given version. The snapshot data is not included.
+func (c *Client) FindSnapshotInfosToUpgrade(
+	ctx context.Context,
+	version int32,
+	limit int,
+) ([]*database.SnapshotInfo, error) {
+	cursor, err := c.collection(colSnapshots).Find(
+		ctx,
+		outdatedSnapshotsFilter(version),
+		options.Find().SetLimit(int64(limit)).SetProjection(bson.M{
+			"snapshot": 0,
+		}),
+	)
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return nil, err
+	}
+
+	var infos []*database.SnapshotInfo
+	if err := cursor.All(ctx, &infos); err != nil {
+		logging.From(ctx).Error(cursor.Err())
+		return nil, cursor.Err()
+	}
+
+	return infos, nil
+}
+
+// CountSnapshotInfosToUpgrade returns the number of all the snapshot infos and
+// the number of the ones whose format version is older than the given version.
+func (c *Client) CountSnapshotInfosToUpgrade(
+	ctx context.Context,
+	version int32,
+) (int64, int64, error) {
+	total, err := c.collection(colSnapshots).EstimatedDocumentCount(ctx)
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return 0, 0, err
+	}
+
+	outdated, err := c.collection(colSnapshots).CountDocuments(ctx, outdatedSnapshotsFilter(version))
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return 0, 0, err
+	}
+
+	return total, outdated, nil
+}
+
+// RemoveSnapshotInfos removes the given snapshot infos of the given document.
+func (c *Client) RemoveSnapshotInfos(
+	ctx context.Context,
+	docID types.ID,
+	snapshotIDs []types.ID,
+) error {
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return err
+	}
+
+	var encodedIDs []primitive.ObjectID
+	for _, id := range snapshotIDs {
+		encodedID, err := encodeID(id)
+		if err != nil {
+			return err
+		}
+		encodedIDs = append(encodedIDs, encodedID)
+	}
+
+	filter := bson.M{
+		"_id":    bson.M{"$in": encodedIDs},
+		"doc_id": encodedDocID,
+	}
+	cursor, err := c.collection(colSnapshots).Find(ctx, filter, options.Find().SetProjection(bson.M{
+		"checksum": 1,
+	}))
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+	var infos []*database.SnapshotInfo
+	if err := cursor.All(ctx, &infos); err != nil {
+		logging.From(ctx).Error(cursor.Err())
+		return cursor.Err()
+	}
+
+	if _, err := c.collection(colSnapshots).DeleteMany(ctx, filter); err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+
+	var checksums []string
+	for _, info := range infos {
+		checksums = append(checksums, info.Checksum)
+	}
+	return c.releaseSnapshotBlobs(ctx, checksums)
+}
+
 // UpdateAndFindMinSyncedTicket updates the given serverSeq of the given client
 // and returns the min synced ticket.
 func (c *Client) UpdateAndFindMinSyncedTicket(
@@ -828,6 +1482,19 @@ func (c *Client) FindDocInfosByPaging(
 		"project_id": bson.M{
 			"$eq": encodedProjectID,
 		},
+		"removed_at":  bson.M{"$exists": false},
+		"archived_at": bson.M{"$exists": false},
+	}
+	if !paging.SnapshotAt.IsZero() {
+		delete(filter, "removed_at")
+		filter["created_at"] = bson.M{"$lte": paging.SnapshotAt}
+		filter["$or"] = bson.A{
+			bson.M{"removed_at": bson.M{"$exists": false}},
+			bson.M{"removed_at": bson.M{"$gt": paging.SnapshotAt}},
+		}
+	}
+	for k, v := range paging.Metadata {
+		filter["metadata."+k] = v
 	}
 	if paging.Offset != "" {
 		encodedOffset, err := encodeID(paging.Offset)
@@ -881,6 +1548,8 @@ func (c *Client) FindDocInfosByQuery(
 		"key": bson.M{"$regex": primitive.Regex{
 			Pattern: "^" + escapeRegexp(query),
 		}},
+		"removed_at":  bson.M{"$exists": false},
+		"archived_at": bson.M{"$exists": false},
 	})
 	if err != nil {
 		logging.From(ctx).Error(err)
@@ -968,60 +1637,980 @@ func (c *Client) UpdateSyncedSeq(
 	return nil
 }
 
-func (c *Client) findTicketByServerSeq(
+// FindDocInfosByKeyPrefix returns at most limit documentInfos whose keys
+// start with the given prefix, in ascending order of ID after the given offset.
+func (c *Client) FindDocInfosByKeyPrefix(
 	ctx context.Context,
-	docID types.ID,
-	serverSeq uint64,
-) (*time.Ticket, error) {
-	if serverSeq == change.InitialServerSeq {
-		return time.InitialTicket, nil
+	projectID types.ID,
+	prefix string,
+	offset types.ID,
+	limit int,
+) ([]*database.DocInfo, error) {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return nil, err
 	}
 
-	encodedDocID, err := encodeID(docID)
+	filter := bson.M{
+		"project_id": encodedProjectID,
+		"key": bson.M{"$regex": primitive.Regex{
+			Pattern: "^" + escapeRegexp(prefix),
+		}},
+		"removed_at": bson.M{"$exists": false},
+	}
+	if offset != "" {
+		encodedOffset, err := encodeID(offset)
+		if err != nil {
+			return nil, err
+		}
+		filter["_id"] = bson.M{"$gt": encodedOffset}
+	}
+
+	cursor, err := c.collection(colDocuments).Find(
+		ctx,
+		filter,
+		options.Find().SetSort(bson.M{"_id": 1}).SetLimit(int64(limit)),
+	)
 	if err != nil {
+		logging.From(ctx).Error(err)
 		return nil, err
 	}
 
-	result := c.collection(colChanges).FindOne(ctx, bson.M{
-		"doc_id":     encodedDocID,
-		"server_seq": serverSeq,
+	var infos []*database.DocInfo
+	if err := cursor.All(ctx, &infos); err != nil {
+		logging.From(ctx).Error(cursor.Err())
+		return nil, cursor.Err()
+	}
+
+	return infos, nil
+}
+
+// RemoveDocInfo soft-removes the document of the given ID.
+func (c *Client) RemoveDocInfo(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+) error {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return err
+	}
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.collection(colDocuments).UpdateOne(ctx, bson.M{
+		"_id":        encodedDocID,
+		"project_id": encodedProjectID,
+		"removed_at": bson.M{"$exists": false},
+	}, bson.M{
+		"$set": bson.M{
+			"removed_at": gotime.Now(),
+		},
 	})
-	if result.Err() == mongo.ErrNoDocuments {
-		return nil, fmt.Errorf(
-			"change docID=%s serverSeq=%d: %w",
-			docID.String(),
-			serverSeq,
-			database.ErrDocumentNotFound,
-		)
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	return nil
+}
+
+// FindRemovedDocInfoByKey finds the document of the given key removed most
+// recently.
+func (c *Client) FindRemovedDocInfoByKey(
+	ctx context.Context,
+	projectID types.ID,
+	docKey key.Key,
+) (*database.DocInfo, error) {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return nil, err
 	}
 
+	result := c.collection(colDocuments).FindOne(ctx, bson.M{
+		"project_id": encodedProjectID,
+		"key":        docKey,
+		"removed_at": bson.M{"$exists": true},
+	}, options.FindOne().SetSort(bson.M{"removed_at": -1}))
+	if result.Err() == mongo.ErrNoDocuments {
+		return nil, fmt.Errorf("%s %s: %w", projectID, docKey, database.ErrDocumentNotFound)
+	}
 	if result.Err() != nil {
 		logging.From(ctx).Error(result.Err())
 		return nil, result.Err()
 	}
 
-	changeInfo := database.ChangeInfo{}
-	if err := result.Decode(&changeInfo); err != nil {
-		return nil, err
-	}
-
-	actorID, err := time.ActorIDFromHex(changeInfo.ActorID.String())
-	if err != nil {
+	docInfo := database.DocInfo{}
+	if err := result.Decode(&docInfo); err != nil {
 		return nil, err
 	}
 
-	return time.NewTicket(
-		changeInfo.Lamport,
-		time.MaxDelimiter,
-		actorID,
-	), nil
+	return &docInfo, nil
 }
 
-func (c *Client) collection(
+// RestoreDocInfo restores the removed document of the given ID. It returns
+// ErrDocumentAlreadyExists if a document of the same key exists.
+func (c *Client) RestoreDocInfo(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+) error {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return err
+	}
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: The unique index of the keys rejects restoring the document if a
+	// document of the same key exists.
+	res, err := c.collection(colDocuments).UpdateOne(ctx, bson.M{
+		"_id":        encodedDocID,
+		"project_id": encodedProjectID,
+		"removed_at": bson.M{"$exists": true},
+	}, bson.M{
+		"$set": bson.M{
+			"updated_at": gotime.Now(),
+		},
+		"$unset": bson.M{
+			"removed_at": "",
+		},
+	})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("%s: %w", docID, database.ErrDocumentAlreadyExists)
+		}
+		logging.From(ctx).Error(err)
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	return nil
+}
+
+// UpdateDocInfoLock locks or unlocks the document of the given ID. The reason
+// is cleared when unlocking.
+func (c *Client) UpdateDocInfoLock(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+	locked bool,
+	reason string,
+) error {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return err
+	}
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{"$unset": bson.M{"locked": "", "lock_reason": ""}}
+	if locked {
+		update = bson.M{"$set": bson.M{"locked": true, "lock_reason": reason}}
+	}
+
+	res, err := c.collection(colDocuments).UpdateOne(ctx, bson.M{
+		"_id":        encodedDocID,
+		"project_id": encodedProjectID,
+		"removed_at": bson.M{"$exists": false},
+	}, update)
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	return nil
+}
+
+// UpdateDocInfoMetadata replaces the metadata of the document of the given ID
+// with the given metadata.
+func (c *Client) UpdateDocInfoMetadata(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+	metadata map[string]string,
+) error {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return err
+	}
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{"$unset": bson.M{"metadata": ""}}
+	if len(metadata) > 0 {
+		update = bson.M{"$set": bson.M{"metadata": metadata}}
+	}
+
+	res, err := c.collection(colDocuments).UpdateOne(ctx, bson.M{
+		"_id":        encodedDocID,
+		"project_id": encodedProjectID,
+		"removed_at": bson.M{"$exists": false},
+	}, update)
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	return nil
+}
+
+// MoveDocInfo moves the document of the given ID to the destination project.
+// The clients attached to the document are detached, and the actors of the
+// document are cleared.
+//
+// NOTE: Multi-document transactions are not used, so the document is moved
+// first. The unique index of the keys rejects the duplicated key in the
+// destination, and if detaching the clients fails after that, the stale
+// attachments only refer to the document that no longer exists in the source
+// project.
+func (c *Client) MoveDocInfo(
+	ctx context.Context,
+	srcProjectID types.ID,
+	docID types.ID,
+	dstProjectID types.ID,
+) error {
+	encodedSrcProjectID, err := encodeID(srcProjectID)
+	if err != nil {
+		return err
+	}
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return err
+	}
+	encodedDstProjectID, err := encodeID(dstProjectID)
+	if err != nil {
+		return err
+	}
+
+	now := gotime.Now()
+	res, err := c.collection(colDocuments).UpdateOne(ctx, bson.M{
+		"_id":        encodedDocID,
+		"project_id": encodedSrcProjectID,
+		"removed_at": bson.M{"$exists": false},
+	}, bson.M{
+		"$set": bson.M{
+			"project_id": encodedDstProjectID,
+			"updated_at": now,
+		},
+		"$unset": bson.M{
+			"actor_ids": "",
+		},
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentAlreadyExists)
+	}
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	clientDocInfoKey := "documents." + docID.String() + "."
+	if _, err := c.collection(colClients).UpdateMany(ctx, bson.M{
+		"project_id":                encodedSrcProjectID,
+		clientDocInfoKey + "status": database.DocumentAttached,
+	}, bson.M{
+		"$set": bson.M{
+			clientDocInfoKey + "server_seq": 0,
+			clientDocInfoKey + "client_seq": 0,
+			clientDocInfoKey + "status":     database.DocumentDetached,
+			clientDocInfoKey + "read_only":  false,
+			"updated_at":                    now,
+		},
+	}); err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+
+	if _, err := c.collection(colSyncedSeqs).DeleteMany(ctx, bson.M{
+		"doc_id": encodedDocID,
+	}); err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// FindDocInfosToArchive returns at most limit documents of the given project
+// which are neither removed nor archived, and have been neither created nor
+// updated since the given time.
+func (c *Client) FindDocInfosToArchive(
+	ctx context.Context,
+	projectID types.ID,
+	inactiveSince gotime.Time,
+	limit int,
+) ([]*database.DocInfo, error) {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := c.collection(colDocuments).Find(ctx, bson.M{
+		"project_id":  encodedProjectID,
+		"removed_at":  bson.M{"$exists": false},
+		"archived_at": bson.M{"$exists": false},
+		"created_at":  bson.M{"$lt": inactiveSince},
+		"$or": bson.A{
+			bson.M{"updated_at": bson.M{"$exists": false}},
+			bson.M{"updated_at": bson.M{"$lt": inactiveSince}},
+		},
+	}, options.Find().SetSort(bson.M{"_id": 1}).SetLimit(int64(limit)))
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return nil, err
+	}
+
+	var infos []*database.DocInfo
+	if err := cursor.All(ctx, &infos); err != nil {
+		logging.From(ctx).Error(cursor.Err())
+		return nil, cursor.Err()
+	}
+
+	return infos, nil
+}
+
+// ArchiveDocInfo archives the document of the given ID. The clients attached
+// to the document are detached, the actors of the document are cleared and the
+// changes and the event log entries before the given serverSeq are removed.
+func (c *Client) ArchiveDocInfo(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+	serverSeq uint64,
+) error {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return err
+	}
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return err
+	}
+
+	now := gotime.Now()
+	res, err := c.collection(colDocuments).UpdateOne(ctx, bson.M{
+		"_id":        encodedDocID,
+		"project_id": encodedProjectID,
+		"removed_at": bson.M{"$exists": false},
+	}, bson.M{
+		"$set": bson.M{
+			"archived_at":          now,
+			"compacted_server_seq": serverSeq,
+		},
+		"$unset": bson.M{
+			"actor_ids": "",
+		},
+	})
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	clientDocInfoKey := "documents." + docID.String() + "."
+	if _, err := c.collection(colClients).UpdateMany(ctx, bson.M{
+		"project_id":                encodedProjectID,
+		clientDocInfoKey + "status": database.DocumentAttached,
+	}, bson.M{
+		"$set": bson.M{
+			clientDocInfoKey + "server_seq": 0,
+			clientDocInfoKey + "client_seq": 0,
+			clientDocInfoKey + "status":     database.DocumentDetached,
+			clientDocInfoKey + "read_only":  false,
+			"updated_at":                    now,
+		},
+	}); err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+
+	if _, err := c.collection(colSyncedSeqs).DeleteMany(ctx, bson.M{
+		"doc_id": encodedDocID,
+	}); err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+
+	if _, err := c.collection(colChanges).DeleteMany(ctx, bson.M{
+		"doc_id":     encodedDocID,
+		"server_seq": bson.M{"$lt": serverSeq},
+	}); err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+
+	if _, err := c.collection(colDocEventLogs).DeleteMany(ctx, bson.M{
+		"doc_id":     encodedDocID,
+		"server_seq": bson.M{"$lt": serverSeq},
+	}); err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// UnarchiveDocInfo unarchives the document of the given ID. The document is
+// regarded as updated so that it is not archived again right away.
+func (c *Client) UnarchiveDocInfo(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+) error {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return err
+	}
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.collection(colDocuments).UpdateOne(ctx, bson.M{
+		"_id":        encodedDocID,
+		"project_id": encodedProjectID,
+		"removed_at": bson.M{"$exists": false},
+	}, bson.M{
+		"$set": bson.M{
+			"updated_at": gotime.Now(),
+		},
+		"$unset": bson.M{
+			"archived_at": "",
+		},
+	})
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	return nil
+}
+
+// CreateDocClientEventInfo stores the event of the given client on the given
+// document.
+func (c *Client) CreateDocClientEventInfo(
+	ctx context.Context,
+	docID types.ID,
+	clientID types.ID,
+	eventType types.DocumentClientEventType,
+) error {
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return err
+	}
+	encodedClientID, err := encodeID(clientID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.collection(colDocClientEvents).InsertOne(ctx, bson.M{
+		"doc_id":     encodedDocID,
+		"client_id":  encodedClientID,
+		"type":       eventType,
+		"created_at": gotime.Now(),
+	}); err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// FindDocClientEventInfos returns the events of clients on the given document
+// which occurred in [from, to) in order of ID.
+func (c *Client) FindDocClientEventInfos(
+	ctx context.Context,
+	docID types.ID,
+	from gotime.Time,
+	to gotime.Time,
+	paging types.Paging[types.ID],
+) ([]*database.DocClientEventInfo, error) {
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{
+		"doc_id": encodedDocID,
+	}
+	if paging.Offset != "" {
+		encodedOffset, err := encodeID(paging.Offset)
+		if err != nil {
+			return nil, err
+		}
+
+		k := "$lt"
+		if paging.IsForward {
+			k = "$gt"
+		}
+		filter["_id"] = bson.M{
+			k: encodedOffset,
+		}
+	}
+
+	createdAt := bson.M{}
+	if !from.IsZero() {
+		createdAt["$gte"] = from
+	}
+	if !to.IsZero() {
+		createdAt["$lt"] = to
+	}
+	if len(createdAt) > 0 {
+		filter["created_at"] = createdAt
+	}
+
+	opts := options.Find().SetLimit(int64(paging.PageSize))
+	if paging.IsForward {
+		opts = opts.SetSort(map[string]int{"_id": 1})
+	} else {
+		opts = opts.SetSort(map[string]int{"_id": -1})
+	}
+
+	cursor, err := c.collection(colDocClientEvents).Find(ctx, filter, opts)
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return nil, err
+	}
+
+	var infos []*database.DocClientEventInfo
+	if err := cursor.All(ctx, &infos); err != nil {
+		logging.From(ctx).Error(cursor.Err())
+		return nil, cursor.Err()
+	}
+
+	return infos, nil
+}
+
+// RemoveDocClientEventInfosBefore removes the events of clients which occurred
+// before the given time.
+func (c *Client) RemoveDocClientEventInfosBefore(
+	ctx context.Context,
+	before gotime.Time,
+) (int, error) {
+	res, err := c.collection(colDocClientEvents).DeleteMany(ctx, bson.M{
+		"created_at": bson.M{"$lt": before},
+	})
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return 0, err
+	}
+
+	return int(res.DeletedCount), nil
+}
+
+// CountDocInfos returns the number of the documents of the given project,
+// excluding the removed ones.
+func (c *Client) CountDocInfos(ctx context.Context, projectID types.ID) (int, error) {
+	encodedProjectID, err := encodeID(projectID)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := c.collection(colDocuments).CountDocuments(ctx, bson.M{
+		"project_id": encodedProjectID,
+		"removed_at": bson.M{"$exists": false},
+	})
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+// AddDocActor adds the given actor to the actors of the given document.
+func (c *Client) AddDocActor(
+	ctx context.Context,
+	docID types.ID,
+	actorID types.ID,
+	maxActors int,
+) error {
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return err
+	}
+	encodedActorID, err := encodeID(actorID)
+	if err != nil {
+		return err
+	}
+
+	filter := bson.M{"_id": encodedDocID}
+	if maxActors > 0 {
+		filter["$or"] = bson.A{
+			bson.M{"actor_ids": encodedActorID},
+			bson.M{fmt.Sprintf("actor_ids.%d", maxActors-1): bson.M{"$exists": false}},
+		}
+	}
+
+	res, err := c.collection(colDocuments).UpdateOne(ctx, filter, bson.M{
+		"$addToSet": bson.M{"actor_ids": encodedActorID},
+	})
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+	if res.MatchedCount > 0 {
+		return nil
+	}
+
+	if _, err := c.FindDocInfoByID(ctx, docID); err != nil {
+		return err
+	}
+	return fmt.Errorf("%s: %w", docID, database.ErrTooManyActors)
+}
+
+// RemoveDocActors removes the given actors from the actors of the given
+// document.
+func (c *Client) RemoveDocActors(
+	ctx context.Context,
+	docID types.ID,
+	actorIDs []types.ID,
+) error {
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return err
+	}
+
+	var encodedActorIDs bson.A
+	for _, actorID := range actorIDs {
+		encodedActorID, err := encodeID(actorID)
+		if err != nil {
+			return err
+		}
+		encodedActorIDs = append(encodedActorIDs, encodedActorID)
+	}
+
+	res, err := c.collection(colDocuments).UpdateOne(ctx, bson.M{
+		"_id": encodedDocID,
+	}, bson.M{
+		"$pullAll": bson.M{"actor_ids": encodedActorIDs},
+	})
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", docID, database.ErrDocumentNotFound)
+	}
+
+	return nil
+}
+
+// FindDocInfosWithActors returns at most limit documentInfos which have
+// actors, in ascending order of ID after the given offset.
+func (c *Client) FindDocInfosWithActors(
+	ctx context.Context,
+	offset types.ID,
+	limit int,
+) ([]*database.DocInfo, error) {
+	filter := bson.M{
+		"actor_ids.0": bson.M{"$exists": true},
+	}
+	return c.findDocInfosAfter(ctx, filter, offset, limit)
+}
+
+// FindDocInfosWithGCInterval returns at most limit documentInfos which have
+// the GC interval in their metadata, in ascending order of ID after the given
+// offset.
+func (c *Client) FindDocInfosWithGCInterval(
+	ctx context.Context,
+	offset types.ID,
+	limit int,
+) ([]*database.DocInfo, error) {
+	filter := bson.M{
+		"metadata." + types.DocumentGCIntervalMetadataKey: bson.M{"$exists": true},
+	}
+	return c.findDocInfosAfter(ctx, filter, offset, limit)
+}
+
+// findDocInfosAfter returns at most limit documentInfos matching the given
+// filter, in ascending order of ID after the given offset.
+func (c *Client) findDocInfosAfter(
+	ctx context.Context,
+	filter bson.M,
+	offset types.ID,
+	limit int,
+) ([]*database.DocInfo, error) {
+	if offset != "" {
+		encodedOffset, err := encodeID(offset)
+		if err != nil {
+			return nil, err
+		}
+		filter["_id"] = bson.M{"$gt": encodedOffset}
+	}
+
+	cursor, err := c.collection(colDocuments).Find(
+		ctx,
+		filter,
+		options.Find().SetSort(bson.M{"_id": 1}).SetLimit(int64(limit)),
+	)
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return nil, err
+	}
+
+	var infos []*database.DocInfo
+	if err := cursor.All(ctx, &infos); err != nil {
+		logging.From(ctx).Error(cursor.Err())
+		return nil, cursor.Err()
+	}
+
+	return infos, nil
+}
+
+// FindMinSyncedSeqInfo returns the syncedSeqInfo of the given document with
+// the smallest serverSeq.
+func (c *Client) FindMinSyncedSeqInfo(
+	ctx context.Context,
+	docID types.ID,
+) (*database.SyncedSeqInfo, error) {
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := c.collection(colSyncedSeqs).FindOne(ctx, bson.M{
+		"doc_id": encodedDocID,
+	}, options.FindOne().SetSort(bson.M{"server_seq": 1}))
+	if result.Err() == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if result.Err() != nil {
+		logging.From(ctx).Error(result.Err())
+		return nil, result.Err()
+	}
+
+	syncedSeqInfo := database.SyncedSeqInfo{}
+	if err := result.Decode(&syncedSeqInfo); err != nil {
+		return nil, err
+	}
+
+	return &syncedSeqInfo, nil
+}
+
+// CreateDocEventLogInfos appends the given entries to the event logs of their
+// documents.
+func (c *Client) CreateDocEventLogInfos(ctx context.Context, infos []*database.DocEventLogInfo) error {
+	if len(infos) == 0 {
+		return nil
+	}
+
+	var models []mongo.WriteModel
+	for _, info := range infos {
+		encodedDocID, err := encodeID(info.DocID)
+		if err != nil {
+			return err
+		}
+		encodedActorID, err := encodeID(info.ActorID)
+		if err != nil {
+			return err
+		}
+
+		models = append(models, mongo.NewInsertOneModel().SetDocument(bson.M{
+			"doc_id":          encodedDocID,
+			"server_seq":      info.ServerSeq,
+			"actor_id":        encodedActorID,
+			"client_seq":      info.ClientSeq,
+			"lamport":         info.Lamport,
+			"operation_count": info.OperationCount,
+			"version_vector":  info.VersionVector,
+			"fingerprint":     info.Fingerprint,
+			"created_at":      info.CreatedAt,
+		}))
+	}
+
+	if _, err := c.collection(colDocEventLogs).BulkWrite(
+		ctx,
+		models,
+		options.BulkWrite().SetOrdered(false),
+	); err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// FindDocEventLogInfos returns the entries of the event log of the given
+// document whose server sequences are in [from, to].
+func (c *Client) FindDocEventLogInfos(
+	ctx context.Context,
+	docID types.ID,
+	from uint64,
+	to uint64,
+) ([]*database.DocEventLogInfo, error) {
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := c.collection(colDocEventLogs).Find(ctx, bson.M{
+		"doc_id": encodedDocID,
+		"server_seq": bson.M{
+			"$gte": from,
+			"$lte": to,
+		},
+	}, options.Find().SetSort(bson.M{"server_seq": 1}))
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return nil, err
+	}
+
+	var infos []*database.DocEventLogInfo
+	if err := cursor.All(ctx, &infos); err != nil {
+		logging.From(ctx).Error(err)
+		return nil, err
+	}
+
+	return infos, nil
+}
+
+// RemoveDocEventLogInfosBefore removes the entries of the event logs created
+// before the given time.
+func (c *Client) RemoveDocEventLogInfosBefore(ctx context.Context, before gotime.Time) (int, error) {
+	res, err := c.collection(colDocEventLogs).DeleteMany(ctx, bson.M{
+		"created_at": bson.M{"$lt": before},
+	})
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return 0, err
+	}
+
+	return int(res.DeletedCount), nil
+}
+
+// FindMaintenanceInfo returns the maintenance mode of the server.
+func (c *Client) FindMaintenanceInfo(ctx context.Context) (*database.MaintenanceInfo, error) {
+	result := c.collection(colMaintenance).FindOne(ctx, bson.M{
+		"_id": database.MaintenanceInfoID,
+	})
+	if result.Err() == mongo.ErrNoDocuments {
+		return &database.MaintenanceInfo{ID: database.MaintenanceInfoID}, nil
+	}
+	if result.Err() != nil {
+		logging.From(ctx).Error(result.Err())
+		return nil, result.Err()
+	}
+
+	info := database.MaintenanceInfo{}
+	if err := result.Decode(&info); err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// UpdateMaintenanceInfo updates the maintenance mode of the server.
+func (c *Client) UpdateMaintenanceInfo(
+	ctx context.Context,
+	enabled bool,
+	message string,
+) (*database.MaintenanceInfo, error) {
+	info := &database.MaintenanceInfo{
+		ID:        database.MaintenanceInfoID,
+		Enabled:   enabled,
+		Message:   message,
+		UpdatedAt: gotime.Now(),
+	}
+
+	if _, err := c.collection(colMaintenance).UpdateOne(ctx, bson.M{
+		"_id": database.MaintenanceInfoID,
+	}, bson.M{
+		"$set": bson.M{
+			"enabled":    info.Enabled,
+			"message":    info.Message,
+			"updated_at": info.UpdatedAt,
+		},
+	}, options.Update().SetUpsert(true)); err != nil {
+		logging.From(ctx).Error(err)
+		return nil, err
+	}
+
+	return info, nil
+}
+
+func (c *Client) findTicketByServerSeq(
+	ctx context.Context,
+	docID types.ID,
+	serverSeq uint64,
+) (*time.Ticket, error) {
+	if serverSeq == change.InitialServerSeq {
+		return time.InitialTicket, nil
+	}
+
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := c.collection(colChanges).FindOne(ctx, bson.M{
+		"doc_id":     encodedDocID,
+		"server_seq": serverSeq,
+	})
+	if result.Err() == mongo.ErrNoDocuments {
+		return nil, fmt.Errorf(
+			"change docID=%s serverSeq=%d: %w",
+			docID.String(),
+			serverSeq,
+			database.ErrDocumentNotFound,
+		)
+	}
+
+	if result.Err() != nil {
+		logging.From(ctx).Error(result.Err())
+		return nil, result.Err()
+	}
+
+	changeInfo := database.ChangeInfo{}
+	if err := result.Decode(&changeInfo); err != nil {
+		return nil, err
+	}
+
+	actorID, err := time.ActorIDFromHex(changeInfo.ActorID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return time.NewTicket(
+		changeInfo.Lamport,
+		time.MaxDelimiter,
+		actorID,
+	), nil
+}
+
+func (c *Client) collection(
 	name string,
 	opts ...*options.CollectionOptions,
 ) *mongo.Collection {
-	return c.client.
+	return c.mongoClient().
 		Database(c.config.YorkieDatabase).
 		Collection(name, opts...)
 }
@@ -1045,3 +2634,37 @@ func escape(s, a string) string {
 func escapeRegexp(s string) string {
 	return escape(s, `\.+*?()|[]{}^$`)
 }
+
+// toChangeInsertError returns the error of inserting changes. A duplicate key
+// error on the IDs of the changes is ErrChangeAlreadyExists, and the other
+// duplicate key errors, e.g. on their server sequences, are
+// ErrConflictOnUpdate.
+func toChangeInsertError(err error) error {
+	if isDuplicateKeyErrorOn(err, changeIDIndexName) {
+		return database.ErrChangeAlreadyExists
+	}
+	if mongo.IsDuplicateKeyError(err) {
+		return database.ErrConflictOnUpdate
+	}
+	return err
+}
+
+// isTransactionNotSupportedError returns whether the given error is returned
+// by a deployment that can not run transactions.
+func isTransactionNotSupportedError(err error) bool {
+	var serverErr mongo.ServerError
+	if !errors.As(err, &serverErr) {
+		return false
+	}
+	return serverErr.HasErrorCodeWithMessage(20, "Transaction numbers are only allowed")
+}
+
+// isDuplicateKeyErrorOn returns whether the given error is a duplicate key
+// error on the index of the given name.
+func isDuplicateKeyErrorOn(err error, indexName string) bool {
+	var serverErr mongo.ServerError
+	if !errors.As(err, &serverErr) {
+		return false
+	}
+	return serverErr.HasErrorCodeWithMessage(11000, "index: "+indexName+" ")
+}
@@ -38,7 +38,7 @@ func TestClient(t *testing.T) {
 	}
 	assert.NoError(t, config.Validate())
 
-	cli, err := mongo.Dial(config)
+	cli, err := mongo.Dial(config, &database.ObjectIDGenerator{})
 	assert.NoError(t, err)
 
 	t.Run("UpdateProjectInfo test", func(t *testing.T) {
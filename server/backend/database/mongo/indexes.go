@@ -31,8 +31,18 @@ const (
 	colChanges    = "changes"
 	colSnapshots  = "snapshots"
 	colSyncedSeqs = "syncedseqs"
+
+	colSnapshotBlobs = "snapshotblobs"
+
+	colDocClientEvents = "docclientevents"
+	colDocEventLogs    = "doceventlogs"
+	colMaintenance     = "maintenance"
 )
 
+// changeIDIndexName is the name of the unique index of the changes on their
+// IDs and payload hashes.
+const changeIDIndexName = "doc_id_actor_id_client_seq_lamport_payload_hash"
+
 type collectionInfo struct {
 	name    string
 	indexes []mongo.IndexModel
@@ -69,10 +79,13 @@ var collectionInfos = []collectionInfo{
 		}},
 	}, {
 		name: colDocuments,
+		// NOTE: Removed documents keep their keys, so the key is unique only
+		// among the documents that are not removed.
 		indexes: []mongo.IndexModel{{
 			Keys: bsonx.Doc{
 				{Key: "project_id", Value: bsonx.Int32(1)},
 				{Key: "key", Value: bsonx.Int32(1)},
+				{Key: "removed_at", Value: bsonx.Int32(1)},
 			},
 			Options: options.Index().SetUnique(true),
 		}},
@@ -84,6 +97,37 @@ var collectionInfos = []collectionInfo{
 				{Key: "server_seq", Value: bsonx.Int32(1)},
 			},
 			Options: options.Index().SetUnique(true),
+		}, {
+			// NOTE: The ID of a change is not unique, since a client reuses
+			// it after attaching a new document of the same key, so the index
+			// includes the hash of the payload. The changes stored before the
+			// hash was introduced are excluded.
+			Keys: bsonx.Doc{
+				{Key: "doc_id", Value: bsonx.Int32(1)},
+				{Key: "actor_id", Value: bsonx.Int32(1)},
+				{Key: "client_seq", Value: bsonx.Int32(1)},
+				{Key: "lamport", Value: bsonx.Int32(1)},
+				{Key: "payload_hash", Value: bsonx.Int32(1)},
+			},
+			Options: options.Index().
+				SetName(changeIDIndexName).
+				SetUnique(true).
+				SetPartialFilterExpression(bsonx.Doc{{
+					Key:   "payload_hash",
+					Value: bsonx.Document(bsonx.Doc{{Key: "$exists", Value: bsonx.Boolean(true)}}),
+				}}),
+		}, {
+			// NOTE: Only the changes with the IDs of their operations given by
+			// the client are indexed.
+			Keys: bsonx.Doc{
+				{Key: "doc_id", Value: bsonx.Int32(1)},
+				{Key: "operation_ids", Value: bsonx.Int32(1)},
+			},
+			Options: options.Index().
+				SetPartialFilterExpression(bsonx.Doc{{
+					Key:   "operation_ids",
+					Value: bsonx.Document(bsonx.Doc{{Key: "$exists", Value: bsonx.Boolean(true)}}),
+				}}),
 		}},
 	}, {
 		name: colSnapshots,
@@ -93,6 +137,10 @@ var collectionInfos = []collectionInfo{
 				{Key: "server_seq", Value: bsonx.Int32(1)},
 			},
 			Options: options.Index().SetUnique(true),
+		}, {
+			Keys: bsonx.Doc{
+				{Key: "version", Value: bsonx.Int32(1)},
+			},
 		}},
 	}, {
 		name: colSyncedSeqs,
@@ -109,6 +157,31 @@ var collectionInfos = []collectionInfo{
 				{Key: "actor_id", Value: bsonx.Int32(1)},
 			},
 		}},
+	}, {
+		name: colDocClientEvents,
+		indexes: []mongo.IndexModel{{
+			Keys: bsonx.Doc{
+				{Key: "doc_id", Value: bsonx.Int32(1)},
+				{Key: "_id", Value: bsonx.Int32(1)},
+			},
+		}, {
+			Keys: bsonx.Doc{
+				{Key: "created_at", Value: bsonx.Int32(1)},
+			},
+		}},
+	}, {
+		name: colDocEventLogs,
+		indexes: []mongo.IndexModel{{
+			Keys: bsonx.Doc{
+				{Key: "doc_id", Value: bsonx.Int32(1)},
+				{Key: "server_seq", Value: bsonx.Int32(1)},
+			},
+			Options: options.Index().SetUnique(true),
+		}, {
+			Keys: bsonx.Doc{
+				{Key: "created_at", Value: bsonx.Int32(1)},
+			},
+		}},
 	},
 }
 
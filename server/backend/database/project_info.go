@@ -50,6 +50,69 @@ type ProjectInfo struct {
 	// AuthWebhookMethods is the methods that run the authorization webhook.
 	AuthWebhookMethods []string `bson:"auth_webhook_methods"`
 
+	// DocumentKeyPolicy is the policy that document keys of this project must
+	// conform to.
+	DocumentKeyPolicy types.DocumentKeyPolicy `bson:"document_key_policy"`
+
+	// CollectApplyLag is whether to collect the lag between the creation of
+	// operations on clients and the reception on the server.
+	CollectApplyLag bool `bson:"collect_apply_lag"`
+
+	// InitialContent is the JSON object that new documents of this project
+	// start from.
+	InitialContent string `bson:"initial_content"`
+
+	// ObjectMergePolicy is the policy to resolve concurrent writes to the same
+	// key of Objects in documents of this project.
+	ObjectMergePolicy string `bson:"object_merge_policy"`
+
+	// EventWebhookURL is the url of the webhook that receives the lifecycle
+	// events of documents of this project.
+	EventWebhookURL string `bson:"event_webhook_url"`
+
+	// EphemeralKeyPrefix is the key prefix of the ephemeral documents.
+	EphemeralKeyPrefix string `bson:"ephemeral_key_prefix"`
+
+	// ChangefeedURL is the url of the message sink that receives the changes
+	// committed to documents of this project.
+	ChangefeedURL string `bson:"changefeed_url"`
+
+	// Features is whether the features of documents are enabled for this
+	// project.
+	Features map[string]bool `bson:"features,omitempty"`
+
+	// ArchiveAfter is the period of inactivity after which documents of this
+	// project are archived.
+	ArchiveAfter string `bson:"archive_after"`
+
+	// DocumentTemplates is the templates of documents of this project by
+	// their IDs.
+	DocumentTemplates map[string]string `bson:"document_templates,omitempty"`
+
+	// ExplicitDocumentCreation is whether documents of this project must be
+	// created explicitly before they are attached.
+	ExplicitDocumentCreation bool `bson:"explicit_document_creation"`
+
+	// ActorIDPolicy is the policy to assign actor IDs to the clients of this
+	// project.
+	ActorIDPolicy string `bson:"actor_id_policy,omitempty"`
+
+	// AllowedOperations is the types of operations that clients of this
+	// project may submit.
+	AllowedOperations []string `bson:"allowed_operations,omitempty"`
+
+	// SnapshotPolicy is the policy to store the snapshots of documents of
+	// this project.
+	SnapshotPolicy types.SnapshotPolicy `bson:"snapshot_policy"`
+
+	// MaxKeysPerObject is the maximum number of live keys of an Object in
+	// documents of this project.
+	MaxKeysPerObject int `bson:"max_keys_per_object,omitempty"`
+
+	// RemovedDocumentPolicy is the policy of attaching to the key of a
+	// removed document of this project.
+	RemovedDocumentPolicy string `bson:"removed_document_policy,omitempty"`
+
 	// CreatedAt is the time when the project was created.
 	CreatedAt time.Time `bson:"created_at"`
 
@@ -71,14 +134,30 @@ func NewProjectInfo(name string) *ProjectInfo {
 // ToProjectInfo converts the given types.Project to ProjectInfo.
 func ToProjectInfo(project *types.Project) *ProjectInfo {
 	return &ProjectInfo{
-		ID:                 project.ID,
-		Name:               project.Name,
-		PublicKey:          project.PublicKey,
-		SecretKey:          project.SecretKey,
-		AuthWebhookURL:     project.AuthWebhookURL,
-		AuthWebhookMethods: project.AuthWebhookMethods,
-		CreatedAt:          project.CreatedAt,
-		UpdatedAt:          project.UpdatedAt,
+		ID:                       project.ID,
+		Name:                     project.Name,
+		PublicKey:                project.PublicKey,
+		SecretKey:                project.SecretKey,
+		AuthWebhookURL:           project.AuthWebhookURL,
+		AuthWebhookMethods:       project.AuthWebhookMethods,
+		DocumentKeyPolicy:        project.DocumentKeyPolicy,
+		CollectApplyLag:          project.CollectApplyLag,
+		InitialContent:           project.InitialContent,
+		ObjectMergePolicy:        project.ObjectMergePolicy,
+		EventWebhookURL:          project.EventWebhookURL,
+		EphemeralKeyPrefix:       project.EphemeralKeyPrefix,
+		ChangefeedURL:            project.ChangefeedURL,
+		Features:                 project.Features,
+		ArchiveAfter:             project.ArchiveAfter,
+		DocumentTemplates:        project.DocumentTemplates,
+		ExplicitDocumentCreation: project.ExplicitDocumentCreation,
+		ActorIDPolicy:            project.ActorIDPolicy,
+		AllowedOperations:        project.AllowedOperations,
+		SnapshotPolicy:           project.SnapshotPolicy,
+		MaxKeysPerObject:         project.MaxKeysPerObject,
+		RemovedDocumentPolicy:    project.RemovedDocumentPolicy,
+		CreatedAt:                project.CreatedAt,
+		UpdatedAt:                project.UpdatedAt,
 	}
 }
 
@@ -89,14 +168,30 @@ func (i *ProjectInfo) DeepCopy() *ProjectInfo {
 	}
 
 	return &ProjectInfo{
-		ID:                 i.ID,
-		Name:               i.Name,
-		PublicKey:          i.PublicKey,
-		SecretKey:          i.SecretKey,
-		AuthWebhookURL:     i.AuthWebhookURL,
-		AuthWebhookMethods: i.AuthWebhookMethods,
-		CreatedAt:          i.CreatedAt,
-		UpdatedAt:          i.UpdatedAt,
+		ID:                       i.ID,
+		Name:                     i.Name,
+		PublicKey:                i.PublicKey,
+		SecretKey:                i.SecretKey,
+		AuthWebhookURL:           i.AuthWebhookURL,
+		AuthWebhookMethods:       i.AuthWebhookMethods,
+		DocumentKeyPolicy:        i.DocumentKeyPolicy.DeepCopy(),
+		CollectApplyLag:          i.CollectApplyLag,
+		InitialContent:           i.InitialContent,
+		ObjectMergePolicy:        i.ObjectMergePolicy,
+		EventWebhookURL:          i.EventWebhookURL,
+		EphemeralKeyPrefix:       i.EphemeralKeyPrefix,
+		ChangefeedURL:            i.ChangefeedURL,
+		Features:                 copyFeatures(i.Features),
+		ArchiveAfter:             i.ArchiveAfter,
+		DocumentTemplates:        copyDocumentTemplates(i.DocumentTemplates),
+		ExplicitDocumentCreation: i.ExplicitDocumentCreation,
+		ActorIDPolicy:            i.ActorIDPolicy,
+		AllowedOperations:        i.AllowedOperations,
+		SnapshotPolicy:           i.SnapshotPolicy,
+		MaxKeysPerObject:         i.MaxKeysPerObject,
+		RemovedDocumentPolicy:    i.RemovedDocumentPolicy,
+		CreatedAt:                i.CreatedAt,
+		UpdatedAt:                i.UpdatedAt,
 	}
 }
 
@@ -111,18 +206,108 @@ func (i *ProjectInfo) UpdateFields(fields *types.UpdatableProjectFields) {
 	if fields.AuthWebhookMethods != nil {
 		i.AuthWebhookMethods = *fields.AuthWebhookMethods
 	}
+	if fields.DocumentKeyPolicy != nil {
+		i.DocumentKeyPolicy = fields.DocumentKeyPolicy.DeepCopy()
+	}
+	if fields.CollectApplyLag != nil {
+		i.CollectApplyLag = *fields.CollectApplyLag
+	}
+	if fields.InitialContent != nil {
+		i.InitialContent = *fields.InitialContent
+	}
+	if fields.ObjectMergePolicy != nil {
+		i.ObjectMergePolicy = *fields.ObjectMergePolicy
+	}
+	if fields.EventWebhookURL != nil {
+		i.EventWebhookURL = *fields.EventWebhookURL
+	}
+	if fields.EphemeralKeyPrefix != nil {
+		i.EphemeralKeyPrefix = *fields.EphemeralKeyPrefix
+	}
+	if fields.ChangefeedURL != nil {
+		i.ChangefeedURL = *fields.ChangefeedURL
+	}
+	if fields.Features != nil {
+		i.Features = copyFeatures(*fields.Features)
+	}
+	if fields.ArchiveAfter != nil {
+		i.ArchiveAfter = *fields.ArchiveAfter
+	}
+	if fields.DocumentTemplates != nil {
+		i.DocumentTemplates = copyDocumentTemplates(*fields.DocumentTemplates)
+	}
+	if fields.ExplicitDocumentCreation != nil {
+		i.ExplicitDocumentCreation = *fields.ExplicitDocumentCreation
+	}
+	if fields.ActorIDPolicy != nil {
+		i.ActorIDPolicy = *fields.ActorIDPolicy
+	}
+	if fields.AllowedOperations != nil {
+		i.AllowedOperations = *fields.AllowedOperations
+	}
+	if fields.SnapshotPolicy != nil {
+		i.SnapshotPolicy = *fields.SnapshotPolicy
+	}
+	if fields.MaxKeysPerObject != nil {
+		i.MaxKeysPerObject = *fields.MaxKeysPerObject
+	}
+	if fields.RemovedDocumentPolicy != nil {
+		i.RemovedDocumentPolicy = *fields.RemovedDocumentPolicy
+	}
 }
 
 // ToProject converts the ProjectInfo to the Project.
 func (i *ProjectInfo) ToProject() *types.Project {
 	return &types.Project{
-		ID:                 i.ID,
-		Name:               i.Name,
-		AuthWebhookURL:     i.AuthWebhookURL,
-		AuthWebhookMethods: i.AuthWebhookMethods,
-		PublicKey:          i.PublicKey,
-		SecretKey:          i.SecretKey,
-		CreatedAt:          i.CreatedAt,
-		UpdatedAt:          i.UpdatedAt,
+		ID:                       i.ID,
+		Name:                     i.Name,
+		AuthWebhookURL:           i.AuthWebhookURL,
+		AuthWebhookMethods:       i.AuthWebhookMethods,
+		DocumentKeyPolicy:        i.DocumentKeyPolicy,
+		CollectApplyLag:          i.CollectApplyLag,
+		InitialContent:           i.InitialContent,
+		ObjectMergePolicy:        i.ObjectMergePolicy,
+		EventWebhookURL:          i.EventWebhookURL,
+		EphemeralKeyPrefix:       i.EphemeralKeyPrefix,
+		ChangefeedURL:            i.ChangefeedURL,
+		Features:                 copyFeatures(i.Features),
+		ArchiveAfter:             i.ArchiveAfter,
+		DocumentTemplates:        copyDocumentTemplates(i.DocumentTemplates),
+		ExplicitDocumentCreation: i.ExplicitDocumentCreation,
+		ActorIDPolicy:            i.ActorIDPolicy,
+		AllowedOperations:        i.AllowedOperations,
+		SnapshotPolicy:           i.SnapshotPolicy,
+		MaxKeysPerObject:         i.MaxKeysPerObject,
+		RemovedDocumentPolicy:    i.RemovedDocumentPolicy,
+		PublicKey:                i.PublicKey,
+		SecretKey:                i.SecretKey,
+		CreatedAt:                i.CreatedAt,
+		UpdatedAt:                i.UpdatedAt,
+	}
+}
+
+// copyFeatures returns a copy of the given features.
+func copyFeatures(features map[string]bool) map[string]bool {
+	if features == nil {
+		return nil
+	}
+
+	copied := make(map[string]bool, len(features))
+	for name, enabled := range features {
+		copied[name] = enabled
+	}
+	return copied
+}
+
+// copyDocumentTemplates returns a copy of the given document templates.
+func copyDocumentTemplates(templates map[string]string) map[string]string {
+	if templates == nil {
+		return nil
+	}
+
+	copied := make(map[string]string, len(templates))
+	for id, template := range templates {
+		copied[id] = template
 	}
+	return copied
 }
@@ -0,0 +1,729 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+var (
+	// ErrQueryTimeout is returned when an operation of the database does not
+	// complete within its timeout.
+	ErrQueryTimeout = errors.New("query timeout")
+)
+
+// IsOperation returns whether the given name is the name of an operation of
+// Database, e.g. "FindDocInfosByPaging".
+func IsOperation(name string) bool {
+	method, ok := reflect.TypeOf((*Database)(nil)).Elem().MethodByName(name)
+	return ok && method.Type.NumIn() > 0 &&
+		method.Type.In(0) == reflect.TypeOf((*context.Context)(nil)).Elem()
+}
+
+// timeoutDatabase is a Database which bounds each operation of the wrapped
+// Database with a timeout, so that a stuck query fails rather than hanging
+// the request.
+type timeoutDatabase struct {
+	db        Database
+	timeout   gotime.Duration
+	overrides map[string]gotime.Duration
+	onTimeout func(operation string)
+}
+
+// WithQueryTimeout returns the Database which bounds each operation of the
+// given Database with the given timeout. The timeouts of the operations in
+// overrides replace it, e.g. for the operations which list many documents.
+// Zero timeout means no limit. The given onTimeout is called with the name of
+// the operation timed out.
+func WithQueryTimeout(
+	db Database,
+	timeout gotime.Duration,
+	overrides map[string]gotime.Duration,
+	onTimeout func(operation string),
+) Database {
+	return &timeoutDatabase{
+		db:        db,
+		timeout:   timeout,
+		overrides: overrides,
+		onTimeout: onTimeout,
+	}
+}
+
+// begin returns the context of the given operation bounded with its timeout,
+// and the function to be called with the result of the operation. The
+// function returns ErrQueryTimeout if the operation failed by the timeout.
+func (d *timeoutDatabase) begin(ctx context.Context, operation string) (context.Context, func(error) error) {
+	timeout := d.timeout
+	if override, ok := d.overrides[operation]; ok {
+		timeout = override
+	}
+	if timeout <= 0 {
+		return ctx, func(err error) error { return err }
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, timeout)
+	return queryCtx, func(err error) error {
+		defer cancel()
+
+		// NOTE: The failures by the deadline or the cancellation of the given
+		// context are returned as they are.
+		if err == nil || ctx.Err() != nil || !errors.Is(queryCtx.Err(), context.DeadlineExceeded) {
+			return err
+		}
+
+		if d.onTimeout != nil {
+			d.onTimeout(operation)
+		}
+		return fmt.Errorf("%s after %s: %w", operation, timeout, ErrQueryTimeout)
+	}
+}
+
+// Close all resources of this database.
+func (d *timeoutDatabase) Close() error {
+	return d.db.Close()
+}
+
+// Ping checks whether the connection to this database is alive.
+func (d *timeoutDatabase) Ping(ctx context.Context) error {
+	ctx, done := d.begin(ctx, "Ping")
+	return done(d.db.Ping(ctx))
+}
+
+// Reconnect replaces the connection to this database with a new one.
+func (d *timeoutDatabase) Reconnect(ctx context.Context) error {
+	ctx, done := d.begin(ctx, "Reconnect")
+	return done(d.db.Reconnect(ctx))
+}
+
+// FindProjectInfoByPublicKey returns a project by public key.
+func (d *timeoutDatabase) FindProjectInfoByPublicKey(
+	ctx context.Context,
+	publicKey string,
+) (*ProjectInfo, error) {
+	ctx, done := d.begin(ctx, "FindProjectInfoByPublicKey")
+	result, err := d.db.FindProjectInfoByPublicKey(ctx, publicKey)
+	return result, done(err)
+}
+
+// FindProjectInfoByName returns a project by the given name.
+func (d *timeoutDatabase) FindProjectInfoByName(ctx context.Context, name string) (*ProjectInfo, error) {
+	ctx, done := d.begin(ctx, "FindProjectInfoByName")
+	result, err := d.db.FindProjectInfoByName(ctx, name)
+	return result, done(err)
+}
+
+// FindProjectInfoByID returns a project by the given id.
+func (d *timeoutDatabase) FindProjectInfoByID(ctx context.Context, id types.ID) (*ProjectInfo, error) {
+	ctx, done := d.begin(ctx, "FindProjectInfoByID")
+	result, err := d.db.FindProjectInfoByID(ctx, id)
+	return result, done(err)
+}
+
+// FindProjectInfosByIDs returns the projects of the given ids. The
+// projects not found are omitted.
+func (d *timeoutDatabase) FindProjectInfosByIDs(ctx context.Context, ids []types.ID) ([]*ProjectInfo, error) {
+	ctx, done := d.begin(ctx, "FindProjectInfosByIDs")
+	result, err := d.db.FindProjectInfosByIDs(ctx, ids)
+	return result, done(err)
+}
+
+// EnsureDefaultProjectInfo ensures that the default project exists.
+func (d *timeoutDatabase) EnsureDefaultProjectInfo(ctx context.Context) (*ProjectInfo, error) {
+	ctx, done := d.begin(ctx, "EnsureDefaultProjectInfo")
+	result, err := d.db.EnsureDefaultProjectInfo(ctx)
+	return result, done(err)
+}
+
+// CreateProjectInfo creates a new project.
+func (d *timeoutDatabase) CreateProjectInfo(ctx context.Context, name string) (*ProjectInfo, error) {
+	ctx, done := d.begin(ctx, "CreateProjectInfo")
+	result, err := d.db.CreateProjectInfo(ctx, name)
+	return result, done(err)
+}
+
+// ListProjectInfos returns all projects.
+func (d *timeoutDatabase) ListProjectInfos(ctx context.Context) ([]*ProjectInfo, error) {
+	ctx, done := d.begin(ctx, "ListProjectInfos")
+	result, err := d.db.ListProjectInfos(ctx)
+	return result, done(err)
+}
+
+// CountProjectInfos returns the number of the projects.
+func (d *timeoutDatabase) CountProjectInfos(ctx context.Context) (int, error) {
+	ctx, done := d.begin(ctx, "CountProjectInfos")
+	result, err := d.db.CountProjectInfos(ctx)
+	return result, done(err)
+}
+
+// UpdateProjectInfo updates the project.
+func (d *timeoutDatabase) UpdateProjectInfo(
+	ctx context.Context,
+	id types.ID,
+	fields *types.UpdatableProjectFields,
+) (*ProjectInfo, error) {
+	ctx, done := d.begin(ctx, "UpdateProjectInfo")
+	result, err := d.db.UpdateProjectInfo(ctx, id, fields)
+	return result, done(err)
+}
+
+// ActivateClient activates the client of the given key.
+func (d *timeoutDatabase) ActivateClient(
+	ctx context.Context,
+	projectID types.ID,
+	key string,
+) (*ClientInfo, error) {
+	ctx, done := d.begin(ctx, "ActivateClient")
+	result, err := d.db.ActivateClient(ctx, projectID, key)
+	return result, done(err)
+}
+
+// DeactivateClient deactivates the client of the given ID.
+func (d *timeoutDatabase) DeactivateClient(
+	ctx context.Context,
+	projectID,
+	clientID types.ID,
+) (*ClientInfo, error) {
+	ctx, done := d.begin(ctx, "DeactivateClient")
+	result, err := d.db.DeactivateClient(ctx, projectID, clientID)
+	return result, done(err)
+}
+
+// FindClientInfoByID finds the client of the given ID.
+func (d *timeoutDatabase) FindClientInfoByID(
+	ctx context.Context,
+	projectID,
+	clientID types.ID,
+) (*ClientInfo, error) {
+	ctx, done := d.begin(ctx, "FindClientInfoByID")
+	result, err := d.db.FindClientInfoByID(ctx, projectID, clientID)
+	return result, done(err)
+}
+
+// CountAttachedClients returns the number of the activated clients that
+// attach the given document.
+func (d *timeoutDatabase) CountAttachedClients(ctx context.Context, projectID, docID types.ID) (int, error) {
+	ctx, done := d.begin(ctx, "CountAttachedClients")
+	result, err := d.db.CountAttachedClients(ctx, projectID, docID)
+	return result, done(err)
+}
+
+// FindAttachedClientInfos returns the activated clients that attach the
+// given document.
+func (d *timeoutDatabase) FindAttachedClientInfos(
+	ctx context.Context,
+	projectID,
+	docID types.ID,
+) ([]*ClientInfo, error) {
+	ctx, done := d.begin(ctx, "FindAttachedClientInfos")
+	result, err := d.db.FindAttachedClientInfos(ctx, projectID, docID)
+	return result, done(err)
+}
+
+// UpdateClientInfoAfterPushPull updates the client from the given clientInfo
+// after handling PushPull.
+func (d *timeoutDatabase) UpdateClientInfoAfterPushPull(
+	ctx context.Context,
+	clientInfo *ClientInfo,
+	docInfo *DocInfo,
+) error {
+	ctx, done := d.begin(ctx, "UpdateClientInfoAfterPushPull")
+	return done(d.db.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo))
+}
+
+// FindDeactivateCandidates finds the housekeeping candidates.
+func (d *timeoutDatabase) FindDeactivateCandidates(
+	ctx context.Context,
+	deactivateThreshold gotime.Duration,
+	candidatesLimit int,
+) ([]*ClientInfo, error) {
+	ctx, done := d.begin(ctx, "FindDeactivateCandidates")
+	result, err := d.db.FindDeactivateCandidates(ctx, deactivateThreshold, candidatesLimit)
+	return result, done(err)
+}
+
+// FindDocInfoByKey finds the document of the given key.
+func (d *timeoutDatabase) FindDocInfoByKey(
+	ctx context.Context,
+	projectID types.ID,
+	docKey key.Key,
+) (*DocInfo, error) {
+	ctx, done := d.begin(ctx, "FindDocInfoByKey")
+	result, err := d.db.FindDocInfoByKey(ctx, projectID, docKey)
+	return result, done(err)
+}
+
+// FindDocInfoByKeyAndOwner finds the document of the given key. If the
+// createDocIfNotExist condition is true, create the document if it does not
+// exist.
+func (d *timeoutDatabase) FindDocInfoByKeyAndOwner(
+	ctx context.Context,
+	projectID types.ID,
+	clientID types.ID,
+	docKey key.Key,
+	createDocIfNotExist bool,
+) (*DocInfo, error) {
+	ctx, done := d.begin(ctx, "FindDocInfoByKeyAndOwner")
+	result, err := d.db.FindDocInfoByKeyAndOwner(ctx, projectID, clientID, docKey, createDocIfNotExist)
+	return result, done(err)
+}
+
+// FindDocInfoByID finds the document of the given ID.
+func (d *timeoutDatabase) FindDocInfoByID(ctx context.Context, id types.ID) (*DocInfo, error) {
+	ctx, done := d.begin(ctx, "FindDocInfoByID")
+	result, err := d.db.FindDocInfoByID(ctx, id)
+	return result, done(err)
+}
+
+// CreateChangeInfos stores the given changes then updates the given docInfo.
+func (d *timeoutDatabase) CreateChangeInfos(
+	ctx context.Context,
+	projectID types.ID,
+	docInfo *DocInfo,
+	initialServerSeq uint64,
+	changes []*change.Change,
+) error {
+	ctx, done := d.begin(ctx, "CreateChangeInfos")
+	return done(d.db.CreateChangeInfos(ctx, projectID, docInfo, initialServerSeq, changes))
+}
+
+// CreateChangeInfosOfDocuments stores the changes of the given batches
+// atomically.
+func (d *timeoutDatabase) CreateChangeInfosOfDocuments(
+	ctx context.Context,
+	projectID types.ID,
+	batches []*ChangeInfoBatch,
+) error {
+	ctx, done := d.begin(ctx, "CreateChangeInfosOfDocuments")
+	return done(d.db.CreateChangeInfosOfDocuments(ctx, projectID, batches))
+}
+
+// FindOperationIDs returns the IDs among the given ones of the operations
+// stored in the document since the given time.
+func (d *timeoutDatabase) FindOperationIDs(
+	ctx context.Context,
+	docID types.ID,
+	ids []string,
+	since gotime.Time,
+) ([]string, error) {
+	ctx, done := d.begin(ctx, "FindOperationIDs")
+	result, err := d.db.FindOperationIDs(ctx, docID, ids, since)
+	return result, done(err)
+}
+
+// FindChangesBetweenServerSeqs returns the changes between two server sequences.
+func (d *timeoutDatabase) FindChangesBetweenServerSeqs(
+	ctx context.Context,
+	docID types.ID,
+	from uint64,
+	to uint64,
+) ([]*change.Change, error) {
+	ctx, done := d.begin(ctx, "FindChangesBetweenServerSeqs")
+	result, err := d.db.FindChangesBetweenServerSeqs(ctx, docID, from, to)
+	return result, done(err)
+}
+
+// FindChangeInfosBetweenServerSeqs returns the changeInfos between two server sequences.
+func (d *timeoutDatabase) FindChangeInfosBetweenServerSeqs(
+	ctx context.Context,
+	docID types.ID,
+	from uint64,
+	to uint64,
+) ([]*ChangeInfo, error) {
+	ctx, done := d.begin(ctx, "FindChangeInfosBetweenServerSeqs")
+	result, err := d.db.FindChangeInfosBetweenServerSeqs(ctx, docID, from, to)
+	return result, done(err)
+}
+
+// CreateSnapshotInfo stores the snapshot of the given document. The
+// snapshot is written atomically and replaces the snapshot at the same
+// server sequence, so the write can be retried after a failure.
+func (d *timeoutDatabase) CreateSnapshotInfo(
+	ctx context.Context,
+	docID types.ID,
+	doc *document.InternalDocument,
+	compression types.SnapshotCompression,
+) error {
+	ctx, done := d.begin(ctx, "CreateSnapshotInfo")
+	return done(d.db.CreateSnapshotInfo(ctx, docID, doc, compression))
+}
+
+// FindClosestSnapshotInfo finds the closest snapshot info in a given
+// serverSeq. The snapshot data is read from its blob.
+func (d *timeoutDatabase) FindClosestSnapshotInfo(
+	ctx context.Context,
+	docID types.ID,
+	serverSeq uint64,
+) (*SnapshotInfo, error) {
+	ctx, done := d.begin(ctx, "FindClosestSnapshotInfo")
+	result, err := d.db.FindClosestSnapshotInfo(ctx, docID, serverSeq)
+	return result, done(err)
+}
+
+// FindSnapshotBlobInfo returns the snapshot blob of the given checksum.
+func (d *timeoutDatabase) FindSnapshotBlobInfo(ctx context.Context, checksum string) (*SnapshotBlobInfo, error) {
+	ctx, done := d.begin(ctx, "FindSnapshotBlobInfo")
+	result, err := d.db.FindSnapshotBlobInfo(ctx, checksum)
+	return result, done(err)
+}
+
+// FindSnapshotInfosToUpgrade returns at most limit snapshot infos whose format
+// version is older than the given version.
+func (d *timeoutDatabase) FindSnapshotInfosToUpgrade(
+	ctx context.Context,
+	version int32,
+	limit int,
+) ([]*SnapshotInfo, error) {
+	ctx, done := d.begin(ctx, "FindSnapshotInfosToUpgrade")
+	result, err := d.db.FindSnapshotInfosToUpgrade(ctx, version, limit)
+	return result, done(err)
+}
+
+// CountSnapshotInfosToUpgrade returns the number of all the snapshot infos and
+// the number of the ones whose format version is older than the given version.
+func (d *timeoutDatabase) CountSnapshotInfosToUpgrade(ctx context.Context, version int32) (int64, int64, error) {
+	ctx, done := d.begin(ctx, "CountSnapshotInfosToUpgrade")
+	total, outdated, err := d.db.CountSnapshotInfosToUpgrade(ctx, version)
+	return total, outdated, done(err)
+}
+
+// FindSnapshotInfos returns the snapshot infos of the given document from
+// the latest one. The snapshot data is not included.
+func (d *timeoutDatabase) FindSnapshotInfos(ctx context.Context, docID types.ID) ([]*SnapshotInfo, error) {
+	ctx, done := d.begin(ctx, "FindSnapshotInfos")
+	result, err := d.db.FindSnapshotInfos(ctx, docID)
+	return result, done(err)
+}
+
+// RemoveSnapshotInfos removes the given snapshot infos of the given
+// document. The blobs which are no longer referenced are removed too.
+func (d *timeoutDatabase) RemoveSnapshotInfos(
+	ctx context.Context,
+	docID types.ID,
+	snapshotIDs []types.ID,
+) error {
+	ctx, done := d.begin(ctx, "RemoveSnapshotInfos")
+	return done(d.db.RemoveSnapshotInfos(ctx, docID, snapshotIDs))
+}
+
+// UpdateAndFindMinSyncedTicket updates the given serverSeq of the given client
+// and returns the min synced ticket.
+func (d *timeoutDatabase) UpdateAndFindMinSyncedTicket(
+	ctx context.Context,
+	clientInfo *ClientInfo,
+	docID types.ID,
+	serverSeq uint64,
+) (*time.Ticket, error) {
+	ctx, done := d.begin(ctx, "UpdateAndFindMinSyncedTicket")
+	result, err := d.db.UpdateAndFindMinSyncedTicket(ctx, clientInfo, docID, serverSeq)
+	return result, done(err)
+}
+
+// UpdateSyncedSeq updates the syncedSeq of the given client.
+func (d *timeoutDatabase) UpdateSyncedSeq(
+	ctx context.Context,
+	clientInfo *ClientInfo,
+	docID types.ID,
+	serverSeq uint64,
+) error {
+	ctx, done := d.begin(ctx, "UpdateSyncedSeq")
+	return done(d.db.UpdateSyncedSeq(ctx, clientInfo, docID, serverSeq))
+}
+
+// FindDocInfosByPaging returns the documentInfos of the given paging. If
+// the paging has SnapshotAt, it returns the documents which existed at
+// that time including the ones removed after it.
+func (d *timeoutDatabase) FindDocInfosByPaging(
+	ctx context.Context,
+	projectID types.ID,
+	paging types.Paging[types.ID],
+) ([]*DocInfo, error) {
+	ctx, done := d.begin(ctx, "FindDocInfosByPaging")
+	result, err := d.db.FindDocInfosByPaging(ctx, projectID, paging)
+	return result, done(err)
+}
+
+// FindDocInfosByQuery returns the documentInfos which match the given query.
+func (d *timeoutDatabase) FindDocInfosByQuery(
+	ctx context.Context,
+	projectID types.ID,
+	query string,
+	pageSize int,
+) (*types.SearchResult[*DocInfo], error) {
+	ctx, done := d.begin(ctx, "FindDocInfosByQuery")
+	result, err := d.db.FindDocInfosByQuery(ctx, projectID, query, pageSize)
+	return result, done(err)
+}
+
+// FindDocInfosByKeyPrefix returns at most limit documentInfos whose keys
+// start with the given prefix, in ascending order of ID after the given
+// offset.
+func (d *timeoutDatabase) FindDocInfosByKeyPrefix(
+	ctx context.Context,
+	projectID types.ID,
+	prefix string,
+	offset types.ID,
+	limit int,
+) ([]*DocInfo, error) {
+	ctx, done := d.begin(ctx, "FindDocInfosByKeyPrefix")
+	result, err := d.db.FindDocInfosByKeyPrefix(ctx, projectID, prefix, offset, limit)
+	return result, done(err)
+}
+
+// RemoveDocInfo soft-removes the document of the given ID.
+func (d *timeoutDatabase) RemoveDocInfo(ctx context.Context, projectID, docID types.ID) error {
+	ctx, done := d.begin(ctx, "RemoveDocInfo")
+	return done(d.db.RemoveDocInfo(ctx, projectID, docID))
+}
+
+// FindRemovedDocInfoByKey finds the document of the given key removed most
+// recently.
+func (d *timeoutDatabase) FindRemovedDocInfoByKey(
+	ctx context.Context,
+	projectID types.ID,
+	docKey key.Key,
+) (*DocInfo, error) {
+	ctx, done := d.begin(ctx, "FindRemovedDocInfoByKey")
+	result, err := d.db.FindRemovedDocInfoByKey(ctx, projectID, docKey)
+	return result, done(err)
+}
+
+// RestoreDocInfo restores the removed document of the given ID.
+func (d *timeoutDatabase) RestoreDocInfo(ctx context.Context, projectID, docID types.ID) error {
+	ctx, done := d.begin(ctx, "RestoreDocInfo")
+	return done(d.db.RestoreDocInfo(ctx, projectID, docID))
+}
+
+// UpdateDocInfoLock locks or unlocks the document of the given ID. The
+// reason is cleared when unlocking.
+func (d *timeoutDatabase) UpdateDocInfoLock(
+	ctx context.Context,
+	projectID,
+	docID types.ID,
+	locked bool,
+	reason string,
+) error {
+	ctx, done := d.begin(ctx, "UpdateDocInfoLock")
+	return done(d.db.UpdateDocInfoLock(ctx, projectID, docID, locked, reason))
+}
+
+// UpdateDocInfoMetadata replaces the metadata of the document of the given
+// ID with the given metadata.
+func (d *timeoutDatabase) UpdateDocInfoMetadata(
+	ctx context.Context,
+	projectID types.ID,
+	docID types.ID,
+	metadata map[string]string,
+) error {
+	ctx, done := d.begin(ctx, "UpdateDocInfoMetadata")
+	return done(d.db.UpdateDocInfoMetadata(ctx, projectID, docID, metadata))
+}
+
+// MoveDocInfo moves the document of the given ID to the destination
+// project. The clients attached to the document are detached, and the
+// actors of the document are cleared.
+func (d *timeoutDatabase) MoveDocInfo(ctx context.Context, srcProjectID, docID, dstProjectID types.ID) error {
+	ctx, done := d.begin(ctx, "MoveDocInfo")
+	return done(d.db.MoveDocInfo(ctx, srcProjectID, docID, dstProjectID))
+}
+
+// FindDocInfosToArchive returns at most limit documents of the given
+// project which are neither removed nor archived, and have been neither
+// created nor updated since the given time.
+func (d *timeoutDatabase) FindDocInfosToArchive(
+	ctx context.Context,
+	projectID types.ID,
+	inactiveSince gotime.Time,
+	limit int,
+) ([]*DocInfo, error) {
+	ctx, done := d.begin(ctx, "FindDocInfosToArchive")
+	result, err := d.db.FindDocInfosToArchive(ctx, projectID, inactiveSince, limit)
+	return result, done(err)
+}
+
+// ArchiveDocInfo archives the document of the given ID. The clients
+// attached to the document are detached, the actors of the document are
+// cleared and the changes and the event log entries before the given
+// serverSeq are removed. The change of the serverSeq is kept to find the
+// ticket of the clients synced to it.
+func (d *timeoutDatabase) ArchiveDocInfo(
+	ctx context.Context,
+	projectID,
+	docID types.ID,
+	serverSeq uint64,
+) error {
+	ctx, done := d.begin(ctx, "ArchiveDocInfo")
+	return done(d.db.ArchiveDocInfo(ctx, projectID, docID, serverSeq))
+}
+
+// UnarchiveDocInfo unarchives the document of the given ID. The document
+// is regarded as updated so that it is not archived again right away.
+func (d *timeoutDatabase) UnarchiveDocInfo(ctx context.Context, projectID, docID types.ID) error {
+	ctx, done := d.begin(ctx, "UnarchiveDocInfo")
+	return done(d.db.UnarchiveDocInfo(ctx, projectID, docID))
+}
+
+// CreateDocClientEventInfo stores the event of the given client on the
+// given document.
+func (d *timeoutDatabase) CreateDocClientEventInfo(
+	ctx context.Context,
+	docID types.ID,
+	clientID types.ID,
+	eventType types.DocumentClientEventType,
+) error {
+	ctx, done := d.begin(ctx, "CreateDocClientEventInfo")
+	return done(d.db.CreateDocClientEventInfo(ctx, docID, clientID, eventType))
+}
+
+// FindDocClientEventInfos returns the events of clients on the given
+// document which occurred in [from, to) in order of ID. Zero from or to
+// means no bound.
+func (d *timeoutDatabase) FindDocClientEventInfos(
+	ctx context.Context,
+	docID types.ID,
+	from gotime.Time,
+	to gotime.Time,
+	paging types.Paging[types.ID],
+) ([]*DocClientEventInfo, error) {
+	ctx, done := d.begin(ctx, "FindDocClientEventInfos")
+	result, err := d.db.FindDocClientEventInfos(ctx, docID, from, to, paging)
+	return result, done(err)
+}
+
+// RemoveDocClientEventInfosBefore removes the events of clients which
+// occurred before the given time and returns the number of removed events.
+func (d *timeoutDatabase) RemoveDocClientEventInfosBefore(
+	ctx context.Context,
+	before gotime.Time,
+) (int, error) {
+	ctx, done := d.begin(ctx, "RemoveDocClientEventInfosBefore")
+	result, err := d.db.RemoveDocClientEventInfosBefore(ctx, before)
+	return result, done(err)
+}
+
+// CountDocInfos returns the number of the documents of the given project,
+// excluding the removed ones.
+func (d *timeoutDatabase) CountDocInfos(ctx context.Context, projectID types.ID) (int, error) {
+	ctx, done := d.begin(ctx, "CountDocInfos")
+	result, err := d.db.CountDocInfos(ctx, projectID)
+	return result, done(err)
+}
+
+// AddDocActor adds the given actor to the actors of the given document. It
+// returns ErrTooManyActors if the actor is new and the document already has
+// maxActors actors. Zero maxActors means no limit.
+func (d *timeoutDatabase) AddDocActor(ctx context.Context, docID, actorID types.ID, maxActors int) error {
+	ctx, done := d.begin(ctx, "AddDocActor")
+	return done(d.db.AddDocActor(ctx, docID, actorID, maxActors))
+}
+
+// RemoveDocActors removes the given actors from the actors of the given
+// document.
+func (d *timeoutDatabase) RemoveDocActors(ctx context.Context, docID types.ID, actorIDs []types.ID) error {
+	ctx, done := d.begin(ctx, "RemoveDocActors")
+	return done(d.db.RemoveDocActors(ctx, docID, actorIDs))
+}
+
+// FindDocInfosWithActors returns at most limit documentInfos which have
+// actors, in ascending order of ID after the given offset.
+func (d *timeoutDatabase) FindDocInfosWithActors(
+	ctx context.Context,
+	offset types.ID,
+	limit int,
+) ([]*DocInfo, error) {
+	ctx, done := d.begin(ctx, "FindDocInfosWithActors")
+	result, err := d.db.FindDocInfosWithActors(ctx, offset, limit)
+	return result, done(err)
+}
+
+// FindDocInfosWithGCInterval returns at most limit documentInfos which have
+// the GC interval in their metadata, in ascending order of ID after the given
+// offset.
+func (d *timeoutDatabase) FindDocInfosWithGCInterval(
+	ctx context.Context,
+	offset types.ID,
+	limit int,
+) ([]*DocInfo, error) {
+	ctx, done := d.begin(ctx, "FindDocInfosWithGCInterval")
+	result, err := d.db.FindDocInfosWithGCInterval(ctx, offset, limit)
+	return result, done(err)
+}
+
+// FindMinSyncedSeqInfo returns the syncedSeqInfo of the given document with
+// the smallest serverSeq. It returns nil if no client attaches the document.
+func (d *timeoutDatabase) FindMinSyncedSeqInfo(ctx context.Context, docID types.ID) (*SyncedSeqInfo, error) {
+	ctx, done := d.begin(ctx, "FindMinSyncedSeqInfo")
+	result, err := d.db.FindMinSyncedSeqInfo(ctx, docID)
+	return result, done(err)
+}
+
+// CreateDocEventLogInfos appends the given entries to the event logs of
+// their documents.
+func (d *timeoutDatabase) CreateDocEventLogInfos(ctx context.Context, infos []*DocEventLogInfo) error {
+	ctx, done := d.begin(ctx, "CreateDocEventLogInfos")
+	return done(d.db.CreateDocEventLogInfos(ctx, infos))
+}
+
+// FindDocEventLogInfos returns the entries of the event log of the given
+// document whose server sequences are in [from, to].
+func (d *timeoutDatabase) FindDocEventLogInfos(
+	ctx context.Context,
+	docID types.ID,
+	from uint64,
+	to uint64,
+) ([]*DocEventLogInfo, error) {
+	ctx, done := d.begin(ctx, "FindDocEventLogInfos")
+	result, err := d.db.FindDocEventLogInfos(ctx, docID, from, to)
+	return result, done(err)
+}
+
+// RemoveDocEventLogInfosBefore removes the entries of the event logs
+// created before the given time.
+func (d *timeoutDatabase) RemoveDocEventLogInfosBefore(ctx context.Context, before gotime.Time) (int, error) {
+	ctx, done := d.begin(ctx, "RemoveDocEventLogInfosBefore")
+	result, err := d.db.RemoveDocEventLogInfosBefore(ctx, before)
+	return result, done(err)
+}
+
+// FindMaintenanceInfo returns the maintenance mode of the server. It
+// returns a disabled one if the mode has never been updated.
+func (d *timeoutDatabase) FindMaintenanceInfo(ctx context.Context) (*MaintenanceInfo, error) {
+	ctx, done := d.begin(ctx, "FindMaintenanceInfo")
+	result, err := d.db.FindMaintenanceInfo(ctx)
+	return result, done(err)
+}
+
+// UpdateMaintenanceInfo updates the maintenance mode of the server and
+// returns the updated one.
+func (d *timeoutDatabase) UpdateMaintenanceInfo(
+	ctx context.Context,
+	enabled bool,
+	message string,
+) (*MaintenanceInfo, error) {
+	ctx, done := d.begin(ctx, "UpdateMaintenanceInfo")
+	result, err := d.db.UpdateMaintenanceInfo(ctx, enabled, message)
+	return result, done(err)
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/database/memory"
+)
+
+// stuckDB is a Database whose FindProjectInfoByName does not complete until
+// the given context is done.
+type stuckDB struct {
+	database.Database
+}
+
+func (d *stuckDB) FindProjectInfoByName(ctx context.Context, _ string) (*database.ProjectInfo, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestQueryTimeout(t *testing.T) {
+	idGenerator, err := database.NewIDGenerator("objectid", nil)
+	assert.NoError(t, err)
+	memDB, err := memory.New(idGenerator)
+	assert.NoError(t, err)
+	stuck := &stuckDB{Database: memDB}
+
+	t.Run("timeout test", func(t *testing.T) {
+		var timedOut []string
+		db := database.WithQueryTimeout(stuck, 10*time.Millisecond, nil, func(operation string) {
+			timedOut = append(timedOut, operation)
+		})
+
+		_, err := db.FindProjectInfoByName(context.Background(), "stuck")
+		assert.ErrorIs(t, err, database.ErrQueryTimeout)
+		assert.Equal(t, []string{"FindProjectInfoByName"}, timedOut)
+
+		// 01. The other operations complete within the timeout.
+		info, err := db.EnsureDefaultProjectInfo(context.Background())
+		assert.NoError(t, err)
+		found, err := db.FindProjectInfoByID(context.Background(), info.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, info.Name, found.Name)
+		assert.Len(t, timedOut, 1)
+	})
+
+	t.Run("override test", func(t *testing.T) {
+		db := database.WithQueryTimeout(stuck, time.Hour, map[string]time.Duration{
+			"FindProjectInfoByName": 10 * time.Millisecond,
+		}, nil)
+		_, err := db.FindProjectInfoByName(context.Background(), "stuck")
+		assert.ErrorIs(t, err, database.ErrQueryTimeout)
+
+		// 01. Zero disables the timeout of the operation.
+		db = database.WithQueryTimeout(stuck, 10*time.Millisecond, map[string]time.Duration{
+			"FindProjectInfoByName": 0,
+		}, nil)
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err = db.FindProjectInfoByName(ctx, "stuck")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.NotErrorIs(t, err, database.ErrQueryTimeout)
+	})
+
+	t.Run("canceled context test", func(t *testing.T) {
+		db := database.WithQueryTimeout(stuck, time.Hour, nil, func(operation string) {
+			assert.Fail(t, "unexpected timeout of "+operation)
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := db.FindProjectInfoByName(ctx, "stuck")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.NotErrorIs(t, err, database.ErrQueryTimeout)
+	})
+
+	t.Run("operation name test", func(t *testing.T) {
+		assert.True(t, database.IsOperation("FindDocInfosByPaging"))
+		assert.True(t, database.IsOperation("CreateChangeInfos"))
+		assert.False(t, database.IsOperation("Close"))
+		assert.False(t, database.IsOperation("FindEverything"))
+	})
+}
@@ -17,6 +17,8 @@
 package database
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"time"
 
 	"github.com/yorkie-team/yorkie/api/types"
@@ -36,9 +38,63 @@ type SnapshotInfo struct {
 	// Lamport is the Lamport timestamp of the snapshot.
 	Lamport uint64 `bson:"lamport"`
 
+	// Snapshot is the snapshot data. It is not stored in the snapshot info if
+	// Checksum is set, but read from the blob of the checksum.
+	Snapshot []byte `bson:"snapshot,omitempty"`
+
+	// Checksum is the checksum of the snapshot data, which is the ID of the
+	// blob storing the data. It is empty for the snapshots which store the
+	// data inline, created before the blobs are introduced.
+	Checksum string `bson:"checksum,omitempty"`
+
+	// VersionVector is the encoded version vector of the snapshot. It is
+	// empty for the snapshots created before it is recorded.
+	VersionVector []byte `bson:"version_vector,omitempty"`
+
+	// Version is the format version of the snapshot data. It is zero for the
+	// snapshots created before it is recorded, whose format is unknown until
+	// the data is decoded.
+	Version int32 `bson:"version,omitempty"`
+
+	// GarbageLen is the number of the tombstones in the snapshot which are
+	// not collected yet.
+	GarbageLen int `bson:"garbage_len,omitempty"`
+
+	// CreatedAt is the time when the snapshot is created.
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// ToSnapshotMeta converts the SnapshotInfo to SnapshotMeta.
+func (i *SnapshotInfo) ToSnapshotMeta() *types.SnapshotMeta {
+	return &types.SnapshotMeta{
+		ServerSeq:  i.ServerSeq,
+		Lamport:    i.Lamport,
+		GarbageLen: i.GarbageLen,
+		CreatedAt:  i.CreatedAt,
+	}
+}
+
+// SnapshotBlobInfo is a structure representing the snapshot data shared by
+// the snapshots with the same content, e.g. the initial snapshots of the
+// documents created from the same template.
+type SnapshotBlobInfo struct {
+	// ID is the checksum of the snapshot data.
+	ID string `bson:"_id"`
+
 	// Snapshot is the snapshot data.
 	Snapshot []byte `bson:"snapshot"`
 
-	// CreatedAt is the time when the snapshot is created.
+	// RefCount is the number of the snapshots referencing this blob. The blob
+	// is removed when it is no longer referenced.
+	RefCount int64 `bson:"ref_count"`
+
+	// CreatedAt is the time when the blob is created.
 	CreatedAt time.Time `bson:"created_at"`
 }
+
+// SnapshotChecksum returns the checksum of the given snapshot data, which is
+// used as the ID of its blob.
+func SnapshotChecksum(snapshot []byte) string {
+	sum := sha256.Sum256(snapshot)
+	return hex.EncodeToString(sum[:])
+}
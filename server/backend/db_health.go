@@ -0,0 +1,212 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"context"
+	"errors"
+	gosync "sync"
+	"time"
+
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/logging"
+	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
+)
+
+var (
+	// ErrDBUnavailable is returned when a request is made or in progress
+	// while the connection to the database is lost.
+	ErrDBUnavailable = errors.New("database unavailable")
+)
+
+// DBHealth supervises the connection to the database. It checks the
+// connection periodically, and while the connection is lost, it reconnects
+// with exponential backoff and lets the requests fail fast rather than hang.
+type DBHealth struct {
+	db         database.Database
+	interval   time.Duration
+	maxBackoff time.Duration
+	metrics    *prometheus.Metrics
+
+	mu        gosync.Mutex
+	connected bool
+	listeners []func(connected bool)
+
+	// inflight is the cancel functions of the requests in progress, which are
+	// called when the connection is lost.
+	inflight  map[uint64]context.CancelFunc
+	requestID uint64
+
+	ctx        context.Context
+	cancelFunc context.CancelFunc
+	wg         gosync.WaitGroup
+}
+
+// newDBHealth creates a new instance of DBHealth. The connection is regarded
+// as alive until the check fails.
+func newDBHealth(
+	db database.Database,
+	interval time.Duration,
+	maxBackoff time.Duration,
+	metrics *prometheus.Metrics,
+) *DBHealth {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	metrics.SetBackendDBConnected(true)
+
+	return &DBHealth{
+		db:         db,
+		interval:   interval,
+		maxBackoff: maxBackoff,
+		metrics:    metrics,
+		connected:  true,
+		inflight:   make(map[uint64]context.CancelFunc),
+		ctx:        ctx,
+		cancelFunc: cancelFunc,
+	}
+}
+
+// start starts the loop checking the connection. It does nothing if the
+// interval is not positive.
+func (h *DBHealth) start() {
+	if h.interval <= 0 {
+		return
+	}
+
+	h.wg.Add(1)
+	go h.run()
+}
+
+// close stops the loop checking the connection.
+func (h *DBHealth) close() {
+	h.cancelFunc()
+	h.wg.Wait()
+}
+
+// run checks the connection at the interval, and reconnects with backoff
+// while the connection is lost.
+func (h *DBHealth) run() {
+	defer h.wg.Done()
+
+	wait := h.interval
+	for {
+		select {
+		case <-time.After(wait):
+		case <-h.ctx.Done():
+			return
+		}
+
+		if h.Connected() {
+			if err := h.db.Ping(h.ctx); err != nil && h.ctx.Err() == nil {
+				logging.DefaultLogger().Errorf("DB: connection lost: %v", err)
+				h.setConnected(false)
+			}
+			continue
+		}
+
+		err := h.db.Reconnect(h.ctx)
+		if h.ctx.Err() != nil {
+			return
+		}
+		h.metrics.AddBackendDBReconnectAttempts(err == nil)
+		if err != nil {
+			if wait *= 2; wait > h.maxBackoff {
+				wait = h.maxBackoff
+			}
+			if wait < h.interval {
+				wait = h.interval
+			}
+			logging.DefaultLogger().Warnf("DB: fail to reconnect, retry in %s: %v", wait, err)
+			continue
+		}
+
+		logging.DefaultLogger().Infof("DB: connection restored")
+		h.setConnected(true)
+		wait = h.interval
+	}
+}
+
+// Connected returns whether the connection to the database is alive.
+func (h *DBHealth) Connected() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.connected
+}
+
+// Check returns ErrDBUnavailable if the connection to the database is lost.
+func (h *DBHealth) Check() error {
+	if !h.Connected() {
+		return ErrDBUnavailable
+	}
+	return nil
+}
+
+// Begin registers a request in progress. It returns the context of the
+// request, which is canceled when the connection is lost, and the function to
+// be called when the request is done. It returns ErrDBUnavailable if the
+// connection is already lost.
+func (h *DBHealth) Begin(ctx context.Context) (context.Context, func(), error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.connected {
+		return nil, nil, ErrDBUnavailable
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	h.requestID++
+	id := h.requestID
+	h.inflight[id] = cancel
+
+	return ctx, func() {
+		h.mu.Lock()
+		delete(h.inflight, id)
+		h.mu.Unlock()
+		cancel()
+	}, nil
+}
+
+// Watch registers the given function to be called whenever the connection is
+// lost or restored. It is also called with the current state right away.
+func (h *DBHealth) Watch(fn func(connected bool)) {
+	h.mu.Lock()
+	h.listeners = append(h.listeners, fn)
+	connected := h.connected
+	h.mu.Unlock()
+
+	fn(connected)
+}
+
+// setConnected updates the state of the connection, cancels the requests in
+// progress if the connection is lost, and notifies the listeners.
+func (h *DBHealth) setConnected(connected bool) {
+	h.mu.Lock()
+	h.connected = connected
+	if !connected {
+		for id, cancel := range h.inflight {
+			cancel()
+			delete(h.inflight, id)
+		}
+	}
+	listeners := append([]func(bool){}, h.listeners...)
+	h.mu.Unlock()
+
+	h.metrics.SetBackendDBConnected(connected)
+	for _, fn := range listeners {
+		fn(connected)
+	}
+}
@@ -0,0 +1,295 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package hotdocs detects the documents whose change rate exceeds the
+// threshold of the server.
+package hotdocs
+
+import (
+	"hash/fnv"
+	"sort"
+	gosync "sync"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+const (
+	// sketchDepth and sketchWidth are the dimensions of the count-min sketch
+	// counting the operations of the documents in a window.
+	sketchDepth = 4
+	sketchWidth = 2048
+
+	// maxHotDocuments is the max number of hot documents tracked at once.
+	maxHotDocuments = 1000
+)
+
+// sketch is a count-min sketch. It estimates the count of each document in
+// fixed memory, and the estimate is never less than the actual count.
+type sketch [sketchDepth][sketchWidth]uint32
+
+// add adds the given count to the document of the given hash and returns the
+// estimated count of the document.
+func (s *sketch) add(h uint64, count uint32) uint32 {
+	estimate := ^uint32(0)
+	for i := 0; i < sketchDepth; i++ {
+		cell := &s[i][index(h, i)]
+		*cell += count
+		if *cell < estimate {
+			estimate = *cell
+		}
+	}
+	return estimate
+}
+
+// estimate returns the estimated count of the document of the given hash.
+func (s *sketch) estimate(h uint64) uint32 {
+	estimate := ^uint32(0)
+	for i := 0; i < sketchDepth; i++ {
+		if cell := s[i][index(h, i)]; cell < estimate {
+			estimate = cell
+		}
+	}
+	return estimate
+}
+
+// index returns the column of the given row for the given hash. The columns
+// of the rows are derived from the two halves of the hash.
+func index(h uint64, row int) uint32 {
+	return (uint32(h) + uint32(row)*uint32(h>>32)) % sketchWidth
+}
+
+// hotDocument is a document detected as hot. The operations of each actor
+// are counted only while the document is hot.
+type hotDocument struct {
+	projectID  types.ID
+	docKey     key.Key
+	hash       uint64
+	detectedAt gotime.Time
+	previous   map[types.ID]int
+	current    map[types.ID]int
+}
+
+// Detector detects the documents whose change rate exceeds the threshold.
+//
+// The rate is measured with a sliding window approximated by two fixed
+// windows: the count of the previous window is weighted by its overlap with
+// the sliding window. The counts of the windows are kept in count-min
+// sketches, so the memory is bounded regardless of the number of documents,
+// and only the hot documents are tracked individually.
+//
+// The operations of each project are also counted in the windows, so that
+// the change rate of the project is measured even if the detection is
+// disabled.
+type Detector struct {
+	threshold float64
+	window    gotime.Duration
+
+	mu              gosync.Mutex
+	windowStart     gotime.Time
+	previous        *sketch
+	current         *sketch
+	hot             map[types.ID]*hotDocument
+	previousProject map[types.ID]uint32
+	currentProject  map[types.ID]uint32
+}
+
+// New creates a new instance of Detector. The documents whose operations per
+// second exceed the given threshold are detected as hot. If the threshold is
+// 0, the detection is disabled.
+func New(threshold float64, window gotime.Duration) *Detector {
+	return &Detector{
+		threshold:   threshold,
+		window:      window,
+		windowStart: gotime.Now(),
+		previous:    &sketch{},
+		current:     &sketch{},
+		hot:         make(map[types.ID]*hotDocument),
+
+		previousProject: make(map[types.ID]uint32),
+		currentProject:  make(map[types.ID]uint32),
+	}
+}
+
+// Record records the given number of operations pushed to the given document
+// by the given actor. It returns true if the document becomes hot.
+func (d *Detector) Record(
+	projectID types.ID,
+	docID types.ID,
+	docKey key.Key,
+	actorID types.ID,
+	ops int,
+) bool {
+	if ops <= 0 {
+		return false
+	}
+
+	now := gotime.Now()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.rotate(now)
+	d.currentProject[projectID] += uint32(ops)
+	if d.threshold <= 0 {
+		return false
+	}
+
+	h := hash(docID)
+	count := d.current.add(h, uint32(ops))
+
+	if doc, ok := d.hot[docID]; ok {
+		doc.current[actorID] += ops
+		return false
+	}
+
+	if d.rate(d.previous.estimate(h), count, now) <= d.threshold || len(d.hot) >= maxHotDocuments {
+		return false
+	}
+
+	d.hot[docID] = &hotDocument{
+		projectID:  projectID,
+		docKey:     docKey,
+		hash:       h,
+		detectedAt: now,
+		previous:   make(map[types.ID]int),
+		current:    map[types.ID]int{actorID: ops},
+	}
+	return true
+}
+
+// HotDocuments returns the hot documents of the given project in descending
+// order of the change rate.
+func (d *Detector) HotDocuments(projectID types.ID) []*types.HotDocument {
+	now := gotime.Now()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.rotate(now)
+
+	var docs []*types.HotDocument
+	for docID, doc := range d.hot {
+		rate := d.rate(d.previous.estimate(doc.hash), d.current.estimate(doc.hash), now)
+		if rate <= d.threshold {
+			delete(d.hot, docID)
+			continue
+		}
+		if doc.projectID != projectID {
+			continue
+		}
+
+		hotDoc := &types.HotDocument{
+			DocumentKey:  doc.docKey,
+			OpsPerSecond: rate,
+			DetectedAt:   doc.detectedAt,
+		}
+		for actorID := range doc.actors() {
+			actorRate := d.rate(uint32(doc.previous[actorID]), uint32(doc.current[actorID]), now)
+			if actorRate > hotDoc.TopActorOpsPerSecond ||
+				(actorRate == hotDoc.TopActorOpsPerSecond && actorID < hotDoc.TopActorID) {
+				hotDoc.TopActorID = actorID
+				hotDoc.TopActorOpsPerSecond = actorRate
+			}
+		}
+		docs = append(docs, hotDoc)
+	}
+
+	sort.Slice(docs, func(i, j int) bool {
+		return docs[i].OpsPerSecond > docs[j].OpsPerSecond
+	})
+
+	return docs
+}
+
+// OpsPerSecond returns the number of operations pushed to the documents of the
+// given project per second in the sliding window.
+func (d *Detector) OpsPerSecond(projectID types.ID) float64 {
+	now := gotime.Now()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.window <= 0 {
+		return 0
+	}
+
+	d.rotate(now)
+	return d.rate(d.previousProject[projectID], d.currentProject[projectID], now)
+}
+
+// rotate moves to the window containing the given time, and removes the hot
+// documents whose rate falls to the threshold.
+func (d *Detector) rotate(now gotime.Time) {
+	elapsed := now.Sub(d.windowStart)
+	if elapsed < d.window {
+		return
+	}
+
+	if elapsed >= 2*d.window {
+		*d.previous = sketch{}
+		*d.current = sketch{}
+		d.hot = make(map[types.ID]*hotDocument)
+		d.previousProject = make(map[types.ID]uint32)
+		d.currentProject = make(map[types.ID]uint32)
+		d.windowStart = now
+		return
+	}
+
+	d.previous, d.current = d.current, d.previous
+	*d.current = sketch{}
+	d.previousProject, d.currentProject = d.currentProject, make(map[types.ID]uint32)
+	d.windowStart = d.windowStart.Add(d.window)
+
+	for docID, doc := range d.hot {
+		if d.rate(d.previous.estimate(doc.hash), 0, d.windowStart) <= d.threshold {
+			delete(d.hot, docID)
+			continue
+		}
+		doc.previous, doc.current = doc.current, make(map[types.ID]int)
+	}
+}
+
+// rate returns the operations per second of the sliding window ending at the
+// given time from the counts of the previous and the current windows.
+func (d *Detector) rate(previous, current uint32, now gotime.Time) float64 {
+	overlap := 1 - float64(now.Sub(d.windowStart))/float64(d.window)
+	if overlap < 0 {
+		overlap = 0
+	}
+	return (float64(previous)*overlap + float64(current)) / d.window.Seconds()
+}
+
+// actors returns the actors which pushed operations to this document in the
+// previous or the current window.
+func (doc *hotDocument) actors() map[types.ID]struct{} {
+	actors := make(map[types.ID]struct{}, len(doc.current))
+	for actorID := range doc.previous {
+		actors[actorID] = struct{}{}
+	}
+	for actorID := range doc.current {
+		actors[actorID] = struct{}{}
+	}
+	return actors
+}
+
+// hash returns the hash of the given document.
+func hash(docID types.ID) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(docID))
+	return h.Sum64()
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hotdocs_test
+
+import (
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/server/backend/hotdocs"
+)
+
+func TestDetector(t *testing.T) {
+	projectA := types.ID("000000000000000000000001")
+	projectB := types.ID("000000000000000000000002")
+	doc1 := types.ID("000000000000000000000011")
+	doc2 := types.ID("000000000000000000000012")
+	actorA := types.ID("000000000000000000000021")
+	actorB := types.ID("000000000000000000000022")
+
+	t.Run("detect hot document test", func(t *testing.T) {
+		detector := hotdocs.New(10, gotime.Second)
+
+		assert.False(t, detector.Record(projectA, doc1, "doc1", actorA, 5))
+		assert.Empty(t, detector.HotDocuments(projectA))
+
+		// the rate of doc1 exceeds the threshold with the operations of actorB.
+		assert.True(t, detector.Record(projectA, doc1, "doc1", actorB, 20))
+		assert.False(t, detector.Record(projectA, doc1, "doc1", actorA, 5))
+		assert.False(t, detector.Record(projectB, doc2, "doc2", actorA, 1))
+
+		docs := detector.HotDocuments(projectA)
+		assert.Len(t, docs, 1)
+		assert.Equal(t, key.Key("doc1"), docs[0].DocumentKey)
+		assert.Greater(t, docs[0].OpsPerSecond, float64(10))
+		assert.Equal(t, actorB, docs[0].TopActorID)
+		assert.Greater(t, docs[0].OpsPerSecond, docs[0].TopActorOpsPerSecond)
+		assert.False(t, docs[0].DetectedAt.IsZero())
+
+		assert.Empty(t, detector.HotDocuments(projectB))
+	})
+
+	t.Run("cool down test", func(t *testing.T) {
+		window := 20 * gotime.Millisecond
+		detector := hotdocs.New(10, window)
+
+		assert.True(t, detector.Record(projectA, doc1, "doc1", actorA, 100))
+		assert.Len(t, detector.HotDocuments(projectA), 1)
+
+		// the document is no longer hot after the sliding window passes.
+		gotime.Sleep(2 * window)
+		assert.Empty(t, detector.HotDocuments(projectA))
+		assert.False(t, detector.Record(projectA, doc1, "doc1", actorA, 0))
+	})
+
+	t.Run("disabled test", func(t *testing.T) {
+		detector := hotdocs.New(0, gotime.Second)
+		assert.False(t, detector.Record(projectA, doc1, "doc1", actorA, 1000))
+		assert.Empty(t, detector.HotDocuments(projectA))
+	})
+
+	t.Run("ops per second of project test", func(t *testing.T) {
+		detector := hotdocs.New(0, gotime.Second)
+		detector.Record(projectA, doc1, "doc1", actorA, 10)
+		detector.Record(projectA, doc2, "doc2", actorB, 10)
+		assert.Equal(t, float64(20), detector.OpsPerSecond(projectA))
+		assert.Zero(t, detector.OpsPerSecond(projectB))
+
+		// the operations are forgotten after the sliding window passes.
+		window := 20 * gotime.Millisecond
+		detector = hotdocs.New(0, window)
+		detector.Record(projectA, doc1, "doc1", actorA, 10)
+		gotime.Sleep(2 * window)
+		assert.Zero(t, detector.OpsPerSecond(projectA))
+	})
+}
@@ -18,9 +18,12 @@ package housekeeping
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	gosync "sync"
 	"time"
 
+	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/server/backend/database"
 	"github.com/yorkie-team/yorkie/server/backend/sync"
 	"github.com/yorkie-team/yorkie/server/clients"
@@ -29,8 +32,32 @@ import (
 
 const (
 	deactivateCandidatesKey = "housekeeping/deactivateCandidates"
+	pruneDocActorsKey       = "housekeeping/pruneDocActors"
+	removeClientEventsKey   = "housekeeping/removeClientEvents"
+	removeDocEventLogsKey   = "housekeeping/removeDocEventLogs"
+	archiveDocumentsKey     = "housekeeping/archiveDocuments"
+	upgradeSnapshotsKey     = "housekeeping/upgradeSnapshots"
+	collectGarbageKey       = "housekeeping/collectGarbage"
 )
 
+// gcCheckInterval is the time between the runs collecting the garbage of the
+// documents with their own GC intervals. It is the minimum GC interval of a
+// document, so that every interval is honored.
+const gcCheckInterval = types.MinDocumentGCInterval
+
+// ArchiveFunc archives at most limit documents which have been inactive for the
+// archive period of their projects, and returns the number of them.
+type ArchiveFunc func(ctx context.Context, limit int) (int, error)
+
+// UpgradeSnapshotsFunc rewrites at most limit snapshots stored in old formats
+// in the current format, and returns the number of them.
+type UpgradeSnapshotsFunc func(ctx context.Context, limit int) (int, error)
+
+// CollectGarbageFunc collects the garbage of at most limit documents after the
+// given offset which have their own GC intervals. It returns the offset of the
+// next run and the number of the collected documents.
+type CollectGarbageFunc func(ctx context.Context, offset types.ID, limit int) (types.ID, int, error)
+
 // Config is the configuration for the housekeeping service.
 type Config struct {
 	// Interval is the time between housekeeping runs.
@@ -42,6 +69,18 @@ type Config struct {
 
 	// CandidatesLimit is the maximum number of candidates to be returned.
 	CandidatesLimit int `yaml:"CandidatesLimit"`
+
+	// ClientEventRetention is the time for which the events of clients on
+	// documents are kept.
+	ClientEventRetention string `yaml:"ClientEventRetention"`
+
+	// DocEventLogRetention is the time for which the entries of the event
+	// logs of documents are kept.
+	DocEventLogRetention string `yaml:"DocEventLogRetention"`
+
+	// SnapshotUpgradeLimit is the maximum number of snapshots in old formats
+	// to be rewritten in a single housekeeping run. Zero disables the upgrade.
+	SnapshotUpgradeLimit int `yaml:"SnapshotUpgradeLimit"`
 }
 
 // Validate validates the configuration.
@@ -62,19 +101,72 @@ func (c *Config) Validate() error {
 		)
 	}
 
+	if _, err := time.ParseDuration(c.ClientEventRetention); err != nil {
+		return fmt.Errorf(
+			`invalid argument %s for "--housekeeping-client-event-retention" flag: %w`,
+			c.ClientEventRetention,
+			err,
+		)
+	}
+
+	if _, err := time.ParseDuration(c.DocEventLogRetention); err != nil {
+		return fmt.Errorf(
+			`invalid argument %s for "--housekeeping-doc-event-log-retention" flag: %w`,
+			c.DocEventLogRetention,
+			err,
+		)
+	}
+
+	if c.SnapshotUpgradeLimit < 0 {
+		return fmt.Errorf(
+			`invalid argument %d for "--housekeeping-snapshot-upgrade-limit" flag`,
+			c.SnapshotUpgradeLimit,
+		)
+	}
+
 	return nil
 }
 
 // Housekeeping is the housekeeping service. It periodically runs housekeeping
 // tasks. It is responsible for deactivating clients that have not been active
-// for a long time.
+// for a long time, pruning them from the actors of documents, removing the
+// events of clients on documents and the entries of the event logs of
+// documents after their retention periods, archiving the documents that
+// have been inactive for the archive period of their projects, upgrading
+// the snapshots stored in old formats and collecting the garbage of the
+// documents with their own GC intervals.
 type Housekeeping struct {
 	database    database.Database
 	coordinator sync.Coordinator
 
-	interval            time.Duration
-	deactivateThreshold time.Duration
-	candidatesLimit     int
+	interval             time.Duration
+	deactivateThreshold  time.Duration
+	candidatesLimit      int
+	clientEventRetention time.Duration
+	docEventLogRetention time.Duration
+	snapshotUpgradeLimit int
+
+	// docActorsOffset is the ID of the last document whose actors are pruned.
+	docActorsOffset types.ID
+
+	// archiveFunc archives the inactive documents. It is set by the server
+	// since archiving documents needs the backend.
+	archiveFuncMu gosync.RWMutex
+	archiveFunc   ArchiveFunc
+
+	// upgradeSnapshotsFunc upgrades the snapshots in old formats. It is set
+	// by the server since upgrading snapshots needs the backend.
+	upgradeSnapshotsFuncMu gosync.RWMutex
+	upgradeSnapshotsFunc   UpgradeSnapshotsFunc
+
+	// collectGarbageFunc collects the garbage of the documents with their own
+	// GC intervals. It is set by the server since storing snapshots needs the
+	// backend.
+	collectGarbageFuncMu gosync.RWMutex
+	collectGarbageFunc   CollectGarbageFunc
+
+	// gcOffset is the ID of the last document whose garbage is checked.
+	gcOffset types.ID
 
 	ctx        context.Context
 	cancelFunc context.CancelFunc
@@ -113,24 +205,69 @@ func New(
 		return nil, err
 	}
 
+	clientEventRetention, err := time.ParseDuration(conf.ClientEventRetention)
+	if err != nil {
+		return nil, err
+	}
+
+	docEventLogRetention, err := time.ParseDuration(conf.DocEventLogRetention)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx, cancelFunc := context.WithCancel(context.Background())
 
 	return &Housekeeping{
 		database:    database,
 		coordinator: coordinator,
 
-		interval:            interval,
-		deactivateThreshold: deactivateThreshold,
-		candidatesLimit:     conf.CandidatesLimit,
+		interval:             interval,
+		deactivateThreshold:  deactivateThreshold,
+		candidatesLimit:      conf.CandidatesLimit,
+		clientEventRetention: clientEventRetention,
+		docEventLogRetention: docEventLogRetention,
+		snapshotUpgradeLimit: conf.SnapshotUpgradeLimit,
 
 		ctx:        ctx,
 		cancelFunc: cancelFunc,
 	}, nil
 }
 
+// SetArchiveFunc sets the function to archive the inactive documents.
+func (h *Housekeeping) SetArchiveFunc(fn ArchiveFunc) {
+	h.archiveFuncMu.Lock()
+	defer h.archiveFuncMu.Unlock()
+
+	h.archiveFunc = fn
+}
+
+// SetUpgradeSnapshotsFunc sets the function to upgrade the snapshots stored
+// in old formats.
+func (h *Housekeeping) SetUpgradeSnapshotsFunc(fn UpgradeSnapshotsFunc) {
+	h.upgradeSnapshotsFuncMu.Lock()
+	defer h.upgradeSnapshotsFuncMu.Unlock()
+
+	h.upgradeSnapshotsFunc = fn
+}
+
+// SetCollectGarbageFunc sets the function to collect the garbage of the
+// documents with their own GC intervals.
+func (h *Housekeeping) SetCollectGarbageFunc(fn CollectGarbageFunc) {
+	h.collectGarbageFuncMu.Lock()
+	defer h.collectGarbageFuncMu.Unlock()
+
+	h.collectGarbageFunc = fn
+}
+
+// Interval returns the time between housekeeping runs.
+func (h *Housekeeping) Interval() time.Duration {
+	return h.interval
+}
+
 // Start starts the housekeeping service.
 func (h *Housekeeping) Start() error {
 	go h.run()
+	go h.runGC()
 	return nil
 }
 
@@ -148,6 +285,21 @@ func (h *Housekeeping) run() {
 		if err := h.deactivateCandidates(ctx); err != nil {
 			continue
 		}
+		if err := h.pruneDocActors(ctx); err != nil {
+			continue
+		}
+		if err := h.removeClientEvents(ctx); err != nil {
+			continue
+		}
+		if err := h.removeDocEventLogs(ctx); err != nil {
+			continue
+		}
+		if err := h.archiveDocuments(ctx); err != nil {
+			continue
+		}
+		if err := h.upgradeSnapshots(ctx); err != nil {
+			continue
+		}
 
 		select {
 		case <-time.After(h.interval):
@@ -157,6 +309,21 @@ func (h *Housekeeping) run() {
 	}
 }
 
+// runGC is the loop collecting the garbage of the documents with their own
+// GC intervals. It runs more frequently than the housekeeping loop, so that
+// the intervals shorter than the housekeeping interval are honored.
+func (h *Housekeeping) runGC() {
+	for {
+		_ = h.collectGarbage(context.Background())
+
+		select {
+		case <-time.After(gcCheckInterval):
+		case <-h.ctx.Done():
+			return
+		}
+	}
+}
+
 // deactivateCandidates deactivates candidates.
 func (h *Housekeeping) deactivateCandidates(ctx context.Context) error {
 	start := time.Now()
@@ -209,3 +376,298 @@ func (h *Housekeeping) deactivateCandidates(ctx context.Context) error {
 
 	return nil
 }
+
+// pruneDocActors removes the deactivated clients from the actors of documents
+// once the replicas of the documents have synced all the changes, so that the
+// changes of the clients are fully garbage collected.
+func (h *Housekeeping) pruneDocActors(ctx context.Context) error {
+	start := time.Now()
+	locker, err := h.coordinator.NewLocker(ctx, pruneDocActorsKey)
+	if err != nil {
+		return err
+	}
+
+	if err := locker.Lock(ctx); err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	docInfos, err := h.database.FindDocInfosWithActors(
+		ctx,
+		h.docActorsOffset,
+		h.candidatesLimit,
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(docInfos) < h.candidatesLimit {
+		h.docActorsOffset = ""
+	} else {
+		h.docActorsOffset = docInfos[len(docInfos)-1].ID
+	}
+
+	prunedCount := 0
+	for _, docInfo := range docInfos {
+		minSyncedSeqInfo, err := h.database.FindMinSyncedSeqInfo(ctx, docInfo.ID)
+		if err != nil {
+			return err
+		}
+		if minSyncedSeqInfo != nil && minSyncedSeqInfo.ServerSeq < docInfo.ServerSeq {
+			continue
+		}
+
+		var actorIDs []types.ID
+		for _, actorID := range docInfo.ActorIDs {
+			clientInfo, err := h.database.FindClientInfoByID(ctx, docInfo.ProjectID, actorID)
+			if errors.Is(err, database.ErrClientNotFound) {
+				actorIDs = append(actorIDs, actorID)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			if clientInfo.Status == database.ClientDeactivated {
+				actorIDs = append(actorIDs, actorID)
+			}
+		}
+		if len(actorIDs) == 0 {
+			continue
+		}
+
+		if err := h.database.RemoveDocActors(ctx, docInfo.ID, actorIDs); err != nil {
+			return err
+		}
+
+		prunedCount += len(actorIDs)
+	}
+
+	if prunedCount > 0 {
+		logging.From(ctx).Infof(
+			"HSKP: documents %d, pruned actors %d, %s",
+			len(docInfos),
+			prunedCount,
+			time.Since(start),
+		)
+	}
+
+	return nil
+}
+
+// removeClientEvents removes the events of clients on documents which occurred
+// before the retention period.
+func (h *Housekeeping) removeClientEvents(ctx context.Context) error {
+	start := time.Now()
+	locker, err := h.coordinator.NewLocker(ctx, removeClientEventsKey)
+	if err != nil {
+		return err
+	}
+
+	if err := locker.Lock(ctx); err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	removedCount, err := h.database.RemoveDocClientEventInfosBefore(
+		ctx,
+		start.Add(-h.clientEventRetention),
+	)
+	if err != nil {
+		return err
+	}
+
+	if removedCount > 0 {
+		logging.From(ctx).Infof(
+			"HSKP: removed client events %d, %s",
+			removedCount,
+			time.Since(start),
+		)
+	}
+
+	return nil
+}
+
+// removeDocEventLogs removes the entries of the event logs of documents which
+// are created before the retention period.
+func (h *Housekeeping) removeDocEventLogs(ctx context.Context) error {
+	start := time.Now()
+	locker, err := h.coordinator.NewLocker(ctx, removeDocEventLogsKey)
+	if err != nil {
+		return err
+	}
+
+	if err := locker.Lock(ctx); err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	removedCount, err := h.database.RemoveDocEventLogInfosBefore(
+		ctx,
+		start.Add(-h.docEventLogRetention),
+	)
+	if err != nil {
+		return err
+	}
+
+	if removedCount > 0 {
+		logging.From(ctx).Infof(
+			"HSKP: removed doc event logs %d, %s",
+			removedCount,
+			time.Since(start),
+		)
+	}
+
+	return nil
+}
+
+// archiveDocuments archives the documents which have been inactive for the
+// archive period of their projects.
+func (h *Housekeeping) archiveDocuments(ctx context.Context) error {
+	h.archiveFuncMu.RLock()
+	archiveFunc := h.archiveFunc
+	h.archiveFuncMu.RUnlock()
+	if archiveFunc == nil {
+		return nil
+	}
+
+	start := time.Now()
+	locker, err := h.coordinator.NewLocker(ctx, archiveDocumentsKey)
+	if err != nil {
+		return err
+	}
+
+	if err := locker.Lock(ctx); err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	archivedCount, err := archiveFunc(ctx, h.candidatesLimit)
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+
+	if archivedCount > 0 {
+		logging.From(ctx).Infof(
+			"HSKP: archived documents %d, %s",
+			archivedCount,
+			time.Since(start),
+		)
+	}
+
+	return nil
+}
+
+// upgradeSnapshots rewrites the snapshots stored in old formats in the current
+// format. At most snapshotUpgradeLimit snapshots are rewritten in a run so that
+// the upgrade does not compete with the regular traffic.
+func (h *Housekeeping) upgradeSnapshots(ctx context.Context) error {
+	if h.snapshotUpgradeLimit <= 0 {
+		return nil
+	}
+
+	h.upgradeSnapshotsFuncMu.RLock()
+	upgradeSnapshotsFunc := h.upgradeSnapshotsFunc
+	h.upgradeSnapshotsFuncMu.RUnlock()
+	if upgradeSnapshotsFunc == nil {
+		return nil
+	}
+
+	start := time.Now()
+	locker, err := h.coordinator.NewLocker(ctx, upgradeSnapshotsKey)
+	if err != nil {
+		return err
+	}
+
+	if err := locker.Lock(ctx); err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	upgradedCount, err := upgradeSnapshotsFunc(ctx, h.snapshotUpgradeLimit)
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+
+	if upgradedCount > 0 {
+		logging.From(ctx).Infof(
+			"HSKP: upgraded snapshots %d, %s",
+			upgradedCount,
+			time.Since(start),
+		)
+	}
+
+	return nil
+}
+
+// collectGarbage collects the garbage of the documents with their own GC
+// intervals whose intervals have elapsed. The documents are scanned at most
+// candidatesLimit in a run.
+func (h *Housekeeping) collectGarbage(ctx context.Context) error {
+	h.collectGarbageFuncMu.RLock()
+	collectGarbageFunc := h.collectGarbageFunc
+	h.collectGarbageFuncMu.RUnlock()
+	if collectGarbageFunc == nil {
+		return nil
+	}
+
+	start := time.Now()
+	locker, err := h.coordinator.NewLocker(ctx, collectGarbageKey)
+	if err != nil {
+		return err
+	}
+
+	if err := locker.Lock(ctx); err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	offset, collectedCount, err := collectGarbageFunc(ctx, h.gcOffset, h.candidatesLimit)
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+	h.gcOffset = offset
+
+	if collectedCount > 0 {
+		logging.From(ctx).Infof(
+			"HSKP: collected garbage of documents %d, %s",
+			collectedCount,
+			time.Since(start),
+		)
+	}
+
+	return nil
+}
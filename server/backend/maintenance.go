@@ -0,0 +1,132 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	gosync "sync"
+
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+var (
+	// ErrServerInMaintenance is returned when a write is requested while the
+	// server is in maintenance mode.
+	ErrServerInMaintenance = errors.New("server in maintenance")
+)
+
+// Maintenance keeps the maintenance mode of the server, which is persisted in
+// the database so that it survives restarts until it is disabled.
+//
+// NOTE: The mode is loaded from the database when the server starts, so in
+// cluster mode the other servers follow the update after they restart.
+type Maintenance struct {
+	mu        gosync.RWMutex
+	info      *database.MaintenanceInfo
+	listeners []func(enabled bool)
+}
+
+// newMaintenance creates a new instance of Maintenance with the mode stored
+// in the given database.
+func newMaintenance(ctx context.Context, db database.Database) (*Maintenance, error) {
+	info, err := db.FindMaintenanceInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Enabled {
+		logging.DefaultLogger().Warnf("server in maintenance: %s", info.Message)
+	}
+
+	return &Maintenance{info: info}, nil
+}
+
+// Info returns the current maintenance mode.
+func (m *Maintenance) Info() *database.MaintenanceInfo {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.info.DeepCopy()
+}
+
+// Enabled returns whether the maintenance mode is enabled.
+func (m *Maintenance) Enabled() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.info.Enabled
+}
+
+// Check returns ErrServerInMaintenance with the message of the mode if the
+// maintenance mode is enabled. Writes should call it before mutating anything.
+func (m *Maintenance) Check() error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if !m.info.Enabled {
+		return nil
+	}
+	if m.info.Message == "" {
+		return ErrServerInMaintenance
+	}
+	return fmt.Errorf("%s: %w", m.info.Message, ErrServerInMaintenance)
+}
+
+// Watch registers the given function to be called whenever the maintenance
+// mode is updated. It is also called with the current mode right away.
+func (m *Maintenance) Watch(fn func(enabled bool)) {
+	m.mu.Lock()
+	m.listeners = append(m.listeners, fn)
+	enabled := m.info.Enabled
+	m.mu.Unlock()
+
+	fn(enabled)
+}
+
+// set replaces the maintenance mode with the given one and notifies the
+// listeners.
+func (m *Maintenance) set(info *database.MaintenanceInfo) {
+	m.mu.Lock()
+	m.info = info
+	listeners := append([]func(bool){}, m.listeners...)
+	m.mu.Unlock()
+
+	for _, fn := range listeners {
+		fn(info.Enabled)
+	}
+}
+
+// SetMaintenance updates the maintenance mode of the server. The mode is
+// stored in the database before it takes effect.
+func (b *Backend) SetMaintenance(
+	ctx context.Context,
+	enabled bool,
+	message string,
+) (*database.MaintenanceInfo, error) {
+	info, err := b.DB.UpdateMaintenanceInfo(ctx, enabled, message)
+	if err != nil {
+		return nil, err
+	}
+
+	b.Maintenance.set(info.DeepCopy())
+	logging.From(ctx).Infof("maintenance updated: enabled: %t, message: %s", enabled, message)
+
+	return info, nil
+}
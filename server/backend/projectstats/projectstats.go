@@ -0,0 +1,217 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package projectstats tracks the live statistics of each project measured
+// by the server, such as the clients watching documents, and delivers the
+// events changing them significantly to the subscribers watching them.
+package projectstats
+
+import (
+	gosync "sync"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+// subscriptionBufferSize is the number of the events buffered for a slow
+// subscriber. The events beyond it are dropped, since the subscriber receives
+// the statistics periodically anyway.
+const subscriptionBufferSize = 64
+
+// Subscription is a subscription to the events of a project.
+type Subscription struct {
+	projectID types.ID
+	events    chan types.ProjectStatsEvent
+
+	lock   gosync.Mutex
+	closed bool
+}
+
+// Events returns the channel of the events delivered to this subscription.
+func (s *Subscription) Events() <-chan types.ProjectStatsEvent {
+	return s.events
+}
+
+// deliver delivers the given event unless the buffer is full.
+func (s *Subscription) deliver(event types.ProjectStatsEvent) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	select {
+	case s.events <- event:
+	default:
+	}
+}
+
+// close closes the channel of this subscription.
+func (s *Subscription) close() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.closed = true
+	close(s.events)
+}
+
+// watches is the clients watching the documents of a project.
+type watches struct {
+	clients   int
+	documents map[key.Key]int
+}
+
+// Tracker tracks the clients watching the documents of each project on this
+// server and delivers the events of the projects to their subscriptions.
+type Tracker struct {
+	lock          gosync.RWMutex
+	watches       map[types.ID]*watches
+	subscriptions map[types.ID]map[*Subscription]struct{}
+}
+
+// New creates a new instance of Tracker.
+func New() *Tracker {
+	return &Tracker{
+		watches:       make(map[types.ID]*watches),
+		subscriptions: make(map[types.ID]map[*Subscription]struct{}),
+	}
+}
+
+// Watch records that a client starts watching the given documents of the
+// given project. The documents which no client watched are activated.
+func (t *Tracker) Watch(projectID types.ID, docKeys []key.Key) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	w, ok := t.watches[projectID]
+	if !ok {
+		w = &watches{documents: make(map[key.Key]int)}
+		t.watches[projectID] = w
+	}
+
+	w.clients++
+	for _, docKey := range docKeys {
+		w.documents[docKey]++
+		if w.documents[docKey] == 1 {
+			t.publish(projectID, types.ProjectStatsEvent{
+				Type:        types.DocumentActivatedEvent,
+				DocumentKey: docKey,
+			})
+		}
+	}
+}
+
+// Unwatch records that a client stops watching the given documents of the
+// given project. The documents which no client watches anymore are
+// deactivated.
+func (t *Tracker) Unwatch(projectID types.ID, docKeys []key.Key) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	w, ok := t.watches[projectID]
+	if !ok {
+		return
+	}
+
+	w.clients--
+	for _, docKey := range docKeys {
+		w.documents[docKey]--
+		if w.documents[docKey] <= 0 {
+			delete(w.documents, docKey)
+			t.publish(projectID, types.ProjectStatsEvent{
+				Type:        types.DocumentDeactivatedEvent,
+				DocumentKey: docKey,
+			})
+		}
+	}
+	if w.clients <= 0 {
+		delete(t.watches, projectID)
+	}
+}
+
+// Counts returns the number of the documents watched by at least one client
+// and the number of the clients watching the documents of the given project.
+func (t *Tracker) Counts(projectID types.ID) (documents int, clients int) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	w, ok := t.watches[projectID]
+	if !ok {
+		return 0, 0
+	}
+	return len(w.documents), w.clients
+}
+
+// Subscribe subscribes to the events of the given project.
+func (t *Tracker) Subscribe(projectID types.ID) *Subscription {
+	sub := &Subscription{
+		projectID: projectID,
+		events:    make(chan types.ProjectStatsEvent, subscriptionBufferSize),
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if _, ok := t.subscriptions[projectID]; !ok {
+		t.subscriptions[projectID] = make(map[*Subscription]struct{})
+	}
+	t.subscriptions[projectID][sub] = struct{}{}
+
+	return sub
+}
+
+// Unsubscribe removes the given subscription and closes its channel.
+func (t *Tracker) Unsubscribe(sub *Subscription) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if subs, ok := t.subscriptions[sub.projectID]; ok {
+		delete(subs, sub)
+		if len(subs) == 0 {
+			delete(t.subscriptions, sub.projectID)
+		}
+	}
+	sub.close()
+}
+
+// Publish delivers the given event to the subscriptions of the project. It
+// does not block even if the subscribers are slow.
+func (t *Tracker) Publish(projectID types.ID, event types.ProjectStatsEvent) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	t.publish(projectID, event)
+}
+
+// publish delivers the given event to the subscriptions of the project. The
+// caller must hold the lock.
+func (t *Tracker) publish(projectID types.ID, event types.ProjectStatsEvent) {
+	for sub := range t.subscriptions[projectID] {
+		sub.deliver(event)
+	}
+}
+
+// Len returns the number of the subscriptions of the given project.
+func (t *Tracker) Len(projectID types.ID) int {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return len(t.subscriptions[projectID])
+}
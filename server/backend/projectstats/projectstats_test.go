@@ -0,0 +1,81 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectstats_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/server/backend/projectstats"
+)
+
+func TestTracker(t *testing.T) {
+	projectA := types.ID("000000000000000000000001")
+	projectB := types.ID("000000000000000000000002")
+
+	t.Run("count watches test", func(t *testing.T) {
+		tracker := projectstats.New()
+		tracker.Watch(projectA, []key.Key{"doc1", "doc2"})
+		tracker.Watch(projectA, []key.Key{"doc1"})
+		tracker.Watch(projectB, []key.Key{"doc1"})
+
+		docs, clients := tracker.Counts(projectA)
+		assert.Equal(t, 2, docs)
+		assert.Equal(t, 2, clients)
+
+		tracker.Unwatch(projectA, []key.Key{"doc1", "doc2"})
+		docs, clients = tracker.Counts(projectA)
+		assert.Equal(t, 1, docs)
+		assert.Equal(t, 1, clients)
+
+		tracker.Unwatch(projectA, []key.Key{"doc1"})
+		docs, clients = tracker.Counts(projectA)
+		assert.Zero(t, docs)
+		assert.Zero(t, clients)
+
+		docs, clients = tracker.Counts(projectB)
+		assert.Equal(t, 1, docs)
+		assert.Equal(t, 1, clients)
+	})
+
+	t.Run("deliver events of project test", func(t *testing.T) {
+		tracker := projectstats.New()
+		sub := tracker.Subscribe(projectA)
+
+		// only the first and the last watches of a document are delivered.
+		tracker.Watch(projectA, []key.Key{"doc1"})
+		tracker.Watch(projectA, []key.Key{"doc1"})
+		tracker.Watch(projectB, []key.Key{"doc2"})
+		tracker.Unwatch(projectA, []key.Key{"doc1"})
+		tracker.Unwatch(projectA, []key.Key{"doc1"})
+		tracker.Publish(projectA, types.ProjectStatsEvent{Type: types.DocumentHotEvent, DocumentKey: "doc1"})
+
+		assert.Len(t, sub.Events(), 3)
+		assert.Equal(t, types.DocumentActivatedEvent, (<-sub.Events()).Type)
+		assert.Equal(t, types.DocumentDeactivatedEvent, (<-sub.Events()).Type)
+		assert.Equal(t, types.DocumentHotEvent, (<-sub.Events()).Type)
+
+		// the channel is closed after unsubscribing.
+		tracker.Unsubscribe(sub)
+		assert.Zero(t, tracker.Len(projectA))
+		_, ok := <-sub.Events()
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package rejections delivers the changes rejected by the server to the
+// subscribers watching them, e.g. operators debugging a client.
+package rejections
+
+import (
+	gosync "sync"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+)
+
+// subscriptionBufferSize is the number of the rejections buffered for a slow
+// subscriber. The rejections beyond it are dropped.
+const subscriptionBufferSize = 64
+
+// Subscription is a subscription to the rejections of a project. At most the
+// given number of rejections are delivered in each window, and the others are
+// dropped so that a storm of rejections does not overwhelm the subscriber.
+type Subscription struct {
+	projectID    types.ID
+	maxPerWindow int
+	events       chan *types.Rejection
+
+	lock        gosync.Mutex
+	closed      bool
+	windowStart gotime.Time
+	delivered   int
+	dropped     int
+}
+
+// Events returns the channel of the rejections delivered to this subscription.
+func (s *Subscription) Events() <-chan *types.Rejection {
+	return s.events
+}
+
+// deliver delivers the given rejection unless the limit of the current window
+// is reached or the buffer is full. The number of the dropped rejections is
+// reported with the next delivered one.
+func (s *Subscription) deliver(rejection types.Rejection, window gotime.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	if rejection.RejectedAt.Sub(s.windowStart) >= window {
+		s.windowStart = rejection.RejectedAt
+		s.delivered = 0
+	}
+	if s.delivered >= s.maxPerWindow {
+		s.dropped++
+		return
+	}
+
+	rejection.Dropped = s.dropped
+	select {
+	case s.events <- &rejection:
+		s.delivered++
+		s.dropped = 0
+	default:
+		s.dropped++
+	}
+}
+
+// close closes the channel of this subscription.
+func (s *Subscription) close() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.closed = true
+	close(s.events)
+}
+
+// Feed delivers the rejections of each project to its subscriptions. Each
+// server delivers the rejections of the changes pushed to it.
+type Feed struct {
+	window gotime.Duration
+
+	lock          gosync.RWMutex
+	subscriptions map[types.ID]map[*Subscription]struct{}
+}
+
+// New creates a new instance of Feed which limits the rejections delivered to
+// each subscription per the given window.
+func New(window gotime.Duration) *Feed {
+	return &Feed{
+		window:        window,
+		subscriptions: make(map[types.ID]map[*Subscription]struct{}),
+	}
+}
+
+// Subscribe subscribes to the rejections of the given project. At most
+// maxPerWindow rejections are delivered in each window.
+func (f *Feed) Subscribe(projectID types.ID, maxPerWindow int) *Subscription {
+	sub := &Subscription{
+		projectID:    projectID,
+		maxPerWindow: maxPerWindow,
+		events:       make(chan *types.Rejection, subscriptionBufferSize),
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if _, ok := f.subscriptions[projectID]; !ok {
+		f.subscriptions[projectID] = make(map[*Subscription]struct{})
+	}
+	f.subscriptions[projectID][sub] = struct{}{}
+
+	return sub
+}
+
+// Unsubscribe removes the given subscription and closes its channel.
+func (f *Feed) Unsubscribe(sub *Subscription) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if subs, ok := f.subscriptions[sub.projectID]; ok {
+		delete(subs, sub)
+		if len(subs) == 0 {
+			delete(f.subscriptions, sub.projectID)
+		}
+	}
+	sub.close()
+}
+
+// Publish delivers the given rejection to the subscriptions of the project.
+// It does not block even if the subscribers are slow.
+func (f *Feed) Publish(projectID types.ID, rejection types.Rejection) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	for sub := range f.subscriptions[projectID] {
+		sub.deliver(rejection, f.window)
+	}
+}
+
+// Len returns the number of the subscriptions of the given project.
+func (f *Feed) Len(projectID types.ID) int {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	return len(f.subscriptions[projectID])
+}
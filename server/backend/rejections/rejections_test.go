@@ -0,0 +1,89 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rejections_test
+
+import (
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/server/backend/rejections"
+)
+
+func TestFeed(t *testing.T) {
+	projectA := types.ID("000000000000000000000001")
+	projectB := types.ID("000000000000000000000002")
+
+	rejectionAt := func(at gotime.Time) types.Rejection {
+		return types.Rejection{
+			DocumentKey: "doc1",
+			Reason:      types.RejectedAsInvalid,
+			RejectedAt:  at,
+		}
+	}
+
+	t.Run("deliver rejections of project test", func(t *testing.T) {
+		feed := rejections.New(gotime.Second)
+		sub := feed.Subscribe(projectA, 10)
+		defer feed.Unsubscribe(sub)
+
+		feed.Publish(projectB, rejectionAt(gotime.Now()))
+		feed.Publish(projectA, rejectionAt(gotime.Now()))
+
+		assert.Len(t, sub.Events(), 1)
+		rejection := <-sub.Events()
+		assert.Equal(t, types.RejectedAsInvalid, rejection.Reason)
+		assert.Zero(t, rejection.Dropped)
+	})
+
+	t.Run("rate limit test", func(t *testing.T) {
+		feed := rejections.New(gotime.Second)
+		sub := feed.Subscribe(projectA, 2)
+		defer feed.Unsubscribe(sub)
+
+		// the rejections over the limit of the window are dropped.
+		now := gotime.Now()
+		for i := 0; i < 5; i++ {
+			feed.Publish(projectA, rejectionAt(now))
+		}
+		assert.Len(t, sub.Events(), 2)
+		<-sub.Events()
+		<-sub.Events()
+
+		// the number of the dropped ones is reported in the next window.
+		feed.Publish(projectA, rejectionAt(now.Add(gotime.Second)))
+		rejection := <-sub.Events()
+		assert.Equal(t, 3, rejection.Dropped)
+	})
+
+	t.Run("unsubscribe test", func(t *testing.T) {
+		feed := rejections.New(gotime.Second)
+		sub := feed.Subscribe(projectA, 10)
+		assert.Equal(t, 1, feed.Len(projectA))
+
+		feed.Unsubscribe(sub)
+		assert.Equal(t, 0, feed.Len(projectA))
+		_, ok := <-sub.Events()
+		assert.False(t, ok)
+
+		// publishing after unsubscribing does not panic.
+		feed.Publish(projectA, rejectionAt(gotime.Now()))
+		feed.Unsubscribe(sub)
+	})
+}
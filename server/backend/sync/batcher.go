@@ -0,0 +1,168 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package sync
+
+import (
+	"context"
+	gosync "sync"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// PublishFunc is the function that publishes the given event.
+type PublishFunc func(ctx context.Context, publisherID *time.ActorID, event DocEvent)
+
+// pendingEvent is the event of a document waiting for the window to close.
+type pendingEvent struct {
+	ctx         context.Context
+	publisherID *time.ActorID
+	event       DocEvent
+}
+
+// EventBatcher coalesces DocumentsChangedEvent of each document published
+// within a window into a single event, so that a burst of pushes to a document
+// is broadcast to the watchers once. The window starts with the first event of
+// a batch, so an event is delayed by the window at most.
+type EventBatcher struct {
+	window  gotime.Duration
+	publish PublishFunc
+
+	mu      gosync.Mutex
+	pending map[string]*pendingEvent
+	timers  map[string]*gotime.Timer
+	closed  bool
+}
+
+// NewEventBatcher creates a new instance of EventBatcher. If the window is 0,
+// the events are published without batching.
+func NewEventBatcher(window gotime.Duration, publish PublishFunc) *EventBatcher {
+	return &EventBatcher{
+		window:  window,
+		publish: publish,
+		pending: make(map[string]*pendingEvent),
+		timers:  make(map[string]*gotime.Timer),
+	}
+}
+
+// Publish publishes the given event. DocumentsChangedEvent of a document is
+// merged into the pending event of the document, and the other events are
+// published immediately.
+func (b *EventBatcher) Publish(ctx context.Context, publisherID *time.ActorID, event DocEvent) {
+	if b.window == 0 || event.Type != types.DocumentsChangedEvent || len(event.DocumentKeys) != 1 {
+		b.publish(ctx, publisherID, event)
+		return
+	}
+
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		b.publish(ctx, publisherID, event)
+		return
+	}
+
+	k := event.DocumentKeys[0].String()
+	if pending, ok := b.pending[k]; ok {
+		pending.merge(publisherID, event)
+		b.mu.Unlock()
+		return
+	}
+
+	b.pending[k] = &pendingEvent{
+		ctx:         ctx,
+		publisherID: publisherID,
+		event:       event,
+	}
+	b.timers[k] = gotime.AfterFunc(b.window, func() {
+		b.flush(k)
+	})
+	b.mu.Unlock()
+}
+
+// Close publishes the pending events and stops batching. The events
+// published after closing are published immediately.
+func (b *EventBatcher) Close() {
+	b.mu.Lock()
+	b.closed = true
+	var keys []string
+	for k, timer := range b.timers {
+		if timer.Stop() {
+			keys = append(keys, k)
+		}
+	}
+	b.mu.Unlock()
+
+	for _, k := range keys {
+		b.flush(k)
+	}
+}
+
+// flush publishes the pending event of the given document.
+func (b *EventBatcher) flush(k string) {
+	b.mu.Lock()
+	pending, ok := b.pending[k]
+	delete(b.pending, k)
+	delete(b.timers, k)
+	b.mu.Unlock()
+
+	if ok {
+		b.publish(pending.ctx, pending.publisherID, pending.event)
+	}
+}
+
+// merge merges the given event of the same document into this event.
+func (p *pendingEvent) merge(publisherID *time.ActorID, event DocEvent) {
+	// NOTE: The publisher is not notified of its own event. If the batch has
+	// the changes of several clients, every watcher including the publishers
+	// must be notified, so the batch is published by the initial actor.
+	if p.publisherID.Compare(publisherID) != 0 {
+		p.publisherID = time.InitialActorID
+		p.event.Publisher = types.Client{ID: time.InitialActorID}
+	}
+
+	if event.ServerSeq > p.event.ServerSeq {
+		p.event.ServerSeq = event.ServerSeq
+	}
+
+	// NOTE: An event without paths affects all the subtrees, so the batch
+	// also has no paths if any of the events has no paths.
+	if !p.event.hasPaths() || !event.hasPaths() {
+		p.event.ChangedPaths = nil
+		p.event.RemovedPaths = nil
+		return
+	}
+	p.event.ChangedPaths = appendUnique(p.event.ChangedPaths, event.ChangedPaths)
+	p.event.RemovedPaths = appendUnique(p.event.RemovedPaths, event.RemovedPaths)
+}
+
+// appendUnique appends the given paths which are not in the given slice.
+func appendUnique(paths []string, others []string) []string {
+	for _, other := range others {
+		found := false
+		for _, path := range paths {
+			if path == other {
+				found = true
+				break
+			}
+		}
+		if !found {
+			paths = append(paths, other)
+		}
+	}
+	return paths
+}
@@ -0,0 +1,122 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package sync_test
+
+import (
+	"context"
+	gosync "sync"
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend/sync"
+)
+
+type recorder struct {
+	mu        gosync.Mutex
+	published []sync.DocEvent
+}
+
+func (r *recorder) publish(_ context.Context, _ *time.ActorID, event sync.DocEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.published = append(r.published, event)
+}
+
+func (r *recorder) events() []sync.DocEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]sync.DocEvent(nil), r.published...)
+}
+
+func changedEvent(actorID *time.ActorID, k key.Key, serverSeq uint64, paths ...string) sync.DocEvent {
+	return sync.DocEvent{
+		Type:         types.DocumentsChangedEvent,
+		Publisher:    types.Client{ID: actorID},
+		DocumentKeys: []key.Key{k},
+		ChangedPaths: paths,
+		ServerSeq:    serverSeq,
+	}
+}
+
+func TestEventBatcher(t *testing.T) {
+	ctx := context.Background()
+	actorA, err := time.ActorIDFromHex("000000000000000000000001")
+	assert.NoError(t, err)
+	actorB, err := time.ActorIDFromHex("000000000000000000000002")
+	assert.NoError(t, err)
+
+	t.Run("coalesce events of a document test", func(t *testing.T) {
+		r := &recorder{}
+		batcher := sync.NewEventBatcher(20*gotime.Millisecond, r.publish)
+		defer batcher.Close()
+
+		batcher.Publish(ctx, actorA, changedEvent(actorA, "d1", 1, "$.a"))
+		batcher.Publish(ctx, actorA, changedEvent(actorA, "d1", 2, "$.b", "$.a"))
+		batcher.Publish(ctx, actorA, changedEvent(actorA, "d2", 1))
+		batcher.Publish(ctx, actorA, sync.DocEvent{
+			Type:         types.DocumentsWatchedEvent,
+			Publisher:    types.Client{ID: actorA},
+			DocumentKeys: []key.Key{"d1"},
+		})
+
+		// 01. the other events are published without waiting.
+		assert.Len(t, r.events(), 1)
+
+		// 02. the change events are published once for each document.
+		assert.Eventually(t, func() bool { return len(r.events()) == 3 }, gotime.Second, 5*gotime.Millisecond)
+		for _, event := range r.events()[1:] {
+			if event.DocumentKeys[0] == "d1" {
+				assert.Equal(t, uint64(2), event.ServerSeq)
+				assert.Equal(t, []string{"$.a", "$.b"}, event.ChangedPaths)
+				assert.Equal(t, actorA, event.Publisher.ID)
+			}
+		}
+	})
+
+	t.Run("coalesce events of several publishers test", func(t *testing.T) {
+		r := &recorder{}
+		batcher := sync.NewEventBatcher(gotime.Hour, r.publish)
+
+		batcher.Publish(ctx, actorA, changedEvent(actorA, "d1", 1, "$.a"))
+		batcher.Publish(ctx, actorB, changedEvent(actorB, "d1", 2))
+		assert.Len(t, r.events(), 0)
+
+		// NOTE: Closing the batcher publishes the pending events.
+		batcher.Close()
+		events := r.events()
+		assert.Len(t, events, 1)
+		assert.Equal(t, time.InitialActorID, events[0].Publisher.ID)
+		assert.Equal(t, uint64(2), events[0].ServerSeq)
+		assert.Nil(t, events[0].ChangedPaths)
+
+		batcher.Publish(ctx, actorA, changedEvent(actorA, "d1", 3))
+		assert.Len(t, r.events(), 2)
+	})
+
+	t.Run("disabled batching test", func(t *testing.T) {
+		r := &recorder{}
+		batcher := sync.NewEventBatcher(0, r.publish)
+		batcher.Publish(ctx, actorA, changedEvent(actorA, "d1", 1))
+		batcher.Publish(ctx, actorA, changedEvent(actorA, "d1", 2))
+		assert.Len(t, r.events(), 2)
+	})
+}
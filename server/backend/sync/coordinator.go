@@ -29,13 +29,19 @@ import (
 var (
 	// ErrEmptyDocKeys is returned when the given keys is empty.
 	ErrEmptyDocKeys = errors.New("empty doc keys")
+
+	// ErrSubtreeWatchDisabled is returned when a client tries to watch
+	// subtrees of documents while subtree watch is disabled.
+	ErrSubtreeWatchDisabled = errors.New("subtree watch is disabled")
 )
 
-// ServerInfo represents the information of the Server.
+// ServerInfo represents the information of the Server. UpdatedAt is the time
+// of the last heartbeat of the server.
 type ServerInfo struct {
 	ID          string      `json:"id"`
 	Hostname    string      `json:"hostname"`
 	ClusterAddr string      `json:"cluster_addr"`
+	Version     string      `json:"version"`
 	UpdatedAt   gotime.Time `json:"updated_at"`
 }
 
@@ -63,6 +63,7 @@ func (c *Client) Members() map[string]*sync.ServerInfo {
 			ID:          member.ID,
 			Hostname:    member.Hostname,
 			ClusterAddr: member.ClusterAddr,
+			Version:     member.Version,
 			UpdatedAt:   member.UpdatedAt,
 		}
 	}
@@ -18,6 +18,7 @@ package memory
 
 import (
 	"context"
+	gotime "time"
 
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
@@ -114,8 +115,13 @@ func (c *Coordinator) UpdatePresence(
 
 // Members returns the members of this cluster.
 func (c *Coordinator) Members() map[string]*sync.ServerInfo {
+	// NOTE: The server itself is the only member in standalone mode, so it is
+	// always alive at the time of the call.
+	member := *c.serverInfo
+	member.UpdatedAt = gotime.Now()
+
 	members := make(map[string]*sync.ServerInfo)
-	members[c.serverInfo.ID] = c.serverInfo
+	members[member.ID] = &member
 	return members
 }
 
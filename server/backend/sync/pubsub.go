@@ -20,6 +20,7 @@ import (
 	"github.com/rs/xid"
 
 	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 )
 
@@ -29,6 +30,10 @@ type Subscription struct {
 	subscriber types.Client
 	closed     bool
 	events     chan DocEvent
+
+	// paths is the paths of the subtrees watched by the subscriber. If it is
+	// empty, the whole documents are watched.
+	paths []string
 }
 
 // NewSubscription creates a new instance of Subscription.
@@ -50,6 +55,22 @@ type DocEvent struct {
 	Type         types.DocEventType
 	Publisher    types.Client
 	DocumentKeys []key.Key
+
+	// ChangedPaths and RemovedPaths are the paths of the elements changed and
+	// removed by DocumentsChangedEvent. They are only set if subtree watch is
+	// enabled.
+	ChangedPaths []string
+	RemovedPaths []string
+
+	// ServerSeq is the server sequence of the document after the changes of
+	// DocumentsChangedEvent. If events are batched, it is the one of the last
+	// changes of the batch. Zero means that it is unknown.
+	ServerSeq uint64
+}
+
+// hasPaths returns whether the paths of the changed elements are known.
+func (e DocEvent) hasPaths() bool {
+	return len(e.ChangedPaths) > 0 || len(e.RemovedPaths) > 0
 }
 
 // Events returns the DocEvent channel of this subscription.
@@ -67,6 +88,55 @@ func (s *Subscription) SubscriberID() string {
 	return s.subscriber.ID.String()
 }
 
+// SetPaths sets the paths of the subtrees watched by the subscriber.
+func (s *Subscription) SetPaths(paths []string) {
+	s.paths = paths
+}
+
+// Paths returns the paths of the subtrees watched by the subscriber.
+func (s *Subscription) Paths() []string {
+	return s.paths
+}
+
+// Filter returns the event to be delivered to the subscriber according to the
+// watched subtrees. If the watched subtrees are removed, SubtreeRemovedEvent
+// with the removed paths is returned. It returns false if the given event does
+// not affect the watched subtrees.
+func (s *Subscription) Filter(event DocEvent) (DocEvent, bool) {
+	if len(s.paths) == 0 || event.Type != types.DocumentsChangedEvent || !event.hasPaths() {
+		return event, true
+	}
+
+	var removedPaths []string
+	for _, path := range s.paths {
+		for _, removedPath := range event.RemovedPaths {
+			if json.IsAncestorPath(removedPath, path) {
+				removedPaths = append(removedPaths, path)
+				break
+			}
+		}
+	}
+	if len(removedPaths) > 0 {
+		return DocEvent{
+			Type:         types.SubtreeRemovedEvent,
+			Publisher:    event.Publisher,
+			DocumentKeys: event.DocumentKeys,
+			RemovedPaths: removedPaths,
+			ServerSeq:    event.ServerSeq,
+		}, true
+	}
+
+	for _, path := range s.paths {
+		for _, changedPath := range event.ChangedPaths {
+			if json.IsAncestorPath(path, changedPath) || json.IsAncestorPath(changedPath, path) {
+				return event, true
+			}
+		}
+	}
+
+	return event, false
+}
+
 // UpdatePresence updates the presence of the subscriber.
 func (s *Subscription) UpdatePresence(info types.PresenceInfo) {
 	s.subscriber.PresenceInfo.Update(info)
@@ -0,0 +1,69 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sync_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend/sync"
+)
+
+func TestSubscription(t *testing.T) {
+	t.Run("filter test", func(t *testing.T) {
+		sub := sync.NewSubscription(types.Client{ID: time.InitialActorID})
+		event := sync.DocEvent{
+			Type:         types.DocumentsChangedEvent,
+			DocumentKeys: []key.Key{"d1"},
+			ChangedPaths: []string{"$.todos.0"},
+		}
+
+		// 01. all events are delivered if paths are not given.
+		filtered, ok := sub.Filter(event)
+		assert.True(t, ok)
+		assert.Equal(t, event, filtered)
+
+		// 02. changes in or above the watched subtrees are delivered.
+		sub.SetPaths([]string{"$.todos"})
+		_, ok = sub.Filter(event)
+		assert.True(t, ok)
+		event.ChangedPaths = []string{"$"}
+		_, ok = sub.Filter(event)
+		assert.True(t, ok)
+
+		// 03. changes outside of the watched subtrees are skipped.
+		event.ChangedPaths = []string{"$.todos2", "$.users.0"}
+		_, ok = sub.Filter(event)
+		assert.False(t, ok)
+
+		// 04. removal of the watched subtrees is notified.
+		event.ChangedPaths = []string{"$.todos"}
+		event.RemovedPaths = []string{"$.todos"}
+		filtered, ok = sub.Filter(event)
+		assert.True(t, ok)
+		assert.Equal(t, types.SubtreeRemovedEvent, filtered.Type)
+		assert.Equal(t, []string{"$.todos"}, filtered.RemovedPaths)
+
+		// 05. events other than DocumentsChangedEvent are delivered.
+		_, ok = sub.Filter(sync.DocEvent{Type: types.DocumentsWatchedEvent})
+		assert.True(t, ok)
+	})
+}
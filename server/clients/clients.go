@@ -19,6 +19,9 @@ package clients
 import (
 	"context"
 	"errors"
+	"fmt"
+	gotime "time"
+	"unicode"
 
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
@@ -31,18 +34,59 @@ var (
 
 	// ErrInvalidClientID is returned when the given Key is not valid ClientID.
 	ErrInvalidClientID = errors.New("invalid client id")
+
+	// ErrInvalidIdentity is returned when the given identity is not valid to
+	// key the actor ID of the client.
+	ErrInvalidIdentity = errors.New("invalid identity")
 )
 
-// Activate activates the given client.
+const (
+	// maxIdentityLength is the maximum length of the identity of a client.
+	maxIdentityLength = 128
+
+	// identityKeyPrefix is the prefix of the keys of the clients keyed by
+	// their identities.
+	identityKeyPrefix = "identity:"
+)
+
+// Activate activates the given client. If the project reuses the actor IDs,
+// the client is keyed by the given identity instead of the given client key,
+// so that the sessions of the identity are activated with the same actor ID.
 func Activate(
 	ctx context.Context,
 	db database.Database,
 	project *types.Project,
 	clientKey string,
+	identity string,
 ) (*database.ClientInfo, error) {
+	if project.HasStableActorID() {
+		if err := ValidateIdentity(identity); err != nil {
+			return nil, err
+		}
+		clientKey = identityKeyPrefix + identity
+	}
+
 	return db.ActivateClient(ctx, project.ID, clientKey)
 }
 
+// ValidateIdentity validates the given identity of a client. It must be
+// non-empty printable characters without spaces.
+func ValidateIdentity(identity string) error {
+	if identity == "" {
+		return fmt.Errorf("empty identity: %w", ErrInvalidIdentity)
+	}
+	if len(identity) > maxIdentityLength {
+		return fmt.Errorf("identity longer than %d: %w", maxIdentityLength, ErrInvalidIdentity)
+	}
+	for _, r := range identity {
+		if !unicode.IsPrint(r) || unicode.IsSpace(r) {
+			return fmt.Errorf("%q: %w", identity, ErrInvalidIdentity)
+		}
+	}
+
+	return nil
+}
+
 // Deactivate deactivates the given client.
 func Deactivate(
 	ctx context.Context,
@@ -80,6 +124,15 @@ func Deactivate(
 		); err != nil {
 			return nil, err
 		}
+
+		if err := db.CreateDocClientEventInfo(
+			ctx,
+			id,
+			clientInfo.ID,
+			types.DocumentDetachedByClientEvent,
+		); err != nil {
+			return nil, err
+		}
 	}
 
 	return db.DeactivateClient(ctx, projectID, clientID)
@@ -98,3 +151,36 @@ func FindClientInfo(
 		types.IDFromActorID(clientID),
 	)
 }
+
+// FindOrReactivateClientInfo finds the client with the given id. If the client
+// was deactivated within the given grace period, for example by housekeeping
+// racing with a reconnect, it reactivates the client and returns true as the
+// second value. In this case, the documents that were attached to the client
+// have been detached, so the client should resync them from scratch.
+func FindOrReactivateClientInfo(
+	ctx context.Context,
+	db database.Database,
+	project *types.Project,
+	clientID *time.ActorID,
+	gracePeriod gotime.Duration,
+) (*database.ClientInfo, bool, error) {
+	clientInfo, err := FindClientInfo(ctx, db, project, clientID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if clientInfo.Status != database.ClientDeactivated ||
+		gotime.Since(clientInfo.UpdatedAt) > gracePeriod {
+		return clientInfo, false, nil
+	}
+
+	reactivated, err := db.ActivateClient(ctx, project.ID, clientInfo.Key)
+	if err != nil {
+		return nil, false, err
+	}
+	if reactivated.ID != clientInfo.ID {
+		return nil, false, fmt.Errorf("%s: %w", clientInfo.ID, ErrInvalidClientID)
+	}
+
+	return reactivated, true, nil
+}
@@ -24,8 +24,10 @@ import (
 
 	"gopkg.in/yaml.v2"
 
+	doctime "github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/server/admin"
 	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
 	"github.com/yorkie-team/yorkie/server/backend/database/mongo"
 	"github.com/yorkie-team/yorkie/server/backend/housekeeping"
 	"github.com/yorkie-team/yorkie/server/backend/sync/etcd"
@@ -39,13 +41,21 @@ const (
 	DefaultRPCPort             = 11101
 	DefaultRPCMaxRequestsBytes = 4 * 1024 * 1024 // 4MiB
 
+	DefaultRPCMaxChangePackBytes          = 3 * 1024 * 1024  // 3MiB
+	DefaultRPCMaxStreamedChangePackBytes  = 64 * 1024 * 1024 // 64MiB
+	DefaultRPCMaxConcurrentStreamsPerConn = 100
+	DefaultRPCShutdownTimeout             = 5 * time.Second
+
 	DefaultProfilingPort = 11102
 
 	DefaultAdminPort = 11103
 
-	DefaultHousekeepingInterval            = time.Minute
-	DefaultHousekeepingDeactivateThreshold = 7 * 24 * time.Hour
-	DefaultHousekeepingCandidateLimit      = 500
+	DefaultHousekeepingInterval             = time.Minute
+	DefaultHousekeepingDeactivateThreshold  = 7 * 24 * time.Hour
+	DefaultHousekeepingCandidateLimit       = 500
+	DefaultHousekeepingClientEventRetention = 7 * 24 * time.Hour
+	DefaultHousekeepingDocEventLogRetention = 3 * 24 * time.Hour
+	DefaultHousekeepingSnapshotUpgradeLimit = 100
 
 	DefaultMongoConnectionURI     = "mongodb://localhost:27017"
 	DefaultMongoConnectionTimeout = 5 * time.Second
@@ -55,12 +65,54 @@ const (
 	DefaultUseDefaultProject = true
 	DefaultSnapshotThreshold = 500
 	DefaultSnapshotInterval  = 1000
+	DefaultMaxLamportGap     = 1000000
+
+	DefaultSnapshotRetentionPeriod = 0 * time.Second
+
+	DefaultSnapshotWriteMaxRetries      = 3
+	DefaultSnapshotWriteMaxWaitInterval = 1000 * time.Millisecond
+
+	DefaultEnableSubtreeWatch    = false
+	DefaultEnableOperationSquash = false
+	DefaultEnableDocEventLog     = false
+
+	DefaultIDGenerator                   = database.ObjectIDGeneratorName
+	DefaultActorIDSize                   = doctime.DefaultActorIDSize
+	DefaultClientReactivationGracePeriod = 10 * time.Minute
 
 	DefaultAuthWebhookMaxRetries      = 10
 	DefaultAuthWebhookMaxWaitInterval = 3000 * time.Millisecond
 	DefaultAuthWebhookCacheSize       = 5000
 	DefaultAuthWebhookCacheAuthTTL    = 10 * time.Second
 	DefaultAuthWebhookCacheUnauthTTL  = 10 * time.Second
+
+	DefaultDocumentCountCacheTTL = 10 * time.Second
+
+	DefaultEventBatchWindow = 10 * time.Millisecond
+
+	DefaultHotDocumentWindow = 10 * time.Second
+
+	DefaultProjectStatsInterval = 5 * time.Second
+
+	DefaultBackgroundQueueWarnThreshold = 100
+
+	DefaultOperationSampleInterval = 10
+
+	DefaultMaxPathDepth = 64
+
+	DefaultQueryTimeout = 30 * time.Second
+
+	DefaultDBHealthCheckInterval = 5 * time.Second
+	DefaultDBReconnectMaxBackoff = 30 * time.Second
+
+	DefaultReadYourWritesTimeout = 1 * time.Second
+
+	DefaultRemovedDocumentRetention = 7 * 24 * time.Hour
+
+	DefaultOperationIDWindow = 1 * time.Minute
+
+	DefaultEventWebhookMaxRetries      = 5
+	DefaultEventWebhookMaxWaitInterval = 3000 * time.Millisecond
 )
 
 // Config is the configuration for creating a Yorkie instance.
@@ -72,6 +124,7 @@ type Config struct {
 	Backend      *backend.Config      `yaml:"Backend"`
 	Mongo        *mongo.Config        `yaml:"Mongo"`
 	ETCD         *etcd.Config         `yaml:"ETCD"`
+	Logging      *logging.Config      `yaml:"Logging"`
 }
 
 // NewConfig returns a Config struct that contains reasonable defaults
@@ -82,7 +135,13 @@ func NewConfig() *Config {
 
 // NewConfigFromFile returns a Config struct for the given conf file.
 func NewConfigFromFile(path string) (*Config, error) {
-	conf := &Config{}
+	// NOTE: The options whose zero value disables the feature are set to the
+	// defaults before reading the file, so that an explicit zero in the file
+	// is kept instead of being replaced with the default.
+	conf := &Config{Backend: &backend.Config{
+		MaxLamportGap: DefaultMaxLamportGap,
+		MaxPathDepth:  DefaultMaxPathDepth,
+	}}
 	bytes, err := ioutil.ReadFile(filepath.Clean(path))
 	if err != nil {
 		logging.DefaultLogger().Error(err)
@@ -103,8 +162,12 @@ func (c *Config) RPCAddr() string {
 	return fmt.Sprintf("localhost:%d", c.RPC.Port)
 }
 
-// AdminAddr returns the Admin address.
+// AdminAddr returns the Admin address. It is the address of the Unix domain
+// socket if the socket path is given.
 func (c *Config) AdminAddr() string {
+	if c.Admin.SocketPath != "" {
+		return "unix://" + c.Admin.SocketPath
+	}
 	return fmt.Sprintf("localhost:%d", c.Admin.Port)
 }
 
@@ -137,7 +200,13 @@ func (c *Config) Validate() error {
 	}
 
 	if c.ETCD != nil {
-		return c.ETCD.Validate()
+		if err := c.ETCD.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if c.Logging != nil {
+		return c.Logging.Validate()
 	}
 	return nil
 }
@@ -153,11 +222,35 @@ func (c *Config) ensureDefaultValue() {
 		c.RPC.MaxRequestBytes = DefaultRPCMaxRequestsBytes
 	}
 
+	if c.RPC.MaxChangePackBytes == 0 {
+		c.RPC.MaxChangePackBytes = DefaultRPCMaxChangePackBytes
+	}
+
+	if c.RPC.MaxStreamedChangePackBytes == 0 {
+		c.RPC.MaxStreamedChangePackBytes = DefaultRPCMaxStreamedChangePackBytes
+	}
+
+	if c.RPC.MaxConcurrentStreamsPerConn == 0 {
+		c.RPC.MaxConcurrentStreamsPerConn = DefaultRPCMaxConcurrentStreamsPerConn
+	}
+
+	if c.RPC.ShutdownTimeout == "" {
+		c.RPC.ShutdownTimeout = DefaultRPCShutdownTimeout.String()
+	}
+
+	if c.Housekeeping.ClientEventRetention == "" {
+		c.Housekeeping.ClientEventRetention = DefaultHousekeepingClientEventRetention.String()
+	}
+
+	if c.Housekeeping.DocEventLogRetention == "" {
+		c.Housekeeping.DocEventLogRetention = DefaultHousekeepingDocEventLogRetention.String()
+	}
+
 	if c.Profiling.Port == 0 {
 		c.Profiling.Port = DefaultProfilingPort
 	}
 
-	if c.Admin.Port == 0 {
+	if c.Admin.Port == 0 && c.Admin.SocketPath == "" {
 		c.Admin.Port = DefaultAdminPort
 	}
 
@@ -169,6 +262,18 @@ func (c *Config) ensureDefaultValue() {
 		c.Backend.SnapshotInterval = DefaultSnapshotInterval
 	}
 
+	if c.Backend.IDGenerator == "" {
+		c.Backend.IDGenerator = DefaultIDGenerator
+	}
+
+	if c.Backend.ActorIDSize == 0 {
+		c.Backend.ActorIDSize = DefaultActorIDSize
+	}
+
+	if c.Backend.ClientReactivationGracePeriod == "" {
+		c.Backend.ClientReactivationGracePeriod = DefaultClientReactivationGracePeriod.String()
+	}
+
 	if c.Backend.AuthWebhookCacheSize == 0 {
 		c.Backend.AuthWebhookCacheSize = DefaultAuthWebhookCacheSize
 	}
@@ -189,6 +294,66 @@ func (c *Config) ensureDefaultValue() {
 		c.Backend.AuthWebhookCacheUnauthTTL = DefaultAuthWebhookCacheUnauthTTL.String()
 	}
 
+	if c.Backend.SnapshotRetentionPeriod == "" {
+		c.Backend.SnapshotRetentionPeriod = DefaultSnapshotRetentionPeriod.String()
+	}
+
+	if c.Backend.SnapshotWriteMaxRetries == 0 {
+		c.Backend.SnapshotWriteMaxRetries = DefaultSnapshotWriteMaxRetries
+	}
+
+	if c.Backend.SnapshotWriteMaxWaitInterval == "" {
+		c.Backend.SnapshotWriteMaxWaitInterval = DefaultSnapshotWriteMaxWaitInterval.String()
+	}
+
+	if c.Backend.DocumentCountCacheTTL == "" {
+		c.Backend.DocumentCountCacheTTL = DefaultDocumentCountCacheTTL.String()
+	}
+
+	if c.Backend.EventBatchWindow == "" {
+		c.Backend.EventBatchWindow = DefaultEventBatchWindow.String()
+	}
+
+	if c.Backend.HotDocumentWindow == "" {
+		c.Backend.HotDocumentWindow = DefaultHotDocumentWindow.String()
+	}
+
+	if c.Backend.ProjectStatsInterval == "" {
+		c.Backend.ProjectStatsInterval = DefaultProjectStatsInterval.String()
+	}
+
+	if c.Backend.QueryTimeout == "" {
+		c.Backend.QueryTimeout = DefaultQueryTimeout.String()
+	}
+
+	if c.Backend.DBHealthCheckInterval == "" {
+		c.Backend.DBHealthCheckInterval = DefaultDBHealthCheckInterval.String()
+	}
+
+	if c.Backend.DBReconnectMaxBackoff == "" {
+		c.Backend.DBReconnectMaxBackoff = DefaultDBReconnectMaxBackoff.String()
+	}
+
+	if c.Backend.ReadYourWritesTimeout == "" {
+		c.Backend.ReadYourWritesTimeout = DefaultReadYourWritesTimeout.String()
+	}
+
+	if c.Backend.RemovedDocumentRetention == "" {
+		c.Backend.RemovedDocumentRetention = DefaultRemovedDocumentRetention.String()
+	}
+
+	if c.Backend.OperationIDWindow == "" {
+		c.Backend.OperationIDWindow = DefaultOperationIDWindow.String()
+	}
+
+	if c.Backend.EventWebhookMaxRetries == 0 {
+		c.Backend.EventWebhookMaxRetries = DefaultEventWebhookMaxRetries
+	}
+
+	if c.Backend.EventWebhookMaxWaitInterval == "" {
+		c.Backend.EventWebhookMaxWaitInterval = DefaultEventWebhookMaxWaitInterval.String()
+	}
+
 	if c.Mongo != nil {
 		if c.Mongo.ConnectionURI == "" {
 			c.Mongo.ConnectionURI = DefaultMongoConnectionURI
@@ -221,7 +386,8 @@ func (c *Config) ensureDefaultValue() {
 func newConfig(port int, profilingPort int) *Config {
 	return &Config{
 		RPC: &rpc.Config{
-			Port: port,
+			Port:                        port,
+			MaxConcurrentStreamsPerConn: DefaultRPCMaxConcurrentStreamsPerConn,
 		},
 		Profiling: &profiling.Config{
 			Port: profilingPort,
@@ -230,13 +396,21 @@ func newConfig(port int, profilingPort int) *Config {
 			Port: DefaultAdminPort,
 		},
 		Housekeeping: &housekeeping.Config{
-			Interval:            DefaultHousekeepingInterval.String(),
-			DeactivateThreshold: DefaultHousekeepingDeactivateThreshold.String(),
-			CandidatesLimit:     DefaultHousekeepingCandidateLimit,
+			Interval:             DefaultHousekeepingInterval.String(),
+			DeactivateThreshold:  DefaultHousekeepingDeactivateThreshold.String(),
+			CandidatesLimit:      DefaultHousekeepingCandidateLimit,
+			ClientEventRetention: DefaultHousekeepingClientEventRetention.String(),
+			DocEventLogRetention: DefaultHousekeepingDocEventLogRetention.String(),
+			SnapshotUpgradeLimit: DefaultHousekeepingSnapshotUpgradeLimit,
 		},
 		Backend: &backend.Config{
-			SnapshotThreshold: DefaultSnapshotThreshold,
-			SnapshotInterval:  DefaultSnapshotInterval,
+			SnapshotThreshold:            DefaultSnapshotThreshold,
+			SnapshotInterval:             DefaultSnapshotInterval,
+			MaxLamportGap:                DefaultMaxLamportGap,
+			MaxPathDepth:                 DefaultMaxPathDepth,
+			ActorIDSize:                  DefaultActorIDSize,
+			BackgroundQueueWarnThreshold: DefaultBackgroundQueueWarnThreshold,
+			OperationSampleInterval:      DefaultOperationSampleInterval,
 		},
 	}
 }
@@ -17,14 +17,19 @@
 package server_test
 
 import (
+	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/server/admin"
 	"github.com/yorkie-team/yorkie/server/backend/sync/etcd"
+	"github.com/yorkie-team/yorkie/server/logging"
 )
 
 func TestNewConfigFromFile(t *testing.T) {
@@ -39,6 +44,10 @@ func TestNewConfigFromFile(t *testing.T) {
 
 		assert.Equal(t, conf.Backend.SnapshotThreshold, uint64(server.DefaultSnapshotThreshold))
 		assert.Equal(t, conf.Backend.SnapshotInterval, uint64(server.DefaultSnapshotInterval))
+		assert.Equal(t, conf.Backend.MaxLamportGap, uint64(server.DefaultMaxLamportGap))
+		assert.Equal(t, conf.Backend.MaxPathDepth, server.DefaultMaxPathDepth)
+		assert.Equal(t, conf.Backend.EnableSubtreeWatch, server.DefaultEnableSubtreeWatch)
+		assert.Equal(t, conf.Backend.EnableOperationSquash, server.DefaultEnableOperationSquash)
 
 		assert.Nil(t, conf.ETCD)
 	})
@@ -51,6 +60,10 @@ func TestNewConfigFromFile(t *testing.T) {
 		assert.Equal(t, conf.RPC.Port, server.DefaultRPCPort)
 		assert.Equal(t, conf.RPC.CertFile, "")
 		assert.Equal(t, conf.RPC.KeyFile, "")
+		assert.Equal(t, conf.RPC.MaxChangePackBytes, uint64(server.DefaultRPCMaxChangePackBytes))
+		assert.Equal(t, conf.RPC.MaxStreamedChangePackBytes, uint64(server.DefaultRPCMaxStreamedChangePackBytes))
+		assert.Equal(t, conf.RPC.MaxConcurrentStreamsPerConn, uint64(server.DefaultRPCMaxConcurrentStreamsPerConn))
+		assert.Equal(t, conf.RPC.ShutdownTimeout, server.DefaultRPCShutdownTimeout.String())
 
 		connTimeout, err := time.ParseDuration(conf.Mongo.ConnectionTimeout)
 		assert.NoError(t, err)
@@ -63,8 +76,18 @@ func TestNewConfigFromFile(t *testing.T) {
 		assert.Equal(t, pingTimeout, server.DefaultMongoPingTimeout)
 		assert.Equal(t, conf.Backend.SnapshotThreshold, uint64(server.DefaultSnapshotThreshold))
 		assert.Equal(t, conf.Backend.SnapshotInterval, uint64(server.DefaultSnapshotInterval))
+		assert.Equal(t, conf.Backend.MaxLamportGap, uint64(server.DefaultMaxLamportGap))
+		assert.Equal(t, conf.Backend.EnableSubtreeWatch, server.DefaultEnableSubtreeWatch)
+		assert.Equal(t, conf.Backend.EnableOperationSquash, server.DefaultEnableOperationSquash)
+		assert.Equal(t, conf.Backend.SnapshotOnAttachThreshold, uint64(0))
 		assert.Equal(t, conf.Backend.AuthWebhookMaxRetries, uint64(server.DefaultAuthWebhookMaxRetries))
 
+		assert.Equal(t, conf.Backend.IDGenerator, server.DefaultIDGenerator)
+
+		clientReactivationGracePeriod, err := time.ParseDuration(conf.Backend.ClientReactivationGracePeriod)
+		assert.NoError(t, err)
+		assert.Equal(t, clientReactivationGracePeriod, server.DefaultClientReactivationGracePeriod)
+
 		authWebhookMaxWaitInterval, err := time.ParseDuration(conf.Backend.AuthWebhookMaxWaitInterval)
 		assert.NoError(t, err)
 		assert.Equal(t, authWebhookMaxWaitInterval, server.DefaultAuthWebhookMaxWaitInterval)
@@ -77,6 +100,68 @@ func TestNewConfigFromFile(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, authWebhookCacheUnauthTTL, server.DefaultAuthWebhookCacheUnauthTTL)
 
+		assert.Equal(t, conf.Backend.EventWebhookMaxRetries, uint64(server.DefaultEventWebhookMaxRetries))
+		eventWebhookMaxWaitInterval, err := time.ParseDuration(conf.Backend.EventWebhookMaxWaitInterval)
+		assert.NoError(t, err)
+		assert.Equal(t, eventWebhookMaxWaitInterval, server.DefaultEventWebhookMaxWaitInterval)
+
+		documentCountCacheTTL, err := time.ParseDuration(conf.Backend.DocumentCountCacheTTL)
+		assert.NoError(t, err)
+		assert.Equal(t, documentCountCacheTTL, server.DefaultDocumentCountCacheTTL)
+
+		eventBatchWindow, err := time.ParseDuration(conf.Backend.EventBatchWindow)
+		assert.NoError(t, err)
+		assert.Equal(t, eventBatchWindow, server.DefaultEventBatchWindow)
+
+		hotDocumentWindow, err := time.ParseDuration(conf.Backend.HotDocumentWindow)
+		assert.NoError(t, err)
+		assert.Equal(t, hotDocumentWindow, server.DefaultHotDocumentWindow)
+
+		projectStatsInterval, err := time.ParseDuration(conf.Backend.ProjectStatsInterval)
+		assert.NoError(t, err)
+		assert.Equal(t, projectStatsInterval, server.DefaultProjectStatsInterval)
+
+		assert.Equal(t, conf.Backend.MaxPathDepth, server.DefaultMaxPathDepth)
+
+		queryTimeout, err := time.ParseDuration(conf.Backend.QueryTimeout)
+		assert.NoError(t, err)
+		assert.Equal(t, queryTimeout, server.DefaultQueryTimeout)
+
+		dbHealthCheckInterval, err := time.ParseDuration(conf.Backend.DBHealthCheckInterval)
+		assert.NoError(t, err)
+		assert.Equal(t, dbHealthCheckInterval, server.DefaultDBHealthCheckInterval)
+
+		dbReconnectMaxBackoff, err := time.ParseDuration(conf.Backend.DBReconnectMaxBackoff)
+		assert.NoError(t, err)
+		assert.Equal(t, dbReconnectMaxBackoff, server.DefaultDBReconnectMaxBackoff)
+
+		readYourWritesTimeout, err := time.ParseDuration(conf.Backend.ReadYourWritesTimeout)
+		assert.NoError(t, err)
+		assert.Equal(t, readYourWritesTimeout, server.DefaultReadYourWritesTimeout)
+
+		removedDocumentRetention, err := time.ParseDuration(conf.Backend.RemovedDocumentRetention)
+		assert.NoError(t, err)
+		assert.Equal(t, removedDocumentRetention, server.DefaultRemovedDocumentRetention)
+
+		operationIDWindow, err := time.ParseDuration(conf.Backend.OperationIDWindow)
+		assert.NoError(t, err)
+		assert.Equal(t, operationIDWindow, server.DefaultOperationIDWindow)
+
+		snapshotRetentionPeriod, err := time.ParseDuration(conf.Backend.SnapshotRetentionPeriod)
+		assert.NoError(t, err)
+		assert.Equal(t, snapshotRetentionPeriod, server.DefaultSnapshotRetentionPeriod)
+
+		assert.Equal(t, conf.Backend.SnapshotWriteMaxRetries, uint64(server.DefaultSnapshotWriteMaxRetries))
+		snapshotWriteMaxWaitInterval, err := time.ParseDuration(conf.Backend.SnapshotWriteMaxWaitInterval)
+		assert.NoError(t, err)
+		assert.Equal(t, snapshotWriteMaxWaitInterval, server.DefaultSnapshotWriteMaxWaitInterval)
+		assert.Equal(t, conf.Backend.EnableDocEventLog, server.DefaultEnableDocEventLog)
+
+		docEventLogRetention, err := time.ParseDuration(conf.Housekeeping.DocEventLogRetention)
+		assert.NoError(t, err)
+		assert.Equal(t, docEventLogRetention, server.DefaultHousekeepingDocEventLogRetention)
+		assert.Equal(t, conf.Housekeeping.SnapshotUpgradeLimit, server.DefaultHousekeepingSnapshotUpgradeLimit)
+
 		assert.NotNil(t, conf.ETCD)
 		etcdDialTimeout, err := time.ParseDuration(conf.ETCD.DialTimeout)
 		assert.NoError(t, err)
@@ -85,5 +170,65 @@ func TestNewConfigFromFile(t *testing.T) {
 		lockLeaseTime, err := time.ParseDuration(conf.ETCD.LockLeaseTime)
 		assert.NoError(t, err)
 		assert.Equal(t, lockLeaseTime, etcd.DefaultLockLeaseTime)
+
+		assert.NotNil(t, conf.Logging)
+		assert.Equal(t, "info", conf.Logging.Level)
+		assert.Equal(t, logging.ConsoleFormat, conf.Logging.Format)
+		assert.NoError(t, conf.Logging.Validate())
+	})
+
+	t.Run("zero disables option test", func(t *testing.T) {
+		sample, err := os.ReadFile("config.sample.yml")
+		assert.NoError(t, err)
+		filePath := filepath.Join(t.TempDir(), "config.yml")
+
+		// an explicit zero is kept to disable the option.
+		zero := strings.Replace(string(sample), "MaxLamportGap: 1000000", "MaxLamportGap: 0", 1)
+		zero = strings.Replace(zero, "MaxPathDepth: 64", "MaxPathDepth: 0", 1)
+		assert.NoError(t, os.WriteFile(filePath, []byte(zero), 0600))
+		conf, err := server.NewConfigFromFile(filePath)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(0), conf.Backend.MaxLamportGap)
+		assert.Equal(t, 0, conf.Backend.MaxPathDepth)
+
+		// the default is applied if the option is not given.
+		unset := strings.Replace(string(sample), "MaxLamportGap: 1000000", "", 1)
+		unset = strings.Replace(unset, "MaxPathDepth: 64", "", 1)
+		assert.NoError(t, os.WriteFile(filePath, []byte(unset), 0600))
+		conf, err = server.NewConfigFromFile(filePath)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(server.DefaultMaxLamportGap), conf.Backend.MaxLamportGap)
+		assert.Equal(t, server.DefaultMaxPathDepth, conf.Backend.MaxPathDepth)
+	})
+}
+
+func TestLoggingConfig(t *testing.T) {
+	t.Run("validate test", func(t *testing.T) {
+		conf := &logging.Config{}
+		assert.NoError(t, conf.Validate())
+
+		conf = &logging.Config{Level: "debug", Format: logging.JSONFormat}
+		assert.NoError(t, conf.Validate())
+
+		conf = &logging.Config{Level: "verbose"}
+		assert.Error(t, conf.Validate())
+
+		conf = &logging.Config{Format: "xml"}
+		assert.Error(t, conf.Validate())
+	})
+}
+
+func TestAdminConfig(t *testing.T) {
+	t.Run("socket path test", func(t *testing.T) {
+		conf := server.NewConfig()
+		conf.Admin = &admin.Config{SocketPath: "/tmp/yorkie-admin.sock"}
+		assert.NoError(t, conf.Admin.Validate())
+		assert.Equal(t, "unix:///tmp/yorkie-admin.sock", conf.AdminAddr())
+
+		conf.Admin.Port = server.DefaultAdminPort
+		assert.ErrorIs(t, conf.Admin.Validate(), admin.ErrInvalidAdminListener)
+
+		conf.Admin = &admin.Config{}
+		assert.ErrorIs(t, conf.Admin.Validate(), admin.ErrInvalidAdminPort)
 	})
 }
@@ -18,23 +18,92 @@ package documents
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	gotime "time"
 
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/jsonpatch"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/logging"
 	"github.com/yorkie-team/yorkie/server/packs"
+	"github.com/yorkie-team/yorkie/server/rpc/auth"
+	"github.com/yorkie-team/yorkie/server/webhook"
+)
+
+const (
+	// removeBatchSize is the number of documents to read at once while
+	// removing documents by key prefix.
+	removeBatchSize = 100
+
+	// streamBatchSize is the number of documents to read at once while
+	// streaming documents.
+	streamBatchSize = 100
+)
+
+var (
+	// ErrEmptyKeyPrefix is returned when the key prefix of the documents to
+	// remove is empty.
+	ErrEmptyKeyPrefix = errors.New("key prefix is empty")
+
+	// ErrVersionVectorTooLarge is returned when a client attaches the document
+	// which is already attached by the maximum number of clients.
+	ErrVersionVectorTooLarge = errors.New("version vector too large")
+
+	// ErrMoveToSameProject is returned when the document is moved to the
+	// project that it belongs to.
+	ErrMoveToSameProject = errors.New("document is moved to the same project")
+
+	// ErrEphemeralDocumentNotMovable is returned when the ephemeral document
+	// is moved, or the document is moved to be ephemeral.
+	ErrEphemeralDocumentNotMovable = errors.New("ephemeral document can not be moved")
+
+	// ErrUnindexedMetadataKey is returned when the documents are filtered by
+	// the metadata key which is not indexed.
+	ErrUnindexedMetadataKey = errors.New("metadata key is not indexed")
+
+	// ErrDocumentNotArchived is returned when the document to unarchive is
+	// not archived.
+	ErrDocumentNotArchived = errors.New("document is not archived")
+
+	// ErrDocumentTemplateNotFound is returned when the template of the given
+	// ID is not registered in the project.
+	ErrDocumentTemplateNotFound = errors.New("document template not found")
+
+	// ErrCheckpointOutOfRange is returned when the changes after the given
+	// checkpoint can not be counted, because the checkpoint is ahead of the
+	// document or the changes after it are compacted.
+	ErrCheckpointOutOfRange = errors.New("checkpoint out of range")
+
+	// ErrDocumentRemoved is returned when the document to attach is removed
+	// and the project rejects attaching to the removed documents.
+	ErrDocumentRemoved = errors.New("document removed")
 )
 
 // ListDocumentSummaries returns a list of document summaries.
+//
+// NOTE: The view of the paging is pinned by the creation and removal times
+// of the documents rather than the snapshot reads of the database, as the
+// reads do not span the requests of the pages. It trades freshness for
+// consistency: the documents created during a pass are listed only in the
+// next pass, while the summaries are built from the latest contents.
 func ListDocumentSummaries(
 	ctx context.Context,
 	be *backend.Backend,
 	project *types.Project,
 	paging types.Paging[types.ID],
 ) ([]*types.DocumentSummary, error) {
+	for k := range paging.Metadata {
+		if !be.Config.IsIndexedMetadataKey(k) {
+			return nil, fmt.Errorf("%s: %w", k, ErrUnindexedMetadataKey)
+		}
+	}
+
 	docInfo, err := be.DB.FindDocInfosByPaging(ctx, project.ID, paging)
 	if err != nil {
 		return nil, err
@@ -42,7 +111,7 @@ func ListDocumentSummaries(
 
 	var summaries []*types.DocumentSummary
 	for _, docInfo := range docInfo {
-		doc, err := packs.BuildDocumentForServerSeq(ctx, be, docInfo, docInfo.ServerSeq)
+		doc, err := packs.BuildDocumentForServerSeq(ctx, be, project, docInfo, docInfo.ServerSeq)
 		if err != nil {
 			return nil, err
 		}
@@ -58,52 +127,159 @@ func ListDocumentSummaries(
 		}
 
 		summaries = append(summaries, &types.DocumentSummary{
-			ID:         docInfo.ID,
-			Key:        docInfo.Key,
-			CreatedAt:  docInfo.CreatedAt,
-			AccessedAt: docInfo.AccessedAt,
-			UpdatedAt:  docInfo.UpdatedAt,
-			Snapshot:   snapshot,
+			ID:              docInfo.ID,
+			Key:             docInfo.Key,
+			CreatedAt:       docInfo.CreatedAt,
+			AccessedAt:      docInfo.AccessedAt,
+			UpdatedAt:       docInfo.UpdatedAt,
+			Metadata:        docInfo.Metadata,
+			OperationCounts: docInfo.OperationCounts,
+			ArchivedAt:      docInfo.ArchivedAt,
+			Snapshot:        snapshot,
 		})
 	}
 
 	return summaries, nil
 }
 
-// GetDocumentSummary returns a document summary.
-func GetDocumentSummary(
+// StreamDocumentSummaries calls the given function with the summaries of all
+// the documents of the project in the order of their IDs. The documents are
+// read in batches from the view at the time when the stream starts, so the
+// documents created during the stream are not included. It stops when the
+// context is done or the function returns an error.
+func StreamDocumentSummaries(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	fn func(summary *types.DocumentSummary) error,
+) error {
+	paging := types.Paging[types.ID]{
+		PageSize:   streamBatchSize,
+		IsForward:  true,
+		SnapshotAt: gotime.Now(),
+	}
+
+	for {
+		summaries, err := ListDocumentSummaries(ctx, be, project, paging)
+		if err != nil {
+			return err
+		}
+
+		for _, summary := range summaries {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(summary); err != nil {
+				return err
+			}
+		}
+
+		if len(summaries) < streamBatchSize {
+			return nil
+		}
+		paging.Offset = summaries[len(summaries)-1].ID
+	}
+}
+
+// GetDocumentDetail returns a document summary with the status of the
+// document on the server. If includeRemoved is true, the latest removed
+// document of the key is returned when there is no live one.
+func GetDocumentDetail(
 	ctx context.Context,
 	be *backend.Backend,
 	project *types.Project,
 	k key.Key,
-) (*types.DocumentSummary, error) {
-	// TODO(hackerwins): Split FindDocInfoByKeyAndOwner into upsert and find.
-	docInfo, err := be.DB.FindDocInfoByKeyAndOwner(
-		ctx,
-		project.ID,
-		types.IDFromActorID(time.InitialActorID),
-		k,
-		false,
-	)
+	includeRemoved bool,
+) (*types.DocumentDetail, error) {
+	docInfo, err := findDocInfoByKey(ctx, be, project, k, includeRemoved)
 	if err != nil {
 		return nil, err
 	}
 
-	doc, err := packs.BuildDocumentForServerSeq(ctx, be, docInfo, docInfo.ServerSeq)
+	doc, err := packs.BuildDocumentForServerSeq(ctx, be, project, docInfo, docInfo.ServerSeq)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.DocumentSummary{
-		ID:         docInfo.ID,
-		Key:        docInfo.Key,
-		CreatedAt:  docInfo.CreatedAt,
-		AccessedAt: docInfo.AccessedAt,
-		UpdatedAt:  docInfo.UpdatedAt,
-		Snapshot:   doc.Marshal(),
+	snapshotInfo, err := be.DocDB(project, k).FindClosestSnapshotInfo(ctx, docInfo.ID, docInfo.ServerSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	attachedClients, err := be.DB.CountAttachedClients(ctx, project.ID, docInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	gcInterval, err := types.ParseDocumentGCInterval(docInfo.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	if gcInterval == 0 {
+		gcInterval = be.Housekeeping.Interval()
+	}
+
+	return &types.DocumentDetail{
+		Summary: &types.DocumentSummary{
+			ID:              docInfo.ID,
+			Key:             docInfo.Key,
+			CreatedAt:       docInfo.CreatedAt,
+			AccessedAt:      docInfo.AccessedAt,
+			UpdatedAt:       docInfo.UpdatedAt,
+			Metadata:        docInfo.Metadata,
+			OperationCounts: docInfo.OperationCounts,
+			ArchivedAt:      docInfo.ArchivedAt,
+			RemovedAt:       docInfo.RemovedAt,
+			Snapshot:        doc.Marshal(),
+		},
+		ServerSeq:         docInfo.ServerSeq,
+		SnapshotServerSeq: snapshotInfo.ServerSeq,
+		AttachedClients:   attachedClients,
+		GCInterval:        gcInterval,
 	}, nil
 }
 
+// CountChangesSinceCheckpoint returns the number of the changes of the given
+// document after the given server sequence of a checkpoint.
+//
+// NOTE: The server sequences of the changes of a document are contiguous, so
+// the changes are counted from the server sequence of the document without
+// reading them.
+func CountChangesSinceCheckpoint(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+	serverSeq uint64,
+	includeRemoved bool,
+) (uint64, error) {
+	docInfo, err := findDocInfoByKey(ctx, be, project, k, includeRemoved)
+	if err != nil {
+		return 0, err
+	}
+
+	if serverSeq > docInfo.ServerSeq {
+		return 0, fmt.Errorf(
+			"%s: checkpoint %d ahead of %d: %w",
+			k,
+			serverSeq,
+			docInfo.ServerSeq,
+			ErrCheckpointOutOfRange,
+		)
+	}
+	if serverSeq < docInfo.CompactedServerSeq {
+		return 0, fmt.Errorf(
+			"%s: checkpoint %d before compaction at %d: %w",
+			k,
+			serverSeq,
+			docInfo.CompactedServerSeq,
+			ErrCheckpointOutOfRange,
+		)
+	}
+
+	return docInfo.ServerSeq - serverSeq, nil
+}
+
 // GetDocumentByServerSeq returns a document for the given server sequence.
 func GetDocumentByServerSeq(
 	ctx context.Context,
@@ -112,7 +288,7 @@ func GetDocumentByServerSeq(
 	k key.Key,
 	serverSeq uint64,
 ) (*document.InternalDocument, error) {
-	docInfo, err := be.DB.FindDocInfoByKeyAndOwner(
+	docInfo, err := be.DocDB(project, k).FindDocInfoByKeyAndOwner(
 		ctx,
 		project.ID,
 		types.IDFromActorID(time.InitialActorID),
@@ -123,7 +299,7 @@ func GetDocumentByServerSeq(
 		return nil, err
 	}
 
-	doc, err := packs.BuildDocumentForServerSeq(ctx, be, docInfo, serverSeq)
+	doc, err := packs.BuildDocumentForServerSeq(ctx, be, project, docInfo, serverSeq)
 	if err != nil {
 		return nil, err
 	}
@@ -147,11 +323,14 @@ func SearchDocumentSummaries(
 	var summaries []*types.DocumentSummary
 	for _, docInfo := range res.Elements {
 		summaries = append(summaries, &types.DocumentSummary{
-			ID:         docInfo.ID,
-			Key:        docInfo.Key,
-			CreatedAt:  docInfo.CreatedAt,
-			AccessedAt: docInfo.AccessedAt,
-			UpdatedAt:  docInfo.UpdatedAt,
+			ID:              docInfo.ID,
+			Key:             docInfo.Key,
+			CreatedAt:       docInfo.CreatedAt,
+			AccessedAt:      docInfo.AccessedAt,
+			UpdatedAt:       docInfo.UpdatedAt,
+			Metadata:        docInfo.Metadata,
+			OperationCounts: docInfo.OperationCounts,
+			ArchivedAt:      docInfo.ArchivedAt,
 		})
 	}
 
@@ -168,13 +347,31 @@ func FindDocInfoByKey(
 	project *types.Project,
 	docKey key.Key,
 ) (*database.DocInfo, error) {
-	return be.DB.FindDocInfoByKey(
+	return be.DocDB(project, docKey).FindDocInfoByKey(
 		ctx,
 		project.ID,
 		docKey,
 	)
 }
 
+// findDocInfoByKey returns a document for the given document key. If
+// includeRemoved is true and there is no live document of the key, the latest
+// removed one is returned.
+func findDocInfoByKey(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docKey key.Key,
+	includeRemoved bool,
+) (*database.DocInfo, error) {
+	docInfo, err := be.DocDB(project, docKey).FindDocInfoByKey(ctx, project.ID, docKey)
+	if !includeRemoved || !errors.Is(err, database.ErrDocumentNotFound) {
+		return docInfo, err
+	}
+
+	return be.DocDB(project, docKey).FindRemovedDocInfoByKey(ctx, project.ID, docKey)
+}
+
 // FindDocInfoByKeyAndOwner returns a document for the given document key and owner.
 func FindDocInfoByKeyAndOwner(
 	ctx context.Context,
@@ -184,7 +381,30 @@ func FindDocInfoByKeyAndOwner(
 	docKey key.Key,
 	createDocIfNotExist bool,
 ) (*database.DocInfo, error) {
-	return be.DB.FindDocInfoByKeyAndOwner(
+	// NOTE: The key policy and the maintenance mode are only enforced when
+	// creating a new document, so that the existing documents are still
+	// accessible.
+	if createDocIfNotExist {
+		err := be.Maintenance.Check()
+		if err == nil {
+			err = project.DocumentKeyPolicy.Validate(docKey)
+		}
+		if err != nil {
+			docInfo, findErr := be.DocDB(project, docKey).FindDocInfoByKeyAndOwner(
+				ctx,
+				project.ID,
+				clientInfo.ID,
+				docKey,
+				false,
+			)
+			if errors.Is(findErr, database.ErrDocumentNotFound) {
+				return nil, err
+			}
+			return docInfo, findErr
+		}
+	}
+
+	return be.DocDB(project, docKey).FindDocInfoByKeyAndOwner(
 		ctx,
 		project.ID,
 		clientInfo.ID,
@@ -192,3 +412,998 @@ func FindDocInfoByKeyAndOwner(
 		createDocIfNotExist,
 	)
 }
+
+// FindDocInfoToAttach returns a document of the given key to attach. If the
+// document of the key is removed, the removed document policy of the project
+// decides whether to reject the attachment, to create a new document or to
+// restore the removed one, and the returned action reports which was taken.
+func FindDocInfoToAttach(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	clientInfo *database.ClientInfo,
+	docKey key.Key,
+	createDocIfNotExist bool,
+) (*database.DocInfo, types.RemovedDocumentAction, error) {
+	docInfo, err := FindDocInfoByKeyAndOwner(ctx, be, project, clientInfo, docKey, false)
+	if err == nil {
+		return docInfo, "", nil
+	}
+	if !errors.Is(err, database.ErrDocumentNotFound) {
+		return nil, "", err
+	}
+
+	removed, err := be.DocDB(project, docKey).FindRemovedDocInfoByKey(ctx, project.ID, docKey)
+	if errors.Is(err, database.ErrDocumentNotFound) {
+		docInfo, err := FindDocInfoByKeyAndOwner(ctx, be, project, clientInfo, docKey, createDocIfNotExist)
+		return docInfo, "", err
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	switch project.RemovedDocumentPolicyOrDefault() {
+	case types.RemovedDocumentReject:
+		return nil, "", fmt.Errorf("%s: %w", docKey, ErrDocumentRemoved)
+	case types.RemovedDocumentRestore:
+		retention := be.Config.ParseRemovedDocumentRetention()
+		if retention == 0 || gotime.Since(removed.RemovedAt) <= retention {
+			err := be.DocDB(project, docKey).RestoreDocInfo(ctx, project.ID, removed.ID)
+			if err == nil {
+				docInfo, err := be.DocDB(project, docKey).FindDocInfoByID(ctx, removed.ID)
+				if err != nil {
+					return nil, "", err
+				}
+				return docInfo, types.RemovedDocumentRestored, nil
+			}
+
+			// NOTE: Another client may have restored or recreated the
+			// document concurrently, so the live document is attached then.
+			if !errors.Is(err, database.ErrDocumentAlreadyExists) {
+				return nil, "", err
+			}
+			docInfo, err := FindDocInfoByKeyAndOwner(ctx, be, project, clientInfo, docKey, false)
+			return docInfo, "", err
+		}
+	}
+
+	docInfo, err = FindDocInfoByKeyAndOwner(ctx, be, project, clientInfo, docKey, createDocIfNotExist)
+	if err != nil {
+		return nil, "", err
+	}
+	return docInfo, types.RemovedDocumentRecreated, nil
+}
+
+// ListDocumentClientEvents returns the events of clients on the document of
+// the given key which occurred in [from, to).
+func ListDocumentClientEvents(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+	from gotime.Time,
+	to gotime.Time,
+	paging types.Paging[types.ID],
+) ([]*types.DocumentClientEvent, error) {
+	docInfo, err := be.DocDB(project, k).FindDocInfoByKey(ctx, project.ID, k)
+	if err != nil {
+		return nil, err
+	}
+
+	infos, err := be.DB.FindDocClientEventInfos(ctx, docInfo.ID, from, to, paging)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*types.DocumentClientEvent
+	for _, info := range infos {
+		events = append(events, info.ToDocumentClientEvent())
+	}
+
+	return events, nil
+}
+
+// GetDocumentVersionVector returns the largest Lamport timestamps of the
+// changes of each actor of the given document.
+func GetDocumentVersionVector(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+) (time.VersionVector, error) {
+	docInfo, err := be.DocDB(project, k).FindDocInfoByKey(ctx, project.ID, k)
+	if err != nil {
+		return nil, err
+	}
+
+	return packs.FindVersionVector(ctx, be, project, docInfo)
+}
+
+// ListDocumentEventLogs returns the entries of the event log of the given
+// document whose server sequences are in [from, to]. Zero to means the last
+// server sequence of the document.
+func ListDocumentEventLogs(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+	from uint64,
+	to uint64,
+) ([]*types.DocumentEventLog, error) {
+	docInfo, err := be.DocDB(project, k).FindDocInfoByKey(ctx, project.ID, k)
+	if err != nil {
+		return nil, err
+	}
+
+	if to == 0 {
+		to = docInfo.ServerSeq
+	}
+
+	return packs.FindEventLogs(ctx, be, project, docInfo, from, to)
+}
+
+// ListSnapshotMetas returns the metadata of the snapshots of the given
+// document retained for rollback from the latest one.
+func ListSnapshotMetas(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+) ([]*types.SnapshotMeta, error) {
+	docInfo, err := be.DocDB(project, k).FindDocInfoByKey(ctx, project.ID, k)
+	if err != nil {
+		return nil, err
+	}
+
+	infos, err := be.DocDB(project, k).FindSnapshotInfos(ctx, docInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var metas []*types.SnapshotMeta
+	for _, info := range infos {
+		metas = append(metas, info.ToSnapshotMeta())
+	}
+
+	return metas, nil
+}
+
+// RollbackDocument restores the given document to the retained snapshot of
+// the given server sequence.
+func RollbackDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+	serverSeq uint64,
+) error {
+	docInfo, err := be.DocDB(project, k).FindDocInfoByKey(ctx, project.ID, k)
+	if err != nil {
+		return err
+	}
+
+	return packs.RollbackDocument(ctx, be, project, docInfo, serverSeq)
+}
+
+// ResetDocument clears all the content of the given document while keeping
+// its key and the clients attaching it. Unlike removing the document, it
+// stays available and the clients converge to the empty document.
+func ResetDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+) error {
+	docInfo, err := be.DocDB(project, k).FindDocInfoByKey(ctx, project.ID, k)
+	if err != nil {
+		return err
+	}
+
+	return packs.ResetDocument(ctx, be, project, docInfo)
+}
+
+// ReplayChanges stores the given changes, authored in another system, in the
+// given order as the history of the document of the given key. The document
+// is created if it does not exist. Unlike creating a document from its final
+// content, the history authored is preserved.
+//
+// NOTE: The initial content of the project is not stored in the document
+// created by the replay, since the replayed history starts from the empty
+// document in the other system.
+func ReplayChanges(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+	changes []*change.Change,
+) (*types.ChangeReplay, error) {
+	if err := be.Maintenance.Check(); err != nil {
+		return nil, err
+	}
+	if err := project.DocumentKeyPolicy.Validate(k); err != nil {
+		return nil, err
+	}
+
+	docInfo, err := be.DocDB(project, k).FindDocInfoByKeyAndOwner(
+		ctx,
+		project.ID,
+		types.IDFromActorID(time.InitialActorID),
+		k,
+		true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return packs.ReplayChanges(ctx, be, project, docInfo, changes)
+}
+
+// ValidateDocument replays the change log of the given document and compares
+// the result with the latest snapshot of it.
+func ValidateDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+) (*types.DocumentValidation, error) {
+	docInfo, err := be.DocDB(project, k).FindDocInfoByKey(ctx, project.ID, k)
+	if err != nil {
+		return nil, err
+	}
+
+	return packs.ValidateDocument(ctx, be, project, docInfo)
+}
+
+// AnalyzeDocument returns the sizes of the subtrees of the given document up
+// to the given depth, to find the parts which make the document large.
+func AnalyzeDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+	maxDepth int,
+) (*types.DocumentAnalysis, error) {
+	docInfo, err := be.DocDB(project, k).FindDocInfoByKey(ctx, project.ID, k)
+	if err != nil {
+		return nil, err
+	}
+
+	return packs.AnalyzeDocument(ctx, be, project, docInfo, maxDepth)
+}
+
+// DiffDocument returns the JSON Patch (RFC 6902) which turns the given
+// document of fromServerSeq into the one of toServerSeq.
+func DiffDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+	fromServerSeq uint64,
+	toServerSeq uint64,
+) (jsonpatch.Patch, error) {
+	docInfo, err := be.DocDB(project, k).FindDocInfoByKey(ctx, project.ID, k)
+	if err != nil {
+		return nil, err
+	}
+
+	if fromServerSeq > toServerSeq || toServerSeq > docInfo.ServerSeq {
+		return nil, fmt.Errorf(
+			"diff %d to %d of %s with %d: %w",
+			fromServerSeq,
+			toServerSeq,
+			k,
+			docInfo.ServerSeq,
+			packs.ErrInvalidServerSeq,
+		)
+	}
+
+	from, err := packs.BuildDocumentForServerSeq(ctx, be, project, docInfo, fromServerSeq)
+	if err != nil {
+		return nil, err
+	}
+	to, err := packs.BuildDocumentForServerSeq(ctx, be, project, docInfo, toServerSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonpatch.Diff(from.RootObject(), to.RootObject()), nil
+}
+
+// CreateDocumentFromTemplate creates the document of the given key whose
+// content is the template of the given ID rendered with the given variables.
+// The content is stored as the first change of the document by the initial
+// actor, so it is pulled by every client attaching the document.
+func CreateDocumentFromTemplate(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+	templateID string,
+	variables map[string]string,
+) (*types.DocumentSummary, error) {
+	template, ok := project.DocumentTemplates[templateID]
+	if !ok {
+		return nil, fmt.Errorf("%s of %s: %w", templateID, project.Name, ErrDocumentTemplateNotFound)
+	}
+	content, err := types.RenderDocumentTemplate(template, variables)
+	if err != nil {
+		return nil, fmt.Errorf("render %s: %w", templateID, err)
+	}
+
+	if err := project.DocumentKeyPolicy.Validate(k); err != nil {
+		return nil, err
+	}
+
+	docInfo, err := be.DocDB(project, k).FindDocInfoByKeyAndOwner(
+		ctx,
+		project.ID,
+		types.IDFromActorID(time.InitialActorID),
+		k,
+		true,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if docInfo.ServerSeq > 0 {
+		return nil, fmt.Errorf("%s: %w", k, database.ErrDocumentAlreadyExists)
+	}
+
+	if err := packs.StoreTemplateContent(ctx, be, project, docInfo, templateID, content); err != nil {
+		return nil, err
+	}
+
+	doc, err := packs.BuildDocumentForServerSeq(ctx, be, project, docInfo, docInfo.ServerSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.DocumentSummary{
+		ID:              docInfo.ID,
+		Key:             docInfo.Key,
+		CreatedAt:       docInfo.CreatedAt,
+		AccessedAt:      docInfo.AccessedAt,
+		UpdatedAt:       docInfo.UpdatedAt,
+		Metadata:        docInfo.Metadata,
+		OperationCounts: docInfo.OperationCounts,
+		Snapshot:        doc.Marshal(),
+	}, nil
+}
+
+// CreateDocument creates an empty document of the given key owned by the given
+// owner. The initial content of the project is stored as the first change of
+// the document if it is set. It returns ErrDocumentAlreadyExists if the
+// document already exists.
+func CreateDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	ownerID types.ID,
+	k key.Key,
+) (*types.DocumentSummary, error) {
+	if err := be.Maintenance.Check(); err != nil {
+		return nil, err
+	}
+	if err := project.DocumentKeyPolicy.Validate(k); err != nil {
+		return nil, err
+	}
+
+	_, err := be.DocDB(project, k).FindDocInfoByKeyAndOwner(ctx, project.ID, ownerID, k, false)
+	if err == nil {
+		return nil, fmt.Errorf("%s: %w", k, database.ErrDocumentAlreadyExists)
+	}
+	if !errors.Is(err, database.ErrDocumentNotFound) {
+		return nil, err
+	}
+
+	docInfo, err := be.DocDB(project, k).FindDocInfoByKeyAndOwner(ctx, project.ID, ownerID, k, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := packs.StoreInitialContent(ctx, be, project, docInfo); err != nil {
+		return nil, err
+	}
+
+	doc, err := packs.BuildDocumentForServerSeq(ctx, be, project, docInfo, docInfo.ServerSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.DocumentSummary{
+		ID:              docInfo.ID,
+		Key:             docInfo.Key,
+		CreatedAt:       docInfo.CreatedAt,
+		AccessedAt:      docInfo.AccessedAt,
+		UpdatedAt:       docInfo.UpdatedAt,
+		Metadata:        docInfo.Metadata,
+		OperationCounts: docInfo.OperationCounts,
+		Snapshot:        doc.Marshal(),
+	}, nil
+}
+
+// LockDocument locks the given document so that changes can not be pushed to
+// it until it is unlocked. The given reason is shown to the rejected clients.
+func LockDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+	reason string,
+) error {
+	return updateDocumentLock(ctx, be, project, k, true, reason)
+}
+
+// UnlockDocument unlocks the given document.
+func UnlockDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+) error {
+	return updateDocumentLock(ctx, be, project, k, false, "")
+}
+
+// updateDocumentLock updates the lock of the given document while holding the
+// lock of pushes so that no push is in progress.
+func updateDocumentLock(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+	locked bool,
+	reason string,
+) error {
+	locker, err := be.Coordinator.NewLocker(ctx, packs.PushPullKey(project.ID, k))
+	if err != nil {
+		return err
+	}
+	if err := locker.Lock(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	docInfo, err := be.DocDB(project, k).FindDocInfoByKey(ctx, project.ID, k)
+	if err != nil {
+		return err
+	}
+
+	return be.DocDB(project, k).UpdateDocInfoLock(ctx, project.ID, docInfo.ID, locked, reason)
+}
+
+// SetDocumentMetadata replaces the metadata of the given document. The
+// metadata is outside the content of the document, so it does not need the
+// lock of pushes and the last update wins.
+func SetDocumentMetadata(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+	metadata map[string]string,
+) error {
+	if err := types.ValidateDocumentMetadata(metadata); err != nil {
+		return err
+	}
+
+	docInfo, err := be.DocDB(project, k).FindDocInfoByKey(ctx, project.ID, k)
+	if err != nil {
+		return err
+	}
+
+	return be.DocDB(project, k).UpdateDocInfoMetadata(ctx, project.ID, docInfo.ID, metadata)
+}
+
+// TransferDocumentOwnership sets the given owner to the owner metadata of the
+// given document, and then re-authorizes the clients attaching the document
+// with the auth webhook of the project. The clients which are no longer
+// permitted are detached from the document, and their IDs are returned.
+//
+// NOTE: The transfer is recorded in the client event log of each attaching
+// client. Since the owner is set before the re-authorization, the transfer
+// can be retried as a whole if the webhook fails.
+func TransferDocumentOwnership(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+	owner string,
+) ([]types.ID, error) {
+	locker, err := be.Coordinator.NewLocker(ctx, packs.PushPullKey(project.ID, k))
+	if err != nil {
+		return nil, err
+	}
+	if err := locker.Lock(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	docInfo, err := be.DocDB(project, k).FindDocInfoByKey(ctx, project.ID, k)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := make(map[string]string, len(docInfo.Metadata)+1)
+	for mk, mv := range docInfo.Metadata {
+		metadata[mk] = mv
+	}
+	metadata[types.DocumentOwnerMetadataKey] = owner
+	if err := types.ValidateDocumentMetadata(metadata); err != nil {
+		return nil, err
+	}
+	if err := be.DocDB(project, k).UpdateDocInfoMetadata(ctx, project.ID, docInfo.ID, metadata); err != nil {
+		return nil, err
+	}
+	webhook.SendEvent(be, project, types.DocumentOwnershipTransferredEvent, docInfo.Key)
+
+	clientInfos, err := be.DB.FindAttachedClientInfos(ctx, project.ID, docInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var detachedClientIDs []types.ID
+	for _, clientInfo := range clientInfos {
+		storeClientEvent(ctx, be, docInfo, clientInfo, types.DocumentOwnershipTransferredByServerEvent)
+
+		err := auth.Reauthorize(ctx, be, project, clientInfo, docInfo)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, auth.ErrNotAllowed) && !errors.Is(err, auth.ErrOperationNotAllowed) {
+			return nil, err
+		}
+
+		if err := detachDocument(ctx, be, project, docInfo, clientInfo); err != nil {
+			return nil, err
+		}
+		storeClientEvent(ctx, be, docInfo, clientInfo, types.DocumentDetachedByServerEvent)
+		detachedClientIDs = append(detachedClientIDs, clientInfo.ID)
+	}
+
+	logging.From(ctx).Infof(
+		"TRANSFER: '%s' is transferred to '%s', detached %d of %d clients",
+		docInfo.Key,
+		owner,
+		len(detachedClientIDs),
+		len(clientInfos),
+	)
+
+	return detachedClientIDs, nil
+}
+
+// detachDocument detaches the given document from the given client on the
+// server side.
+func detachDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	clientInfo *database.ClientInfo,
+) error {
+	if err := clientInfo.DetachDocument(docInfo.ID); err != nil {
+		return err
+	}
+	if err := be.DB.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo); err != nil {
+		return err
+	}
+
+	return be.DocDB(project, docInfo.Key).UpdateSyncedSeq(ctx, clientInfo, docInfo.ID, docInfo.ServerSeq)
+}
+
+// storeClientEvent stores the event of the client on the document. The history
+// is only for debugging, so the failure is logged without failing the caller.
+func storeClientEvent(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *database.DocInfo,
+	clientInfo *database.ClientInfo,
+	eventType types.DocumentClientEventType,
+) {
+	if err := be.DB.CreateDocClientEventInfo(ctx, docInfo.ID, clientInfo.ID, eventType); err != nil {
+		logging.From(ctx).Error(err)
+	}
+}
+
+// MoveDocument moves the given document with its snapshots and changes to the
+// destination project. The clients attached to the document are detached and
+// must attach it again under the destination project.
+//
+// NOTE: The changes keep the actors of the clients of the source project.
+// Since the IDs of clients are unique across projects, they do not collide
+// with the actors of the destination project, and they are not pruned from
+// the version vectors of the changes. Only the actors of the document used to
+// limit the number of actors are cleared.
+func MoveDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	srcProject *types.Project,
+	k key.Key,
+	dstProject *types.Project,
+) error {
+	if srcProject.ID == dstProject.ID {
+		return fmt.Errorf("%s: %w", dstProject.Name, ErrMoveToSameProject)
+	}
+	if srcProject.IsEphemeralDocument(k) || dstProject.IsEphemeralDocument(k) {
+		return fmt.Errorf("%s: %w", k, ErrEphemeralDocumentNotMovable)
+	}
+	if err := dstProject.DocumentKeyPolicy.Validate(k); err != nil {
+		return err
+	}
+
+	// NOTE: The locks are acquired in the order of the IDs of the projects to
+	// avoid the deadlock with the move in the opposite direction.
+	lockOrder := []*types.Project{srcProject, dstProject}
+	if dstProject.ID < srcProject.ID {
+		lockOrder = []*types.Project{dstProject, srcProject}
+	}
+	for _, project := range lockOrder {
+		locker, err := be.Coordinator.NewLocker(ctx, packs.PushPullKey(project.ID, k))
+		if err != nil {
+			return err
+		}
+		if err := locker.Lock(ctx); err != nil {
+			return err
+		}
+		defer func() {
+			if err := locker.Unlock(ctx); err != nil {
+				logging.From(ctx).Error(err)
+			}
+		}()
+	}
+
+	docInfo, err := be.DB.FindDocInfoByKey(ctx, srcProject.ID, k)
+	if err != nil {
+		return err
+	}
+
+	return be.DB.MoveDocInfo(ctx, srcProject.ID, docInfo.ID, dstProject.ID)
+}
+
+// ArchiveInactiveDocuments archives at most limit documents of the projects
+// with the archive period, which have been neither created nor updated during
+// the period. It returns the number of the archived documents.
+//
+// NOTE: Only the changes update the documents, so the documents which are
+// only read during the period are archived as well.
+func ArchiveInactiveDocuments(
+	ctx context.Context,
+	be *backend.Backend,
+	limit int,
+) (int, error) {
+	projectInfos, err := be.DB.ListProjectInfos(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	archived := 0
+	for _, projectInfo := range projectInfos {
+		project := projectInfo.ToProject()
+		archiveAfter := project.ParseArchiveAfter()
+		if archiveAfter == 0 {
+			continue
+		}
+
+		inactiveSince := gotime.Now().Add(-archiveAfter)
+		docInfos, err := be.DB.FindDocInfosToArchive(ctx, project.ID, inactiveSince, limit-archived)
+		if err != nil {
+			return archived, err
+		}
+
+		for _, docInfo := range docInfos {
+			ok, err := archiveDocument(ctx, be, project, docInfo.ID, inactiveSince)
+			if err != nil {
+				return archived, err
+			}
+			if ok {
+				archived++
+			}
+		}
+
+		if archived >= limit {
+			break
+		}
+	}
+
+	return archived, nil
+}
+
+// archiveDocument archives the given document if it is still inactive. The
+// document is kept as a snapshot at its current server sequence, and the
+// changes before it are removed. The lock of the document is held while
+// archiving it, so that no change is pushed in the meantime.
+func archiveDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docID types.ID,
+	inactiveSince gotime.Time,
+) (bool, error) {
+	docInfo, err := be.DB.FindDocInfoByID(ctx, docID)
+	if err != nil {
+		return false, err
+	}
+
+	locker, err := be.Coordinator.NewLocker(ctx, packs.PushPullKey(project.ID, docInfo.Key))
+	if err != nil {
+		return false, err
+	}
+	if err := locker.Lock(ctx); err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	// NOTE: Clients may have pushed changes while waiting for the lock.
+	docInfo, err = be.DB.FindDocInfoByID(ctx, docID)
+	if err != nil {
+		return false, err
+	}
+	if docInfo.IsRemoved() || docInfo.IsArchived() || docInfo.IsActiveSince(inactiveSince) {
+		return false, nil
+	}
+
+	if err := packs.StoreSnapshotAtHead(ctx, be, project, docInfo); err != nil {
+		return false, err
+	}
+	if err := be.DB.ArchiveDocInfo(ctx, project.ID, docInfo.ID, docInfo.ServerSeq); err != nil {
+		return false, err
+	}
+	webhook.SendEvent(be, project, types.DocumentArchivedEvent, docInfo.Key)
+
+	logging.From(ctx).Infof("ARCHIVE: '%s', serverSeq: %d", docInfo.Key, docInfo.ServerSeq)
+	return true, nil
+}
+
+// UnarchiveDocument unarchives the given document so that clients can attach
+// it again. The changes removed by archiving the document are not restored.
+func UnarchiveDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+) error {
+	locker, err := be.Coordinator.NewLocker(ctx, packs.PushPullKey(project.ID, k))
+	if err != nil {
+		return err
+	}
+	if err := locker.Lock(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	docInfo, err := be.DocDB(project, k).FindDocInfoByKey(ctx, project.ID, k)
+	if err != nil {
+		return err
+	}
+	if !docInfo.IsArchived() {
+		return fmt.Errorf("%s: %w", k, ErrDocumentNotArchived)
+	}
+
+	if err := be.DB.UnarchiveDocInfo(ctx, project.ID, docInfo.ID); err != nil {
+		return err
+	}
+	webhook.SendEvent(be, project, types.DocumentUnarchivedEvent, docInfo.Key)
+
+	return nil
+}
+
+// AddActor records the given client as an actor of the given document. It
+// returns an error if the document exceeds the limits of actors or of the
+// version vector by the client.
+func AddActor(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	clientInfo *database.ClientInfo,
+) error {
+	if maxSize := be.Config.MaxVersionVectorSize; maxSize > 0 {
+		attachedClients, err := be.DB.CountAttachedClients(ctx, project.ID, docInfo.ID)
+		if err != nil {
+			return err
+		}
+		if attachedClients >= maxSize {
+			return fmt.Errorf("%s: %w", docInfo.Key, ErrVersionVectorTooLarge)
+		}
+	}
+
+	return be.DocDB(project, docInfo.Key).AddDocActor(
+		ctx,
+		docInfo.ID,
+		clientInfo.ID,
+		be.Config.MaxActorsPerDocument,
+	)
+}
+
+// RemoveDocumentsByPrefix soft-removes the documents of the project whose keys
+// start with the given prefix. Documents attached by clients are skipped unless
+// force is true. If dryRun is true, the documents are only counted. It returns
+// the number of documents removed and the number of documents skipped.
+func RemoveDocumentsByPrefix(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	prefix string,
+	dryRun bool,
+	force bool,
+) (removed int, skipped int, err error) {
+	if prefix == "" {
+		return 0, 0, ErrEmptyKeyPrefix
+	}
+
+	var offset types.ID
+	for {
+		docInfos, err := be.DB.FindDocInfosByKeyPrefix(
+			ctx,
+			project.ID,
+			prefix,
+			offset,
+			removeBatchSize,
+		)
+		if err != nil {
+			return removed, skipped, err
+		}
+
+		for _, docInfo := range docInfos {
+			ok, err := removeDocument(ctx, be, project, docInfo, dryRun, force)
+			if err != nil {
+				return removed, skipped, err
+			}
+			if ok {
+				removed++
+			} else {
+				skipped++
+			}
+		}
+
+		if len(docInfos) < removeBatchSize {
+			return removed, skipped, nil
+		}
+		offset = docInfos[len(docInfos)-1].ID
+	}
+}
+
+// removeDocument soft-removes the given document if it can be removed. The
+// lock of the document is held only while removing it.
+func removeDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	dryRun bool,
+	force bool,
+) (bool, error) {
+	locker, err := be.Coordinator.NewLocker(ctx, packs.PushPullKey(project.ID, docInfo.Key))
+	if err != nil {
+		return false, err
+	}
+	if err := locker.Lock(ctx); err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	if !force {
+		attachedClients, err := be.DB.CountAttachedClients(ctx, project.ID, docInfo.ID)
+		if err != nil {
+			return false, err
+		}
+		if attachedClients > 0 {
+			return false, nil
+		}
+	}
+
+	if dryRun {
+		return true, nil
+	}
+
+	if err := be.DB.RemoveDocInfo(ctx, project.ID, docInfo.ID); err != nil {
+		// NOTE: The document may have been removed by another request.
+		if errors.Is(err, database.ErrDocumentNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	webhook.SendEvent(be, project, types.DocumentRemovedEvent, docInfo.Key)
+
+	return true, nil
+}
+
+// PurgeEphemeralDocuments discards the state of the ephemeral documents
+// detached by the given client in the background, if no client is attached to
+// them anymore.
+//
+// NOTE: The documents of clients deactivated by housekeeping are not purged
+// here. They are kept in memory until they are attached and detached again,
+// or until the server restarts.
+func PurgeEphemeralDocuments(
+	be *backend.Backend,
+	project *types.Project,
+	clientInfo *database.ClientInfo,
+) {
+	if project.EphemeralKeyPrefix == "" {
+		return
+	}
+
+	clientInfo = clientInfo.DeepCopy()
+	be.Background.AttachGoroutine(func(ctx context.Context) {
+		for docID := range clientInfo.Documents {
+			isAttached, err := clientInfo.IsAttached(docID)
+			if err != nil {
+				logging.From(ctx).Error(err)
+				return
+			}
+			if isAttached {
+				continue
+			}
+
+			docInfo, err := be.EphemeralDB.FindDocInfoByID(ctx, docID)
+			if errors.Is(err, database.ErrDocumentNotFound) {
+				continue
+			}
+			if err != nil {
+				logging.From(ctx).Error(err)
+				return
+			}
+
+			if err := purgeEphemeralDocument(ctx, be, project, docInfo); err != nil {
+				logging.From(ctx).Error(err)
+				return
+			}
+		}
+	})
+}
+
+// purgeEphemeralDocument discards the state of the given ephemeral document
+// if no client is attached to it. The lock of the document is held while
+// purging it, so that no client attaches it in the meantime.
+func purgeEphemeralDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+) error {
+	locker, err := be.Coordinator.NewLocker(ctx, packs.PushPullKey(project.ID, docInfo.Key))
+	if err != nil {
+		return err
+	}
+	if err := locker.Lock(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	attachedClients, err := be.DB.CountAttachedClients(ctx, project.ID, docInfo.ID)
+	if err != nil {
+		return err
+	}
+	if attachedClients > 0 {
+		return nil
+	}
+
+	if err := be.EphemeralDB.PurgeDocInfo(ctx, docInfo.ID); err != nil {
+		return err
+	}
+
+	logging.From(ctx).Infof("PURGE: ephemeral '%s'", docInfo.Key)
+	return nil
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpchelper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+
+	"github.com/yorkie-team/yorkie/server/backend"
+)
+
+// healthServicePrefix is the prefix of the methods of the health service,
+// which should report the status even while the database is unavailable.
+const healthServicePrefix = "/grpc.health.v1.Health/"
+
+// DBHealthInterceptor is an interceptor which fails the requests fast with
+// Unavailable while the connection to the database is lost, rather than
+// letting them hang until the connection is restored.
+type DBHealthInterceptor struct {
+	backend *backend.Backend
+}
+
+// NewDBHealthInterceptor creates a new instance of DBHealthInterceptor.
+func NewDBHealthInterceptor(be *backend.Backend) *DBHealthInterceptor {
+	return &DBHealthInterceptor{backend: be}
+}
+
+// Unary creates a unary server interceptor for the health of the database.
+// The requests in progress when the connection is lost are canceled.
+func (i *DBHealthInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+			return handler(ctx, req)
+		}
+
+		reqCtx, done, err := i.backend.DBHealth.Begin(ctx)
+		if err != nil {
+			return nil, ToStatusError(err)
+		}
+		defer done()
+
+		resp, err := handler(reqCtx, req)
+		if err != nil && ctx.Err() == nil && errors.Is(reqCtx.Err(), context.Canceled) {
+			return nil, ToStatusError(backend.ErrDBUnavailable)
+		}
+		return resp, err
+	}
+}
+
+// Stream creates a stream server interceptor for the health of the database.
+// The streams are only checked when they are opened, since they do not use
+// the database while they are kept.
+func (i *DBHealthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if !strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+			if err := i.backend.DBHealth.Check(); err != nil {
+				return ToStatusError(err)
+			}
+		}
+
+		return handler(srv, ss)
+	}
+}
@@ -26,14 +26,32 @@ import (
 
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/sync"
 	"github.com/yorkie-team/yorkie/server/clients"
+	"github.com/yorkie-team/yorkie/server/documents"
 	"github.com/yorkie-team/yorkie/server/packs"
+	"github.com/yorkie-team/yorkie/server/projects"
 	"github.com/yorkie-team/yorkie/server/rpc/auth"
 )
 
 func detailsFromError(err error) (protoiface.MessageV1, bool) {
+	var invalidChangePackError *packs.InvalidChangePackError
+	if errors.As(err, &invalidChangePackError) {
+		br := &errdetails.BadRequest{}
+		for _, violation := range invalidChangePackError.Violations {
+			br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       violation.Field,
+				Description: violation.Err.Error(),
+			})
+		}
+		return br, true
+	}
+
 	invalidFieldsError, ok := err.(*types.InvalidFieldsError)
 	if !ok {
 		return nil, false
@@ -51,6 +69,16 @@ func detailsFromError(err error) (protoiface.MessageV1, bool) {
 	return br, true
 }
 
+// statusWithDetails returns a status.Error of the given code with the details
+// of the given error if it has them.
+func statusWithDetails(code codes.Code, err error) error {
+	st := status.New(code, err.Error())
+	if details, ok := detailsFromError(err); ok {
+		st, _ = st.WithDetails(details)
+	}
+	return st.Err()
+}
+
 // ToStatusError returns a status.Error from the given logic error. If an error
 // occurs while executing logic in API handler, gRPC status.error should be
 // returned so that the client can know more about the status of the request.
@@ -69,13 +97,34 @@ func ToStatusError(err error) error {
 		errors.Is(err, types.ErrInvalidID) ||
 		errors.Is(err, clients.ErrInvalidClientID) ||
 		errors.Is(err, clients.ErrInvalidClientKey) ||
+		errors.Is(err, clients.ErrInvalidIdentity) ||
 		errors.Is(err, types.ErrEmptyProjectFields) ||
+		errors.Is(err, types.ErrInvalidDocumentKey) ||
+		errors.Is(err, types.ErrInvalidDocumentMetadata) ||
+		errors.Is(err, types.ErrInvalidFieldMask) ||
+		errors.Is(err, types.ErrMissingTemplateVariables) ||
+		errors.Is(err, types.ErrInvalidDocumentTemplate) ||
+		errors.Is(err, types.ErrInvalidPageToken) ||
+		errors.Is(err, types.ErrInvalidConsistencyToken) ||
+		errors.Is(err, types.ErrPageTokenExpired) ||
+		errors.Is(err, packs.ErrInvalidChunk) ||
+		errors.Is(err, packs.ErrLamportTooFarAhead) ||
+		errors.Is(err, packs.ErrActorMismatch) ||
+		errors.Is(err, types.ErrActorIDFormatMismatch) ||
+		errors.Is(err, packs.ErrClientSeqGap) ||
+		errors.Is(err, packs.ErrTypeMismatch) ||
+		errors.Is(err, change.ErrInvalidExecutedAt) ||
+		errors.Is(err, change.ErrLamportNotIncreasing) ||
+		errors.Is(err, packs.ErrChangeNotApplicable) ||
+		errors.Is(err, packs.ErrInvalidTransaction) ||
+		errors.Is(err, documents.ErrEmptyKeyPrefix) ||
+		errors.Is(err, projects.ErrTooManyProjectIDs) ||
+		errors.Is(err, documents.ErrMoveToSameProject) ||
+		errors.Is(err, documents.ErrUnindexedMetadataKey) ||
+		errors.Is(err, json.ErrInvalidPath) ||
+		errors.Is(err, json.ErrPathTooDeep) ||
 		errors.As(err, &invalidFieldsError) {
-		st := status.New(codes.InvalidArgument, err.Error())
-		if details, ok := detailsFromError(err); ok {
-			st, _ = st.WithDetails(details)
-		}
-		return st.Err()
+		return statusWithDetails(codes.InvalidArgument, err)
 	}
 
 	if errors.Is(err, converter.ErrUnsupportedOperation) ||
@@ -88,12 +137,35 @@ func ToStatusError(err error) error {
 
 	if errors.Is(err, database.ErrProjectNotFound) ||
 		errors.Is(err, database.ErrClientNotFound) ||
-		errors.Is(err, database.ErrDocumentNotFound) {
+		errors.Is(err, database.ErrDocumentNotFound) ||
+		errors.Is(err, packs.ErrSnapshotNotRetained) ||
+		errors.Is(err, documents.ErrDocumentTemplateNotFound) ||
+		errors.Is(err, documents.ErrDocumentRemoved) {
 		return status.Error(codes.NotFound, err.Error())
 	}
 
+	if errors.Is(err, database.ErrDocumentReadOnly) ||
+		errors.Is(err, auth.ErrOperationNotAllowed) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if errors.Is(err, packs.ErrOperationDisallowed) {
+		return statusWithDetails(codes.PermissionDenied, err)
+	}
+
+	if errors.Is(err, packs.ErrChangePackTooLarge) ||
+		errors.Is(err, packs.ErrValueTooLarge) ||
+		errors.Is(err, packs.ErrTooManyObjectKeys) ||
+		errors.Is(err, change.ErrMessageTooLarge) ||
+		errors.Is(err, database.ErrTooManyActors) ||
+		errors.Is(err, projects.ErrTooManyProjects) ||
+		errors.Is(err, documents.ErrVersionVectorTooLarge) {
+		return statusWithDetails(codes.ResourceExhausted, err)
+	}
+
 	if errors.Is(err, database.ErrProjectAlreadyExists) ||
-		errors.Is(err, database.ErrProjectNameAlreadyExists) {
+		errors.Is(err, database.ErrProjectNameAlreadyExists) ||
+		errors.Is(err, database.ErrDocumentAlreadyExists) {
 		return status.Error(codes.AlreadyExists, err.Error())
 	}
 
@@ -101,8 +173,32 @@ func ToStatusError(err error) error {
 		err == database.ErrDocumentNotAttached ||
 		err == database.ErrDocumentAlreadyAttached ||
 		errors.Is(err, packs.ErrInvalidServerSeq) ||
-		errors.Is(err, database.ErrConflictOnUpdate) {
-		return status.Error(codes.FailedPrecondition, err.Error())
+		errors.Is(err, sync.ErrSubtreeWatchDisabled) ||
+		errors.Is(err, database.ErrConflictOnUpdate) ||
+		errors.Is(err, database.ErrChangeAlreadyExists) ||
+		errors.Is(err, database.ErrTransactionNotSupported) ||
+		errors.Is(err, packs.ErrOperationAlreadyPushed) ||
+		errors.Is(err, database.ErrDocumentLocked) ||
+		errors.Is(err, database.ErrDocumentArchived) ||
+		errors.Is(err, documents.ErrDocumentNotArchived) ||
+		errors.Is(err, packs.ErrChangesCompacted) ||
+		errors.Is(err, documents.ErrEphemeralDocumentNotMovable) ||
+		errors.Is(err, packs.ErrFeatureDisabled) {
+		return statusWithDetails(codes.FailedPrecondition, err)
+	}
+
+	if errors.Is(err, documents.ErrCheckpointOutOfRange) {
+		return statusWithDetails(codes.OutOfRange, err)
+	}
+
+	if errors.Is(err, database.ErrQueryTimeout) {
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	if errors.Is(err, backend.ErrServerInMaintenance) ||
+		errors.Is(err, backend.ErrDBUnavailable) ||
+		errors.Is(err, packs.ErrCheckpointNotReached) {
+		return status.Error(codes.Unavailable, err.Error())
 	}
 
 	return status.Error(codes.Internal, err.Error())
@@ -0,0 +1,45 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logging
+
+// Config is the configuration of the logs of the server.
+type Config struct {
+	// Level is the minimum level of logs. One of "debug", "info", "warn",
+	// "error", "panic" and "fatal".
+	Level string `yaml:"Level"`
+
+	// Format is the format of logs. "console" for human-readable logs and
+	// "json" for structured logs.
+	Format string `yaml:"Format"`
+}
+
+// Validate validates this config.
+func (c *Config) Validate() error {
+	if c.Level != "" {
+		if _, err := parseLevel(c.Level); err != nil {
+			return err
+		}
+	}
+
+	if c.Format != "" {
+		if err := validateFormat(c.Format); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
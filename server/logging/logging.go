@@ -29,30 +29,102 @@ import (
 // Logger is a wrapper of zap.Logger.
 type Logger = *zap.SugaredLogger
 
+// The formats of logs.
+const (
+	// ConsoleFormat is the human-readable format for development. It is the
+	// default.
+	ConsoleFormat = "console"
+
+	// JSONFormat is the structured format for log aggregation in production.
+	// The name of a logger, e.g. the ID of the request, is kept in the "N"
+	// field and the fields added with With are kept as they are.
+	JSONFormat = "json"
+)
+
 var defaultLogger Logger
 var logLevel = zapcore.InfoLevel
+var logFormat = ConsoleFormat
+var baseLogger *zap.Logger
 var loggerOnce sync.Once
 
 // SetLogLevel sets the level of global logger with ["debug", "info", "warn", "error", "panic", "fatal"].
 // SetLoglevel must be sets before calling DefaultLogger() or New().
 func SetLogLevel(level string) error {
+	parsed, err := parseLevel(level)
+	if err != nil {
+		return err
+	}
+
+	logLevel = parsed
+	return nil
+}
+
+// SetLogFormat sets the format of global logger with ["console", "json"].
+// SetLogFormat must be set before calling DefaultLogger() or New().
+func SetLogFormat(format string) error {
+	if err := validateFormat(format); err != nil {
+		return err
+	}
+
+	logFormat = strings.ToLower(format)
+	return nil
+}
+
+// SetLogger sets the logger of the host application that the loggers of
+// Yorkie are derived from, so that the logs are integrated with the logs of
+// the application. The level and the format of the given logger are used
+// instead of the ones set by SetLogLevel and SetLogFormat. SetLogger must be
+// set before calling DefaultLogger() or New().
+func SetLogger(logger *zap.Logger) {
+	baseLogger = logger
+}
+
+// Configure sets the level and the format of global logger with the given
+// configuration. The empty values are left as they are.
+func Configure(conf *Config) error {
+	if conf.Level != "" {
+		if err := SetLogLevel(conf.Level); err != nil {
+			return err
+		}
+	}
+
+	if conf.Format != "" {
+		if err := SetLogFormat(conf.Format); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseLevel parses the given name of a log level.
+func parseLevel(level string) (zapcore.Level, error) {
 	switch strings.ToLower(level) {
 	case "debug":
-		logLevel = zapcore.DebugLevel
+		return zapcore.DebugLevel, nil
 	case "info":
-		logLevel = zapcore.InfoLevel
+		return zapcore.InfoLevel, nil
 	case "warn":
-		logLevel = zapcore.WarnLevel
+		return zapcore.WarnLevel, nil
 	case "error":
-		logLevel = zapcore.ErrorLevel
+		return zapcore.ErrorLevel, nil
 	case "panic":
-		logLevel = zapcore.PanicLevel
+		return zapcore.PanicLevel, nil
 	case "fatal":
-		logLevel = zapcore.FatalLevel
+		return zapcore.FatalLevel, nil
 	default:
-		return fmt.Errorf("invalid log level: %s", level)
+		return zapcore.InfoLevel, fmt.Errorf("invalid log level: %s", level)
+	}
+}
+
+// validateFormat validates the given name of a log format.
+func validateFormat(format string) error {
+	switch strings.ToLower(format) {
+	case ConsoleFormat, JSONFormat:
+		return nil
+	default:
+		return fmt.Errorf("invalid log format: %s", format)
 	}
-	return nil
 }
 
 // New creates a new logger with the given configuration.
@@ -75,9 +147,18 @@ func Enabled(level zapcore.Level) bool {
 
 // newLogger returns a new raw logger.
 func newLogger(name string) Logger {
+	if baseLogger != nil {
+		return baseLogger.Named(name).Sugar()
+	}
+
+	encoder := zapcore.NewConsoleEncoder(humanEncoderConfig())
+	if logFormat == JSONFormat {
+		encoder = zapcore.NewJSONEncoder(encoderConfig())
+	}
+
 	return zap.New(zapcore.NewTee(
 		zapcore.NewCore(
-			zapcore.NewConsoleEncoder(humanEncoderConfig()),
+			encoder,
 			zapcore.AddSync(os.Stdout),
 			logLevel,
 		),
@@ -0,0 +1,54 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logging_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+func TestLogging(t *testing.T) {
+	t.Run("set log format test", func(t *testing.T) {
+		assert.NoError(t, logging.SetLogFormat(logging.JSONFormat))
+		assert.NoError(t, logging.SetLogFormat(logging.ConsoleFormat))
+		assert.Error(t, logging.SetLogFormat("xml"))
+	})
+
+	t.Run("custom logger test", func(t *testing.T) {
+		core, logs := observer.New(zapcore.InfoLevel)
+		logging.SetLogger(zap.New(core))
+		defer logging.SetLogger(nil)
+
+		// The name and the fields of the request logger flow into the logs of
+		// the host application.
+		ctx := logging.With(context.Background(), logging.New("r1").With("project", "p1"))
+		logging.From(ctx).Infof("hello %s", "world")
+
+		entries := logs.All()
+		assert.Len(t, entries, 1)
+		assert.Equal(t, "r1", entries[0].LoggerName)
+		assert.Equal(t, "hello world", entries[0].Message)
+		assert.Equal(t, map[string]interface{}{"project": "p1"}, entries[0].ContextMap())
+	})
+}
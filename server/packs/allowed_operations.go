@@ -0,0 +1,69 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package packs
+
+import (
+	"errors"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+)
+
+// ErrOperationDisallowed is returned when the given changes have an operation
+// whose type is not allowed in the project.
+var ErrOperationDisallowed = errors.New("operation is not allowed")
+
+// operationType returns the type of the given operation.
+func operationType(op operations.Operation) types.OperationType {
+	switch op.(type) {
+	case *operations.Set:
+		return types.OperationSet
+	case *operations.Add:
+		return types.OperationAdd
+	case *operations.Move:
+		return types.OperationMove
+	case *operations.Remove:
+		return types.OperationRemove
+	case *operations.Edit:
+		return types.OperationEdit
+	case *operations.Select:
+		return types.OperationSelect
+	case *operations.RichEdit:
+		return types.OperationRichEdit
+	case *operations.Style:
+		return types.OperationStyle
+	case *operations.Increase:
+		return types.OperationIncrease
+	case *operations.TreeEdit:
+		return types.OperationTreeEdit
+	case *operations.TreeStyle:
+		return types.OperationTreeStyle
+	case *operations.Clear:
+		return types.OperationClear
+	}
+
+	return ""
+}
+
+// operationTypeLabel returns the type of the given operation as a label of
+// metrics. The unknown types share a label to keep the cardinality bounded.
+func operationTypeLabel(op operations.Operation) string {
+	if t := operationType(op); t != "" {
+		return string(t)
+	}
+	return "unknown"
+}
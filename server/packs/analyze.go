@@ -0,0 +1,57 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+)
+
+// AnalyzeDocument returns the sizes of the subtrees of the latest version of
+// the given document up to the given depth, measured in the bytes of its
+// snapshot. The depth is DefaultDocumentAnalysisDepth if it is not positive,
+// and capped to MaxDocumentAnalysisDepth.
+func AnalyzeDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	maxDepth int,
+) (*types.DocumentAnalysis, error) {
+	if maxDepth <= 0 {
+		maxDepth = types.DefaultDocumentAnalysisDepth
+	} else if maxDepth > types.MaxDocumentAnalysisDepth {
+		maxDepth = types.MaxDocumentAnalysisDepth
+	}
+
+	doc, err := BuildDocumentForServerSeq(ctx, be, project, docInfo, docInfo.ServerSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	analysis, err := converter.AnalyzeObject(doc.RootObject(), maxDepth)
+	if err != nil {
+		return nil, err
+	}
+	analysis.ServerSeq = docInfo.ServerSeq
+
+	return analysis, nil
+}
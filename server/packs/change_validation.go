@@ -0,0 +1,98 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+)
+
+// ErrChangeNotApplicable is returned when a change passes the validation but
+// fails to be applied to the document.
+var ErrChangeNotApplicable = errors.New("change not applicable")
+
+// ValidateChange passes the changes of the given pack through the validation
+// of pushes and applies them to a copy of the document, and then discards the
+// result. It returns the error that PushPull would return for the changes.
+//
+// NOTE: Nothing is stored and the server sequence and the lamport of the
+// document and the checkpoint of the client are not advanced, so the
+// validation does not hold the lock of the document.
+func ValidateChange(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	clientInfo *database.ClientInfo,
+	docInfo *database.DocInfo,
+	reqPack *change.Pack,
+) error {
+	handler := ChainPushMiddlewares(applyChangesToCopy, authorizePush, validatePush)
+	return handler(ctx, &PushRequest{
+		Backend:          be,
+		Project:          project,
+		ClientInfo:       clientInfo,
+		DocInfo:          docInfo,
+		Pack:             reqPack,
+		InitialServerSeq: docInfo.ServerSeq,
+	})
+}
+
+// applyChangesToCopy applies the changes of the given request, excluding the
+// ones already pushed, to a copy of the document built at its server sequence.
+func applyChangesToCopy(ctx context.Context, req *PushRequest) error {
+	cp := req.ClientInfo.Checkpoint(req.DocInfo.ID)
+
+	var changes []*change.Change
+	var fields []string
+	for i, cn := range req.Pack.Changes {
+		if cn.ID().ClientSeq() > cp.ClientSeq {
+			changes = append(changes, cn)
+			fields = append(fields, fmt.Sprintf("changes[%d]", i))
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	doc, err := BuildDocumentForServerSeq(
+		ctx,
+		req.Backend,
+		req.Project,
+		req.DocInfo,
+		req.InitialServerSeq,
+	)
+	if err != nil {
+		return err
+	}
+
+	for i, cn := range changes {
+		if err := doc.ApplyChanges(cn); err != nil {
+			return &InvalidChangePackError{Violations: []*Violation{{
+				Field: fields[i],
+				Err:   fmt.Errorf("%s: %w", err.Error(), ErrChangeNotApplicable),
+			}}}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrChangePackTooLarge is returned when the given change pack exceeds
+	// the maximum size.
+	ErrChangePackTooLarge = errors.New("change pack too large")
+
+	// ErrInvalidChunk is returned when the given chunk of a change pack is
+	// out of order or inconsistent with the previous chunks.
+	ErrInvalidChunk = errors.New("invalid change pack chunk")
+)
+
+// ChunkAssembler reassembles a serialized change pack from the chunks sent in
+// order by PushChangesStream.
+type ChunkAssembler struct {
+	maxSize uint64
+
+	nextSeq   uint32
+	totalSize uint64
+	clientID  []byte
+	buf       []byte
+}
+
+// NewChunkAssembler creates a new instance of ChunkAssembler. If maxSize is
+// 0, the size of the change pack is not limited.
+func NewChunkAssembler(maxSize uint64) *ChunkAssembler {
+	return &ChunkAssembler{
+		maxSize: maxSize,
+	}
+}
+
+// Add appends the given chunk. The first chunk determines the client and the
+// total size of the change pack, and the following chunks must match them.
+func (a *ChunkAssembler) Add(clientID []byte, seq uint32, totalSize uint64, chunk []byte) error {
+	if seq != a.nextSeq {
+		return fmt.Errorf("expected seq %d, given %d: %w", a.nextSeq, seq, ErrInvalidChunk)
+	}
+
+	if seq == 0 {
+		if a.maxSize > 0 && totalSize > a.maxSize {
+			return fmt.Errorf(
+				"total size %d exceeds %d bytes: %w",
+				totalSize,
+				a.maxSize,
+				ErrChangePackTooLarge,
+			)
+		}
+		a.clientID = clientID
+		a.totalSize = totalSize
+		a.buf = make([]byte, 0, totalSize)
+	} else if totalSize != a.totalSize || !bytes.Equal(clientID, a.clientID) {
+		return fmt.Errorf("chunk %d does not match the first chunk: %w", seq, ErrInvalidChunk)
+	}
+
+	if uint64(len(a.buf))+uint64(len(chunk)) > a.totalSize {
+		return fmt.Errorf("chunk %d exceeds total size %d: %w", seq, a.totalSize, ErrInvalidChunk)
+	}
+
+	a.buf = append(a.buf, chunk...)
+	a.nextSeq++
+	return nil
+}
+
+// ClientID returns the ID of the client that sends the chunks.
+func (a *ChunkAssembler) ClientID() []byte {
+	return a.clientID
+}
+
+// Bytes returns the reassembled change pack. It returns an error if the
+// chunks have not been received completely.
+func (a *ChunkAssembler) Bytes() ([]byte, error) {
+	if a.nextSeq == 0 {
+		return nil, fmt.Errorf("no chunks: %w", ErrInvalidChunk)
+	}
+
+	if uint64(len(a.buf)) != a.totalSize {
+		return nil, fmt.Errorf(
+			"received %d of %d bytes: %w",
+			len(a.buf),
+			a.totalSize,
+			ErrInvalidChunk,
+		)
+	}
+
+	return a.buf, nil
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/server/packs"
+)
+
+func TestChunkAssembler(t *testing.T) {
+	clientID := []byte{1, 2, 3}
+
+	t.Run("reassemble test", func(t *testing.T) {
+		assembler := packs.NewChunkAssembler(10)
+		assert.NoError(t, assembler.Add(clientID, 0, 6, []byte("abc")))
+		assert.NoError(t, assembler.Add(clientID, 1, 6, []byte("de")))
+		_, err := assembler.Bytes()
+		assert.ErrorIs(t, err, packs.ErrInvalidChunk)
+
+		assert.NoError(t, assembler.Add(clientID, 2, 6, []byte("f")))
+		data, err := assembler.Bytes()
+		assert.NoError(t, err)
+		assert.Equal(t, "abcdef", string(data))
+		assert.Equal(t, clientID, assembler.ClientID())
+	})
+
+	t.Run("ordering test", func(t *testing.T) {
+		assembler := packs.NewChunkAssembler(0)
+		assert.ErrorIs(t, assembler.Add(clientID, 1, 6, []byte("abc")), packs.ErrInvalidChunk)
+		assert.NoError(t, assembler.Add(clientID, 0, 6, []byte("abc")))
+		assert.ErrorIs(t, assembler.Add(clientID, 0, 6, []byte("abc")), packs.ErrInvalidChunk)
+		assert.ErrorIs(t, assembler.Add(clientID, 2, 6, []byte("def")), packs.ErrInvalidChunk)
+	})
+
+	t.Run("size test", func(t *testing.T) {
+		assembler := packs.NewChunkAssembler(5)
+		assert.ErrorIs(t, assembler.Add(clientID, 0, 6, []byte("abc")), packs.ErrChangePackTooLarge)
+
+		assembler = packs.NewChunkAssembler(10)
+		assert.NoError(t, assembler.Add(clientID, 0, 4, []byte("abc")))
+		assert.ErrorIs(t, assembler.Add(clientID, 1, 4, []byte("de")), packs.ErrInvalidChunk)
+		assert.ErrorIs(t, assembler.Add(clientID, 1, 5, []byte("d")), packs.ErrInvalidChunk)
+		assert.ErrorIs(t, assembler.Add([]byte{4}, 1, 4, []byte("d")), packs.ErrInvalidChunk)
+
+		_, err := packs.NewChunkAssembler(10).Bytes()
+		assert.ErrorIs(t, err, packs.ErrInvalidChunk)
+	})
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+// recordConflictWins applies the given changes, stored right after the given
+// server sequence, to the head of the document and counts the concurrent
+// writes won by each actor. It is called once per stored change while the
+// document is locked, so each conflict is counted exactly once.
+func recordConflictWins(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	serverSeq uint64,
+	changes []*change.Change,
+) {
+	// NOTE: Only Set operations can conflict, so the document is not built
+	// for the changes without them.
+	if _, ok := be.HeadDocumentCache.Get(docInfo.ID); !ok && !hasSetOperations(changes) {
+		return
+	}
+
+	head, err := lockHeadDocument(ctx, be, project, docInfo, serverSeq)
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return
+	}
+	defer head.Unlock()
+
+	head.Doc.SetObjectMergePolicy(json.MergePolicy(project.ObjectMergePolicy))
+	head.Doc.SetConflictObserver(func(exposed, _ json.Element) {
+		be.ConflictWins.Record(project.ID, types.IDFromActorID(exposed.CreatedAt().ActorID()))
+	})
+	err = head.Doc.ApplyChanges(changes...)
+	head.Doc.SetConflictObserver(nil)
+	if err != nil {
+		// NOTE: The document may be partially applied, so it is not reused.
+		head.Doc = nil
+		be.HeadDocumentCache.Remove(docInfo.ID)
+		logging.From(ctx).Error(err)
+		return
+	}
+
+	head.ServerSeq = docInfo.ServerSeq
+}
+
+// hasSetOperations returns whether the given changes have Set operations.
+func hasSetOperations(changes []*change.Change) bool {
+	for _, c := range changes {
+		for _, op := range c.Operations() {
+			if _, ok := op.(*operations.Set); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
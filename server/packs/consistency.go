@@ -0,0 +1,111 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+)
+
+const (
+	// catchUpMinInterval is the first interval to read the document again
+	// while catching up to a consistency token.
+	catchUpMinInterval = 10 * gotime.Millisecond
+
+	// catchUpMaxInterval is the max interval to read the document again while
+	// catching up to a consistency token.
+	catchUpMaxInterval = 100 * gotime.Millisecond
+)
+
+// ErrCheckpointNotReached is returned when the server does not catch up to
+// the write of a consistency token within ReadYourWritesTimeout.
+var ErrCheckpointNotReached = errors.New("checkpoint not reached")
+
+// IssueConsistencyToken returns the consistency token of the current version
+// of the given document, which the client passes to the later requests to
+// read its own writes.
+func IssueConsistencyToken(docInfo *database.DocInfo) (string, error) {
+	return types.NewConsistencyToken(docInfo.ID, docInfo.ServerSeq).Encode()
+}
+
+// CatchUp returns the given document if it has caught up to the write of the
+// given consistency token. Otherwise, it reads the document again with
+// backoff until the document catches up, e.g. when the database replica read
+// by this server is behind the primary written by another server. It returns
+// ErrCheckpointNotReached if the document does not catch up within
+// ReadYourWritesTimeout. An empty token is caught up to by any version.
+func CatchUp(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *database.DocInfo,
+	token string,
+) (*database.DocInfo, error) {
+	if token == "" {
+		return docInfo, nil
+	}
+
+	consistencyToken, err := types.DecodeConsistencyToken(token, docInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+	if docInfo.ServerSeq >= consistencyToken.ServerSeq {
+		return docInfo, nil
+	}
+
+	timeout := be.Config.ParseReadYourWritesTimeout()
+	deadline := gotime.Now().Add(timeout)
+	interval := catchUpMinInterval
+	for {
+		remaining := gotime.Until(deadline)
+		if remaining <= 0 {
+			return nil, fmt.Errorf(
+				"%d of %s, read %d in %s: %w",
+				consistencyToken.ServerSeq,
+				docInfo.ID,
+				docInfo.ServerSeq,
+				timeout,
+				ErrCheckpointNotReached,
+			)
+		}
+		if interval > remaining {
+			interval = remaining
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-gotime.After(interval):
+		}
+		if interval *= 2; interval > catchUpMaxInterval {
+			interval = catchUpMaxInterval
+		}
+
+		docInfo, err = be.DB.FindDocInfoByID(ctx, docInfo.ID)
+		if err != nil {
+			return nil, err
+		}
+		if docInfo.ServerSeq >= consistencyToken.ServerSeq {
+			return docInfo, nil
+		}
+	}
+}
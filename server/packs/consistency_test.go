@@ -0,0 +1,151 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/database/memory"
+	"github.com/yorkie-team/yorkie/server/packs"
+)
+
+// staleDB is a database whose reads of documents are behind the writes a
+// given number of times, like a replica behind the primary.
+type staleDB struct {
+	database.Database
+
+	// staleReads is the number of the next reads which miss the changes.
+	staleReads int
+
+	reads int
+}
+
+func (d *staleDB) FindDocInfoByID(ctx context.Context, id types.ID) (*database.DocInfo, error) {
+	d.reads++
+	docInfo, err := d.Database.FindDocInfoByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if d.staleReads == 0 {
+		return docInfo, nil
+	}
+
+	d.staleReads--
+	stale := *docInfo
+	stale.ServerSeq = 0
+	return &stale, nil
+}
+
+func TestCatchUp(t *testing.T) {
+	ctx := context.Background()
+
+	// setup creates a backend with the given database and a document which
+	// has 3 changes, and returns the document read before the changes.
+	setup := func(t *testing.T, db *staleDB, timeout string) (*backend.Backend, *database.DocInfo, string) {
+		idGenerator, err := database.NewIDGenerator(database.ObjectIDGeneratorName, nil)
+		assert.NoError(t, err)
+		memDB, err := memory.New(idGenerator)
+		assert.NoError(t, err)
+		db.Database = memDB
+
+		projectInfo, err := memDB.EnsureDefaultProjectInfo(ctx)
+		assert.NoError(t, err)
+		clientInfo, err := memDB.ActivateClient(ctx, projectInfo.ID, t.Name())
+		assert.NoError(t, err)
+		docInfo, err := memDB.FindDocInfoByKeyAndOwner(ctx, projectInfo.ID, clientInfo.ID, key.Key(t.Name()), true)
+		assert.NoError(t, err)
+		staleDocInfo := *docInfo
+
+		actorID, err := time.ActorIDFromHex(clientInfo.ID.String())
+		assert.NoError(t, err)
+		doc := document.New(docInfo.Key)
+		doc.SetActor(actorID)
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger("k", i)
+				return nil
+			}))
+		}
+		pack := doc.CreateChangePack()
+		for _, c := range pack.Changes {
+			c.SetServerSeq(docInfo.IncreaseServerSeq())
+		}
+		assert.NoError(t, memDB.CreateChangeInfos(ctx, projectInfo.ID, docInfo, 0, pack.Changes))
+
+		token, err := packs.IssueConsistencyToken(docInfo)
+		assert.NoError(t, err)
+
+		return &backend.Backend{
+			Config: &backend.Config{ReadYourWritesTimeout: timeout},
+			DB:     db,
+		}, &staleDocInfo, token
+	}
+
+	t.Run("catch up to token test", func(t *testing.T) {
+		db := &staleDB{staleReads: 2}
+		be, docInfo, token := setup(t, db, "1s")
+
+		caughtUp, err := packs.CatchUp(ctx, be, docInfo, token)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(3), caughtUp.ServerSeq)
+		assert.Equal(t, 3, db.reads)
+
+		// the document which has caught up is not read again.
+		_, err = packs.CatchUp(ctx, be, caughtUp, token)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, db.reads)
+	})
+
+	t.Run("checkpoint not reached test", func(t *testing.T) {
+		db := &staleDB{staleReads: 1000}
+		be, docInfo, token := setup(t, db, "50ms")
+
+		_, err := packs.CatchUp(ctx, be, docInfo, token)
+		assert.ErrorIs(t, err, packs.ErrCheckpointNotReached)
+		assert.Positive(t, db.reads)
+
+		be.Config.ReadYourWritesTimeout = "0s"
+		db.reads = 0
+		_, err = packs.CatchUp(ctx, be, docInfo, token)
+		assert.ErrorIs(t, err, packs.ErrCheckpointNotReached)
+		assert.Zero(t, db.reads)
+	})
+
+	t.Run("empty or invalid token test", func(t *testing.T) {
+		db := &staleDB{}
+		be, docInfo, _ := setup(t, db, "1s")
+
+		caughtUp, err := packs.CatchUp(ctx, be, docInfo, "")
+		assert.NoError(t, err)
+		assert.Same(t, docInfo, caughtUp)
+
+		token, err := types.NewConsistencyToken("6400a1b2c3d4e5f6a7b8c9d0", 1).Encode()
+		assert.NoError(t, err)
+		_, err = packs.CatchUp(ctx, be, docInfo, token)
+		assert.ErrorIs(t, err, types.ErrInvalidConsistencyToken)
+	})
+}
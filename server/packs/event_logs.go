@@ -0,0 +1,176 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+// Fingerprint returns the fingerprint of a document after the given change is
+// applied to the document of the given fingerprint. The fingerprint of a new
+// document is empty.
+//
+// The fingerprint chains the hashes of the changes, so the replicas which
+// applied the same changes in the same order have the same fingerprint, and
+// the first entry of the event log whose fingerprint differs from the one of
+// a replica is the change where the replica diverged.
+func Fingerprint(prev string, c *change.Change) (string, error) {
+	encodedOps, err := database.EncodeOperations(c.Operations())
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(prev))
+	hash.Write(c.ID().ActorID().Bytes())
+	var seqs [12]byte
+	binary.BigEndian.PutUint32(seqs[:4], c.ID().ClientSeq())
+	binary.BigEndian.PutUint64(seqs[4:], c.ID().Lamport())
+	hash.Write(seqs[:])
+	for _, op := range encodedOps {
+		hash.Write(op)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// FindEventLogs returns the entries of the event log of the given document
+// whose server sequences are in [from, to].
+func FindEventLogs(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	from uint64,
+	to uint64,
+) ([]*types.DocumentEventLog, error) {
+	infos, err := be.DocDB(project, docInfo.Key).FindDocEventLogInfos(ctx, docInfo.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var logs []*types.DocumentEventLog
+	for _, info := range infos {
+		log, err := info.ToDocumentEventLog()
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, log)
+	}
+
+	return logs, nil
+}
+
+// appendEventLogs appends the entries of the given changes stored after the
+// given server sequence to the event log of the document, if the event log is
+// enabled. The event log is for debugging, so the failure is only logged
+// rather than failing the changes.
+func appendEventLogs(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	initialServerSeq uint64,
+	changes []*change.Change,
+) {
+	// NOTE: The event logs of ephemeral documents are not recorded since
+	// they are not removed by housekeeping, which only cleans up DB.
+	if !be.Config.EnableDocEventLog ||
+		len(changes) == 0 ||
+		project.IsEphemeralDocument(docInfo.Key) {
+		return
+	}
+
+	if err := storeEventLogs(ctx, be, project, docInfo, initialServerSeq, changes); err != nil {
+		logging.From(ctx).Error(err)
+	}
+}
+
+// storeEventLogs stores the entries of the given changes stored after the
+// given server sequence.
+func storeEventLogs(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	initialServerSeq uint64,
+	changes []*change.Change,
+) error {
+	db := be.DocDB(project, docInfo.Key)
+
+	// NOTE: The entry of the change before the given ones is missing if it is
+	// removed after the retention period or the event log is enabled after
+	// the change. Then the version vector is computed from the changes, and
+	// the chain of the fingerprints restarts from the given changes.
+	var vector time.VersionVector
+	var fingerprint string
+	prevs, err := db.FindDocEventLogInfos(ctx, docInfo.ID, initialServerSeq, initialServerSeq)
+	if err != nil {
+		return err
+	}
+	if len(prevs) > 0 {
+		if vector, err = converter.BytesToVersionVector(prevs[0].VersionVector); err != nil {
+			return err
+		}
+		fingerprint = prevs[0].Fingerprint
+	} else {
+		prevDocInfo := docInfo.DeepCopy()
+		prevDocInfo.ServerSeq = initialServerSeq
+		if vector, err = FindVersionVector(ctx, be, project, prevDocInfo); err != nil {
+			return err
+		}
+	}
+
+	now := gotime.Now()
+	infos := make([]*database.DocEventLogInfo, 0, len(changes))
+	for _, c := range changes {
+		vector.Set(c.ID().ActorID(), c.ID().Lamport())
+		encodedVector, err := converter.VersionVectorToBytes(vector)
+		if err != nil {
+			return err
+		}
+		if fingerprint, err = Fingerprint(fingerprint, c); err != nil {
+			return err
+		}
+
+		infos = append(infos, &database.DocEventLogInfo{
+			DocID:          docInfo.ID,
+			ServerSeq:      c.ServerSeq(),
+			ActorID:        types.IDFromActorID(c.ID().ActorID()),
+			ClientSeq:      c.ClientSeq(),
+			Lamport:        c.ID().Lamport(),
+			OperationCount: len(c.Operations()),
+			VersionVector:  encodedVector,
+			Fingerprint:    fingerprint,
+			CreatedAt:      now,
+		})
+	}
+
+	return db.CreateDocEventLogInfos(ctx, infos)
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package packs
+
+import (
+	"errors"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+)
+
+// ErrFeatureDisabled is returned when the given changes use a feature which
+// is disabled in the project.
+var ErrFeatureDisabled = errors.New("feature is disabled")
+
+// requiredFeatures returns the features used by the given operation.
+func requiredFeatures(op operations.Operation) []types.Feature {
+	switch op := op.(type) {
+	case *operations.TreeEdit, *operations.TreeStyle:
+		return []types.Feature{types.FeatureTree}
+	case *operations.RichEdit, *operations.Style:
+		return []types.Feature{types.FeatureRichText}
+	case *operations.Set:
+		return elementFeatures(op.Value())
+	case *operations.Add:
+		return elementFeatures(op.Value())
+	}
+
+	return nil
+}
+
+// elementFeatures returns the features used by the given element and its
+// descendants.
+func elementFeatures(elem json.Element) []types.Feature {
+	var features []types.Feature
+	visit := func(elem json.Element) {
+		switch elem.(type) {
+		case *json.Tree:
+			features = append(features, types.FeatureTree)
+		case *json.RichText:
+			features = append(features, types.FeatureRichText)
+		}
+	}
+
+	visit(elem)
+	if container, ok := elem.(json.Container); ok {
+		container.Descendants(func(elem json.Element, _ json.Container) bool {
+			visit(elem)
+			return false
+		})
+	}
+
+	return features
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/background"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+// CollectGarbageByInterval collects the garbage of at most limit documents
+// after the given offset which have their own GC intervals, if the intervals
+// have elapsed since their latest snapshots. It returns the ID of the last
+// document scanned, which is empty if no document is left, and the number of
+// the collected documents.
+func CollectGarbageByInterval(
+	ctx context.Context,
+	be *backend.Backend,
+	offset types.ID,
+	limit int,
+) (types.ID, int, error) {
+	docInfos, err := be.DB.FindDocInfosWithGCInterval(ctx, offset, limit)
+	if err != nil {
+		return offset, 0, err
+	}
+
+	// NOTE: The documents found are pending until they are collected one by
+	// one, so that the backlog of the collection is observable.
+	dones := make([]func(), len(docInfos))
+	for i := range docInfos {
+		dones[i] = be.Background.EnqueueJob(background.JobGC)
+	}
+
+	collected := 0
+	for i, docInfo := range docInfos {
+		ok, err := collectGarbage(ctx, be, docInfo)
+		dones[i]()
+		if err != nil {
+			for _, done := range dones[i+1:] {
+				done()
+			}
+			return offset, collected, err
+		}
+		if ok {
+			collected++
+		}
+	}
+
+	if len(docInfos) < limit {
+		return "", collected, nil
+	}
+	return docInfos[len(docInfos)-1].ID, collected, nil
+}
+
+// collectGarbage stores a new snapshot of the given document to collect its
+// garbage, if the GC interval of the document has elapsed since the latest
+// snapshot. It returns false if the document is skipped.
+//
+// NOTE: The garbage is collected with the ticket of the client which has
+// synced the least, so the tombstones which are still needed by the clients
+// are kept.
+func collectGarbage(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *database.DocInfo,
+) (bool, error) {
+	interval, err := types.ParseDocumentGCInterval(docInfo.Metadata)
+	if err != nil {
+		logging.From(ctx).Warnf("GC: skip '%s': %s", docInfo.Key, err)
+		return false, nil
+	}
+	if interval == 0 || docInfo.IsArchived() {
+		return false, nil
+	}
+
+	snapshotInfo, err := be.DB.FindClosestSnapshotInfo(ctx, docInfo.ID, docInfo.ServerSeq)
+	if err != nil {
+		return false, err
+	}
+	if snapshotInfo.ServerSeq == docInfo.ServerSeq ||
+		gotime.Since(snapshotInfo.CreatedAt) < interval {
+		return false, nil
+	}
+
+	projectInfo, err := be.DB.FindProjectInfoByID(ctx, docInfo.ProjectID)
+	if err != nil {
+		return false, err
+	}
+	project := projectInfo.ToProject()
+
+	minSyncedTicket, err := findMinSyncedTicket(ctx, be.DB, docInfo.ID)
+	if err != nil {
+		return false, err
+	}
+
+	locker, err := be.Coordinator.NewLocker(ctx, SnapshotKey(project.ID, docInfo.Key))
+	if err != nil {
+		return false, err
+	}
+
+	// NOTE: If the snapshot is being created by another routine, the garbage
+	//       is collected there, so we can skip it.
+	if err := locker.TryLock(ctx); err != nil {
+		return false, nil
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	if err := storeSnapshot(ctx, be, project, docInfo, minSyncedTicket, 0); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// findMinSyncedTicket returns the ticket of the client which has synced the
+// least among the clients attaching the given document. It returns nil if no
+// client attaches the document.
+func findMinSyncedTicket(
+	ctx context.Context,
+	db database.Database,
+	docID types.ID,
+) (*time.Ticket, error) {
+	info, err := db.FindMinSyncedSeqInfo(ctx, docID)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, nil
+	}
+	if info.ServerSeq == change.InitialServerSeq {
+		return time.InitialTicket, nil
+	}
+
+	actorID, err := time.ActorIDFromHex(info.ActorID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return time.NewTicket(info.Lamport, time.MaxDelimiter, actorID), nil
+}
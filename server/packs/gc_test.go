@@ -0,0 +1,120 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/background"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/database/memory"
+	memsync "github.com/yorkie-team/yorkie/server/backend/sync/memory"
+	"github.com/yorkie-team/yorkie/server/logging"
+	"github.com/yorkie-team/yorkie/server/packs"
+	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
+)
+
+func TestCollectGarbageByInterval(t *testing.T) {
+	ctx := logging.With(context.Background(), logging.New(t.Name()))
+	metrics, err := prometheus.NewMetrics()
+	assert.NoError(t, err)
+
+	idGenerator, err := database.NewIDGenerator(database.ObjectIDGeneratorName, nil)
+	assert.NoError(t, err)
+	memDB, err := memory.New(idGenerator)
+	assert.NoError(t, err)
+
+	projectInfo, err := memDB.EnsureDefaultProjectInfo(ctx)
+	assert.NoError(t, err)
+	clientInfo, err := memDB.ActivateClient(ctx, projectInfo.ID, t.Name())
+	assert.NoError(t, err)
+	actorID, err := time.ActorIDFromHex(clientInfo.ID.String())
+	assert.NoError(t, err)
+
+	// 01. Store the documents which have tombstones, one of which has its own
+	// GC interval.
+	var docInfos []*database.DocInfo
+	for _, k := range []key.Key{"hot", "cold"} {
+		docInfo, err := memDB.FindDocInfoByKeyAndOwner(ctx, projectInfo.ID, clientInfo.ID, k, true)
+		assert.NoError(t, err)
+
+		doc := document.New(docInfo.Key)
+		doc.SetActor(actorID)
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.Delete("k1")
+			return nil
+		}))
+		pack := doc.CreateChangePack()
+		for _, c := range pack.Changes {
+			c.SetServerSeq(docInfo.IncreaseServerSeq())
+		}
+		assert.NoError(t, memDB.CreateChangeInfos(ctx, projectInfo.ID, docInfo, 0, pack.Changes))
+
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID, false))
+		assert.NoError(t, memDB.UpdateSyncedSeq(ctx, clientInfo, docInfo.ID, docInfo.ServerSeq))
+		docInfos = append(docInfos, docInfo)
+	}
+	assert.NoError(t, memDB.UpdateDocInfoMetadata(ctx, projectInfo.ID, docInfos[0].ID, map[string]string{
+		types.DocumentGCIntervalMetadataKey: "10s",
+	}))
+
+	be := &backend.Backend{
+		Config: &backend.Config{
+			SnapshotRetentionPeriod:      "0s",
+			SnapshotWriteMaxRetries:      2,
+			SnapshotWriteMaxWaitInterval: "1ms",
+		},
+		DB:          memDB,
+		Background:  background.New(0),
+		Coordinator: memsync.NewCoordinator(nil),
+		Metrics:     metrics,
+	}
+
+	// 02. Only the garbage of the document with the GC interval is collected.
+	offset, count, err := packs.CollectGarbageByInterval(ctx, be, "", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, types.ID(""), offset)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, background.QueueStats{}, be.Background.QueueStats(background.JobGC))
+
+	infos, err := memDB.FindSnapshotInfos(ctx, docInfos[0].ID)
+	assert.NoError(t, err)
+	assert.Len(t, infos, 1)
+	assert.Equal(t, 0, infos[0].GarbageLen)
+	infos, err = memDB.FindSnapshotInfos(ctx, docInfos[1].ID)
+	assert.NoError(t, err)
+	assert.Len(t, infos, 0)
+
+	// 03. The document is skipped until it is changed and its GC interval
+	// elapses.
+	_, count, err = packs.CollectGarbageByInterval(ctx, be, "", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
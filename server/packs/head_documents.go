@@ -0,0 +1,61 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+)
+
+// headDocumentCacheTTL is the time the documents materialized at their heads
+// are kept after their last use.
+const headDocumentCacheTTL = 10 * gotime.Minute
+
+// lockHeadDocument returns the document materialized at the given server
+// sequence from the cache of the heads. It is built and cached if it is not
+// cached or cached at another server sequence. The returned head is locked,
+// so the caller should unlock it.
+func lockHeadDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	serverSeq uint64,
+) (*backend.HeadDocument, error) {
+	head, ok := be.HeadDocumentCache.Get(docInfo.ID)
+	if !ok {
+		head = &backend.HeadDocument{}
+	}
+
+	head.Lock()
+	if head.Doc == nil || head.ServerSeq != serverSeq {
+		doc, err := BuildDocumentForServerSeq(ctx, be, project, docInfo, serverSeq)
+		if err != nil {
+			head.Unlock()
+			return nil, err
+		}
+		head.ServerSeq = serverSeq
+		head.Doc = doc
+	}
+	be.HeadDocumentCache.Add(docInfo.ID, head, headDocumentCacheTTL)
+
+	return head, nil
+}
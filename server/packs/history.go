@@ -18,8 +18,12 @@ package packs
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/backend/database"
 )
@@ -28,11 +32,12 @@ import (
 func FindChanges(
 	ctx context.Context,
 	be *backend.Backend,
+	project *types.Project,
 	docInfo *database.DocInfo,
 	from uint64,
 	to uint64,
 ) ([]*change.Change, error) {
-	changes, err := be.DB.FindChangesBetweenServerSeqs(
+	changes, err := be.DocDB(project, docInfo.Key).FindChangesBetweenServerSeqs(
 		ctx,
 		docInfo.ID,
 		from,
@@ -40,3 +45,46 @@ func FindChanges(
 	)
 	return changes, err
 }
+
+// PullPackAt returns the pack of the snapshot of the given document at the
+// past version of the given server sequence. The snapshot is materialized
+// from the closest snapshot and the changes after it, so the version whose
+// changes are compacted away can not be pulled.
+func PullPackAt(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	serverSeq uint64,
+) (*ServerPack, error) {
+	if serverSeq > docInfo.ServerSeq {
+		return nil, fmt.Errorf(
+			"%s of %d greater than %d: %w",
+			docInfo.Key,
+			serverSeq,
+			docInfo.ServerSeq,
+			ErrInvalidServerSeq,
+		)
+	}
+
+	doc, err := BuildDocumentForServerSeq(ctx, be, project, docInfo, serverSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := converter.ObjectToBytes(doc.RootObject())
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: The document at a past version is not synchronized, so nothing
+	// is collected as garbage by the initial ticket.
+	pack := NewServerPack(
+		docInfo.Key,
+		change.InitialCheckpoint.NextServerSeq(serverSeq),
+		nil,
+		snapshot,
+	)
+	pack.MinSyncedTicket = time.InitialTicket
+	return pack, nil
+}
@@ -0,0 +1,158 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+// StoreInitialContent stores the initial content of the project as the first
+// change of the given document if the document has no changes yet. The change
+// is created by the initial actor, so it is pulled by every client.
+func StoreInitialContent(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+) error {
+	if project.InitialContent == "" || docInfo.ServerSeq > 0 {
+		return nil
+	}
+
+	stored, err := storeContent(ctx, be, project, docInfo, project.InitialContent, "initial content")
+	if err != nil {
+		return err
+	}
+	if stored {
+		logging.From(ctx).Infof(
+			"INIT: '%s' starts from the initial content of '%s'",
+			docInfo.Key,
+			project.Name,
+		)
+	}
+	return nil
+}
+
+// StoreTemplateContent stores the given content rendered from the template of
+// the given ID as the first change of the given document. It returns
+// ErrDocumentAlreadyExists if the document already has changes.
+func StoreTemplateContent(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	templateID string,
+	content string,
+) error {
+	stored, err := storeContent(ctx, be, project, docInfo, content, "template "+templateID)
+	if err != nil {
+		return err
+	}
+	if !stored {
+		return fmt.Errorf("%s: %w", docInfo.Key, database.ErrDocumentAlreadyExists)
+	}
+
+	logging.From(ctx).Infof(
+		"INIT: '%s' starts from the template '%s' of '%s'",
+		docInfo.Key,
+		templateID,
+		project.Name,
+	)
+
+	// NOTE: The snapshot is stored right away, since the initial snapshots of
+	// the documents created from the same template with the same variables
+	// are identical and stored once in the shared blob.
+	lockAndStoreSnapshot(ctx, be, project, docInfo, nil, 0)
+	return nil
+}
+
+// storeContent stores the given JSON object as the first change of the given
+// document while holding the lock of pushes. It returns false without
+// storing it if the document already has changes.
+func storeContent(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	content string,
+	message string,
+) (bool, error) {
+	locker, err := be.Coordinator.NewLocker(ctx, PushPullKey(project.ID, docInfo.Key))
+	if err != nil {
+		return false, err
+	}
+	if err := locker.Lock(ctx); err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	// NOTE: Another client may have pushed changes while waiting for the lock.
+	db := be.DocDB(project, docInfo.Key)
+	loaded, err := db.FindDocInfoByID(ctx, docInfo.ID)
+	if err != nil {
+		return false, err
+	}
+	if loaded.ServerSeq > 0 {
+		*docInfo = *loaded
+		return false, nil
+	}
+
+	doc := document.New(docInfo.Key)
+	if err := doc.Update(func(root *proxy.ObjectProxy) error {
+		return root.Import([]byte(content))
+	}, message); err != nil {
+		return false, err
+	}
+
+	if err := RecoverLamport(ctx, be, project, docInfo, docInfo.ServerSeq); err != nil {
+		return false, err
+	}
+	initialServerSeq := docInfo.ServerSeq
+	changes := doc.CreateChangePack().Changes
+	for _, cn := range changes {
+		cn.SetServerSeq(docInfo.IncreaseServerSeq())
+		if cn.ID().Lamport() > docInfo.Lamport {
+			docInfo.Lamport = cn.ID().Lamport()
+		}
+	}
+	docInfo.LamportServerSeq = docInfo.ServerSeq
+
+	if err := db.CreateChangeInfos(
+		ctx,
+		project.ID,
+		docInfo,
+		initialServerSeq,
+		changes,
+	); err != nil {
+		return false, err
+	}
+	appendEventLogs(ctx, be, project, docInfo, initialServerSeq, changes)
+
+	return true, nil
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+)
+
+// RecoverLamport raises the Lamport of the given document loaded from the
+// database to the largest Lamport timestamp of the changes stored after its
+// LamportServerSeq, up to the given server sequence. The stored Lamport is
+// behind these changes when it is not flushed before a crash, so the
+// recovered one is never less than any Lamport timestamp stored.
+func RecoverLamport(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	serverSeq uint64,
+) error {
+	if docInfo.LamportServerSeq >= serverSeq {
+		return nil
+	}
+
+	// NOTE: The documents stored before LamportServerSeq is added have the
+	// Lamport stored with every change, so it covers all of their changes.
+	if docInfo.LamportServerSeq == 0 && docInfo.Lamport > 0 {
+		docInfo.LamportServerSeq = serverSeq
+		return nil
+	}
+
+	infos, err := be.DocDB(project, docInfo.Key).FindChangeInfosBetweenServerSeqs(
+		ctx,
+		docInfo.ID,
+		docInfo.LamportServerSeq+1,
+		serverSeq,
+	)
+	if err != nil {
+		return err
+	}
+	for _, info := range infos {
+		if info.Lamport > docInfo.Lamport {
+			docInfo.Lamport = info.Lamport
+		}
+	}
+	docInfo.LamportServerSeq = serverSeq
+	return nil
+}
+
+// flushLamport recovers the Lamport of the given document then marks it to
+// be stored with the changes pushed after the given server sequence, if
+// LamportFlushInterval changes are pushed since it is stored.
+func flushLamport(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	initialServerSeq uint64,
+) error {
+	interval := be.Config.LamportFlushInterval
+	if docInfo.ServerSeq-docInfo.LamportServerSeq < interval {
+		return nil
+	}
+
+	if err := RecoverLamport(ctx, be, project, docInfo, initialServerSeq); err != nil {
+		return err
+	}
+	docInfo.LamportServerSeq = docInfo.ServerSeq
+	return nil
+}
+
+// isBeyondLamportGap returns whether a change of the given pack may be
+// rejected by MaxLamportGap against the Lamport of the given document. The
+// Lamport is recovered only then, since it is only behind the stored changes.
+func isBeyondLamportGap(
+	conf *backend.Config,
+	docInfo *database.DocInfo,
+	reqPack *change.Pack,
+) bool {
+	if conf.MaxLamportGap == 0 || docInfo.LamportServerSeq >= docInfo.ServerSeq {
+		return false
+	}
+
+	for _, cn := range reqPack.Changes {
+		if lamport := cn.ID().Lamport(); lamport > docInfo.Lamport &&
+			lamport-docInfo.Lamport > conf.MaxLamportGap {
+			return true
+		}
+	}
+	return false
+}
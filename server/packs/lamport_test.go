@@ -0,0 +1,94 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/packs"
+	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestRecoverLamport(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("crash recovery test", func(t *testing.T) {
+		conf := helper.TestConfig()
+		conf.Backend.LamportFlushInterval = 4
+		met, err := prometheus.NewMetrics()
+		assert.NoError(t, err)
+		be, err := backend.New(conf.Backend, nil, nil, conf.Housekeeping, "", met)
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, be.Shutdown())
+		}()
+
+		projectInfo, err := be.DB.EnsureDefaultProjectInfo(ctx)
+		assert.NoError(t, err)
+		project := projectInfo.ToProject()
+		clientInfo, err := be.DB.ActivateClient(ctx, project.ID, t.Name())
+		assert.NoError(t, err)
+		docInfo, err := be.DB.FindDocInfoByKeyAndOwner(ctx, project.ID, clientInfo.ID, key.Key(t.Name()), true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID, false))
+
+		actorID, err := time.ActorIDFromHex(clientInfo.ID.String())
+		assert.NoError(t, err)
+		doc := document.New(docInfo.Key)
+		doc.SetActor(actorID)
+
+		var recovered uint64
+		for i := 0; i < 10; i++ {
+			// 01. Push a change with the document loaded from the database.
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger("k", i)
+				return nil
+			}))
+			loaded, err := be.DB.FindDocInfoByID(ctx, docInfo.ID)
+			assert.NoError(t, err)
+			_, err = packs.PushPull(ctx, be, project, clientInfo, loaded, doc.CreateChangePack())
+			assert.NoError(t, err)
+			lamport := loaded.Lamport
+
+			// 02. Crash after the push, then reload the document. The stored
+			// Lamport is flushed once per 4 changes only.
+			stored, err := be.DB.FindDocInfoByID(ctx, docInfo.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, stored.ServerSeq/4*4, stored.LamportServerSeq)
+			assert.LessOrEqual(t, stored.Lamport, lamport)
+
+			// 03. The recovered Lamport is the one before the crash, so it
+			// never goes backward.
+			persisted := stored.Lamport
+			assert.NoError(t, packs.RecoverLamport(ctx, be, project, stored, stored.ServerSeq))
+			assert.Equal(t, lamport, stored.Lamport)
+			assert.Equal(t, stored.ServerSeq, stored.LamportServerSeq)
+			assert.GreaterOrEqual(t, stored.Lamport, persisted)
+			assert.Greater(t, stored.Lamport, recovered)
+			recovered = stored.Lamport
+		}
+	})
+}
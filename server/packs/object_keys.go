@@ -0,0 +1,141 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+)
+
+// ErrTooManyObjectKeys is returned when a Set adds a key to an Object which
+// already has the maximum number of live keys.
+var ErrTooManyObjectKeys = errors.New("too many object keys")
+
+// validateObjectKeys applies the changes of the given request, excluding the
+// ones already pushed, to a copy of the document and rejects the Sets which
+// grow an Object beyond the maximum number of live keys. The removed keys are
+// not counted, so Sets replacing or restoring keys under the limit pass. The
+// copy is made from the head of the document in the cache, so the document is
+// built only if it is not cached.
+func validateObjectKeys(ctx context.Context, req *PushRequest) error {
+	limit := req.Backend.Config.ObjectKeyLimit(req.Project)
+	if limit == 0 {
+		return nil
+	}
+
+	cp := req.ClientInfo.Checkpoint(req.DocInfo.ID)
+
+	var changes []*change.Change
+	var fields []string
+	hasSet := false
+	for i, cn := range req.Pack.Changes {
+		if cn.ID().ClientSeq() <= cp.ClientSeq {
+			continue
+		}
+		changes = append(changes, cn)
+		fields = append(fields, fmt.Sprintf("changes[%d]", i))
+		for _, op := range cn.Operations() {
+			if _, ok := op.(*operations.Set); ok {
+				hasSet = true
+			}
+		}
+	}
+	if !hasSet {
+		return nil
+	}
+
+	head, err := lockHeadDocument(ctx, req.Backend, req.Project, req.DocInfo, req.InitialServerSeq)
+	if err != nil {
+		return err
+	}
+	root := head.Doc.Root().DeepCopy()
+	head.Unlock()
+
+	var violations []*Violation
+	for i, cn := range changes {
+		// NOTE: The keys are counted before and after the whole change, and
+		// the violation is reported at the first Set adding a key to the
+		// Object. The Objects created in the change are found after it is
+		// applied, and all of their keys are added by the change.
+		var objs []*json.Object
+		before := make(map[*json.Object]int)
+		opIndexes := make(map[*json.Object]int)
+		added := make(map[*json.Object]bool)
+		var pendings []int
+		for j, op := range cn.Operations() {
+			set, ok := op.(*operations.Set)
+			if !ok {
+				continue
+			}
+			obj, ok := root.FindByCreatedAt(set.ParentCreatedAt()).(*json.Object)
+			if !ok {
+				pendings = append(pendings, j)
+				continue
+			}
+			if _, ok := before[obj]; !ok {
+				objs = append(objs, obj)
+				before[obj] = len(obj.Members())
+				opIndexes[obj] = j
+			}
+			if _, ok := added[obj]; !ok && !obj.Has(set.Key()) {
+				added[obj] = true
+				opIndexes[obj] = j
+			}
+		}
+
+		if err := cn.Execute(root); err != nil {
+			return &InvalidChangePackError{Violations: []*Violation{{
+				Field: fields[i],
+				Err:   fmt.Errorf("%s: %w", err.Error(), ErrChangeNotApplicable),
+			}}}
+		}
+
+		for _, j := range pendings {
+			set := cn.Operations()[j].(*operations.Set)
+			obj, ok := root.FindByCreatedAt(set.ParentCreatedAt()).(*json.Object)
+			if !ok {
+				continue
+			}
+			if _, ok := before[obj]; !ok {
+				objs = append(objs, obj)
+				before[obj] = 0
+				opIndexes[obj] = j
+			}
+		}
+
+		for _, obj := range objs {
+			after := len(obj.Members())
+			if after > limit && after > before[obj] {
+				violations = append(violations, &Violation{
+					Field: fmt.Sprintf("%s.operations[%d]", fields[i], opIndexes[obj]),
+					Err:   fmt.Errorf("%d keys exceeds %d keys: %w", after, limit, ErrTooManyObjectKeys),
+				})
+			}
+		}
+	}
+
+	if len(violations) > 0 {
+		return &InvalidChangePackError{Violations: violations}
+	}
+
+	return nil
+}
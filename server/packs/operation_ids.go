@@ -0,0 +1,93 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+)
+
+// ErrOperationAlreadyPushed is returned when the ID of a pushed operation is
+// repeated in the change pack or already stored in the document within the
+// window.
+var ErrOperationAlreadyPushed = errors.New("operation already pushed")
+
+// checkOperationIDs returns ErrOperationAlreadyPushed if the IDs of the
+// operations of the given changes are repeated in them or already stored in
+// the document within the window. The client should resend the changes
+// without the operations.
+//
+// NOTE: The IDs are checked against the changes stored in the database while
+// the document is locked, so the operations resent to another server of the
+// cluster are also rejected.
+func checkOperationIDs(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	changes []*change.Change,
+) error {
+	window := be.Config.ParseOperationIDWindow()
+	if window == 0 {
+		return nil
+	}
+
+	var ids []string
+	seen := make(map[string]bool)
+	for _, cn := range changes {
+		for _, id := range database.OperationIDs(cn) {
+			if seen[id] {
+				be.Metrics.AddPushPullDuplicateOperations(1)
+				return fmt.Errorf("%s of %s: %w", id, docInfo.Key, ErrOperationAlreadyPushed)
+			}
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	stored, err := be.DocDB(project, docInfo.Key).FindOperationIDs(
+		ctx,
+		docInfo.ID,
+		ids,
+		gotime.Now().Add(-window),
+	)
+	if err != nil {
+		return err
+	}
+	if len(stored) > 0 {
+		be.Metrics.AddPushPullDuplicateOperations(len(stored))
+		return fmt.Errorf(
+			"%s of %s: %w",
+			strings.Join(stored, ","),
+			docInfo.Key,
+			ErrOperationAlreadyPushed,
+		)
+	}
+
+	return nil
+}
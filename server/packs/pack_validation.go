@@ -0,0 +1,272 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+)
+
+var (
+	// ErrActorMismatch is returned when the actor of a change or an operation
+	// is not the client pushing it.
+	ErrActorMismatch = errors.New("actor mismatch")
+
+	// ErrClientSeqGap is returned when the ClientSeq of a change does not
+	// follow the previous one.
+	ErrClientSeqGap = errors.New("client seq gap")
+
+	// ErrValueTooLarge is returned when a value of an operation exceeds the
+	// maximum size.
+	ErrValueTooLarge = errors.New("value too large")
+
+	// ErrTypeMismatch is returned when the value of an operation has a type
+	// that the operation can not be applied with.
+	ErrTypeMismatch = errors.New("type mismatch")
+)
+
+// Violation describes a problem of a change pack.
+type Violation struct {
+	// Field is the path of the problematic field in the change pack, e.g.
+	// "changes[1].operations[0]".
+	Field string
+
+	// Err is the error describing the problem.
+	Err error
+}
+
+// InvalidChangePackError is returned when a change pack is rejected. It
+// reports all problems of the pack so that the client can fix them at once.
+type InvalidChangePackError struct {
+	Violations []*Violation
+}
+
+// Error returns the error message.
+func (e *InvalidChangePackError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		messages = append(messages, fmt.Sprintf("%s: %s", v.Field, v.Err.Error()))
+	}
+	return fmt.Sprintf("invalid change pack: %s", strings.Join(messages, "; "))
+}
+
+// Is returns whether one of the violations is the given error.
+func (e *InvalidChangePackError) Is(target error) bool {
+	for _, v := range e.Violations {
+		if errors.Is(v.Err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// changePackValidator collects the violations of a change pack.
+type changePackValidator struct {
+	conf          *backend.Config
+	project       *types.Project
+	actorID       *time.ActorID
+	actorIDFormat *types.ActorIDFormat
+	violations    []*Violation
+}
+
+func (v *changePackValidator) add(field string, err error) {
+	v.violations = append(v.violations, &Violation{Field: field, Err: err})
+}
+
+// validateChangePack validates the changes of the given pack to be pushed and
+// returns an InvalidChangePackError with all the problems found. It runs
+// before anything is mutated, so a pack is either pushed entirely or rejected
+// as a whole. Changes already pushed are skipped so that retries of them are
+// not rejected, e.g. after a feature is disabled.
+func validateChangePack(
+	conf *backend.Config,
+	project *types.Project,
+	clientInfo *database.ClientInfo,
+	docInfo *database.DocInfo,
+	reqPack *change.Pack,
+) error {
+	actorID, err := clientInfo.ID.ToActorID()
+	if err != nil {
+		return err
+	}
+
+	v := &changePackValidator{
+		conf:          conf,
+		project:       project,
+		actorID:       actorID,
+		actorIDFormat: conf.ActorIDFormat(),
+	}
+
+	cp := clientInfo.Checkpoint(docInfo.ID)
+	clientSeq := cp.ClientSeq
+
+	// NOTE: The checkpoint of a detaching client is reset before its changes
+	// are pushed, so only the changes in the pack are checked to be
+	// contiguous.
+	if attached, err := clientInfo.IsAttached(docInfo.ID); err == nil && !attached &&
+		reqPack.HasChanges() && reqPack.Changes[0].ClientSeq() > 0 {
+		clientSeq = reqPack.Changes[0].ClientSeq() - 1
+	}
+	maxLamport := docInfo.Lamport
+	var prevLamport uint64
+	for i, cn := range reqPack.Changes {
+		if cn.ID().ClientSeq() <= cp.ClientSeq {
+			continue
+		}
+
+		field := fmt.Sprintf("changes[%d]", i)
+		id := cn.ID()
+		if id.ActorID() == nil || id.ActorID().Compare(actorID) != 0 {
+			v.add(field+".id.actor_id", fmt.Errorf("%s of %s: %w", id.ActorID(), actorID, ErrActorMismatch))
+		} else if err := v.actorIDFormat.Validate(id.ActorID()); err != nil {
+			v.add(field+".id.actor_id", err)
+		}
+		if id.ClientSeq() != clientSeq+1 {
+			v.add(field+".id.client_seq", fmt.Errorf(
+				"%d does not follow %d: %w",
+				id.ClientSeq(),
+				clientSeq,
+				ErrClientSeqGap,
+			))
+		}
+		clientSeq = id.ClientSeq()
+
+		// NOTE: The largest Lamport timestamp is unknown for the documents
+		// created before it is recorded. The first push of these documents
+		// records it.
+		lamport := id.Lamport()
+		if v.conf.MaxLamportGap > 0 && maxLamport > 0 &&
+			lamport > maxLamport && lamport-maxLamport > v.conf.MaxLamportGap {
+			v.add(field+".id.lamport", fmt.Errorf(
+				"lamport %d exceeds %d by more than %d: %w",
+				lamport,
+				maxLamport,
+				v.conf.MaxLamportGap,
+				ErrLamportTooFarAhead,
+			))
+		}
+		if maxLamport > 0 && lamport > maxLamport {
+			maxLamport = lamport
+		}
+
+		var ticketErr *change.TicketError
+		if err := change.VerifyTickets(cn, prevLamport); errors.As(err, &ticketErr) {
+			v.add(field+"."+ticketErr.Field, ticketErr.Err)
+		}
+		prevLamport = lamport
+
+		if size := len(cn.Message()); size > change.MaxMessageBytes {
+			v.add(field+".message", fmt.Errorf(
+				"%d bytes exceeds %d bytes: %w",
+				size,
+				change.MaxMessageBytes,
+				change.ErrMessageTooLarge,
+			))
+		}
+
+		for j, op := range cn.Operations() {
+			v.validateOperation(fmt.Sprintf("%s.operations[%d]", field, j), op)
+		}
+	}
+
+	if len(v.violations) > 0 {
+		return &InvalidChangePackError{Violations: v.violations}
+	}
+
+	return nil
+}
+
+// validateOperation validates the given operation.
+func (v *changePackValidator) validateOperation(field string, op operations.Operation) {
+	if executedAt := op.ExecutedAt(); executedAt != nil && executedAt.ActorID().Compare(v.actorID) != 0 {
+		v.add(field+".executed_at", fmt.Errorf(
+			"%s of %s: %w",
+			executedAt.ActorID(),
+			v.actorID,
+			ErrActorMismatch,
+		))
+	}
+
+	if opType := operationType(op); !v.project.IsOperationAllowed(opType) {
+		v.add(field, fmt.Errorf("%s of %s: %w", opType, v.project.Name, ErrOperationDisallowed))
+	}
+
+	for _, feature := range requiredFeatures(op) {
+		if !v.project.IsFeatureEnabled(feature) {
+			v.add(field, fmt.Errorf("%s of %s: %w", feature, v.project.Name, ErrFeatureDisabled))
+		}
+	}
+
+	switch op := op.(type) {
+	case *operations.Set:
+		v.validateValueSize(field+".value", op.Value())
+	case *operations.Add:
+		v.validateValueSize(field+".value", op.Value())
+	case *operations.Edit:
+		v.validateContentSize(field+".content", len(op.Content()))
+	case *operations.RichEdit:
+		v.validateContentSize(field+".content", len(op.Content()))
+	case *operations.Increase:
+		primitive, ok := op.Value().(*json.Primitive)
+		if !ok || !primitive.IsNumericType() {
+			v.add(field+".value", fmt.Errorf("increase with non-numeric value: %w", ErrTypeMismatch))
+		}
+	}
+}
+
+// validateValueSize validates the sizes of the primitives in the given
+// element.
+func (v *changePackValidator) validateValueSize(field string, elem json.Element) {
+	if v.conf.MaxValueBytes == 0 {
+		return
+	}
+
+	check := func(elem json.Element) bool {
+		if primitive, ok := elem.(*json.Primitive); ok {
+			if size := len(primitive.Bytes()); uint64(size) > v.conf.MaxValueBytes {
+				v.add(field, fmt.Errorf("%d bytes exceeds %d bytes: %w", size, v.conf.MaxValueBytes, ErrValueTooLarge))
+				return true
+			}
+		}
+		return false
+	}
+
+	if check(elem) {
+		return
+	}
+	if container, ok := elem.(json.Container); ok {
+		container.Descendants(func(elem json.Element, _ json.Container) bool {
+			return check(elem)
+		})
+	}
+}
+
+// validateContentSize validates the size of the content of an edit.
+func (v *changePackValidator) validateContentSize(field string, size int) {
+	if v.conf.MaxValueBytes > 0 && uint64(size) > v.conf.MaxValueBytes {
+		v.add(field, fmt.Errorf("%d bytes exceeds %d bytes: %w", size, v.conf.MaxValueBytes, ErrValueTooLarge))
+	}
+}
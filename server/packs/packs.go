@@ -18,6 +18,7 @@ package packs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	gotime "time"
 
@@ -26,8 +27,11 @@ import (
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/background"
 	"github.com/yorkie-team/yorkie/server/backend/database"
 	"github.com/yorkie-team/yorkie/server/backend/sync"
 	"github.com/yorkie-team/yorkie/server/logging"
@@ -52,26 +56,112 @@ func PushPull(
 	clientInfo *database.ClientInfo,
 	docInfo *database.DocInfo,
 	reqPack *change.Pack,
+) (*ServerPack, error) {
+	respPack, err := pushPull(ctx, be, project, clientInfo, docInfo, reqPack)
+	if !errors.Is(err, database.ErrChangeAlreadyExists) {
+		return respPack, err
+	}
+
+	// NOTE: The changes are already stored by another request of the client,
+	// e.g. the same changes delivered to another server, which this request
+	// has missed with the checkpoint of the client loaded before. The push is
+	// retried with the stored checkpoint, so that the changes already stored
+	// are skipped instead of failing the request.
+	loadedClientInfo, err := be.DB.FindClientInfoByID(ctx, project.ID, clientInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+	loadedDocInfo, err := be.DocDB(project, docInfo.Key).FindDocInfoByID(ctx, docInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+	*clientInfo = *loadedClientInfo
+	*docInfo = *loadedDocInfo
+
+	return pushPull(ctx, be, project, clientInfo, docInfo, reqPack)
+}
+
+func pushPull(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	clientInfo *database.ClientInfo,
+	docInfo *database.DocInfo,
+	reqPack *change.Pack,
 ) (*ServerPack, error) {
 	start := gotime.Now()
 	defer func() {
 		be.Metrics.ObservePushPullResponseSeconds(gotime.Since(start).Seconds())
 	}()
 
+	prepared, err := preparePushPull(ctx, be, project, clientInfo, docInfo, reqPack, start)
+	if err != nil {
+		return nil, err
+	}
+
+	// 03. store pushed changes, docInfo and checkpoint of the client to DB.
+	if len(prepared.pushedChanges) > 0 {
+		if err := be.DocDB(project, docInfo.Key).CreateChangeInfos(
+			ctx,
+			project.ID,
+			docInfo,
+			prepared.initialServerSeq,
+			prepared.pushedChanges,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return completePushPull(ctx, be, project, clientInfo, docInfo, reqPack, prepared)
+}
+
+// preparedPushPull is the result of a PushPull prepared before its changes
+// are stored.
+type preparedPushPull struct {
+	respPack         *ServerPack
+	pushedChanges    []*change.Change
+	initialServerSeq uint64
+}
+
+// preparePushPull pushes the changes of the given pack to the given document
+// and pulls the changes for the response, without storing anything. The
+// server sequence of the document and the checkpoint of the client are
+// increased for the pushed changes.
+func preparePushPull(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	clientInfo *database.ClientInfo,
+	docInfo *database.DocInfo,
+	reqPack *change.Pack,
+	receivedAt gotime.Time,
+) (*preparedPushPull, error) {
 	// TODO: Changes may be reordered or missing during communication on the network.
 	// We should check the change.pack with checkpoint to make sure the changes are in the correct order.
 	initialServerSeq := docInfo.ServerSeq
 
-	// 01. push changes: filter out the changes that are already saved in the database.
-	cpAfterPush, pushedChanges := pushChanges(ctx, clientInfo, docInfo, reqPack, initialServerSeq)
-	be.Metrics.AddPushPullReceivedChanges(reqPack.ChangesLen())
-	be.Metrics.AddPushPullReceivedOperations(reqPack.OperationsLen())
+	// 01. push changes: filter out the changes that are already saved in the
+	// database after passing them through the middlewares.
+	pushReq := &PushRequest{
+		Backend:          be,
+		Project:          project,
+		ClientInfo:       clientInfo,
+		DocInfo:          docInfo,
+		Pack:             reqPack,
+		ReceivedAt:       receivedAt,
+		InitialServerSeq: initialServerSeq,
+	}
+	if err := newPushHandler(be.Config)(ctx, pushReq); err != nil {
+		return nil, err
+	}
+	cpAfterPush, pushedChanges, deferred := pushReq.Checkpoint, pushReq.PushedChanges, pushReq.Deferred
 
 	// 02. pull pack: pull changes or a snapshot from the database and create a response pack.
-	respPack, err := pullPack(ctx, be, clientInfo, docInfo, reqPack, cpAfterPush, initialServerSeq)
+	respPack, err := pullPack(ctx, be, project, clientInfo, docInfo, reqPack, cpAfterPush, initialServerSeq)
 	if err != nil {
 		return nil, err
 	}
+	respPack.DeferredChanges = uint32(deferred)
 	be.Metrics.AddPushPullSentChanges(respPack.ChangesLen())
 	be.Metrics.AddPushPullSentOperations(respPack.OperationsLen())
 	be.Metrics.AddPushPullSnapshotBytes(respPack.SnapshotLen())
@@ -80,11 +170,28 @@ func PushPull(
 		return nil, err
 	}
 
-	// 03. store pushed changes, docInfo and checkpoint of the client to DB.
+	return &preparedPushPull{
+		respPack:         respPack,
+		pushedChanges:    pushedChanges,
+		initialServerSeq: initialServerSeq,
+	}, nil
+}
+
+// completePushPull stores the checkpoint of the client and publishes the
+// pushed changes after they are stored.
+func completePushPull(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	clientInfo *database.ClientInfo,
+	docInfo *database.DocInfo,
+	reqPack *change.Pack,
+	prepared *preparedPushPull,
+) (*ServerPack, error) {
+	respPack, pushedChanges, initialServerSeq := prepared.respPack, prepared.pushedChanges, prepared.initialServerSeq
 	if len(pushedChanges) > 0 {
-		if err := be.DB.CreateChangeInfos(ctx, project.ID, docInfo, initialServerSeq, pushedChanges); err != nil {
-			return nil, err
-		}
+		recordConflictWins(ctx, be, project, docInfo, initialServerSeq, pushedChanges)
+		appendEventLogs(ctx, be, project, docInfo, initialServerSeq, pushedChanges)
 	}
 
 	if err := be.DB.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo); err != nil {
@@ -94,7 +201,7 @@ func PushPull(
 	// 04. update and find min synced ticket for garbage collection.
 	// NOTE(hackerwins): Since the client could not receive the response, the
 	// requested seq(reqPack) is stored instead of the response seq(resPack).
-	minSyncedTicket, err := be.DB.UpdateAndFindMinSyncedTicket(
+	minSyncedTicket, err := be.DocDB(project, docInfo.Key).UpdateAndFindMinSyncedTicket(
 		ctx,
 		clientInfo,
 		docInfo.ID,
@@ -107,85 +214,207 @@ func PushPull(
 
 	// 05. publish document change event then store snapshot asynchronously.
 	if reqPack.HasChanges() {
-		be.Background.AttachGoroutine(func(ctx context.Context) {
-			publisherID, err := clientInfo.ID.ToActorID()
-			if err != nil {
-				logging.From(ctx).Error(err)
-				return
-			}
+		// NOTE: The changes are added to the changefeed before releasing the
+		// lock of the document so that they are published in order.
+		if err := be.Changefeed.Publish(ctx, project, docInfo.Key, pushedChanges); err != nil {
+			logging.From(ctx).Error(err)
+		}
 
-			be.Coordinator.Publish(
-				ctx,
-				publisherID,
-				sync.DocEvent{
-					Type:         types.DocumentsChangedEvent,
-					Publisher:    types.Client{ID: publisherID},
-					DocumentKeys: []key.Key{reqPack.DocumentKey},
-				},
-			)
-
-			locker, err := be.Coordinator.NewLocker(ctx, SnapshotKey(project.ID, reqPack.DocumentKey))
+		be.Background.AttachJob(background.JobSnapshot, func(ctx context.Context) {
+			publisherID, err := clientInfo.ID.ToActorID()
 			if err != nil {
 				logging.From(ctx).Error(err)
 				return
 			}
 
-			// NOTE: If the snapshot is already being created by another routine, it
-			//       is not necessary to recreate it, so we can skip it.
-			if err := locker.TryLock(ctx); err != nil {
-				return
+			event := sync.DocEvent{
+				Type:         types.DocumentsChangedEvent,
+				Publisher:    types.Client{ID: publisherID},
+				DocumentKeys: []key.Key{reqPack.DocumentKey},
+				ServerSeq:    docInfo.ServerSeq,
 			}
-			defer func() {
-				if err := locker.Unlock(ctx); err != nil {
+			if be.Config.EnableSubtreeWatch && len(pushedChanges) > 0 {
+				event.ChangedPaths, event.RemovedPaths, err = findChangedPaths(
+					ctx,
+					be,
+					project,
+					docInfo,
+					initialServerSeq,
+					pushedChanges,
+				)
+				if err != nil {
 					logging.From(ctx).Error(err)
-					return
 				}
-			}()
-
-			start := gotime.Now()
-			if err := storeSnapshot(
-				ctx,
-				be,
-				docInfo,
-				minSyncedTicket,
-			); err != nil {
-				logging.From(ctx).Error(err)
 			}
-			be.Metrics.ObservePushPullSnapshotDurationSeconds(
-				gotime.Since(start).Seconds(),
-			)
+			be.EventBatcher.Publish(ctx, publisherID, event)
+
+			lockAndStoreSnapshot(ctx, be, project, docInfo, minSyncedTicket, be.Config.SnapshotInterval)
 		})
 	}
 
 	return respPack, nil
 }
 
+// findChangedPaths returns the paths of the elements changed and removed by
+// the given changes pushed after the given server sequence.
+func findChangedPaths(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	serverSeq uint64,
+	changes []*change.Change,
+) ([]string, []string, error) {
+	doc, err := BuildDocumentForServerSeq(ctx, be, project, docInfo, serverSeq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return doc.ApplyChangesWithPaths(changes...)
+}
+
+// StoreSnapshotOnAttach stores the snapshot of the given document in the
+// background if the number of changes after the last snapshot reaches
+// SnapshotOnAttachThreshold. It speeds up the following attachments of cold
+// documents which otherwise replay a long change log.
+func StoreSnapshotOnAttach(
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	minSyncedTicket *time.Ticket,
+) {
+	threshold := be.Config.SnapshotOnAttachThreshold
+	if threshold == 0 || docInfo.ServerSeq < threshold {
+		return
+	}
+
+	be.Background.AttachJob(background.JobSnapshot, func(ctx context.Context) {
+		lockAndStoreSnapshot(ctx, be, project, docInfo, minSyncedTicket, threshold)
+	})
+}
+
+// lockAndStoreSnapshot stores the snapshot of the given document if the
+// number of changes after the last snapshot reaches the given interval.
+func lockAndStoreSnapshot(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	minSyncedTicket *time.Ticket,
+	interval uint64,
+) {
+	locker, err := be.Coordinator.NewLocker(ctx, SnapshotKey(project.ID, docInfo.Key))
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return
+	}
+
+	// NOTE: If the snapshot is already being created by another routine, it
+	//       is not necessary to recreate it, so we can skip it.
+	if err := locker.TryLock(ctx); err != nil {
+		return
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+			return
+		}
+	}()
+
+	start := gotime.Now()
+	if err := storeSnapshot(
+		ctx,
+		be,
+		project,
+		docInfo,
+		minSyncedTicket,
+		interval,
+	); err != nil {
+		logging.From(ctx).Error(err)
+	}
+	be.Metrics.ObservePushPullSnapshotDurationSeconds(
+		gotime.Since(start).Seconds(),
+	)
+}
+
+// observeApplyLag observes the lag between the creation of the operations of
+// the given changes on the client and the given receivedAt.
+func observeApplyLag(be *backend.Backend, changes []*change.Change, receivedAt gotime.Time) {
+	for _, c := range changes {
+		for _, op := range c.Operations() {
+			if op.WallTime() == 0 {
+				continue
+			}
+
+			// NOTE: the lag can be negative if the clock of the client is
+			// ahead of the server. It is recorded as 0.
+			lag := receivedAt.Sub(gotime.UnixMilli(op.WallTime())).Seconds()
+			if lag < 0 {
+				lag = 0
+			}
+			be.Metrics.ObservePushPullApplyLagSeconds(lag)
+		}
+	}
+}
+
+// recordChangeRate records the operations of the given changes to measure the
+// change rate of the document, and reports the document if it becomes hot.
+func recordChangeRate(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	clientInfo *database.ClientInfo,
+	docInfo *database.DocInfo,
+	changes []*change.Change,
+) {
+	ops := 0
+	for _, c := range changes {
+		ops += len(c.Operations())
+	}
+
+	if be.HotDocuments.Record(project.ID, docInfo.ID, docInfo.Key, clientInfo.ID, ops) {
+		be.Metrics.AddPushPullHotDocuments()
+		be.ProjectStats.Publish(project.ID, types.ProjectStatsEvent{
+			Type:        types.DocumentHotEvent,
+			DocumentKey: docInfo.Key,
+		})
+		logging.From(ctx).Warnf(
+			"HOT: '%s' exceeds %g ops/sec, actor: %s",
+			docInfo.Key,
+			be.Config.HotDocumentThreshold,
+			clientInfo.ID,
+		)
+	}
+}
+
 // BuildDocumentForServerSeq returns a new document for the given serverSeq.
 func BuildDocumentForServerSeq(
 	ctx context.Context,
 	be *backend.Backend,
+	project *types.Project,
 	docInfo *database.DocInfo,
 	serverSeq uint64,
 ) (*document.InternalDocument, error) {
-	snapshotInfo, err := be.DB.FindClosestSnapshotInfo(ctx, docInfo.ID, serverSeq)
+	if serverSeq < docInfo.CompactedServerSeq {
+		return nil, fmt.Errorf("%s of %d: %w", docInfo.Key, serverSeq, ErrChangesCompacted)
+	}
+
+	db := be.DocDB(project, docInfo.Key)
+	snapshotInfo, err := db.FindClosestSnapshotInfo(ctx, docInfo.ID, serverSeq)
 	if err != nil {
 		return nil, err
 	}
 
-	doc, err := document.NewInternalDocumentFromSnapshot(
-		docInfo.Key,
-		snapshotInfo.ServerSeq,
-		snapshotInfo.Lamport,
-		snapshotInfo.Snapshot,
-	)
+	doc, err := newDocumentFromSnapshot(be, docInfo, snapshotInfo)
 	if err != nil {
 		return nil, err
 	}
+	doc.SetObjectMergePolicy(json.MergePolicy(project.ObjectMergePolicy))
 
 	// TODO(hackerwins): If the Snapshot is missing, we may have a very large
 	// number of changes to read at once here. We need to split changes by a
 	// certain size (e.g. 100) and read and gradually reflect it into the document.
-	changes, err := be.DB.FindChangesBetweenServerSeqs(
+	changes, err := db.FindChangesBetweenServerSeqs(
 		ctx,
 		docInfo.ID,
 		snapshotInfo.ServerSeq+1,
@@ -0,0 +1,205 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+)
+
+// PushRequest is the request to push the changes of a pack to a document,
+// which passes through the middlewares of pushes.
+type PushRequest struct {
+	// Backend is the backend of the server.
+	Backend *backend.Backend
+
+	// Project is the project of the document.
+	Project *types.Project
+
+	// ClientInfo is the client pushing the changes.
+	ClientInfo *database.ClientInfo
+
+	// DocInfo is the document which the changes are pushed to. Its server
+	// sequence is increased by the handler for the pushed changes.
+	DocInfo *database.DocInfo
+
+	// Pack is the change pack of the request. The middlewares may transform
+	// its changes before the handler.
+	Pack *change.Pack
+
+	// ReceivedAt is the time when the request is received.
+	ReceivedAt gotime.Time
+
+	// InitialServerSeq is the server sequence of the document before the
+	// changes are pushed.
+	InitialServerSeq uint64
+
+	// Checkpoint is the checkpoint of the client after the push. It is set by
+	// the handler.
+	Checkpoint change.Checkpoint
+
+	// PushedChanges is the changes pushed excluding the ones already pushed
+	// and the deferred ones. It is set by the handler.
+	PushedChanges []*change.Change
+
+	// Deferred is the number of the changes deferred to the following
+	// requests. It is set by the handler.
+	Deferred int
+}
+
+// PushHandler pushes the changes of the given request.
+type PushHandler func(ctx context.Context, req *PushRequest) error
+
+// PushMiddleware inspects, transforms or rejects the changes of the given
+// request before passing it to the next handler. It rejects the request by
+// returning an error without calling next, and it can inspect the result of
+// the push after next returns.
+type PushMiddleware func(ctx context.Context, req *PushRequest, next PushHandler) error
+
+// ChainPushMiddlewares returns a handler which passes the request through the
+// given middlewares in order before the given handler.
+func ChainPushMiddlewares(handler PushHandler, middlewares ...PushMiddleware) PushHandler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		middleware, next := middlewares[i], handler
+		handler = func(ctx context.Context, req *PushRequest) error {
+			return middleware(ctx, req, next)
+		}
+	}
+	return handler
+}
+
+// builtinPushMiddlewares is the built-in middlewares of pushes by name.
+var builtinPushMiddlewares = map[string]PushMiddleware{
+	backend.PushMiddlewareAuthz:      authorizePush,
+	backend.PushMiddlewareValidation: validatePush,
+	backend.PushMiddlewareSquash:     squashPush,
+	backend.PushMiddlewareMetrics:    recordPushMetrics,
+}
+
+// newPushHandler returns the handler of pushes with the middlewares in the
+// order of the given config.
+func newPushHandler(conf *backend.Config) PushHandler {
+	var middlewares []PushMiddleware
+	for _, name := range conf.ParsePushMiddlewares() {
+		middlewares = append(middlewares, builtinPushMiddlewares[name])
+	}
+	return ChainPushMiddlewares(handlePush, middlewares...)
+}
+
+// handlePush pushes the changes of the given request, excluding the ones
+// already pushed. It rejects the changes with the operations whose IDs are
+// already pushed.
+func handlePush(ctx context.Context, req *PushRequest) error {
+	// NOTE: The changes of a detaching client are pushed regardless of the
+	// limit, since the client can not push the deferred changes afterwards.
+	maxOps := req.Backend.Config.MaxOperationsPerPush
+	if attached, err := req.ClientInfo.IsAttached(req.DocInfo.ID); err != nil || !attached {
+		maxOps = 0
+	}
+
+	req.Checkpoint, req.PushedChanges, req.Deferred = pushChanges(
+		ctx,
+		req.ClientInfo,
+		req.DocInfo,
+		req.Pack,
+		req.InitialServerSeq,
+		maxOps,
+	)
+	if len(req.PushedChanges) > 0 {
+		if err := flushLamport(ctx, req.Backend, req.Project, req.DocInfo, req.InitialServerSeq); err != nil {
+			return err
+		}
+	}
+	return checkOperationIDs(ctx, req.Backend, req.Project, req.DocInfo, req.PushedChanges)
+}
+
+// authorizePush rejects the changes if the document is not writable by the
+// client.
+func authorizePush(ctx context.Context, req *PushRequest, next PushHandler) error {
+	if req.Pack.HasChanges() {
+		if err := req.Backend.Maintenance.Check(); err != nil {
+			return err
+		}
+		if err := req.ClientInfo.EnsureDocumentWritable(req.DocInfo.ID); err != nil {
+			return err
+		}
+		if err := req.DocInfo.EnsureWritable(); err != nil {
+			return err
+		}
+	}
+
+	return next(ctx, req)
+}
+
+// validatePush rejects the change pack if it has invalid changes.
+func validatePush(ctx context.Context, req *PushRequest, next PushHandler) error {
+	if isBeyondLamportGap(req.Backend.Config, req.DocInfo, req.Pack) {
+		if err := RecoverLamport(ctx, req.Backend, req.Project, req.DocInfo, req.DocInfo.ServerSeq); err != nil {
+			return err
+		}
+	}
+	if err := validateChangePack(
+		req.Backend.Config,
+		req.Project,
+		req.ClientInfo,
+		req.DocInfo,
+		req.Pack,
+	); err != nil {
+		return err
+	}
+	if err := validateObjectKeys(ctx, req); err != nil {
+		return err
+	}
+
+	return next(ctx, req)
+}
+
+// squashPush merges the adjacent operations of the pushed changes.
+func squashPush(ctx context.Context, req *PushRequest, next PushHandler) error {
+	if err := next(ctx, req); err != nil {
+		return err
+	}
+
+	if req.Backend.Config.EnableOperationSquash && len(req.PushedChanges) > 0 {
+		squashChanges(req.Backend, req.PushedChanges)
+	}
+	return nil
+}
+
+// recordPushMetrics records the metrics of the pushed changes.
+func recordPushMetrics(ctx context.Context, req *PushRequest, next PushHandler) error {
+	if err := next(ctx, req); err != nil {
+		return err
+	}
+
+	be := req.Backend
+	be.Metrics.AddPushPullReceivedChanges(req.Pack.ChangesLen())
+	be.Metrics.AddPushPullReceivedOperations(req.Pack.OperationsLen())
+	be.Metrics.AddPushPullDuplicateChanges(req.Pack.ChangesLen() - len(req.PushedChanges) - req.Deferred)
+	if req.Project.CollectApplyLag {
+		observeApplyLag(be, req.PushedChanges, req.ReceivedAt)
+	}
+	if len(req.PushedChanges) > 0 {
+		recordChangeRate(ctx, be, req.Project, req.ClientInfo, req.DocInfo, req.PushedChanges)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/server/packs"
+)
+
+func TestPushMiddlewares(t *testing.T) {
+	var calls []string
+	record := func(name string) packs.PushMiddleware {
+		return func(ctx context.Context, req *packs.PushRequest, next packs.PushHandler) error {
+			calls = append(calls, name+":before")
+			err := next(ctx, req)
+			calls = append(calls, name+":after")
+			return err
+		}
+	}
+	handler := func(ctx context.Context, req *packs.PushRequest) error {
+		calls = append(calls, "handler")
+		req.Deferred = 1
+		return nil
+	}
+
+	t.Run("middleware ordering test", func(t *testing.T) {
+		calls = nil
+		req := &packs.PushRequest{}
+		push := packs.ChainPushMiddlewares(handler, record("a"), record("b"))
+		assert.NoError(t, push(context.Background(), req))
+		assert.Equal(t, []string{"a:before", "b:before", "handler", "b:after", "a:after"}, calls)
+		assert.Equal(t, 1, req.Deferred)
+
+		calls = nil
+		assert.NoError(t, packs.ChainPushMiddlewares(handler)(context.Background(), req))
+		assert.Equal(t, []string{"handler"}, calls)
+	})
+
+	t.Run("middleware short-circuit test", func(t *testing.T) {
+		calls = nil
+		errRejected := errors.New("rejected")
+		reject := func(ctx context.Context, req *packs.PushRequest, next packs.PushHandler) error {
+			calls = append(calls, "reject")
+			return errRejected
+		}
+
+		req := &packs.PushRequest{}
+		push := packs.ChainPushMiddlewares(handler, record("a"), reject, record("b"))
+		assert.ErrorIs(t, push(context.Background(), req), errRejected)
+		assert.Equal(t, []string{"a:before", "reject", "a:after"}, calls)
+		assert.Equal(t, 0, req.Deferred)
+	})
+}
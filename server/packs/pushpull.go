@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/backend/database"
@@ -32,28 +33,62 @@ var (
 	// ErrInvalidServerSeq is returned when the given server seq greater than
 	// the initial server seq.
 	ErrInvalidServerSeq = errors.New("invalid server seq")
+
+	// ErrChangesCompacted is returned when the changes needed to build the
+	// document at the given server seq are removed by archiving it.
+	ErrChangesCompacted = errors.New("changes compacted")
+
+	// ErrLamportTooFarAhead is returned when the Lamport timestamp of the given
+	// change exceeds the largest one of the document by more than the
+	// acceptance window.
+	ErrLamportTooFarAhead = errors.New("lamport too far ahead")
 )
 
-// pushChanges returns the changes excluding already saved in DB.
+// pushChanges returns the changes excluding already saved in DB. A change
+// whose ClientSeq is not greater than the checkpoint of the client has been
+// applied by an earlier request, e.g. the client retried after losing the
+// response, so it is skipped to keep pushes idempotent.
+//
+// If maxOps is not zero, the changes after the operations reach maxOps are
+// deferred to the following requests, and the number of them is returned.
+// The deferred changes are kept by the client as local changes since the
+// checkpoint does not cover them.
 func pushChanges(
 	ctx context.Context,
 	clientInfo *database.ClientInfo,
 	docInfo *database.DocInfo,
 	reqPack *change.Pack,
 	initialServerSeq uint64,
-) (change.Checkpoint, []*change.Change) {
+	maxOps uint64,
+) (change.Checkpoint, []*change.Change, int) {
 	cp := clientInfo.Checkpoint(docInfo.ID)
 
 	var pushedChanges []*change.Change
+	var ops uint64
+	deferred := 0
 	for _, cn := range reqPack.Changes {
 		if cn.ID().ClientSeq() > cp.ClientSeq {
+			// NOTE: Only a prefix of the changes is pushed so that the causal
+			// order is kept across requests. At least one change is pushed
+			// for a change with more operations than maxOps to make progress.
+			if deferred > 0 || (maxOps > 0 && len(pushedChanges) > 0 &&
+				ops+uint64(len(cn.Operations())) > maxOps) {
+				deferred++
+				continue
+			}
+			ops += uint64(len(cn.Operations()))
+
 			serverSeq := docInfo.IncreaseServerSeq()
 			cp = cp.NextServerSeq(serverSeq)
 			cn.SetServerSeq(serverSeq)
+			if cn.ID().Lamport() > docInfo.Lamport {
+				docInfo.Lamport = cn.ID().Lamport()
+			}
 			pushedChanges = append(pushedChanges, cn)
 		} else {
 			logging.From(ctx).Warnf(
-				"change already pushed, clientSeq: %d, cp: %d",
+				"change already pushed, actor: %s, clientSeq: %d, cp: %d",
+				cn.ID().ActorID(),
 				cn.ID().ClientSeq(),
 				cp.ClientSeq,
 			)
@@ -64,23 +99,36 @@ func pushChanges(
 
 	if len(reqPack.Changes) > 0 {
 		logging.From(ctx).Infof(
-			"PUSH: '%s' pushes %d changes into '%s', rejected %d changes, serverSeq: %d -> %d, cp: %s",
+			"PUSH: '%s' pushes %d changes into '%s', rejected %d changes, deferred %d changes, serverSeq: %d -> %d, cp: %s",
 			clientInfo.ID,
 			len(pushedChanges),
 			docInfo.Key,
-			len(reqPack.Changes)-len(pushedChanges),
+			len(reqPack.Changes)-len(pushedChanges)-deferred,
+			deferred,
 			initialServerSeq,
 			docInfo.ServerSeq,
 			cp.String(),
 		)
 	}
 
-	return cp, pushedChanges
+	return cp, pushedChanges, deferred
+}
+
+// squashChanges merges the adjacent operations of the given changes into fewer
+// operations before they are stored.
+func squashChanges(be *backend.Backend, changes []*change.Change) {
+	operations := 0
+	for _, cn := range changes {
+		operations += len(cn.Operations())
+	}
+
+	be.Metrics.AddPushPullSquashedOperations(change.Squash(changes), operations)
 }
 
 func pullPack(
 	ctx context.Context,
 	be *backend.Backend,
+	project *types.Project,
 	clientInfo *database.ClientInfo,
 	docInfo *database.DocInfo,
 	reqPack *change.Pack,
@@ -97,10 +145,13 @@ func pullPack(
 	}
 
 	// Pull changes from DB if the size of changes for the response is less than the snapshot threshold.
-	if initialServerSeq-reqPack.Checkpoint.ServerSeq < be.Config.SnapshotThreshold {
+	// The changes removed by archiving the document can only be pulled as a snapshot.
+	if initialServerSeq-reqPack.Checkpoint.ServerSeq < be.Config.SnapshotPolicy(project).Threshold &&
+		reqPack.Checkpoint.ServerSeq >= docInfo.CompactedServerSeq {
 		cpAfterPull, pulledChanges, err := pullChangeInfos(
 			ctx,
 			be,
+			project,
 			clientInfo,
 			docInfo,
 			reqPack,
@@ -113,13 +164,14 @@ func pullPack(
 		return NewServerPack(docInfo.Key, cpAfterPull, pulledChanges, nil), err
 	}
 
-	return pullSnapshot(ctx, be, clientInfo, docInfo, reqPack, cpAfterPush, initialServerSeq)
+	return pullSnapshot(ctx, be, project, clientInfo, docInfo, reqPack, cpAfterPush, initialServerSeq)
 }
 
 // pullSnapshot pulls the snapshot from DB.
 func pullSnapshot(
 	ctx context.Context,
 	be *backend.Backend,
+	project *types.Project,
 	clientInfo *database.ClientInfo,
 	docInfo *database.DocInfo,
 	reqPack *change.Pack,
@@ -127,17 +179,26 @@ func pullSnapshot(
 	initialServerSeq uint64,
 ) (*ServerPack, error) {
 	// Build document from DB if the size of changes for the response is greater than the snapshot threshold.
-	doc, err := BuildDocumentForServerSeq(ctx, be, docInfo, initialServerSeq)
+	doc, err := BuildDocumentForServerSeq(ctx, be, project, docInfo, initialServerSeq)
 	if err != nil {
 		return nil, err
 	}
 
-	// Apply changes that are in the request pack.
-	if reqPack.HasChanges() {
+	// Apply changes that are in the request pack. The changes already pushed
+	// are included in the built document, and the deferred changes are
+	// excluded.
+	var changes []*change.Change
+	cp := clientInfo.Checkpoint(docInfo.ID)
+	for _, cn := range reqPack.Changes {
+		if cn.ID().ClientSeq() > cp.ClientSeq && cn.ID().ClientSeq() <= cpAfterPush.ClientSeq {
+			changes = append(changes, cn)
+		}
+	}
+	if len(changes) > 0 {
 		if err := doc.ApplyChangePack(change.NewPack(
 			docInfo.Key,
 			doc.Checkpoint().NextServerSeq(docInfo.ServerSeq),
-			reqPack.Changes,
+			changes,
 			nil,
 		)); err != nil {
 			return nil, err
@@ -165,13 +226,14 @@ func pullSnapshot(
 func pullChangeInfos(
 	ctx context.Context,
 	be *backend.Backend,
+	project *types.Project,
 	clientInfo *database.ClientInfo,
 	docInfo *database.DocInfo,
 	reqPack *change.Pack,
 	cpAfterPush change.Checkpoint,
 	initialServerSeq uint64,
 ) (change.Checkpoint, []*database.ChangeInfo, error) {
-	pulledChanges, err := be.DB.FindChangeInfosBetweenServerSeqs(
+	pulledChanges, err := be.DocDB(project, docInfo.Key).FindChangeInfosBetweenServerSeqs(
 		ctx,
 		docInfo.ID,
 		reqPack.Checkpoint.ServerSeq+1,
@@ -181,6 +243,20 @@ func pullChangeInfos(
 		return change.InitialCheckpoint, nil, err
 	}
 
+	// NOTE: The changes resent by the client were stored by an earlier request
+	// whose response was lost. The client still holds them as local changes,
+	// so they are excluded from the response to avoid applying them twice.
+	if duplicates := duplicateChanges(clientInfo, docInfo, reqPack); len(duplicates) > 0 {
+		var filtered []*database.ChangeInfo
+		for _, info := range pulledChanges {
+			if info.ActorID == clientInfo.ID && duplicates[changeKey{info.ClientSeq, info.Lamport}] {
+				continue
+			}
+			filtered = append(filtered, info)
+		}
+		pulledChanges = filtered
+	}
+
 	cpAfterPull := cpAfterPush.NextServerSeq(docInfo.ServerSeq)
 
 	if len(pulledChanges) > 0 {
@@ -197,3 +273,26 @@ func pullChangeInfos(
 
 	return cpAfterPull, pulledChanges, nil
 }
+
+// changeKey identifies a change of a client within a document.
+type changeKey struct {
+	clientSeq uint32
+	lamport   uint64
+}
+
+// duplicateChanges returns the keys of the changes in the given pack that
+// had already been pushed before the request.
+func duplicateChanges(
+	clientInfo *database.ClientInfo,
+	docInfo *database.DocInfo,
+	reqPack *change.Pack,
+) map[changeKey]bool {
+	cp := clientInfo.Checkpoint(docInfo.ID)
+	duplicates := make(map[changeKey]bool)
+	for _, cn := range reqPack.Changes {
+		if cn.ID().ClientSeq() <= cp.ClientSeq {
+			duplicates[changeKey{cn.ID().ClientSeq(), cn.ID().Lamport()}] = true
+		}
+	}
+	return duplicates
+}
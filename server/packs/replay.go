@@ -0,0 +1,248 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/sync"
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+// changeCursor is the client sequence and the Lamport timestamp of the last
+// change of an actor.
+type changeCursor struct {
+	clientSeq uint32
+	lamport   uint64
+}
+
+// ReplayChanges stores the given changes, authored in another system, in the
+// given order as the history of the given document. The changes must be in a
+// causal order: the changes of each actor must have contiguous client
+// sequences and increasing Lamport timestamps, and each change must be
+// applicable to the document built from the changes before it.
+//
+// The changes whose client sequences are not greater than the last stored
+// ones of their actors are skipped, so that a retried replay does not
+// duplicate the changes stored by the previous attempt.
+func ReplayChanges(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	changes []*change.Change,
+) (*types.ChangeReplay, error) {
+	locker, err := be.Coordinator.NewLocker(ctx, PushPullKey(project.ID, docInfo.Key))
+	if err != nil {
+		return nil, err
+	}
+	if err := locker.Lock(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	// NOTE: Clients may have pushed changes while waiting for the lock.
+	db := be.DocDB(project, docInfo.Key)
+	loaded, err := db.FindDocInfoByID(ctx, docInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+	*docInfo = *loaded
+
+	// NOTE: The changes of the actors are unknown once they are removed by
+	// archiving the document, so the stored changes could not be skipped.
+	if docInfo.CompactedServerSeq > 0 {
+		return nil, fmt.Errorf("%s: %w", docInfo.Key, ErrChangesCompacted)
+	}
+
+	cursors, err := findChangeCursors(ctx, db, docInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := BuildDocumentForServerSeq(ctx, be, project, docInfo, docInfo.ServerSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	actorIDFormat := be.Config.ActorIDFormat()
+	replay := &types.ChangeReplay{}
+	var replayed []*change.Change
+	for i, cn := range changes {
+		field := fmt.Sprintf("changes[%d]", i)
+		id := cn.ID()
+		if id.ActorID() == nil {
+			return nil, &InvalidChangePackError{Violations: []*Violation{{
+				Field: field + ".id.actor_id",
+				Err:   fmt.Errorf("empty actor: %w", ErrActorMismatch),
+			}}}
+		}
+		if err := actorIDFormat.Validate(id.ActorID()); err != nil {
+			return nil, &InvalidChangePackError{Violations: []*Violation{{
+				Field: field + ".id.actor_id",
+				Err:   err,
+			}}}
+		}
+
+		cursor := cursors[id.ActorID().String()]
+		if id.ClientSeq() <= cursor.clientSeq {
+			replay.Skipped++
+			continue
+		}
+		if id.ClientSeq() != cursor.clientSeq+1 {
+			return nil, &InvalidChangePackError{Violations: []*Violation{{
+				Field: field + ".id.client_seq",
+				Err: fmt.Errorf(
+					"%d does not follow %d: %w",
+					id.ClientSeq(),
+					cursor.clientSeq,
+					ErrClientSeqGap,
+				),
+			}}}
+		}
+		if err := change.VerifyTickets(cn, cursor.lamport); err != nil {
+			var ticketErr *change.TicketError
+			if errors.As(err, &ticketErr) {
+				return nil, &InvalidChangePackError{Violations: []*Violation{{
+					Field: field + "." + ticketErr.Field,
+					Err:   ticketErr.Err,
+				}}}
+			}
+			return nil, err
+		}
+		if err := doc.ApplyChanges(cn); err != nil {
+			return nil, &InvalidChangePackError{Violations: []*Violation{{
+				Field: field,
+				Err:   fmt.Errorf("%s: %w", err.Error(), ErrChangeNotApplicable),
+			}}}
+		}
+
+		cursors[id.ActorID().String()] = changeCursor{clientSeq: id.ClientSeq(), lamport: id.Lamport()}
+		replayed = append(replayed, cn)
+	}
+
+	replay.Replayed = len(replayed)
+	if len(replayed) > 0 {
+		if err := storeReplayedChanges(ctx, be, project, docInfo, replayed); err != nil {
+			return nil, err
+		}
+	}
+	replay.ServerSeq = docInfo.ServerSeq
+
+	logging.From(ctx).Infof(
+		"REPLAY: '%s' replayed %d, skipped %d, serverSeq: %d",
+		docInfo.Key,
+		replay.Replayed,
+		replay.Skipped,
+		docInfo.ServerSeq,
+	)
+	return replay, nil
+}
+
+// findChangeCursors returns the cursors of the last changes of the actors
+// stored in the given document.
+func findChangeCursors(
+	ctx context.Context,
+	db database.Database,
+	docInfo *database.DocInfo,
+) (map[string]changeCursor, error) {
+	cursors := make(map[string]changeCursor)
+	for from := uint64(1); from <= docInfo.ServerSeq; from += validateBatchSize {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		to := from + validateBatchSize - 1
+		if to > docInfo.ServerSeq {
+			to = docInfo.ServerSeq
+		}
+
+		changes, err := db.FindChangesBetweenServerSeqs(ctx, docInfo.ID, from, to)
+		if err != nil {
+			return nil, err
+		}
+		for _, cn := range changes {
+			id := cn.ID()
+			cursor := cursors[id.ActorID().String()]
+			if id.ClientSeq() > cursor.clientSeq {
+				cursor.clientSeq = id.ClientSeq()
+			}
+			if id.Lamport() > cursor.lamport {
+				cursor.lamport = id.Lamport()
+			}
+			cursors[id.ActorID().String()] = cursor
+		}
+	}
+
+	return cursors, nil
+}
+
+// storeReplayedChanges stores the given changes applied to the document and
+// publishes them to the watchers of the document. The snapshot is stored at
+// the head so that the clients attaching the document later do not build it
+// from the whole replayed history.
+func storeReplayedChanges(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	changes []*change.Change,
+) error {
+	if err := RecoverLamport(ctx, be, project, docInfo, docInfo.ServerSeq); err != nil {
+		return err
+	}
+	initialServerSeq := docInfo.ServerSeq
+	for _, cn := range changes {
+		cn.SetServerSeq(docInfo.IncreaseServerSeq())
+		if cn.ID().Lamport() > docInfo.Lamport {
+			docInfo.Lamport = cn.ID().Lamport()
+		}
+	}
+	docInfo.LamportServerSeq = docInfo.ServerSeq
+
+	if err := be.DocDB(project, docInfo.Key).CreateChangeInfos(
+		ctx,
+		project.ID,
+		docInfo,
+		initialServerSeq,
+		changes,
+	); err != nil {
+		return err
+	}
+	appendEventLogs(ctx, be, project, docInfo, initialServerSeq, changes)
+
+	be.EventBatcher.Publish(ctx, time.InitialActorID, sync.DocEvent{
+		Type:         types.DocumentsChangedEvent,
+		Publisher:    types.Client{ID: time.InitialActorID},
+		DocumentKeys: []key.Key{docInfo.Key},
+		ServerSeq:    docInfo.ServerSeq,
+	})
+
+	return StoreSnapshotAtHead(ctx, be, project, docInfo)
+}
@@ -0,0 +1,222 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/sync"
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+// ErrSnapshotNotRetained is returned when the snapshot to roll back to is not
+// retained.
+var ErrSnapshotNotRetained = errors.New("snapshot not retained")
+
+// RollbackDocument restores the given document to the retained snapshot of
+// the given server sequence. The restoration is stored as a change of the
+// initial actor on top of the current document, so the clients attaching the
+// document are reconciled by pulling it like the other changes.
+func RollbackDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	serverSeq uint64,
+) error {
+	db := be.DocDB(project, docInfo.Key)
+	snapshotInfo, err := db.FindClosestSnapshotInfo(ctx, docInfo.ID, serverSeq)
+	if err != nil {
+		return err
+	}
+	if serverSeq == 0 || snapshotInfo.ServerSeq != serverSeq {
+		return fmt.Errorf("%s of %d: %w", docInfo.Key, serverSeq, ErrSnapshotNotRetained)
+	}
+
+	target, err := converter.BytesToObject(snapshotInfo.Snapshot)
+	if err != nil {
+		return err
+	}
+
+	locker, err := be.Coordinator.NewLocker(ctx, PushPullKey(project.ID, docInfo.Key))
+	if err != nil {
+		return err
+	}
+	if err := locker.Lock(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	initialServerSeq, err := storeServerChange(
+		ctx,
+		be,
+		project,
+		docInfo,
+		fmt.Sprintf("rollback to %d", serverSeq),
+		func(root *proxy.ObjectProxy) error {
+			root.Restore(target)
+			return nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if initialServerSeq == docInfo.ServerSeq {
+		return nil
+	}
+
+	logging.From(ctx).Infof(
+		"ROLLBACK: '%s' is rolled back to serverSeq %d, serverSeq: %d -> %d",
+		docInfo.Key,
+		serverSeq,
+		initialServerSeq,
+		docInfo.ServerSeq,
+	)
+	return nil
+}
+
+// ResetDocument clears all the content of the given document while keeping
+// its key and the clients attaching it. The clearing is stored as a change of
+// the initial actor like RollbackDocument, and the empty state is snapshotted
+// so that the clients attaching the document later start from it.
+func ResetDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+) error {
+	locker, err := be.Coordinator.NewLocker(ctx, PushPullKey(project.ID, docInfo.Key))
+	if err != nil {
+		return err
+	}
+	if err := locker.Lock(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	initialServerSeq, err := storeServerChange(
+		ctx,
+		be,
+		project,
+		docInfo,
+		"reset",
+		func(root *proxy.ObjectProxy) error {
+			root.Clear()
+			return nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := StoreSnapshotAtHead(ctx, be, project, docInfo); err != nil {
+		return err
+	}
+
+	logging.From(ctx).Infof(
+		"RESET: '%s' is reset, serverSeq: %d -> %d",
+		docInfo.Key,
+		initialServerSeq,
+		docInfo.ServerSeq,
+	)
+	return nil
+}
+
+// storeServerChange stores the change made by the given updater on top of the
+// current document as a change of the initial actor, and publishes it to the
+// watchers of the document. It must be called while holding the lock of the
+// document, and returns the server sequence before the change.
+func storeServerChange(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	message string,
+	updater func(root *proxy.ObjectProxy) error,
+) (uint64, error) {
+	// NOTE: Clients may have pushed changes while waiting for the lock.
+	db := be.DocDB(project, docInfo.Key)
+	loaded, err := db.FindDocInfoByID(ctx, docInfo.ID)
+	if err != nil {
+		return 0, err
+	}
+	*docInfo = *loaded
+
+	current, err := BuildDocumentForServerSeq(ctx, be, project, docInfo, docInfo.ServerSeq)
+	if err != nil {
+		return 0, err
+	}
+
+	doc := document.NewFromInternalDocument(current)
+	if err := doc.Update(updater, message); err != nil {
+		return 0, err
+	}
+
+	if err := RecoverLamport(ctx, be, project, docInfo, docInfo.ServerSeq); err != nil {
+		return 0, err
+	}
+	initialServerSeq := docInfo.ServerSeq
+	changes := doc.CreateChangePack().Changes
+	for _, cn := range changes {
+		cn.SetServerSeq(docInfo.IncreaseServerSeq())
+		if cn.ID().Lamport() > docInfo.Lamport {
+			docInfo.Lamport = cn.ID().Lamport()
+		}
+	}
+	docInfo.LamportServerSeq = docInfo.ServerSeq
+	if len(changes) == 0 {
+		return initialServerSeq, nil
+	}
+
+	if err := db.CreateChangeInfos(
+		ctx,
+		project.ID,
+		docInfo,
+		initialServerSeq,
+		changes,
+	); err != nil {
+		return 0, err
+	}
+	appendEventLogs(ctx, be, project, docInfo, initialServerSeq, changes)
+
+	be.EventBatcher.Publish(ctx, time.InitialActorID, sync.DocEvent{
+		Type:         types.DocumentsChangedEvent,
+		Publisher:    types.Client{ID: time.InitialActorID},
+		DocumentKeys: []key.Key{docInfo.Key},
+		ServerSeq:    docInfo.ServerSeq,
+	})
+
+	return initialServerSeq, nil
+}
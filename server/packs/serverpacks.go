@@ -44,6 +44,10 @@ type ServerPack struct {
 	// MinSyncedTicket is the minimum logical time taken by clients who attach the document.
 	// It used to collect garbage on the replica on the client.
 	MinSyncedTicket *time.Ticket
+
+	// DeferredChanges is the number of the changes of the request deferred to
+	// the following requests by the limit of operations per push.
+	DeferredChanges uint32
 }
 
 // NewServerPack creates a new instance of ServerPack.
@@ -113,5 +117,6 @@ func (p *ServerPack) ToPBChangePack() (*api.ChangePack, error) {
 		Changes:         pbChanges,
 		Snapshot:        p.Snapshot,
 		MinSyncedTicket: converter.ToTimeTicket(p.MinSyncedTicket),
+		DeferredChanges: p.DeferredChanges,
 	}, nil
 }
@@ -0,0 +1,155 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package packs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+// UpgradeSnapshots rewrites at most limit snapshots stored in old formats in
+// the current format, and returns the number of them. The progress of the
+// upgrade is reported to the metrics.
+func UpgradeSnapshots(
+	ctx context.Context,
+	be *backend.Backend,
+	limit int,
+) (int, error) {
+	infos, err := be.DB.FindSnapshotInfosToUpgrade(ctx, converter.CurrentSnapshotVersion, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	upgradedCount := 0
+	for _, info := range infos {
+		upgraded, err := upgradeSnapshot(ctx, be, info)
+		if err != nil {
+			return upgradedCount, err
+		}
+		if upgraded {
+			upgradedCount++
+		}
+	}
+
+	total, outdated, err := be.DB.CountSnapshotInfosToUpgrade(ctx, converter.CurrentSnapshotVersion)
+	if err != nil {
+		return upgradedCount, err
+	}
+	be.Metrics.SetBackendSnapshotsUpgradedPercent(upgradedPercent(total, outdated))
+
+	return upgradedCount, nil
+}
+
+// upgradeSnapshot rewrites the given snapshot in the current format at the
+// same server sequence. It returns false if the snapshot is skipped.
+//
+// NOTE: The snapshot is rewritten as it is without applying changes or
+// collecting garbage, so the tombstones which are still needed by the clients
+// are kept. It is skipped if the snapshot of the document is being created,
+// and upgraded in a later run.
+func upgradeSnapshot(
+	ctx context.Context,
+	be *backend.Backend,
+	info *database.SnapshotInfo,
+) (bool, error) {
+	docInfo, err := be.DB.FindDocInfoByID(ctx, info.DocID)
+	if errors.Is(err, database.ErrDocumentNotFound) {
+		logging.From(ctx).Warnf("SNAP: skip upgrading snapshot of missing doc %s", info.DocID)
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	locker, err := be.Coordinator.NewLocker(ctx, SnapshotKey(docInfo.ProjectID, docInfo.Key))
+	if err != nil {
+		return false, err
+	}
+	if err := locker.TryLock(ctx); err != nil {
+		return false, nil
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	// NOTE: The snapshot may have been replaced or removed while acquiring the
+	// lock, so it is read again under the lock.
+	snapshotInfo, err := be.DB.FindClosestSnapshotInfo(ctx, docInfo.ID, info.ServerSeq)
+	if err != nil {
+		return false, err
+	}
+	if snapshotInfo.ServerSeq != info.ServerSeq ||
+		snapshotInfo.Version >= converter.CurrentSnapshotVersion {
+		return false, nil
+	}
+
+	doc, err := document.NewInternalDocumentFromSnapshot(
+		docInfo.Key,
+		snapshotInfo.ServerSeq,
+		snapshotInfo.Lamport,
+		snapshotInfo.Snapshot,
+	)
+	if err != nil {
+		return false, fmt.Errorf("upgrade snapshot of %s at %d: %w", docInfo.Key, info.ServerSeq, err)
+	}
+
+	vector, err := snapshotVersionVector(ctx, be.DB, docInfo.ID, snapshotInfo)
+	if err != nil {
+		return false, err
+	}
+	doc.SetVersionVector(vector)
+
+	projectInfo, err := be.DB.FindProjectInfoByID(ctx, docInfo.ProjectID)
+	if err != nil {
+		return false, err
+	}
+	policy := be.Config.SnapshotPolicy(projectInfo.ToProject())
+	compression := types.SnapshotCompression(policy.Compression)
+	if err := createSnapshotInfo(ctx, be, be.DB, docInfo, doc, compression); err != nil {
+		return false, err
+	}
+
+	logging.From(ctx).Infof(
+		"SNAP: upgraded '%s', serverSeq: %d, version: %d",
+		docInfo.Key,
+		snapshotInfo.ServerSeq,
+		snapshotInfo.Version,
+	)
+	return true, nil
+}
+
+// upgradedPercent returns the percentage of the snapshots stored in the
+// current format.
+func upgradedPercent(total, outdated int64) float64 {
+	if total <= 0 || outdated <= 0 {
+		return 100
+	}
+	if outdated >= total {
+		return 0
+	}
+
+	return float64(total-outdated) / float64(total) * 100
+}
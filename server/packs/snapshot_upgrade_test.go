@@ -0,0 +1,202 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package packs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/database/memory"
+	memsync "github.com/yorkie-team/yorkie/server/backend/sync/memory"
+	"github.com/yorkie-team/yorkie/server/logging"
+	"github.com/yorkie-team/yorkie/server/packs"
+	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
+)
+
+// legacyDB is a database whose snapshots are reported in an old format until
+// they are rewritten.
+type legacyDB struct {
+	database.Database
+
+	// rewritten is the set of the server sequences of the rewritten snapshots.
+	rewritten map[uint64]bool
+}
+
+func (d *legacyDB) CreateSnapshotInfo(
+	ctx context.Context,
+	docID types.ID,
+	doc *document.InternalDocument,
+	compression types.SnapshotCompression,
+) error {
+	if err := d.Database.CreateSnapshotInfo(ctx, docID, doc, compression); err != nil {
+		return err
+	}
+	d.rewritten[doc.Checkpoint().ServerSeq] = true
+	return nil
+}
+
+func (d *legacyDB) FindClosestSnapshotInfo(
+	ctx context.Context,
+	docID types.ID,
+	serverSeq uint64,
+) (*database.SnapshotInfo, error) {
+	info, err := d.Database.FindClosestSnapshotInfo(ctx, docID, serverSeq)
+	if err != nil {
+		return nil, err
+	}
+	return d.legacy(info), nil
+}
+
+func (d *legacyDB) FindSnapshotInfosToUpgrade(
+	ctx context.Context,
+	version int32,
+	limit int,
+) ([]*database.SnapshotInfo, error) {
+	infos, err := d.Database.FindSnapshotInfosToUpgrade(ctx, version+1, 1000)
+	if err != nil {
+		return nil, err
+	}
+
+	var outdated []*database.SnapshotInfo
+	for _, info := range infos {
+		if len(outdated) < limit && !d.rewritten[info.ServerSeq] {
+			outdated = append(outdated, d.legacy(info))
+		}
+	}
+	return outdated, nil
+}
+
+func (d *legacyDB) CountSnapshotInfosToUpgrade(
+	ctx context.Context,
+	version int32,
+) (int64, int64, error) {
+	infos, err := d.FindSnapshotInfosToUpgrade(ctx, version, 1000)
+	if err != nil {
+		return 0, 0, err
+	}
+	total, _, err := d.Database.CountSnapshotInfosToUpgrade(ctx, version)
+	if err != nil {
+		return 0, 0, err
+	}
+	return total, int64(len(infos)), nil
+}
+
+func (d *legacyDB) legacy(info *database.SnapshotInfo) *database.SnapshotInfo {
+	copied := *info
+	if !d.rewritten[info.ServerSeq] {
+		copied.Version = 0
+	}
+	return &copied
+}
+
+func TestUpgradeSnapshots(t *testing.T) {
+	ctx := logging.With(context.Background(), logging.New(t.Name()))
+	metrics, err := prometheus.NewMetrics()
+	assert.NoError(t, err)
+
+	idGenerator, err := database.NewIDGenerator(database.ObjectIDGeneratorName, nil)
+	assert.NoError(t, err)
+	memDB, err := memory.New(idGenerator)
+	assert.NoError(t, err)
+	db := &legacyDB{Database: memDB, rewritten: make(map[uint64]bool)}
+
+	projectInfo, err := memDB.EnsureDefaultProjectInfo(ctx)
+	assert.NoError(t, err)
+	clientInfo, err := memDB.ActivateClient(ctx, projectInfo.ID, t.Name())
+	assert.NoError(t, err)
+	docInfo, err := memDB.FindDocInfoByKeyAndOwner(ctx, projectInfo.ID, clientInfo.ID, key.Key(t.Name()), true)
+	assert.NoError(t, err)
+
+	// 01. Store the snapshots at each change of the document.
+	actorID, err := time.ActorIDFromHex(clientInfo.ID.String())
+	assert.NoError(t, err)
+	doc := document.New(docInfo.Key)
+	doc.SetActor(actorID)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger(fmt.Sprintf("k%d", i), i)
+			return nil
+		}))
+		pack := doc.CreateChangePack()
+		initialServerSeq := docInfo.ServerSeq
+		for _, c := range pack.Changes {
+			c.SetServerSeq(docInfo.IncreaseServerSeq())
+		}
+		assert.NoError(t, memDB.CreateChangeInfos(ctx, projectInfo.ID, docInfo, initialServerSeq, pack.Changes))
+		assert.NoError(t, doc.ApplyChangePack(change.NewPack(
+			doc.Key(),
+			change.NewCheckpoint(docInfo.ServerSeq, pack.Checkpoint.ClientSeq),
+			nil,
+			nil,
+		)))
+		assert.NoError(t, memDB.CreateSnapshotInfo(ctx, docInfo.ID, doc.InternalDocument(), types.SnapshotCompressionNone))
+	}
+
+	be := &backend.Backend{
+		Config: &backend.Config{
+			SnapshotWriteMaxRetries:      2,
+			SnapshotWriteMaxWaitInterval: "1ms",
+		},
+		DB:          db,
+		Coordinator: memsync.NewCoordinator(nil),
+		Metrics:     metrics,
+	}
+
+	// 02. Upgrade the snapshots at most two in a run.
+	count, err := packs.UpgradeSnapshots(ctx, be, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	_, outdated, err := db.CountSnapshotInfosToUpgrade(ctx, converter.CurrentSnapshotVersion)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), outdated)
+
+	count, err = packs.UpgradeSnapshots(ctx, be, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	count, err = packs.UpgradeSnapshots(ctx, be, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	// 03. The upgraded snapshots keep their contents.
+	expected := []string{`{"k0":0}`, `{"k0":0,"k1":1}`, `{"k0":0,"k1":1,"k2":2}`}
+	infos, err := memDB.FindSnapshotInfos(ctx, docInfo.ID)
+	assert.NoError(t, err)
+	assert.Len(t, infos, 3)
+	for _, info := range infos {
+		snapshotInfo, err := db.FindClosestSnapshotInfo(ctx, docInfo.ID, info.ServerSeq)
+		assert.NoError(t, err)
+		snapshotDoc, err := document.NewInternalDocumentFromSnapshot(
+			docInfo.Key,
+			snapshotInfo.ServerSeq,
+			snapshotInfo.Lamport,
+			snapshotInfo.Snapshot,
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, expected[info.ServerSeq-1], snapshotDoc.Marshal())
+	}
+}
@@ -18,36 +18,67 @@ package packs
 
 import (
 	"context"
+	"fmt"
+	"math"
+	gotime "time"
 
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/backend/database"
 	"github.com/yorkie-team/yorkie/server/logging"
 )
 
+// StoreSnapshotAtHead stores the snapshot of the given document at its current
+// server sequence regardless of the snapshot interval, if it does not exist.
+func StoreSnapshotAtHead(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+) error {
+	return storeSnapshot(ctx, be, project, docInfo, nil, 0)
+}
+
 func storeSnapshot(
 	ctx context.Context,
 	be *backend.Backend,
+	project *types.Project,
 	docInfo *database.DocInfo,
 	minSyncedTicket *time.Ticket,
+	interval uint64,
 ) error {
 	// 01. get the closest snapshot of this docInfo
 	// TODO: For performance issue, we only need to read the snapshot's metadata.
-	snapshotInfo, err := be.DB.FindClosestSnapshotInfo(ctx, docInfo.ID, docInfo.ServerSeq)
+	db := be.DocDB(project, docInfo.Key)
+	snapshotInfo, err := db.FindClosestSnapshotInfo(ctx, docInfo.ID, docInfo.ServerSeq)
 	if err != nil {
 		return err
 	}
 	if snapshotInfo.ServerSeq == docInfo.ServerSeq {
 		return nil
 	}
-	if docInfo.ServerSeq-snapshotInfo.ServerSeq < be.Config.SnapshotInterval {
+
+	// NOTE: If the closest snapshot is encoded in an old format or holds too
+	// many tombstones which can be collected now, we store a new snapshot
+	// regardless of the interval to upgrade it or to collect them.
+	version, err := converter.SnapshotVersion(snapshotInfo.Snapshot)
+	if err != nil {
+		return err
+	}
+	if version == converter.CurrentSnapshotVersion &&
+		!hasCollectableGarbage(be.Config, snapshotInfo, minSyncedTicket) &&
+		docInfo.ServerSeq-snapshotInfo.ServerSeq < interval {
 		return nil
 	}
 
 	// 02. retrieve the changes between last snapshot and current docInfo
-	changes, err := be.DB.FindChangesBetweenServerSeqs(
+	changes, err := db.FindChangesBetweenServerSeqs(
 		ctx,
 		docInfo.ID,
 		snapshotInfo.ServerSeq+1,
@@ -58,15 +89,17 @@ func storeSnapshot(
 	}
 
 	// 03. create document instance of the docInfo
-	doc, err := document.NewInternalDocumentFromSnapshot(
-		docInfo.Key,
-		snapshotInfo.ServerSeq,
-		snapshotInfo.Lamport,
-		snapshotInfo.Snapshot,
-	)
+	doc, err := newDocumentFromSnapshot(be, docInfo, snapshotInfo)
+	if err != nil {
+		return err
+	}
+	doc.SetObjectMergePolicy(json.MergePolicy(project.ObjectMergePolicy))
+
+	vector, err := snapshotVersionVector(ctx, db, docInfo.ID, snapshotInfo)
 	if err != nil {
 		return err
 	}
+	doc.SetVersionVector(vector)
 
 	pack := change.NewPack(
 		docInfo.Key,
@@ -80,8 +113,35 @@ func storeSnapshot(
 		return err
 	}
 
+	// NOTE: The tombstones left after the garbage collection are still
+	// needed by the client which has synced the least, so we warn operators
+	// that the client blocks the garbage collection.
+	threshold := be.Config.TombstoneThreshold
+	if threshold > 0 && minSyncedTicket != nil && uint64(doc.GarbageLen()) >= threshold {
+		be.Metrics.AddPushPullGCBlocked()
+		logging.From(ctx).Warnf(
+			"SNAP: GC of '%s' blocked by lagging client %s, tombstones: %d",
+			docInfo.Key,
+			minSyncedTicket.ActorIDHex(),
+			doc.GarbageLen(),
+		)
+	}
+
 	// 04. save the snapshot of the docInfo
-	if err := be.DB.CreateSnapshotInfo(ctx, docInfo.ID, doc); err != nil {
+	policy := be.Config.SnapshotPolicy(project)
+	compression := types.SnapshotCompression(policy.Compression)
+	if err := createSnapshotInfo(ctx, be, db, docInfo, doc, compression); err != nil {
+		return err
+	}
+
+	// 05. remove the snapshots out of the retention
+	if err := pruneSnapshots(
+		ctx,
+		db,
+		docInfo,
+		policy.RetentionCount,
+		be.Config.ParseSnapshotRetentionPeriod(),
+	); err != nil {
 		return err
 	}
 
@@ -92,3 +152,189 @@ func storeSnapshot(
 	)
 	return nil
 }
+
+// newDocumentFromSnapshot returns a new document materialized from the given
+// snapshot, whose operations applied afterwards are observed by the metrics.
+func newDocumentFromSnapshot(
+	be *backend.Backend,
+	docInfo *database.DocInfo,
+	snapshotInfo *database.SnapshotInfo,
+) (*document.InternalDocument, error) {
+	doc, err := materializeSnapshot(be, docInfo, snapshotInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	doc.SetOperationObserver(func(op operations.Operation, elapsed gotime.Duration) {
+		be.Metrics.ObserveDocumentOperationApplySeconds(operationTypeLabel(op), elapsed.Seconds())
+	}, be.Config.OperationSampleInterval)
+	return doc, nil
+}
+
+// materializeSnapshot returns a new document materialized from the given
+// snapshot. If the snapshot cache is enabled, the root materialized from the
+// snapshot is cached and the document is given a copy of it.
+func materializeSnapshot(
+	be *backend.Backend,
+	docInfo *database.DocInfo,
+	snapshotInfo *database.SnapshotInfo,
+) (*document.InternalDocument, error) {
+	// NOTE: The document without a snapshot is built from the empty root,
+	// which is not worth caching.
+	if be.SnapshotCache == nil || snapshotInfo.ServerSeq == 0 {
+		return document.NewInternalDocumentFromSnapshot(
+			docInfo.Key,
+			snapshotInfo.ServerSeq,
+			snapshotInfo.Lamport,
+			snapshotInfo.Snapshot,
+		)
+	}
+
+	cacheKey := backend.SnapshotCacheKey{DocID: docInfo.ID, ServerSeq: snapshotInfo.ServerSeq}
+	root, ok := be.SnapshotCache.Get(cacheKey)
+	be.Metrics.AddBackendSnapshotCache(ok)
+	if !ok {
+		obj, err := converter.BytesToObject(snapshotInfo.Snapshot)
+		if err != nil {
+			return nil, err
+		}
+		root = json.NewRoot(obj)
+		be.SnapshotCache.Add(cacheKey, root, int64(len(snapshotInfo.Snapshot)))
+	}
+
+	// NOTE: The cached root must not be modified, since it is shared by the
+	// documents materialized from the same snapshot.
+	return document.NewInternalDocumentFromRoot(
+		docInfo.Key,
+		snapshotInfo.ServerSeq,
+		snapshotInfo.Lamport,
+		root.DeepCopy(),
+	), nil
+}
+
+// invalidateSnapshotCache removes the cached roots of the given document after
+// a newer snapshot of it is written.
+func invalidateSnapshotCache(be *backend.Backend, docID types.ID) {
+	if be.SnapshotCache == nil {
+		return
+	}
+
+	be.SnapshotCache.RemoveFunc(func(key backend.SnapshotCacheKey) bool {
+		return key.DocID == docID
+	})
+}
+
+// hasCollectableGarbage returns whether the given snapshot holds tombstones
+// over the threshold which can be collected with the given min synced ticket.
+// The elements in the snapshot are removed before the Lamport timestamp of the
+// snapshot, so they can be collected once all the clients have synced the
+// changes at or after it.
+func hasCollectableGarbage(
+	conf *backend.Config,
+	snapshotInfo *database.SnapshotInfo,
+	minSyncedTicket *time.Ticket,
+) bool {
+	if conf.TombstoneThreshold == 0 || minSyncedTicket == nil {
+		return false
+	}
+
+	return uint64(snapshotInfo.GarbageLen) >= conf.TombstoneThreshold &&
+		minSyncedTicket.Lamport() >= snapshotInfo.Lamport
+}
+
+// createSnapshotInfo writes the snapshot of the given document compressed with
+// the given compression, retrying with exponential backoff on failure.
+//
+// NOTE: A snapshot is written atomically, so a failed write leaves no partial
+// snapshot, and the document is built from the previous snapshot and the
+// changes after it until a snapshot is written.
+func createSnapshotInfo(
+	ctx context.Context,
+	be *backend.Backend,
+	db database.Database,
+	docInfo *database.DocInfo,
+	doc *document.InternalDocument,
+	compression types.SnapshotCompression,
+) error {
+	var retries uint64
+	for {
+		err := db.CreateSnapshotInfo(ctx, docInfo.ID, doc, compression)
+		if err == nil {
+			invalidateSnapshotCache(be, docInfo.ID)
+			return nil
+		}
+		be.Metrics.AddPushPullSnapshotWriteFailures()
+
+		if retries >= be.Config.SnapshotWriteMaxRetries {
+			return fmt.Errorf("write snapshot of %s after %d retries: %w", docInfo.Key, retries, err)
+		}
+		logging.From(ctx).Warnf(
+			"SNAP: fail to write '%s' at %d, retries: %d: %v",
+			docInfo.Key,
+			doc.Checkpoint().ServerSeq,
+			retries,
+			err,
+		)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-gotime.After(waitInterval(retries, be.Config.ParseSnapshotWriteMaxWaitInterval())):
+		}
+		retries++
+	}
+}
+
+// waitInterval returns the interval of given retries. (2^retries * 100) milliseconds.
+func waitInterval(retries uint64, maxWaitInterval gotime.Duration) gotime.Duration {
+	interval := gotime.Duration(math.Pow(2, float64(retries))) * 100 * gotime.Millisecond
+	if maxWaitInterval < interval {
+		return maxWaitInterval
+	}
+
+	return interval
+}
+
+// pruneSnapshots removes the snapshots of the given document which are out of
+// both the given retention count and period. The latest snapshot and
+// the snapshot at the compacted server sequence are always retained.
+//
+// NOTE: Removing snapshots does not affect building documents at any server
+// sequence, because the changes after the compacted server sequence are never
+// removed and the documents are built from the closest snapshot retained.
+func pruneSnapshots(
+	ctx context.Context,
+	db database.Database,
+	docInfo *database.DocInfo,
+	count uint64,
+	period gotime.Duration,
+) error {
+	if count == 0 && period == 0 {
+		return nil
+	}
+
+	infos, err := db.FindSnapshotInfos(ctx, docInfo.ID)
+	if err != nil {
+		return err
+	}
+
+	retainedAfter := gotime.Now().Add(-period)
+	var removedIDs []types.ID
+	for i, info := range infos {
+		if i == 0 || (count > 0 && uint64(i) < count) {
+			continue
+		}
+		if docInfo.CompactedServerSeq > 0 && info.ServerSeq == docInfo.CompactedServerSeq {
+			continue
+		}
+		if period > 0 && info.CreatedAt.After(retainedAfter) {
+			continue
+		}
+		removedIDs = append(removedIDs, info.ID)
+	}
+	if len(removedIDs) == 0 {
+		return nil
+	}
+
+	return db.RemoveSnapshotInfos(ctx, docInfo.ID, removedIDs)
+}
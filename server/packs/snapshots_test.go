@@ -0,0 +1,227 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/cache"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/database/memory"
+	"github.com/yorkie-team/yorkie/server/logging"
+	"github.com/yorkie-team/yorkie/server/packs"
+	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
+)
+
+var errWriteFailed = errors.New("write failed")
+
+// faultyDB is a database whose snapshot writes fail a given number of times.
+type faultyDB struct {
+	database.Database
+
+	// failures is the number of the next writes to fail.
+	failures int
+
+	// writeBeforeFailure is whether a failed write stores the snapshot
+	// before failing, like a write whose acknowledgement is lost.
+	writeBeforeFailure bool
+
+	attempts int
+}
+
+func (d *faultyDB) CreateSnapshotInfo(
+	ctx context.Context,
+	docID types.ID,
+	doc *document.InternalDocument,
+	compression types.SnapshotCompression,
+) error {
+	d.attempts++
+	if d.failures == 0 {
+		return d.Database.CreateSnapshotInfo(ctx, docID, doc, compression)
+	}
+
+	d.failures--
+	if d.writeBeforeFailure {
+		if err := d.Database.CreateSnapshotInfo(ctx, docID, doc, compression); err != nil {
+			return err
+		}
+	}
+	return errWriteFailed
+}
+
+func TestStoreSnapshot(t *testing.T) {
+	ctx := logging.With(context.Background(), logging.New(t.Name()))
+	metrics, err := prometheus.NewMetrics()
+	assert.NoError(t, err)
+
+	// setup creates a backend with the given database and a document which
+	// has changes but no snapshot.
+	setup := func(t *testing.T, db *faultyDB) (*backend.Backend, *types.Project, *database.DocInfo) {
+		idGenerator, err := database.NewIDGenerator(database.ObjectIDGeneratorName, nil)
+		assert.NoError(t, err)
+		memDB, err := memory.New(idGenerator)
+		assert.NoError(t, err)
+		db.Database = memDB
+
+		projectInfo, err := memDB.EnsureDefaultProjectInfo(ctx)
+		assert.NoError(t, err)
+		clientInfo, err := memDB.ActivateClient(ctx, projectInfo.ID, t.Name())
+		assert.NoError(t, err)
+		docInfo, err := memDB.FindDocInfoByKeyAndOwner(ctx, projectInfo.ID, clientInfo.ID, key.Key(t.Name()), true)
+		assert.NoError(t, err)
+
+		actorID, err := time.ActorIDFromHex(clientInfo.ID.String())
+		assert.NoError(t, err)
+		doc := document.New(docInfo.Key)
+		doc.SetActor(actorID)
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger("k", i)
+				return nil
+			}))
+		}
+		pack := doc.CreateChangePack()
+		for _, c := range pack.Changes {
+			c.SetServerSeq(docInfo.IncreaseServerSeq())
+		}
+		assert.NoError(t, memDB.CreateChangeInfos(ctx, projectInfo.ID, docInfo, 0, pack.Changes))
+
+		return &backend.Backend{
+			Config: &backend.Config{
+				SnapshotRetentionPeriod:      "0s",
+				SnapshotWriteMaxRetries:      2,
+				SnapshotWriteMaxWaitInterval: "1ms",
+			},
+			DB:      db,
+			Metrics: metrics,
+		}, projectInfo.ToProject(), docInfo
+	}
+
+	t.Run("retry failed write test", func(t *testing.T) {
+		db := &faultyDB{failures: 2}
+		be, project, docInfo := setup(t, db)
+
+		assert.NoError(t, packs.StoreSnapshotAtHead(ctx, be, project, docInfo))
+		assert.Equal(t, 3, db.attempts)
+
+		infos, err := db.FindSnapshotInfos(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 1)
+		assert.Equal(t, docInfo.ServerSeq, infos[0].ServerSeq)
+	})
+
+	t.Run("retry write with unknown result test", func(t *testing.T) {
+		db := &faultyDB{failures: 1, writeBeforeFailure: true}
+		be, project, docInfo := setup(t, db)
+
+		// the retry replaces the snapshot written by the failed write.
+		assert.NoError(t, packs.StoreSnapshotAtHead(ctx, be, project, docInfo))
+		assert.Equal(t, 2, db.attempts)
+
+		infos, err := db.FindSnapshotInfos(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 1)
+		assert.Equal(t, docInfo.ServerSeq, infos[0].ServerSeq)
+	})
+
+	t.Run("serve from previous snapshot after failure test", func(t *testing.T) {
+		db := &faultyDB{failures: 3}
+		be, project, docInfo := setup(t, db)
+
+		err := packs.StoreSnapshotAtHead(ctx, be, project, docInfo)
+		assert.ErrorIs(t, err, errWriteFailed)
+		assert.Equal(t, 3, db.attempts)
+
+		infos, err := db.FindSnapshotInfos(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, infos)
+
+		// the document is built from the changes without the snapshot.
+		doc, err := packs.BuildDocumentForServerSeq(ctx, be, project, docInfo, docInfo.ServerSeq)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k":2}`, doc.Marshal())
+	})
+
+	t.Run("cache materialized snapshot test", func(t *testing.T) {
+		db := &faultyDB{}
+		be, project, docInfo := setup(t, db)
+		be.SnapshotCache, err = cache.NewLRUSizeCache[backend.SnapshotCacheKey, *json.Root](1024)
+		assert.NoError(t, err)
+		assert.NoError(t, packs.StoreSnapshotAtHead(ctx, be, project, docInfo))
+
+		// 01. The root materialized from the snapshot is cached, and each
+		// document is given a copy of it.
+		doc1, err := packs.BuildDocumentForServerSeq(ctx, be, project, docInfo, docInfo.ServerSeq)
+		assert.NoError(t, err)
+		assert.Positive(t, be.SnapshotCache.Size())
+		doc2, err := packs.BuildDocumentForServerSeq(ctx, be, project, docInfo, docInfo.ServerSeq)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k":2}`, doc2.Marshal())
+		assert.NotSame(t, doc1.Root(), doc2.Root())
+
+		// 02. The cached roots of the document are invalidated when a newer
+		// snapshot is written.
+		clientInfo, err := db.ActivateClient(ctx, project.ID, "other")
+		assert.NoError(t, err)
+		doc := document.New(docInfo.Key)
+		actorID, err := time.ActorIDFromHex(clientInfo.ID.String())
+		assert.NoError(t, err)
+		doc.SetActor(actorID)
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger("k2", 3)
+			return nil
+		}))
+		pack := doc.CreateChangePack()
+		initialServerSeq := docInfo.ServerSeq
+		pack.Changes[0].SetServerSeq(docInfo.IncreaseServerSeq())
+		assert.NoError(t, db.CreateChangeInfos(ctx, project.ID, docInfo, initialServerSeq, pack.Changes))
+		assert.NoError(t, packs.StoreSnapshotAtHead(ctx, be, project, docInfo))
+		assert.Zero(t, be.SnapshotCache.Size())
+	})
+
+	t.Run("compress snapshot by project policy test", func(t *testing.T) {
+		db := &faultyDB{}
+		be, project, docInfo := setup(t, db)
+		project.SnapshotPolicy = types.SnapshotPolicy{
+			Threshold:      10,
+			Compression:    string(types.SnapshotCompressionGzip),
+			RetentionCount: 1,
+		}
+		assert.NoError(t, packs.StoreSnapshotAtHead(ctx, be, project, docInfo))
+
+		// 01. The snapshot is stored compressed with gzip.
+		info, err := db.FindClosestSnapshotInfo(ctx, docInfo.ID, docInfo.ServerSeq)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{0x1f, 0x8b}, info.Snapshot[:2])
+
+		// 02. The document is built from the compressed snapshot.
+		doc, err := packs.BuildDocumentForServerSeq(ctx, be, project, docInfo, docInfo.ServerSeq)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k":2}`, doc.Marshal())
+	})
+}
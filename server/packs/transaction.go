@@ -0,0 +1,199 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/sync"
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+// MaxTransactionDocuments is the maximum number of the documents updated by a
+// transaction.
+const MaxTransactionDocuments = 16
+
+// ErrInvalidTransaction is returned when the change packs of a transaction
+// can not be pushed atomically.
+var ErrInvalidTransaction = errors.New("invalid transaction")
+
+// LockTransaction locks the documents of the given change packs of a
+// transaction and returns the function to unlock them.
+//
+// NOTE: The documents are locked in the order of their keys, so that the
+// transactions updating the same documents can not wait for each other.
+func LockTransaction(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	reqPacks []*change.Pack,
+) (func(), error) {
+	if len(reqPacks) == 0 || len(reqPacks) > MaxTransactionDocuments {
+		return nil, fmt.Errorf(
+			"%d documents not in [1, %d]: %w",
+			len(reqPacks),
+			MaxTransactionDocuments,
+			ErrInvalidTransaction,
+		)
+	}
+
+	keys := make([]key.Key, 0, len(reqPacks))
+	for _, pack := range reqPacks {
+		keys = append(keys, pack.DocumentKey)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+	for i := 1; i < len(keys); i++ {
+		if keys[i] == keys[i-1] {
+			return nil, fmt.Errorf("duplicate document %s: %w", keys[i], ErrInvalidTransaction)
+		}
+	}
+
+	var lockers []sync.Locker
+	unlock := func() {
+		for i := len(lockers) - 1; i >= 0; i-- {
+			if err := lockers[i].Unlock(ctx); err != nil {
+				logging.From(ctx).Error(err)
+			}
+		}
+	}
+	for _, k := range keys {
+		locker, err := be.Coordinator.NewLocker(ctx, PushPullKey(project.ID, k))
+		if err != nil {
+			unlock()
+			return nil, err
+		}
+		if err := locker.Lock(ctx); err != nil {
+			unlock()
+			return nil, err
+		}
+		lockers = append(lockers, locker)
+	}
+
+	return unlock, nil
+}
+
+// TransactDocuments pushes the given change packs to their documents
+// atomically and returns the accumulated changes of the documents in the given
+// order. The documents must be locked with LockTransaction.
+//
+// All the packs are validated as ValidateChange does and prepared before any
+// of them is stored. The changes of the packs are then stored together with
+// CreateChangeInfosOfDocuments, and the checkpoints are updated and the events
+// are published only after that, so a failed transaction leaves nothing
+// behind. The documents of a transaction must belong to the same database.
+func TransactDocuments(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	clientInfo *database.ClientInfo,
+	docInfos []*database.DocInfo,
+	reqPacks []*change.Pack,
+) ([]*ServerPack, error) {
+	for i, pack := range reqPacks {
+		if err := validateTransactionPack(ctx, be, project, clientInfo, docInfos[i], pack); err != nil {
+			var invalidChangePackError *InvalidChangePackError
+			if errors.As(err, &invalidChangePackError) {
+				for _, v := range invalidChangePackError.Violations {
+					v.Field = fmt.Sprintf("change_packs[%d].%s", i, v.Field)
+				}
+				return nil, invalidChangePackError
+			}
+			return nil, fmt.Errorf("%s: %w", pack.DocumentKey, err)
+		}
+	}
+
+	db := be.DocDB(project, reqPacks[0].DocumentKey)
+	for _, pack := range reqPacks[1:] {
+		if be.DocDB(project, pack.DocumentKey) != db {
+			return nil, fmt.Errorf("%s: mixed ephemeral documents: %w", pack.DocumentKey, ErrInvalidTransaction)
+		}
+	}
+
+	start := gotime.Now()
+	prepared := make([]*preparedPushPull, 0, len(reqPacks))
+	var batches []*database.ChangeInfoBatch
+	for i, pack := range reqPacks {
+		p, err := preparePushPull(ctx, be, project, clientInfo, docInfos[i], pack, start)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", pack.DocumentKey, err)
+		}
+		prepared = append(prepared, p)
+
+		if len(p.pushedChanges) > 0 {
+			batches = append(batches, &database.ChangeInfoBatch{
+				DocInfo:          docInfos[i],
+				InitialServerSeq: p.initialServerSeq,
+				Changes:          p.pushedChanges,
+			})
+		}
+	}
+
+	if len(batches) > 0 {
+		if err := db.CreateChangeInfosOfDocuments(ctx, project.ID, batches); err != nil {
+			return nil, err
+		}
+	}
+
+	pulled := make([]*ServerPack, 0, len(reqPacks))
+	for i, pack := range reqPacks {
+		respPack, err := completePushPull(ctx, be, project, clientInfo, docInfos[i], pack, prepared[i])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", pack.DocumentKey, err)
+		}
+		pulled = append(pulled, respPack)
+	}
+
+	return pulled, nil
+}
+
+// validateTransactionPack validates the given pack of a transaction.
+func validateTransactionPack(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	clientInfo *database.ClientInfo,
+	docInfo *database.DocInfo,
+	reqPack *change.Pack,
+) error {
+	// NOTE: The changes after the limit of operations would be deferred to
+	// the following requests, which would split the transaction.
+	if maxOps := be.Config.MaxOperationsPerPush; maxOps > 0 {
+		cp := clientInfo.Checkpoint(docInfo.ID)
+		var ops uint64
+		for _, cn := range reqPack.Changes {
+			if cn.ID().ClientSeq() > cp.ClientSeq {
+				ops += uint64(len(cn.Operations()))
+			}
+		}
+		if ops > maxOps {
+			return fmt.Errorf("%d operations exceed %d: %w", ops, maxOps, ErrInvalidTransaction)
+		}
+	}
+
+	return ValidateChange(ctx, be, project, clientInfo, docInfo, reqPack)
+}
@@ -0,0 +1,202 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package packs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	gojson "encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+// validateBatchSize is the number of changes read at once while replaying
+// the change log of a document.
+const validateBatchSize = 1000
+
+// ValidateDocument replays the full change log of the given document from the
+// empty document and compares the result with the latest snapshot.
+//
+// NOTE: The snapshot and the changes before it are never modified, so the
+// validation does not hold the lock of the document and pushes are not
+// blocked while replaying.
+func ValidateDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+) (*types.DocumentValidation, error) {
+	if docInfo.CompactedServerSeq > 0 {
+		return nil, fmt.Errorf("%s: %w", docInfo.Key, ErrChangesCompacted)
+	}
+
+	db := be.DocDB(project, docInfo.Key)
+	snapshotInfo, err := db.FindClosestSnapshotInfo(ctx, docInfo.ID, docInfo.ServerSeq)
+	if err != nil {
+		return nil, err
+	}
+	if snapshotInfo.ServerSeq == 0 {
+		return nil, fmt.Errorf("%s: %w", docInfo.Key, ErrSnapshotNotRetained)
+	}
+
+	expected, err := document.NewInternalDocumentFromSnapshot(
+		docInfo.Key,
+		snapshotInfo.ServerSeq,
+		snapshotInfo.Lamport,
+		snapshotInfo.Snapshot,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	actual := document.NewInternalDocument(docInfo.Key)
+	actual.SetObjectMergePolicy(json.MergePolicy(project.ObjectMergePolicy))
+	for from := uint64(1); from <= snapshotInfo.ServerSeq; from += validateBatchSize {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		to := from + validateBatchSize - 1
+		if to > snapshotInfo.ServerSeq {
+			to = snapshotInfo.ServerSeq
+		}
+
+		changes, err := db.FindChangesBetweenServerSeqs(ctx, docInfo.ID, from, to)
+		if err != nil {
+			return nil, err
+		}
+		if err := actual.ApplyChanges(changes...); err != nil {
+			return nil, fmt.Errorf("replay changes %d-%d: %w", from, to, err)
+		}
+	}
+
+	expectedJSON, actualJSON := expected.Marshal(), actual.Marshal()
+	validation := &types.DocumentValidation{
+		ServerSeq:        snapshotInfo.ServerSeq,
+		ExpectedChecksum: Checksum(expectedJSON),
+		ActualChecksum:   Checksum(actualJSON),
+	}
+	validation.Valid = validation.ExpectedChecksum == validation.ActualChecksum
+	if !validation.Valid {
+		validation.DivergingPath = DivergingPath(expectedJSON, actualJSON)
+		logging.From(ctx).Warnf(
+			"VALIDATE: '%s' diverges from the snapshot of %d at '%s'",
+			docInfo.Key,
+			snapshotInfo.ServerSeq,
+			validation.DivergingPath,
+		)
+	}
+
+	return validation, nil
+}
+
+// Checksum returns the SHA-256 checksum of the given JSON of a document.
+func Checksum(docJSON string) string {
+	sum := sha256.Sum256([]byte(docJSON))
+	return hex.EncodeToString(sum[:])
+}
+
+// DivergingPath returns the JSON path of the first element where the given
+// JSONs of documents diverge. It returns an empty string if they are equal.
+func DivergingPath(expected, actual string) string {
+	e, err := decodeJSON(expected)
+	if err != nil {
+		return "$"
+	}
+	a, err := decodeJSON(actual)
+	if err != nil {
+		return "$"
+	}
+
+	path, _ := divergingPath("$", e, a)
+	return path
+}
+
+// decodeJSON decodes the given JSON keeping numbers as gojson.Number, so that
+// long values which differ beyond the precision of float64 are not treated as
+// equal.
+func decodeJSON(content string) (interface{}, error) {
+	decoder := gojson.NewDecoder(strings.NewReader(content))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// divergingPath walks the given values in the order of keys and returns the
+// path of the first difference.
+func divergingPath(path string, expected, actual interface{}) (string, bool) {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return path, true
+		}
+
+		keys := make([]string, 0, len(e)+len(a))
+		for k := range e {
+			keys = append(keys, k)
+		}
+		for k := range a {
+			if _, ok := e[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			if p, diverged := divergingPath(path+"."+k, e[k], a[k]); diverged {
+				return p, true
+			}
+		}
+		return "", false
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok {
+			return path, true
+		}
+
+		for i := 0; i < len(e) || i < len(a); i++ {
+			if i >= len(e) || i >= len(a) {
+				return path + "." + strconv.Itoa(i), true
+			}
+			if p, diverged := divergingPath(path+"."+strconv.Itoa(i), e[i], a[i]); diverged {
+				return p, true
+			}
+		}
+		return "", false
+	default:
+		if !reflect.DeepEqual(expected, actual) {
+			return path, true
+		}
+		return "", false
+	}
+}
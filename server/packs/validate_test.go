@@ -0,0 +1,55 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package packs_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/server/packs"
+)
+
+func TestDivergingPath(t *testing.T) {
+	t.Run("equal documents test", func(t *testing.T) {
+		doc := `{"a":{"b":[1,2]},"c":"d"}`
+		assert.Equal(t, "", packs.DivergingPath(doc, doc))
+		assert.Equal(t, packs.Checksum(doc), packs.Checksum(doc))
+	})
+
+	t.Run("diverged documents test", func(t *testing.T) {
+		assert.Equal(t, "$.a.b.1", packs.DivergingPath(
+			`{"a":{"b":[1,2]},"c":"d"}`,
+			`{"a":{"b":[1,3]},"c":"e"}`,
+		))
+		assert.Equal(t, "$.a.b.2", packs.DivergingPath(
+			`{"a":{"b":[1,2]}}`,
+			`{"a":{"b":[1,2,3]}}`,
+		))
+		assert.Equal(t, "$.b", packs.DivergingPath(`{"a":1}`, `{"a":1,"b":2}`))
+		assert.Equal(t, "$.a", packs.DivergingPath(`{"a":{}}`, `{"a":[]}`))
+		assert.Equal(t, "$", packs.DivergingPath(`{"a":1}`, `invalid`))
+	})
+
+	t.Run("diverged long values test", func(t *testing.T) {
+		// NOTE: 2^53+1 and 2^53 are equal when they are decoded as float64.
+		assert.Equal(t, "$.a", packs.DivergingPath(
+			`{"a":9007199254740993}`,
+			`{"a":9007199254740992}`,
+		))
+	})
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+)
+
+// FindVersionVector returns the largest Lamport timestamps of the changes of
+// each actor of the given document. It is computed from the version vector of
+// the latest snapshot and the changes after the snapshot.
+func FindVersionVector(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+) (time.VersionVector, error) {
+	db := be.DocDB(project, docInfo.Key)
+	snapshotInfo, err := db.FindClosestSnapshotInfo(ctx, docInfo.ID, docInfo.ServerSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	vector, err := snapshotVersionVector(ctx, db, docInfo.ID, snapshotInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := syncVersionVector(
+		ctx,
+		db,
+		docInfo.ID,
+		vector,
+		snapshotInfo.ServerSeq+1,
+		docInfo.ServerSeq,
+	); err != nil {
+		return nil, err
+	}
+
+	return vector, nil
+}
+
+// snapshotVersionVector returns the version vector of the given snapshot.
+func snapshotVersionVector(
+	ctx context.Context,
+	db database.Database,
+	docID types.ID,
+	snapshotInfo *database.SnapshotInfo,
+) (time.VersionVector, error) {
+	if len(snapshotInfo.VersionVector) > 0 {
+		return converter.BytesToVersionVector(snapshotInfo.VersionVector)
+	}
+
+	// NOTE: The snapshots created before the version vector is recorded have
+	// no version vector, so it is computed from the changes before them.
+	vector := time.NewVersionVector()
+	if snapshotInfo.ServerSeq == 0 {
+		return vector, nil
+	}
+	if err := syncVersionVector(ctx, db, docID, vector, 1, snapshotInfo.ServerSeq); err != nil {
+		return nil, err
+	}
+
+	return vector, nil
+}
+
+// syncVersionVector records the Lamport timestamps of the changes between the
+// given server sequences to the given version vector.
+func syncVersionVector(
+	ctx context.Context,
+	db database.Database,
+	docID types.ID,
+	vector time.VersionVector,
+	from uint64,
+	to uint64,
+) error {
+	if from > to {
+		return nil
+	}
+
+	infos, err := db.FindChangeInfosBetweenServerSeqs(ctx, docID, from, to)
+	if err != nil {
+		return err
+	}
+
+	for _, info := range infos {
+		actorID, err := info.ActorID.ToActorID()
+		if err != nil {
+			return err
+		}
+		vector.Set(actorID, info.Lamport)
+	}
+
+	return nil
+}
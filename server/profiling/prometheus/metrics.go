@@ -17,6 +17,8 @@
 package prometheus
 
 import (
+	"time"
+
 	grpcprometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
@@ -37,13 +39,33 @@ type Metrics struct {
 
 	serverVersion *prometheus.GaugeVec
 
-	pushPullResponseSeconds         prometheus.Histogram
-	pushPullReceivedChangesTotal    prometheus.Counter
-	pushPullSentChangesTotal        prometheus.Counter
-	pushPullReceivedOperationsTotal prometheus.Counter
-	pushPullSentOperationsTotal     prometheus.Counter
-	pushPullSnapshotDurationSeconds prometheus.Histogram
-	pushPullSnapshotBytesTotal      prometheus.Counter
+	rpcActiveStreams prometheus.Gauge
+
+	pushPullResponseSeconds            prometheus.Histogram
+	pushPullReceivedChangesTotal       prometheus.Counter
+	pushPullSentChangesTotal           prometheus.Counter
+	pushPullDuplicateChangesTotal      prometheus.Counter
+	pushPullDuplicateOperationsTotal   prometheus.Counter
+	pushPullReceivedOperationsTotal    prometheus.Counter
+	pushPullSquashedOperationsTotal    prometheus.Counter
+	pushPullSquashRatio                prometheus.Histogram
+	pushPullSentOperationsTotal        prometheus.Counter
+	pushPullSnapshotDurationSeconds    prometheus.Histogram
+	pushPullSnapshotBytesTotal         prometheus.Counter
+	pushPullSnapshotWriteFailuresTotal prometheus.Counter
+	pushPullGCBlockedTotal             prometheus.Counter
+	pushPullApplyLagSeconds            prometheus.Histogram
+	pushPullHotDocumentsTotal          prometheus.Counter
+
+	documentOperationApplySeconds *prometheus.HistogramVec
+
+	eventWebhookDeadLettersTotal *prometheus.CounterVec
+
+	backendQueryTimeoutsTotal       *prometheus.CounterVec
+	backendDBReconnectAttemptsTotal *prometheus.CounterVec
+	backendSnapshotCacheTotal       *prometheus.CounterVec
+	backendDBConnected              prometheus.Gauge
+	backendSnapshotsUpgradedPercent prometheus.Gauge
 }
 
 // NewMetrics creates a new instance of Metrics.
@@ -70,6 +92,12 @@ func NewMetrics() (*Metrics, error) {
 			Name:      "version",
 			Help:      "Which version is running. 1 for 'server_version' label with current version.",
 		}, []string{"server_version"}),
+		rpcActiveStreams: promauto.With(reg).NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "rpc",
+			Name:      "active_streams",
+			Help:      "The number of active streams of RPC.",
+		}),
 		pushPullResponseSeconds: promauto.With(reg).NewHistogram(prometheus.HistogramOpts{
 			Namespace: namespace,
 			Subsystem: "pushpull",
@@ -88,6 +116,18 @@ func NewMetrics() (*Metrics, error) {
 			Name:      "sent_changes_total",
 			Help:      "The total count of changes included in response packs in PushPull.",
 		}),
+		pushPullDuplicateChangesTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "duplicate_changes_total",
+			Help:      "The total count of already pushed changes skipped in PushPull.",
+		}),
+		pushPullDuplicateOperationsTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "duplicate_operations_total",
+			Help:      "The total count of operations rejected in PushPull because their IDs were already pushed.",
+		}),
 		pushPullReceivedOperationsTotal: promauto.With(reg).NewCounter(
 			prometheus.CounterOpts{
 				Namespace: namespace,
@@ -96,6 +136,20 @@ func NewMetrics() (*Metrics, error) {
 				Help: "The total count of operations included in request" +
 					" packs in PushPull.",
 			}),
+		pushPullSquashedOperationsTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "squashed_operations_total",
+			Help:      "The total count of operations removed by squashing pushed changes in PushPull.",
+		}),
+		pushPullSquashRatio: promauto.With(reg).NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "squash_ratio",
+			Help: "The ratio of operations removed by squashing to the operations" +
+				" of pushed changes in PushPull.",
+			Buckets: prometheus.LinearBuckets(0, 0.1, 11),
+		}),
 		pushPullSentOperationsTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: "pushpull",
@@ -115,6 +169,74 @@ func NewMetrics() (*Metrics, error) {
 			Name:      "snapshot_bytes_total",
 			Help:      "The total bytes of snapshots for response packs in PushPull.",
 		}),
+		pushPullSnapshotWriteFailuresTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "snapshot_write_failures_total",
+			Help:      "The total count of failed attempts to write snapshots.",
+		}),
+		pushPullGCBlockedTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "gc_blocked_total",
+			Help:      "The total count of snapshots stored with tombstones over the threshold, as GC is blocked by lagging clients.",
+		}),
+		pushPullApplyLagSeconds: promauto.With(reg).NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "apply_lag_seconds",
+			Help: "The lag between the creation of operations on clients and" +
+				" the reception on the server in PushPull.",
+		}),
+		pushPullHotDocumentsTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "hot_documents_total",
+			Help:      "The total count of documents detected as hot by their change rate in PushPull.",
+		}),
+		documentOperationApplySeconds: promauto.With(reg).NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "document",
+			Name:      "operation_apply_seconds",
+			Help:      "The sampled duration of applying operations to documents on the server by operation type.",
+			Buckets:   prometheus.ExponentialBuckets(0.000001, 4, 12),
+		}, []string{"operation_type"}),
+		eventWebhookDeadLettersTotal: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "webhook",
+			Name:      "event_dead_letters_total",
+			Help:      "The total count of events that failed to be delivered to event webhooks.",
+		}, []string{"event_type"}),
+		backendQueryTimeoutsTotal: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "backend",
+			Name:      "query_timeouts_total",
+			Help:      "The total count of database operations that failed by the query timeout.",
+		}, []string{"operation"}),
+		backendDBReconnectAttemptsTotal: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "backend",
+			Name:      "db_reconnect_attempts_total",
+			Help:      "The total count of the attempts to reconnect to the database.",
+		}, []string{"result"}),
+		backendSnapshotCacheTotal: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "backend",
+			Name:      "snapshot_cache_total",
+			Help:      "The total count of the lookups of materialized snapshots in the cache.",
+		}, []string{"result"}),
+		backendDBConnected: promauto.With(reg).NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "backend",
+			Name:      "db_connected",
+			Help:      "Whether the connection to the database is alive. 1 if it is alive, 0 otherwise.",
+		}),
+		backendSnapshotsUpgradedPercent: promauto.With(reg).NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "backend",
+			Name:      "snapshots_upgraded_percent",
+			Help:      "The percentage of the snapshots encoded in the current format.",
+		}),
 	}
 
 	metrics.serverVersion.With(prometheus.Labels{
@@ -124,6 +246,11 @@ func NewMetrics() (*Metrics, error) {
 	return metrics, nil
 }
 
+// AddRPCActiveStreams adds the given delta to the number of active streams.
+func (m *Metrics) AddRPCActiveStreams(delta int) {
+	m.rpcActiveStreams.Add(float64(delta))
+}
+
 // ObservePushPullResponseSeconds adds an observation for response time of
 // PushPull.
 func (m *Metrics) ObservePushPullResponseSeconds(seconds float64) {
@@ -142,12 +269,33 @@ func (m *Metrics) AddPushPullSentChanges(count int) {
 	m.pushPullSentChangesTotal.Add(float64(count))
 }
 
+// AddPushPullDuplicateChanges adds the number of changes in the request pack
+// of PushPull that were skipped because they had already been pushed.
+func (m *Metrics) AddPushPullDuplicateChanges(count int) {
+	m.pushPullDuplicateChangesTotal.Add(float64(count))
+}
+
+// AddPushPullDuplicateOperations adds the number of operations in the request
+// pack of PushPull that were rejected because their IDs had already been pushed.
+func (m *Metrics) AddPushPullDuplicateOperations(count int) {
+	m.pushPullDuplicateOperationsTotal.Add(float64(count))
+}
+
 // AddPushPullReceivedOperations sets the number of operations
 // included in the request pack of PushPull.
 func (m *Metrics) AddPushPullReceivedOperations(count int) {
 	m.pushPullReceivedOperationsTotal.Add(float64(count))
 }
 
+// AddPushPullSquashedOperations adds the number of operations removed by
+// squashing and observes the ratio of them to the given total.
+func (m *Metrics) AddPushPullSquashedOperations(squashed, total int) {
+	m.pushPullSquashedOperationsTotal.Add(float64(squashed))
+	if total > 0 {
+		m.pushPullSquashRatio.Observe(float64(squashed) / float64(total))
+	}
+}
+
 // AddPushPullSentOperations adds the number of operations
 // included in the response pack of PushPull.
 func (m *Metrics) AddPushPullSentOperations(count int) {
@@ -165,6 +313,121 @@ func (m *Metrics) AddPushPullSnapshotBytes(bytes int) {
 	m.pushPullSnapshotBytesTotal.Add(float64(bytes))
 }
 
+// AddPushPullSnapshotWriteFailures adds one to the number of failed attempts
+// to write snapshots.
+func (m *Metrics) AddPushPullSnapshotWriteFailures() {
+	m.pushPullSnapshotWriteFailuresTotal.Inc()
+}
+
+// AddPushPullGCBlocked adds one to the number of snapshots stored with
+// tombstones over the threshold, as GC is blocked by lagging clients.
+func (m *Metrics) AddPushPullGCBlocked() {
+	m.pushPullGCBlockedTotal.Inc()
+}
+
+// ObservePushPullApplyLagSeconds adds an observation for the lag between the
+// creation of an operation on the client and the reception on the server.
+func (m *Metrics) ObservePushPullApplyLagSeconds(seconds float64) {
+	m.pushPullApplyLagSeconds.Observe(seconds)
+}
+
+// AddPushPullHotDocuments adds one to the number of documents detected as hot.
+func (m *Metrics) AddPushPullHotDocuments() {
+	m.pushPullHotDocumentsTotal.Inc()
+}
+
+// ObserveDocumentOperationApplySeconds observes the duration of applying an
+// operation of the given type to a document.
+func (m *Metrics) ObserveDocumentOperationApplySeconds(operationType string, seconds float64) {
+	m.documentOperationApplySeconds.With(prometheus.Labels{
+		"operation_type": operationType,
+	}).Observe(seconds)
+}
+
+// AddEventWebhookDeadLetters adds one to the number of events of the given
+// type that failed to be delivered.
+func (m *Metrics) AddEventWebhookDeadLetters(eventType string) {
+	m.eventWebhookDeadLettersTotal.With(prometheus.Labels{
+		"event_type": eventType,
+	}).Inc()
+}
+
+// AddBackendQueryTimeouts adds one to the number of the given database
+// operation that failed by the query timeout.
+func (m *Metrics) AddBackendQueryTimeouts(operation string) {
+	m.backendQueryTimeoutsTotal.With(prometheus.Labels{
+		"operation": operation,
+	}).Inc()
+}
+
+// AddBackendDBReconnectAttempts adds one to the number of the attempts to
+// reconnect to the database with the given result.
+func (m *Metrics) AddBackendDBReconnectAttempts(succeeded bool) {
+	result := "failure"
+	if succeeded {
+		result = "success"
+	}
+
+	m.backendDBReconnectAttemptsTotal.With(prometheus.Labels{
+		"result": result,
+	}).Inc()
+}
+
+// AddBackendSnapshotCache adds one to the number of the lookups of
+// materialized snapshots in the cache with the given result.
+func (m *Metrics) AddBackendSnapshotCache(hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+
+	m.backendSnapshotCacheTotal.With(prometheus.Labels{
+		"result": result,
+	}).Inc()
+}
+
+// SetBackendDBConnected sets whether the connection to the database is alive.
+func (m *Metrics) SetBackendDBConnected(connected bool) {
+	if connected {
+		m.backendDBConnected.Set(1)
+		return
+	}
+	m.backendDBConnected.Set(0)
+}
+
+// SetBackendSnapshotsUpgradedPercent sets the percentage of the snapshots
+// encoded in the current format.
+func (m *Metrics) SetBackendSnapshotsUpgradedPercent(percent float64) {
+	m.backendSnapshotsUpgradedPercent.Set(percent)
+}
+
+// RegisterBackgroundQueue registers the depth and the age of the oldest job
+// of the queue of the given type of background jobs, which are measured by
+// the given function whenever the metrics are collected.
+func (m *Metrics) RegisterBackgroundQueue(jobType string, stats func() (int, time.Duration)) {
+	labels := prometheus.Labels{"type": jobType}
+	promauto.With(m.registry).NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace:   namespace,
+		Subsystem:   "background",
+		Name:        "queue_depth",
+		Help:        "The number of the pending jobs in the background.",
+		ConstLabels: labels,
+	}, func() float64 {
+		depth, _ := stats()
+		return float64(depth)
+	})
+	promauto.With(m.registry).NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace:   namespace,
+		Subsystem:   "background",
+		Name:        "queue_oldest_age_seconds",
+		Help:        "The age of the oldest pending job in the background.",
+		ConstLabels: labels,
+	}, func() float64 {
+		_, oldestAge := stats()
+		return oldestAge.Seconds()
+	})
+}
+
 // RegisterGRPCServer registers the given gRPC server.
 func (m *Metrics) RegisterGRPCServer(server *grpc.Server) {
 	m.serverMetrics.InitializeMetrics(server)
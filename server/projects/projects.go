@@ -18,17 +18,69 @@ package projects
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/background"
+	"github.com/yorkie-team/yorkie/server/backend/sync"
+	"github.com/yorkie-team/yorkie/server/logging"
 )
 
-// CreateProject creates a project.
+// MaxProjectIDs is the maximum number of projects to get at once.
+const MaxProjectIDs = 100
+
+// createProjectKey is the key of the lock to serialize the creation of
+// projects while counting them.
+const createProjectKey = sync.Key("projects/createProject")
+
+var (
+	// ErrTooManyProjectIDs is returned when the number of the projects to get
+	// at once exceeds MaxProjectIDs.
+	ErrTooManyProjectIDs = errors.New("too many project IDs")
+
+	// ErrTooManyProjects is returned when the number of the projects of the
+	// deployment reaches MaxProjects of the configuration.
+	ErrTooManyProjects = errors.New("too many projects")
+)
+
+// CreateProject creates a project. It returns ErrTooManyProjects if the
+// deployment already has MaxProjects projects.
 func CreateProject(
 	ctx context.Context,
 	be *backend.Backend,
 	name string,
 ) (*types.Project, error) {
+	if be.Config.MaxProjects > 0 {
+		locker, err := be.Coordinator.NewLocker(ctx, createProjectKey)
+		if err != nil {
+			return nil, err
+		}
+		if err := locker.Lock(ctx); err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err := locker.Unlock(ctx); err != nil {
+				logging.From(ctx).Error(err)
+			}
+		}()
+
+		count, err := be.DB.CountProjectInfos(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if count >= be.Config.MaxProjects {
+			return nil, fmt.Errorf(
+				"%d projects reach %d: %w",
+				count,
+				be.Config.MaxProjects,
+				ErrTooManyProjects,
+			)
+		}
+	}
+
 	info, err := be.DB.CreateProjectInfo(ctx, name)
 	if err != nil {
 		return nil, err
@@ -49,12 +101,40 @@ func ListProjects(
 
 	var projects []*types.Project
 	for _, info := range infos {
-		projects = append(projects, info.ToProject())
+		project := info.ToProject()
+		count, err := countDocuments(ctx, be, project.ID)
+		if err != nil {
+			return nil, err
+		}
+		project.DocumentCount = count
+
+		projects = append(projects, project)
 	}
 
 	return projects, nil
 }
 
+// countDocuments returns the number of documents of the given project. The
+// count is cached for the TTL of the configuration, so it may not reflect the
+// documents created or removed within the TTL.
+func countDocuments(
+	ctx context.Context,
+	be *backend.Backend,
+	projectID types.ID,
+) (int, error) {
+	if count, ok := be.DocumentCountCache.Get(projectID); ok {
+		return count, nil
+	}
+
+	count, err := be.DB.CountDocInfos(ctx, projectID)
+	if err != nil {
+		return 0, err
+	}
+
+	be.DocumentCountCache.Add(projectID, count, be.Config.ParseDocumentCountCacheTTL())
+	return count, nil
+}
+
 // GetProject returns a project by the given name.
 func GetProject(
 	ctx context.Context,
@@ -69,6 +149,89 @@ func GetProject(
 	return info.ToProject(), nil
 }
 
+// GetProjectStats returns the statistics of the given project measured by
+// this server, with the queues of the background jobs of this server.
+func GetProjectStats(
+	be *backend.Backend,
+	project *types.Project,
+) *types.ProjectStats {
+	var queues []*types.BackgroundQueue
+	for _, jobType := range background.JobTypes {
+		stats := be.Background.QueueStats(jobType)
+		queues = append(queues, &types.BackgroundQueue{
+			Type:      string(jobType),
+			Depth:     stats.Depth,
+			OldestAge: stats.OldestAge,
+		})
+	}
+
+	return &types.ProjectStats{
+		ConflictWins:     be.ConflictWins.Wins(project.ID),
+		HotDocuments:     be.HotDocuments.HotDocuments(project.ID),
+		BackgroundQueues: queues,
+	}
+}
+
+// GetLiveProjectStats returns the live statistics of the given project
+// measured by this server. They are aggregated from the counters kept in
+// memory, so it is cheap enough to be called periodically.
+func GetLiveProjectStats(
+	be *backend.Backend,
+	project *types.Project,
+) *types.LiveProjectStats {
+	documents, clients := be.ProjectStats.Counts(project.ID)
+	return &types.LiveProjectStats{
+		ActiveDocuments: documents,
+		AttachedClients: clients,
+		OpsPerSecond:    be.HotDocuments.OpsPerSecond(project.ID),
+		HotDocuments:    len(be.HotDocuments.HotDocuments(project.ID)),
+		ConflictWins:    be.ConflictWins.Wins(project.ID),
+		MeasuredAt:      time.Now(),
+	}
+}
+
+// GetProjects returns the projects of the given IDs in the given order and
+// the IDs of the projects not found.
+func GetProjects(
+	ctx context.Context,
+	be *backend.Backend,
+	ids []types.ID,
+) ([]*types.Project, []types.ID, error) {
+	if len(ids) == 0 {
+		return nil, nil, nil
+	}
+	if len(ids) > MaxProjectIDs {
+		return nil, nil, fmt.Errorf("%d > %d: %w", len(ids), MaxProjectIDs, ErrTooManyProjectIDs)
+	}
+	for _, id := range ids {
+		if err := id.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	infos, err := be.DB.FindProjectInfosByIDs(ctx, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	infoMap := make(map[types.ID]*types.Project)
+	for _, info := range infos {
+		infoMap[info.ID] = info.ToProject()
+	}
+
+	var projects []*types.Project
+	var notFoundIDs []types.ID
+	for _, id := range ids {
+		if project, ok := infoMap[id]; ok {
+			projects = append(projects, project)
+		} else {
+			notFoundIDs = append(notFoundIDs, id)
+		}
+	}
+
+	return projects, notFoundIDs, nil
+}
+
 // GetProjectFromAPIKey returns a project from an API key.
 func GetProjectFromAPIKey(ctx context.Context, be *backend.Backend, apiKey string) (*types.Project, error) {
 	if apiKey == "" {
@@ -87,13 +250,28 @@ func GetProjectFromAPIKey(ctx context.Context, be *backend.Backend, apiKey strin
 	return info.ToProject(), nil
 }
 
-// UpdateProject updates a project.
+// UpdateProject updates a project. If the mask is given, only the fields of
+// the paths in it are updated and the others are left untouched. The project
+// is read and written in a single update of the database, so the fields
+// updated concurrently by others are not clobbered.
 func UpdateProject(
 	ctx context.Context,
 	be *backend.Backend,
 	id types.ID,
 	fields *types.UpdatableProjectFields,
+	mask []string,
 ) (*types.Project, error) {
+	if len(mask) > 0 {
+		masked, err := fields.Mask(mask)
+		if err != nil {
+			return nil, err
+		}
+		fields = masked
+	}
+	if err := fields.Validate(); err != nil {
+		return nil, err
+	}
+
 	info, err := be.DB.UpdateProjectInfo(ctx, id, fields)
 	if err != nil {
 		return nil, err
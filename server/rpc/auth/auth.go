@@ -18,10 +18,13 @@ package auth
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
 	"github.com/yorkie-team/yorkie/server/projects"
 	"github.com/yorkie-team/yorkie/server/rpc/metadata"
 )
@@ -29,18 +32,35 @@ import (
 // AccessAttributes returns an array of AccessAttribute from the given pack.
 func AccessAttributes(pack *change.Pack) []types.AccessAttribute {
 	verb := types.Read
+	operations := []types.OperationKind{types.ReadOperation}
 	if pack.HasChanges() {
 		verb = types.ReadWrite
+		operations = append(operations, types.WriteOperation)
 	}
 
 	// NOTE(hackerwins): In the future, methods such as bulk PushPull can be
 	// added, so we declare it as an array.
 	return []types.AccessAttribute{{
-		Key:  pack.DocumentKey.String(),
-		Verb: verb,
+		Key:        pack.DocumentKey.String(),
+		Verb:       verb,
+		Operations: operations,
 	}}
 }
 
+// PresenceAccessAttributes returns an array of AccessAttribute for updating
+// the presence of the given documents.
+func PresenceAccessAttributes(keys []key.Key) []types.AccessAttribute {
+	var attrs []types.AccessAttribute
+	for _, k := range keys {
+		attrs = append(attrs, types.AccessAttribute{
+			Key:        k.String(),
+			Verb:       types.Read,
+			Operations: []types.OperationKind{types.PresenceOperation},
+		})
+	}
+	return attrs
+}
+
 // VerifyAccess verifies the given access.
 func VerifyAccess(ctx context.Context, be *backend.Backend, accessInfo *types.AccessInfo) error {
 	md := metadata.From(ctx)
@@ -58,3 +78,47 @@ func VerifyAccess(ctx context.Context, be *backend.Backend, accessInfo *types.Ac
 		accessInfo,
 	)
 }
+
+// Reauthorize verifies the access of the given client attaching the given
+// document without the token of the client. It is used to re-evaluate the
+// access after the server changes the document, e.g. transfers its ownership.
+// The webhook is requested without the cache since the previous result may be
+// no longer valid.
+func Reauthorize(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	clientInfo *database.ClientInfo,
+	docInfo *database.DocInfo,
+) error {
+	if !project.RequireAuth(types.ReauthorizeDocument) {
+		return nil
+	}
+
+	attr := types.AccessAttribute{
+		Key:        docInfo.Key.String(),
+		Verb:       types.ReadWrite,
+		Operations: []types.OperationKind{types.ReadOperation, types.WriteOperation},
+	}
+	if clientDocInfo, ok := clientInfo.Documents[docInfo.ID]; ok && clientDocInfo.ReadOnly {
+		attr.Verb = types.Read
+		attr.Operations = []types.OperationKind{types.ReadOperation}
+	}
+	accessInfo := &types.AccessInfo{
+		Method:     types.ReauthorizeDocument,
+		Attributes: []types.AccessAttribute{attr},
+	}
+
+	reqBody, err := json.Marshal(types.AuthWebhookRequest{
+		Method:     accessInfo.Method,
+		Attributes: accessInfo.Attributes,
+		ClientID:   clientInfo.ID.String(),
+		ClientKey:  clientInfo.Key,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = requestWebhook(ctx, be, project.AuthWebhookURL, reqBody, accessInfo)
+	return err
+}
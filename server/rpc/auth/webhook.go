@@ -36,6 +36,10 @@ var (
 	// ErrNotAllowed is returned when the given user is not allowed for the access.
 	ErrNotAllowed = errors.New("method is not allowed for this user")
 
+	// ErrOperationNotAllowed is returned when the given user is not allowed to
+	// write changes to the document, e.g. read-only users.
+	ErrOperationNotAllowed = errors.New("operation is not allowed for this user")
+
 	// ErrUnexpectedStatusCode is returned when the response code is not 200 from the webhook.
 	ErrUnexpectedStatusCode = errors.New("unexpected status code from webhook")
 
@@ -64,11 +68,34 @@ func verifyAccess(
 	if entry, ok := be.AuthWebhookCache.Get(cacheKey); ok {
 		resp := entry
 		if !resp.Allowed {
-			return fmt.Errorf("%s: %w", resp.Reason, ErrNotAllowed)
+			return notAllowedError(accessInfo, resp.Reason)
 		}
 		return nil
 	}
 
+	authResp, err := requestWebhook(ctx, be, authWebhookURL, reqBody, accessInfo)
+	if err != nil {
+		if errors.Is(err, ErrNotAllowed) || errors.Is(err, ErrOperationNotAllowed) {
+			be.AuthWebhookCache.Add(cacheKey, authResp, be.Config.ParseAuthWebhookCacheUnauthTTL())
+		}
+
+		return err
+	}
+
+	be.AuthWebhookCache.Add(cacheKey, authResp, be.Config.ParseAuthWebhookCacheAuthTTL())
+
+	return nil
+}
+
+// requestWebhook sends the given request body to the webhook with retries and
+// returns the response. An error is returned if the access is denied.
+func requestWebhook(
+	ctx context.Context,
+	be *backend.Backend,
+	authWebhookURL string,
+	reqBody []byte,
+	accessInfo *types.AccessInfo,
+) (*types.AuthWebhookResponse, error) {
 	var authResp *types.AuthWebhookResponse
 	if err := withExponentialBackoff(ctx, be.Config, func() (int, error) {
 		resp, err := http.Post(
@@ -96,21 +123,26 @@ func verifyAccess(
 		}
 
 		if !authResp.Allowed {
-			return resp.StatusCode, fmt.Errorf("%s: %w", authResp.Reason, ErrNotAllowed)
+			return resp.StatusCode, notAllowedError(accessInfo, authResp.Reason)
 		}
 
 		return resp.StatusCode, nil
 	}); err != nil {
-		if errors.Is(err, ErrNotAllowed) {
-			be.AuthWebhookCache.Add(cacheKey, authResp, be.Config.ParseAuthWebhookCacheUnauthTTL())
-		}
-
-		return err
+		return authResp, err
 	}
 
-	be.AuthWebhookCache.Add(cacheKey, authResp, be.Config.ParseAuthWebhookCacheAuthTTL())
+	return authResp, nil
+}
 
-	return nil
+// notAllowedError returns the error for the denied access. If the access
+// requests to write changes, the denial is regarded as the lack of permission
+// rather than the failure of authentication.
+func notAllowedError(accessInfo *types.AccessInfo, reason string) error {
+	if accessInfo.HasOperation(types.WriteOperation) {
+		return fmt.Errorf("%s: %w", reason, ErrOperationNotAllowed)
+	}
+
+	return fmt.Errorf("%s: %w", reason, ErrNotAllowed)
 }
 
 func withExponentialBackoff(ctx context.Context, cfg *backend.Config, webhookFn func() (int, error)) error {
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"time"
 )
 
 var (
@@ -29,6 +30,11 @@ var (
 	ErrInvalidCertFile = errors.New("invalid cert file for RPC server")
 	// ErrInvalidKeyFile occurs when the key file is invalid.
 	ErrInvalidKeyFile = errors.New("invalid key file for RPC server")
+	// ErrInvalidMaxChangePackBytes occurs when the max change pack size is
+	// larger than the max request size.
+	ErrInvalidMaxChangePackBytes = errors.New("invalid max change pack bytes for RPC server")
+	// ErrInvalidShutdownTimeout occurs when the shutdown timeout is invalid.
+	ErrInvalidShutdownTimeout = errors.New("invalid shutdown timeout for RPC server")
 )
 
 // Config is the configuration for creating a Server instance.
@@ -44,6 +50,24 @@ type Config struct {
 
 	// MaxRequestBytes is the maximum client request size in bytes the server will accept.
 	MaxRequestBytes uint64 `yaml:"MaxRequestBytes"`
+
+	// MaxChangePackBytes is the maximum size in bytes of a change pack sent
+	// with PushPull. Larger change packs should be sent with PushChangesStream.
+	// Zero means unlimited.
+	MaxChangePackBytes uint64 `yaml:"MaxChangePackBytes"`
+
+	// MaxStreamedChangePackBytes is the maximum size in bytes of a change pack
+	// sent with PushChangesStream. Zero means unlimited.
+	MaxStreamedChangePackBytes uint64 `yaml:"MaxStreamedChangePackBytes"`
+
+	// MaxConcurrentStreamsPerConn is the maximum number of concurrent streams
+	// such as Watch on a single connection. Zero means unlimited.
+	MaxConcurrentStreamsPerConn uint64 `yaml:"MaxConcurrentStreamsPerConn"`
+
+	// ShutdownTimeout is the maximum time to wait for in-flight requests and
+	// streams to finish on graceful shutdown. The connections left after it
+	// are closed forcibly. Zero means waiting without limit.
+	ShutdownTimeout string `yaml:"ShutdownTimeout"`
 }
 
 // Validate validates the port number and the files for certification.
@@ -65,5 +89,37 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if c.MaxRequestBytes > 0 && c.MaxChangePackBytes > c.MaxRequestBytes {
+		return fmt.Errorf(
+			"must be less than or equal to %d, given %d: %w",
+			c.MaxRequestBytes,
+			c.MaxChangePackBytes,
+			ErrInvalidMaxChangePackBytes,
+		)
+	}
+
+	if _, err := c.ParseShutdownTimeout(); err != nil {
+		return err
+	}
+
 	return nil
 }
+
+// ParseShutdownTimeout returns the shutdown timeout. It returns zero if the
+// timeout is not given.
+func (c *Config) ParseShutdownTimeout() (time.Duration, error) {
+	if c.ShutdownTimeout == "" {
+		return 0, nil
+	}
+
+	result, err := time.ParseDuration(c.ShutdownTimeout)
+	if err != nil || result < 0 {
+		return 0, fmt.Errorf(
+			`invalid argument "%s" for "--rpc-shutdown-timeout" flag: %w`,
+			c.ShutdownTimeout,
+			ErrInvalidShutdownTimeout,
+		)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interceptors
+
+import (
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
+)
+
+// StreamLimitInterceptor is an interceptor for limiting the number of
+// concurrent streams per connection.
+type StreamLimitInterceptor struct {
+	maxStreams uint64
+	metrics    *prometheus.Metrics
+
+	mu      sync.Mutex
+	streams map[string]uint64
+}
+
+// NewStreamLimitInterceptor creates a new instance of StreamLimitInterceptor.
+// If maxStreams is zero, the number of streams is not limited.
+func NewStreamLimitInterceptor(
+	maxStreams uint64,
+	metrics *prometheus.Metrics,
+) *StreamLimitInterceptor {
+	return &StreamLimitInterceptor{
+		maxStreams: maxStreams,
+		metrics:    metrics,
+		streams:    make(map[string]uint64),
+	}
+}
+
+// Stream creates a stream server interceptor for limiting the number of
+// concurrent streams per connection.
+func (i *StreamLimitInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		conn := connKey(ss)
+		if !i.acquire(conn) {
+			return grpcstatus.Errorf(
+				codes.ResourceExhausted,
+				"too many concurrent streams: limit is %d per connection",
+				i.maxStreams,
+			)
+		}
+		defer i.release(conn)
+
+		return handler(srv, ss)
+	}
+}
+
+// ActiveStreams returns the number of active streams of the given connection.
+func (i *StreamLimitInterceptor) ActiveStreams(conn string) uint64 {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.streams[conn]
+}
+
+func (i *StreamLimitInterceptor) acquire(conn string) bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.maxStreams > 0 && i.streams[conn] >= i.maxStreams {
+		return false
+	}
+
+	i.streams[conn]++
+	i.metrics.AddRPCActiveStreams(1)
+	return true
+}
+
+func (i *StreamLimitInterceptor) release(conn string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.streams[conn]--
+	if i.streams[conn] == 0 {
+		delete(i.streams, conn)
+	}
+	i.metrics.AddRPCActiveStreams(-1)
+}
+
+// connKey returns the key identifying the connection of the given stream.
+// The remote address is unique among the connections alive at the same time.
+func connKey(ss grpc.ServerStream) string {
+	p, ok := peer.FromContext(ss.Context())
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	return p.Addr.String()
+}
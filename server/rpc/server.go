@@ -21,12 +21,21 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+
+	// NOTE: gzip is registered so that clients can send compressed change
+	// packs. The server compresses responses with the compressor of the
+	// request, so clients that do not compress receive uncompressed ones.
+	_ "google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/stats"
 
 	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/server/backend"
@@ -35,29 +44,50 @@ import (
 	"github.com/yorkie-team/yorkie/server/rpc/interceptors"
 )
 
+// yorkieServiceName is the name of the Yorkie service in health checks.
+const yorkieServiceName = "api.Yorkie"
+
 // Server is a normal server that processes the logic requested by the client.
 type Server struct {
 	conf                *Config
 	grpcServer          *grpc.Server
+	conns               *connCounter
+	shutdownTimeout     time.Duration
 	yorkieServiceCancel context.CancelFunc
 }
 
 // NewServer creates a new instance of Server.
 func NewServer(conf *Config, be *backend.Backend) (*Server, error) {
+	shutdownTimeout, err := conf.ParseShutdownTimeout()
+	if err != nil {
+		return nil, err
+	}
+
 	loggingInterceptor := grpchelper.NewLoggingInterceptor()
+	dbHealthInterceptor := grpchelper.NewDBHealthInterceptor(be)
 	contextInterceptor := interceptors.NewContextInterceptor(be)
 	defaultInterceptor := interceptors.NewDefaultInterceptor()
+	streamLimitInterceptor := interceptors.NewStreamLimitInterceptor(
+		conf.MaxConcurrentStreamsPerConn,
+		be.Metrics,
+	)
+
+	conns := &connCounter{}
 
 	opts := []grpc.ServerOption{
+		grpc.StatsHandler(conns),
 		grpc.UnaryInterceptor(grpcmiddleware.ChainUnaryServer(
 			loggingInterceptor.Unary(),
 			be.Metrics.ServerMetrics().UnaryServerInterceptor(),
+			dbHealthInterceptor.Unary(),
 			contextInterceptor.Unary(),
 			defaultInterceptor.Unary(),
 		)),
 		grpc.StreamInterceptor(grpcmiddleware.ChainStreamServer(
 			loggingInterceptor.Stream(),
+			streamLimitInterceptor.Stream(),
 			be.Metrics.ServerMetrics().StreamServerInterceptor(),
+			dbHealthInterceptor.Stream(),
 			contextInterceptor.Stream(),
 			defaultInterceptor.Stream(),
 		)),
@@ -79,13 +109,18 @@ func NewServer(conf *Config, be *backend.Backend) (*Server, error) {
 	yorkieServiceCtx, yorkieServiceCancel := context.WithCancel(context.Background())
 
 	grpcServer := grpc.NewServer(opts...)
-	healthpb.RegisterHealthServer(grpcServer, health.NewServer())
-	api.RegisterYorkieServer(grpcServer, newYorkieServer(yorkieServiceCtx, be))
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	watchServingStatus(be, healthServer)
+	api.RegisterYorkieServer(grpcServer, newYorkieServer(yorkieServiceCtx, conf, be))
 	be.Metrics.RegisterGRPCServer(grpcServer)
 
 	return &Server{
 		conf:                conf,
 		grpcServer:          grpcServer,
+		conns:               conns,
+		shutdownTimeout:     shutdownTimeout,
 		yorkieServiceCancel: yorkieServiceCancel,
 	}, nil
 }
@@ -95,14 +130,42 @@ func (s *Server) Start() error {
 	return s.listenAndServeGRPC()
 }
 
-// Shutdown shuts down this server.
+// Shutdown shuts down this server. If graceful is true, it waits for the
+// in-flight requests and streams to finish at most the shutdown timeout, and
+// then closes the remaining connections forcibly.
 func (s *Server) Shutdown(graceful bool) {
 	s.yorkieServiceCancel()
 
-	if graceful {
+	if !graceful {
+		s.grpcServer.Stop()
+		return
+	}
+
+	if s.shutdownTimeout == 0 {
 		s.grpcServer.GracefulStop()
-	} else {
+		return
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	timer := time.NewTimer(s.shutdownTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-stopped:
+	case <-timer.C:
+		conns := s.conns.count()
 		s.grpcServer.Stop()
+		<-stopped
+		logging.DefaultLogger().Warnf(
+			"force-closed %d connections after waiting %s for graceful shutdown",
+			conns,
+			s.shutdownTimeout,
+		)
 	}
 }
 
@@ -125,3 +188,73 @@ func (s *Server) listenAndServeGRPC() error {
 
 	return nil
 }
+
+// watchServingStatus updates the serving status of the health server with
+// the state of the backend. The server keeps serving reads in maintenance
+// mode, so only the status of the Yorkie service is changed for it, while the
+// overall status also follows the connection to the database.
+func watchServingStatus(be *backend.Backend, healthServer *health.Server) {
+	var mu sync.Mutex
+	maintenance, connected := false, true
+
+	update := func() {
+		overall := healthpb.HealthCheckResponse_SERVING
+		if !connected {
+			overall = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		service := overall
+		if maintenance {
+			service = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+
+		healthServer.SetServingStatus("", overall)
+		healthServer.SetServingStatus(yorkieServiceName, service)
+	}
+
+	be.Maintenance.Watch(func(enabled bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		maintenance = enabled
+		update()
+	})
+	be.DBHealth.Watch(func(ok bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		connected = ok
+		update()
+	})
+}
+
+// connCounter is a stats.Handler counting the open connections of the
+// server, to report the connections closed forcibly on shutdown.
+type connCounter struct {
+	open int64
+}
+
+// count returns the number of the open connections.
+func (c *connCounter) count() int64 {
+	return atomic.LoadInt64(&c.open)
+}
+
+// TagRPC implements stats.Handler.
+func (c *connCounter) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+// HandleRPC implements stats.Handler.
+func (c *connCounter) HandleRPC(_ context.Context, _ stats.RPCStats) {}
+
+// TagConn implements stats.Handler.
+func (c *connCounter) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+// HandleConn implements stats.Handler.
+func (c *connCounter) HandleConn(_ context.Context, s stats.ConnStats) {
+	switch s.(type) {
+	case *stats.ConnBegin:
+		atomic.AddInt64(&c.open, 1)
+	case *stats.ConnEnd:
+		atomic.AddInt64(&c.open, -1)
+	}
+}
@@ -65,7 +65,14 @@ func TestMain(m *testing.M) {
 
 	be, err := backend.New(&backend.Config{
 		SnapshotThreshold:    helper.SnapshotThreshold,
+		MaxLamportGap:        helper.MaxLamportGap,
 		AuthWebhookCacheSize: helper.AuthWebhookSize,
+		// NOTE: Reactivation is disabled to check that deactivated clients
+		// cannot attach documents.
+		ClientReactivationGracePeriod: "0s",
+		EventBatchWindow:              helper.EventBatchWindow.String(),
+		HotDocumentWindow:             helper.HotDocumentWindow.String(),
+		SnapshotWriteMaxWaitInterval:  helper.SnapshotWriteMaxWaitInterval.String(),
 	}, &mongo.Config{
 		ConnectionURI:     helper.MongoConnectionURI,
 		YorkieDatabase:    helper.TestDBName(),
@@ -76,9 +83,11 @@ func TestMain(m *testing.M) {
 		DialTimeout:   helper.ETCDDialTimeout.String(),
 		LockLeaseTime: helper.ETCDLockLeaseTime.String(),
 	}, &housekeeping.Config{
-		Interval:            helper.HousekeepingInterval.String(),
-		DeactivateThreshold: helper.HousekeepingDeactivateThreshold.String(),
-		CandidatesLimit:     helper.HousekeepingCandidatesLimit,
+		Interval:             helper.HousekeepingInterval.String(),
+		DeactivateThreshold:  helper.HousekeepingDeactivateThreshold.String(),
+		CandidatesLimit:      helper.HousekeepingCandidatesLimit,
+		ClientEventRetention: helper.HousekeepingClientEventRetention.String(),
+		DocEventLogRetention: helper.HousekeepingDocEventLogRetention.String(),
 	}, testAdminAddr, met)
 	if err != nil {
 		log.Fatal(err)
@@ -381,6 +390,73 @@ func TestRPCServerBackend(t *testing.T) {
 		)
 		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
 	})
+
+	t.Run("reject changes with lamport too far ahead test", func(t *testing.T) {
+		activateResp, err := testClient.ActivateClient(
+			context.Background(),
+			&api.ActivateClientRequest{ClientKey: t.Name()},
+		)
+		assert.NoError(t, err)
+
+		_, err = testClient.AttachDocument(
+			context.Background(),
+			&api.AttachDocumentRequest{
+				ClientId: activateResp.ClientId,
+				ChangePack: &api.ChangePack{
+					DocumentKey: t.Name(),
+					Checkpoint:  &api.Checkpoint{ServerSeq: 0, ClientSeq: 1},
+					Changes: []*api.Change{{
+						Id: &api.ChangeID{
+							ClientSeq: 1,
+							Lamport:   1,
+							ActorId:   activateResp.ClientId,
+						},
+					}},
+				},
+			},
+		)
+		assert.NoError(t, err)
+
+		// 01. Changes within the acceptance window are pushed.
+		_, err = testClient.PushPull(
+			context.Background(),
+			&api.PushPullRequest{
+				ClientId: activateResp.ClientId,
+				ChangePack: &api.ChangePack{
+					DocumentKey: t.Name(),
+					Checkpoint:  &api.Checkpoint{ServerSeq: 1, ClientSeq: 2},
+					Changes: []*api.Change{{
+						Id: &api.ChangeID{
+							ClientSeq: 2,
+							Lamport:   1 + helper.MaxLamportGap,
+							ActorId:   activateResp.ClientId,
+						},
+					}},
+				},
+			},
+		)
+		assert.NoError(t, err)
+
+		// 02. Changes over the acceptance window are rejected.
+		_, err = testClient.PushPull(
+			context.Background(),
+			&api.PushPullRequest{
+				ClientId: activateResp.ClientId,
+				ChangePack: &api.ChangePack{
+					DocumentKey: t.Name(),
+					Checkpoint:  &api.Checkpoint{ServerSeq: 2, ClientSeq: 3},
+					Changes: []*api.Change{{
+						Id: &api.ChangeID{
+							ClientSeq: 3,
+							Lamport:   2 + 2*helper.MaxLamportGap,
+							ActorId:   activateResp.ClientId,
+						},
+					}},
+				},
+			},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+	})
 }
 
 func TestConfig_Validate(t *testing.T) {
@@ -395,6 +471,12 @@ func TestConfig_Validate(t *testing.T) {
 		{config: &rpc.Config{Port: 11101, CertFile: "", KeyFile: ""}, expected: nil},
 		// pass any file existing
 		{config: &rpc.Config{Port: 11101, CertFile: "server_test.go", KeyFile: "server_test.go"}, expected: nil},
+		{
+			config:   &rpc.Config{Port: 11101, MaxRequestBytes: 10, MaxChangePackBytes: 11},
+			expected: rpc.ErrInvalidMaxChangePackBytes,
+		},
+		{config: &rpc.Config{Port: 11101, ShutdownTimeout: "1"}, expected: rpc.ErrInvalidShutdownTimeout},
+		{config: &rpc.Config{Port: 11101, ShutdownTimeout: "-1s"}, expected: rpc.ErrInvalidShutdownTimeout},
 	}
 	for _, scenario := range scenarios {
 		assert.ErrorIs(t, scenario.config.Validate(), scenario.expected, "provided config: %#v", scenario.config)
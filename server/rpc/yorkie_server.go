@@ -18,30 +18,45 @@ package rpc
 
 import (
 	"context"
+	"fmt"
+	"io"
+	gotime "time"
+
+	protoTypes "github.com/gogo/protobuf/types"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/backend/database"
 	"github.com/yorkie-team/yorkie/server/backend/sync"
 	"github.com/yorkie-team/yorkie/server/clients"
 	"github.com/yorkie-team/yorkie/server/documents"
+	"github.com/yorkie-team/yorkie/server/grpchelper"
 	"github.com/yorkie-team/yorkie/server/logging"
 	"github.com/yorkie-team/yorkie/server/packs"
 	"github.com/yorkie-team/yorkie/server/projects"
 	"github.com/yorkie-team/yorkie/server/rpc/auth"
+	"github.com/yorkie-team/yorkie/server/webhook"
 )
 
 type yorkieServer struct {
+	conf       *Config
 	backend    *backend.Backend
 	serviceCtx context.Context
 }
 
 // newYorkieServer creates a new instance of yorkieServer
-func newYorkieServer(serviceCtx context.Context, be *backend.Backend) *yorkieServer {
+func newYorkieServer(serviceCtx context.Context, conf *Config, be *backend.Backend) *yorkieServer {
 	return &yorkieServer{
+		conf:       conf,
 		backend:    be,
 		serviceCtx: serviceCtx,
 	}
@@ -62,7 +77,7 @@ func (s *yorkieServer) ActivateClient(
 		return nil, err
 	}
 
-	cli, err := clients.Activate(ctx, s.backend.DB, projects.From(ctx), req.ClientKey)
+	cli, err := clients.Activate(ctx, s.backend.DB, projects.From(ctx), req.ClientKey, req.Identity)
 	if err != nil {
 		return nil, err
 	}
@@ -73,8 +88,9 @@ func (s *yorkieServer) ActivateClient(
 	}
 
 	return &api.ActivateClientResponse{
-		ClientKey: cli.Key,
-		ClientId:  pbClientID,
+		ClientKey:      cli.Key,
+		ClientId:       pbClientID,
+		RecordWallTime: projects.From(ctx).CollectApplyLag,
 	}, nil
 }
 
@@ -103,6 +119,7 @@ func (s *yorkieServer) DeactivateClient(
 	if err != nil {
 		return nil, err
 	}
+	documents.PurgeEphemeralDocuments(s.backend, projects.From(ctx), cli)
 
 	pbClientID, err := cli.ID.Bytes()
 	if err != nil {
@@ -114,6 +131,48 @@ func (s *yorkieServer) DeactivateClient(
 	}, nil
 }
 
+// CreateDocument creates an empty document of the given key owned by the
+// client. The document can then be attached by the clients even if the project
+// requires documents to be created explicitly.
+func (s *yorkieServer) CreateDocument(
+	ctx context.Context,
+	req *api.CreateDocumentRequest,
+) (*api.CreateDocumentResponse, error) {
+	actorID, err := time.ActorIDFromBytes(req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+	docKey := key.Key(req.DocumentKey)
+
+	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
+		Method: types.CreateDocument,
+		Attributes: []types.AccessAttribute{{
+			Key:        docKey.String(),
+			Verb:       types.ReadWrite,
+			Operations: []types.OperationKind{types.ReadOperation, types.WriteOperation},
+		}},
+	}); err != nil {
+		return nil, err
+	}
+
+	clientInfo, err := clients.FindClientInfo(ctx, s.backend.DB, projects.From(ctx), actorID)
+	if err != nil {
+		return nil, err
+	}
+	if clientInfo.Status != database.ClientActivated {
+		return nil, fmt.Errorf("%s: %w", clientInfo.ID, database.ErrClientNotActivated)
+	}
+
+	summary, err := documents.CreateDocument(ctx, s.backend, projects.From(ctx), clientInfo.ID, docKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.CreateDocumentResponse{
+		DocumentId: summary.ID.String(),
+	}, nil
+}
+
 // AttachDocument attaches the given document to the client.
 func (s *yorkieServer) AttachDocument(
 	ctx context.Context,
@@ -136,7 +195,70 @@ func (s *yorkieServer) AttachDocument(
 		return nil, err
 	}
 
-	if pack.HasChanges() {
+	if req.TargetServerSeq > 0 {
+		return s.attachDocumentAt(ctx, actorID, pack, req.TargetServerSeq)
+	}
+
+	return s.attachDocument(ctx, actorID, pack, req.ReadOnly, req.CreateIfMissing, req.ConsistencyToken)
+}
+
+// AttachDocuments attaches the given documents to the client in one round
+// trip. Each document is attached independently, so the failure of a document
+// is reported in its result without aborting the others.
+func (s *yorkieServer) AttachDocuments(
+	ctx context.Context,
+	req *api.AttachDocumentsRequest,
+) (*api.AttachDocumentsResponse, error) {
+	actorID, err := time.ActorIDFromBytes(req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+
+	changePacks, attributes, err := fromBatchChangePacks(req.ChangePacks)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: The access to all the documents is verified at once to call the
+	// authorization webhook only once for the batch.
+	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
+		Method:     types.AttachDocument,
+		Attributes: attributes,
+	}); err != nil {
+		return nil, err
+	}
+
+	results := make([]*api.AttachDocumentsResponse_Result, 0, len(changePacks))
+	for _, pack := range changePacks {
+		result := &api.AttachDocumentsResponse_Result{DocumentKey: pack.DocumentKey.String()}
+		res, err := s.attachDocument(ctx, actorID, pack, req.ReadOnly, req.CreateIfMissing, "")
+		if err != nil {
+			st := status.Convert(grpchelper.ToStatusError(err))
+			result.ErrorCode = int32(st.Code())
+			result.ErrorMessage = st.Message()
+		} else {
+			result.Response = res
+		}
+		results = append(results, result)
+	}
+
+	return &api.AttachDocumentsResponse{Results: results}, nil
+}
+
+// attachDocument attaches the document of the given pack to the client. If
+// the consistency token is given, the document is attached after the server
+// has caught up to the write of the token.
+func (s *yorkieServer) attachDocument(
+	ctx context.Context,
+	actorID *time.ActorID,
+	pack *change.Pack,
+	readOnly bool,
+	createIfMissingValue *protoTypes.BoolValue,
+	consistencyToken string,
+) (*api.AttachDocumentResponse, error) {
+	// NOTE: Attaching an ephemeral document is serialized with purging it,
+	// even if the pack has no changes.
+	if pack.HasChanges() || projects.From(ctx).IsEphemeralDocument(pack.DocumentKey) {
 		locker, err := s.backend.Coordinator.NewLocker(
 			ctx,
 			packs.PushPullKey(projects.From(ctx).ID, pack.DocumentKey),
@@ -155,28 +277,69 @@ func (s *yorkieServer) AttachDocument(
 		}()
 	}
 
-	clientInfo, err := clients.FindClientInfo(
+	// NOTE: MongoDB keeps times in milliseconds, so the document is regarded
+	// as created by this request if it is created after the truncated time.
+	requestedAt := gotime.Now().Truncate(gotime.Millisecond)
+
+	clientInfo, reactivated, err := clients.FindOrReactivateClientInfo(
 		ctx,
 		s.backend.DB,
 		projects.From(ctx),
 		actorID,
+		s.backend.Config.ParseClientReactivationGracePeriod(),
 	)
 	if err != nil {
 		return nil, err
 	}
-	docInfo, err := documents.FindDocInfoByKeyAndOwner(
+
+	// NOTE: The request decides whether to create the document if it is
+	// missing, and the project decides it otherwise.
+	createIfMissing := !projects.From(ctx).ExplicitDocumentCreation
+	if createIfMissingValue != nil {
+		createIfMissing = createIfMissingValue.Value
+	}
+	docInfo, removedAction, err := documents.FindDocInfoToAttach(
 		ctx,
 		s.backend,
 		projects.From(ctx),
 		clientInfo,
 		pack.DocumentKey,
-		true,
+		createIfMissing,
 	)
 	if err != nil {
 		return nil, err
 	}
+	if err := docInfo.EnsureUnarchived(); err != nil {
+		return nil, err
+	}
+	if docInfo, err = packs.CatchUp(ctx, s.backend, docInfo, consistencyToken); err != nil {
+		return nil, err
+	}
 
-	if err := clientInfo.AttachDocument(docInfo.ID); err != nil {
+	// NOTE: The changes pushed with the attachment override the initial
+	// content of the project.
+	if !pack.HasChanges() {
+		if err := packs.StoreInitialContent(ctx, s.backend, projects.From(ctx), docInfo); err != nil {
+			return nil, err
+		}
+	}
+
+	// NOTE: With stable actor IDs, a session resumes the attachment of the
+	// previous sessions of the same identity which are not detached, e.g.
+	// when the previous session is closed without detaching the document.
+	if projects.From(ctx).HasStableActorID() {
+		if attached, _ := clientInfo.IsAttached(docInfo.ID); attached {
+			if err := clientInfo.DetachDocument(docInfo.ID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := clientInfo.AttachDocument(docInfo.ID, readOnly); err != nil {
+		return nil, err
+	}
+
+	if err := documents.AddActor(ctx, s.backend, projects.From(ctx), docInfo, clientInfo); err != nil {
 		return nil, err
 	}
 
@@ -185,16 +348,129 @@ func (s *yorkieServer) AttachDocument(
 		return nil, err
 	}
 
+	packs.StoreSnapshotOnAttach(s.backend, projects.From(ctx), docInfo, pulled.MinSyncedTicket)
+	s.storeClientEvent(ctx, docInfo, clientInfo, types.DocumentAttachedByClientEvent)
+
+	if err := s.sendAttachEvents(ctx, docInfo, requestedAt); err != nil {
+		return nil, err
+	}
+
 	pbChangePack, err := pulled.ToPBChangePack()
 	if err != nil {
 		return nil, err
 	}
+	issuedToken, err := packs.IssueConsistencyToken(docInfo)
+	if err != nil {
+		return nil, err
+	}
 
 	return &api.AttachDocumentResponse{
-		ChangePack: pbChangePack,
+		ChangePack:        pbChangePack,
+		Reactivated:       reactivated,
+		ObjectMergePolicy: projects.From(ctx).ObjectMergePolicy,
+		ConsistencyToken:  issuedToken,
+
+		RemovedDocumentAction: string(removedAction),
 	}, nil
 }
 
+// attachDocumentAt attaches the given document at the past version of the
+// given server sequence. The document is attached read-only without being
+// registered to the client, so it is neither synchronized nor watched, and the
+// presence of the client is not shared on it.
+func (s *yorkieServer) attachDocumentAt(
+	ctx context.Context,
+	actorID *time.ActorID,
+	pack *change.Pack,
+	serverSeq uint64,
+) (*api.AttachDocumentResponse, error) {
+	if pack.HasChanges() {
+		return nil, fmt.Errorf(
+			"push changes to %s at %d: %w",
+			pack.DocumentKey,
+			serverSeq,
+			database.ErrDocumentReadOnly,
+		)
+	}
+
+	clientInfo, err := clients.FindClientInfo(ctx, s.backend.DB, projects.From(ctx), actorID)
+	if err != nil {
+		return nil, err
+	}
+	if clientInfo.Status != database.ClientActivated {
+		return nil, database.ErrClientNotActivated
+	}
+
+	docInfo, err := documents.FindDocInfoByKey(ctx, s.backend, projects.From(ctx), pack.DocumentKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := docInfo.EnsureUnarchived(); err != nil {
+		return nil, err
+	}
+
+	pulled, err := packs.PullPackAt(ctx, s.backend, projects.From(ctx), docInfo, serverSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	pbChangePack, err := pulled.ToPBChangePack()
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.AttachDocumentResponse{
+		ChangePack:        pbChangePack,
+		ObjectMergePolicy: projects.From(ctx).ObjectMergePolicy,
+	}, nil
+}
+
+// sendAttachEvents sends the events of the document attached by this request
+// to the event webhook of the project.
+func (s *yorkieServer) sendAttachEvents(
+	ctx context.Context,
+	docInfo *database.DocInfo,
+	requestedAt gotime.Time,
+) error {
+	project := projects.From(ctx)
+	if project.EventWebhookURL == "" {
+		return nil
+	}
+
+	if !docInfo.CreatedAt.Before(requestedAt) {
+		webhook.SendEvent(s.backend, project, types.DocumentCreatedEvent, docInfo.Key)
+	}
+
+	attachedClients, err := s.backend.DB.CountAttachedClients(ctx, project.ID, docInfo.ID)
+	if err != nil {
+		return err
+	}
+	if attachedClients == 1 {
+		webhook.SendEvent(s.backend, project, types.DocumentFirstAttachedEvent, docInfo.Key)
+	}
+
+	return nil
+}
+
+// storeClientEvent stores the event of the client on the document for the
+// history of clients. The history is only for debugging, so the failure is
+// logged without failing the request.
+func (s *yorkieServer) storeClientEvent(
+	ctx context.Context,
+	docInfo *database.DocInfo,
+	clientInfo *database.ClientInfo,
+	eventType types.DocumentClientEventType,
+) {
+	if err := s.backend.DB.CreateDocClientEventInfo(
+		ctx,
+		docInfo.ID,
+		clientInfo.ID,
+		eventType,
+	); err != nil {
+		logging.From(ctx).Error(err)
+	}
+}
+
 // DetachDocument detaches the given document to the client.
 func (s *yorkieServer) DetachDocument(
 	ctx context.Context,
@@ -217,6 +493,56 @@ func (s *yorkieServer) DetachDocument(
 		return nil, err
 	}
 
+	return s.detachDocument(ctx, actorID, pack)
+}
+
+// DetachDocuments detaches the given documents from the client in one round
+// trip. Each document is detached independently, so the failure of a document
+// is reported in its result without aborting the others.
+func (s *yorkieServer) DetachDocuments(
+	ctx context.Context,
+	req *api.DetachDocumentsRequest,
+) (*api.DetachDocumentsResponse, error) {
+	actorID, err := time.ActorIDFromBytes(req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+
+	changePacks, attributes, err := fromBatchChangePacks(req.ChangePacks)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
+		Method:     types.DetachDocument,
+		Attributes: attributes,
+	}); err != nil {
+		return nil, err
+	}
+
+	results := make([]*api.DetachDocumentsResponse_Result, 0, len(changePacks))
+	for _, pack := range changePacks {
+		result := &api.DetachDocumentsResponse_Result{DocumentKey: pack.DocumentKey.String()}
+		res, err := s.detachDocument(ctx, actorID, pack)
+		if err != nil {
+			st := status.Convert(grpchelper.ToStatusError(err))
+			result.ErrorCode = int32(st.Code())
+			result.ErrorMessage = st.Message()
+		} else {
+			result.Response = res
+		}
+		results = append(results, result)
+	}
+
+	return &api.DetachDocumentsResponse{Results: results}, nil
+}
+
+// detachDocument detaches the document of the given pack from the client.
+func (s *yorkieServer) detachDocument(
+	ctx context.Context,
+	actorID *time.ActorID,
+	pack *change.Pack,
+) (*api.DetachDocumentResponse, error) {
 	if pack.HasChanges() {
 		locker, err := s.backend.Coordinator.NewLocker(
 			ctx,
@@ -268,6 +594,8 @@ func (s *yorkieServer) DetachDocument(
 	if err != nil {
 		return nil, err
 	}
+	s.storeClientEvent(ctx, docInfo, clientInfo, types.DocumentDetachedByClientEvent)
+	documents.PurgeEphemeralDocuments(s.backend, projects.From(ctx), clientInfo)
 
 	pbChangePack, err := pulled.ToPBChangePack()
 	if err != nil {
@@ -279,22 +607,217 @@ func (s *yorkieServer) DetachDocument(
 	}, nil
 }
 
+// fromBatchChangePacks converts the change packs of a batch request and
+// returns them with the access attributes of all of them.
+func fromBatchChangePacks(pbPacks []*api.ChangePack) ([]*change.Pack, []types.AccessAttribute, error) {
+	changePacks := make([]*change.Pack, 0, len(pbPacks))
+	var attributes []types.AccessAttribute
+	for _, pbPack := range pbPacks {
+		pack, err := converter.FromChangePack(pbPack)
+		if err != nil {
+			return nil, nil, err
+		}
+		changePacks = append(changePacks, pack)
+		attributes = append(attributes, auth.AccessAttributes(pack)...)
+	}
+
+	return changePacks, attributes, nil
+}
+
 // PushPull stores the changes sent by the client and delivers the changes
 // accumulated in the server to the client.
 func (s *yorkieServer) PushPull(
 	ctx context.Context,
 	req *api.PushPullRequest,
 ) (*api.PushPullResponse, error) {
+	if s.conf.MaxChangePackBytes > 0 && req.ChangePack != nil {
+		if size := uint64(req.ChangePack.Size()); size > s.conf.MaxChangePackBytes {
+			return nil, fmt.Errorf(
+				"%d bytes exceeds %d bytes, use PushChangesStream instead: %w",
+				size,
+				s.conf.MaxChangePackBytes,
+				packs.ErrChangePackTooLarge,
+			)
+		}
+	}
+
+	return s.pushPull(ctx, req.ClientId, req.ChangePack, req.ConsistencyToken)
+}
+
+// PushChangesStream receives a change pack that is too large for PushPull in
+// chunks, and then stores the changes and returns accumulated changes like
+// PushPull.
+func (s *yorkieServer) PushChangesStream(stream api.Yorkie_PushChangesStreamServer) error {
+	assembler := packs.NewChunkAssembler(s.conf.MaxStreamedChangePackBytes)
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := assembler.Add(req.ClientId, req.Seq, req.TotalSize, req.Chunk); err != nil {
+			return err
+		}
+	}
+
+	data, err := assembler.Bytes()
+	if err != nil {
+		return err
+	}
+
+	pbChangePack := &api.ChangePack{}
+	if err := pbChangePack.Unmarshal(data); err != nil {
+		return fmt.Errorf("%s: %w", err.Error(), packs.ErrInvalidChunk)
+	}
+
+	res, err := s.pushPull(stream.Context(), assembler.ClientID(), pbChangePack, "")
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(res)
+}
+
+// TransactDocuments pushes the change packs to their documents atomically and
+// delivers the changes accumulated in the server to the client.
+func (s *yorkieServer) TransactDocuments(
+	ctx context.Context,
+	req *api.TransactDocumentsRequest,
+) (*api.TransactDocumentsResponse, error) {
 	actorID, err := time.ActorIDFromBytes(req.ClientId)
 	if err != nil {
 		return nil, err
 	}
 
-	pack, err := converter.FromChangePack(req.ChangePack)
+	changePacks, attributes, err := fromBatchChangePacks(req.ChangePacks)
 	if err != nil {
 		return nil, err
 	}
 
+	res, err := s.transactDocuments(ctx, actorID, changePacks, attributes)
+	if err != nil {
+		for _, pack := range changePacks {
+			s.publishRejection(ctx, actorID, pack, err)
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// transactDocuments pushes the given packs to their documents atomically.
+func (s *yorkieServer) transactDocuments(
+	ctx context.Context,
+	actorID *time.ActorID,
+	changePacks []*change.Pack,
+	attributes []types.AccessAttribute,
+) (*api.TransactDocumentsResponse, error) {
+	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
+		Method:     types.PushPull,
+		Attributes: attributes,
+	}); err != nil {
+		return nil, err
+	}
+
+	unlock, err := packs.LockTransaction(ctx, s.backend, projects.From(ctx), changePacks)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	clientInfo, err := clients.FindClientInfo(
+		ctx,
+		s.backend.DB,
+		projects.From(ctx),
+		actorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	docInfos := make([]*database.DocInfo, 0, len(changePacks))
+	for _, pack := range changePacks {
+		docInfo, err := documents.FindDocInfoByKeyAndOwner(
+			ctx,
+			s.backend,
+			projects.From(ctx),
+			clientInfo,
+			pack.DocumentKey,
+			false,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if err := clientInfo.EnsureDocumentAttached(docInfo.ID); err != nil {
+			return nil, err
+		}
+		docInfos = append(docInfos, docInfo)
+	}
+
+	pulled, err := packs.TransactDocuments(ctx, s.backend, projects.From(ctx), clientInfo, docInfos, changePacks)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*api.TransactDocumentsResponse_Result, 0, len(pulled))
+	for i, respPack := range pulled {
+		pbPulled, err := respPack.ToPBChangePack()
+		if err != nil {
+			return nil, err
+		}
+		issuedToken, err := packs.IssueConsistencyToken(docInfos[i])
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &api.TransactDocumentsResponse_Result{
+			DocumentKey:      changePacks[i].DocumentKey.String(),
+			ChangePack:       pbPulled,
+			ConsistencyToken: issuedToken,
+		})
+	}
+
+	return &api.TransactDocumentsResponse{Results: results}, nil
+}
+
+// pushPull stores the changes of the given change pack and returns
+// accumulated changes of the document.
+func (s *yorkieServer) pushPull(
+	ctx context.Context,
+	clientID []byte,
+	pbChangePack *api.ChangePack,
+	consistencyToken string,
+) (*api.PushPullResponse, error) {
+	actorID, err := time.ActorIDFromBytes(clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	pack, err := converter.FromChangePack(pbChangePack)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.pushPullPack(ctx, actorID, pack, consistencyToken)
+	if err != nil {
+		s.publishRejection(ctx, actorID, pack, err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// pushPullPack stores the changes of the given pack and returns accumulated
+// changes of the document. If the consistency token is given, the changes
+// are pulled after the server has caught up to the write of the token.
+func (s *yorkieServer) pushPullPack(
+	ctx context.Context,
+	actorID *time.ActorID,
+	pack *change.Pack,
+	consistencyToken string,
+) (*api.PushPullResponse, error) {
 	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
 		Method:     types.PushPull,
 		Attributes: auth.AccessAttributes(pack),
@@ -346,22 +869,145 @@ func (s *yorkieServer) PushPull(
 	if err := clientInfo.EnsureDocumentAttached(docInfo.ID); err != nil {
 		return nil, err
 	}
+	if docInfo, err = packs.CatchUp(ctx, s.backend, docInfo, consistencyToken); err != nil {
+		return nil, err
+	}
 
 	pulled, err := packs.PushPull(ctx, s.backend, projects.From(ctx), clientInfo, docInfo, pack)
 	if err != nil {
 		return nil, err
 	}
 
-	pbChangePack, err := pulled.ToPBChangePack()
+	pbPulled, err := pulled.ToPBChangePack()
+	if err != nil {
+		return nil, err
+	}
+	issuedToken, err := packs.IssueConsistencyToken(docInfo)
 	if err != nil {
 		return nil, err
 	}
 
 	return &api.PushPullResponse{
-		ChangePack: pbChangePack,
+		ChangePack:       pbPulled,
+		ConsistencyToken: issuedToken,
 	}, nil
 }
 
+// publishRejection publishes the rejection of the changes of the given pack
+// to the operators watching the rejections of the project. The errors which
+// are not caused by the changes, e.g. failures of the database, are not
+// published.
+func (s *yorkieServer) publishRejection(
+	ctx context.Context,
+	actorID *time.ActorID,
+	pack *change.Pack,
+	err error,
+) {
+	if !pack.HasChanges() {
+		return
+	}
+
+	var reason types.RejectionReason
+	st := status.Convert(grpchelper.ToStatusError(err))
+	switch st.Code() {
+	case codes.ResourceExhausted:
+		reason = types.RejectedByQuota
+	case codes.InvalidArgument:
+		reason = types.RejectedAsInvalid
+	case codes.Unauthenticated, codes.PermissionDenied, codes.FailedPrecondition:
+		reason = types.RejectedByAuthz
+	default:
+		return
+	}
+
+	s.backend.Rejections.Publish(projects.From(ctx).ID, types.Rejection{
+		DocumentKey: pack.DocumentKey,
+		ClientID:    types.IDFromActorID(actorID),
+		Reason:      reason,
+		Message:     st.Message(),
+		Changes:     len(pack.Changes),
+		RejectedAt:  gotime.Now(),
+	})
+}
+
+// ValidateChange validates the changes of the given change pack as PushPull
+// does and applies them to a copy of the document, and then reports whether
+// they would be accepted without storing them.
+func (s *yorkieServer) ValidateChange(
+	ctx context.Context,
+	req *api.ValidateChangeRequest,
+) (*api.ValidateChangeResponse, error) {
+	actorID, err := time.ActorIDFromBytes(req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+
+	pack, err := converter.FromChangePack(req.ChangePack)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
+		Method:     types.PushPull,
+		Attributes: auth.AccessAttributes(pack),
+	}); err != nil {
+		return nil, err
+	}
+
+	clientInfo, err := clients.FindClientInfo(
+		ctx,
+		s.backend.DB,
+		projects.From(ctx),
+		actorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	docInfo, err := documents.FindDocInfoByKeyAndOwner(
+		ctx,
+		s.backend,
+		projects.From(ctx),
+		clientInfo,
+		pack.DocumentKey,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := clientInfo.EnsureDocumentAttached(docInfo.ID); err != nil {
+		return nil, err
+	}
+
+	response := &api.ValidateChangeResponse{Accepted: true}
+	if err := packs.ValidateChange(ctx, s.backend, projects.From(ctx), clientInfo, docInfo, pack); err != nil {
+		// NOTE: The errors which are not caused by the changes, e.g. failures
+		// of the database, are returned instead of being reported as rejected.
+		st := status.Convert(grpchelper.ToStatusError(err))
+		if st.Code() == codes.Internal || st.Code() == codes.DeadlineExceeded {
+			return nil, err
+		}
+
+		response.Accepted = false
+		response.ErrorCode = int32(st.Code())
+		response.ErrorMessage = st.Message()
+		for _, detail := range st.Details() {
+			br, ok := detail.(*errdetails.BadRequest)
+			if !ok {
+				continue
+			}
+			for _, violation := range br.FieldViolations {
+				response.Violations = append(response.Violations, &api.ValidateChangeResponse_Violation{
+					Field:       violation.Field,
+					Description: violation.Description,
+				})
+			}
+		}
+	}
+
+	return response, nil
+}
+
 // WatchDocuments connects the stream to deliver events from the given documents
 // to the requesting client.
 func (s *yorkieServer) WatchDocuments(
@@ -374,6 +1020,15 @@ func (s *yorkieServer) WatchDocuments(
 	}
 	docKeys := converter.FromDocumentKeys(req.DocumentKeys)
 
+	if len(req.Paths) > 0 && !s.backend.Config.EnableSubtreeWatch {
+		return sync.ErrSubtreeWatchDisabled
+	}
+	for _, path := range req.Paths {
+		if err := json.ValidatePath(path, s.backend.Config.MaxPathDepth); err != nil {
+			return err
+		}
+	}
+
 	var attrs []types.AccessAttribute
 	for _, k := range docKeys {
 		attrs = append(attrs, types.AccessAttribute{
@@ -389,10 +1044,11 @@ func (s *yorkieServer) WatchDocuments(
 		return err
 	}
 
+	project := projects.From(stream.Context())
 	if _, err = clients.FindClientInfo(
 		stream.Context(),
 		s.backend.DB,
-		projects.From(stream.Context()),
+		project,
 		cli.ID,
 	); err != nil {
 		return err
@@ -407,6 +1063,10 @@ func (s *yorkieServer) WatchDocuments(
 		logging.From(stream.Context()).Error(err)
 		return err
 	}
+	subscription.SetPaths(req.Paths)
+
+	s.backend.ProjectStats.Watch(project.ID, docKeys)
+	defer s.backend.ProjectStats.Unwatch(project.ID, docKeys)
 
 	if err := stream.Send(&api.WatchDocumentsResponse{
 		Body: &api.WatchDocumentsResponse_Initialization_{
@@ -429,6 +1089,11 @@ func (s *yorkieServer) WatchDocuments(
 			s.unwatchDocs(docKeys, subscription)
 			return nil
 		case event := <-subscription.Events():
+			event, ok := subscription.Filter(event)
+			if !ok {
+				continue
+			}
+
 			eventType, err := converter.ToDocEventType(event.Type)
 			if err != nil {
 				return err
@@ -440,6 +1105,8 @@ func (s *yorkieServer) WatchDocuments(
 						Type:         eventType,
 						Publisher:    converter.ToClient(event.Publisher),
 						DocumentKeys: converter.ToDocumentKeys(event.DocumentKeys),
+						RemovedPaths: event.RemovedPaths,
+						ServerSeq:    event.ServerSeq,
 					},
 				},
 			}); err != nil {
@@ -462,6 +1129,13 @@ func (s *yorkieServer) UpdatePresence(
 	}
 	keys := converter.FromDocumentKeys(req.DocumentKeys)
 
+	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
+		Method:     types.UpdatePresence,
+		Attributes: auth.PresenceAccessAttributes(keys),
+	}); err != nil {
+		return nil, err
+	}
+
 	docEvent, err := s.backend.Coordinator.UpdatePresence(ctx, cli, keys)
 	if err != nil {
 		return nil, err
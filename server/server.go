@@ -17,12 +17,16 @@
 package server
 
 import (
+	"context"
 	gosync "sync"
 
+	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/server/admin"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/backend/sync"
+	"github.com/yorkie-team/yorkie/server/documents"
 	"github.com/yorkie-team/yorkie/server/logging"
+	"github.com/yorkie-team/yorkie/server/packs"
 	"github.com/yorkie-team/yorkie/server/profiling"
 	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
 	"github.com/yorkie-team/yorkie/server/rpc"
@@ -67,6 +71,16 @@ func New(conf *Config) (*Yorkie, error) {
 		return nil, err
 	}
 
+	be.Housekeeping.SetArchiveFunc(func(ctx context.Context, limit int) (int, error) {
+		return documents.ArchiveInactiveDocuments(ctx, be, limit)
+	})
+	be.Housekeeping.SetUpgradeSnapshotsFunc(func(ctx context.Context, limit int) (int, error) {
+		return packs.UpgradeSnapshots(ctx, be, limit)
+	})
+	be.Housekeeping.SetCollectGarbageFunc(func(ctx context.Context, offset types.ID, limit int) (types.ID, int, error) {
+		return packs.CollectGarbageByInterval(ctx, be, offset, limit)
+	})
+
 	rpcServer, err := rpc.NewServer(conf.RPC, be)
 	if err != nil {
 		return nil, err
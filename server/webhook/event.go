@@ -0,0 +1,147 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package webhook delivers the lifecycle events of documents to the event
+// webhooks of projects.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/logging"
+)
+
+var (
+	// ErrUnexpectedStatusCode is returned when the response code is not 2xx
+	// from the event webhook.
+	ErrUnexpectedStatusCode = errors.New("unexpected status code from event webhook")
+)
+
+// SendEvent sends the given event of the document to the event webhook of the
+// project in background. The event is retried with exponential backoff, and
+// counted as a dead letter if it fails permanently.
+func SendEvent(
+	be *backend.Backend,
+	project *types.Project,
+	eventType types.EventWebhookType,
+	docKey key.Key,
+) {
+	if project.EventWebhookURL == "" {
+		return
+	}
+
+	event := types.EventWebhookRequest{
+		Type:        eventType,
+		ProjectName: project.Name,
+		DocumentKey: docKey.String(),
+		IssuedAt:    time.Now(),
+	}
+	url, secretKey := project.EventWebhookURL, project.SecretKey
+
+	be.Background.AttachGoroutine(func(ctx context.Context) {
+		if err := Deliver(ctx, be.Config, url, secretKey, event); err != nil {
+			logging.From(ctx).Errorf("fail to deliver %s of '%s': %v", eventType, docKey, err)
+			be.Metrics.AddEventWebhookDeadLetters(string(eventType))
+		}
+	})
+}
+
+// Deliver posts the given event to the given url with the signature of the
+// payload. It retries on network errors and retryable status codes.
+func Deliver(
+	ctx context.Context,
+	conf *backend.Config,
+	url string,
+	secretKey string,
+	event types.EventWebhookRequest,
+) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	signature := types.SignEventWebhookPayload(secretKey, payload)
+
+	var retries uint64
+	for {
+		statusCode, err := post(ctx, url, payload, signature)
+		if err == nil {
+			return nil
+		}
+		if !shouldRetry(statusCode) || retries >= conf.EventWebhookMaxRetries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(waitInterval(retries, conf.ParseEventWebhookMaxWaitInterval())):
+		}
+		retries++
+	}
+}
+
+func post(ctx context.Context, url string, payload []byte, signature string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(types.EventWebhookSignatureHeader, signature)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("%d: %w", resp.StatusCode, ErrUnexpectedStatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// waitInterval returns the interval of given retries. (2^retries * 100) milliseconds.
+func waitInterval(retries uint64, maxWaitInterval time.Duration) time.Duration {
+	interval := time.Duration(math.Pow(2, float64(retries))) * 100 * time.Millisecond
+	if maxWaitInterval < interval {
+		return maxWaitInterval
+	}
+
+	return interval
+}
+
+// shouldRetry returns true if the request with the given status code should
+// be retried. The status code is zero if the request could not be sent.
+func shouldRetry(statusCode int) bool {
+	return statusCode == 0 ||
+		statusCode == http.StatusTooManyRequests ||
+		statusCode >= http.StatusInternalServerError
+}
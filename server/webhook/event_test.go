@@ -0,0 +1,104 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/webhook"
+)
+
+func TestDeliver(t *testing.T) {
+	conf := &backend.Config{
+		EventWebhookMaxRetries:      3,
+		EventWebhookMaxWaitInterval: "1ms",
+	}
+	event := types.EventWebhookRequest{
+		Type:        types.DocumentCreatedEvent,
+		ProjectName: "default",
+		DocumentKey: "doc",
+		IssuedAt:    time.Now(),
+	}
+
+	t.Run("signed delivery test", func(t *testing.T) {
+		var received types.EventWebhookRequest
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			payload, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.True(t, types.VerifyEventWebhookSignature(
+				"secret",
+				payload,
+				r.Header.Get(types.EventWebhookSignatureHeader),
+			))
+			assert.NoError(t, json.Unmarshal(payload, &received))
+		}))
+		defer svr.Close()
+
+		assert.NoError(t, webhook.Deliver(context.Background(), conf, svr.URL, "secret", event))
+		assert.Equal(t, event.Type, received.Type)
+		assert.Equal(t, event.DocumentKey, received.DocumentKey)
+	})
+
+	t.Run("retry test", func(t *testing.T) {
+		var requests int32
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&requests, 1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		}))
+		defer svr.Close()
+
+		assert.NoError(t, webhook.Deliver(context.Background(), conf, svr.URL, "secret", event))
+		assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("permanent failure test", func(t *testing.T) {
+		var requests int32
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer svr.Close()
+
+		err := webhook.Deliver(context.Background(), conf, svr.URL, "secret", event)
+		assert.ErrorIs(t, err, webhook.ErrUnexpectedStatusCode)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+		// retries are exhausted if the webhook keeps failing.
+		unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer unavailable.Close()
+
+		atomic.StoreInt32(&requests, 0)
+		err = webhook.Deliver(context.Background(), conf, unavailable.URL, "secret", event)
+		assert.ErrorIs(t, err, webhook.ErrUnexpectedStatusCode)
+		assert.Equal(t, int32(conf.EventWebhookMaxRetries+1), atomic.LoadInt32(&requests))
+	})
+}
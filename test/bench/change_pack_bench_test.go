@@ -0,0 +1,96 @@
+//go:build bench
+
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bench
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+)
+
+// editsPerChangePack is the number of edits in a change pack. It is similar
+// to the number of edits that a user makes between two syncs.
+const editsPerChangePack = 1000
+
+func BenchmarkChangePackCompression(b *testing.B) {
+	editingTrace, err := readEditingTraceFromFile(b)
+	assert.NoError(b, err)
+
+	doc := document.New("d1")
+	assert.NoError(b, doc.Update(func(root *proxy.ObjectProxy) error {
+		root.SetNewText("text")
+		return nil
+	}))
+	for _, edit := range editingTrace.Edits[:editsPerChangePack] {
+		cursor := int(edit[0].(float64))
+		mode := int(edit[1].(float64))
+
+		assert.NoError(b, doc.Update(func(root *proxy.ObjectProxy) error {
+			text := root.GetText("text")
+			if mode == 0 {
+				text.Edit(cursor, cursor, edit[2].(string))
+			} else if mode == 1 {
+				text.Edit(cursor, cursor+1, "")
+			}
+			return nil
+		}))
+	}
+
+	pbPack, err := converter.ToChangePack(doc.CreateChangePack())
+	assert.NoError(b, err)
+
+	b.Run("uncompressed", func(b *testing.B) {
+		var size int
+		for i := 0; i < b.N; i++ {
+			data, err := pbPack.Marshal()
+			assert.NoError(b, err)
+			size = len(data)
+		}
+		b.ReportMetric(float64(size), "bytes/pack")
+	})
+
+	b.Run("gzip", func(b *testing.B) {
+		var size int
+		for i := 0; i < b.N; i++ {
+			data, err := pbPack.Marshal()
+			assert.NoError(b, err)
+
+			var buf bytes.Buffer
+			writer := gzip.NewWriter(&buf)
+			_, err = writer.Write(data)
+			assert.NoError(b, err)
+			assert.NoError(b, writer.Close())
+			size = buf.Len()
+
+			reader, err := gzip.NewReader(&buf)
+			assert.NoError(b, err)
+			decompressed, err := ioutil.ReadAll(reader)
+			assert.NoError(b, err)
+			assert.Equal(b, len(data), len(decompressed))
+		}
+		b.ReportMetric(float64(size), "bytes/pack")
+	})
+}
@@ -0,0 +1,85 @@
+//go:build bench
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bench
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/database/memory"
+)
+
+// BenchmarkSnapshotDedup measures the storage of the initial snapshots of
+// the documents created from one template, which are stored once in the
+// shared blob, compared to storing them inline.
+func BenchmarkSnapshotDedup(b *testing.B) {
+	const docCount = 1000
+	ctx := context.Background()
+	template := `{"title":"Untitled","sections":[`
+	for i := 0; i < 20; i++ {
+		if i > 0 {
+			template += ","
+		}
+		template += fmt.Sprintf(`{"heading":"Section %d","body":"Write the content of the section here."}`, i)
+	}
+	template += `]}`
+
+	for i := 0; i < b.N; i++ {
+		db, err := memory.New(&database.ObjectIDGenerator{})
+		assert.NoError(b, err)
+
+		var inline, stored int
+		checksums := make(map[string]bool)
+		for j := 0; j < docCount; j++ {
+			docID := types.ID(fmt.Sprintf("%024x", j+1))
+			doc := document.New(key.Key(fmt.Sprintf("doc-%d", j)))
+			assert.NoError(b, doc.Update(func(root *proxy.ObjectProxy) error {
+				return root.Import([]byte(template))
+			}))
+			assert.NoError(b, doc.ApplyChangePack(change.NewPack(
+				doc.Key(),
+				doc.Checkpoint().NextServerSeq(1),
+				nil,
+				nil,
+			)))
+			assert.NoError(b, db.CreateSnapshotInfo(ctx, docID, doc.InternalDocument(), types.SnapshotCompressionNone))
+
+			info, err := db.FindClosestSnapshotInfo(ctx, docID, 1)
+			assert.NoError(b, err)
+			inline += len(info.Snapshot)
+			if !checksums[info.Checksum] {
+				checksums[info.Checksum] = true
+				stored += len(info.Snapshot)
+			}
+		}
+		assert.NoError(b, db.Close())
+
+		b.ReportMetric(float64(inline), "inline-bytes")
+		b.ReportMetric(float64(stored), "stored-bytes")
+	}
+}
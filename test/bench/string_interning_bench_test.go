@@ -0,0 +1,85 @@
+//go:build bench
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bench
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+)
+
+// BenchmarkStringInterning measures the memory retained by a document with
+// highly repeated values built from a snapshot, with and without string
+// interning.
+func BenchmarkStringInterning(b *testing.B) {
+	statuses := []string{
+		"status-waiting-for-the-review-of-the-maintainers",
+		"status-in-progress-by-the-assignee-of-the-issue",
+		"status-done-and-released-in-the-latest-version",
+	}
+
+	doc := document.New("d1")
+	assert.NoError(b, doc.Update(func(root *proxy.ObjectProxy) error {
+		for i := 0; i < 10000; i++ {
+			root.SetString(fmt.Sprintf("k%d", i), statuses[i%len(statuses)])
+		}
+		return nil
+	}))
+	snapshot, err := converter.ObjectToBytes(doc.RootObject())
+	assert.NoError(b, err)
+
+	benchmarkRetainedBytes := func(b *testing.B, interning bool) {
+		var retained uint64
+		for i := 0; i < b.N; i++ {
+			var before, after runtime.MemStats
+			runtime.GC()
+			runtime.ReadMemStats(&before)
+
+			doc := document.New("d1")
+			doc.SetStringInterning(interning)
+			assert.NoError(b, doc.ApplyChangePack(change.NewPack(
+				doc.Key(),
+				change.InitialCheckpoint.NextServerSeq(1),
+				nil,
+				snapshot,
+			)))
+
+			runtime.GC()
+			runtime.ReadMemStats(&after)
+			retained = after.HeapAlloc - before.HeapAlloc
+			runtime.KeepAlive(doc)
+		}
+		b.ReportMetric(float64(retained), "bytes/doc")
+	}
+
+	b.Run("without interning", func(b *testing.B) {
+		benchmarkRetainedBytes(b, false)
+	})
+
+	b.Run("with interning", func(b *testing.B) {
+		benchmarkRetainedBytes(b, true)
+	})
+}
@@ -0,0 +1,175 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package helper
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// Update is a function that makes a change to the root of a document.
+type Update func(root *proxy.ObjectProxy) error
+
+// changeRef refers to the change of the index made by the actor.
+type changeRef struct {
+	actor int
+	index int
+}
+
+// interleaving is an order of the changes of actors.
+type interleaving []changeRef
+
+// String returns the string representation of this interleaving like
+// "1:0 2:0 1:1", where each item is the actor and the index of its change.
+func (i interleaving) String() string {
+	var refs []string
+	for _, ref := range i {
+		refs = append(refs, fmt.Sprintf("%d:%d", ref.actor+1, ref.index))
+	}
+	return strings.Join(refs, " ")
+}
+
+// AssertConvergence asserts that replicas converge regardless of the order of
+// concurrent changes. The base change is made with init, and then the changes
+// of each actor are made with updatesByActor concurrently. The changes are
+// applied to independent documents in the given number of randomized orders,
+// which keep the order of the changes of each actor, and all documents should
+// have the same JSON and snapshot bytes. The interleaving and the seed are
+// reported on failure for reproduction.
+func AssertConvergence(t *testing.T, init Update, updatesByActor [][]Update, rounds int) {
+	t.Helper()
+
+	base := document.New("convergence")
+	if err := base.Update(init); err != nil {
+		t.Fatalf("init: %v", err)
+	}
+	baseChanges := base.CreateChangePack().Changes
+
+	changesByActor := make([][]*change.Change, len(updatesByActor))
+	for actor, updates := range updatesByActor {
+		actorID, err := time.ActorIDFromHex(fmt.Sprintf("%024x", actor+1))
+		if err != nil {
+			t.Fatalf("actor %d: %v", actor+1, err)
+		}
+
+		doc := document.New("convergence")
+		doc.SetActor(actorID)
+		pack := change.NewPack(doc.Key(), change.InitialCheckpoint, baseChanges, nil)
+		pack.MinSyncedTicket = time.InitialTicket
+		if err := doc.ApplyChangePack(pack); err != nil {
+			t.Fatalf("actor %d: %v", actor+1, err)
+		}
+
+		for _, update := range updates {
+			if err := doc.Update(update); err != nil {
+				t.Fatalf("actor %d: %v", actor+1, err)
+			}
+		}
+		changesByActor[actor] = doc.CreateChangePack().Changes
+	}
+
+	// NOTE: The first interleaving applies the changes actor by actor, and
+	// the following ones are randomized.
+	var expectedOrder interleaving
+	for actor, changes := range changesByActor {
+		for index := range changes {
+			expectedOrder = append(expectedOrder, changeRef{actor, index})
+		}
+	}
+	expectedJSON, expectedBytes, err := applyInterleaving(baseChanges, changesByActor, expectedOrder)
+	if err != nil {
+		t.Fatalf("interleaving [%s]: %v", expectedOrder, err)
+	}
+
+	seed := gotime.Now().UnixNano()
+	rnd := rand.New(rand.NewSource(seed))
+	for round := 0; round < rounds; round++ {
+		order := shuffle(rnd, changesByActor)
+		actualJSON, actualBytes, err := applyInterleaving(baseChanges, changesByActor, order)
+		if err != nil {
+			t.Fatalf("interleaving [%s] (seed %d): %v", order, seed, err)
+		}
+
+		if actualJSON != expectedJSON || !bytes.Equal(actualBytes, expectedBytes) {
+			t.Fatalf(
+				"diverged (seed %d):\n[%s]: %s\n[%s]: %s",
+				seed,
+				expectedOrder,
+				expectedJSON,
+				order,
+				actualJSON,
+			)
+		}
+	}
+}
+
+// shuffle returns a random interleaving of the given changes, which keeps the
+// order of the changes of each actor.
+func shuffle(rnd *rand.Rand, changesByActor [][]*change.Change) interleaving {
+	var actors []int
+	for actor, changes := range changesByActor {
+		for range changes {
+			actors = append(actors, actor)
+		}
+	}
+	rnd.Shuffle(len(actors), func(i, j int) {
+		actors[i], actors[j] = actors[j], actors[i]
+	})
+
+	next := make([]int, len(changesByActor))
+	var order interleaving
+	for _, actor := range actors {
+		order = append(order, changeRef{actor, next[actor]})
+		next[actor]++
+	}
+	return order
+}
+
+// applyInterleaving applies the changes to a new document in the given order
+// and returns the JSON and the snapshot bytes of the document.
+func applyInterleaving(
+	baseChanges []*change.Change,
+	changesByActor [][]*change.Change,
+	order interleaving,
+) (string, []byte, error) {
+	doc := document.NewInternalDocument("convergence")
+	if err := doc.ApplyChanges(baseChanges...); err != nil {
+		return "", nil, err
+	}
+
+	for _, ref := range order {
+		if err := doc.ApplyChanges(changesByActor[ref.actor][ref.index]); err != nil {
+			return "", nil, err
+		}
+	}
+
+	snapshot, err := converter.ObjectToBytes(doc.RootObject())
+	if err != nil {
+		return "", nil, err
+	}
+	return doc.Marshal(), snapshot, nil
+}
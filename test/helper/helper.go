@@ -41,19 +41,44 @@ var (
 	RPCPort            = 21101
 	RPCMaxRequestBytes = uint64(4 * 1024 * 1024)
 
+	RPCMaxChangePackBytes          = uint64(3 * 1024 * 1024)
+	RPCMaxStreamedChangePackBytes  = uint64(64 * 1024 * 1024)
+	RPCMaxConcurrentStreamsPerConn = uint64(10)
+	RPCShutdownTimeout             = 3 * gotime.Second
+
 	ProfilingPort = 21102
 
 	AdminPort = 21103
 
-	HousekeepingInterval            = 1 * gotime.Second
-	HousekeepingDeactivateThreshold = 1 * gotime.Minute
-	HousekeepingCandidatesLimit     = 10
-
-	SnapshotThreshold          = uint64(10)
-	AuthWebhookMaxWaitInterval = 3 * gotime.Millisecond
-	AuthWebhookSize            = 100
-	AuthWebhookCacheAuthTTL    = 10 * gotime.Second
-	AuthWebhookCacheUnauthTTL  = 10 * gotime.Second
+	HousekeepingInterval             = 1 * gotime.Second
+	HousekeepingDeactivateThreshold  = 1 * gotime.Minute
+	HousekeepingCandidatesLimit      = 10
+	HousekeepingClientEventRetention = 1 * gotime.Hour
+	HousekeepingDocEventLogRetention = 1 * gotime.Hour
+	HousekeepingSnapshotUpgradeLimit = 10
+
+	SnapshotThreshold             = uint64(10)
+	SnapshotOnAttachThreshold     = uint64(10)
+	TombstoneThreshold            = uint64(10)
+	MaxLamportGap                 = uint64(1000)
+	ClientReactivationGracePeriod = 10 * gotime.Second
+	AuthWebhookMaxWaitInterval    = 3 * gotime.Millisecond
+	AuthWebhookSize               = 100
+	AuthWebhookCacheAuthTTL       = 10 * gotime.Second
+	AuthWebhookCacheUnauthTTL     = 10 * gotime.Second
+	EventWebhookMaxWaitInterval   = 3 * gotime.Millisecond
+	DocumentCountCacheTTL         = 0 * gotime.Second
+	EventBatchWindow              = 10 * gotime.Millisecond
+	HotDocumentWindow             = 10 * gotime.Second
+	ProjectStatsInterval          = 100 * gotime.Millisecond
+	QueryTimeout                  = 10 * gotime.Second
+	MaxPathDepth                  = 16
+	DBHealthCheckInterval         = 100 * gotime.Millisecond
+	DBReconnectMaxBackoff         = 1 * gotime.Second
+	OperationIDWindow             = 1 * gotime.Minute
+	SnapshotRetentionCount        = uint64(3)
+	SnapshotWriteMaxWaitInterval  = 3 * gotime.Millisecond
+	SnapshotCacheBytes            = int64(1024 * 1024)
 
 	MongoConnectionURI     = "mongodb://localhost:27017"
 	MongoConnectionTimeout = "5s"
@@ -96,8 +121,12 @@ func TestConfig() *server.Config {
 	portOffset += 100
 	return &server.Config{
 		RPC: &rpc.Config{
-			Port:            RPCPort + portOffset,
-			MaxRequestBytes: RPCMaxRequestBytes,
+			Port:                        RPCPort + portOffset,
+			MaxRequestBytes:             RPCMaxRequestBytes,
+			MaxChangePackBytes:          RPCMaxChangePackBytes,
+			MaxStreamedChangePackBytes:  RPCMaxStreamedChangePackBytes,
+			MaxConcurrentStreamsPerConn: RPCMaxConcurrentStreamsPerConn,
+			ShutdownTimeout:             RPCShutdownTimeout.String(),
 		},
 		Profiling: &profiling.Config{
 			Port: ProfilingPort + portOffset,
@@ -106,17 +135,42 @@ func TestConfig() *server.Config {
 			Port: AdminPort + portOffset,
 		},
 		Housekeeping: &housekeeping.Config{
-			Interval:            HousekeepingInterval.String(),
-			DeactivateThreshold: HousekeepingDeactivateThreshold.String(),
-			CandidatesLimit:     HousekeepingCandidatesLimit,
+			Interval:             HousekeepingInterval.String(),
+			DeactivateThreshold:  HousekeepingDeactivateThreshold.String(),
+			CandidatesLimit:      HousekeepingCandidatesLimit,
+			ClientEventRetention: HousekeepingClientEventRetention.String(),
+			DocEventLogRetention: HousekeepingDocEventLogRetention.String(),
+			SnapshotUpgradeLimit: HousekeepingSnapshotUpgradeLimit,
 		},
 		Backend: &backend.Config{
-			UseDefaultProject:          true,
-			SnapshotThreshold:          SnapshotThreshold,
-			AuthWebhookMaxWaitInterval: AuthWebhookMaxWaitInterval.String(),
-			AuthWebhookCacheSize:       AuthWebhookSize,
-			AuthWebhookCacheAuthTTL:    AuthWebhookCacheAuthTTL.String(),
-			AuthWebhookCacheUnauthTTL:  AuthWebhookCacheUnauthTTL.String(),
+			UseDefaultProject:             true,
+			SnapshotThreshold:             SnapshotThreshold,
+			SnapshotOnAttachThreshold:     SnapshotOnAttachThreshold,
+			TombstoneThreshold:            TombstoneThreshold,
+			MaxLamportGap:                 MaxLamportGap,
+			EnableSubtreeWatch:            true,
+			EnableDocEventLog:             true,
+			EnableOperationSquash:         true,
+			IndexedMetadataKeys:           []string{"owner"},
+			ClientReactivationGracePeriod: ClientReactivationGracePeriod.String(),
+			AuthWebhookMaxWaitInterval:    AuthWebhookMaxWaitInterval.String(),
+			AuthWebhookCacheSize:          AuthWebhookSize,
+			AuthWebhookCacheAuthTTL:       AuthWebhookCacheAuthTTL.String(),
+			AuthWebhookCacheUnauthTTL:     AuthWebhookCacheUnauthTTL.String(),
+			EventWebhookMaxWaitInterval:   EventWebhookMaxWaitInterval.String(),
+			DocumentCountCacheTTL:         DocumentCountCacheTTL.String(),
+			EventBatchWindow:              EventBatchWindow.String(),
+			HotDocumentWindow:             HotDocumentWindow.String(),
+			ProjectStatsInterval:          ProjectStatsInterval.String(),
+			QueryTimeout:                  QueryTimeout.String(),
+			MaxPathDepth:                  MaxPathDepth,
+			DBHealthCheckInterval:         DBHealthCheckInterval.String(),
+			DBReconnectMaxBackoff:         DBReconnectMaxBackoff.String(),
+			OperationIDWindow:             OperationIDWindow.String(),
+			SnapshotCacheBytes:            SnapshotCacheBytes,
+			SnapshotRetentionCount:        SnapshotRetentionCount,
+			SnapshotRetentionPeriod:       "0s",
+			SnapshotWriteMaxWaitInterval:  SnapshotWriteMaxWaitInterval.String(),
 		},
 		Mongo: &mongo.Config{
 			ConnectionURI:     MongoConnectionURI,
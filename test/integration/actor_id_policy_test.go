@@ -0,0 +1,118 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestActorIDPolicy(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli, err := admin.Dial(svr.AdminAddr())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	newProject := func(name, policy string) *types.Project {
+		project, err := adminCli.CreateProject(context.Background(), name)
+		assert.NoError(t, err)
+		project, err = adminCli.UpdateProject(context.Background(), project.ID.String(), &types.UpdatableProjectFields{
+			ActorIDPolicy: &policy,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, policy, project.ActorIDPolicy)
+		return project
+	}
+
+	activate := func(t *testing.T, project *types.Project, opts ...client.Option) *client.Client {
+		cli, err := client.Dial(svr.RPCAddr(), append(opts, client.WithAPIKey(project.PublicKey))...)
+		assert.NoError(t, err)
+		assert.NoError(t, cli.Activate(context.Background()))
+		return cli
+	}
+
+	t.Run("per-session actor id test", func(t *testing.T) {
+		project := newProject("per-session-actor-id", types.ActorIDPerSession)
+
+		c1 := activate(t, project, client.WithIdentity("user-1"))
+		c2 := activate(t, project, client.WithIdentity("user-1"))
+		defer cleanupClients(t, []*client.Client{c1, c2})
+
+		assert.NotEqual(t, c1.ID().String(), c2.ID().String())
+	})
+
+	t.Run("stable actor id test", func(t *testing.T) {
+		ctx := context.Background()
+		project := newProject("stable-actor-id", types.ActorIDStable)
+		docKey := key.Key(t.Name())
+
+		// 01. The first session attaches the document and leaves it attached.
+		c1 := activate(t, project, client.WithIdentity("user-1"))
+		d1 := document.New(docKey)
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+
+		// 02. The next session of the identity reuses the actor ID and resumes
+		// the attachment.
+		// NOTE: c1 shares the client with c2, so it is deactivated with c2.
+		c2 := activate(t, project, client.WithIdentity("user-1"))
+		defer cleanupClients(t, []*client.Client{c2})
+		assert.Equal(t, c1.ID().String(), c2.ID().String())
+
+		d2 := document.New(docKey)
+		assert.NoError(t, c2.Attach(ctx, d2))
+		assert.Equal(t, `{"k1":"v1"}`, d2.Marshal())
+
+		// 03. The sessions of other identities get other actor IDs.
+		c3 := activate(t, project, client.WithIdentity("user-2"))
+		defer cleanupClients(t, []*client.Client{c3})
+		assert.NotEqual(t, c1.ID().String(), c3.ID().String())
+	})
+
+	t.Run("invalid identity test", func(t *testing.T) {
+		project := newProject("invalid-identity", types.ActorIDStable)
+
+		for _, identity := range []string{"", "user 1"} {
+			cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey), client.WithIdentity(identity))
+			assert.NoError(t, err)
+			err = cli.Activate(context.Background())
+			assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+		}
+	})
+}
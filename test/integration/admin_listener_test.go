@@ -0,0 +1,111 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package integration
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/server"
+	adminServer "github.com/yorkie-team/yorkie/server/admin"
+	"github.com/yorkie-team/yorkie/server/backend"
+	"github.com/yorkie-team/yorkie/server/profiling/prometheus"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestAdminListener(t *testing.T) {
+	conf := helper.TestConfig()
+	met, err := prometheus.NewMetrics()
+	assert.NoError(t, err)
+	be, err := backend.New(conf.Backend, conf.Mongo, conf.ETCD, conf.Housekeeping, conf.AdminAddr(), met)
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, be.Shutdown()) }()
+
+	// testAdminServer serves the admin service on the given listener and
+	// checks that a client dialing with the given options can use it.
+	testAdminServer := func(t *testing.T, lis net.Listener, target string, opts ...grpc.DialOption) {
+		ctx := context.Background()
+
+		svr := adminServer.NewServer(conf.Admin, be)
+		assert.NoError(t, svr.StartWithListener(lis))
+		defer svr.Shutdown(true)
+
+		cli, err := admin.Dial(target, admin.WithDialOptions(opts...))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+
+		project, err := cli.CreateProject(ctx, t.Name())
+		assert.NoError(t, err)
+		projects, err := cli.ListProjects(ctx)
+		assert.NoError(t, err)
+		assert.Contains(t, projects, project)
+	}
+
+	t.Run("in-memory listener test", func(t *testing.T) {
+		lis := bufconn.Listen(1024 * 1024)
+		testAdminServer(t, lis, "bufconn", grpc.WithContextDialer(
+			func(ctx context.Context, _ string) (net.Conn, error) {
+				return lis.DialContext(ctx)
+			},
+		))
+	})
+
+	t.Run("unix domain socket listener test", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "admin.sock")
+		lis, err := net.Listen("unix", path)
+		assert.NoError(t, err)
+		testAdminServer(t, lis, "unix://"+path)
+	})
+}
+
+func TestAdminSocketPath(t *testing.T) {
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "admin.sock")
+	conf := helper.TestConfig()
+	conf.Admin.Port = 0
+	conf.Admin.SocketPath = path
+	svr, err := server.New(conf)
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+
+	// 01. The socket file is only accessible by the owner and the group.
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0660), info.Mode().Perm())
+
+	// 02. The admin service is served on the socket.
+	cli, err := admin.Dial(svr.AdminAddr())
+	assert.NoError(t, err)
+	_, err = cli.ListProjects(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, cli.Close())
+
+	// 03. The socket file is removed on shutdown.
+	assert.NoError(t, svr.Shutdown(true))
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+}
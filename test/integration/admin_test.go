@@ -0,0 +1,1028 @@
+//go:build integration
+
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/server/projects"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestAdmin(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli, err := admin.Dial(svr.AdminAddr())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	project, err := adminCli.CreateProject(context.Background(), "admin-test")
+	assert.NoError(t, err)
+
+	t.Run("get document test", func(t *testing.T) {
+		ctx := context.Background()
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		docKey := key.Key(t.Name())
+		doc := document.New(docKey)
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+		assert.Equal(t, uint64(1), doc.Checkpoint().ServerSeq)
+
+		// NOTE: The snapshot of the pushed change is stored in the background,
+		// since the test server stores one after every push.
+		var detail *types.DocumentDetail
+		assert.Eventually(t, func() bool {
+			detail, err = adminCli.GetDocument(ctx, project.Name, docKey)
+			return err == nil && detail.SnapshotServerSeq == 1
+		}, 5*gotime.Second, 10*gotime.Millisecond)
+		assert.Equal(t, docKey, detail.Summary.Key)
+		assert.Equal(t, `{"k1":"v1"}`, detail.Summary.Snapshot)
+		assert.Equal(t, uint64(1), detail.ServerSeq)
+		assert.Equal(t, uint64(1), detail.SnapshotServerSeq)
+		assert.Equal(t, 1, detail.AttachedClients)
+
+		assert.NoError(t, cli.Detach(ctx, doc))
+		detail, err = adminCli.GetDocument(ctx, project.Name, docKey)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, detail.AttachedClients)
+
+		_, err = adminCli.GetDocument(ctx, project.Name, "not-exist")
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+	})
+
+	t.Run("get removed document test", func(t *testing.T) {
+		ctx := context.Background()
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		docKey := key.Key(t.Name())
+		doc := document.New(docKey)
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, cli.Detach(ctx, doc))
+
+		// 01. The live document is returned regardless of includeRemoved.
+		detail, err := adminCli.GetDocumentIncludingRemoved(ctx, project.Name, docKey)
+		assert.NoError(t, err)
+		assert.True(t, detail.Summary.RemovedAt.IsZero())
+
+		removed, _, err := adminCli.RemoveDocumentsByPrefix(ctx, project.Name, docKey.String(), false, false)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, removed)
+
+		// 02. The removed document is returned only with includeRemoved.
+		_, err = adminCli.GetDocument(ctx, project.Name, docKey)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		detail, err = adminCli.GetDocumentIncludingRemoved(ctx, project.Name, docKey)
+		assert.NoError(t, err)
+		assert.Equal(t, docKey, detail.Summary.Key)
+		assert.Equal(t, `{"k1":"v1"}`, detail.Summary.Snapshot)
+		assert.False(t, detail.Summary.RemovedAt.IsZero())
+
+		_, err = adminCli.GetDocumentIncludingRemoved(ctx, project.Name, "not-exist")
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+	})
+
+	t.Run("get document since checkpoint test", func(t *testing.T) {
+		ctx := context.Background()
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		docKey := key.Key(t.Name())
+		doc := document.New(docKey)
+		assert.NoError(t, cli.Attach(ctx, doc))
+		checkpoint := doc.Checkpoint().ServerSeq
+
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger("k1", i)
+				return nil
+			}))
+			assert.NoError(t, cli.Sync(ctx))
+		}
+
+		detail, err := adminCli.GetDocumentSinceCheckpoint(ctx, project.Name, docKey, checkpoint)
+		assert.NoError(t, err)
+		assert.Equal(t, checkpoint+3, detail.ServerSeq)
+		assert.Equal(t, uint64(3), detail.ChangesSinceCheckpoint)
+
+		detail, err = adminCli.GetDocumentSinceCheckpoint(ctx, project.Name, docKey, detail.ServerSeq)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(0), detail.ChangesSinceCheckpoint)
+
+		_, err = adminCli.GetDocumentSinceCheckpoint(ctx, project.Name, docKey, detail.ServerSeq+1)
+		assert.Equal(t, codes.OutOfRange, status.Convert(err).Code())
+	})
+
+	t.Run("document operation counts test", func(t *testing.T) {
+		ctx := context.Background()
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		docKey := key.Key(t.Name())
+		doc := document.New(docKey)
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		detail, err := adminCli.GetDocument(ctx, project.Name, docKey)
+		assert.NoError(t, err)
+		assert.Empty(t, detail.Summary.OperationCounts)
+
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("k1").AddInteger(1, 2)
+			root.SetNewText("k2").Edit(0, 0, "hello")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.Delete("k1")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+
+		detail, err = adminCli.GetDocument(ctx, project.Name, docKey)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int64{
+			"set":    2,
+			"add":    2,
+			"edit":   1,
+			"remove": 1,
+		}, detail.Summary.OperationCounts)
+	})
+
+	t.Run("collect apply lag test", func(t *testing.T) {
+		ctx := context.Background()
+
+		collectApplyLag := true
+		updated, err := adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+			CollectApplyLag: &collectApplyLag,
+		})
+		assert.NoError(t, err)
+		assert.True(t, updated.CollectApplyLag)
+
+		// clients of the project record the wall time of operations.
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		doc := document.New(key.Key(t.Name()))
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		pack := doc.CreateChangePack()
+		assert.NotEqual(t, int64(0), pack.Changes[0].Operations()[0].WallTime())
+		assert.NoError(t, cli.Sync(ctx))
+	})
+
+	t.Run("remove documents by prefix test", func(t *testing.T) {
+		ctx := context.Background()
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		var docs []*document.Document
+		for _, docKey := range []key.Key{"tmp-1", "tmp-2", "tmp-3", "keep-1"} {
+			doc := document.New(docKey)
+			assert.NoError(t, cli.Attach(ctx, doc))
+			docs = append(docs, doc)
+		}
+		assert.NoError(t, cli.Detach(ctx, docs[0]))
+		assert.NoError(t, cli.Detach(ctx, docs[1]))
+
+		_, _, err = adminCli.RemoveDocumentsByPrefix(ctx, project.Name, "", false, false)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		// dry run only counts the documents to remove.
+		removed, skipped, err := adminCli.RemoveDocumentsByPrefix(ctx, project.Name, "tmp-", true, false)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, removed)
+		assert.Equal(t, 1, skipped)
+		_, err = adminCli.GetDocument(ctx, project.Name, "tmp-1")
+		assert.NoError(t, err)
+
+		// attached documents are skipped without force.
+		removed, skipped, err = adminCli.RemoveDocumentsByPrefix(ctx, project.Name, "tmp-", false, false)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, removed)
+		assert.Equal(t, 1, skipped)
+		_, err = adminCli.GetDocument(ctx, project.Name, "tmp-1")
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+		_, err = adminCli.GetDocument(ctx, project.Name, "tmp-3")
+		assert.NoError(t, err)
+
+		removed, skipped, err = adminCli.RemoveDocumentsByPrefix(ctx, project.Name, "tmp-", false, true)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, removed)
+		assert.Equal(t, 0, skipped)
+
+		_, err = adminCli.GetDocument(ctx, project.Name, "keep-1")
+		assert.NoError(t, err)
+	})
+
+	t.Run("export document test", func(t *testing.T) {
+		ctx := context.Background()
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		docKey := key.Key(t.Name())
+		doc := document.New(docKey)
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+
+		exported, err := adminCli.ExportDocument(ctx, project.Name, docKey, false)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":"v1"}`, exported)
+
+		exported, err = adminCli.ExportDocument(ctx, project.Name, docKey, true)
+		assert.NoError(t, err)
+		assert.Contains(t, exported, `"type":"object"`)
+		assert.Contains(t, exported, `"modifiedBy":"`+cli.ID().String()+`"`)
+		assert.Contains(t, exported, `"value":"v1"`)
+	})
+
+	t.Run("document count test", func(t *testing.T) {
+		ctx := context.Background()
+
+		countProject, err := adminCli.CreateProject(ctx, "document-count-test")
+		assert.NoError(t, err)
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(countProject.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		for _, docKey := range []key.Key{"doc-1", "doc-2", "doc-3"} {
+			doc := document.New(docKey)
+			assert.NoError(t, cli.Attach(ctx, doc))
+			assert.NoError(t, cli.Detach(ctx, doc))
+		}
+
+		// removed documents are excluded from the count.
+		_, _, err = adminCli.RemoveDocumentsByPrefix(ctx, countProject.Name, "doc-1", false, false)
+		assert.NoError(t, err)
+
+		projects, err := adminCli.ListProjects(ctx)
+		assert.NoError(t, err)
+		for _, p := range projects {
+			if p.ID == countProject.ID {
+				assert.Equal(t, 2, p.DocumentCount)
+			}
+		}
+	})
+
+	t.Run("get projects test", func(t *testing.T) {
+		ctx := context.Background()
+
+		project1, err := adminCli.CreateProject(ctx, "get-projects-test-1")
+		assert.NoError(t, err)
+		project2, err := adminCli.CreateProject(ctx, "get-projects-test-2")
+		assert.NoError(t, err)
+
+		notFoundID := types.ID("ffffffffffffffffffffffff")
+		found, notFoundIDs, err := adminCli.GetProjects(ctx, []types.ID{project2.ID, notFoundID, project1.ID})
+		assert.NoError(t, err)
+		assert.Len(t, found, 2)
+		assert.Equal(t, project2.ID, found[0].ID)
+		assert.Equal(t, project1.ID, found[1].ID)
+		assert.Equal(t, []types.ID{notFoundID}, notFoundIDs)
+
+		// the number of IDs exceeds the maximum.
+		ids := make([]types.ID, projects.MaxProjectIDs+1)
+		for i := range ids {
+			ids[i] = project1.ID
+		}
+		_, _, err = adminCli.GetProjects(ctx, ids)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+	})
+
+	t.Run("update project with mask test", func(t *testing.T) {
+		ctx := context.Background()
+
+		masked, err := adminCli.CreateProject(ctx, "update-mask-test")
+		assert.NoError(t, err)
+
+		// 01. Only the masked fields are updated even if the others are given
+		// with the values which would be rejected.
+		name := masked.Name
+		authWebhookURL := "http://localhost:3000"
+		objectMergePolicy := "unknown"
+		updated, err := adminCli.UpdateProjectWithMask(ctx, masked.ID.String(), &types.UpdatableProjectFields{
+			Name:              &name,
+			AuthWebhookURL:    &authWebhookURL,
+			ObjectMergePolicy: &objectMergePolicy,
+		}, []string{"auth_webhook_url"})
+		assert.NoError(t, err)
+		assert.Equal(t, masked.Name, updated.Name)
+		assert.Equal(t, authWebhookURL, updated.AuthWebhookURL)
+		assert.Equal(t, masked.ObjectMergePolicy, updated.ObjectMergePolicy)
+
+		// 02. The unknown paths are rejected.
+		_, err = adminCli.UpdateProjectWithMask(ctx, masked.ID.String(), &types.UpdatableProjectFields{
+			AuthWebhookURL: &authWebhookURL,
+		}, []string{"auth_webhook_url", "public_key"})
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+	})
+
+	t.Run("list document client events test", func(t *testing.T) {
+		ctx := context.Background()
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		docKey := key.Key(t.Name())
+		doc := document.New(docKey)
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.NoError(t, cli.Detach(ctx, doc))
+
+		events, err := adminCli.ListDocumentClientEvents(
+			ctx, project.Name, docKey, gotime.Time{}, gotime.Time{}, "", 10, true,
+		)
+		assert.NoError(t, err)
+		assert.Len(t, events, 2)
+		assert.Equal(t, types.DocumentAttachedByClientEvent, events[0].Type)
+		assert.Equal(t, types.DocumentDetachedByClientEvent, events[1].Type)
+		assert.Equal(t, types.IDFromActorID(cli.ID()), events[0].ClientID)
+
+		// the events before the given time are excluded.
+		events, err = adminCli.ListDocumentClientEvents(
+			ctx, project.Name, docKey, gotime.Now().Add(gotime.Hour), gotime.Time{}, "", 10, true,
+		)
+		assert.NoError(t, err)
+		assert.Len(t, events, 0)
+	})
+
+	t.Run("document version vector test", func(t *testing.T) {
+		ctx := context.Background()
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		docKey := key.Key(t.Name())
+		doc := document.New(docKey)
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		// changes beyond the snapshot threshold are split into the snapshot
+		// and the trailing changes.
+		for i := 0; i < int(helper.SnapshotThreshold)+3; i++ {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger("k1", i)
+				return nil
+			}))
+			assert.NoError(t, cli.Sync(ctx))
+		}
+
+		vector, err := adminCli.GetDocumentVersionVector(ctx, project.Name, docKey)
+		assert.NoError(t, err)
+		assert.Len(t, vector, 1)
+		assert.GreaterOrEqual(t, vector.Get(cli.ID()), helper.SnapshotThreshold+3)
+
+		assert.NoError(t, cli.Detach(ctx, doc))
+	})
+	t.Run("rollback document test", func(t *testing.T) {
+		ctx := context.Background()
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		docKey := key.Key(t.Name())
+		doc := document.New(docKey)
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		// 01. Update changes over snapshot threshold to create a snapshot.
+		for i := 0; i <= int(helper.SnapshotThreshold); i++ {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger(fmt.Sprintf("%d", i), i)
+				return nil
+			}))
+		}
+		assert.NoError(t, cli.Sync(ctx))
+		expected := doc.Marshal()
+
+		// NOTE: waiting for snapshot.
+		gotime.Sleep(500 * gotime.Millisecond)
+
+		metas, err := adminCli.ListSnapshotMetas(ctx, project.Name, docKey)
+		assert.NoError(t, err)
+		assert.Len(t, metas, 1)
+		assert.Equal(t, doc.Checkpoint().ServerSeq, metas[0].ServerSeq)
+
+		// 02. Update changes after the snapshot then rollback to the snapshot.
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.Delete("0")
+			root.SetString("after", "snapshot")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+		assert.NotEqual(t, expected, doc.Marshal())
+
+		assert.NoError(t, adminCli.RollbackDocument(ctx, project.Name, docKey, metas[0].ServerSeq))
+		assert.NoError(t, cli.Sync(ctx))
+		assert.Equal(t, expected, doc.Marshal())
+
+		// 03. Rollback to the server seq without snapshot. The changes before
+		// the snapshot were pushed at once, so no snapshot is stored for them.
+		err = adminCli.RollbackDocument(ctx, project.Name, docKey, metas[0].ServerSeq-1)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		assert.NoError(t, cli.Detach(ctx, doc))
+	})
+
+	t.Run("project stats conflict wins test", func(t *testing.T) {
+		ctx := context.Background()
+
+		project, err := adminCli.CreateProject(ctx, "conflict-wins-test")
+		assert.NoError(t, err)
+
+		var clients []*client.Client
+		var docs []*document.Document
+		for i := 0; i < 2; i++ {
+			cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+			assert.NoError(t, err)
+			defer func() { assert.NoError(t, cli.Close()) }()
+			assert.NoError(t, cli.Activate(ctx))
+			defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+			doc := document.New(key.Key(t.Name()))
+			assert.NoError(t, cli.Attach(ctx, doc))
+			clients = append(clients, cli)
+			docs = append(docs, doc)
+		}
+
+		// 01. Set the same key concurrently then sync the clients.
+		for i, doc := range docs {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger("k1", i)
+				return nil
+			}))
+		}
+		assert.NoError(t, clients[0].Sync(ctx))
+		assert.NoError(t, clients[1].Sync(ctx))
+		assert.NoError(t, clients[0].Sync(ctx))
+		assert.Equal(t, docs[0].Marshal(), docs[1].Marshal())
+
+		winner := clients[0]
+		if docs[0].Marshal() == `{"k1":1}` {
+			winner = clients[1]
+		}
+
+		// 02. The conflict is counted once for the actor whose value is exposed.
+		stats, err := adminCli.GetProjectStats(ctx, project.Name)
+		assert.NoError(t, err)
+		assert.Len(t, stats.ConflictWins, 1)
+		assert.Equal(t, string(types.IDFromActorID(winner.ID())), stats.ConflictWins[0].Actor)
+		assert.Equal(t, 1, stats.ConflictWins[0].Wins)
+
+		// 03. Syncing again does not count the conflict again.
+		assert.NoError(t, clients[1].Sync(ctx))
+		stats, err = adminCli.GetProjectStats(ctx, project.Name)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, stats.ConflictWins[0].Wins)
+
+		for i, cli := range clients {
+			assert.NoError(t, cli.Detach(ctx, docs[i]))
+		}
+	})
+
+	t.Run("reset document test", func(t *testing.T) {
+		ctx := context.Background()
+
+		c1, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, c1.Close()) }()
+		assert.NoError(t, c1.Activate(ctx))
+		defer func() { assert.NoError(t, c1.Deactivate(ctx)) }()
+
+		c2, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, c2.Close()) }()
+		assert.NoError(t, c2.Activate(ctx))
+		defer func() { assert.NoError(t, c2.Deactivate(ctx)) }()
+
+		docKey := key.Key(t.Name())
+		d1 := document.New(docKey)
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			root.SetNewArray("k2").AddString("v2")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+
+		d2 := document.New(docKey)
+		assert.NoError(t, c2.Attach(ctx, d2))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		rch, err := c2.Watch(watchCtx, d2)
+		assert.NoError(t, err)
+
+		// 01. Reset the document, then the watchers are notified of it.
+		before, err := adminCli.GetDocument(ctx, project.Name, docKey)
+		assert.NoError(t, err)
+		assert.NoError(t, adminCli.ResetDocument(ctx, project.Name, docKey))
+		resp := <-rch
+		assert.Equal(t, client.DocumentsChanged, resp.Type)
+
+		after, err := adminCli.GetDocument(ctx, project.Name, docKey)
+		assert.NoError(t, err)
+		assert.Equal(t, before.ServerSeq+1, after.ServerSeq)
+		assert.Equal(t, "{}", after.Summary.Snapshot)
+		assert.Equal(t, after.ServerSeq, after.SnapshotServerSeq)
+
+		// 02. The attaching clients converge to the empty document.
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, "{}", d1.Marshal())
+		assert.Equal(t, "{}", d2.Marshal())
+
+		// 03. The empty state is snapshotted for the clients attaching later.
+		metas, err := adminCli.ListSnapshotMetas(ctx, project.Name, docKey)
+		assert.NoError(t, err)
+		assert.Equal(t, after.ServerSeq, metas[0].ServerSeq)
+
+		d3 := document.New(docKey)
+		assert.NoError(t, c2.Detach(ctx, d2))
+		assert.NoError(t, c2.Attach(ctx, d3))
+		assert.Equal(t, "{}", d3.Marshal())
+
+		// 04. The document keeps working after the reset.
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k3", "v3")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, `{"k3":"v3"}`, d3.Marshal())
+
+		assert.NoError(t, c1.Detach(ctx, d1))
+		assert.NoError(t, c2.Detach(ctx, d3))
+	})
+
+	t.Run("validate document test", func(t *testing.T) {
+		ctx := context.Background()
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		docKey := key.Key(t.Name())
+		doc := document.New(docKey)
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		// 01. The document without snapshot can not be validated.
+		_, err = adminCli.ValidateDocument(ctx, project.Name, docKey)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		// 02. Update changes over snapshot threshold to create a snapshot.
+		for i := 0; i <= int(helper.SnapshotThreshold); i++ {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetNewArray("list").AddInteger(i)
+				root.SetInteger(fmt.Sprintf("%d", i), i)
+				return nil
+			}))
+		}
+		assert.NoError(t, cli.Sync(ctx))
+
+		// NOTE: waiting for snapshot.
+		gotime.Sleep(500 * gotime.Millisecond)
+
+		validation, err := adminCli.ValidateDocument(ctx, project.Name, docKey)
+		assert.NoError(t, err)
+		assert.True(t, validation.Valid)
+		assert.Equal(t, doc.Checkpoint().ServerSeq, validation.ServerSeq)
+		assert.Equal(t, validation.ExpectedChecksum, validation.ActualChecksum)
+		assert.Empty(t, validation.DivergingPath)
+
+		assert.NoError(t, cli.Detach(ctx, doc))
+	})
+
+	t.Run("analyze document test", func(t *testing.T) {
+		ctx := context.Background()
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		docKey := key.Key(t.Name())
+		doc := document.New(docKey)
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("list").AddString("a", "b", "c", "d")
+			root.SetNewObject("large").SetString("k1", strings.Repeat("v", 1024))
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("list").Delete(0)
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+
+		// 01. The top-level subtrees are reported in descending order of bytes.
+		analysis, err := adminCli.AnalyzeDocument(ctx, project.Name, docKey, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, doc.Checkpoint().ServerSeq, analysis.ServerSeq)
+		assert.Len(t, analysis.Subtrees, 3)
+		assert.Equal(t, "$", analysis.Subtrees[0].Path)
+		assert.Equal(t, "$.large", analysis.Subtrees[1].Path)
+		assert.Equal(t, "$.list", analysis.Subtrees[2].Path)
+		assert.Positive(t, analysis.Subtrees[2].TombstoneBytes)
+
+		// 02. The deeper subtrees are reported with the depth.
+		analysis, err = adminCli.AnalyzeDocument(ctx, project.Name, docKey, 2)
+		assert.NoError(t, err)
+		assert.Len(t, analysis.Subtrees, 7)
+
+		assert.NoError(t, cli.Detach(ctx, doc))
+	})
+
+	t.Run("diff document test", func(t *testing.T) {
+		ctx := context.Background()
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		docKey := key.Key(t.Name())
+		doc := document.New(docKey)
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("list").AddString("a", "b", "c", "d")
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+		from, before := doc.Checkpoint().ServerSeq, doc.Marshal()
+
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			list := root.GetArray("list")
+			list.MoveBefore(list.Get(0).CreatedAt(), list.Get(3).CreatedAt())
+			list.Delete(2)
+			list.AddString("e")
+			root.Delete("k1")
+			root.SetNewObject("k2").SetInteger("k3", 3)
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+		to := doc.Checkpoint().ServerSeq
+
+		patch, err := adminCli.DiffDocument(ctx, project.Name, docKey, from, to)
+		assert.NoError(t, err)
+		result, err := patch.Apply([]byte(before))
+		assert.NoError(t, err)
+		assert.JSONEq(t, doc.Marshal(), string(result))
+
+		patch, err = adminCli.DiffDocument(ctx, project.Name, docKey, to, to)
+		assert.NoError(t, err)
+		assert.Empty(t, patch)
+
+		_, err = adminCli.DiffDocument(ctx, project.Name, docKey, to, from)
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+		_, err = adminCli.DiffDocument(ctx, project.Name, docKey, from, to+1)
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+
+		assert.NoError(t, cli.Detach(ctx, doc))
+	})
+
+	t.Run("lock document test", func(t *testing.T) {
+		ctx := context.Background()
+
+		cli1, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli1.Close()) }()
+		assert.NoError(t, cli1.Activate(ctx))
+		defer func() { assert.NoError(t, cli1.Deactivate(ctx)) }()
+
+		cli2, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli2.Close()) }()
+		assert.NoError(t, cli2.Activate(ctx))
+		defer func() { assert.NoError(t, cli2.Deactivate(ctx)) }()
+
+		docKey := key.Key(t.Name())
+		d1 := document.New(docKey)
+		assert.NoError(t, cli1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, cli1.Sync(ctx))
+
+		// 01. pushing changes to the locked document is rejected with the reason.
+		assert.NoError(t, adminCli.LockDocument(ctx, project.Name, docKey, "maintenance"))
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		err = cli1.Sync(ctx)
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+		assert.Contains(t, status.Convert(err).Message(), "maintenance")
+
+		// 02. the locked document can still be read.
+		d2 := document.New(docKey)
+		assert.NoError(t, cli2.Attach(ctx, d2))
+		assert.NoError(t, cli2.Sync(ctx))
+		assert.Equal(t, `{"k1":"v1"}`, d2.Marshal())
+
+		// 03. the pending changes are pushed after unlocking.
+		assert.NoError(t, adminCli.UnlockDocument(ctx, project.Name, docKey))
+		assert.NoError(t, cli1.Sync(ctx))
+		assert.NoError(t, cli2.Sync(ctx))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+
+		assert.NoError(t, cli1.Detach(ctx, d1))
+		assert.NoError(t, cli2.Detach(ctx, d2))
+	})
+
+	t.Run("stream documents test", func(t *testing.T) {
+		ctx := context.Background()
+		streamProject, err := adminCli.CreateProject(ctx, "stream-test")
+		assert.NoError(t, err)
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(streamProject.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		// NOTE: more documents than a batch of the stream are created.
+		const docCount = 120
+		for i := 0; i < docCount; i++ {
+			doc := document.New(key.Key(fmt.Sprintf("%s-%03d", t.Name(), i)))
+			assert.NoError(t, cli.Attach(ctx, doc))
+			assert.NoError(t, cli.Detach(ctx, doc))
+		}
+
+		var keys []key.Key
+		assert.NoError(t, adminCli.StreamDocuments(ctx, streamProject.Name, func(summary *types.DocumentSummary) error {
+			keys = append(keys, summary.Key)
+			return nil
+		}))
+		assert.Len(t, keys, docCount)
+		assert.Equal(t, key.Key(fmt.Sprintf("%s-%03d", t.Name(), 0)), keys[0])
+		assert.Equal(t, key.Key(fmt.Sprintf("%s-%03d", t.Name(), docCount-1)), keys[docCount-1])
+
+		// the stream stops in the middle when the function returns an error.
+		errStop := errors.New("stop")
+		received := 0
+		err = adminCli.StreamDocuments(ctx, streamProject.Name, func(summary *types.DocumentSummary) error {
+			received++
+			if received == 10 {
+				return errStop
+			}
+			return nil
+		})
+		assert.ErrorIs(t, err, errStop)
+		assert.Equal(t, 10, received)
+	})
+
+	t.Run("document metadata test", func(t *testing.T) {
+		ctx := context.Background()
+		metaProject, err := adminCli.CreateProject(ctx, "metadata-project")
+		assert.NoError(t, err)
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(metaProject.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		d1, d2 := document.New("doc-1"), document.New("doc-2")
+		assert.NoError(t, cli.Attach(ctx, d1))
+		assert.NoError(t, cli.Attach(ctx, d2))
+
+		// 01. Set metadata and list the documents with it.
+		metadata := map[string]string{"owner": "alice", "category": "memo"}
+		assert.NoError(t, adminCli.SetDocumentMetadata(ctx, metaProject.Name, d1.Key(), metadata))
+		summaries, _, err := adminCli.ListDocuments(
+			ctx,
+			metaProject.Name,
+			"",
+			10,
+			true,
+			gotime.Time{},
+			map[string]string{"owner": "alice"},
+		)
+		assert.NoError(t, err)
+		assert.Len(t, summaries, 1)
+		assert.Equal(t, d1.Key(), summaries[0].Key)
+		assert.Equal(t, metadata, summaries[0].Metadata)
+
+		// 02. The last update wins and empty metadata clears it.
+		assert.NoError(t, adminCli.SetDocumentMetadata(ctx, metaProject.Name, d1.Key(), nil))
+		summaries, _, err = adminCli.ListDocuments(
+			ctx,
+			metaProject.Name,
+			"",
+			10,
+			true,
+			gotime.Time{},
+			map[string]string{"owner": "alice"},
+		)
+		assert.NoError(t, err)
+		assert.Len(t, summaries, 0)
+
+		// 03. Filtering by the metadata key which is not indexed is rejected.
+		_, _, err = adminCli.ListDocuments(
+			ctx,
+			metaProject.Name,
+			"",
+			10,
+			true,
+			gotime.Time{},
+			map[string]string{"category": "memo"},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		// 04. Invalid metadata is rejected.
+		err = adminCli.SetDocumentMetadata(ctx, metaProject.Name, d2.Key(), map[string]string{
+			"owner.name": "alice",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		assert.NoError(t, cli.Detach(ctx, d1))
+		assert.NoError(t, cli.Detach(ctx, d2))
+	})
+
+	t.Run("document gc interval test", func(t *testing.T) {
+		ctx := context.Background()
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, cli.Attach(ctx, d1))
+
+		// 01. The interval of housekeeping is effective by default.
+		detail, err := adminCli.GetDocument(ctx, project.Name, d1.Key())
+		assert.NoError(t, err)
+		assert.Equal(t, helper.HousekeepingInterval, detail.GCInterval)
+
+		// 02. The interval in the metadata overrides it.
+		assert.NoError(t, adminCli.SetDocumentMetadata(ctx, project.Name, d1.Key(), map[string]string{
+			types.DocumentGCIntervalMetadataKey: "30s",
+		}))
+		detail, err = adminCli.GetDocument(ctx, project.Name, d1.Key())
+		assert.NoError(t, err)
+		assert.Equal(t, 30*gotime.Second, detail.GCInterval)
+
+		// 03. The interval out of the bounds is rejected.
+		for _, interval := range []string{"1s", "48h", "fast"} {
+			err = adminCli.SetDocumentMetadata(ctx, project.Name, d1.Key(), map[string]string{
+				types.DocumentGCIntervalMetadataKey: interval,
+			})
+			assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code(), interval)
+		}
+
+		assert.NoError(t, cli.Detach(ctx, d1))
+	})
+
+	t.Run("move document test", func(t *testing.T) {
+		ctx := context.Background()
+		target, err := adminCli.CreateProject(ctx, "move-target")
+		assert.NoError(t, err)
+
+		cli1, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli1.Close()) }()
+		assert.NoError(t, cli1.Activate(ctx))
+		defer func() { assert.NoError(t, cli1.Deactivate(ctx)) }()
+
+		docKey := key.Key(t.Name())
+		d1 := document.New(docKey)
+		assert.NoError(t, cli1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, cli1.Sync(ctx))
+
+		// 01. the document is moved and no longer found by the client of the
+		// source project.
+		err = adminCli.MoveDocument(ctx, project.Name, docKey, project.Name)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+		assert.NoError(t, adminCli.MoveDocument(ctx, project.Name, docKey, target.Name))
+		err = cli1.Sync(ctx)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		// 02. the client of the target project attaches the moved document.
+		cli2, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(target.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli2.Close()) }()
+		assert.NoError(t, cli2.Activate(ctx))
+		defer func() { assert.NoError(t, cli2.Deactivate(ctx)) }()
+
+		d2 := document.New(docKey)
+		assert.NoError(t, cli2.Attach(ctx, d2))
+		assert.Equal(t, `{"k1":"v1"}`, d2.Marshal())
+		assert.NoError(t, d2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		assert.NoError(t, cli2.Sync(ctx))
+
+		// 03. the document is not moved if the key exists in the target.
+		d3 := document.New(docKey)
+		assert.NoError(t, cli1.Attach(ctx, d3))
+		err = adminCli.MoveDocument(ctx, project.Name, docKey, target.Name)
+		assert.Equal(t, codes.AlreadyExists, status.Convert(err).Code())
+
+		assert.NoError(t, cli1.Detach(ctx, d3))
+		assert.NoError(t, cli2.Detach(ctx, d2))
+	})
+}
@@ -0,0 +1,127 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestAllowedOperations(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli, err := admin.Dial(svr.AdminAddr())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	project, err := adminCli.CreateProject(context.Background(), "allowed-operations-test")
+	assert.NoError(t, err)
+	assert.Empty(t, project.AllowedOperations)
+
+	// NOTE: Each test uses its own client, since the rejected changes remain
+	// in the documents attached by the client.
+	newClient := func(t *testing.T) *client.Client {
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		assert.NoError(t, cli.Activate(context.Background()))
+		return cli
+	}
+
+	t.Run("unknown operation type test", func(t *testing.T) {
+		allowed := []string{string(types.OperationSet), "drop"}
+		_, err := adminCli.UpdateProject(
+			context.Background(),
+			project.ID.String(),
+			&types.UpdatableProjectFields{AllowedOperations: &allowed},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+	})
+
+	t.Run("all operations allowed by default test", func(t *testing.T) {
+		ctx := context.Background()
+		cli := newClient(t)
+		defer func() { assert.NoError(t, cli.Close()) }()
+
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, cli.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			root.Delete("k1")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+		assert.NoError(t, cli.Detach(ctx, d1))
+	})
+
+	allowed := []string{string(types.OperationSet), string(types.OperationEdit)}
+	updated, err := adminCli.UpdateProject(
+		context.Background(),
+		project.ID.String(),
+		&types.UpdatableProjectFields{AllowedOperations: &allowed},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, allowed, updated.AllowedOperations)
+
+	t.Run("allowed operation test", func(t *testing.T) {
+		ctx := context.Background()
+		cli := newClient(t)
+		defer func() { assert.NoError(t, cli.Close()) }()
+
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, cli.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewText("text").Edit(0, 0, "hello")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+		assert.NoError(t, cli.Detach(ctx, d1))
+	})
+
+	t.Run("disallowed operation test", func(t *testing.T) {
+		ctx := context.Background()
+		cli := newClient(t)
+		defer func() { assert.NoError(t, cli.Close()) }()
+
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, cli.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			root.Delete("k1")
+			return nil
+		}))
+		err := cli.Sync(ctx)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+	})
+}
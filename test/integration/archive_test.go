@@ -0,0 +1,146 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestArchiveDocument(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli, err := admin.Dial(svr.AdminAddr())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	t.Run("archive and unarchive inactive document test", func(t *testing.T) {
+		ctx := context.Background()
+		project, err := adminCli.CreateProject(ctx, "archive-test")
+		assert.NoError(t, err)
+
+		eventServer, events := newEventServer(t, project.SecretKey)
+		defer eventServer.Close()
+
+		archiveAfter := "2s"
+		_, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+			EventWebhookURL: &eventServer.URL,
+			ArchiveAfter:    &archiveAfter,
+		})
+		assert.NoError(t, err)
+
+		waitFor := func(eventType types.EventWebhookType) {
+			for {
+				select {
+				case event := <-events:
+					if event.Type == eventType {
+						return
+					}
+				case <-time.After(10 * time.Second):
+					assert.Fail(t, "event webhook is not called", eventType)
+					return
+				}
+			}
+		}
+
+		newClient := func() *client.Client {
+			cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+			assert.NoError(t, err)
+			assert.NoError(t, cli.Activate(ctx))
+			return cli
+		}
+
+		// 01. The document is archived after the period of inactivity.
+		c1 := newClient()
+		defer func() { assert.NoError(t, c1.Close()) }()
+		docKey := key.Key("archive-doc")
+		d1 := document.New(docKey)
+		assert.NoError(t, c1.Attach(ctx, d1))
+		for _, v := range []string{"v0", "v1"} {
+			assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("k1", v)
+				return nil
+			}))
+			assert.NoError(t, c1.Sync(ctx))
+		}
+		waitFor(types.DocumentArchivedEvent)
+
+		detail, err := adminCli.GetDocument(ctx, project.Name, docKey)
+		assert.NoError(t, err)
+		assert.False(t, detail.Summary.ArchivedAt.IsZero())
+		assert.Equal(t, 0, detail.AttachedClients)
+		assert.Equal(t, `{"k1":"v1"}`, detail.Summary.Snapshot)
+
+		// NOTE: The changes removed by archiving can not be counted.
+		_, err = adminCli.GetDocumentSinceCheckpoint(ctx, project.Name, docKey, 1)
+		assert.Equal(t, codes.OutOfRange, status.Convert(err).Code())
+
+		summaries, _, err := adminCli.ListDocuments(ctx, project.Name, "", 10, true, time.Time{}, nil)
+		assert.NoError(t, err)
+		assert.Len(t, summaries, 0)
+
+		// 02. The archived document can not be attached.
+		c2 := newClient()
+		defer func() { assert.NoError(t, c2.Close()) }()
+		err = c2.Attach(ctx, document.New(docKey))
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+
+		// 03. The unarchived document can be attached again from the snapshot.
+		assert.NoError(t, adminCli.UnarchiveDocument(ctx, project.Name, docKey))
+		waitFor(types.DocumentUnarchivedEvent)
+		err = adminCli.UnarchiveDocument(ctx, project.Name, docKey)
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+
+		// NOTE: The versions of the changes removed by archiving can not be
+		// attached.
+		err = c2.Attach(ctx, document.New(docKey), client.WithTargetServerSeq(1))
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+
+		d2 := document.New(docKey)
+		assert.NoError(t, c2.Attach(ctx, d2))
+		assert.Equal(t, `{"k1":"v1"}`, d2.Marshal())
+		assert.NoError(t, d2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.NoError(t, c2.Detach(ctx, d2))
+
+		d3 := document.New(docKey)
+		assert.NoError(t, c1.Attach(ctx, d3))
+		assert.Equal(t, `{"k1":"v1","k2":"v2"}`, d3.Marshal())
+	})
+}
@@ -59,6 +59,24 @@ func newAuthServer(t *testing.T) (*httptest.Server, string) {
 	})), token
 }
 
+func newReadOnlyAuthServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := types.NewAuthWebhookRequest(r.Body)
+		assert.NoError(t, err)
+
+		res := types.AuthWebhookResponse{Allowed: true}
+		for _, attr := range req.Attributes {
+			if attr.HasOperation(types.WriteOperation) {
+				res.Allowed = false
+				res.Reason = "read-only user"
+			}
+		}
+
+		_, err = res.Write(w)
+		assert.NoError(t, err)
+	}))
+}
+
 func newUnavailableAuthServer(t *testing.T, recoveryCnt uint64) *httptest.Server {
 	var retries uint64
 	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -181,6 +199,47 @@ func TestProjectAuthWebhook(t *testing.T) {
 		_, err = cli.Watch(ctx, doc)
 		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
 	})
+
+	t.Run("read-only authorization webhook test", func(t *testing.T) {
+		ctx := context.Background()
+		authServer := newReadOnlyAuthServer(t)
+
+		// project with authorization webhook for all methods
+		project, err := adminCli.CreateProject(ctx, "read-only-auth-webhook-test")
+		assert.NoError(t, err)
+		_, err = adminCli.UpdateProject(
+			ctx,
+			project.ID.String(),
+			&types.UpdatableProjectFields{
+				AuthWebhookURL: &authServer.URL,
+			},
+		)
+		assert.NoError(t, err)
+
+		cli, err := client.Dial(
+			svr.RPCAddr(),
+			client.WithAPIKey(project.PublicKey),
+			client.WithToken(xid.New().String()),
+		)
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		// 01. reading the document and updating the presence are allowed.
+		doc := document.New(key.Key(t.Name()))
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.NoError(t, cli.Sync(ctx))
+		assert.NoError(t, cli.UpdatePresence(ctx, "name", "reader"))
+
+		// 02. writing changes to the document is denied.
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		err = cli.Sync(ctx)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+	})
 }
 
 func TestAuthWebhook(t *testing.T) {
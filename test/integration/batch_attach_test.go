@@ -0,0 +1,103 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+)
+
+func TestBatchAttach(t *testing.T) {
+	clients := activeClients(t, 2)
+	c1, c2 := clients[0], clients[1]
+	defer cleanupClients(t, clients)
+
+	t.Run("attach and detach documents in a batch test", func(t *testing.T) {
+		ctx := context.Background()
+
+		var docs []*document.Document
+		for _, k := range []string{"a", "b", "c"} {
+			docs = append(docs, document.New(key.Key(t.Name()+k)))
+		}
+		assert.NoError(t, docs[0].Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+
+		errs, err := c1.AttachDocuments(ctx, docs)
+		assert.NoError(t, err)
+		assert.Equal(t, []error{nil, nil, nil}, errs)
+		for _, doc := range docs {
+			assert.True(t, doc.IsAttached())
+		}
+
+		d2 := document.New(docs[0].Key())
+		assert.NoError(t, c2.Attach(ctx, d2))
+		assert.Equal(t, `{"k1":"v1"}`, d2.Marshal())
+
+		assert.NoError(t, docs[1].Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		errs, err = c1.DetachDocuments(ctx, docs)
+		assert.NoError(t, err)
+		assert.Equal(t, []error{nil, nil, nil}, errs)
+		for _, doc := range docs {
+			assert.False(t, doc.IsAttached())
+		}
+		assert.NoError(t, c2.Detach(ctx, d2))
+
+		d3 := document.New(docs[1].Key())
+		assert.NoError(t, c2.Attach(ctx, d3))
+		assert.Equal(t, `{"k2":"v2"}`, d3.Marshal())
+		assert.NoError(t, c2.Detach(ctx, d3))
+	})
+
+	t.Run("partial failure of batch attach test", func(t *testing.T) {
+		ctx := context.Background()
+
+		existing := key.Key(t.Name() + "existing")
+		_, err := c1.CreateDocument(ctx, existing)
+		assert.NoError(t, err)
+
+		d1 := document.New(existing)
+		d2 := document.New(key.Key(t.Name() + "missing"))
+		errs, err := c1.AttachDocuments(ctx, []*document.Document{d1, d2}, client.WithCreateIfMissing(false))
+		assert.NoError(t, err)
+		assert.Len(t, errs, 2)
+		assert.NoError(t, errs[0])
+		assert.Equal(t, codes.NotFound, status.Code(errs[1]))
+		assert.True(t, d1.IsAttached())
+		assert.False(t, d2.IsAttached())
+
+		errs, err = c1.DetachDocuments(ctx, []*document.Document{d1, d2})
+		assert.NoError(t, err)
+		assert.NoError(t, errs[0])
+		assert.ErrorIs(t, errs[1], client.ErrDocumentNotAttached)
+	})
+}
@@ -20,11 +20,23 @@ package integration
 
 import (
 	"context"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/test/helper"
 )
 
 func TestClient(t *testing.T) {
@@ -66,4 +78,405 @@ func TestClient(t *testing.T) {
 		assert.NoError(t, err)
 		assert.False(t, cli.IsActive())
 	})
+	t.Run("reactivate deactivated client on attach test", func(t *testing.T) {
+		ctx := context.Background()
+		clients := activeClients(t, 1)
+		cli := clients[0]
+		defer cleanupClients(t, clients)
+
+		d1 := document.New(key.Key(t.Name() + "-1"))
+		assert.NoError(t, cli.Attach(ctx, d1))
+
+		// 01. Deactivate the client on the server side like housekeeping does.
+		conn, err := clientConn()
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, conn.Close())
+		}()
+		_, err = api.NewYorkieClient(conn).DeactivateClient(ctx, &api.DeactivateClientRequest{
+			ClientId: cli.ID().Bytes(),
+		})
+		assert.NoError(t, err)
+
+		// 02. Attach a document immediately. The client is reactivated and the
+		// documents attached before are detached.
+		d2 := document.New(key.Key(t.Name() + "-2"))
+		assert.NoError(t, cli.Attach(ctx, d2))
+		assert.True(t, d2.IsAttached())
+		assert.False(t, d1.IsAttached())
+
+		// 03. The detached document can be attached again to resync.
+		assert.NoError(t, cli.Attach(ctx, d1))
+		assert.True(t, d1.IsAttached())
+		assert.NoError(t, cli.Sync(ctx))
+	})
+
+	t.Run("change pack compression test", func(t *testing.T) {
+		ctx := context.Background()
+
+		// 01. c1 compresses change packs but c2 does not.
+		c1, err := client.Dial(defaultServer.RPCAddr(), client.WithChangePackCompression(true))
+		assert.NoError(t, err)
+		assert.NoError(t, c1.Activate(ctx))
+		c2, err := client.Dial(defaultServer.RPCAddr())
+		assert.NoError(t, err)
+		assert.NoError(t, c2.Activate(ctx))
+		defer cleanupClients(t, []*client.Client{c1, c2})
+
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		// 02. Both clients can exchange changes with each other.
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewText("k1").Edit(0, 0, strings.Repeat("compressed ", 100))
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k2", "uncompressed")
+			return nil
+		}))
+
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+	})
+
+	t.Run("push oversized change pack with stream test", func(t *testing.T) {
+		ctx := context.Background()
+
+		// 01. c1 sends change packs larger than 1KiB with PushChangesStream.
+		c1, err := client.Dial(defaultServer.RPCAddr(), client.WithMaxChangePackBytes(1024))
+		assert.NoError(t, err)
+		assert.NoError(t, c1.Activate(ctx))
+		c2, err := client.Dial(defaultServer.RPCAddr())
+		assert.NoError(t, err)
+		assert.NoError(t, c2.Activate(ctx))
+		defer cleanupClients(t, []*client.Client{c1, c2})
+
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		// 02. The change pack is split into multiple chunks.
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewText("k1").Edit(0, 0, strings.Repeat("a", 5*1024*1024/2))
+			return nil
+		}))
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+	})
+
+	t.Run("reject oversized change pack with PushPull test", func(t *testing.T) {
+		ctx := context.Background()
+
+		// 01. c1 sends change packs with PushPull regardless of the size.
+		c1, err := client.Dial(defaultServer.RPCAddr(), client.WithMaxChangePackBytes(8*1024*1024))
+		assert.NoError(t, err)
+		assert.NoError(t, c1.Activate(ctx))
+		defer cleanupClients(t, []*client.Client{c1})
+
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c1.Attach(ctx, d1))
+
+		// 02. The server rejects the change pack over the limit of PushPull.
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewText("k1").Edit(0, 0, strings.Repeat("a", 7*1024*1024/2))
+			return nil
+		}))
+		err = c1.Sync(ctx)
+		assert.Equal(t, codes.ResourceExhausted, status.Convert(err).Code())
+		assert.Contains(t, err.Error(), "PushChangesStream")
+	})
+
+	t.Run("reject streams over the limit per connection test", func(t *testing.T) {
+		ctx := context.Background()
+
+		clients := activeClients(t, 1)
+		c1 := clients[0]
+		defer cleanupClients(t, clients)
+
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c1.Attach(ctx, d1))
+
+		// 01. Open streams up to the limit on the connection of c1.
+		var cancels []context.CancelFunc
+		for i := 0; i < int(helper.RPCMaxConcurrentStreamsPerConn); i++ {
+			watchCtx, cancel := context.WithCancel(ctx)
+			cancels = append(cancels, cancel)
+			_, err := c1.Watch(watchCtx, d1)
+			assert.NoError(t, err)
+		}
+
+		// 02. The stream over the limit is rejected.
+		_, err := c1.Watch(ctx, d1)
+		assert.Equal(t, codes.ResourceExhausted, status.Convert(err).Code())
+
+		// 03. A new stream is accepted after closing one of the streams.
+		cancels[0]()
+		assert.Eventually(t, func() bool {
+			watchCtx, cancel := context.WithCancel(ctx)
+			cancels = append(cancels, cancel)
+			_, err := c1.Watch(watchCtx, d1)
+			return err == nil
+		}, time.Second, 50*time.Millisecond)
+
+		for _, cancel := range cancels {
+			cancel()
+		}
+	})
+
+	t.Run("read-only attachment test", func(t *testing.T) {
+		ctx := context.Background()
+
+		clients := activeClients(t, 2)
+		c1, c2 := clients[0], clients[1]
+		defer cleanupClients(t, clients)
+
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c1.Attach(ctx, d1))
+
+		d2 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c2.Attach(ctx, d2, client.WithReadOnly()))
+		assert.True(t, c2.IsReadOnly(d2.Key()))
+
+		// 01. The read-only client receives changes of other clients.
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		rch, err := c2.Watch(watchCtx, d2)
+		assert.NoError(t, err)
+		go func() {
+			defer wg.Done()
+			for resp := range rch {
+				if resp.Type == client.DocumentsChanged {
+					assert.NoError(t, c2.Sync(ctx, resp.Keys...))
+					return
+				}
+			}
+		}()
+
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		wg.Wait()
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+
+		// 02. The read-only client cannot push changes.
+		assert.NoError(t, d2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		err = c2.Sync(ctx)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+	})
+
+	t.Run("attach at past version test", func(t *testing.T) {
+		ctx := context.Background()
+
+		clients := activeClients(t, 2)
+		c1, c2 := clients[0], clients[1]
+		defer cleanupClients(t, clients)
+
+		// 01. Store changes beyond the snapshot threshold to materialize the
+		// past versions from the snapshot and the changes after it.
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		versions := make(map[uint64]string)
+		for i := 0; i < int(helper.SnapshotThreshold)+3; i++ {
+			assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger("k1", i)
+				return nil
+			}))
+			assert.NoError(t, c1.Sync(ctx))
+			versions[d1.Checkpoint().ServerSeq] = d1.Marshal()
+		}
+
+		for _, serverSeq := range []uint64{2, helper.SnapshotThreshold + 2} {
+			d2 := document.New(key.Key(t.Name()))
+			assert.NoError(t, c2.Attach(ctx, d2, client.WithTargetServerSeq(serverSeq)))
+			assert.True(t, c2.IsReadOnly(d2.Key()))
+			assert.Equal(t, versions[serverSeq], d2.Marshal())
+
+			// 02. The document attached at a past version is neither
+			// synchronized nor watched.
+			assert.NoError(t, c2.Sync(ctx))
+			assert.ErrorIs(t, c2.Sync(ctx, d2.Key()), client.ErrDocumentAttachedAtPastVersion)
+			_, err := c2.Watch(ctx, d2)
+			assert.ErrorIs(t, err, client.ErrDocumentAttachedAtPastVersion)
+			assert.Equal(t, versions[serverSeq], d2.Marshal())
+
+			assert.NoError(t, c2.Detach(ctx, d2))
+		}
+
+		// 03. The version after the latest one can not be attached.
+		err := c2.Attach(ctx, document.New(key.Key(t.Name())), client.WithTargetServerSeq(100))
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+
+		// 04. The changes can not be pushed to a past version.
+		d3 := document.New(key.Key(t.Name()))
+		assert.NoError(t, d3.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		err = c2.Attach(ctx, d3, client.WithTargetServerSeq(2))
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+	})
+
+	t.Run("ignore duplicate change submission test", func(t *testing.T) {
+		ctx := context.Background()
+		clients := activeClients(t, 2)
+		c1, c2 := clients[0], clients[1]
+		defer cleanupClients(t, clients)
+
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewCounter("cnt", 0)
+			return nil
+		}))
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+
+		// 01. Push the same change twice as if the client retried after losing
+		// the response of the first request.
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.GetCounter("cnt").Increase(1)
+			return nil
+		}))
+		pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+
+		conn, err := clientConn()
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, conn.Close())
+		}()
+		for i := 0; i < 2; i++ {
+			_, err = api.NewYorkieClient(conn).PushPull(ctx, &api.PushPullRequest{
+				ClientId:   c1.ID().Bytes(),
+				ChangePack: pbPack,
+			})
+			assert.NoError(t, err)
+		}
+
+		// 02. The change is applied only once, even though the client pushes
+		// it once more with its own sync.
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+		assert.Equal(t, `{"cnt":1}`, d2.Marshal())
+	})
+
+	t.Run("reject change pack with all violations test", func(t *testing.T) {
+		ctx := context.Background()
+		clients := activeClients(t, 2)
+		c1, c2 := clients[0], clients[1]
+		defer cleanupClients(t, clients)
+
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewCounter("cnt", 0)
+			return nil
+		}))
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+
+		// 01. Push a pack with a non-numeric increase, a change of another
+		// actor and a gap of ClientSeq.
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.GetCounter("cnt").Increase(1)
+			return nil
+		}))
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+		assert.Len(t, pbPack.Changes, 2)
+		value := pbPack.Changes[0].Operations[0].GetIncrease().Value
+		value.Type = api.ValueType_STRING
+		value.Value = []byte("1")
+		pbPack.Changes[1].Id.ActorId = c2.ID().Bytes()
+		pbPack.Changes[1].Id.ClientSeq++
+
+		conn, err := clientConn()
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, conn.Close())
+		}()
+		_, err = api.NewYorkieClient(conn).PushPull(ctx, &api.PushPullRequest{
+			ClientId:   c1.ID().Bytes(),
+			ChangePack: pbPack,
+		})
+
+		// 02. All the violations are reported at once.
+		st := status.Convert(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Len(t, st.Details(), 1)
+		var fields []string
+		for _, violation := range st.Details()[0].(*errdetails.BadRequest).FieldViolations {
+			fields = append(fields, violation.Field)
+		}
+		assert.Equal(t, []string{
+			"changes[0].operations[0].value",
+			"changes[1].id.actor_id",
+			"changes[1].id.client_seq",
+		}, fields)
+
+		// 03. Nothing of the pack is stored, and the valid changes of the
+		// client are pushed afterwards.
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, `{"cnt":0}`, d2.Marshal())
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+		assert.Equal(t, `{"cnt":1,"k1":"v1"}`, d2.Marshal())
+	})
+
+	t.Run("reject change with inconsistent timestamps test", func(t *testing.T) {
+		ctx := context.Background()
+		clients := activeClients(t, 1)
+		c1 := clients[0]
+		defer cleanupClients(t, clients)
+
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c1.Attach(ctx, d1))
+
+		// 01. Push a change whose operation is executed at another lamport.
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+		assert.Len(t, pbPack.Changes, 1)
+		pbPack.Changes[0].Operations[1].GetSet().ExecutedAt.Lamport++
+
+		conn, err := clientConn()
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, conn.Close())
+		}()
+		_, err = api.NewYorkieClient(conn).PushPull(ctx, &api.PushPullRequest{
+			ClientId:   c1.ID().Bytes(),
+			ChangePack: pbPack,
+		})
+
+		// 02. The operation of the inconsistent timestamp is reported.
+		st := status.Convert(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Len(t, st.Details(), 1)
+		violations := st.Details()[0].(*errdetails.BadRequest).FieldViolations
+		assert.Len(t, violations, 1)
+		assert.Equal(t, "changes[0].operations[1].executed_at", violations[0].Field)
+
+		// 03. The original change is pushed afterwards.
+		assert.NoError(t, c1.Sync(ctx))
+	})
 }
@@ -27,9 +27,13 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/internal/version"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/proxy"
@@ -113,6 +117,30 @@ func TestClusterMode(t *testing.T) {
 		assert.Len(t, defaultServer.Members(), 1)
 	})
 
+	t.Run("list members rpc test", func(t *testing.T) {
+		ctx := context.Background()
+		svr := helper.TestServer()
+		assert.NoError(t, svr.Start())
+		defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+		time.Sleep(100 * time.Millisecond)
+
+		conn, err := grpc.Dial(svr.AdminAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, conn.Close()) }()
+
+		resp, err := api.NewClusterClient(conn).ListMembers(ctx, &api.ListMembersRequest{})
+		assert.NoError(t, err)
+
+		var ids []string
+		for _, member := range resp.Members {
+			ids = append(ids, member.Id)
+			assert.Equal(t, version.Version, member.Version)
+			assert.NotEmpty(t, member.ClusterAddr)
+			assert.NotNil(t, member.UpdatedAt)
+		}
+		assert.Equal(t, keysFromServers(svr.Members()), ids)
+	})
+
 	t.Run("watch document across servers test", func(t *testing.T) {
 		withTwoClientsAndDocsInClusterMode(t, func(
 			t *testing.T,
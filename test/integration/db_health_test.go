@@ -0,0 +1,151 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+// dbProxy is a TCP proxy in front of the database, which can drop and restore
+// the connections to simulate the loss of the database.
+type dbProxy struct {
+	addr   string
+	target string
+
+	mu       sync.Mutex
+	listener net.Listener
+	conns    []net.Conn
+}
+
+// start starts accepting the connections on the address of the proxy.
+func (p *dbProxy) start(t *testing.T) {
+	addr := p.addr
+	if addr == "" {
+		addr = "127.0.0.1:0"
+	}
+	listener, err := net.Listen("tcp", addr)
+	assert.NoError(t, err)
+	p.addr = listener.Addr().String()
+
+	p.mu.Lock()
+	p.listener = listener
+	p.mu.Unlock()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			upstream, err := net.Dial("tcp", p.target)
+			if err != nil {
+				_ = conn.Close()
+				continue
+			}
+
+			p.mu.Lock()
+			p.conns = append(p.conns, conn, upstream)
+			p.mu.Unlock()
+
+			go func() { _, _ = io.Copy(upstream, conn) }()
+			go func() { _, _ = io.Copy(conn, upstream) }()
+		}
+	}()
+}
+
+// stop closes the listener and all the connections of the proxy.
+func (p *dbProxy) stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_ = p.listener.Close()
+	for _, conn := range p.conns {
+		_ = conn.Close()
+	}
+	p.conns = nil
+}
+
+func TestDBHealth(t *testing.T) {
+	t.Run("reconnect to database after connection loss test", func(t *testing.T) {
+		ctx := context.Background()
+		proxy := &dbProxy{target: "localhost:27017"}
+		proxy.start(t)
+		defer proxy.stop()
+
+		conf := helper.TestConfig()
+		conf.Mongo.ConnectionURI = "mongodb://" + proxy.addr + "/?directConnection=true"
+		conf.Mongo.ConnectionTimeout = "1s"
+		conf.Mongo.PingTimeout = "1s"
+		svr, err := server.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, svr.Start())
+		defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+		conn, err := grpc.Dial(svr.RPCAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, conn.Close()) }()
+		healthCli := healthpb.NewHealthClient(conn)
+		servingStatus := func() healthpb.HealthCheckResponse_ServingStatus {
+			resp, err := healthCli.Check(ctx, &healthpb.HealthCheckRequest{})
+			assert.NoError(t, err)
+			return resp.Status
+		}
+
+		cli, err := client.Dial(svr.RPCAddr())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		assert.NoError(t, cli.Deactivate(ctx))
+
+		// 01. Requests fail fast with Unavailable while the database is lost.
+		proxy.stop()
+		assert.Eventually(t, func() bool {
+			return servingStatus() == healthpb.HealthCheckResponse_NOT_SERVING
+		}, 10*time.Second, 100*time.Millisecond)
+
+		start := time.Now()
+		err = cli.Activate(ctx)
+		assert.Equal(t, codes.Unavailable, status.Convert(err).Code())
+		assert.Less(t, time.Since(start), time.Second)
+
+		// 02. The server reconnects to the database once it comes back.
+		proxy.start(t)
+		assert.Eventually(t, func() bool {
+			return servingStatus() == healthpb.HealthCheckResponse_SERVING
+		}, 10*time.Second, 100*time.Millisecond)
+		assert.NoError(t, cli.Activate(ctx))
+		assert.NoError(t, cli.Deactivate(ctx))
+	})
+}
@@ -0,0 +1,120 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/server/packs"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestDocumentEventLog(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli, err := admin.Dial(svr.AdminAddr())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	project, err := adminCli.CreateProject(context.Background(), "doc-event-log-test")
+	assert.NoError(t, err)
+
+	t.Run("list event logs of document test", func(t *testing.T) {
+		ctx := context.Background()
+
+		newClient := func() *client.Client {
+			cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+			assert.NoError(t, err)
+			assert.NoError(t, cli.Activate(ctx))
+			return cli
+		}
+		c1, c2 := newClient(), newClient()
+		defer func() { assert.NoError(t, c1.Close()) }()
+		defer func() { assert.NoError(t, c2.Close()) }()
+
+		docKey := key.Key(t.Name())
+		d1, d2 := document.New(docKey), document.New(docKey)
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		// 01. Push the changes of the clients in turn while computing the
+		// fingerprints of the changes in the order they are pushed.
+		var fingerprints []string
+		var opCounts []int
+		push := func(cli *client.Client, doc *document.Document, ops int) {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				for i := 0; i < ops; i++ {
+					root.SetInteger("k", i)
+				}
+				return nil
+			}))
+
+			prev := ""
+			if len(fingerprints) > 0 {
+				prev = fingerprints[len(fingerprints)-1]
+			}
+			fingerprint, err := packs.Fingerprint(prev, doc.CreateChangePack().Changes[0])
+			assert.NoError(t, err)
+			fingerprints = append(fingerprints, fingerprint)
+			opCounts = append(opCounts, ops)
+
+			assert.NoError(t, cli.Sync(ctx))
+		}
+		push(c1, d1, 1)
+		push(c2, d2, 2)
+		push(c1, d1, 3)
+		assert.NoError(t, c2.Sync(ctx))
+
+		logs, err := adminCli.ListDocumentEventLogs(ctx, project.Name, docKey, 0, 0)
+		assert.NoError(t, err)
+		assert.Len(t, logs, 3)
+		for i, log := range logs {
+			assert.Equal(t, uint64(i+1), log.ServerSeq)
+			assert.Equal(t, opCounts[i], log.OperationCount)
+			assert.Equal(t, fingerprints[i], log.Fingerprint)
+			actorID, err := log.ActorID.ToActorID()
+			assert.NoError(t, err)
+			assert.Equal(t, log.Lamport, log.VersionVector.Get(actorID))
+		}
+		assert.Equal(t, types.IDFromActorID(c1.ID()), logs[0].ActorID)
+		assert.Equal(t, types.IDFromActorID(c2.ID()), logs[1].ActorID)
+		assert.Len(t, logs[0].VersionVector, 1)
+		assert.Len(t, logs[2].VersionVector, 2)
+
+		// 02. The entries can be limited to the range of the server sequences.
+		logs, err = adminCli.ListDocumentEventLogs(ctx, project.Name, docKey, 2, 2)
+		assert.NoError(t, err)
+		assert.Len(t, logs, 1)
+		assert.Equal(t, uint64(2), logs[0].ServerSeq)
+		assert.Equal(t, fingerprints[1], logs[0].Fingerprint)
+	})
+}
@@ -0,0 +1,119 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestDocumentCreation(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli, err := admin.Dial(svr.AdminAddr())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	project, err := adminCli.CreateProject(context.Background(), "document-creation-test")
+	assert.NoError(t, err)
+
+	cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+	assert.NoError(t, err)
+	assert.NoError(t, cli.Activate(context.Background()))
+	defer cleanupClients(t, []*client.Client{cli})
+
+	t.Run("lazy creation test", func(t *testing.T) {
+		ctx := context.Background()
+
+		doc := document.New(key.Key(t.Name()))
+		err := cli.Attach(ctx, doc, client.WithCreateIfMissing(false))
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.NoError(t, cli.Detach(ctx, doc))
+	})
+
+	t.Run("create existing document test", func(t *testing.T) {
+		ctx := context.Background()
+
+		summary, err := adminCli.CreateDocument(ctx, project.Name, key.Key(t.Name()))
+		assert.NoError(t, err)
+		assert.Equal(t, key.Key(t.Name()), summary.Key)
+		assert.Equal(t, "{}", summary.Snapshot)
+
+		_, err = adminCli.CreateDocument(ctx, project.Name, key.Key(t.Name()))
+		assert.Equal(t, codes.AlreadyExists, status.Convert(err).Code())
+
+		_, err = cli.CreateDocument(ctx, key.Key(t.Name()))
+		assert.Equal(t, codes.AlreadyExists, status.Convert(err).Code())
+	})
+
+	t.Run("explicit creation test", func(t *testing.T) {
+		ctx := context.Background()
+
+		explicit := true
+		updated, err := adminCli.UpdateProject(
+			ctx,
+			project.ID.String(),
+			&types.UpdatableProjectFields{ExplicitDocumentCreation: &explicit},
+		)
+		assert.NoError(t, err)
+		assert.True(t, updated.ExplicitDocumentCreation)
+		defer func() {
+			explicit = false
+			_, err := adminCli.UpdateProject(
+				ctx,
+				project.ID.String(),
+				&types.UpdatableProjectFields{ExplicitDocumentCreation: &explicit},
+			)
+			assert.NoError(t, err)
+		}()
+
+		doc := document.New(key.Key(t.Name()))
+		err = cli.Attach(ctx, doc)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		docID, err := cli.CreateDocument(ctx, key.Key(t.Name()))
+		assert.NoError(t, err)
+		assert.NotEmpty(t, docID)
+
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.NoError(t, cli.Detach(ctx, doc))
+
+		// NOTE: The request can still create the document lazily.
+		other := document.New(key.Key(t.Name() + "-other"))
+		assert.NoError(t, cli.Attach(ctx, other, client.WithCreateIfMissing(true)))
+		assert.NoError(t, cli.Detach(ctx, other))
+	})
+}
@@ -0,0 +1,101 @@
+//go:build integration
+
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestDocumentKeyPolicy(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli, err := admin.Dial(svr.AdminAddr())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	project, err := adminCli.CreateProject(context.Background(), "key-policy-test")
+	assert.NoError(t, err)
+	assert.True(t, project.DocumentKeyPolicy.IsEmpty())
+
+	t.Run("attach with document key policy test", func(t *testing.T) {
+		ctx := context.Background()
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		// document created before the policy is applied.
+		legacyDoc := document.New(key.Key("Legacy Doc"))
+		assert.NoError(t, cli.Attach(ctx, legacyDoc))
+		assert.NoError(t, cli.Detach(ctx, legacyDoc))
+
+		updated, err := adminCli.UpdateProject(
+			ctx,
+			project.ID.String(),
+			&types.UpdatableProjectFields{
+				DocumentKeyPolicy: &types.DocumentKeyPolicy{
+					AllowedCharset:     `a-z0-9\-`,
+					MaxLength:          10,
+					DisallowedPrefixes: []string{"sys-"},
+				},
+			},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, 10, updated.DocumentKeyPolicy.MaxLength)
+
+		assert.NoError(t, cli.Attach(ctx, document.New(key.Key("valid-doc"))))
+
+		for _, k := range []string{"has blank", "too-long-document", "sys-doc", "문서"} {
+			err = cli.Attach(ctx, document.New(key.Key(k)))
+			assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code(), k)
+		}
+
+		// documents that already exist are still accessible.
+		assert.NoError(t, cli.Attach(ctx, document.New(key.Key("Legacy Doc"))))
+	})
+
+	t.Run("invalid document key policy test", func(t *testing.T) {
+		_, err := adminCli.UpdateProject(
+			context.Background(),
+			project.ID.String(),
+			&types.UpdatableProjectFields{
+				DocumentKeyPolicy: &types.DocumentKeyPolicy{MaxLength: -1},
+			},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+	})
+}
@@ -0,0 +1,343 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestDocumentLimits(t *testing.T) {
+	activateClients := func(t *testing.T, rpcAddr string, n int) []*client.Client {
+		var clients []*client.Client
+		for i := 0; i < n; i++ {
+			cli, err := client.Dial(rpcAddr)
+			assert.NoError(t, err)
+			assert.NoError(t, cli.Activate(context.Background()))
+			clients = append(clients, cli)
+		}
+		return clients
+	}
+
+	t.Run("max actors per document test", func(t *testing.T) {
+		ctx := context.Background()
+		conf := helper.TestConfig()
+		conf.Backend.MaxActorsPerDocument = 2
+		svr, err := server.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, svr.Start())
+		defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+		clients := activateClients(t, svr.RPCAddr(), 3)
+		defer cleanupClients(t, clients)
+
+		docKey := key.Key(t.Name())
+		assert.NoError(t, clients[0].Attach(ctx, document.New(docKey)))
+		doc := document.New(docKey)
+		assert.NoError(t, clients[1].Attach(ctx, doc))
+		assert.NoError(t, clients[1].Detach(ctx, doc))
+
+		// the actors that have attached the document are kept after detaching.
+		err = clients[2].Attach(ctx, document.New(docKey))
+		assert.Equal(t, codes.ResourceExhausted, status.Convert(err).Code())
+		assert.NoError(t, clients[1].Attach(ctx, document.New(docKey)))
+	})
+
+	t.Run("max version vector size test", func(t *testing.T) {
+		ctx := context.Background()
+		conf := helper.TestConfig()
+		conf.Backend.MaxVersionVectorSize = 2
+		svr, err := server.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, svr.Start())
+		defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+		clients := activateClients(t, svr.RPCAddr(), 3)
+		defer cleanupClients(t, clients)
+
+		docKey := key.Key(t.Name())
+		assert.NoError(t, clients[0].Attach(ctx, document.New(docKey)))
+		doc := document.New(docKey)
+		assert.NoError(t, clients[1].Attach(ctx, doc))
+
+		err = clients[2].Attach(ctx, document.New(docKey))
+		assert.Equal(t, codes.ResourceExhausted, status.Convert(err).Code())
+
+		// the detached client leaves room for a new client.
+		assert.NoError(t, clients[1].Detach(ctx, doc))
+		assert.NoError(t, clients[2].Attach(ctx, document.New(docKey)))
+	})
+
+	t.Run("max value bytes test", func(t *testing.T) {
+		ctx := context.Background()
+		conf := helper.TestConfig()
+		conf.Backend.MaxValueBytes = 8
+		svr, err := server.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, svr.Start())
+		defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+		clients := activateClients(t, svr.RPCAddr(), 2)
+		defer cleanupClients(t, clients)
+
+		docKey := key.Key(t.Name())
+		d1 := document.New(docKey)
+		assert.NoError(t, clients[0].Attach(ctx, d1))
+		d2 := document.New(docKey)
+		assert.NoError(t, clients[1].Attach(ctx, d2))
+
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "12345678")
+			root.SetNewObject("k2").SetString("k3", "123456789")
+			root.SetNewText("k4").Edit(0, 0, "123456789")
+			return nil
+		}))
+
+		// the pack is rejected with all the oversized values.
+		err = clients[0].Sync(ctx)
+		st := status.Convert(err)
+		assert.Equal(t, codes.ResourceExhausted, st.Code())
+		assert.Len(t, st.Details(), 1)
+		br := st.Details()[0].(*errdetails.BadRequest)
+		assert.Len(t, br.FieldViolations, 2)
+		assert.Equal(t, "changes[0].operations[2].value", br.FieldViolations[0].Field)
+		assert.Equal(t, "changes[0].operations[4].content", br.FieldViolations[1].Field)
+
+		assert.NoError(t, clients[1].Sync(ctx))
+		assert.Equal(t, "{}", d2.Marshal())
+	})
+	t.Run("max operations per push test", func(t *testing.T) {
+		ctx := context.Background()
+		conf := helper.TestConfig()
+		conf.Backend.MaxOperationsPerPush = 3
+		svr, err := server.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, svr.Start())
+		defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+		clients := activateClients(t, svr.RPCAddr(), 2)
+		defer cleanupClients(t, clients)
+		c1, c2 := clients[0], clients[1]
+
+		docKey := key.Key(t.Name())
+		d1 := document.New(docKey)
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(docKey)
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		// 01. c1 replays a backlog of 5 changes with an operation each.
+		for i := 0; i < 5; i++ {
+			assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger(fmt.Sprintf("k%d", i), i)
+				return nil
+			}))
+		}
+		pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+
+		conn, err := grpc.Dial(svr.RPCAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, conn.Close()) }()
+		resp, err := api.NewYorkieClient(conn).PushPull(ctx, &api.PushPullRequest{
+			ClientId:   c1.ID().Bytes(),
+			ChangePack: pbPack,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(2), resp.ChangePack.DeferredChanges)
+		assert.Equal(t, uint32(3), resp.ChangePack.Checkpoint.ClientSeq)
+
+		// 02. c2 makes progress between the syncs of c1.
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, `{"k0":0,"k1":1,"k2":2}`, d2.Marshal())
+		assert.NoError(t, d2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("c2", "v")
+			return nil
+		}))
+		assert.NoError(t, c2.Sync(ctx))
+
+		// 03. the deferred changes are pushed by the following syncs in order.
+		assert.NoError(t, c1.Sync(ctx))
+		assert.False(t, d1.HasLocalChanges())
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+		assert.Equal(t, `{"c2":"v","k0":0,"k1":1,"k2":2,"k3":3,"k4":4}`, d1.Marshal())
+
+		// 04. a change with more operations than the limit is pushed alone.
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			for i := 0; i < 5; i++ {
+				root.SetInteger(fmt.Sprintf("k%d", i), i+1)
+			}
+			return nil
+		}))
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger("k5", 5)
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.True(t, d1.HasLocalChanges())
+
+		// 05. the changes of a detaching client are pushed regardless of the limit.
+		assert.NoError(t, c1.Detach(ctx, d1))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, `{"c2":"v","k0":1,"k1":2,"k2":3,"k3":4,"k4":5,"k5":5}`, d2.Marshal())
+	})
+
+	t.Run("max keys per object test", func(t *testing.T) {
+		ctx := context.Background()
+		conf := helper.TestConfig()
+		conf.Backend.MaxKeysPerObject = 3
+		svr, err := server.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, svr.Start())
+		defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+		clients := activateClients(t, svr.RPCAddr(), 2)
+		defer cleanupClients(t, clients)
+
+		docKey := key.Key(t.Name())
+		d1 := document.New(docKey)
+		assert.NoError(t, clients[0].Attach(ctx, d1))
+		d2 := document.New(docKey)
+		assert.NoError(t, clients[1].Attach(ctx, d2))
+
+		// 01. keys up to the limit are accepted.
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewObject("obj")
+			root.SetInteger("k1", 1)
+			root.SetInteger("k2", 2)
+			return nil
+		}))
+		assert.NoError(t, clients[0].Sync(ctx))
+
+		// 02. a key past the limit is rejected.
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger("k1", 10)
+			root.SetInteger("k3", 3)
+			return nil
+		}))
+		err = clients[0].Sync(ctx)
+		st := status.Convert(err)
+		assert.Equal(t, codes.ResourceExhausted, st.Code())
+		assert.Len(t, st.Details(), 1)
+		br := st.Details()[0].(*errdetails.BadRequest)
+		assert.Len(t, br.FieldViolations, 1)
+		assert.Equal(t, "changes[0].operations[1]", br.FieldViolations[0].Field)
+
+		// 03. the removed keys are not counted.
+		assert.NoError(t, clients[1].Sync(ctx))
+		assert.Equal(t, `{"k1":1,"k2":2,"obj":{}}`, d2.Marshal())
+		assert.NoError(t, d2.Update(func(root *proxy.ObjectProxy) error {
+			root.Delete("k1")
+			root.Delete("k2")
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger("k2", 2)
+			root.SetInteger("k3", 3)
+			root.GetObject("obj").SetInteger("k1", 1)
+			return nil
+		}))
+		assert.NoError(t, clients[1].Sync(ctx))
+		assert.Equal(t, `{"k2":2,"k3":3,"obj":{"k1":1}}`, d2.Marshal())
+
+		// 04. the keys of an object created in the same change are counted.
+		assert.NoError(t, d2.Update(func(root *proxy.ObjectProxy) error {
+			nested := root.GetObject("obj").SetNewObject("nested")
+			for i := 0; i < 4; i++ {
+				nested.SetInteger(fmt.Sprintf("k%d", i), i)
+			}
+			return nil
+		}))
+		err = clients[1].Sync(ctx)
+		st = status.Convert(err)
+		assert.Equal(t, codes.ResourceExhausted, st.Code())
+		assert.Len(t, st.Details(), 1)
+		br = st.Details()[0].(*errdetails.BadRequest)
+		assert.Len(t, br.FieldViolations, 1)
+		assert.Equal(t, "changes[0].operations[1]", br.FieldViolations[0].Field)
+	})
+
+	t.Run("max keys per object of project test", func(t *testing.T) {
+		ctx := context.Background()
+		conf := helper.TestConfig()
+		conf.Backend.MaxKeysPerObject = 1
+		svr, err := server.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, svr.Start())
+		defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+		adminCli, err := admin.Dial(svr.AdminAddr())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, adminCli.Close()) }()
+
+		project, err := adminCli.CreateProject(ctx, "max-keys-test")
+		assert.NoError(t, err)
+		maxKeys := 2
+		updated, err := adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+			MaxKeysPerObject: &maxKeys,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, updated.MaxKeysPerObject)
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		doc := document.New(key.Key(t.Name()))
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger("k1", 1)
+			root.SetInteger("k2", 2)
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger("k3", 3)
+			return nil
+		}))
+		assert.Equal(t, codes.ResourceExhausted, status.Convert(cli.Sync(ctx)).Code())
+
+		negative := -1
+		_, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+			MaxKeysPerObject: &negative,
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+	})
+}
@@ -0,0 +1,114 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestDocumentTemplate(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli, err := admin.Dial(svr.AdminAddr())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	project, err := adminCli.CreateProject(context.Background(), "document-template-test")
+	assert.NoError(t, err)
+
+	templates := map[string]string{
+		"memo": `{"title":"{{title}}","owner":"{{ owner }}","todos":[]}`,
+	}
+	updated, err := adminCli.UpdateProject(
+		context.Background(),
+		project.ID.String(),
+		&types.UpdatableProjectFields{DocumentTemplates: &templates},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, templates, updated.DocumentTemplates)
+
+	cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+	assert.NoError(t, err)
+	assert.NoError(t, cli.Activate(context.Background()))
+	defer cleanupClients(t, []*client.Client{cli})
+
+	t.Run("create document from template test", func(t *testing.T) {
+		ctx := context.Background()
+		expected := `{"owner":"alice","title":"Weekly","todos":[]}`
+
+		summary, err := adminCli.CreateDocumentFromTemplate(
+			ctx,
+			project.Name,
+			key.Key(t.Name()),
+			"memo",
+			map[string]string{"title": "Weekly", "owner": "alice"},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, key.Key(t.Name()), summary.Key)
+		assert.Equal(t, expected, summary.Snapshot)
+
+		doc := document.New(key.Key(t.Name()))
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.Equal(t, expected, doc.Marshal())
+	})
+
+	t.Run("create existing document from template test", func(t *testing.T) {
+		ctx := context.Background()
+		variables := map[string]string{"title": "Weekly", "owner": "alice"}
+
+		_, err := adminCli.CreateDocumentFromTemplate(ctx, project.Name, key.Key(t.Name()), "memo", variables)
+		assert.NoError(t, err)
+
+		_, err = adminCli.CreateDocumentFromTemplate(ctx, project.Name, key.Key(t.Name()), "memo", variables)
+		assert.Equal(t, codes.AlreadyExists, status.Convert(err).Code())
+	})
+
+	t.Run("invalid template request test", func(t *testing.T) {
+		ctx := context.Background()
+
+		_, err := adminCli.CreateDocumentFromTemplate(
+			ctx,
+			project.Name,
+			key.Key(t.Name()),
+			"memo",
+			map[string]string{"title": "Weekly"},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+		assert.Contains(t, status.Convert(err).Message(), "owner")
+
+		_, err = adminCli.CreateDocumentFromTemplate(ctx, project.Name, key.Key(t.Name()), "unknown", nil)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+	})
+}
@@ -0,0 +1,108 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestEphemeralDocument(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli, err := admin.Dial(svr.AdminAddr())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	project, err := adminCli.CreateProject(context.Background(), "ephemeral-document-test")
+	assert.NoError(t, err)
+
+	prefix := "tmp-"
+	_, err = adminCli.UpdateProject(
+		context.Background(),
+		project.ID.String(),
+		&types.UpdatableProjectFields{EphemeralKeyPrefix: &prefix},
+	)
+	assert.NoError(t, err)
+
+	clients := make([]*client.Client, 2)
+	for i := range clients {
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		assert.NoError(t, cli.Activate(context.Background()))
+		clients[i] = cli
+	}
+	defer cleanupClients(t, clients)
+	c1, c2 := clients[0], clients[1]
+
+	t.Run("sync ephemeral document test", func(t *testing.T) {
+		ctx := context.Background()
+		docKey := key.Key(prefix + "board")
+
+		d1 := document.New(docKey)
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(docKey)
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		for i := 0; i <= int(helper.SnapshotThreshold); i++ {
+			assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger("k1", i)
+				return nil
+			}))
+		}
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+
+		// 01. The version vector is kept with the state of the document.
+		vector, err := adminCli.GetDocumentVersionVector(ctx, project.Name, docKey)
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, vector.Get(c1.ID()), helper.SnapshotThreshold)
+
+		// 02. The ephemeral document is not stored in the database.
+		summaries, _, err := adminCli.ListDocuments(ctx, project.Name, "", 10, false, gotime.Time{}, nil)
+		assert.NoError(t, err)
+		assert.Len(t, summaries, 0)
+
+		// 03. The state is discarded when the last client detaches.
+		assert.NoError(t, c1.Detach(ctx, d1))
+		assert.NoError(t, c2.Detach(ctx, d2))
+
+		// NOTE: waiting for purging the document.
+		gotime.Sleep(500 * gotime.Millisecond)
+
+		d3 := document.New(docKey)
+		assert.NoError(t, c1.Attach(ctx, d3))
+		assert.Equal(t, "{}", d3.Marshal())
+		assert.NoError(t, c1.Detach(ctx, d3))
+	})
+}
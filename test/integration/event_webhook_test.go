@@ -0,0 +1,123 @@
+//go:build integration
+
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func newEventServer(t *testing.T, secretKey string) (*httptest.Server, chan types.EventWebhookRequest) {
+	events := make(chan types.EventWebhookRequest, 10)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.True(t, types.VerifyEventWebhookSignature(
+			secretKey,
+			payload,
+			r.Header.Get(types.EventWebhookSignatureHeader),
+		))
+
+		var event types.EventWebhookRequest
+		assert.NoError(t, json.Unmarshal(payload, &event))
+		events <- event
+	})), events
+}
+
+func TestProjectEventWebhook(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli, err := admin.Dial(svr.AdminAddr())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	project, err := adminCli.CreateProject(context.Background(), "event-webhook-test")
+	assert.NoError(t, err)
+
+	t.Run("document lifecycle events test", func(t *testing.T) {
+		ctx := context.Background()
+		eventServer, events := newEventServer(t, project.SecretKey)
+		defer eventServer.Close()
+
+		_, err := adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+			EventWebhookURL: &eventServer.URL,
+		})
+		assert.NoError(t, err)
+
+		receive := func() types.EventWebhookRequest {
+			select {
+			case event := <-events:
+				return event
+			case <-time.After(5 * time.Second):
+				assert.Fail(t, "event webhook is not called")
+				return types.EventWebhookRequest{}
+			}
+		}
+
+		c1, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, c1.Close()) }()
+		assert.NoError(t, c1.Activate(ctx))
+		defer func() { assert.NoError(t, c1.Deactivate(ctx)) }()
+
+		docKey := key.Key("event-doc")
+		d1 := document.New(docKey)
+		assert.NoError(t, c1.Attach(ctx, d1))
+
+		received := map[types.EventWebhookType]bool{}
+		for i := 0; i < 2; i++ {
+			event := receive()
+			assert.Equal(t, docKey.String(), event.DocumentKey)
+			assert.Equal(t, project.Name, event.ProjectName)
+			received[event.Type] = true
+		}
+		assert.True(t, received[types.DocumentCreatedEvent])
+		assert.True(t, received[types.DocumentFirstAttachedEvent])
+
+		// detach and attach again to receive the first attachment only.
+		assert.NoError(t, c1.Detach(ctx, d1))
+		d1 = document.New(docKey)
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.Equal(t, types.DocumentFirstAttachedEvent, receive().Type)
+		assert.NoError(t, c1.Detach(ctx, d1))
+
+		removed, _, err := adminCli.RemoveDocumentsByPrefix(ctx, project.Name, "event-", false, false)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, removed)
+		assert.Equal(t, types.DocumentRemovedEvent, receive().Type)
+	})
+}
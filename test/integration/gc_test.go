@@ -20,13 +20,19 @@ package integration
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	gotime "time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/client"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
 )
 
 func TestGarbageCollection(t *testing.T) {
@@ -238,3 +244,89 @@ func TestGarbageCollection(t *testing.T) {
 		assert.Equal(t, 6, d2.GarbageLen())
 	})
 }
+
+func TestTombstoneThreshold(t *testing.T) {
+	conf := helper.TestConfig()
+	conf.Backend.SnapshotInterval = 3
+	svr, err := server.New(conf)
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli, err := admin.Dial(svr.AdminAddr())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	project, err := adminCli.CreateProject(context.Background(), "tombstone-threshold")
+	assert.NoError(t, err)
+
+	var clients []*client.Client
+	for i := 0; i < 2; i++ {
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		assert.NoError(t, cli.Activate(context.Background()))
+		clients = append(clients, cli)
+	}
+	c1, c2 := clients[0], clients[1]
+	defer cleanupClients(t, clients)
+
+	t.Run("force garbage collection after lagging client syncs test", func(t *testing.T) {
+		ctx := context.Background()
+		docKey := key.Key(t.Name())
+		tombstones := int(helper.TombstoneThreshold) + 2
+
+		update := func(doc *document.Document, fn func(root *proxy.ObjectProxy)) {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				fn(root)
+				return nil
+			}))
+			assert.NoError(t, c1.Sync(ctx))
+		}
+		findGarbageLen := func(serverSeq uint64) int {
+			// NOTE: waiting for snapshot.
+			gotime.Sleep(500 * gotime.Millisecond)
+
+			metas, err := adminCli.ListSnapshotMetas(ctx, project.Name, docKey)
+			assert.NoError(t, err)
+			for _, meta := range metas {
+				if meta.ServerSeq == serverSeq {
+					return meta.GarbageLen
+				}
+			}
+			assert.Fail(t, "snapshot not found", serverSeq)
+			return 0
+		}
+
+		// 01. c2 syncs once and then lags behind.
+		d1 := document.New(docKey)
+		assert.NoError(t, c1.Attach(ctx, d1))
+		update(d1, func(root *proxy.ObjectProxy) {
+			for i := 0; i < tombstones; i++ {
+				root.SetInteger(fmt.Sprintf("k%d", i), i)
+			}
+		})
+		d2 := document.New(docKey)
+		assert.NoError(t, c2.Attach(ctx, d2))
+		assert.NoError(t, c2.Sync(ctx))
+
+		// 02. The tombstones stay in the snapshot as c2 has not synced them.
+		update(d1, func(root *proxy.ObjectProxy) {
+			for i := 0; i < tombstones; i++ {
+				root.Delete(fmt.Sprintf("k%d", i))
+			}
+		})
+		update(d1, func(root *proxy.ObjectProxy) { root.SetInteger("a", 1) })
+		blockedSeq := d1.Checkpoint().ServerSeq
+		assert.Equal(t, tombstones, findGarbageLen(blockedSeq))
+
+		// 03. After both clients sync the changes after the snapshot, the next
+		// change stores a snapshot before the interval to collect the
+		// tombstones.
+		update(d1, func(root *proxy.ObjectProxy) { root.SetInteger("b", 1) })
+		assert.NoError(t, c2.Sync(ctx))
+		assert.NoError(t, c2.Sync(ctx))
+		update(d1, func(root *proxy.ObjectProxy) { root.SetInteger("c", 1) })
+		assert.Less(t, d1.Checkpoint().ServerSeq-blockedSeq, conf.Backend.SnapshotInterval)
+		assert.Equal(t, 0, findGarbageLen(d1.Checkpoint().ServerSeq))
+	})
+}
@@ -0,0 +1,99 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestHotDocuments(t *testing.T) {
+	ctx := context.Background()
+	conf := helper.TestConfig()
+	conf.Backend.HotDocumentThreshold = 1
+	svr, err := server.New(conf)
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli, err := admin.Dial(svr.AdminAddr())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	project, err := adminCli.CreateProject(ctx, "hot-documents-test")
+	assert.NoError(t, err)
+
+	cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, cli.Close()) }()
+	assert.NoError(t, cli.Activate(ctx))
+	defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+	coldDoc := document.New(key.Key(t.Name() + "-cold"))
+	assert.NoError(t, cli.Attach(ctx, coldDoc))
+	assert.NoError(t, coldDoc.Update(func(root *proxy.ObjectProxy) error {
+		root.SetString("k1", "v1")
+		return nil
+	}))
+	assert.NoError(t, cli.Sync(ctx))
+
+	stats, err := adminCli.GetProjectStats(ctx, project.Name)
+	assert.NoError(t, err)
+	assert.Empty(t, stats.HotDocuments)
+
+	// the queues of the background jobs are exposed with the stats.
+	assert.Len(t, stats.BackgroundQueues, 2)
+	assert.Equal(t, "snapshot", stats.BackgroundQueues[0].Type)
+	assert.Equal(t, "gc", stats.BackgroundQueues[1].Type)
+
+	// push more operations than the threshold allows within the window.
+	hotDoc := document.New(key.Key(t.Name() + "-hot"))
+	assert.NoError(t, cli.Attach(ctx, hotDoc))
+	window := conf.Backend.ParseHotDocumentWindow()
+	assert.NoError(t, hotDoc.Update(func(root *proxy.ObjectProxy) error {
+		for i := 0; i < 2*int(window.Seconds()); i++ {
+			root.SetInteger(fmt.Sprintf("k%d", i), i)
+		}
+		return nil
+	}))
+	assert.NoError(t, cli.Sync(ctx))
+
+	stats, err = adminCli.GetProjectStats(ctx, project.Name)
+	assert.NoError(t, err)
+	assert.Len(t, stats.HotDocuments, 1)
+	assert.Equal(t, hotDoc.Key(), stats.HotDocuments[0].DocumentKey)
+	assert.Greater(t, stats.HotDocuments[0].OpsPerSecond, conf.Backend.HotDocumentThreshold)
+	assert.Equal(t, types.IDFromActorID(cli.ID()), stats.HotDocuments[0].TopActorID)
+
+	// the hot documents of the other projects are not exposed.
+	stats, err = adminCli.GetProjectStats(ctx, "default")
+	assert.NoError(t, err)
+	assert.Empty(t, stats.HotDocuments)
+}
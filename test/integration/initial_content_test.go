@@ -0,0 +1,116 @@
+//go:build integration
+
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestInitialContent(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli, err := admin.Dial(svr.AdminAddr())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	project, err := adminCli.CreateProject(context.Background(), "initial-content-test")
+	assert.NoError(t, err)
+
+	initialContent := `{"title":"untitled","todos":[{"done":false,"text":"first"}]}`
+	updated, err := adminCli.UpdateProject(
+		context.Background(),
+		project.ID.String(),
+		&types.UpdatableProjectFields{InitialContent: &initialContent},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, initialContent, updated.InitialContent)
+
+	clients := make([]*client.Client, 2)
+	for i := range clients {
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		assert.NoError(t, cli.Activate(context.Background()))
+		clients[i] = cli
+	}
+	defer cleanupClients(t, clients)
+	c1, c2 := clients[0], clients[1]
+
+	t.Run("start from initial content test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.Equal(t, initialContent, d1.Marshal())
+
+		// 01. The initial content is stored only once.
+		d2 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c2.Attach(ctx, d2))
+		assert.Equal(t, initialContent, d2.Marshal())
+
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("title", "todos")
+			return nil
+		}))
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+		assert.Equal(t, `"todos"`, d2.RootObject().Get("title").Marshal())
+	})
+
+	t.Run("explicit content overrides initial content test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.Equal(t, `{"k1":"v1"}`, d1.Marshal())
+
+		d2 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c2.Attach(ctx, d2))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+	})
+
+	t.Run("invalid initial content test", func(t *testing.T) {
+		invalid := `["not", "an", "object"]`
+		_, err := adminCli.UpdateProject(
+			context.Background(),
+			project.ID.String(),
+			&types.UpdatableProjectFields{InitialContent: &invalid},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+	})
+}
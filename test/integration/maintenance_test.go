@@ -0,0 +1,142 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestMaintenance(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli, err := admin.Dial(svr.AdminAddr())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	conn, err := grpc.Dial(svr.RPCAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, conn.Close()) }()
+	healthCli := healthpb.NewHealthClient(conn)
+
+	checkHealth := func(ctx context.Context, service string) healthpb.HealthCheckResponse_ServingStatus {
+		resp, err := healthCli.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+		assert.NoError(t, err)
+		return resp.Status
+	}
+
+	t.Run("reject writes in maintenance mode test", func(t *testing.T) {
+		ctx := context.Background()
+		project, err := adminCli.CreateProject(ctx, "maintenance-test")
+		assert.NoError(t, err)
+
+		cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, cli.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+
+		// 01. Enable the maintenance mode.
+		maintenance, err := adminCli.SetMaintenance(ctx, true, "migrating database")
+		assert.NoError(t, err)
+		assert.True(t, maintenance.Enabled)
+		assert.Equal(t, "migrating database", maintenance.Message)
+		assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, checkHealth(ctx, "api.Yorkie"))
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, checkHealth(ctx, ""))
+
+		// 02. Pushing changes is rejected, but pulling and reading are not.
+		assert.NoError(t, cli.Sync(ctx))
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		err = cli.Sync(ctx)
+		assert.Equal(t, codes.Unavailable, status.Convert(err).Code())
+		assert.Contains(t, status.Convert(err).Message(), "migrating database")
+
+		detail, err := adminCli.GetDocument(ctx, project.Name, d1.Key())
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":"v1"}`, detail.Summary.Snapshot)
+
+		// 03. Creating documents is rejected.
+		d2 := document.New(key.Key(t.Name() + "-new"))
+		err = cli.Attach(ctx, d2)
+		assert.Equal(t, codes.Unavailable, status.Convert(err).Code())
+
+		_, err = adminCli.CreateProject(ctx, "maintenance-test-2")
+		assert.Equal(t, codes.Unavailable, status.Convert(err).Code())
+
+		// 04. The writes are accepted again after the mode is disabled.
+		maintenance, err = adminCli.SetMaintenance(ctx, false, "")
+		assert.NoError(t, err)
+		assert.False(t, maintenance.Enabled)
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, checkHealth(ctx, "api.Yorkie"))
+
+		assert.NoError(t, cli.Sync(ctx))
+		assert.NoError(t, cli.Attach(ctx, d2))
+		detail, err = adminCli.GetDocument(ctx, project.Name, d1.Key())
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":"v1","k2":"v2"}`, detail.Summary.Snapshot)
+	})
+
+	t.Run("get maintenance mode test", func(t *testing.T) {
+		ctx := context.Background()
+		maintenance, err := adminCli.GetMaintenance(ctx)
+		assert.NoError(t, err)
+		assert.False(t, maintenance.Enabled)
+
+		_, err = adminCli.SetMaintenance(ctx, true, "upgrading")
+		assert.NoError(t, err)
+		defer func() {
+			_, err := adminCli.SetMaintenance(ctx, false, "")
+			assert.NoError(t, err)
+		}()
+
+		maintenance, err = adminCli.GetMaintenance(ctx)
+		assert.NoError(t, err)
+		assert.True(t, maintenance.Enabled)
+		assert.Equal(t, "upgrading", maintenance.Message)
+		assert.False(t, maintenance.UpdatedAt.IsZero())
+	})
+}
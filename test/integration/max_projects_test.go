@@ -0,0 +1,92 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestMaxProjects(t *testing.T) {
+	ctx := context.Background()
+
+	// startServer creates the given projects, then starts a server that
+	// allows the given number of projects more than the stored projects.
+	startServer := func(t *testing.T, offset int, names ...string) (*server.Yorkie, *admin.Client) {
+		conf := helper.TestConfig()
+		svr, err := server.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, svr.Start())
+		adminCli, err := admin.Dial(svr.AdminAddr())
+		assert.NoError(t, err)
+		for _, name := range names {
+			_, err := adminCli.CreateProject(ctx, name)
+			assert.NoError(t, err)
+		}
+		projects, err := adminCli.ListProjects(ctx)
+		assert.NoError(t, err)
+		assert.NoError(t, adminCli.Close())
+		assert.NoError(t, svr.Shutdown(true))
+
+		conf = helper.TestConfig()
+		conf.Backend.MaxProjects = len(projects) + offset
+		svr, err = server.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, svr.Start())
+		adminCli, err = admin.Dial(svr.AdminAddr())
+		assert.NoError(t, err)
+		return svr, adminCli
+	}
+
+	t.Run("below and at max projects test", func(t *testing.T) {
+		svr, adminCli := startServer(t, 2)
+		defer func() {
+			assert.NoError(t, adminCli.Close())
+			assert.NoError(t, svr.Shutdown(true))
+		}()
+
+		for i := 0; i < 2; i++ {
+			_, err := adminCli.CreateProject(ctx, fmt.Sprintf("max-projects-%d", i))
+			assert.NoError(t, err)
+		}
+
+		_, err := adminCli.CreateProject(ctx, "max-projects-2")
+		assert.Equal(t, codes.ResourceExhausted, status.Convert(err).Code())
+	})
+
+	t.Run("above max projects test", func(t *testing.T) {
+		svr, adminCli := startServer(t, -1, "max-projects-3")
+		defer func() {
+			assert.NoError(t, adminCli.Close())
+			assert.NoError(t, svr.Shutdown(true))
+		}()
+
+		_, err := adminCli.CreateProject(ctx, "max-projects-4")
+		assert.Equal(t, codes.ResourceExhausted, status.Convert(err).Code())
+	})
+}
@@ -0,0 +1,138 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+)
+
+func TestOperationID(t *testing.T) {
+	clients := activeClients(t, 2)
+	c1, c2 := clients[0], clients[1]
+	defer cleanupClients(t, clients)
+
+	t.Run("resend subset of operations test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewCounter("cnt", 0)
+			root.GetCounter("cnt").Increase(1).Increase(2)
+			return nil
+		}))
+		ops := d1.CreateChangePack().Changes[0].Operations()
+		ops[1].SetID("inc-1")
+		ops[2].SetID("inc-2")
+		assert.NoError(t, c1.Sync(ctx, d1.Key()))
+
+		// NOTE: The increase of "inc-1" is resent with a new one. The change
+		// is rejected instead of applying "inc-1" twice or dropping it.
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.GetCounter("cnt").Increase(1).Increase(5)
+			return nil
+		}))
+		ops = d1.CreateChangePack().Changes[0].Operations()
+		ops[0].SetID("inc-1")
+		ops[1].SetID("inc-3")
+		err := c1.Sync(ctx, d1.Key())
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+		assert.Contains(t, status.Convert(err).Message(), "inc-1")
+
+		assert.NoError(t, c2.Sync(ctx, d2.Key()))
+		assert.Equal(t, `{"cnt":3}`, d2.Marshal())
+	})
+
+	t.Run("resend operations in the same pack test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		for i := 0; i < 2; i++ {
+			assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("k1", "v1")
+				return nil
+			}))
+		}
+		for _, cn := range d1.CreateChangePack().Changes {
+			cn.Operations()[0].SetID("set-k1")
+		}
+		err := c1.Sync(ctx, d1.Key())
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+
+		assert.NoError(t, c2.Sync(ctx, d2.Key()))
+		assert.Equal(t, `{}`, d2.Marshal())
+	})
+
+	t.Run("operations without ids test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		for i := 0; i < 2; i++ {
+			assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("k1", "v1")
+				return nil
+			}))
+		}
+		assert.NoError(t, c1.Sync(ctx, d1.Key()))
+
+		assert.NoError(t, c2.Sync(ctx, d2.Key()))
+		assert.Equal(t, `{"k1":"v1"}`, d2.Marshal())
+	})
+
+	t.Run("operation ids of other documents test", func(t *testing.T) {
+		ctx := context.Background()
+
+		for _, k := range []key.Key{key.Key(t.Name()) + "-1", key.Key(t.Name()) + "-2"} {
+			d1 := document.New(k)
+			assert.NoError(t, c1.Attach(ctx, d1))
+			d2 := document.New(k)
+			assert.NoError(t, c2.Attach(ctx, d2))
+
+			assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("k1", "v1")
+				return nil
+			}))
+			d1.CreateChangePack().Changes[0].Operations()[0].SetID("set-k1")
+			assert.NoError(t, c1.Sync(ctx, d1.Key()))
+
+			assert.NoError(t, c2.Sync(ctx, d2.Key()))
+			assert.Equal(t, `{"k1":"v1"}`, d2.Marshal())
+		}
+	})
+}
@@ -0,0 +1,156 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	gosync "sync"
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+// newOwnerAuthServer creates an auth webhook server which allows only the
+// client whose key is the current owner when re-authorizing documents.
+func newOwnerAuthServer(t *testing.T, owner func() string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := types.NewAuthWebhookRequest(r.Body)
+		assert.NoError(t, err)
+
+		res := types.AuthWebhookResponse{Allowed: true}
+		if req.Method == types.ReauthorizeDocument && req.ClientKey != owner() {
+			res.Allowed = false
+			res.Reason = "not the owner"
+		}
+
+		_, err = res.Write(w)
+		assert.NoError(t, err)
+	}))
+}
+
+func TestTransferDocumentOwnership(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli, err := admin.Dial(svr.AdminAddr())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	var mu gosync.Mutex
+	owner := "alice"
+	authServer := newOwnerAuthServer(t, func() string {
+		mu.Lock()
+		defer mu.Unlock()
+		return owner
+	})
+	defer authServer.Close()
+
+	ctx := context.Background()
+	project, err := adminCli.CreateProject(ctx, "ownership-test")
+	assert.NoError(t, err)
+	_, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+		AuthWebhookURL: &authServer.URL,
+	})
+	assert.NoError(t, err)
+
+	t.Run("transfer document ownership test", func(t *testing.T) {
+		var clients []*client.Client
+		var docs []*document.Document
+		docKey := key.Key(t.Name())
+		for _, clientKey := range []string{"alice", "bob"} {
+			cli, err := client.Dial(
+				svr.RPCAddr(),
+				client.WithAPIKey(project.PublicKey),
+				client.WithKey(clientKey),
+			)
+			assert.NoError(t, err)
+			assert.NoError(t, cli.Activate(ctx))
+			doc := document.New(docKey)
+			assert.NoError(t, cli.Attach(ctx, doc))
+			clients = append(clients, cli)
+			docs = append(docs, doc)
+		}
+		defer cleanupClients(t, clients)
+		alice, bob := clients[0], clients[1]
+
+		assert.NoError(t, docs[0].Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, alice.Sync(ctx))
+
+		// 01. Only the owner is kept attaching the document after the transfer.
+		detached, err := adminCli.TransferDocumentOwnership(ctx, project.Name, docKey, "alice")
+		assert.NoError(t, err)
+		assert.Equal(t, []types.ID{types.IDFromActorID(bob.ID())}, detached)
+
+		detail, err := adminCli.GetDocument(ctx, project.Name, docKey)
+		assert.NoError(t, err)
+		assert.Equal(t, "alice", detail.Summary.Metadata[types.DocumentOwnerMetadataKey])
+		assert.Equal(t, 1, detail.AttachedClients)
+
+		assert.NoError(t, alice.Sync(ctx))
+		err = bob.Sync(ctx)
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+
+		// 02. The transfer is recorded in the client event log.
+		events, err := adminCli.ListDocumentClientEvents(
+			ctx, project.Name, docKey, gotime.Time{}, gotime.Time{}, "", 10, true,
+		)
+		assert.NoError(t, err)
+		counts := make(map[types.DocumentClientEventType]int)
+		for _, event := range events {
+			counts[event.Type]++
+			if event.Type == types.DocumentDetachedByServerEvent {
+				assert.Equal(t, types.IDFromActorID(bob.ID()), event.ClientID)
+			}
+		}
+		assert.Equal(t, 2, counts[types.DocumentOwnershipTransferredByServerEvent])
+		assert.Equal(t, 1, counts[types.DocumentDetachedByServerEvent])
+
+		// 03. The detached client can attach the document again once it is
+		// permitted.
+		mu.Lock()
+		owner = "bob"
+		mu.Unlock()
+		doc := document.New(docKey)
+		assert.NoError(t, bob.Attach(ctx, doc))
+		assert.Equal(t, `{"k1":"v1"}`, doc.Marshal())
+
+		detached, err = adminCli.TransferDocumentOwnership(ctx, project.Name, docKey, "bob")
+		assert.NoError(t, err)
+		assert.Equal(t, []types.ID{types.IDFromActorID(alice.ID())}, detached)
+	})
+}
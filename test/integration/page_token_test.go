@@ -0,0 +1,176 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestPageToken(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli, err := admin.Dial(svr.AdminAddr())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	ctx := context.Background()
+	project, err := adminCli.CreateProject(ctx, "page-token-test")
+	assert.NoError(t, err)
+
+	cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, cli.Close()) }()
+	assert.NoError(t, cli.Activate(ctx))
+
+	var docKeys []key.Key
+	for i := 0; i < 5; i++ {
+		doc := document.New(key.Key(fmt.Sprintf("doc-%d", i)))
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger("k", i)
+			return nil
+		}))
+		assert.NoError(t, cli.Detach(ctx, doc))
+		docKeys = append(docKeys, doc.Key())
+	}
+
+	t.Run("list documents with page token test", func(t *testing.T) {
+		var keys []key.Key
+		var pageToken string
+		for {
+			summaries, nextPageToken, err := adminCli.ListDocumentsByPageToken(
+				ctx, project.Name, pageToken, 2, true, nil,
+			)
+			assert.NoError(t, err)
+			for _, summary := range summaries {
+				keys = append(keys, summary.Key)
+			}
+			if nextPageToken == "" {
+				break
+			}
+			pageToken = nextPageToken
+		}
+		assert.Equal(t, docKeys, keys)
+
+		// the documents created after the first page are not listed.
+		summaries, pageToken, err := adminCli.ListDocumentsByPageToken(ctx, project.Name, "", 3, false, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, docKeys[4], summaries[0].Key)
+		assert.NoError(t, cli.Attach(ctx, document.New("doc-new")))
+		summaries, pageToken, err = adminCli.ListDocumentsByPageToken(ctx, project.Name, pageToken, 3, false, nil)
+		assert.NoError(t, err)
+		assert.Len(t, summaries, 2)
+		assert.Equal(t, docKeys[0], summaries[1].Key)
+		assert.Empty(t, pageToken)
+	})
+
+	t.Run("list document client events with page token test", func(t *testing.T) {
+		events, pageToken, err := adminCli.ListDocumentClientEventsByPageToken(
+			ctx, project.Name, docKeys[0], "", 1, true,
+		)
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+		assert.Equal(t, types.DocumentAttachedByClientEvent, events[0].Type)
+
+		events, _, err = adminCli.ListDocumentClientEventsByPageToken(
+			ctx, project.Name, docKeys[0], pageToken, 1, true,
+		)
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+		assert.Equal(t, types.DocumentDetachedByClientEvent, events[0].Type)
+	})
+
+	t.Run("list changes with page token test", func(t *testing.T) {
+		conn, err := grpc.Dial(svr.AdminAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, conn.Close()) }()
+		apiCli := api.NewAdminClient(conn)
+
+		doc := document.New("changes-doc")
+		assert.NoError(t, cli.Attach(ctx, doc))
+		for i := 0; i < 4; i++ {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger("k", i)
+				return nil
+			}))
+		}
+		assert.NoError(t, cli.Sync(ctx))
+
+		var seqs []uint64
+		req := &api.ListChangesRequest{
+			ProjectName: project.Name,
+			DocumentKey: doc.Key().String(),
+			PageSize:    3,
+		}
+		for {
+			resp, err := apiCli.ListChanges(ctx, req)
+			assert.NoError(t, err)
+			var pageSeqs []uint64
+			for _, pbChange := range resp.Changes {
+				pageSeqs = append(pageSeqs, pbChange.Id.ServerSeq)
+			}
+			seqs = append(pageSeqs, seqs...)
+			if resp.NextPageToken == "" {
+				break
+			}
+			req.PageToken = resp.NextPageToken
+		}
+		assert.Equal(t, []uint64{1, 2, 3, 4}, seqs)
+	})
+
+	t.Run("reject malformed or expired page token test", func(t *testing.T) {
+		_, _, err := adminCli.ListDocumentsByPageToken(ctx, project.Name, "not-a-token", 2, true, nil)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		token := types.NewPageToken(types.SortByServerSeq, "1", true)
+		encoded, err := token.Encode()
+		assert.NoError(t, err)
+		_, _, err = adminCli.ListDocumentsByPageToken(ctx, project.Name, encoded, 2, true, nil)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		token = types.NewPageToken(types.SortByID, "000000000000000000000000", true)
+		token.IssuedAt = gotime.Now().Add(-types.PageTokenTTL - gotime.Minute)
+		encoded, err = token.Encode()
+		assert.NoError(t, err)
+		_, _, err = adminCli.ListDocumentsByPageToken(ctx, project.Name, encoded, 2, true, nil)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+	})
+}
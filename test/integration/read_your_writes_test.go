@@ -0,0 +1,72 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+)
+
+func TestReadYourWrites(t *testing.T) {
+	clients := activeClients(t, 2)
+	c1, c2 := clients[0], clients[1]
+	defer cleanupClients(t, clients)
+
+	t.Run("attach with consistency token test", func(t *testing.T) {
+		ctx := context.Background()
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NotEmpty(t, c1.ConsistencyToken(d1.Key()))
+
+		// 01. c1 writes to the document, and gets the token of the write.
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		token := c1.ConsistencyToken(d1.Key())
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NotEqual(t, token, c1.ConsistencyToken(d1.Key()))
+
+		// 02. c2 reads the write of c1 with the token.
+		d2 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c2.Attach(ctx, d2, client.WithConsistencyToken(c1.ConsistencyToken(d1.Key()))))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+		assert.NoError(t, c2.Sync(ctx))
+	})
+
+	t.Run("invalid consistency token test", func(t *testing.T) {
+		ctx := context.Background()
+		d1 := document.New(key.Key(t.Name() + "-1"))
+		assert.NoError(t, c1.Attach(ctx, d1))
+
+		// the token of another document is rejected.
+		d2 := document.New(key.Key(t.Name() + "-2"))
+		err := c2.Attach(ctx, d2, client.WithConsistencyToken(c1.ConsistencyToken(d1.Key())))
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+	})
+}
@@ -0,0 +1,150 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestRemovedDocumentPolicy(t *testing.T) {
+	conf := helper.TestConfig()
+	conf.Backend.RemovedDocumentRetention = "1s"
+	svr, err := server.New(conf)
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli, err := admin.Dial(svr.AdminAddr())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	project, err := adminCli.CreateProject(context.Background(), "removed-document-policy-test")
+	assert.NoError(t, err)
+
+	cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+	assert.NoError(t, err)
+	assert.NoError(t, cli.Activate(context.Background()))
+	defer cleanupClients(t, []*client.Client{cli})
+
+	setPolicy := func(t *testing.T, policy string) {
+		updated, err := adminCli.UpdateProject(
+			context.Background(),
+			project.ID.String(),
+			&types.UpdatableProjectFields{RemovedDocumentPolicy: &policy},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, policy, updated.RemovedDocumentPolicy)
+	}
+
+	// createAndRemove creates a document of the given key with some content,
+	// then removes it.
+	createAndRemove := func(t *testing.T, k key.Key) {
+		ctx := context.Background()
+		doc := document.New(k)
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.Empty(t, cli.RemovedDocumentAction(k))
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+		assert.NoError(t, cli.Detach(ctx, doc))
+
+		removed, _, err := adminCli.RemoveDocumentsByPrefix(ctx, project.Name, k.String(), false, false)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, removed)
+	}
+
+	t.Run("recreate policy test", func(t *testing.T) {
+		ctx := context.Background()
+		k := key.Key(t.Name())
+		createAndRemove(t, k)
+
+		doc := document.New(k)
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.Equal(t, types.RemovedDocumentRecreated, cli.RemovedDocumentAction(k))
+		assert.Equal(t, `{}`, doc.Marshal())
+		assert.NoError(t, cli.Detach(ctx, doc))
+
+		// NOTE: The recreated document is attached as usual afterward.
+		doc = document.New(k)
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.Empty(t, cli.RemovedDocumentAction(k))
+		assert.NoError(t, cli.Detach(ctx, doc))
+	})
+
+	t.Run("reject policy test", func(t *testing.T) {
+		ctx := context.Background()
+		setPolicy(t, types.RemovedDocumentReject)
+		defer setPolicy(t, types.RemovedDocumentRecreate)
+
+		k := key.Key(t.Name())
+		createAndRemove(t, k)
+
+		doc := document.New(k)
+		err := cli.Attach(ctx, doc)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+	})
+
+	t.Run("restore policy test", func(t *testing.T) {
+		ctx := context.Background()
+		setPolicy(t, types.RemovedDocumentRestore)
+		defer setPolicy(t, types.RemovedDocumentRecreate)
+
+		k := key.Key(t.Name())
+		createAndRemove(t, k)
+
+		doc := document.New(k)
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.Equal(t, types.RemovedDocumentRestored, cli.RemovedDocumentAction(k))
+		assert.Equal(t, `{"k1":"v1"}`, doc.Marshal())
+		assert.NoError(t, cli.Detach(ctx, doc))
+	})
+
+	t.Run("restore policy outside retention test", func(t *testing.T) {
+		ctx := context.Background()
+		setPolicy(t, types.RemovedDocumentRestore)
+		defer setPolicy(t, types.RemovedDocumentRecreate)
+
+		k := key.Key(t.Name())
+		createAndRemove(t, k)
+		gotime.Sleep(1100 * gotime.Millisecond)
+
+		doc := document.New(k)
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.Equal(t, types.RemovedDocumentRecreated, cli.RemovedDocumentAction(k))
+		assert.Equal(t, `{}`, doc.Marshal())
+		assert.NoError(t, cli.Detach(ctx, doc))
+	})
+}
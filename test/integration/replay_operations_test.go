@@ -0,0 +1,138 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+func TestReplayOperations(t *testing.T) {
+	clients := activeClients(t, 1)
+	c1 := clients[0]
+	defer cleanupClients(t, clients)
+
+	adminCli, err := admin.Dial(defaultServer.AdminAddr())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	// newHistory returns a document authored by the given actor in another
+	// system, whose changes are replayed.
+	newHistory := func(t *testing.T, hexActor string) *document.Document {
+		actor, err := time.ActorIDFromHex(hexActor)
+		assert.NoError(t, err)
+		doc := document.New(key.Key(t.Name()))
+		doc.SetActor(actor)
+		return doc
+	}
+
+	t.Run("replay authored history test", func(t *testing.T) {
+		ctx := context.Background()
+		docKey := key.Key(t.Name())
+		history := newHistory(t, "0000000000000000000000a1")
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, history.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger("k1", i)
+				return nil
+			}))
+		}
+
+		// 01. The changes are stored in the order as the history.
+		replay, err := adminCli.ReplayOperations(ctx, "default", docKey, history.CreateChangePack().Changes)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, replay.Replayed)
+		assert.Equal(t, 0, replay.Skipped)
+		assert.Equal(t, uint64(3), replay.ServerSeq)
+
+		d1 := document.New(docKey)
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.Equal(t, history.Marshal(), d1.Marshal())
+
+		// 02. A retried replay does not duplicate the changes.
+		assert.NoError(t, history.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		replay, err = adminCli.ReplayOperations(ctx, "default", docKey, history.CreateChangePack().Changes)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, replay.Replayed)
+		assert.Equal(t, 3, replay.Skipped)
+		assert.Equal(t, uint64(4), replay.ServerSeq)
+
+		assert.NoError(t, c1.Sync(ctx))
+		assert.Equal(t, history.Marshal(), d1.Marshal())
+	})
+
+	t.Run("reject causally inconsistent changes test", func(t *testing.T) {
+		ctx := context.Background()
+		docKey := key.Key(t.Name())
+		history := newHistory(t, "0000000000000000000000a2")
+		for i := 0; i < 2; i++ {
+			assert.NoError(t, history.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger("k1", i)
+				return nil
+			}))
+		}
+		changes := history.CreateChangePack().Changes
+
+		// 01. The changes of an actor must be contiguous.
+		_, err := adminCli.ReplayOperations(ctx, "default", docKey, []*change.Change{changes[1]})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// 02. Nothing is stored by the rejected replay.
+		replay, err := adminCli.ReplayOperations(ctx, "default", docKey, changes)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, replay.Replayed)
+		assert.Equal(t, uint64(2), replay.ServerSeq)
+	})
+	t.Run("reject actors of other format test", func(t *testing.T) {
+		ctx := context.Background()
+		docKey := key.Key(t.Name())
+
+		// 01. The actors must have the length of the actor ID format.
+		history := newHistory(t, "00000000000000a3")
+		assert.NoError(t, history.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger("k1", 1)
+			return nil
+		}))
+		_, err := adminCli.ReplayOperations(ctx, "default", docKey, history.CreateChangePack().Changes)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// 02. The actors of the format are replayed.
+		history = newHistory(t, "0000000000000000000000a3")
+		assert.NoError(t, history.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger("k1", 1)
+			return nil
+		}))
+		replay, err := adminCli.ReplayOperations(ctx, "default", docKey, history.CreateChangePack().Changes)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, replay.Replayed)
+	})
+}
@@ -0,0 +1,67 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package integration
+
+import (
+	"context"
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestShutdown(t *testing.T) {
+	t.Run("shutdown timeout test", func(t *testing.T) {
+		ctx := context.Background()
+
+		conf := helper.TestConfig()
+		conf.RPC.ShutdownTimeout = "500ms"
+		svr, err := server.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, svr.Start())
+
+		// 01. Open a stream which is never closed by the client.
+		conn, err := grpc.Dial(svr.RPCAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, conn.Close()) }()
+
+		stream, err := api.NewYorkieClient(conn).PushChangesStream(ctx)
+		assert.NoError(t, err)
+		assert.NoError(t, stream.Send(&api.PushChangesStreamRequest{
+			TotalSize: 2,
+			Chunk:     []byte{0},
+		}))
+
+		// 02. The graceful shutdown closes the stream forcibly after the timeout
+		// instead of waiting for it.
+		start := gotime.Now()
+		assert.NoError(t, svr.Shutdown(true))
+		elapsed := gotime.Since(start)
+		assert.GreaterOrEqual(t, elapsed, 500*gotime.Millisecond)
+		assert.Less(t, elapsed, 3*gotime.Second)
+
+		_, err = stream.CloseAndRecv()
+		assert.Error(t, err)
+	})
+}
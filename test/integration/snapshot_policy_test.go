@@ -0,0 +1,117 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestSnapshotPolicy(t *testing.T) {
+	ctx := context.Background()
+	adminCli, err := admin.Dial(defaultServer.AdminAddr())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	project, err := adminCli.CreateProject(ctx, "snapshot-policy-test")
+	assert.NoError(t, err)
+
+	t.Run("effective default policy test", func(t *testing.T) {
+		fetched, err := adminCli.GetProject(ctx, project.Name)
+		assert.NoError(t, err)
+		assert.Equal(t, types.SnapshotPolicy{
+			Threshold:      helper.SnapshotThreshold,
+			Compression:    string(types.SnapshotCompressionNone),
+			RetentionCount: helper.SnapshotRetentionCount,
+		}, fetched.SnapshotPolicy)
+	})
+
+	t.Run("invalid policy test", func(t *testing.T) {
+		for _, policy := range []types.SnapshotPolicy{
+			{Threshold: 5},
+			{Threshold: 5, Compression: "zstd", RetentionCount: 1},
+			{Threshold: 0, Compression: "gzip", RetentionCount: 1},
+		} {
+			_, err := adminCli.UpdateProject(
+				ctx,
+				project.ID.String(),
+				&types.UpdatableProjectFields{SnapshotPolicy: &policy},
+			)
+			assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+		}
+	})
+
+	t.Run("project policy test", func(t *testing.T) {
+		policy := types.SnapshotPolicy{
+			Threshold:      5,
+			Compression:    string(types.SnapshotCompressionGzip),
+			RetentionCount: 1,
+		}
+		_, err := adminCli.UpdateProject(
+			ctx,
+			project.ID.String(),
+			&types.UpdatableProjectFields{SnapshotPolicy: &policy},
+		)
+		assert.NoError(t, err)
+
+		fetched, err := adminCli.GetProject(ctx, project.Name)
+		assert.NoError(t, err)
+		assert.Equal(t, policy, fetched.SnapshotPolicy)
+
+		// the documents are built from the compressed snapshots.
+		var clients []*client.Client
+		for i := 0; i < 3; i++ {
+			cli, err := client.Dial(defaultServer.RPCAddr(), client.WithAPIKey(project.PublicKey))
+			assert.NoError(t, err)
+			assert.NoError(t, cli.Activate(ctx))
+			clients = append(clients, cli)
+		}
+		defer cleanupClients(t, clients)
+
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, clients[0].Attach(ctx, d1))
+		for i := 0; i < int(helper.SnapshotOnAttachThreshold)+1; i++ {
+			assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger("k", i)
+				return nil
+			}))
+		}
+		assert.NoError(t, clients[0].Sync(ctx))
+
+		d2 := document.New(key.Key(t.Name()))
+		assert.NoError(t, clients[1].Attach(ctx, d2))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+
+		d3 := document.New(key.Key(t.Name()))
+		assert.NoError(t, clients[2].Attach(ctx, d3))
+		assert.Equal(t, d1.Marshal(), d3.Marshal())
+	})
+}
@@ -76,6 +76,43 @@ func TestSnapshot(t *testing.T) {
 		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
 	})
 
+	t.Run("snapshot on attach test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(key.Key(t.Name()))
+		err := c1.Attach(ctx, d1)
+		assert.NoError(t, err)
+
+		// 01. Update changes over snapshot on attach threshold.
+		for i := 0; i <= int(helper.SnapshotOnAttachThreshold); i++ {
+			err := d1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger(fmt.Sprintf("%d", i), i)
+				return nil
+			})
+			assert.NoError(t, err)
+			assert.NoError(t, c1.Sync(ctx))
+		}
+
+		// 02. Attach the document to trigger a snapshot in the background.
+		d2 := document.New(key.Key(t.Name()))
+		err = c2.Attach(ctx, d2)
+		assert.NoError(t, err)
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+
+		// NOTE: waiting for snapshot.
+		time.Sleep(500 * time.Millisecond)
+
+		// 03. The document attached after the snapshot should be the same.
+		d3 := document.New(key.Key(t.Name()))
+		err = c2.Detach(ctx, d2)
+		assert.NoError(t, err)
+		err = c2.Attach(ctx, d3)
+		assert.NoError(t, err)
+		assert.Equal(t, d1.Marshal(), d3.Marshal())
+
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d3}})
+	})
+
 	t.Run("text snapshot test", func(t *testing.T) {
 		ctx := context.Background()
 
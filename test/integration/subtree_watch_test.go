@@ -0,0 +1,110 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestSubtreeWatch(t *testing.T) {
+	clients := activeClients(t, 2)
+	c1, c2 := clients[0], clients[1]
+	defer cleanupClients(t, clients)
+
+	t.Run("watch subtree test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		rch, err := c2.WatchSubtree(watchCtx, d2, "$.todos")
+		assert.NoError(t, err)
+
+		// 01. changes of the watched subtree are notified.
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("todos").AddString("a")
+			root.SetNewObject("users")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		resp := <-rch
+		assert.Equal(t, client.DocumentsChanged, resp.Type)
+
+		// 02. changes outside of the watched subtree are skipped.
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.GetObject("users").SetString("alice", "online")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("todos").AddString("b")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		resp = <-rch
+		assert.Equal(t, client.DocumentsChanged, resp.Type)
+
+		// 03. removal of the watched subtree is notified.
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.Delete("todos")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		resp = <-rch
+		assert.Equal(t, client.SubtreeRemoved, resp.Type)
+		assert.Equal(t, []string{"$.todos"}, resp.Paths)
+		assert.Equal(t, []key.Key{d2.Key()}, resp.Keys)
+
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+	})
+
+	t.Run("watch too deep subtree test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c1.Attach(ctx, d1))
+
+		path := json.RootPath + strings.Repeat(".k", helper.MaxPathDepth)
+		watchCtx, cancel := context.WithCancel(ctx)
+		_, err := c1.WatchSubtree(watchCtx, d1, path)
+		assert.NoError(t, err)
+		cancel()
+
+		_, err = c1.WatchSubtree(ctx, d1, path+".k")
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+	})
+}
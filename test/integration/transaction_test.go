@@ -0,0 +1,138 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestTransactDocuments(t *testing.T) {
+	clients := activeClients(t, 2)
+	c1, c2 := clients[0], clients[1]
+	defer cleanupClients(t, clients)
+
+	t.Run("transact documents test", func(t *testing.T) {
+		ctx := context.Background()
+		from := document.New(key.Key(t.Name() + "-from"))
+		to := document.New(key.Key(t.Name() + "-to"))
+		assert.NoError(t, c1.Attach(ctx, from))
+		assert.NoError(t, c1.Attach(ctx, to))
+		assert.NoError(t, from.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("items").AddString("item1", "item2")
+			return nil
+		}))
+		assert.NoError(t, to.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("items")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+
+		// 01. c1 moves an item from a list to another atomically.
+		assert.NoError(t, from.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("items").Delete(0)
+			return nil
+		}))
+		assert.NoError(t, to.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("items").AddString("item1")
+			return nil
+		}))
+		assert.NoError(t, c1.TransactDocuments(ctx, from, to))
+		assert.False(t, from.HasLocalChanges())
+		assert.False(t, to.HasLocalChanges())
+
+		// 02. c2 receives the changes of both documents.
+		from2 := document.New(from.Key())
+		to2 := document.New(to.Key())
+		assert.NoError(t, c2.Attach(ctx, from2))
+		assert.NoError(t, c2.Attach(ctx, to2))
+		assert.Equal(t, `{"items":["item2"]}`, from2.Marshal())
+		assert.Equal(t, `{"items":["item1"]}`, to2.Marshal())
+	})
+
+	t.Run("reject duplicate documents test", func(t *testing.T) {
+		ctx := context.Background()
+		doc := document.New(key.Key(t.Name()))
+		assert.NoError(t, c1.Attach(ctx, doc))
+
+		err := c1.TransactDocuments(ctx, doc, doc)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.NoError(t, c1.TransactDocuments(ctx, doc))
+		assert.Equal(t, codes.InvalidArgument, status.Code(c1.TransactDocuments(ctx)))
+	})
+
+	t.Run("reject transaction as a whole test", func(t *testing.T) {
+		ctx := context.Background()
+		conf := helper.TestConfig()
+		conf.Backend.MaxValueBytes = 8
+		svr, err := server.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, svr.Start())
+		defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+		cli, err := client.Dial(svr.RPCAddr())
+		assert.NoError(t, err)
+		assert.NoError(t, cli.Activate(ctx))
+		defer cleanupClients(t, []*client.Client{cli})
+
+		d1 := document.New(key.Key(t.Name() + "-1"))
+		d2 := document.New(key.Key(t.Name() + "-2"))
+		assert.NoError(t, cli.Attach(ctx, d1))
+		assert.NoError(t, cli.Attach(ctx, d2))
+
+		// 01. The transaction is rejected if a pack is rejected.
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "123456789")
+			return nil
+		}))
+		err = cli.TransactDocuments(ctx, d1, d2)
+		st := status.Convert(err)
+		assert.Equal(t, codes.ResourceExhausted, st.Code())
+		assert.Len(t, st.Details(), 1)
+		br := st.Details()[0].(*errdetails.BadRequest)
+		assert.Len(t, br.FieldViolations, 1)
+		assert.Equal(t, "change_packs[1].changes[0].operations[0].value", br.FieldViolations[0].Field)
+
+		// 02. None of the packs is stored.
+		other, err := client.Dial(svr.RPCAddr())
+		assert.NoError(t, err)
+		assert.NoError(t, other.Activate(ctx))
+		defer cleanupClients(t, []*client.Client{other})
+
+		d3 := document.New(d1.Key())
+		assert.NoError(t, other.Attach(ctx, d3))
+		assert.Equal(t, "{}", d3.Marshal())
+	})
+}
@@ -0,0 +1,161 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+)
+
+func TestValidateChange(t *testing.T) {
+	clients := activeClients(t, 2)
+	c1, c2 := clients[0], clients[1]
+	defer cleanupClients(t, clients)
+
+	t.Run("accepted change test", func(t *testing.T) {
+		ctx := context.Background()
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+
+		// 01. The change is accepted, but it is not stored.
+		validation, err := c1.ValidateChange(ctx, d1)
+		assert.NoError(t, err)
+		assert.True(t, validation.Accepted)
+		assert.Equal(t, codes.OK, validation.Code)
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, `{}`, d2.Marshal())
+
+		// 02. The change is pushed by the next sync only once.
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+		assert.Equal(t, `{"k1":"v1"}`, d2.Marshal())
+	})
+
+	t.Run("change rejected by validation test", func(t *testing.T) {
+		ctx := context.Background()
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c1.Attach(ctx, d1))
+
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+		pbPack.Changes[0].Operations[1].GetSet().ExecutedAt.Lamport++
+
+		conn, err := clientConn()
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, conn.Close())
+		}()
+		res, err := api.NewYorkieClient(conn).ValidateChange(ctx, &api.ValidateChangeRequest{
+			ClientId:   c1.ID().Bytes(),
+			ChangePack: pbPack,
+		})
+		assert.NoError(t, err)
+		assert.False(t, res.Accepted)
+		assert.Equal(t, int32(codes.InvalidArgument), res.ErrorCode)
+		assert.Len(t, res.Violations, 1)
+		assert.Equal(t, "changes[0].operations[1].executed_at", res.Violations[0].Field)
+
+		// the original change is still accepted.
+		validation, err := c1.ValidateChange(ctx, d1)
+		assert.NoError(t, err)
+		assert.True(t, validation.Accepted)
+	})
+
+	t.Run("change rejected by authorization test", func(t *testing.T) {
+		ctx := context.Background()
+		docKey := key.Key(t.Name())
+		d1 := document.New(docKey)
+		assert.NoError(t, c1.Attach(ctx, d1))
+
+		adminCli, err := admin.Dial(defaultServer.AdminAddr())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, adminCli.Close()) }()
+		assert.NoError(t, adminCli.LockDocument(ctx, "default", docKey, "migration"))
+
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		validation, err := c1.ValidateChange(ctx, d1)
+		assert.NoError(t, err)
+		assert.False(t, validation.Accepted)
+		assert.Equal(t, codes.FailedPrecondition, validation.Code)
+
+		assert.NoError(t, adminCli.UnlockDocument(ctx, "default", docKey))
+		validation, err = c1.ValidateChange(ctx, d1)
+		assert.NoError(t, err)
+		assert.True(t, validation.Accepted)
+	})
+
+	t.Run("change not applicable test", func(t *testing.T) {
+		ctx := context.Background()
+		d1 := document.New(key.Key(t.Name()))
+		assert.NoError(t, c1.Attach(ctx, d1))
+
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewObject("obj")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.GetObject("obj").SetString("k1", "v1")
+			return nil
+		}))
+		pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+		pbPack.Changes[0].Operations[0].GetSet().ParentCreatedAt.Delimiter += 100
+
+		conn, err := clientConn()
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, conn.Close())
+		}()
+		res, err := api.NewYorkieClient(conn).ValidateChange(ctx, &api.ValidateChangeRequest{
+			ClientId:   c1.ID().Bytes(),
+			ChangePack: pbPack,
+		})
+		assert.NoError(t, err)
+		assert.False(t, res.Accepted)
+		assert.Equal(t, int32(codes.InvalidArgument), res.ErrorCode)
+		assert.Len(t, res.Violations, 1)
+		assert.Equal(t, "changes[0]", res.Violations[0].Field)
+	})
+}
@@ -0,0 +1,141 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestWatchProjectStats(t *testing.T) {
+	ctx := context.Background()
+	conf := helper.TestConfig()
+	conf.Backend.HotDocumentThreshold = 1
+	svr, err := server.New(conf)
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli, err := admin.Dial(svr.AdminAddr())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	project, err := adminCli.CreateProject(ctx, "watch-stats-test")
+	assert.NoError(t, err)
+
+	cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, cli.Close()) }()
+	assert.NoError(t, cli.Activate(ctx))
+	defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+	doc := document.New(key.Key(t.Name()))
+	assert.NoError(t, cli.Attach(ctx, doc))
+
+	// 01. Watch the live statistics of the project.
+	statsCtx, cancelStats := context.WithCancel(ctx)
+	defer cancelStats()
+	updates := make(chan *types.ProjectStatsUpdate, 100)
+	done := make(chan error, 1)
+	go func() {
+		done <- adminCli.WatchProjectStats(statsCtx, project.Name, func(update *types.ProjectStatsUpdate) error {
+			updates <- update
+			return nil
+		})
+	}()
+
+	waitEvent := func(eventType types.ProjectStatsEventType) *types.ProjectStatsUpdate {
+		deadline := time.After(5 * time.Second)
+		for {
+			select {
+			case update := <-updates:
+				if update.Event != nil && update.Event.Type == eventType {
+					return update
+				}
+			case <-deadline:
+				assert.Fail(t, "event is not delivered", eventType)
+				return nil
+			}
+		}
+	}
+
+	// 02. The statistics are sent right away and periodically.
+	for i := 0; i < 2; i++ {
+		select {
+		case update := <-updates:
+			assert.Nil(t, update.Event)
+			assert.Zero(t, update.Stats.ActiveDocuments)
+			assert.Zero(t, update.Stats.AttachedClients)
+			assert.False(t, update.Stats.MeasuredAt.IsZero())
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "stats are not delivered")
+			return
+		}
+	}
+
+	// 03. The document is activated when a client starts watching it.
+	watchCtx, cancelWatch := context.WithCancel(ctx)
+	defer cancelWatch()
+	_, err = cli.Watch(watchCtx, doc)
+	assert.NoError(t, err)
+	update := waitEvent(types.DocumentActivatedEvent)
+	assert.Equal(t, doc.Key(), update.Event.DocumentKey)
+	assert.Equal(t, 1, update.Stats.ActiveDocuments)
+	assert.Equal(t, 1, update.Stats.AttachedClients)
+
+	// 04. The hot document is delivered with the change rate of the project.
+	window := conf.Backend.ParseHotDocumentWindow()
+	assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+		for i := 0; i < 2*int(window.Seconds()); i++ {
+			root.SetInteger(fmt.Sprintf("k%d", i), i)
+		}
+		return nil
+	}))
+	assert.NoError(t, cli.Sync(ctx))
+	update = waitEvent(types.DocumentHotEvent)
+	assert.Equal(t, doc.Key(), update.Event.DocumentKey)
+	assert.Equal(t, 1, update.Stats.HotDocuments)
+	assert.Greater(t, update.Stats.OpsPerSecond, conf.Backend.HotDocumentThreshold)
+
+	// 05. The document is deactivated when the client stops watching it.
+	cancelWatch()
+	update = waitEvent(types.DocumentDeactivatedEvent)
+	assert.Zero(t, update.Stats.ActiveDocuments)
+	assert.Zero(t, update.Stats.AttachedClients)
+
+	// 06. The watch ends when the context is done.
+	cancelStats()
+	err = <-done
+	assert.Equal(t, codes.Canceled, status.Code(err))
+}
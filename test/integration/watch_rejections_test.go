@@ -0,0 +1,109 @@
+//go:build integration
+
+/*
+ * Copyright 2023 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+)
+
+func TestWatchRejections(t *testing.T) {
+	clients := activeClients(t, 1)
+	c1 := clients[0]
+	defer cleanupClients(t, clients)
+
+	adminCli, err := admin.Dial(defaultServer.AdminAddr())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, adminCli.Close()) }()
+
+	t.Run("watch rejected changes test", func(t *testing.T) {
+		ctx := context.Background()
+		docKey := key.Key(t.Name())
+		d1 := document.New(docKey)
+		assert.NoError(t, c1.Attach(ctx, d1))
+
+		// 01. Watch the rejections of the project.
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		rejections := make(chan *types.Rejection, 10)
+		done := make(chan error, 1)
+		go func() {
+			done <- adminCli.WatchRejections(watchCtx, "default", 0, func(rejection *types.Rejection) error {
+				rejections <- rejection
+				return nil
+			})
+		}()
+
+		// 02. Push a change of an inconsistent timestamp until it is reported.
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+		pbPack.Changes[0].Operations[1].GetSet().ExecutedAt.Lamport++
+
+		conn, err := clientConn()
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, conn.Close())
+		}()
+
+		var rejection *types.Rejection
+		deadline := time.After(5 * time.Second)
+		for rejection == nil {
+			_, err = api.NewYorkieClient(conn).PushPull(ctx, &api.PushPullRequest{
+				ClientId:   c1.ID().Bytes(),
+				ChangePack: pbPack,
+			})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+			select {
+			case rejection = <-rejections:
+			case <-time.After(100 * time.Millisecond):
+			case <-deadline:
+				assert.Fail(t, "rejection is not delivered")
+				return
+			}
+		}
+		assert.Equal(t, docKey, rejection.DocumentKey)
+		assert.Equal(t, c1.ID().String(), rejection.ClientID.String())
+		assert.Equal(t, types.RejectedAsInvalid, rejection.Reason)
+		assert.Equal(t, 1, rejection.Changes)
+
+		// 03. The watch ends when the context is done.
+		cancel()
+		err = <-done
+		assert.Equal(t, codes.Canceled, status.Code(err))
+	})
+}